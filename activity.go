@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// activityDayFormat is how days are keyed in the counts of pages per day
+	activityDayFormat = "2006-01-02"
+
+	statusActivityBuild = "building activity page"
+)
+
+// activityShades are the cells of the calendar, from days without pages to
+// days with four or more
+var activityShades = []string{"·", "░", "▒", "▓", "█"}
+
+// activity is how much was written when: the number of pages created on each
+// day, and the streaks of days in a row with at least one
+type activity struct {
+	// counts are the number of pages created on each day, by activityDayFormat
+	counts map[string]int
+
+	total int
+
+	// currentStreak is the number of days in a row up to today, or up to
+	// yesterday if nothing's been written yet today
+	currentStreak int
+
+	longestStreak      int
+	longestStreakStart time.Time
+	longestStreakEnd   time.Time
+
+	busiestDay   time.Time
+	busiestCount int
+}
+
+// calendar is a GitHub-style contribution calendar: a column for each week,
+// Sunday to Saturday, with a label over the first column of each month
+type calendar struct {
+	weeks  [][7]calendarDay
+	months []calendarMonth
+}
+
+// calendarDay is a cell of the calendar. Days outside the calendar's range,
+// at the start of its first week and the end of its last, are left blank
+type calendarDay struct {
+	date    time.Time
+	count   int
+	inRange bool
+}
+
+// calendarMonth is a month's label, over the week it starts in
+type calendarMonth struct {
+	label string
+	week  int
+}
+
+// pageActivity counts the content pages created on each day, with their dates
+// in loc, and works out the streaks up to today
+func pageActivity(pageSet []*pages.Page, today time.Time, loc *time.Location) activity {
+	act := activity{counts: map[string]int{}}
+
+	for _, page := range listedPages(pageSet, "") {
+		created := page.CreatedAt()
+		if created.IsZero() {
+			continue
+		}
+
+		day := created.In(loc)
+		key := day.Format(activityDayFormat)
+
+		act.counts[key]++
+		act.total++
+
+		// Pages are newest first, so on a tie the earliest day is kept
+		if act.counts[key] >= act.busiestCount {
+			act.busiestDay = startOfDay(day)
+			act.busiestCount = act.counts[key]
+		}
+	}
+
+	act.findStreaks(startOfDay(today.In(loc)))
+
+	return act
+}
+
+// activityCalendar lays out the calendar of the year up to end. The first
+// week starts on the Sunday on or before the day after end a year earlier,
+// and the last week is the one end is in
+func activityCalendar(counts map[string]int, end time.Time) calendar {
+	end = startOfDay(end)
+	start := end.AddDate(-1, 0, 1)
+	first := start.AddDate(0, 0, -int(start.Weekday()))
+
+	cal := calendar{weeks: [][7]calendarDay{}, months: []calendarMonth{}}
+	labelled := map[string]bool{}
+
+	for weekStart := first; !weekStart.After(end); weekStart = weekStart.AddDate(0, 0, 7) {
+		week := [7]calendarDay{}
+
+		for weekday := range week {
+			date := weekStart.AddDate(0, 0, weekday)
+			inRange := !date.Before(start) && !date.After(end)
+
+			week[weekday] = calendarDay{date: date, inRange: inRange}
+			if !inRange {
+				continue
+			}
+
+			week[weekday].count = counts[date.Format(activityDayFormat)]
+
+			// A month is labelled over the first week that has any of its days
+			month := date.Format("2006-01")
+			if !labelled[month] {
+				labelled[month] = true
+				cal.months = append(cal.months, calendarMonth{label: date.Format("Jan"), week: len(cal.weeks)})
+			}
+		}
+
+		cal.weeks = append(cal.weeks, week)
+	}
+
+	return cal
+}
+
+// String draws the calendar, a character for each day, with the months above
+// and every other weekday beside it. A month's label that would run into the
+// one before it is left out
+func (cal calendar) String() string {
+	const margin = "    "
+
+	var builder strings.Builder
+
+	labels := []rune(strings.Repeat(" ", len(cal.weeks)+3))
+	next := 0
+
+	for _, month := range cal.months {
+		if month.week < next {
+			continue
+		}
+
+		copy(labels[month.week:], []rune(month.label))
+		next = month.week + len(month.label) + 1
+	}
+
+	builder.WriteString(strings.TrimRight(margin+string(labels), " "))
+	builder.WriteString("\n")
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		label := margin
+		if weekday%2 == 1 {
+			label = weekday.String()[:3] + " "
+		}
+
+		row := label
+		for _, week := range cal.weeks {
+			day := week[weekday]
+
+			if !day.inRange {
+				row += " "
+				continue
+			}
+
+			row += activityShade(day.count)
+		}
+
+		builder.WriteString(strings.TrimRight(row, " "))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// buildActivityPage writes the activity page, with the calendar of the last
+// year and the streaks, into the docs directory
+func buildActivityPage(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, docsDir string, warnings *buildWarnings) error {
+	src.Info(statusActivityBuild)
+
+	loc, err := configuredLocation()
+	if err != nil {
+		return err
+	}
+
+	today := pages.Now().In(loc)
+
+	content := activityContent(pageActivity(pageSet, today, loc), today)
+	content += "\n"
+	content += siteFooter(pageSet)
+
+	filePath := filepath.Join(docsDir, fmt.Sprintf("activity.%s", pages.FileExtension))
+
+	err = fileSystem.WriteFile(filePath, []byte(pages.MarkGenerated(content)), 0644)
+	if err != nil {
+		return err
+	}
+
+	src.Progress(filePath)
+
+	return nil
+}
+
+// activityContent returns the body of the activity page
+func activityContent(act activity, today time.Time) string {
+	content := "## Activity\n\n"
+	content += fmt.Sprintf("```text\n%s```\n\n", activityCalendar(act.counts, today))
+
+	for _, line := range activitySummary(act) {
+		content += fmt.Sprintf("* %s\n", line)
+	}
+
+	return content
+}
+
+// activitySummary returns a line each for the streaks and the busiest day
+func activitySummary(act activity) []string {
+	lines := []string{fmt.Sprintf("Current streak: %s", pluralDays(act.currentStreak))}
+
+	if act.longestStreak > 0 {
+		lines = append(lines, fmt.Sprintf(
+			"Longest streak: %s, %s to %s",
+			pluralDays(act.longestStreak), pages.FormatDate(act.longestStreakStart), pages.FormatDate(act.longestStreakEnd),
+		))
+	} else {
+		lines = append(lines, "Longest streak: 0 days")
+	}
+
+	if act.busiestCount > 0 {
+		lines = append(lines, fmt.Sprintf("Busiest day: %s, with %s", pages.FormatDate(act.busiestDay), pluralPages(act.busiestCount)))
+	}
+
+	return lines
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// findStreaks works out the current and longest streaks from the counts
+func (act *activity) findStreaks(today time.Time) {
+	days := []time.Time{}
+	for key := range act.counts {
+		day, err := time.ParseInLocation(activityDayFormat, key, today.Location())
+		if err == nil {
+			days = append(days, day)
+		}
+	}
+
+	// Walk backwards from the latest day, counting the days in a row
+	sort.Slice(days, func(i, j int) bool { return days[i].After(days[j]) })
+
+	streak := 0
+	var streakEnd time.Time
+
+	for i, day := range days {
+		if i == 0 || !sameCalendarDay(shiftPeriod(day, src.CadenceDaily, 1), days[i-1]) {
+			streak = 0
+			streakEnd = day
+		}
+
+		streak++
+
+		// Newest first, so on a tie the most recent streak is kept
+		if streak > act.longestStreak {
+			act.longestStreak = streak
+			act.longestStreakStart = day
+			act.longestStreakEnd = streakEnd
+		}
+	}
+
+	act.currentStreak = 0
+
+	day := today
+	if act.counts[day.Format(activityDayFormat)] == 0 {
+		day = shiftPeriod(day, src.CadenceDaily, -1)
+	}
+
+	for act.counts[day.Format(activityDayFormat)] > 0 {
+		act.currentStreak++
+		day = shiftPeriod(day, src.CadenceDaily, -1)
+	}
+}
+
+// activityShade returns the calendar cell for a day with count pages
+func activityShade(count int) string {
+	if count >= len(activityShades) {
+		count = len(activityShades) - 1
+	}
+
+	return activityShades[count]
+}
+
+// startOfDay returns midnight at the start of the date's day, in its location
+func startOfDay(date time.Time) time.Time {
+	return periodStart(date, src.CadenceDaily, time.Sunday)
+}
+
+// sameCalendarDay returns true if the two dates are on the same day
+func sameCalendarDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// pluralDays returns the number of days, like "1 day" or "3 days"
+func pluralDays(count int) string {
+	if count == 1 {
+		return "1 day"
+	}
+
+	return fmt.Sprintf("%d days", count)
+}
+
+// pluralPages returns the number of pages, like "1 page" or "3 pages"
+func pluralPages(count int) string {
+	if count == 1 {
+		return "1 page"
+	}
+
+	return fmt.Sprintf("%d pages", count)
+}
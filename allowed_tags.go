@@ -0,0 +1,226 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errTagNotAllowed     = "tag '%s' isn't in the allowed tags"
+	errTagNotAllowedHint = "tag '%s' isn't in the allowed tags. Did you mean '%s'?"
+	errTagsNotAllowed    = "found %d uses of tags that aren't in the allowed tags"
+)
+
+// loadAllowedTags returns the tags that pages are allowed to use, as listed
+// by allowedTags in the config or, one per line, in the allowedTagsFile in
+// the docs directory. If neither is set any tag is allowed, and ok is false
+func loadAllowedTags() (allowed []string, ok bool, err error) {
+	if fileName := src.GlobalConfig.UString("allowedTagsFile", ""); fileName != "" {
+		data, err := fileSystem.ReadFile(allowedTagsFilePath())
+		if err != nil {
+			return nil, false, err
+		}
+
+		return parseAllowedTags(string(data)), true, nil
+	}
+
+	list, err := src.GlobalConfig.List("allowedTags")
+	if err != nil {
+		// No allowed tags defined, so anything goes
+		return []string{}, false, nil
+	}
+
+	for _, name := range list {
+		allowed = append(allowed, strings.TrimSpace(fmt.Sprintf("%v", name)))
+	}
+
+	return allowed, true, nil
+}
+
+// allowedTagsFilePath returns the path to the file that lists the allowed
+// tags, or an empty string if there isn't one
+func allowedTagsFilePath() string {
+	fileName := src.GlobalConfig.UString("allowedTagsFile", "")
+	if fileName == "" {
+		return ""
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	return filepath.Join(tDir, fileName)
+}
+
+// parseAllowedTags reads the allowed tags from a file with one tag per line.
+// Blank lines, and lines starting with #, are ignored
+func parseAllowedTags(data string) []string {
+	allowed := []string{}
+
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			allowed = append(allowed, line)
+		}
+	}
+
+	return allowed
+}
+
+// checkAllowedTags returns an error for the first of the tags that isn't
+// allowed. When no allowed tags are configured, every tag is allowed
+func checkAllowedTags(tags []string) error {
+	allowed, ok, err := loadAllowedTags()
+	if err != nil || !ok {
+		return err
+	}
+
+	aliases, err := loadAliases()
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if !isAllowedTag(tag, allowed, aliases) {
+			return errors.New(disallowedTagMessage(tag, allowed))
+		}
+	}
+
+	return nil
+}
+
+// validateAllowedTags returns a problem for each page with a tag that isn't
+// in the allowed tags. When no allowed tags are configured, it finds nothing
+func validateAllowedTags(pageSet []*pages.Page) ([]string, error) {
+	warnings := []string{}
+
+	allowed, ok, err := loadAllowedTags()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return warnings, nil
+	}
+
+	aliases, err := loadAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pageSet {
+		for _, tag := range page.Tags() {
+			if tag.IsValid() && !isAllowedTag(tag.Name, allowed, aliases) {
+				warnings = append(warnings, fmt.Sprintf("%s: %s", page.FilePath, disallowedTagMessage(tag.Name, allowed)))
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// isAllowedTag returns true if the tag, or the tag it is an alias of, is one
+// of the allowed tags, in any case. Allowing a child tag (go/concurrency)
+// also allows its parents (go), because every page with the child has them
+func isAllowedTag(name string, allowed []string, aliases map[string]string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if tag, ok := aliases[name]; ok {
+		name = strings.ToLower(tag)
+	}
+
+	for _, allowedName := range allowed {
+		allowedName = strings.ToLower(allowedName)
+
+		if allowedName == name || strings.HasPrefix(allowedName, name+pages.TagSeparator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// disallowedTagMessage explains that a tag isn't allowed, and suggests the
+// allowed tag it's closest to
+func disallowedTagMessage(name string, allowed []string) string {
+	closest := closestTag(name, allowed)
+	if closest == "" {
+		return fmt.Sprintf(errTagNotAllowed, name)
+	}
+
+	return fmt.Sprintf(errTagNotAllowedHint, name, closest)
+}
+
+// closestTag returns the allowed tag that is the fewest edits away from name,
+// ignoring case. Tags the same distance away are ranked alphabetically. A tag
+// more than a third of name's length away is too different to be what was
+// meant, so when every tag is, it returns an empty string
+func closestTag(name string, allowed []string) string {
+	sorted := append([]string{}, allowed...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i]) < strings.ToLower(sorted[j])
+	})
+
+	closest := ""
+	best := utf8.RuneCountInString(name)/3 + 1
+
+	for _, allowedName := range sorted {
+		distance := editDistance(strings.ToLower(name), strings.ToLower(allowedName))
+
+		if distance < best {
+			closest = allowedName
+			best = distance
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between two strings: the
+// number of single character insertions, deletions, or substitutions it
+// takes to turn one into the other
+func editDistance(a, b string) int {
+	from, to := []rune(a), []rune(b)
+
+	prev := make([]int, len(to)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(from); i++ {
+		curr := make([]int, len(to)+1)
+		curr[0] = i
+
+		for j := 1; j <= len(to); j++ {
+			cost := 1
+			if from[i-1] == to[j-1] {
+				cost = 0
+			}
+
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev = curr
+	}
+
+	return prev[len(to)]
+}
+
+func minInt(nums ...int) int {
+	min := nums[0]
+
+	for _, num := range nums[1:] {
+		if num < min {
+			min = num
+		}
+	}
+
+	return min
+}
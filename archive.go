@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	statusAlreadyArchived = "%s is already archived"
+	statusNotArchived     = "%s isn't archived"
+	statusPageArchived    = "archived %s. It's on the archive page and its tags' pages, but not the index"
+	statusPageUnarchived  = "unarchived %s. It's back on the index"
+)
+
+// runArchive archives the page that matches the query, setting archived: true
+// in its front-matter. Archived pages are left off the index and the all page,
+// and listed on the archive page instead. They stay on their tags' pages,
+// marked as archived.
+// Example:
+//
+//	> til archive vagrant boxes
+func runArchive(ctx context.Context, args []string) {
+	setArchived(ctx, "archive", args, true)
+}
+
+// runUnarchive puts an archived page that matches the query back on the
+// index, taking archived out of its front-matter.
+// Example:
+//
+//	> til unarchive vagrant boxes
+func runUnarchive(ctx context.Context, args []string) {
+	setArchived(ctx, "unarchive", args, false)
+}
+
+// markArchived archives the page, or unarchives it. Unarchiving takes the
+// archived field out of the front-matter, rather than leaving archived: false
+func markArchived(page *pages.Page, archived bool) error {
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	data, err := fileSystem.ReadFile(page.FilePath)
+	if err != nil {
+		return err
+	}
+
+	if archived {
+		data, err = pages.SetFrontMatterField(data, "archived", "true")
+	} else {
+		data, err = pages.RemoveFrontMatterField(data, "archived")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = rewritePage(page.FilePath, data)
+	if err != nil {
+		return err
+	}
+
+	page.Archived = archived
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// setArchived is til archive and til unarchive, which only differ in which
+// way they change the page
+func setArchived(ctx context.Context, name string, args []string, archived bool) {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	switch {
+	case page.Archived && archived:
+		src.Info(fmt.Sprintf(statusAlreadyArchived, page.FilePath))
+		return
+	case !page.Archived && !archived:
+		src.Info(fmt.Sprintf(statusNotArchived, page.FilePath))
+		return
+	}
+
+	err = markArchived(page, archived)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if archived {
+		src.Info(fmt.Sprintf(statusPageArchived, page.FilePath))
+	} else {
+		src.Info(fmt.Sprintf(statusPageUnarchived, page.FilePath))
+	}
+}
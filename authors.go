@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// unknownAuthor is who a page is counted for when its author can't be told
+	unknownAuthor = "unknown"
+
+	statusAuthorPagePruned = "removed %s, because no page has that author any more"
+)
+
+// authorCount is an author along with the number of pages they wrote
+type authorCount struct {
+	name  string
+	count int
+}
+
+// authorsSummary returns the line at the bottom of the index that says how
+// many pages there are and who wrote them (e.g.: 47 TILs by 6 people: Ann
+// (30), Bob (17)). Each page's author comes from the author field in its
+// front-matter or, if it doesn't have one, from the git history
+func authorsSummary(pageSet []*pages.Page) string {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	inRepo := isGitRepo(tDir)
+
+	authors := map[string]int{}
+	total := 0
+
+	for _, page := range pageSet {
+		if !page.IsContentPage() {
+			continue
+		}
+
+		name := strings.TrimSpace(page.Author)
+		if name == "" && inRepo {
+			name = authorFromGit(tDir, page.FilePath)
+		}
+
+		if name == "" {
+			name = unknownAuthor
+		}
+
+		authors[name]++
+		total++
+	}
+
+	return formatAuthors(total, sortAuthors(authors))
+}
+
+// buildAuthorPages writes the author pages that the index links to, and
+// removes the ones for authors who don't have any pages now
+func buildAuthorPages(ctx context.Context, pageSet []*pages.Page, tDir string, opts til.Options) error {
+	written, removed, err := til.BuildAuthorPages(ctx, tDir, pageSet, opts)
+
+	for _, filePath := range written {
+		src.Progress(filePath)
+	}
+
+	for _, filePath := range removed {
+		src.Progress(fmt.Sprintf(statusAuthorPagePruned, filePath))
+	}
+
+	return err
+}
+
+// pagesByAuthor returns the pages by the author, matched the way the author
+// pages group them, or all the pages when author is empty
+func pagesByAuthor(pageSet []*pages.Page, author string) []*pages.Page {
+	if author == "" {
+		return pageSet
+	}
+
+	return til.PagesBy(pageSet, author)
+}
+
+// authorFromGit returns the person who made the most commits to the file. If
+// nobody made the most, because of a tie, it returns an empty string
+func authorFromGit(tDir, filePath string) string {
+	out, err := runCommand(tDir, "git", "log", "--follow", "--format=%an", "--", filePath)
+	if err != nil {
+		return ""
+	}
+
+	commits := map[string]int{}
+	for _, line := range strings.Split(string(out), "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			commits[name]++
+		}
+	}
+
+	sorted := sortAuthors(commits)
+
+	if len(sorted) == 0 || (len(sorted) > 1 && sorted[0].count == sorted[1].count) {
+		return ""
+	}
+
+	return sorted[0].name
+}
+
+// sortAuthors sorts the authors by the number of pages they wrote, then by
+// name. Unknown always comes last
+func sortAuthors(counts map[string]int) []authorCount {
+	authors := []authorCount{}
+	for name, count := range counts {
+		authors = append(authors, authorCount{name: name, count: count})
+	}
+
+	sort.Slice(authors, func(i, j int) bool {
+		if (authors[i].name == unknownAuthor) != (authors[j].name == unknownAuthor) {
+			return authors[j].name == unknownAuthor
+		}
+
+		if authors[i].count != authors[j].count {
+			return authors[i].count > authors[j].count
+		}
+
+		return strings.ToLower(authors[i].name) < strings.ToLower(authors[j].name)
+	})
+
+	return authors
+}
+
+// formatAuthors writes the authors line. Unknown is listed with the others
+// but isn't counted as a person
+func formatAuthors(total int, authors []authorCount) string {
+	people := 0
+	names := []string{}
+
+	for _, author := range authors {
+		if author.name != unknownAuthor {
+			people++
+		}
+
+		names = append(names, fmt.Sprintf("%s (%d)", author.name, author.count))
+	}
+
+	summary := fmt.Sprintf("%d %s by %d %s", total, plural(total, "TIL", "TILs"), people, plural(people, "person", "people"))
+	if len(names) == 0 {
+		return summary
+	}
+
+	return fmt.Sprintf("%s: %s", summary, strings.Join(names, ", "))
+}
+
+func plural(count int, one, many string) string {
+	if count == 1 {
+		return one
+	}
+
+	return many
+}
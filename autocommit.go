@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	defaultRemote = "origin"
+
+	statusAutoCommit = "committed: %s"
+	statusAutoPush   = "pushed %s to %s"
+)
+
+// commandRunner runs an external command in the given directory, and returns
+// what it wrote to stdout and stderr
+type commandRunner func(dir string, name string, args ...string) ([]byte, error)
+
+// runCommand is the commandRunner that til uses. Tests swap it out so that
+// they can see which commands would be run, without needing a real repo
+var runCommand commandRunner = func(dir string, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+
+	return cmd.CombinedOutput()
+}
+
+// autoCommit commits the given paths to git, with a message written from the
+// info about the change, if git.autoCommit is turned on in the config.
+// It quietly does nothing if the target directory isn't a git repo, or if
+// none of the paths have changed. Anything already staged in other files is
+// left out of the commit
+func autoCommit(info src.CommitInfo, paths ...string) {
+	if !src.GlobalConfig.UBool("git.autoCommit", false) {
+		return
+	}
+
+	msg, err := src.CommitMessage(src.GlobalConfig, info)
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not commit: %s", err))
+		return
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	_, err = runCommand(tDir, "git", "rev-parse", "--is-inside-work-tree")
+	if err != nil {
+		// Not a git repo, so there's nothing to commit to
+		return
+	}
+
+	_, err = runCommand(tDir, "git", append([]string{"add", "--all", "--"}, paths...)...)
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not stage %s: %s", strings.Join(paths, ", "), err))
+		return
+	}
+
+	_, err = runCommand(tDir, "git", append([]string{"diff", "--cached", "--quiet", "--"}, paths...)...)
+	if err == nil {
+		// Nothing changed, so nothing to commit
+		return
+	}
+
+	out, err := runCommand(tDir, "git", commitArgs(msg, paths)...)
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not commit: %s", strings.TrimSpace(string(out))))
+		return
+	}
+
+	src.Info(fmt.Sprintf(statusAutoCommit, msg))
+
+	if shouldAutoPush() {
+		autoPush(tDir)
+	}
+}
+
+// shouldAutoPush returns true if a commit made by autoCommit should be pushed.
+// The -push and -no-push flags win over git.autoPush in the config, and
+// -no-push wins over everything
+func shouldAutoPush() bool {
+	if noPushFlag {
+		return false
+	}
+
+	return pushFlag || src.GlobalConfig.UBool("git.autoPush", false)
+}
+
+// autoPush pushes the current branch to its remote. The commit has already
+// been made by then, so a failed push (no network, rejected credentials, a
+// remote that has moved on) is only a warning
+func autoPush(tDir string) {
+	out, err := runCommand(tDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not push: %s", strings.TrimSpace(string(out))))
+		return
+	}
+
+	branch := strings.TrimSpace(string(out))
+	remote := defaultRemote
+
+	// A branch that tracks a remote knows which one. Otherwise git's error
+	// for a missing setting is fine to ignore
+	out, err = runCommand(tDir, "git", "config", fmt.Sprintf("branch.%s.remote", branch))
+	if err == nil && strings.TrimSpace(string(out)) != "" {
+		remote = strings.TrimSpace(string(out))
+	}
+
+	out, err = runCommand(tDir, "git", "push", remote, branch)
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not push %s to %s: %s", branch, remote, strings.TrimSpace(string(out))))
+		return
+	}
+
+	src.Info(fmt.Sprintf(statusAutoPush, branch, remote))
+}
+
+// autoCommitBuild commits everything that a build changed in the docs
+// directory, including tag pages that it removed
+func autoCommitBuild() {
+	docsDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	autoCommit(src.CommitInfo{Action: src.ActionBuild}, docsDir)
+}
+
+// repoRelativePath returns the path of a file relative to the target
+// directory (e.g.: docs/2020-05-07-zombies.md), which is how git shows it
+func repoRelativePath(filePath string) string {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	relPath, err := filepath.Rel(tDir, filePath)
+	if err != nil {
+		return filePath
+	}
+
+	return filepath.ToSlash(relPath)
+}
+
+// commitArgs returns the arguments to git that commit the paths. The commit
+// is made as the configured committer, if there is one
+func commitArgs(msg string, paths []string) []string {
+	args := []string{}
+
+	if name := src.GlobalConfig.UString("committerName", ""); name != "" {
+		args = append(args, "-c", fmt.Sprintf("user.name=%s", name))
+	}
+
+	if email := src.GlobalConfig.UString("committerEmail", ""); email != "" {
+		args = append(args, "-c", fmt.Sprintf("user.email=%s", email))
+	}
+
+	args = append(args, "commit", "--message", msg, "--")
+
+	return append(args, paths...)
+}
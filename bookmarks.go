@@ -0,0 +1,370 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errBookmarksFolder = "there's no bookmarks folder called %s"
+	errBookmarksFormat = "this isn't a bookmarks export; browsers export bookmarks as an HTML file that starts with <!DOCTYPE NETSCAPE-Bookmark-file-1>"
+
+	// bookmarksDoctype starts every bookmarks export, whichever browser
+	// wrote it
+	bookmarksDoctype = "<!DOCTYPE NETSCAPE-Bookmark-file-1>"
+
+	statusImportDuplicates = "%d bookmarks were skipped because a page already has their URL as its source"
+)
+
+var (
+	// bookmarkTagRegex matches the tags in a bookmarks export that matter:
+	// folders (H3) and the lists of what's in them (DL), the bookmarks
+	// themselves (A), and their descriptions (DD)
+	bookmarkTagRegex = regexp.MustCompile(`(?i)<(/?)(a|dd|dl|h3)\b([^>]*)>`)
+
+	// The browser's own top-level folders aren't worth a tag
+	bookmarkRootAttrs = []string{"personal_toolbar_folder", "unfiled_bookmarks_folder"}
+)
+
+// bookmark is a single bookmark read from a browser's export
+type bookmark struct {
+	addDate     time.Time
+	description string
+	folders     []bookmarkFolder
+	title       string
+	url         string
+}
+
+// bookmarkFolder is a folder that bookmarks are kept in
+type bookmarkFolder struct {
+	addDate time.Time
+	name    string
+
+	// root is one of the browser's own folders, like the bookmarks bar
+	root bool
+}
+
+// planBookmarks works out the pages that the bookmarks in the export at
+// filePath become: one page for each bookmark, or with opts.Folder, one page
+// for everything in that folder. Bookmarks that a page already has as its
+// source are skipped
+func planBookmarks(ctx context.Context, filePath, docsDir string, opts importOptions, pageSet []*pages.Page) (*importPlan, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks, err := parseBookmarks(string(data))
+	if err != nil {
+		return nil, &src.ParseError{FilePath: filePath, Err: err}
+	}
+
+	plan := &importPlan{notes: []*importedNote{}, skipped: []string{}}
+
+	sources := map[string]bool{}
+	for _, page := range pageSet {
+		if page.Source != "" {
+			sources[strings.TrimSpace(page.Source)] = true
+		}
+	}
+
+	picked := []bookmark{}
+
+	for _, bkm := range bookmarks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case opts.Folder != "" && !bkm.inFolder(opts.Folder):
+			continue
+		case !strings.HasPrefix(bkm.url, "http://") && !strings.HasPrefix(bkm.url, "https://"):
+			plan.skipped = append(plan.skipped, fmt.Sprintf("%s (not a web page)", bkm.url))
+			continue
+		case sources[bkm.url]:
+			plan.duplicates++
+			continue
+		}
+
+		// The same URL can be bookmarked more than once
+		sources[bkm.url] = true
+
+		picked = append(picked, bkm)
+	}
+
+	opts.PageOptions.ReservedNames = append([]string{}, opts.PageOptions.ReservedNames...)
+
+	if opts.Folder != "" {
+		folder, ok := findBookmarkFolder(bookmarks, opts.Folder)
+		if !ok {
+			return nil, &src.UsageError{Err: fmt.Errorf(errBookmarksFolder, opts.Folder)}
+		}
+
+		note, err := bookmarkFolderNote(folder, picked, docsDir, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.notes = append(plan.notes, note)
+
+		return plan, nil
+	}
+
+	for _, bkm := range picked {
+		note, err := bookmarkNote(bkm, docsDir, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.PageOptions.ReservedNames = append(opts.PageOptions.ReservedNames, pageFileName(note.page))
+
+		plan.notes = append(plan.notes, note)
+	}
+
+	return plan, nil
+}
+
+// parseBookmarks reads the bookmarks out of a browser's export, in the
+// Netscape bookmarks format that they all use. It's HTML, but of a very
+// particular, unclosed, kind: each folder's H3 heading is followed by a DL
+// list of the bookmarks and folders in it
+func parseBookmarks(data string) ([]bookmark, error) {
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(data, "\ufeff"))), strings.ToUpper(bookmarksDoctype)) {
+		return nil, errors.New(errBookmarksFormat)
+	}
+
+	bookmarks := []bookmark{}
+
+	// The folders that the current list is in, from the top down
+	folders := []bookmarkFolder{}
+
+	var heading *bookmarkFolder
+	var last *bookmark
+
+	for _, loc := range bookmarkTagRegex.FindAllStringSubmatchIndex(data, -1) {
+		closing := data[loc[2]:loc[3]] == "/"
+		name := strings.ToLower(data[loc[4]:loc[5]])
+		attrs := htmlAttrs(data[loc[6]:loc[7]])
+		rest := data[loc[1]:]
+
+		switch {
+		case name == "dl" && closing:
+			if len(folders) > 0 {
+				folders = folders[:len(folders)-1]
+			}
+		case name == "dl":
+			// The list at the very top isn't in a folder
+			folder := bookmarkFolder{root: true}
+			if heading != nil {
+				folder = *heading
+			}
+
+			folders = append(folders, folder)
+			heading = nil
+		case name == "h3" && !closing:
+			heading = &bookmarkFolder{
+				addDate: bookmarkDate(attrs["add_date"]),
+				name:    bookmarkText(rest, "</h3>"),
+			}
+
+			for _, attr := range bookmarkRootAttrs {
+				if _, ok := attrs[attr]; ok {
+					heading.root = true
+				}
+			}
+		case name == "a" && !closing:
+			bookmarks = append(bookmarks, bookmark{
+				addDate: bookmarkDate(attrs["add_date"]),
+				folders: append([]bookmarkFolder{}, folders...),
+				title:   bookmarkText(rest, "</a>"),
+				url:     strings.TrimSpace(html.UnescapeString(attrs["href"])),
+			})
+
+			last = &bookmarks[len(bookmarks)-1]
+		case name == "dd" && !closing && last != nil:
+			last.description = bookmarkText(rest, "<")
+		}
+
+		if name != "a" && name != "dd" {
+			last = nil
+		}
+	}
+
+	return bookmarks, nil
+}
+
+// bookmarkNote works out the page that a single bookmark becomes. The page is
+// named after the bookmark, or its URL if it has no name, and tagged with the
+// folders it was in
+func bookmarkNote(bkm bookmark, docsDir string, opts importOptions) (*importedNote, error) {
+	note := &importedNote{lossy: []string{}, notes: []string{}, page: &pages.Page{}, source: bkm.url}
+
+	note.page.Title = bkm.title
+	if note.page.Title == "" {
+		note.page.Title = bkm.url
+		note.notes = append(note.notes, "titled with its URL")
+	}
+
+	note.page.Date = bookmarkPageDate(bkm.addDate, &note.notes)
+	note.page.Source = bkm.url
+
+	tags := opts.Tags
+	if len(tags) == 0 {
+		if tag := bookmarkFolderTag(bkm.folders); tag != "" {
+			tags = []string{tag}
+		}
+	}
+
+	body := fmt.Sprintf("# %s\n\n[%s](%s)\n", note.page.Title, bkm.url, bkm.url)
+	if bkm.description != "" {
+		body += "\n" + bkm.description + "\n"
+	}
+
+	err := placeNote(note, tags, body, docsDir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// bookmarkFolderNote works out the single page that a folder of bookmarks
+// becomes, with a list of links to them
+func bookmarkFolderNote(folder []bookmarkFolder, bookmarks []bookmark, docsDir string, opts importOptions) (*importedNote, error) {
+	last := folder[len(folder)-1]
+
+	note := &importedNote{lossy: []string{}, notes: []string{}, page: &pages.Page{}, source: last.name}
+
+	note.page.Title = last.name
+	note.page.Date = bookmarkPageDate(last.addDate, &note.notes)
+
+	tags := opts.Tags
+	if len(tags) == 0 {
+		if tag := bookmarkFolderTag(folder); tag != "" {
+			tags = []string{tag}
+		}
+	}
+
+	body := fmt.Sprintf("# %s\n\n", note.page.Title)
+
+	for _, bkm := range bookmarks {
+		title := bkm.title
+		if title == "" {
+			title = bkm.url
+		}
+
+		body += fmt.Sprintf("* [%s](%s)", title, bkm.url)
+		if bkm.description != "" {
+			body += " - " + bkm.description
+		}
+
+		body += "\n"
+	}
+
+	note.notes = append(note.notes, fmt.Sprintf("%d bookmarks", len(bookmarks)))
+
+	// A page only has the one source, so none of them are the page's
+	note.lossy = append(note.lossy, "the page has no source, because its links all have their own")
+
+	err := placeNote(note, tags, body, docsDir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// inFolder returns true if the bookmark is in the named folder, or in a
+// folder inside it
+func (bkm bookmark) inFolder(name string) bool {
+	for _, folder := range bkm.folders {
+		if strings.EqualFold(folder.name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findBookmarkFolder returns the first folder with the name, along with the
+// folders it's in, from the top down. Only folders with bookmarks somewhere
+// in them can be found
+func findBookmarkFolder(bookmarks []bookmark, name string) ([]bookmarkFolder, bool) {
+	for _, bkm := range bookmarks {
+		for i, folder := range bkm.folders {
+			if strings.EqualFold(folder.name, name) {
+				return bkm.folders[:i+1], true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// bookmarkFolderTag returns the tag for bookmarks in the folders: the
+// folders' names, as a hierarchical tag, without the browser's own folders
+func bookmarkFolderTag(folders []bookmarkFolder) string {
+	names := []string{}
+
+	for _, folder := range folders {
+		if folder.root || folder.name == "" {
+			continue
+		}
+
+		// A slash in a folder's name would start a new level of the tag
+		names = append(names, strings.TrimSpace(strings.ReplaceAll(folder.name, pages.TagSeparator, "-")))
+	}
+
+	return strings.Join(names, pages.TagSeparator)
+}
+
+// bookmarkPageDate returns the date for the page, in RFC3339: when it was
+// bookmarked, or else now
+func bookmarkPageDate(addDate time.Time, notes *[]string) string {
+	if addDate.IsZero() {
+		*notes = append(*notes, "dated now, because it has no date")
+		return pages.Now().Format(time.RFC3339)
+	}
+
+	*notes = append(*notes, "date from when it was bookmarked")
+
+	return addDate.Format(time.RFC3339)
+}
+
+// bookmarkDate turns an ADD_DATE, in seconds since 1970, into a time. Some
+// browsers write it in milliseconds or microseconds instead
+func bookmarkDate(raw string) time.Time {
+	secs, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || secs <= 0 {
+		return time.Time{}
+	}
+
+	// Seconds won't reach a hundred billion for a few thousand years yet
+	for secs > 1e11 {
+		secs /= 1000
+	}
+
+	return time.Unix(secs, 0)
+}
+
+// bookmarkText returns the text that follows a tag, up to the end, with its
+// HTML entities turned into the characters they stand for
+func bookmarkText(rest, end string) string {
+	if i := strings.Index(strings.ToLower(rest), end); i >= 0 {
+		rest = rest[:i]
+	}
+
+	return cleanHTMLText(rest)
+}
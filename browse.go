@@ -0,0 +1,422 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	browseHelp = "↑/↓ move  enter open  / filter  t tag  n new  esc clear  q quit"
+
+	reverseVideo = "\033[7m%s\033[0m"
+)
+
+// browseMode is what the keys typed into the browser do
+type browseMode int
+
+const (
+	browseList      browseMode = iota // Moving around the list of pages
+	browseFilter                      // Typing a filter on titles and tags
+	browseTagFilter                   // Typing a filter on tags only
+	browseNewTitle                    // Typing the title of a new page
+)
+
+// browseAction is what the browser was left to do when it closed
+type browseAction int
+
+const (
+	browseNone browseAction = iota
+	browseOpen
+	browseNew
+	browseQuit
+)
+
+// browser is the state of til browse. It only changes through update, which
+// makes it easy to drive with made-up key presses in tests; drawing it on
+// the terminal is left to view
+type browser struct {
+	action    browseAction
+	cursor    int
+	filter    string
+	input     string
+	mode      browseMode
+	pageSet   []*pages.Page
+	tagFilter string
+}
+
+// runBrowse lets the pages be browsed, filtered, and opened in the terminal.
+// Example:
+//
+//	> til browse
+func runBrowse(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("browse", flag.ContinueOnError)
+	parseFlags(flags, args)
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	restore, err := rawTerminal()
+	if err != nil {
+		src.Defeat(&src.UsageError{Err: err})
+	}
+
+	brw := newBrowser(pageSet)
+	reader := bufio.NewReader(os.Stdin)
+
+	for brw.action == browseNone {
+		writeScreen(os.Stdout, brw.view(terminalSize()))
+
+		k, err := readKey(reader)
+		if err != nil {
+			restore()
+			src.Defeat(err)
+		}
+
+		brw.update(k)
+	}
+
+	restore()
+
+	switch brw.action {
+	case browseOpen:
+		err = brw.selected().Open(defaultEditorFor(runtime.GOOS))
+	case browseNew:
+		err = createNewPage(ctx, brw.input, pages.PageOptions{})
+	}
+
+	if err != nil {
+		src.Defeat(err)
+	}
+}
+
+// newBrowser returns a browser over the content pages, which are expected to
+// be newest first
+func newBrowser(pageSet []*pages.Page) *browser {
+	brw := &browser{pageSet: []*pages.Page{}}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() {
+			brw.pageSet = append(brw.pageSet, page)
+		}
+	}
+
+	return brw
+}
+
+// update changes the browser's state for a key press
+func (brw *browser) update(k key) {
+	if k.kind == keyCtrlC {
+		brw.action = browseQuit
+		return
+	}
+
+	switch brw.mode {
+	case browseFilter:
+		brw.filter = brw.edit(brw.filter, k)
+	case browseTagFilter:
+		brw.tagFilter = brw.edit(brw.tagFilter, k)
+	case browseNewTitle:
+		brw.updateNewTitle(k)
+	default:
+		brw.updateList(k)
+	}
+
+	// Filtering can leave the cursor past the end of the matches
+	if count := len(brw.matches()); brw.cursor >= count {
+		brw.cursor = count - 1
+	}
+
+	if brw.cursor < 0 {
+		brw.cursor = 0
+	}
+}
+
+// matches returns the pages that pass the filters
+func (brw *browser) matches() []*pages.Page {
+	filter := strings.ToLower(brw.filter)
+	tagFilter := strings.ToLower(brw.tagFilter)
+
+	matches := []*pages.Page{}
+
+	for _, page := range brw.pageSet {
+		tagNames := strings.ToLower(strings.Join(jsonPage(page).Tags, "\n"))
+
+		if filter != "" && !strings.Contains(strings.ToLower(page.Title), filter) && !strings.Contains(tagNames, filter) {
+			continue
+		}
+
+		if tagFilter != "" && !strings.Contains(tagNames, tagFilter) {
+			continue
+		}
+
+		matches = append(matches, page)
+	}
+
+	return matches
+}
+
+// selected returns the page under the cursor, or nil if nothing matches
+func (brw *browser) selected() *pages.Page {
+	matches := brw.matches()
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return matches[brw.cursor]
+}
+
+// view returns the lines to draw the browser with: a header, the list of
+// matching pages, and a preview of the selected page below them
+func (brw *browser) view(height, width int) []string {
+	matches := brw.matches()
+
+	lines := []string{
+		truncateLine(fmt.Sprintf("til browse: %d of %d pages%s", len(matches), len(brw.pageSet), brw.filterSummary()), width),
+		truncateLine(brw.prompt(), width),
+		"",
+	}
+
+	// The list gets the top half of what's left, and the preview the rest
+	listHeight := (height - len(lines) - 1) / 2
+	if listHeight < 1 {
+		listHeight = 1
+	}
+
+	first := 0
+	if brw.cursor >= listHeight {
+		first = brw.cursor - listHeight + 1
+	}
+
+	for i := first; i < len(matches) && i < first+listHeight; i++ {
+		line := truncateLine(fmt.Sprintf("  %s  %s%s", matches[i].PrettyDate(), matches[i].Title, tagList(matches[i])), width)
+
+		if i == brw.cursor {
+			line = fmt.Sprintf(reverseVideo, line)
+		}
+
+		lines = append(lines, line)
+	}
+
+	for len(lines) < listHeight+3 {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, strings.Repeat("─", width))
+
+	if page := brw.selected(); page != nil {
+		preview := renderMarkdown(page.Content, width)
+
+		for i := 0; i < len(preview) && len(lines) < height; i++ {
+			lines = append(lines, preview[i])
+		}
+	}
+
+	return lines
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// edit types the key into one of the filters, going back to the list when
+// it's done with
+func (brw *browser) edit(text string, k key) string {
+	switch k.kind {
+	case keyRune:
+		return text + string(k.r)
+	case keyBackspace:
+		return dropLastRune(text)
+	case keyEnter:
+		brw.mode = browseList
+	case keyEsc:
+		brw.mode = browseList
+		return ""
+	}
+
+	return text
+}
+
+func (brw *browser) updateList(k key) {
+	switch k.kind {
+	case keyUp:
+		brw.cursor--
+	case keyDown:
+		brw.cursor++
+	case keyEnter:
+		if brw.selected() != nil {
+			brw.action = browseOpen
+		}
+	case keyEsc:
+		brw.filter = ""
+		brw.tagFilter = ""
+	case keyRune:
+		switch k.r {
+		case '/':
+			brw.mode = browseFilter
+		case 't':
+			brw.mode = browseTagFilter
+		case 'n':
+			brw.input = ""
+			brw.mode = browseNewTitle
+		case 'q':
+			brw.action = browseQuit
+		}
+	}
+}
+
+func (brw *browser) updateNewTitle(k key) {
+	switch k.kind {
+	case keyRune:
+		brw.input += string(k.r)
+	case keyBackspace:
+		brw.input = dropLastRune(brw.input)
+	case keyEnter:
+		if strings.TrimSpace(brw.input) != "" {
+			brw.action = browseNew
+		}
+	case keyEsc:
+		brw.input = ""
+		brw.mode = browseList
+	}
+}
+
+// filterSummary describes the filters in use, for the header
+func (brw *browser) filterSummary() string {
+	summary := ""
+
+	if brw.filter != "" {
+		summary += fmt.Sprintf(", matching '%s'", brw.filter)
+	}
+
+	if brw.tagFilter != "" {
+		summary += fmt.Sprintf(", tagged '%s'", brw.tagFilter)
+	}
+
+	return summary
+}
+
+// prompt is the line under the header: what's being typed, or the keys
+func (brw *browser) prompt() string {
+	switch brw.mode {
+	case browseFilter:
+		return "/" + brw.filter
+	case browseTagFilter:
+		return "tag: " + brw.tagFilter
+	case browseNewTitle:
+		return "new page title: " + brw.input
+	}
+
+	return browseHelp
+}
+
+// tagList returns the page's tags, like " [go, cli]", or nothing if it has none
+func tagList(page *pages.Page) string {
+	tagNames := jsonPage(page).Tags
+	if len(tagNames) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("  [%s]", strings.Join(tagNames, ", "))
+}
+
+// renderMarkdown turns the Markdown into lines of styled text for the
+// terminal, wrapped to the width. Headings are bold, code is coloured, and
+// list items get bullets
+func renderMarkdown(content string, width int) []string {
+	lines := []string{}
+	inCode := false
+
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+			continue
+		}
+
+		switch {
+		case inCode:
+			lines = append(lines, src.Blue(truncateLine("    "+line, width)))
+		case strings.HasPrefix(line, "#"):
+			lines = append(lines, src.Bold(truncateLine(strings.TrimSpace(strings.TrimLeft(line, "#")), width)))
+		case strings.HasPrefix(line, "* "), strings.HasPrefix(line, "- "):
+			for i, wrapped := range wrapLine(line[2:], width-2) {
+				prefix := "  "
+				if i == 0 {
+					prefix = "• "
+				}
+
+				lines = append(lines, prefix+styleInline(wrapped))
+			}
+		default:
+			for _, wrapped := range wrapLine(line, width) {
+				lines = append(lines, styleInline(wrapped))
+			}
+		}
+	}
+
+	return lines
+}
+
+// styleInline colours the `code` in a line of text
+func styleInline(line string) string {
+	parts := strings.Split(line, "`")
+	if len(parts) < 3 {
+		return line
+	}
+
+	for i := 1; i < len(parts)-1; i += 2 {
+		parts[i] = src.Yellow(parts[i])
+	}
+
+	return strings.Join(parts, "")
+}
+
+// wrapLine breaks the line into lines no wider than width, between words
+func wrapLine(line string, width int) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	lines := []string{}
+	current := ""
+
+	for _, word := range words {
+		if current != "" && utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, current)
+			current = ""
+		}
+
+		if current != "" {
+			current += " "
+		}
+
+		current += word
+	}
+
+	return append(lines, current)
+}
+
+// truncateLine cuts the line down to width characters
+func truncateLine(line string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(line) <= width {
+		return line
+	}
+
+	return string([]rune(line)[:width])
+}
+
+func dropLastRune(text string) string {
+	_, size := utf8.DecodeLastRuneInString(text)
+	return text[:len(text)-size]
+}
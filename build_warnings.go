@@ -0,0 +1,246 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	statusBuildWarnings   = "%d warnings: %s"
+	statusEmptyPage       = "%s has nothing in it but its title"
+	statusReservedTagPage = "skipping the tag page for '%s': til generates a page with that name. Please rename the tag"
+	statusStaleTagPage    = "%s is a tag page for a tag that no page has any more, so it can be removed"
+	statusStaleTagPruned  = "removed %s, because its tag has fewer pages than minTagCount"
+	statusUntaggedPage    = "%s has no tags, so it isn't on any tag page"
+)
+
+// warningKind is a kind of problem that a build notices, but carries on past
+type warningKind string
+
+const (
+	warningBrokenLink     warningKind = "broken-link"
+	warningDuplicateTitle warningKind = "duplicate-title"
+	warningEmptyPage      warningKind = "empty-page"
+	warningParseSkipped   warningKind = "parse-skipped"
+	warningReservedTag    warningKind = "reserved-tag"
+	warningStaleTagPage   warningKind = "stale-tag-page"
+	warningTagCollision   warningKind = "tag-collision"
+	warningUntagged       warningKind = "untagged"
+)
+
+// warningKinds are the kinds of warning in the order the summary lists them,
+// with how the summary counts them, one and many
+var warningKinds = []struct {
+	kind     warningKind
+	singular string
+	plural   string
+}{
+	{warningParseSkipped, "page that couldn't be parsed", "pages that couldn't be parsed"},
+	{warningEmptyPage, "empty page", "empty pages"},
+	{warningUntagged, "untagged page", "untagged pages"},
+	{warningDuplicateTitle, "duplicate title", "duplicate titles"},
+	{warningReservedTag, "reserved tag", "reserved tags"},
+	{warningTagCollision, "tag page collision", "tag page collisions"},
+	{warningBrokenLink, "broken link", "broken links"},
+	{warningStaleTagPage, "stale tag page", "stale tag pages"},
+}
+
+// buildWarning is one problem that a build noticed
+type buildWarning struct {
+	kind    warningKind
+	message string
+}
+
+// buildWarnings collects the problems that a build notices as it goes, so
+// that they can be summed up once it's done, or written out as JSON. The
+// build steps are given it to add to, rather than writing warnings out
+// themselves
+type buildWarnings struct {
+	warnings []buildWarning
+}
+
+// newBuildWarnings returns an empty buildWarnings
+func newBuildWarnings() *buildWarnings {
+	return &buildWarnings{warnings: []buildWarning{}}
+}
+
+// add adds a warning of the kind
+func (bw *buildWarnings) add(kind warningKind, message string) {
+	bw.warnings = append(bw.warnings, buildWarning{kind: kind, message: message})
+}
+
+// len returns the number of warnings, of every kind
+func (bw *buildWarnings) len() int {
+	return len(bw.warnings)
+}
+
+// count returns the number of warnings of the kind
+func (bw *buildWarnings) count(kind warningKind) int {
+	count := 0
+
+	for _, warning := range bw.warnings {
+		if warning.kind == kind {
+			count++
+		}
+	}
+
+	return count
+}
+
+// messages returns the messages of the warnings of the kind, in the order
+// they were added
+func (bw *buildWarnings) messages(kind warningKind) []string {
+	messages := []string{}
+
+	for _, warning := range bw.warnings {
+		if warning.kind == kind {
+			messages = append(messages, warning.message)
+		}
+	}
+
+	return messages
+}
+
+// summary returns the line that sums up the warnings, with the number of
+// each kind, like "3 warnings: 1 empty page, 2 broken links". It's empty
+// when there aren't any
+func (bw *buildWarnings) summary() string {
+	if bw.len() == 0 {
+		return ""
+	}
+
+	counts := []string{}
+
+	for _, kind := range warningKinds {
+		switch n := bw.count(kind.kind); n {
+		case 0:
+		case 1:
+			counts = append(counts, "1 "+kind.singular)
+		default:
+			counts = append(counts, fmt.Sprintf("%d %s", n, kind.plural))
+		}
+	}
+
+	return fmt.Sprintf(statusBuildWarnings, bw.len(), strings.Join(counts, ", "))
+}
+
+// report writes out the warnings, a kind at a time, and then the summary
+func (bw *buildWarnings) report() {
+	for _, kind := range warningKinds {
+		for _, message := range bw.messages(kind.kind) {
+			src.Warn(message)
+		}
+	}
+
+	if summary := bw.summary(); summary != "" {
+		src.Info(summary)
+	}
+}
+
+// json returns the warnings as they're written out by -json
+func (bw *buildWarnings) json() src.JSONBuild {
+	build := src.JSONBuild{Version: src.JSONVersion, Counts: map[string]int{}, Warnings: []src.JSONBuildWarning{}}
+
+	for _, kind := range warningKinds {
+		build.Counts[string(kind.kind)] = bw.count(kind.kind)
+
+		for _, message := range bw.messages(kind.kind) {
+			build.Warnings = append(build.Warnings, src.JSONBuildWarning{Kind: string(kind.kind), Message: message})
+		}
+	}
+
+	return build
+}
+
+// checkPages adds a warning for each content page that's empty, has no
+// tags, or has the same title as another
+func checkPages(pageSet []*pages.Page, warnings *buildWarnings) {
+	for _, page := range listedPages(pageSet, "") {
+		if isEmptyPage(page) {
+			warnings.add(warningEmptyPage, fmt.Sprintf(statusEmptyPage, page.FilePath))
+		}
+	}
+
+	for _, page := range untaggedPages(pageSet) {
+		warnings.add(warningUntagged, fmt.Sprintf(statusUntaggedPage, page.FilePath))
+	}
+
+	for _, warning := range duplicateTitleWarnings(pageSet) {
+		warnings.add(warningDuplicateTitle, warning)
+	}
+}
+
+// isEmptyPage returns true if there's nothing in the page but its headings
+// and whitespace, like a page that was created and never written
+func isEmptyPage(page *pages.Page) bool {
+	for _, line := range strings.Split(page.Content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkStaleTagPages adds a warning for each tag page in the docs directory
+// whose tag no page has any more. They're told apart from the pages that
+// til generates under a fixed name, and the tag pages of the tags that are
+// still used, by their GeneratedMarker and their path
+func checkStaleTagPages(pageSet []*pages.Page, tagMap *pages.TagMap, tDir string, warnings *buildWarnings) {
+	current := map[string]bool{}
+
+	for _, name := range generatedPageNames(pageSet) {
+		current[filepath.Join(tDir, fmt.Sprintf("%s.%s", name, pages.FileExtension))] = true
+	}
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		current[filepath.Join(tDir, filepath.FromSlash(tagMap.Get(tagName)[0].PagePath()))] = true
+	}
+
+	for _, filePath := range tagPageFilePaths(tDir) {
+		if current[filePath] || !isGeneratedFile(filePath) {
+			continue
+		}
+
+		warnings.add(warningStaleTagPage, fmt.Sprintf(statusStaleTagPage, filePath))
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// tagPageFilePaths returns the paths of the files in the docs directory that
+// could be tag pages: the Markdown files at the top, and the ones anywhere
+// under the directory that child tag pages are written into
+func tagPageFilePaths(tDir string) []string {
+	filePaths, _ := fileSystem.Glob(filepath.Join(tDir, "*."+pages.FileExtension))
+
+	pattern := filepath.Join(tDir, "tags", "*")
+
+	for {
+		matches, err := fileSystem.Glob(pattern)
+		if err != nil || len(matches) == 0 {
+			return filePaths
+		}
+
+		for _, match := range matches {
+			if filepath.Ext(match) == "."+pages.FileExtension {
+				filePaths = append(filePaths, match)
+			}
+		}
+
+		pattern = filepath.Join(pattern, "*")
+	}
+}
+
+// isGeneratedFile returns true if the file starts with the GeneratedMarker
+func isGeneratedFile(filePath string) bool {
+	data, err := fileSystem.ReadFile(filePath)
+
+	return err == nil && strings.HasPrefix(string(data), pages.GeneratedMarker)
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// The number of commits the changelog covers, unless changelogDays is set
+	defaultChangelogCommits = 20
+
+	// Marks the start of each commit in the git log output
+	changelogCommitMarker = "@@commit "
+
+	changelogNoGit     = "_The changelog is made from the git history, and the target directory isn't a git repo yet._"
+	changelogNoChanges = "_Nothing has changed recently._"
+
+	statusChangelogBuild = "building changelog page"
+)
+
+// The kinds of change the changelog lists, strongest first. When a page
+// changes more than once on the same day only the strongest change is listed,
+// so a page that was added and then edited that day shows up as added
+const (
+	changeAdded = iota
+	changeRenamed
+	changeModified
+	changeRemoved
+)
+
+// changelogCommit is a single commit from the git log, and the files it changed
+type changelogCommit struct {
+	hash    string
+	date    time.Time
+	changes []fileChange
+}
+
+// fileChange is a single file changed by a commit. oldPath is only set for a
+// rename
+type fileChange struct {
+	kind    int
+	path    string
+	oldPath string
+}
+
+// buildChangelogPage creates the changelog.md page, which lists the content
+// pages that were added, changed, renamed, or removed recently, by day
+func buildChangelogPage(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, docsDir string, warnings *buildWarnings) error {
+	src.Info(statusChangelogBuild)
+
+	content := "## What's New\n\n"
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		return err
+	}
+
+	if isGitRepo(tDir) {
+		commits, err := changelogCommits(tDir)
+		if err != nil {
+			return err
+		}
+
+		content += changelogContent(commits, pageSet, func(commit changelogCommit, filePath string) bool {
+			return wasContentPage(tDir, commit, filePath)
+		})
+	} else {
+		content += changelogNoGit + "\n"
+	}
+
+	content += "\n"
+	content += siteFooter(pageSet)
+
+	filePath := filepath.Join(docsDir, fmt.Sprintf("changelog.%s", pages.FileExtension))
+
+	err = fileSystem.WriteFile(filePath, []byte(pages.MarkGenerated(content)), 0644)
+	if err != nil {
+		return err
+	}
+
+	src.Progress(filePath)
+
+	return nil
+}
+
+// changelogCommits returns the recent commits that touched the docs
+// directory: the last changelogDays days of them if that is set, otherwise
+// the last changelogCommits
+func changelogCommits(tDir string) ([]changelogCommit, error) {
+	args := []string{"log", "--name-status", "--relative", "--format=" + changelogCommitMarker + "%H %aI"}
+
+	if days := src.GlobalConfig.UInt("changelogDays", 0); days > 0 {
+		args = append(args, fmt.Sprintf("--since=%d.days", days))
+	} else {
+		args = append(args, fmt.Sprintf("--max-count=%d", src.GlobalConfig.UInt("changelogCommits", defaultChangelogCommits)))
+	}
+
+	out, err := runCommand(tDir, "git", append(args, "--", "docs")...)
+	if err != nil {
+		return []changelogCommit{}, fmt.Errorf("git log: %s", strings.TrimSpace(string(out)))
+	}
+
+	return parseChangelogLog(string(out)), nil
+}
+
+// parseChangelogLog reads the output of git log --name-status, as run by
+// changelogCommits
+func parseChangelogLog(out string) []changelogCommit {
+	commits := []changelogCommit{}
+
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if strings.HasPrefix(line, changelogCommitMarker) {
+			fields := strings.Fields(strings.TrimPrefix(line, changelogCommitMarker))
+			if len(fields) != 2 {
+				continue
+			}
+
+			date, err := time.Parse(time.RFC3339, fields[1])
+			if err != nil {
+				continue
+			}
+
+			commits = append(commits, changelogCommit{hash: fields[0], date: date})
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(commits) == 0 || len(fields) < 2 || fields[0] == "" {
+			continue
+		}
+
+		change := fileChange{path: fields[len(fields)-1]}
+
+		switch fields[0][0] {
+		case 'A', 'C':
+			change.kind = changeAdded
+		case 'D':
+			change.kind = changeRemoved
+		case 'R':
+			change.kind = changeRenamed
+			change.oldPath = fields[1]
+		default:
+			change.kind = changeModified
+		}
+
+		last := &commits[len(commits)-1]
+		last.changes = append(last.changes, change)
+	}
+
+	return commits
+}
+
+// changelogContent lists the changes to content pages, grouped by day, newest
+// first. isContent decides whether a file that no longer exists was a content
+// page when the commit removed it
+func changelogContent(commits []changelogCommit, pageSet []*pages.Page, isContent func(commit changelogCommit, filePath string) bool) string {
+	byName := map[string]*pages.Page{}
+	for _, page := range pageSet {
+		if page.IsContentPage() {
+			byName[page.LinkPath()] = page
+		}
+	}
+
+	days := []string{}
+	names := map[string][]string{}
+	changes := map[string]map[string]fileChange{}
+
+	for _, commit := range commits {
+		day := pages.FormatDate(commit.date)
+		if _, ok := changes[day]; !ok {
+			days = append(days, day)
+			changes[day] = map[string]fileChange{}
+		}
+
+		for _, change := range commit.changes {
+			name, ok := changelogFileName(change.path)
+			if !ok {
+				continue
+			}
+
+			if change.kind == changeRemoved && !isContent(commit, change.path) {
+				continue
+			}
+
+			if change.kind != changeRemoved && byName[name] == nil {
+				continue
+			}
+
+			existing, seen := changes[day][name]
+			if !seen {
+				names[day] = append(names[day], name)
+			}
+
+			if !seen || change.kind < existing.kind {
+				changes[day][name] = change
+			}
+		}
+	}
+
+	content := ""
+
+	for _, day := range days {
+		if len(names[day]) == 0 {
+			continue
+		}
+
+		content += fmt.Sprintf("### %s\n\n", day)
+
+		for _, name := range names[day] {
+			content += changelogEntry(changes[day][name], name, byName[name]) + "\n"
+		}
+
+		content += "\n"
+	}
+
+	if content == "" {
+		return changelogNoChanges + "\n"
+	}
+
+	return strings.TrimSuffix(content, "\n")
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// changelogFileName returns the path of a file relative to the docs
+// directory, given its path in the repo. Only Markdown files directly in the
+// docs directory, or in its year directories, can be content pages
+func changelogFileName(filePath string) (string, bool) {
+	dir, name := path.Split(filePath)
+
+	if path.Ext(name) != "."+pages.FileExtension {
+		return "", false
+	}
+
+	if dir == "docs/" {
+		return name, true
+	}
+
+	if year := strings.TrimSuffix(strings.TrimPrefix(dir, "docs/"), "/"); strings.HasPrefix(dir, "docs/") && pages.IsYearDir(year) {
+		return year + "/" + name, true
+	}
+
+	return "", false
+}
+
+// changelogEntry writes a single line of the changelog
+func changelogEntry(change fileChange, name string, page *pages.Page) string {
+	switch change.kind {
+	case changeAdded:
+		return fmt.Sprintf("* Added [%s](%s)", page.Title, name)
+	case changeRenamed:
+		return fmt.Sprintf("* Renamed [%s](%s) (was %s)", page.Title, name, path.Base(change.oldPath))
+	case changeRemoved:
+		return fmt.Sprintf("* Removed %s", name)
+	}
+
+	return fmt.Sprintf("* Updated [%s](%s)", page.Title, name)
+}
+
+// wasContentPage returns true if a file that a commit removed was a content
+// page, rather than a generated one, which is told by it having front-matter
+// just before the commit
+func wasContentPage(tDir string, commit changelogCommit, filePath string) bool {
+	out, err := runCommand(tDir, "git", "show", commit.hash+"^:./"+filePath)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(string(out), "---\n")
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	errClipboardBinary = "the clipboard has something other than text on it"
+	errClipboardEmpty  = "the clipboard is empty"
+	errNoClipboard     = "no clipboard tool was found (pbcopy, wl-copy, xclip, or xsel)"
+	errNoClipboardRead = "no clipboard tool was found (pbpaste, wl-paste, xclip, or xsel)"
+)
+
+// lookPath finds an executable on the PATH. Tests swap it out to pretend
+// that different clipboard tools are installed
+var lookPath = exec.LookPath
+
+// clipboardReader reads what's on the clipboard
+type clipboardReader interface {
+	Read() ([]byte, error)
+}
+
+// systemClipboard reads the clipboard with whichever clipboard tool is
+// installed
+type systemClipboard struct{}
+
+// clipboard is the clipboard that new pages are filled from. Tests swap in
+// a fake one
+var clipboard clipboardReader = systemClipboard{}
+
+// clipboardTool is a tool that can put text on the clipboard, and read it
+// back off
+type clipboardTool struct {
+	copy  []string
+	paste []string
+}
+
+// clipboardCommand returns the command that puts its input on the system
+// clipboard, picking the first tool that is installed. It returns nil if
+// there isn't one
+func clipboardCommand() []string {
+	for _, tool := range clipboardTools() {
+		if _, err := lookPath(tool.copy[0]); err == nil {
+			return tool.copy
+		}
+	}
+
+	return nil
+}
+
+// clipboardPasteCommand returns the command that writes out what's on the
+// system clipboard, picking the first tool that is installed. It returns nil
+// if there isn't one
+func clipboardPasteCommand() []string {
+	for _, tool := range clipboardTools() {
+		if _, err := lookPath(tool.paste[0]); err == nil {
+			return tool.paste
+		}
+	}
+
+	return nil
+}
+
+// copyToClipboard puts the text on the system clipboard
+func copyToClipboard(text string) error {
+	command := clipboardCommand()
+	if command == nil {
+		return errors.New(errNoClipboard)
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+
+	return cmd.Run()
+}
+
+// Read returns what's on the system clipboard
+func (systemClipboard) Read() ([]byte, error) {
+	command := clipboardPasteCommand()
+	if command == nil {
+		return nil, errors.New(errNoClipboardRead)
+	}
+
+	return exec.Command(command[0], command[1:]...).Output()
+}
+
+// clipboardText returns the text on the clipboard, ready to go into a page:
+// with Unix line endings, and a single newline at the end. Anything that
+// isn't text, or is only whitespace, is an error rather than a page full of
+// garbage
+func clipboardText(reader clipboardReader) (string, error) {
+	data, err := reader.Read()
+	if err != nil {
+		return "", err
+	}
+
+	if !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0 {
+		return "", errors.New(errClipboardBinary)
+	}
+
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.TrimRight(text, " \t\n")
+
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New(errClipboardEmpty)
+	}
+
+	return text + "\n", nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// clipboardTools returns the clipboard tools to look for, best first
+func clipboardTools() []clipboardTool {
+	tools := []clipboardTool{}
+
+	switch runtime.GOOS {
+	case "darwin":
+		tools = append(tools, clipboardTool{copy: []string{"pbcopy"}, paste: []string{"pbpaste"}})
+	case "windows":
+		tools = append(tools, clipboardTool{
+			copy:  []string{"clip"},
+			paste: []string{"powershell", "-NoProfile", "-Command", "Get-Clipboard -Raw"},
+		})
+	}
+
+	// Wayland's tool only works under Wayland, while xclip and xsel might
+	// be installed either way
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		tools = append(tools, clipboardTool{copy: []string{"wl-copy"}, paste: []string{"wl-paste", "--no-newline"}})
+	}
+
+	return append(
+		tools,
+		clipboardTool{copy: []string{"xclip", "-selection", "clipboard"}, paste: []string{"xclip", "-selection", "clipboard", "-out"}},
+		clipboardTool{copy: []string{"xsel", "--clipboard", "--input"}, paste: []string{"xsel", "--clipboard", "--output"}},
+	)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	errLangInvalid = "-lang '%s' can't have whitespace or backticks in it, because it goes on the opening line of the code block"
+	errStdinBinary = "what's on stdin isn't text, so it can't go in a page"
+	errStdinEmpty  = "there's nothing on stdin to go in the page"
+)
+
+// validateLang checks that the language can be written after the ``` of a
+// code block. It's otherwise written as given, since Markdown renderers all
+// have their own names for languages
+func validateLang(lang string) error {
+	if strings.ContainsRune(lang, '`') || strings.IndexFunc(lang, unicode.IsSpace) >= 0 {
+		return fmt.Errorf(errLangInvalid, lang)
+	}
+
+	return nil
+}
+
+// withLangTag returns the tags with the language added to the end, unless
+// it's already one of them
+func withLangTag(tags []string, lang string) []string {
+	for _, tag := range tags {
+		if strings.EqualFold(tag, lang) {
+			return tags
+		}
+	}
+
+	return append(tags, lang)
+}
+
+// codeBlock returns a code block fenced for the language, with the code in
+// it, or an empty line to write the code on. The fence is longer than any
+// run of backticks in the code, so that code with code blocks of its own
+// doesn't end it early
+func codeBlock(lang, code string) string {
+	if code == "" {
+		code = "\n"
+	}
+
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+
+	return fmt.Sprintf("%s%s\n%s%s\n", fence, lang, code, fence)
+}
+
+// isBlankBody returns true if a new page's body has nothing in it, apart
+// from an empty code block for the language
+func isBlankBody(body, lang string) bool {
+	return body == "" || (lang != "" && body == codeBlock(lang, ""))
+}
+
+// withCodeBlock adds a code block for the language, with the code in it, to
+// a new page's body. It returns the body, and the line of the body that the
+// code starts on, counting from 1, for the editor's cursor
+func withCodeBlock(body, lang, code string) (string, int) {
+	line := 2
+	if body != "" {
+		line += strings.Count(body, "\n") + 1
+	}
+
+	return joinBody(body, codeBlock(lang, code)), line
+}
+
+// stdinText returns what's piped into til, ready to go into a page: with
+// Unix line endings, and a single newline at the end. Anything that isn't
+// text, or is only whitespace, is an error rather than a page full of garbage
+func stdinText(in io.Reader) (string, error) {
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+
+	if !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0 {
+		return "", errors.New(errStdinBinary)
+	}
+
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.TrimRight(text, " \t\n")
+
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New(errStdinEmpty)
+	}
+
+	return text + "\n", nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+// commands maps the name of a sub-command (til <command> [flags]) to the
+// function that runs it. Each command receives the arguments that follow
+// its name, and parses its own flags from them
+var commands = map[string]func(ctx context.Context, args []string){
+	"archive":    runArchive,
+	"browse":     runBrowse,
+	"dupes":      runDupes,
+	"export":     runExport,
+	"history":    runHistory,
+	"import":     runImport,
+	"list":       runList,
+	"migrate":    runMigrate,
+	"notify":     runNotify,
+	"onthisday":  runOnThisDay,
+	"publish":    runPublish,
+	"restore":    runRestore,
+	"review":     runReview,
+	"search":     runSearch,
+	"serve":      runServe,
+	"show":       runShow,
+	"spellcheck": runSpellcheck,
+	"stats":      runStats,
+	"tag":        runTag,
+	"tags":       runTags,
+	"unarchive":  runUnarchive,
+	"untagged":   runUntagged,
+	"validate":   runValidate,
+}
+
+// parseInterspersed parses flags that are given either before or after the
+// positional arguments (til tag suggest my-page --apply), which the flag
+// package doesn't allow on its own. It returns the positional arguments
+func parseInterspersed(flags *flag.FlagSet, args []string) []string {
+	positional := []string{}
+
+	for {
+		parseFlags(flags, args)
+
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseFlags parses the flags in args. The flag sets are created with
+// flag.ContinueOnError, because the flag package would otherwise exit with
+// the same code that til uses for IO errors. The flag package has already
+// written out the problem and the usage, so this only needs to exit
+func parseFlags(flags *flag.FlagSet, args []string) {
+	err := flags.Parse(args)
+
+	switch {
+	case err == flag.ErrHelp:
+		os.Exit(0)
+	case err != nil:
+		os.Exit(src.ExitUsage)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errDocsDirFile       = "%s is a file, but it needs to be the directory the pages go in. Move it out of the way, or point targetDirectories somewhere else"
+	errDocsDirMissing    = "the docs directory %s doesn't exist yet. Create it, or run til again with -create-dir to have til create it"
+	errDocsDirPermission = "til doesn't have permission to write to the docs directory %s"
+
+	statusDocsDirCreated = "created the docs directory %s"
+)
+
+// docsDirError is a problem with the docs directory that stops til from
+// writing to it. Its message says what's wrong, so it doesn't repeat the
+// underlying error, but keeps it so that til exits with the right code
+type docsDirError struct {
+	msg string
+	err error
+}
+
+func (e *docsDirError) Error() string {
+	return e.msg
+}
+
+func (e *docsDirError) Unwrap() error {
+	return e.err
+}
+
+// checkDocsDir makes sure that the docs directory is there to write to
+// before anything's written. With create, a missing docs directory is
+// created, along with the target directory if need be
+func checkDocsDir(docsDir string, create bool) error {
+	info, err := os.Stat(docsDir)
+
+	if os.IsNotExist(err) && create {
+		err = os.MkdirAll(docsDir, 0755)
+		if err != nil {
+			return newDocsDirError(docsDir, err)
+		}
+
+		src.Progress(fmt.Sprintf(statusDocsDirCreated, docsDir))
+
+		return nil
+	}
+
+	if err != nil {
+		return newDocsDirError(docsDir, err)
+	}
+
+	if !info.IsDir() {
+		return &docsDirError{msg: fmt.Sprintf(errDocsDirFile, docsDir)}
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newDocsDirError returns the error for the docs directory that says what
+// went wrong in err, if it's something til can explain, or err itself
+func newDocsDirError(docsDir string, err error) error {
+	switch {
+	case os.IsNotExist(err):
+		return &docsDirError{msg: fmt.Sprintf(errDocsDirMissing, docsDir), err: err}
+	case os.IsPermission(err):
+		return &docsDirError{msg: fmt.Sprintf(errDocsDirPermission, docsDir), err: err}
+	}
+
+	return err
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// defaultDupesThreshold is how similar two pages' content has to be, from
+	// 0 to 1, for them to be near-duplicates
+	defaultDupesThreshold = 0.5
+
+	// dupesShingleSize is the number of words in a row that make a shingle.
+	// Pages that share a lot of their shingles say the same things in the
+	// same words
+	dupesShingleSize = 3
+
+	// dupesCommonTitleWord is the number of pages a word has to be in the
+	// titles of to be too common to say that two pages are about the same
+	// thing, like "go" in a collection of notes about Go
+	dupesCommonTitleWord = 50
+
+	errDupesThreshold = "--threshold needs to be more than 0 and no more than 1"
+
+	statusDupesNone       = "no duplicate pages"
+	statusDuplicateTitles = "title '%s' is used by %d pages: %s"
+)
+
+// dupePair is two pages that look like they're the same TIL
+type dupePair struct {
+	page       *pages.Page
+	other      *pages.Page
+	similarity float64
+	sameTitle  bool
+}
+
+// runDupes writes out the pairs of pages that have the same title, or
+// content that's nearly the same, with how similar their content is. With
+// --titles-only, it's just the pages with the same title.
+// Example:
+//
+//	> til dupes --threshold 0.6
+func runDupes(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("dupes", flag.ContinueOnError)
+	threshold := flags.Float64("threshold", src.GlobalConfig.UFloat64("dupesThreshold", defaultDupesThreshold), "how similar, from 0 to 1, two pages' content has to be to be near-duplicates")
+	titlesOnly := flags.Bool("titles-only", false, "only looks for pages with the same title")
+	parseFlags(flags, args)
+
+	if *threshold <= 0 || *threshold > 1 {
+		src.Defeat(&src.UsageError{Err: errors.New(errDupesThreshold)})
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	pairs := findDupes(pageSet, *threshold)
+	if *titlesOnly {
+		pairs = sameTitlePairs(pairs)
+	}
+
+	if len(pairs) == 0 {
+		src.Info(statusDupesNone)
+		return
+	}
+
+	fmt.Print(dupesTable(pairs))
+}
+
+// findDupes returns the pairs of content pages that have the same title,
+// ignoring case, whitespace, and punctuation, or whose content is at least threshold similar. Only pages that
+// share a word in their titles have their content compared, so that a few
+// thousand pages don't mean millions of comparisons. The pairs with the same
+// title come first, then the most similar
+func findDupes(pageSet []*pages.Page, threshold float64) []dupePair {
+	content := listedPages(pageSet, "")
+
+	shingles := make([]map[string]bool, len(content))
+	for i, page := range content {
+		shingles[i] = pageShingles(page)
+	}
+
+	pairs := []dupePair{}
+
+	for _, candidate := range dupeCandidates(content) {
+		page, other := content[candidate[0]], content[candidate[1]]
+
+		pair := dupePair{
+			page:       page,
+			other:      other,
+			similarity: jaccard(shingles[candidate[0]], shingles[candidate[1]]),
+			sameTitle:  normalizeTitle(page.Title) == normalizeTitle(other.Title),
+		}
+
+		if pair.sameTitle || pair.similarity >= threshold {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].sameTitle != pairs[j].sameTitle {
+			return pairs[i].sameTitle
+		}
+
+		if pairs[i].similarity != pairs[j].similarity {
+			return pairs[i].similarity > pairs[j].similarity
+		}
+
+		return pairs[i].page.FilePath < pairs[j].page.FilePath
+	})
+
+	return pairs
+}
+
+// duplicateTitles returns the groups of content pages that have the same
+// title, ignoring case, whitespace, and punctuation, so that "Go: contexts"
+// and "Go - Contexts" are the same. Each group is in filename order, and the
+// groups are in the order of their first pages
+func duplicateTitles(pageSet []*pages.Page) [][]*pages.Page {
+	byTitle := map[string][]*pages.Page{}
+	for _, page := range listedPages(pageSet, "") {
+		title := normalizeTitle(page.Title)
+		byTitle[title] = append(byTitle[title], page)
+	}
+
+	groups := [][]*pages.Page{}
+
+	for _, group := range byTitle {
+		if len(group) < 2 {
+			continue
+		}
+
+		sort.Slice(group, func(i, j int) bool { return group[i].FilePath < group[j].FilePath })
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0].FilePath < groups[j][0].FilePath })
+
+	return groups
+}
+
+// duplicateTitleWarnings returns a warning for each group of pages that have
+// the same title, listing their files and dates
+func duplicateTitleWarnings(pageSet []*pages.Page) []string {
+	warnings := []string{}
+
+	for _, group := range duplicateTitles(pageSet) {
+		files := []string{}
+		for _, page := range group {
+			files = append(files, fmt.Sprintf("%s (%s)", page.FilePath, page.PrettyDate()))
+		}
+
+		warnings = append(warnings, fmt.Sprintf(statusDuplicateTitles, group[0].Title, len(group), strings.Join(files, ", ")))
+	}
+
+	return warnings
+}
+
+// dupesTable returns the pairs as a table with aligned columns
+func dupesTable(pairs []dupePair) string {
+	buf := &bytes.Buffer{}
+	writer := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "SIMILARITY\tPAGE\tOTHER PAGE\tWHY")
+
+	for _, pair := range pairs {
+		why := "similar content"
+		if pair.sameTitle {
+			why = fmt.Sprintf("same title: %s", pair.page.Title)
+		}
+
+		fmt.Fprintf(writer, "%.0f%%\t%s\t%s\t%s\n", pair.similarity*100, pair.page.FilePath, pair.other.FilePath, why)
+	}
+
+	writer.Flush()
+
+	return buf.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// dupeCandidates returns the pairs of pages, by their index, that share a
+// word in their titles that isn't too common to mean anything. Pages with the
+// same title always share all their words, so they're always candidates
+func dupeCandidates(pageSet []*pages.Page) [][2]int {
+	byWord := map[string][]int{}
+
+	for i, page := range pageSet {
+		seen := map[string]bool{}
+
+		for _, word := range til.Tokenize(page.Title) {
+			if !seen[word] {
+				seen[word] = true
+				byWord[word] = append(byWord[word], i)
+			}
+		}
+	}
+
+	paired := map[[2]int]bool{}
+	candidates := [][2]int{}
+
+	addPair := func(i, j int) {
+		pair := [2]int{i, j}
+		if !paired[pair] {
+			paired[pair] = true
+			candidates = append(candidates, pair)
+		}
+	}
+
+	for _, indexes := range byWord {
+		if len(indexes) > dupesCommonTitleWord {
+			continue
+		}
+
+		for a := 0; a < len(indexes); a++ {
+			for b := a + 1; b < len(indexes); b++ {
+				addPair(indexes[a], indexes[b])
+			}
+		}
+	}
+
+	// Pages whose titles are only common words still match on the whole title
+	byTitle := map[string][]int{}
+	for i, page := range pageSet {
+		title := normalizeTitle(page.Title)
+		byTitle[title] = append(byTitle[title], i)
+	}
+
+	for _, indexes := range byTitle {
+		for a := 0; a < len(indexes); a++ {
+			for b := a + 1; b < len(indexes); b++ {
+				addPair(indexes[a], indexes[b])
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i][0] != candidates[j][0] {
+			return candidates[i][0] < candidates[j][0]
+		}
+
+		return candidates[i][1] < candidates[j][1]
+	})
+
+	return candidates
+}
+
+// sameTitlePairs returns the pairs of pages that have the same title
+func sameTitlePairs(pairs []dupePair) []dupePair {
+	same := []dupePair{}
+
+	for _, pair := range pairs {
+		if pair.sameTitle {
+			same = append(same, pair)
+		}
+	}
+
+	return same
+}
+
+// normalizeTitle returns the title in lower case, with every run of
+// whitespace and punctuation made into a single space
+func normalizeTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
+// pageShingles returns the runs of dupesShingleSize words in the page's
+// content, leaving out its code blocks, which are often boilerplate that two
+// quite different pages share. The front-matter isn't in the content to
+// begin with
+func pageShingles(page *pages.Page) map[string]bool {
+	tokens := til.Tokenize(proseOnly(page.Content))
+	shingles := map[string]bool{}
+
+	if len(tokens) > 0 && len(tokens) < dupesShingleSize {
+		shingles[strings.Join(tokens, " ")] = true
+		return shingles
+	}
+
+	for i := 0; i+dupesShingleSize <= len(tokens); i++ {
+		shingles[strings.Join(tokens[i:i+dupesShingleSize], " ")] = true
+	}
+
+	return shingles
+}
+
+// proseOnly returns the content without its fenced code blocks
+func proseOnly(content string) string {
+	lines := []string{}
+	fence := ""
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case fence == "" && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")):
+			fence = trimmed[:3]
+		case fence != "" && strings.HasPrefix(trimmed, fence):
+			fence = ""
+		case fence == "":
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// jaccard returns how many of the shingles in either set are in both, from
+// 0 to 1
+func jaccard(shingles, other map[string]bool) float64 {
+	if len(shingles) == 0 || len(other) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for shingle := range shingles {
+		if other[shingle] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(shingles)+len(other)-shared)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	errEmptyPageFlags = "-keep-empty and -discard-empty can't both be given"
+
+	promptKeepEmpty = "Keep empty page? [y/N] "
+
+	statusPageDiscarded = "removed %s, which was still empty"
+)
+
+// isUnedited returns true if the page's file is the same as the stub it was
+// created with, apart from the whitespace at the ends of its lines and at the
+// end of the file, which editors like to tidy up
+func isUnedited(stub, edited []byte) bool {
+	return trimTrailingSpace(string(stub)) == trimTrailingSpace(string(edited))
+}
+
+// keepEmptyPage returns true if a page that was left empty should be kept.
+// -keep-empty and -discard-empty decide for scripts. Otherwise, if there's
+// someone at the terminal, they're asked on out and their answer is read from
+// in, and without anyone to ask the page is kept
+func keepEmptyPage(in io.Reader, out io.Writer, interactive bool) bool {
+	switch {
+	case keepEmptyFlag:
+		return true
+	case discardEmptyFlag:
+		return false
+	case !interactive:
+		return true
+	}
+
+	fmt.Fprint(out, promptKeepEmpty)
+
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
+
+// discardPage removes the new page's file again
+func discardPage(page *pages.Page) error {
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return fileSystem.Remove(page.FilePath)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// trimTrailingSpace returns the text without the whitespace at the end of
+// each line, or the blank lines at the end
+func trimTrailingSpace(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errExportAll   = "--all only works with --via-pandoc"
+	errExportGraph = "til export needs --graph dot or --graph mermaid, or a page and --html or --via-pandoc"
+
+	// The number of pages two tags need to share to be joined in the graph
+	defaultGraphMinPages = 1
+
+	statusGraphBuild = "building graph page"
+)
+
+// graphFormats maps the name of a graph format to the function that writes
+// a tag map out in that format
+var graphFormats = map[string]func(tagMap *pages.TagMap, minPages int) string{
+	"dot":     tagGraphDOT,
+	"mermaid": tagGraphMermaid,
+}
+
+// tagEdge joins two tags that are used on the same pages
+type tagEdge struct {
+	from, to string
+	count    int
+}
+
+// runExport writes the tags out to the terminal as a graph, in Graphviz DOT
+// or Mermaid format. Tags are joined when they are used on the same pages.
+// With --html, it writes a single page out as a self-contained HTML file
+// instead, and with --via-pandoc, in any format pandoc can write, or every
+// page as a book with --all. Example:
+//
+//	> til export --graph dot --min-pages 2 | dot -Tsvg > tags.svg
+//	> til export closures --html --out closures.html
+//	> til export --all --via-pandoc --to epub
+func runExport(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	all := flags.Bool("all", false, "with --via-pandoc, export every page, oldest first, as one book")
+	format := flags.String("graph", "", "the graph format to write: dot or mermaid")
+	htmlFlag := flags.Bool("html", false, "write the page that matches the query out as an HTML file")
+	minPages := flags.Int("min-pages", src.GlobalConfig.UInt("graphMinPages", defaultGraphMinPages), "the number of pages two tags need to share to be joined")
+	out := flags.String("out", "", "the file to write the page to, instead of <slug>.html or <slug>.<format>")
+	to := flags.String("to", "", "with --via-pandoc, the format to export to, like docx, pdf, or epub")
+	viaPandoc := flags.Bool("via-pandoc", false, "export the page that matches the query with pandoc")
+	positional := parseInterspersed(flags, args)
+
+	if *all && !*viaPandoc {
+		src.Defeat(&src.UsageError{Err: errors.New(errExportAll)})
+	}
+
+	if *viaPandoc {
+		err := exportPandoc(ctx, strings.Join(positional, " "), *all, *to, *out)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		return
+	}
+
+	if *htmlFlag {
+		err := exportHTML(ctx, strings.Join(positional, " "), *out)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		return
+	}
+
+	graph, ok := graphFormats[*format]
+	if !ok || len(positional) > 0 {
+		src.Defeat(errors.New(errExportGraph))
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	fmt.Print(graph(newTagMap(pageSet), *minPages))
+}
+
+// exportHTML writes the page that matches the query out as a self-contained
+// HTML file, at outPath or else at the page's slug in the working directory
+func exportHTML(ctx context.Context, query, outPath string) error {
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		return err
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		return err
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return err
+	}
+
+	document, warnings := pageHTML(page, tDir, src.GlobalConfig.UInt("htmlImageMaxBytes", defaultHTMLImageMaxBytes))
+	for _, warning := range warnings {
+		src.Warn(warning)
+	}
+
+	if outPath == "" {
+		outPath = htmlFileName(page)
+	}
+
+	err = ioutil.WriteFile(outPath, []byte(document), 0644)
+	if err != nil {
+		return err
+	}
+
+	src.Info(fmt.Sprintf(statusHTMLExport, page.Title, outPath))
+
+	return nil
+}
+
+// buildGraphPage creates the graph.md page, which shows the tag graph as a
+// Mermaid diagram
+func buildGraphPage(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, tDir string, warnings *buildWarnings) error {
+	src.Info(statusGraphBuild)
+
+	content := "## Tags\n\n"
+	content += fmt.Sprintf("```mermaid\n%s```\n", tagGraphMermaid(tagMap, src.GlobalConfig.UInt("graphMinPages", defaultGraphMinPages)))
+
+	content += "\n"
+	content += siteFooter(pageSet)
+
+	filePath := filepath.Join(tDir, fmt.Sprintf("graph.%s", pages.FileExtension))
+
+	err := fileSystem.WriteFile(filePath, []byte(pages.MarkGenerated(content)), 0644)
+	if err != nil {
+		return err
+	}
+
+	src.Progress(filePath)
+
+	return nil
+}
+
+// tagGraphDOT writes the tag graph in Graphviz DOT format. Each tag is a node
+// whose size grows with its number of pages, and each edge is weighted by the
+// number of pages its two tags share
+func tagGraphDOT(tagMap *pages.TagMap, minPages int) string {
+	var builder strings.Builder
+
+	builder.WriteString("graph tags {\n")
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		count := len(tagMap.PagesFor(tagName))
+
+		builder.WriteString(fmt.Sprintf(
+			"  %s [label=%s, fontsize=%d];\n",
+			dotQuote(tagName), dotQuote(fmt.Sprintf("%s (%d)", tagName, count)), graphNodeSize(count),
+		))
+	}
+
+	for _, edge := range tagEdges(tagMap, minPages) {
+		builder.WriteString(fmt.Sprintf(
+			"  %s -- %s [weight=%d, penwidth=%d, label=\"%d\"];\n",
+			dotQuote(edge.from), dotQuote(edge.to), edge.count, edge.count, edge.count,
+		))
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// tagGraphMermaid writes the tag graph as a Mermaid flowchart, which can be put
+// straight into a Markdown page. Tag names can't be used as Mermaid node IDs,
+// so each node gets a generated ID and the tag name as its label
+func tagGraphMermaid(tagMap *pages.TagMap, minPages int) string {
+	var builder strings.Builder
+
+	builder.WriteString("graph LR\n")
+
+	ids := map[string]string{}
+
+	for i, tagName := range tagMap.SortedTagNames() {
+		id := fmt.Sprintf("t%d", i)
+		ids[tagName] = id
+		count := len(tagMap.PagesFor(tagName))
+
+		builder.WriteString(fmt.Sprintf("  %s[%s]\n", id, mermaidQuote(fmt.Sprintf("%s (%d)", tagName, count))))
+		builder.WriteString(fmt.Sprintf("  style %s font-size:%dpx\n", id, graphNodeSize(count)))
+	}
+
+	for i, edge := range tagEdges(tagMap, minPages) {
+		builder.WriteString(fmt.Sprintf("  %s ---|%d| %s\n", ids[edge.from], edge.count, ids[edge.to]))
+		builder.WriteString(fmt.Sprintf("  linkStyle %d stroke-width:%dpx\n", i, edge.count))
+	}
+
+	return builder.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// tagEdges returns one edge for every pair of tags that share at least
+// minPages pages, in tag name order
+func tagEdges(tagMap *pages.TagMap, minPages int) []tagEdge {
+	coOccurrences := tagMap.CoOccurrences()
+	edges := []tagEdge{}
+	seen := map[string]bool{}
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		seen[tagName] = true
+
+		for _, related := range coOccurrences[tagName] {
+			// Each pair is listed under both of its tags, so only take it once
+			if seen[related.Name] || related.Count < minPages {
+				continue
+			}
+
+			edges = append(edges, tagEdge{from: tagName, to: related.Name, count: related.Count})
+		}
+	}
+
+	return edges
+}
+
+// graphNodeSize returns the font size of a tag's node, which grows with the
+// number of pages the tag has, up to a limit
+func graphNodeSize(count int) int {
+	size := 12 + 2*(count-1)
+	if size > 36 {
+		return 36
+	}
+
+	return size
+}
+
+// dotQuote quotes a string as a DOT ID, so that tag names with spaces,
+// hyphens, or quotes in them can be used as node names
+func dotQuote(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	str = strings.ReplaceAll(str, `"`, `\"`)
+
+	return fmt.Sprintf(`"%s"`, str)
+}
+
+// mermaidQuote quotes a string as a Mermaid node label. Mermaid has no escape
+// character, so double quotes are written as an entity
+func mermaidQuote(str string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(str, `"`, "#quot;"))
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	// Images bigger than this are linked to rather than inlined, so that one
+	// screenshot doesn't turn a page into a file too big to send
+	defaultHTMLImageMaxBytes = 1 << 20
+
+	statusHTMLExport       = "exported %s to %s"
+	statusHTMLImageMissing = "couldn't inline the image %s, so it's left as a link: %s"
+	statusHTMLImageTooBig  = "the image %s is %d KB, more than the %d KB that's inlined, so it's left as a link"
+)
+
+// htmlExportCSS styles an exported page. It's kept in the page, so that it
+// looks the same wherever the file ends up
+const htmlExportCSS = `body{margin:0 auto;max-width:46em;padding:2em 1em;color:#24292f;background:#fff;font:16px/1.6 -apple-system,BlinkMacSystemFont,"Segoe UI",Helvetica,Arial,sans-serif}
+header{border-bottom:1px solid #d0d7de;margin-bottom:2em}
+header h1{margin:0 0 .25em}
+header time{color:#57606a}
+ul.tags{list-style:none;margin:.5em 0 1em;padding:0}
+ul.tags li{display:inline-block;margin-right:.5em;padding:0 .5em;border-radius:1em;background:#ddf4ff;color:#0969da;font-size:.85em}
+a{color:#0969da}
+img{max-width:100%}
+blockquote{margin:0;padding:0 1em;border-left:.25em solid #d0d7de;color:#57606a}
+code{padding:.2em .4em;border-radius:6px;background:#f6f8fa;font:85% SFMono-Regular,Consolas,"Liberation Mono",Menlo,monospace}
+pre{overflow:auto;padding:1em;border-radius:6px;background:#f6f8fa;line-height:1.45}
+pre code{padding:0;background:none;font-size:85%}
+hr{border:0;border-top:1px solid #d0d7de}
+.c{color:#6e7781;font-style:italic}
+.k{color:#cf222e}
+.n{color:#0550ae}
+.s{color:#0a3069}
+`
+
+// pageHTML renders the page as a self-contained HTML document, with its
+// title, date, and tags in a header. Local images no bigger than maxBytes are
+// inlined as data URIs. It returns the warnings about the images that
+// couldn't be
+func pageHTML(page *pages.Page, docsDir string, maxBytes int) (string, []string) {
+	warnings := []string{}
+
+	opts := pages.HTMLOptions{
+		ImageSrc: func(imgSrc string) string {
+			inlined, warning := inlineImage(page, docsDir, imgSrc, maxBytes)
+			if warning != "" {
+				warnings = append(warnings, warning)
+			}
+
+			return inlined
+		},
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("<!DOCTYPE html>\n")
+	builder.WriteString("<html>\n<head>\n")
+	builder.WriteString("<meta charset=\"utf-8\">\n")
+	builder.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	builder.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(page.Title)))
+	builder.WriteString(fmt.Sprintf("<style>\n%s</style>\n", htmlExportCSS))
+	builder.WriteString("</head>\n<body>\n<header>\n")
+	builder.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(page.Title)))
+
+	if !page.CreatedAt().IsZero() {
+		builder.WriteString(fmt.Sprintf(
+			"<time datetime=\"%s\">%s</time>\n",
+			page.CreatedAt().Format(time.RFC3339), page.PrettyDate(),
+		))
+	}
+
+	tagNames := []string{}
+	for _, tag := range page.Tags() {
+		if tag.Name != "" {
+			tagNames = append(tagNames, tag.Name)
+		}
+	}
+
+	if len(tagNames) > 0 {
+		builder.WriteString("<ul class=\"tags\">\n")
+		for _, tagName := range tagNames {
+			builder.WriteString(fmt.Sprintf("<li>%s</li>\n", html.EscapeString(tagName)))
+		}
+		builder.WriteString("</ul>\n")
+	}
+
+	builder.WriteString("</header>\n<article>\n")
+	builder.WriteString(pages.RenderHTML(exportBody(page), opts))
+	builder.WriteString("</article>\n</body>\n</html>\n")
+
+	return builder.String(), warnings
+}
+
+// htmlFileName returns the file a page is exported to when no other is given:
+// its slug, in the working directory
+func htmlFileName(page *pages.Page) string {
+	return pages.Slug(page.Title) + ".html"
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// exportBody returns the Markdown that a page is exported as. Pages in a
+// format that til can't render are shown as they are, in a code block
+func exportBody(page *pages.Page) string {
+	format := page.Format()
+	if format.Rendered {
+		return htmlBody(page)
+	}
+
+	// The fence has to be longer than any run of backticks in the page
+	fence := "```"
+	for strings.Contains(page.Content, fence) {
+		fence += "`"
+	}
+
+	return fmt.Sprintf(
+		"This page is written in %s, so here it is as it is, from `%s`:\n\n%s%s\n%s\n%s\n",
+		format.Name, filepath.Base(page.FilePath), fence, format.Lang, strings.TrimRight(page.Content, "\n"), fence,
+	)
+}
+
+// htmlBody returns the page's content without the heading that repeats its
+// title, which the header already shows
+func htmlBody(page *pages.Page) string {
+	body := strings.TrimLeft(page.Content, "\n")
+
+	first, rest := body, ""
+	if i := strings.Index(body, "\n"); i >= 0 {
+		first, rest = body[:i], body[i+1:]
+	}
+
+	if strings.HasPrefix(first, "# ") && strings.TrimSpace(first[2:]) == page.Title {
+		return strings.TrimLeft(rest, "\n")
+	}
+
+	return body
+}
+
+// inlineImage returns the data URI for a local image, or the src as it is for
+// images on the web and those that can't be inlined, along with a warning
+// saying why. Images are found relative to the page, or to the docs
+// directory if their path starts with a slash
+func inlineImage(page *pages.Page, docsDir, imgSrc string, maxBytes int) (string, string) {
+	if strings.Contains(imgSrc, "://") || strings.HasPrefix(imgSrc, "data:") || strings.HasPrefix(imgSrc, "//") {
+		return imgSrc, ""
+	}
+
+	filePath := filepath.Join(filepath.Dir(page.FilePath), filepath.FromSlash(imgSrc))
+	if strings.HasPrefix(imgSrc, "/") {
+		filePath = filepath.Join(docsDir, filepath.FromSlash(imgSrc))
+	}
+
+	data, err := fileSystem.ReadFile(filePath)
+	if err != nil {
+		return imgSrc, fmt.Sprintf(statusHTMLImageMissing, imgSrc, err)
+	}
+
+	if len(data) > maxBytes {
+		return imgSrc, fmt.Sprintf(statusHTMLImageTooBig, imgSrc, (len(data)+1023)/1024, maxBytes/1024)
+	}
+
+	mimeType := mime.TypeByExtension(strings.ToLower(filepath.Ext(filePath)))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+
+	// Parameters like a charset have no place in an image's data URI
+	if i := strings.Index(mimeType, ";"); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+
+	return fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(data)), ""
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errFetchNoTitle = "the page has no title"
+	errFetchStatus  = "%s answered %s"
+	errFromURL      = "-from-url needs a web address, like https://example.com/article, not %s"
+
+	// How long fetching a page's title can take, and how much of the page is
+	// read looking for it. Titles are in the head, near the start
+	fetchTimeout  = 10 * time.Second
+	fetchMaxBytes = 1 << 20
+
+	statusFetchFailed = "couldn't get the title of %s, so the page is titled with its address instead: %s"
+)
+
+var (
+	htmlAttrRegex    = regexp.MustCompile(`(?i)([a-z_:-]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	htmlCharsetRegex = regexp.MustCompile(`(?i)charset\s*=\s*["']?([a-z0-9_-]+)`)
+	htmlMetaRegex    = regexp.MustCompile(`(?i)<meta\b([^>]*)>`)
+	htmlTitleRegex   = regexp.MustCompile(`(?is)<title\b[^>]*>(.*?)</title>`)
+)
+
+// pageTitleFetcher gets the title of the web page at a URL. Tests swap it out
+// so that they never go out to the network
+var pageTitleFetcher = fetchTitle
+
+// windows1252 are the characters that Windows-1252 has in place of the
+// control characters at 0x80 to 0x9F in ISO-8859-1. Browsers read pages that
+// say they're ISO-8859-1 as Windows-1252, so til does too
+var windows1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', '\u008d', 'Ž', '\u008f',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', '\u009d', 'ž', 'Ÿ',
+}
+
+// fromURLPage returns the title and options for a page about the web page at
+// rawURL, with the URL as its source and a link to it in its body. Without a
+// title of its own, the page gets the web page's, or failing that, the URL
+func fromURLPage(ctx context.Context, rawURL, title string, opts pages.PageOptions) (string, pages.PageOptions, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", opts, &src.UsageError{Err: fmt.Errorf(errFromURL, rawURL)}
+	}
+
+	if title == "" {
+		title = titleForURL(ctx, rawURL)
+	}
+
+	opts.Body = fmt.Sprintf("[%s](%s)\n", title, rawURL)
+	opts.Source = rawURL
+
+	return title, opts, nil
+}
+
+// titleForURL returns the title of the web page at rawURL, or rawURL itself
+// if the title can't be had
+func titleForURL(ctx context.Context, rawURL string) string {
+	title, err := pageTitleFetcher(ctx, rawURL)
+	if err != nil {
+		src.Warn(fmt.Sprintf(statusFetchFailed, rawURL, err))
+		return rawURL
+	}
+
+	return title
+}
+
+// fetchTitle gets the web page at rawURL and returns its title
+func fetchTitle(ctx context.Context, rawURL string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", "til")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf(errFetchStatus, rawURL, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, fetchMaxBytes))
+	if err != nil {
+		return "", err
+	}
+
+	return htmlTitle(data, resp.Header.Get("Content-Type"))
+}
+
+// htmlTitle returns the title of the HTML page in data, from its title tag or
+// else its og:title, with its entities turned into the characters they stand
+// for. contentType is the Content-Type that the page was sent with, which
+// can say what character set it's in
+func htmlTitle(data []byte, contentType string) (string, error) {
+	text := decodeHTML(data, contentType)
+
+	if match := htmlTitleRegex.FindStringSubmatch(text); match != nil {
+		if title := cleanHTMLText(match[1]); title != "" {
+			return title, nil
+		}
+	}
+
+	for _, match := range htmlMetaRegex.FindAllStringSubmatch(text, -1) {
+		attrs := htmlAttrs(match[1])
+
+		if strings.EqualFold(attrs["property"], "og:title") || strings.EqualFold(attrs["name"], "og:title") {
+			if title := cleanHTMLText(attrs["content"]); title != "" {
+				return title, nil
+			}
+		}
+	}
+
+	return "", errors.New(errFetchNoTitle)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// decodeHTML returns the HTML page as UTF-8. The character set comes from
+// the Content-Type, or else the page's own meta tags. UTF-8 that isn't valid
+// is read as Windows-1252, which is what older pages usually turn out to be
+func decodeHTML(data []byte, contentType string) string {
+	charset := ""
+	if match := htmlCharsetRegex.FindStringSubmatch(contentType); match != nil {
+		charset = match[1]
+	}
+
+	if charset == "" {
+		head := data
+		if len(head) > 2048 {
+			head = head[:2048]
+		}
+
+		for _, meta := range htmlMetaRegex.FindAllSubmatch(head, -1) {
+			if match := htmlCharsetRegex.FindSubmatch(meta[1]); match != nil {
+				charset = string(match[1])
+				break
+			}
+		}
+	}
+
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1", "us-ascii", "windows-1252", "cp1252":
+		return decodeWindows1252(data)
+	case "", "utf-8", "utf8":
+		if !utf8.Valid(data) {
+			return decodeWindows1252(data)
+		}
+	}
+
+	return string(data)
+}
+
+func decodeWindows1252(data []byte) string {
+	var builder strings.Builder
+
+	for _, b := range data {
+		if b >= 0x80 && b <= 0x9f {
+			builder.WriteRune(windows1252[b-0x80])
+		} else {
+			builder.WriteRune(rune(b))
+		}
+	}
+
+	return builder.String()
+}
+
+// htmlAttrs returns the attributes of an HTML tag, by their lower-case names
+func htmlAttrs(raw string) map[string]string {
+	attrs := map[string]string{}
+
+	for _, match := range htmlAttrRegex.FindAllStringSubmatch(raw, -1) {
+		attrs[strings.ToLower(match[1])] = match[2] + match[3]
+	}
+
+	return attrs
+}
+
+// cleanHTMLText turns the entities in the text into the characters they stand
+// for, and collapses its whitespace
+func cleanHTMLText(text string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(text)), " ")
+}
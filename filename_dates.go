@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	statusNoFileNameDate = "%s has no date, and its name doesn't start with one like 2006-01-02T15-04-05"
+)
+
+// backfillFileNameDate writes the date that the page's file name starts with
+// into its date field, if it doesn't have one, adding what it changed to
+// changes. The date is read in loc. A page that already has a date is left
+// as it is, so running it again changes nothing. It returns false for a page
+// without a date whose name doesn't start with one either
+func backfillFileNameDate(filePath string, data []byte, changes []string, loc *time.Location) ([]byte, []string, bool, error) {
+	current, err := pages.FrontMatterField(data, "date")
+	if err != nil || current != "" {
+		return data, changes, true, err
+	}
+
+	date, ok := pages.FileNameDate(filePath, loc)
+	if !ok {
+		return data, changes, false, nil
+	}
+
+	value := date.Format(time.RFC3339)
+
+	data, changed, err := pages.SetMissingFrontMatterField(data, "date", value)
+	if err != nil || !changed {
+		return data, changes, true, err
+	}
+
+	return data, append(changes, fmt.Sprintf("date set to '%s' from the file name", value)), true, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	errFindAmbiguous = "'%s' matches more than one page: %s"
+	errFindNone      = "no page matches '%s'"
+	errFindQuery     = "which page? Give part of its title or its filename"
+)
+
+// findPage returns the content page that the query refers to. A query that is
+// a page's filename (with or without the .md extension) selects that page.
+// Otherwise the query has to appear, in any case, in the title of exactly one
+// page
+func findPage(pageSet []*pages.Page, query string) (*pages.Page, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New(errFindQuery)
+	}
+
+	for _, page := range pageSet {
+		if !page.IsContentPage() {
+			continue
+		}
+
+		name := filepath.Base(page.FilePath)
+		if name == query || strings.TrimSuffix(name, filepath.Ext(name)) == query {
+			return page, nil
+		}
+	}
+
+	matches := titleMatches(pageSet, query)
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf(errFindNone, query)
+	case 1:
+		return matches[0], nil
+	}
+
+	names := []string{}
+	for _, page := range matches {
+		names = append(names, filepath.Base(page.FilePath))
+	}
+
+	return nil, fmt.Errorf(errFindAmbiguous, query, strings.Join(names, ", "))
+}
+
+// pickPage is findPage for commands run in a terminal. When the query is left
+// out, or matches more than one page, it lets the page be picked with the
+// fuzzy finder instead of failing
+func pickPage(pageSet []*pages.Page, query string) (*pages.Page, error) {
+	page, err := findPage(pageSet, query)
+	if err == nil || !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		return page, err
+	}
+
+	if strings.TrimSpace(query) != "" && len(titleMatches(pageSet, query)) < 2 {
+		return nil, err
+	}
+
+	return runFinder(pageSet, strings.TrimSpace(query))
+}
+
+// titleMatches returns the content pages with the query in their title, in
+// any case
+func titleMatches(pageSet []*pages.Page, query string) []*pages.Page {
+	matches := []*pages.Page{}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() && strings.Contains(strings.ToLower(page.Title), strings.ToLower(query)) {
+			matches = append(matches, page)
+		}
+	}
+
+	return matches
+}
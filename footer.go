@@ -0,0 +1,42 @@
+package main
+
+import (
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// siteFooter returns the footer of the generated pages, in the footerStyle
+// from the config. The timestamp style changes with every build, while the
+// entries style only changes when the pages do
+func siteFooter(pageSet []*pages.Page) string {
+	style, _ := src.FooterStyle(src.GlobalConfig)
+	if style != src.FooterStyleEntries {
+		return src.Footer()
+	}
+
+	return entriesFooter(pageSet)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// entriesFooter is the entries style of footer, with the number of content
+// pages and the date of the newest of them
+func entriesFooter(pageSet []*pages.Page) string {
+	listed := listedPages(pageSet, "")
+
+	var lastAdded time.Time
+	for _, page := range listed {
+		if created := page.CreatedAt(); created.After(lastAdded) {
+			lastAdded = created
+		}
+	}
+
+	date := ""
+	if !lastAdded.IsZero() {
+		date = pages.FormatDate(lastAdded)
+	}
+
+	return src.EntriesFooter(len(listed), date)
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	errFindCanceled = "no page was picked"
+
+	// How a fuzzy match is scored. Every matched character scores, matches at
+	// the start of a word and runs of matches score extra, and characters
+	// skipped between matches cost a little
+	fuzzyBoundaryBonus    = 8
+	fuzzyConsecutiveBonus = 4
+	fuzzyGapPenalty       = 1
+	fuzzyMatchScore       = 1
+
+	finderDateFormat = "2006-01-02"
+)
+
+// finder is the state of the fuzzy finder that pages are picked with. Like
+// the browser, it only changes through update
+type finder struct {
+	cursor  int
+	done    bool
+	lines   []string
+	matches []int
+	picked  int
+	query   string
+}
+
+// runFinder lets one of the content pages be picked by typing part of its
+// date, title, or tags, starting with the query already typed in
+func runFinder(pageSet []*pages.Page, query string) (*pages.Page, error) {
+	candidates := []*pages.Page{}
+	lines := []string{}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() {
+			candidates = append(candidates, page)
+			lines = append(lines, finderLine(page))
+		}
+	}
+
+	restore, err := rawTerminal()
+	if err != nil {
+		return nil, err
+	}
+
+	fnd := newFinder(lines, query)
+	reader := bufio.NewReader(os.Stdin)
+
+	for !fnd.done {
+		writeScreen(os.Stdout, fnd.view(terminalSize()))
+
+		k, err := readKey(reader)
+		if err != nil {
+			restore()
+			return nil, err
+		}
+
+		fnd.update(k)
+	}
+
+	restore()
+
+	if fnd.picked < 0 {
+		return nil, fmt.Errorf("%s: %w", errFindCanceled, context.Canceled)
+	}
+
+	return candidates[fnd.picked], nil
+}
+
+// newFinder returns a finder over the lines, with the query typed in
+func newFinder(lines []string, query string) *finder {
+	fnd := &finder{lines: lines, picked: -1, query: query}
+	fnd.matches = fuzzyFilter(query, lines)
+
+	return fnd
+}
+
+// update changes the finder's state for a key press
+func (fnd *finder) update(k key) {
+	switch k.kind {
+	case keyRune:
+		fnd.query += string(k.r)
+	case keyBackspace:
+		fnd.query = dropLastRune(fnd.query)
+	case keyUp:
+		fnd.cursor--
+	case keyDown:
+		fnd.cursor++
+	case keyEnter:
+		if len(fnd.matches) > 0 {
+			fnd.picked = fnd.matches[fnd.cursor]
+			fnd.done = true
+		}
+	case keyEsc, keyCtrlC:
+		fnd.done = true
+	}
+
+	if k.kind == keyRune || k.kind == keyBackspace {
+		fnd.matches = fuzzyFilter(fnd.query, fnd.lines)
+		fnd.cursor = 0
+	}
+
+	if fnd.cursor >= len(fnd.matches) {
+		fnd.cursor = len(fnd.matches) - 1
+	}
+
+	if fnd.cursor < 0 {
+		fnd.cursor = 0
+	}
+}
+
+// view returns the lines to draw the finder with: what's been typed, how
+// many lines match, and the best matches
+func (fnd *finder) view(height, width int) []string {
+	lines := []string{
+		truncateLine("> "+fnd.query, width),
+		truncateLine(fmt.Sprintf("  %d/%d", len(fnd.matches), len(fnd.lines)), width),
+	}
+
+	listHeight := height - len(lines)
+
+	first := 0
+	if fnd.cursor >= listHeight {
+		first = fnd.cursor - listHeight + 1
+	}
+
+	for i := first; i < len(fnd.matches) && i < first+listHeight; i++ {
+		line := truncateLine("  "+fnd.lines[fnd.matches[i]], width)
+
+		if i == fnd.cursor {
+			line = fmt.Sprintf(reverseVideo, line)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// finderLine is how a page is shown in the fuzzy finder: its date, title,
+// and tags, like "2020-05-07 Go Closures [go, javascript]"
+func finderLine(page *pages.Page) string {
+	date := page.CreatedAt()
+
+	line := page.Title
+	if !date.IsZero() {
+		line = fmt.Sprintf("%s %s", date.Format(finderDateFormat), line)
+	}
+
+	if tagNames := jsonPage(page).Tags; len(tagNames) > 0 {
+		line += fmt.Sprintf(" [%s]", strings.Join(tagNames, ", "))
+	}
+
+	return line
+}
+
+// fuzzyFilter returns the indexes of the lines that match the query, best
+// match first. Lines that match equally well keep their order
+func fuzzyFilter(query string, lines []string) []int {
+	type scored struct {
+		index int
+		score int
+	}
+
+	matches := []scored{}
+
+	for i, line := range lines {
+		if score, ok := fuzzyScore(query, line); ok {
+			matches = append(matches, scored{index: i, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	indexes := []int{}
+	for _, match := range matches {
+		indexes = append(indexes, match.index)
+	}
+
+	return indexes
+}
+
+// fuzzyScore returns how well the pattern matches the candidate, and whether
+// it matches at all. It matches if its characters appear in the candidate in
+// the same order, in any case, though not necessarily next to each other.
+// Of all the ways they could line up, the best scoring one is used, which
+// means matches at the start of words win out. Spaces in the pattern are
+// ignored
+func fuzzyScore(pattern, candidate string) (int, bool) {
+	pat := []rune(strings.ToLower(strings.Join(strings.Fields(pattern), "")))
+	if len(pat) == 0 {
+		return 0, true
+	}
+
+	original := []rune(candidate)
+	cand := []rune(strings.ToLower(candidate))
+
+	if len(cand) != len(original) {
+		// Changing case changed the length, so the positions don't line up
+		original = cand
+	}
+
+	// best[j] is the best score with the current pattern character matched
+	// at cand[j], or -1 if it can't be
+	const none = -1 << 30
+
+	prev := make([]int, len(cand))
+	best := make([]int, len(cand))
+
+	for i, pr := range pat {
+		// The best score of the previous row so far, adjusted so that the gap
+		// penalty can be worked out from where the next match lands
+		runningMax := none
+
+		for j := range cand {
+			best[j] = none
+
+			if i > 0 && j > 1 && prev[j-2] != none {
+				if gapped := prev[j-2] + fuzzyGapPenalty*(j-2); gapped > runningMax {
+					runningMax = gapped
+				}
+			}
+
+			if cand[j] != pr {
+				continue
+			}
+
+			score := fuzzyMatchScore
+			if isWordStart(original, j) {
+				score += fuzzyBoundaryBonus
+			}
+
+			switch {
+			case i == 0:
+				best[j] = score
+			default:
+				if j > 0 && prev[j-1] != none {
+					best[j] = prev[j-1] + score + fuzzyConsecutiveBonus
+				}
+
+				if runningMax != none {
+					if gapped := runningMax - fuzzyGapPenalty*(j-1) + score; gapped > best[j] {
+						best[j] = gapped
+					}
+				}
+			}
+		}
+
+		prev, best = best, prev
+	}
+
+	top := none
+	for _, score := range prev {
+		if score > top {
+			top = score
+		}
+	}
+
+	return top, top != none
+}
+
+// isWordStart returns true if the character at i starts a word: it's the
+// first character, it follows something that isn't a letter or digit, or
+// it's an upper-case letter after a lower-case one
+func isWordStart(str []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	before := str[i-1]
+
+	if !unicode.IsLetter(before) && !unicode.IsDigit(before) {
+		return true
+	}
+
+	return unicode.IsLower(before) && unicode.IsUpper(str[i])
+}
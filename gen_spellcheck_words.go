@@ -0,0 +1,77 @@
+//go:build ignore
+// +build ignore
+
+// gen_spellcheck_words writes spellcheck_words.go from words/english.txt, so
+// that the word list is built into til without being thousands of lines of Go.
+// The words are gzipped and base64-encoded, 76 characters to a line.
+// Example:
+//
+//	> go generate
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const (
+	lineLength = 76
+	sourcePath = "words/english.txt"
+	targetPath = "spellcheck_words.go"
+)
+
+const header = `// Code generated by gen_spellcheck_words.go from words/english.txt. DO NOT EDIT.
+
+package main
+
+// englishWordsGzip is words/english.txt, gzipped and base64-encoded. See
+// words/README.md for where the words came from
+const englishWordsGzip = ` + "`"
+
+func main() {
+	words, err := ioutil.ReadFile(sourcePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var compressed bytes.Buffer
+
+	writer, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := writer.Write(words); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+	var source strings.Builder
+	source.WriteString(header)
+
+	for len(encoded) > 0 {
+		n := lineLength
+		if n > len(encoded) {
+			n = len(encoded)
+		}
+
+		fmt.Fprintf(&source, "\n%s", encoded[:n])
+		encoded = encoded[n:]
+	}
+
+	source.WriteString("\n`\n")
+
+	if err := ioutil.WriteFile(targetPath, []byte(source.String()), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
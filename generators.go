@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errUnknownGenerator = "unknown generator '%s' in the generators config. Known generators are: %s"
+)
+
+// buildStep is a til.Generator made from one of the functions that build
+// part of the site and write out what they're doing as they go. Whatever's
+// worth a warning is added to the step's warnings
+type buildStep struct {
+	name     string
+	build    func(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, dir string, warnings *buildWarnings) error
+	warnings *buildWarnings
+}
+
+// Name returns the name that the step is listed by in the generators config
+func (step buildStep) Name() string {
+	return step.name
+}
+
+// Generate runs the step
+func (step buildStep) Generate(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, dir string) error {
+	return step.build(ctx, pageSet, tagMap, dir, step.warnings)
+}
+
+// generators are everything a build can produce, by the name it is listed by
+// in the generators config
+var generators = map[string]buildStep{
+	"activity":  buildStep{name: "activity", build: buildActivityPage},
+	"changelog": buildStep{name: "changelog", build: buildChangelogPage},
+	"graph":     buildStep{name: "graph", build: buildGraphPage},
+	"index":     buildStep{name: "index", build: buildIndexPage},
+	"searches":  buildStep{name: "searches", build: buildSavedSearchPages},
+	"tags":      buildStep{name: "tags", build: buildTagPages},
+}
+
+// configuredGenerators returns the generators that a build runs, in order,
+// adding their warnings to warnings. They're listed in the generators config.
+// Without that, a build writes the tag pages and the index, plus the graph,
+// changelog, and activity pages if graphPage, changelogPage, and activityPage
+// are set, and the saved search pages if there are any
+func configuredGenerators(warnings *buildWarnings) ([]til.Generator, error) {
+	gens := []til.Generator{}
+
+	for _, name := range configuredGeneratorNames() {
+		step, ok := generators[name]
+		if !ok {
+			return nil, fmt.Errorf(errUnknownGenerator, name, strings.Join(generatorNames(), ", "))
+		}
+
+		step.warnings = warnings
+		gens = append(gens, step)
+	}
+
+	return gens, nil
+}
+
+// isGeneratorConfigured returns true if a build runs the generator with the name
+func isGeneratorConfigured(name string) bool {
+	for _, configured := range configuredGeneratorNames() {
+		if configured == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configuredGeneratorNames returns the names of the generators that a build
+// runs, in order, as configuredGenerators finds them. They aren't checked
+func configuredGeneratorNames() []string {
+	names, err := src.GlobalConfig.List("generators")
+	if err != nil {
+		names = []interface{}{"tags", "index"}
+
+		if src.GlobalConfig.UBool("graphPage", false) {
+			names = append(names, "graph")
+		}
+
+		if src.GlobalConfig.UBool("changelogPage", false) {
+			names = append(names, "changelog")
+		}
+
+		if src.GlobalConfig.UBool("activityPage", false) {
+			names = append(names, "activity")
+		}
+
+		if _, err := src.GlobalConfig.Map("savedSearches"); err == nil {
+			names = append(names, "searches")
+		}
+	}
+
+	trimmed := []string{}
+	for _, name := range names {
+		trimmed = append(trimmed, strings.TrimSpace(fmt.Sprintf("%v", name)))
+	}
+
+	return trimmed
+}
+
+// generatorNames returns the names of all the generators, in alphabetical order
+func generatorNames() []string {
+	names := []string{}
+	for name := range generators {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
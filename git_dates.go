@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	statusNotGitRepo = "the target directory isn't a git repo, so --dates-from-git is skipped"
+)
+
+// pageDates are when a page was first written, and when it was last changed
+type pageDates struct {
+	created  time.Time
+	modified time.Time
+	source   string
+}
+
+// isGitRepo returns true if the directory is inside a git work tree
+func isGitRepo(dir string) bool {
+	_, err := runCommand(dir, "git", "rev-parse", "--is-inside-work-tree")
+	return err == nil
+}
+
+// datesFromGit returns the dates of the first and the last commits that
+// touched the file, following it through renames. A file that has never been
+// committed falls back to its modification time
+func datesFromGit(dir, filePath string) (pageDates, error) {
+	out, err := runCommand(dir, "git", "log", "--follow", "--format=%aI", "--", filePath)
+	if err != nil {
+		return pageDates{}, fmt.Errorf("%s: %s", filePath, strings.TrimSpace(string(out)))
+	}
+
+	lines := strings.Fields(string(out))
+	if len(lines) == 0 {
+		return datesFromModTime(filePath)
+	}
+
+	// git log lists the newest commit first
+	modified, err := time.Parse(time.RFC3339, lines[0])
+	if err != nil {
+		return pageDates{}, err
+	}
+
+	created, err := time.Parse(time.RFC3339, lines[len(lines)-1])
+	if err != nil {
+		return pageDates{}, err
+	}
+
+	return pageDates{created: created, modified: modified, source: "git history"}, nil
+}
+
+// datesFromModTime uses the file's modification time for both of its dates
+func datesFromModTime(filePath string) (pageDates, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return pageDates{}, err
+	}
+
+	return pageDates{created: info.ModTime(), modified: info.ModTime(), source: "the file's modification time"}, nil
+}
+
+// backfillDates writes the dates into the page's date and modified fields,
+// wherever those are missing or empty. It returns the changed page and a list
+// of what changed
+func backfillDates(data []byte, dates pageDates) ([]byte, []string, error) {
+	changes := []string{}
+
+	fields := []struct {
+		key  string
+		date time.Time
+	}{
+		{"date", dates.created},
+		{"modified", dates.modified},
+	}
+
+	for _, field := range fields {
+		value := field.date.Format(time.RFC3339)
+
+		updated, changed, err := pages.SetMissingFrontMatterField(data, field.key, value)
+		if err != nil {
+			return data, []string{}, err
+		}
+
+		if changed {
+			data = updated
+			changes = append(changes, fmt.Sprintf("%s set to '%s' from %s", field.key, value, dates.source))
+		}
+	}
+
+	return data, changes, nil
+}
+
+// gitDatesTargetDir returns the target directory if --dates-from-git can be
+// used with it, or an empty string if it isn't a git repo
+func gitDatesTargetDir() string {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if !isGitRepo(tDir) {
+		src.Warn(statusNotGitRepo)
+		return ""
+	}
+
+	return tDir
+}
@@ -7,5 +7,5 @@ require (
 	github.com/go-git/go-git/v5 v5.0.0
 	github.com/olebedev/config v0.0.0-20190528211619-364964f3a8e4
 	github.com/stretchr/testify v1.4.0
-	gopkg.in/yaml.v2 v2.2.8 // indirect
+	gopkg.in/yaml.v2 v2.2.8
 )
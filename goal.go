@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// goalPeriods is the number of periods the hit rate is worked out over
+	goalPeriods = 12
+
+	statusGoalCurrent = "Goal: %d of %d %s"
+	statusGoalHitRate = "Met the goal %d of the last %s (%d%%)"
+	statusGoalRun     = "Longest run: %s in a row"
+)
+
+// goalPeriodNames are how the periods of each cadence are written: one, many,
+// and the current one
+var goalPeriodNames = map[string][3]string{
+	src.CadenceDaily:   {"day", "days", "today"},
+	src.CadenceWeekly:  {"week", "weeks", "this week"},
+	src.CadenceMonthly: {"month", "months", "this month"},
+}
+
+// periodCount is the number of content pages created in a period
+type periodCount struct {
+	start time.Time
+	count int
+}
+
+// goalProgress is how the writing goal is going
+type goalProgress struct {
+	goal src.WritingGoal
+
+	// current is the number of pages written so far in the current period
+	current int
+
+	// met is the number of the last periods, not counting the current one,
+	// that met the goal
+	met     int
+	periods int
+
+	// longestRun is the most periods in a row that met the goal. The
+	// current one only counts once it has
+	longestRun int
+}
+
+// periodStart returns the start of the period that the date is in, in its
+// location: midnight at the start of its day, of the first day of its week,
+// with weeks starting on weekStart, or of the first day of its month
+func periodStart(date time.Time, cadence string, weekStart time.Weekday) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	switch cadence {
+	case src.CadenceWeekly:
+		back := (int(day.Weekday()) - int(weekStart) + 7) % 7
+		return day.AddDate(0, 0, -back)
+	case src.CadenceMonthly:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// shiftPeriod returns the start of the period n periods after the one that
+// starts at start, or before it for a negative n. Periods are moved by
+// calendar days and months, so a day that a clock change makes 23 or 25 hours
+// long is still a day
+func shiftPeriod(start time.Time, cadence string, n int) time.Time {
+	switch cadence {
+	case src.CadenceWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case src.CadenceMonthly:
+		return start.AddDate(0, n, 0)
+	default:
+		return start.AddDate(0, 0, n)
+	}
+}
+
+// periodCounts returns the number of content pages created in each period,
+// from the one the first page was created in up to and including now's,
+// oldest first. Every period is there, even the ones without any pages.
+// The pages' dates are looked at in loc
+func periodCounts(pageSet []*pages.Page, now time.Time, loc *time.Location, cadence string, weekStart time.Weekday) []periodCount {
+	current := periodStart(now.In(loc), cadence, weekStart)
+	first := current
+
+	counts := map[int64]int{}
+
+	for _, page := range listedPages(pageSet, "") {
+		created := page.CreatedAt()
+		if created.IsZero() {
+			continue
+		}
+
+		start := periodStart(created.In(loc), cadence, weekStart)
+		if start.After(current) {
+			continue
+		}
+
+		if start.Before(first) {
+			first = start
+		}
+
+		counts[start.Unix()]++
+	}
+
+	periods := []periodCount{}
+
+	for start := first; !start.After(current); start = shiftPeriod(start, cadence, 1) {
+		periods = append(periods, periodCount{start: start, count: counts[start.Unix()]})
+	}
+
+	return periods
+}
+
+// measureGoal works out how the goal is going from the counts of the pages
+// in each period, oldest first and ending with the current one
+func measureGoal(goal src.WritingGoal, counts []periodCount) goalProgress {
+	progress := goalProgress{goal: goal}
+	if len(counts) == 0 {
+		return progress
+	}
+
+	last := len(counts) - 1
+	progress.current = counts[last].count
+
+	for i := last - 1; i >= 0 && i >= last-goalPeriods; i-- {
+		progress.periods++
+
+		if counts[i].count >= goal.Count {
+			progress.met++
+		}
+	}
+
+	run := 0
+
+	for i, pc := range counts {
+		if pc.count < goal.Count {
+			// The current period isn't over, so it doesn't end a run yet
+			if i < last {
+				run = 0
+			}
+
+			continue
+		}
+
+		run++
+		if run > progress.longestRun {
+			progress.longestRun = run
+		}
+	}
+
+	return progress
+}
+
+// configuredGoalProgress returns how the writing goal in the config is going
+// at now, in the configured timezone, and whether there is one
+func configuredGoalProgress(pageSet []*pages.Page, now time.Time) (goalProgress, bool, error) {
+	goal, ok, err := src.Goal(src.GlobalConfig)
+	if err != nil || !ok {
+		return goalProgress{}, false, err
+	}
+
+	weekStart, err := src.WeekStart(src.GlobalConfig)
+	if err != nil {
+		return goalProgress{}, false, err
+	}
+
+	loc, err := configuredLocation()
+	if err != nil {
+		return goalProgress{}, false, err
+	}
+
+	counts := periodCounts(pageSet, now, loc, goal.Cadence, weekStart)
+
+	return measureGoal(goal, counts), true, nil
+}
+
+// reportGoal writes out how the current period of the writing goal is going,
+// at the end of a build, when goal.buildSummary is set
+func reportGoal(pageSet []*pages.Page) error {
+	progress, ok, err := configuredGoalProgress(pageSet, pages.Now())
+	if err != nil || !ok || !progress.goal.BuildSummary {
+		return err
+	}
+
+	src.Info(progress.currentLine())
+
+	return nil
+}
+
+// currentLine returns the line that says how the current period is going,
+// like "Goal: 1 of 2 this week"
+func (progress goalProgress) currentLine() string {
+	names := goalPeriodNames[progress.goal.Cadence]
+
+	return fmt.Sprintf(statusGoalCurrent, progress.current, progress.goal.Count, names[2])
+}
+
+// summary returns the lines that til stats writes out about the goal: the
+// current period, the hit rate, once there's a period before the current
+// one, and the longest run of periods that met it
+func (progress goalProgress) summary() []string {
+	lines := []string{progress.currentLine()}
+
+	if progress.periods > 0 {
+		rate := progress.met * 100 / progress.periods
+		lines = append(lines, fmt.Sprintf(statusGoalHitRate, progress.met, pluralPeriods(progress.periods, progress.goal.Cadence), rate))
+	}
+
+	return append(lines, fmt.Sprintf(statusGoalRun, pluralPeriods(progress.longestRun, progress.goal.Cadence)))
+}
+
+// json returns how the goal is going, for the --json flag
+func (progress goalProgress) json() *src.JSONGoal {
+	return &src.JSONGoal{
+		Cadence:    progress.goal.Cadence,
+		Count:      progress.goal.Count,
+		Current:    progress.current,
+		Met:        progress.met,
+		Periods:    progress.periods,
+		LongestRun: progress.longestRun,
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// pluralPeriods returns the number of periods of the cadence, like "1 week"
+// or "3 weeks"
+func pluralPeriods(count int, cadence string) string {
+	names := goalPeriodNames[cadence]
+
+	if count == 1 {
+		return "1 " + names[0]
+	}
+
+	return fmt.Sprintf("%d %s", count, names[1])
+}
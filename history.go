@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// defaultHistoryVersions is the number of versions of each page that are
+	// kept without the historyVersions config
+	defaultHistoryVersions = 10
+
+	// historyTimeFormat is how til history writes when each version is from
+	historyTimeFormat = "2006-01-02 15:04:05"
+
+	errRestoreVersion  = "til restore needs the --version to bring back. til history %s lists them"
+	errVersionNotFound = "there's no version %d of %s. til history lists the ones there are"
+	statusHistoryNone  = "there are no earlier versions of %s"
+	statusPageRestored = "restored %s to the version from %s"
+	statusRestoreKept  = "the version it replaced is in the history too, so it can be brought back"
+)
+
+// historyDir is the directory in the docs directory that the earlier versions
+// of the pages are kept in. Being hidden, and without pages' extensions, the
+// versions are never loaded as pages
+var historyDir = filepath.Join(".til", "history")
+
+// pageVersion is an earlier version of a page, kept in the history. The
+// version is the Unix time that it was replaced at
+type pageVersion struct {
+	version  int64
+	filePath string
+}
+
+// runHistory lists the earlier versions of the page that matches the query,
+// newest first, that til kept when it rewrote the page.
+// Example:
+//
+//	> til history go contexts
+func runHistory(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("history", flag.ContinueOnError)
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	loc, err := configuredLocation()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	versions, err := pageVersions(page.FilePath)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if len(versions) == 0 {
+		src.Info(fmt.Sprintf(statusHistoryNone, page.FilePath))
+		return
+	}
+
+	for _, version := range versions {
+		fmt.Printf("%d  %s\n", version.version, time.Unix(version.version, 0).In(loc).Format(historyTimeFormat))
+	}
+}
+
+// runRestore brings back an earlier version of the page that matches the
+// query, from the history. --version is which one, as til history lists
+// them. The version that's there now goes into the history first.
+// Example:
+//
+//	> til restore go contexts --version 1715677200
+func runRestore(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	version := flags.Int64("version", 0, "the version to bring back, as til history lists it")
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	if *version == 0 {
+		src.Defeat(&src.UsageError{Err: fmt.Errorf(errRestoreVersion, query)})
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	loc, err := configuredLocation()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	err = restoreVersion(page.FilePath, *version)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(fmt.Sprintf(statusPageRestored, page.FilePath, time.Unix(*version, 0).In(loc).Format(historyTimeFormat)))
+	src.Progress(statusRestoreKept)
+}
+
+// rewritePage writes the data over a page that's already there, keeping what
+// it was in the history first. It's how the commands that change pages, like
+// til tag and til migrate, write them. Nothing's kept when nothing changes
+func rewritePage(filePath string, data []byte) error {
+	current, err := fileSystem.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err == nil && !bytes.Equal(current, data) {
+		err = keepVersion(filePath, current, pages.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	return fileSystem.WriteFile(filePath, data, 0644)
+}
+
+// restoreVersion writes the version of the page back over it
+func restoreVersion(filePath string, version int64) error {
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	versions, err := pageVersions(filePath)
+	if err != nil {
+		return err
+	}
+
+	for _, kept := range versions {
+		if kept.version != version {
+			continue
+		}
+
+		data, err := fileSystem.ReadFile(kept.filePath)
+		if err != nil {
+			return err
+		}
+
+		return rewritePage(filePath, data)
+	}
+
+	return fmt.Errorf(errVersionNotFound, version, filePath)
+}
+
+// pageVersions returns the versions of the page in the history, newest first
+func pageVersions(filePath string) ([]pageVersion, error) {
+	prefix, err := versionPathPrefix(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := fileSystem.Glob(filepath.Join(filepath.Dir(prefix), "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []pageVersion{}
+
+	for _, match := range matches {
+		if !strings.HasPrefix(match, prefix) {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[len(prefix):], 10, 64)
+		if err == nil {
+			versions = append(versions, pageVersion{version: version, filePath: match})
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i].version > versions[j].version })
+
+	return versions, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// keepVersion puts the data into the history as the version of the page from
+// now, then prunes the oldest versions past the historyVersions config. Two
+// versions from the same second get the next second that's free
+func keepVersion(filePath string, data []byte, now time.Time) error {
+	max := src.GlobalConfig.UInt("historyVersions", defaultHistoryVersions)
+	if max <= 0 {
+		return nil
+	}
+
+	prefix, err := versionPathPrefix(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = fileSystem.MkdirAll(filepath.Dir(prefix), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	version := now.Unix()
+	versionPath := ""
+
+	for {
+		versionPath = fmt.Sprintf("%s%d", prefix, version)
+
+		if _, err := fileSystem.Stat(versionPath); err != nil {
+			break
+		}
+
+		version++
+	}
+
+	err = fileSystem.WriteFile(versionPath, data, 0644)
+	if err != nil {
+		return err
+	}
+
+	versions, err := pageVersions(filePath)
+	if err != nil {
+		return err
+	}
+
+	for len(versions) > max {
+		err = fileSystem.Remove(versions[len(versions)-1].filePath)
+		if err != nil {
+			return err
+		}
+
+		versions = versions[:len(versions)-1]
+	}
+
+	return nil
+}
+
+// versionPathPrefix returns what the paths of the page's versions in the
+// history start with, before the version. The history has the same
+// directories as the docs directory, so that pages with the same name in
+// different year directories keep their versions apart
+func versionPathPrefix(filePath string) (string, error) {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return "", err
+	}
+
+	relPath, err := filepath.Rel(tDir, filePath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		relPath = filepath.Base(filePath)
+	}
+
+	return filepath.Join(tDir, historyDir, relPath) + ".", nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errHookFailed  = "the %s hook failed: %s"
+	errHookTimeout = "timed out after %s"
+
+	// The number of seconds a hook gets to finish
+	defaultHookTimeout = 60
+
+	statusHookRun = "running the %s hook"
+)
+
+// hookNames are the names in the config of the hooks that come before and
+// after each action
+var hookNames = map[string][2]string{
+	src.ActionBuild: {"preBuild", "postBuild"},
+	src.ActionNew:   {"preNew", "postNew"},
+}
+
+// hookRunner runs a hook's shell command in the given directory, with the
+// given extra environment variables, and returns what it wrote to stdout and
+// stderr. The command is stopped if it takes longer than the timeout
+type hookRunner func(dir string, env []string, timeout time.Duration, command string) ([]byte, error)
+
+// runHookCommand is the hookRunner that til uses. Tests swap it out so that
+// they can see which hooks would be run
+var runHookCommand hookRunner = func(dir string, env []string, timeout time.Duration, command string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	var out bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, shell, flag, command)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	err := cmd.Start()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	// Anything the hook started in the background can keep its output open
+	// after the hook itself has been stopped, so don't wait around for that
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err = <-done:
+		return out.Bytes(), err
+	case <-ctx.Done():
+		return []byte{}, fmt.Errorf(errHookTimeout, timeout)
+	}
+}
+
+// runPreHook runs the hook that comes before an action (e.g.: preBuild). If
+// the hook fails, til stops, so the action never happens
+func runPreHook(action, filePath string) {
+	err := runHook(hookNames[action][0], action, filePath)
+	if err != nil {
+		src.Defeat(err)
+	}
+}
+
+// runPostHook runs the hook that comes after an action (e.g.: postBuild). The
+// action has already happened by then, so a failed hook is only a warning
+func runPostHook(action, filePath string) {
+	err := runHook(hookNames[action][1], action, filePath)
+	if err != nil {
+		src.Warn(err.Error())
+	}
+}
+
+// runHook runs the named hook from the config, if there is one. It runs in
+// the target directory, and is told about the action through environment
+// variables:
+//
+//	TIL_ACTION: the action, e.g. new or build
+//	TIL_DIR:    the docs directory
+//	TIL_FILE:   the page the action is for, if there is one
+func runHook(name, action, filePath string) error {
+	command := strings.TrimSpace(src.GlobalConfig.UString("hooks."+name, ""))
+	if command == "" {
+		return nil
+	}
+
+	src.Info(fmt.Sprintf(statusHookRun, name))
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, false)
+	if err != nil {
+		return err
+	}
+
+	docsDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return err
+	}
+
+	env := []string{
+		"TIL_ACTION=" + action,
+		"TIL_DIR=" + docsDir,
+		"TIL_FILE=" + filePath,
+	}
+
+	timeout := time.Duration(src.GlobalConfig.UInt("hooks.timeout", defaultHookTimeout)) * time.Second
+
+	out, err := runHookCommand(tDir, env, timeout, command)
+
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line != "" {
+			src.Progress(line)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf(errHookFailed, name, err)
+	}
+
+	return nil
+}
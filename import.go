@@ -0,0 +1,589 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	errImportDir   = "til import needs the directory of notes, or the bookmarks file, to import"
+	errImportKinds = "only one of --bookmarks, --notion, and --obsidian can be used at a time"
+	errImportSelf  = "%s is the docs directory, so there's nothing to import"
+
+	statusImport        = "importing notes from %s"
+	statusImportLossy   = "%s: %s"
+	statusImportSkipped = "skipped %s"
+)
+
+// importOptions defines how til import turns notes into pages
+type importOptions struct {
+	// Folder picks a single folder of bookmarks, which is imported as one page
+	Folder string
+
+	// Notion reads the directory as a Notion export: the IDs are taken off
+	// the names, the property blocks and databases become front-matter, and
+	// the links between pages and to pictures are pointed at the imports
+	Notion bool
+
+	// Obsidian reads the directory as an Obsidian vault: its settings and
+	// templates are left out, and wiki links are turned into Markdown links
+	Obsidian bool
+
+	// PageOptions name the page files, like they do for new pages
+	PageOptions pages.PageOptions
+
+	// Tags are given to every imported page. Without them, pages are tagged
+	// with the sub-directory their note was in
+	Tags []string
+}
+
+// importPlan is what til import is going to do
+type importPlan struct {
+	// duplicates are how many bookmarks were skipped for already having pages
+	duplicates int
+
+	// notes are the notes that are imported
+	notes []*importedNote
+
+	// skipped are the files and directories that aren't imported, and why
+	skipped []string
+}
+
+// importedNote is a note that til import writes into the docs directory
+type importedNote struct {
+	// assets are the files the note links to that are copied into the docs
+	// directory along with it
+	assets []importedAsset
+
+	// body is the Markdown after the front-matter
+	body string
+
+	// frontMatter is the page's front-matter, including its separators
+	frontMatter string
+
+	// lossy says what didn't survive the conversion, for the import's report
+	lossy []string
+
+	// notes say how the note was converted, for the import's report
+	notes []string
+
+	// page is the page the note becomes, including the path it's written to
+	page *pages.Page
+
+	// source is the path of the note that was imported
+	source string
+}
+
+// runImport turns a directory of Markdown notes into pages in the docs
+// directory, giving them front-matter and timestamped filenames. Notes that
+// are already til pages are copied over with their front-matter normalized.
+// With --bookmarks, it turns the bookmarks a browser exported into pages
+// instead. Example:
+//
+//	> til import ~/notes --tags imported --dry-run
+//	> til import --obsidian ~/vault
+//	> til import --notion ~/Downloads/Export-1a2b3c
+//	> til import --bookmarks bookmarks.html --folder TIL
+func runImport(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	bookmarks := flags.Bool("bookmarks", false, "imports the bookmarks in a browser's bookmarks export")
+	dryRun := flags.Bool("dry-run", false, "lists the pages that would be written without writing them")
+	folder := flags.String("folder", "", "imports just this folder of bookmarks, as a single page")
+	notion := flags.Bool("notion", false, "imports a Notion Markdown export, converting its properties and links")
+	obsidian := flags.Bool("obsidian", false, "imports an Obsidian vault, converting its wiki links")
+	tagsStr := flags.String("tags", "", "comma-separated tags to give every imported page, instead of its sub-directory")
+	positional := parseInterspersed(flags, args)
+
+	if len(positional) != 1 {
+		src.Defeat(&src.UsageError{Err: errors.New(errImportDir)})
+	}
+
+	if countTrue(*bookmarks, *notion, *obsidian) > 1 {
+		src.Defeat(&src.UsageError{Err: errors.New(errImportKinds)})
+	}
+
+	tags := parseTags(*tagsStr)
+
+	err := validateNewTags(tags)
+	if err != nil {
+		src.Defeat(&src.UsageError{Err: err})
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(fmt.Sprintf(statusImport, positional[0]))
+
+	if !*dryRun {
+		unlock, err := lockTargetDocs()
+		if err != nil {
+			src.Defeat(err)
+		}
+		defer unlock()
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	opts := importOptions{
+		Folder:   *folder,
+		Notion:   *notion,
+		Obsidian: *obsidian,
+		PageOptions: pages.PageOptions{
+			DateFormat:    src.GlobalConfig.UString("filenameDateFormat", ""),
+			FS:            fileSystem,
+			OmitDate:      !src.GlobalConfig.UBool("filenameDatePrefix", true),
+			ReservedNames: generatedPageNames(pageSet),
+			SlugMaxLength: src.GlobalConfig.UInt("slugMaxLength", defaultSlugMaxLength),
+		},
+		Tags: tags,
+	}
+
+	var plan *importPlan
+	if *bookmarks {
+		plan, err = planBookmarks(ctx, positional[0], tDir, opts, pageSet)
+	} else {
+		plan, err = planImport(ctx, positional[0], tDir, opts)
+	}
+
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	for _, note := range plan.notes {
+		src.Progress(fmt.Sprintf("%s -> %s (%s)", note.source, note.page.FilePath, strings.Join(note.notes, ", ")))
+
+		for _, lost := range note.lossy {
+			src.Warn(fmt.Sprintf(statusImportLossy, note.source, lost))
+		}
+
+		if *dryRun {
+			continue
+		}
+
+		err = pages.WriteNewFile(fileSystem, note.page.FilePath, note.content(), 0644)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		err = copyAssets(note.assets)
+		if err != nil {
+			src.Defeat(err)
+		}
+	}
+
+	for _, skipped := range plan.skipped {
+		src.Warn(fmt.Sprintf(statusImportSkipped, skipped))
+	}
+
+	if plan.duplicates > 0 {
+		src.Info(fmt.Sprintf(statusImportDuplicates, plan.duplicates))
+	}
+
+	verb := "imported"
+	if *dryRun {
+		verb = "would be imported"
+	}
+
+	src.Info(fmt.Sprintf("%d notes %s", len(plan.notes), verb))
+}
+
+// planImport works out the page that each Markdown note in sourceDir, and its
+// sub-directories, becomes in docsDir. Hidden files and directories are left
+// out. Nothing is written
+func planImport(ctx context.Context, sourceDir, docsDir string, opts importOptions) (*importPlan, error) {
+	if samePath(sourceDir, docsDir) {
+		return nil, &src.UsageError{Err: fmt.Errorf(errImportSelf, sourceDir)}
+	}
+
+	plan := &importPlan{notes: []*importedNote{}, skipped: []string{}}
+
+	templatesDir := ""
+	if opts.Obsidian {
+		templatesDir = obsidianTemplatesDir(sourceDir)
+	}
+
+	var export *notionExport
+	if opts.Notion {
+		var err error
+
+		// The pages' properties can be in the databases' CSVs, which
+		// might come after the pages
+		export, err = readNotionExport(sourceDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Names are reserved as they're handed out, so that two notes with the
+	// same title don't get the same file
+	opts.PageOptions.ReservedNames = append([]string{}, opts.PageOptions.ReservedNames...)
+
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if filePath == sourceDir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		switch {
+		case info.IsDir() && opts.Obsidian && info.Name() == obsidianConfigDir:
+			plan.skipped = append(plan.skipped, fmt.Sprintf("%s (Obsidian's settings)", filePath))
+			return filepath.SkipDir
+		case info.IsDir() && strings.EqualFold(rel, templatesDir):
+			plan.skipped = append(plan.skipped, fmt.Sprintf("%s (Obsidian's templates)", filePath))
+			return filepath.SkipDir
+		case strings.HasPrefix(info.Name(), ".") && info.IsDir():
+			return filepath.SkipDir
+		case strings.HasPrefix(info.Name(), "."), info.IsDir():
+			return nil
+		case opts.Notion && filepath.Ext(filePath) != "."+pages.FileExtension:
+			// The databases and the pages' pictures were read with the export
+			return nil
+		case filepath.Ext(filePath) != "."+pages.FileExtension:
+			plan.skipped = append(plan.skipped, fmt.Sprintf("%s (not a Markdown note)", filePath))
+			return nil
+		}
+
+		var note *importedNote
+		if opts.Notion {
+			note, err = importNotionNote(filePath, path.Dir(rel), info, docsDir, export, opts)
+		} else {
+			note, err = importNote(filePath, path.Dir(rel), info, docsDir, opts)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		opts.PageOptions.ReservedNames = append(opts.PageOptions.ReservedNames, pageFileName(note.page))
+
+		plan.notes = append(plan.notes, note)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Obsidian {
+		convertWikiLinks(sourceDir, plan.notes)
+	}
+
+	if opts.Notion {
+		plan.skipped = append(plan.skipped, convertNotionLinks(export, plan.notes, docsDir)...)
+	}
+
+	return plan, nil
+}
+
+// importNote works out the page that a single note becomes. relDir is the
+// note's directory within the directory being imported, with forward slashes
+func importNote(filePath, relDir string, info os.FileInfo, docsDir string, opts importOptions) (*importedNote, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, body, hasFrontMatter, err := pages.SplitFrontMatter(data)
+	if err != nil {
+		return nil, &src.ParseError{FilePath: filePath, Err: err}
+	}
+
+	note := &importedNote{assets: []importedAsset{}, lossy: []string{}, notes: []string{}, page: &pages.Page{}, source: filePath}
+
+	// A note that's already a til page only needs its front-matter tidying.
+	// Obsidian notes always need their links converting
+	if !opts.Obsidian && hasFrontMatter && frontMatterString(meta, "title") != "" && frontMatterString(meta, "date") != "" {
+		return copyTilPage(note, data, docsDir, opts)
+	}
+
+	note.page.Title, body = importedTitle(filePath, frontMatterString(meta, "title"), body, &note.notes)
+	note.page.Date = importedDate(meta, info, &note.notes)
+
+	tags := importedTags(meta, opts.Obsidian)
+	switch {
+	case len(opts.Tags) > 0:
+		tags = append(tags, opts.Tags...)
+	case relDir != ".":
+		tags = append(tags, relDir)
+	}
+
+	note.page.Source = frontMatterString(meta, "source")
+
+	err = placeNote(note, tags, body, docsDir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range meta {
+		if key := fmt.Sprintf("%v", item.Key); !importedKeys[key] {
+			note.lossy = append(note.lossy, fmt.Sprintf("front-matter %s was left out", key))
+		}
+	}
+
+	return note, nil
+}
+
+// placeNote tags the note's page, names its file after its title and date,
+// and gives it front-matter and the body
+func placeNote(note *importedNote, tags []string, body, docsDir string, opts importOptions) error {
+	note.page.TagsStr = pages.TagsString(strings.Join(tags, ", "))
+	if len(tags) > 0 {
+		note.notes = append(note.notes, fmt.Sprintf("tagged %s", strings.Join(tags, ", ")))
+	}
+
+	date, _ := pages.ParseDate(note.page.Date)
+	name := pages.FileName(note.page.Title, date, opts.PageOptions)
+	filePath, err := pages.FreeFilePathFS(fileSystemOf(opts), docsDir, name, opts.PageOptions.ReservedNames)
+	if err != nil {
+		return err
+	}
+
+	note.page.FilePath = filePath
+
+	frontMatter, err := note.page.CanonicalFrontMatter()
+	if err != nil {
+		return err
+	}
+
+	note.frontMatter = frontMatter
+	note.body = body
+
+	return nil
+}
+
+// copyTilPage imports a note that is already a til page, keeping its file
+// name if it's free
+func copyTilPage(note *importedNote, data []byte, docsDir string, opts importOptions) (*importedNote, error) {
+	migrated, changes, err := pages.MigrateFrontMatter(data)
+	if err != nil {
+		return nil, &src.ParseError{FilePath: note.source, Err: err}
+	}
+
+	_, body, _, err := pages.SplitFrontMatter(migrated)
+	if err != nil {
+		return nil, &src.ParseError{FilePath: note.source, Err: err}
+	}
+
+	note.frontMatter = string(migrated[:len(migrated)-len(body)])
+	note.body = body
+
+	note.notes = append(note.notes, "already a til page")
+	note.notes = append(note.notes, changes...)
+
+	name := strings.TrimSuffix(filepath.Base(note.source), filepath.Ext(note.source))
+	note.page.FilePath, err = pages.FreeFilePathFS(fileSystemOf(opts), docsDir, name, opts.PageOptions.ReservedNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// importedTitle returns the note's title, from its front-matter, its first
+// heading, or else its filename, and its body. A note without a heading gets
+// one
+func importedTitle(filePath, metaTitle, body string, notes *[]string) (string, string) {
+	body = strings.TrimLeft(body, "\n")
+
+	if metaTitle != "" {
+		*notes = append(*notes, "title from its front-matter")
+		return metaTitle, body
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			*notes = append(*notes, "title from its heading")
+			return strings.TrimSpace(strings.TrimPrefix(line, "# ")), body
+		}
+	}
+
+	name := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	title := strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(name))
+
+	*notes = append(*notes, "title from its filename")
+
+	return title, fmt.Sprintf("# %s\n\n%s", title, body)
+}
+
+// importedDate returns the note's date, from its front-matter or else the
+// time the file was last changed, in RFC3339
+func importedDate(meta yaml.MapSlice, info os.FileInfo, notes *[]string) string {
+	for _, key := range []string{"date", "created"} {
+		if date, ok := pages.ParseDate(frontMatterString(meta, key)); ok {
+			*notes = append(*notes, fmt.Sprintf("date from its front-matter %s", key))
+			return date.Format(time.RFC3339)
+		}
+	}
+
+	*notes = append(*notes, "date from its file time")
+
+	return info.ModTime().Format(time.RFC3339)
+}
+
+// importedTags returns the tags in the note's front-matter, under tags or
+// tag, without any # they're written with. Obsidian tags can't have spaces
+// in them, so in a vault they separate tags too
+func importedTags(meta yaml.MapSlice, obsidian bool) []string {
+	tags := pages.FrontMatterTags(pages.FrontMatterValue(meta, "tags"))
+	tags = append(tags, pages.FrontMatterTags(pages.FrontMatterValue(meta, "tag"))...)
+
+	cleaned := []string{}
+	for _, tag := range tags {
+		fields := []string{tag}
+		if obsidian {
+			fields = strings.Fields(tag)
+		}
+
+		for _, field := range fields {
+			if field = strings.TrimSpace(strings.TrimLeft(field, "#")); field != "" {
+				cleaned = append(cleaned, field)
+			}
+		}
+	}
+
+	return cleaned
+}
+
+// content returns what the page file is written with
+func (note *importedNote) content() []byte {
+	return []byte(note.frontMatter + note.body)
+}
+
+// copyAssets copies the files that a note links to into the docs directory
+func copyAssets(assets []importedAsset) error {
+	for _, asset := range assets {
+		data, err := asset.content()
+		if err != nil {
+			return err
+		}
+
+		err = fileSystem.MkdirAll(filepath.Dir(asset.filePath), os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		err = pages.WriteNewFile(fileSystem, asset.filePath, data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// importedKeys are the front-matter keys that are carried over into pages
+var importedKeys = map[string]bool{"created": true, "date": true, "source": true, "tag": true, "tags": true, "title": true}
+
+// countTrue returns the number of the flags that are set
+func countTrue(flags ...bool) int {
+	count := 0
+	for _, flag := range flags {
+		if flag {
+			count++
+		}
+	}
+
+	return count
+}
+
+// pageFileName returns the name of the page's file, without its extension
+func pageFileName(page *pages.Page) string {
+	return strings.TrimSuffix(filepath.Base(page.FilePath), filepath.Ext(page.FilePath))
+}
+
+// frontMatterString returns the value of the key in the front-matter as a
+// string, or an empty string if it isn't there
+func frontMatterString(meta yaml.MapSlice, key string) string {
+	val := pages.FrontMatterValue(meta, key)
+
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%v", val))
+}
+
+// fileSystemOf returns the filesystem the pages are written to
+func fileSystemOf(opts importOptions) pages.FS {
+	if opts.PageOptions.FS == nil {
+		return pages.OSFS{}
+	}
+
+	return opts.PageOptions.FS
+}
+
+// replaceInProse replaces each match of the regexp in the Markdown with what
+// replace returns for its submatches. Matches in code blocks and inline code
+// are left alone
+func replaceInProse(markdown string, re *regexp.Regexp, replace func(match []string) string) string {
+	lines := strings.Split(markdown, "\n")
+	inCode := false
+
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			continue
+		}
+
+		// Every other part between backticks is inline code
+		parts := strings.Split(line, "`")
+		for j := 0; j < len(parts); j += 2 {
+			parts[j] = re.ReplaceAllStringFunc(parts[j], func(found string) string {
+				return replace(re.FindStringSubmatch(found))
+			})
+		}
+
+		lines[i] = strings.Join(parts, "`")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// samePath returns true if the two paths are the same directory
+func samePath(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+
+	return errA == nil && errB == nil && absA == absB
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// jsonOutput is where the --json flag's JSON is written. It is a variable so
+// that tests can capture it
+var jsonOutput io.Writer = os.Stdout
+
+// writeJSON writes the value out as indented JSON
+func writeJSON(val interface{}) {
+	encoder := json.NewEncoder(jsonOutput)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(val)
+	if err != nil {
+		src.Defeat(err)
+	}
+}
+
+// jsonPage returns the page as it appears in the JSON output
+func jsonPage(page *pages.Page) src.JSONPage {
+	tagNames := []string{}
+
+	for _, tag := range page.Tags() {
+		if tag.Name != "" {
+			tagNames = append(tagNames, tag.Name)
+		}
+	}
+
+	return src.JSONPage{
+		Archived: page.Archived,
+		Author:   strings.TrimSpace(page.Author),
+		Date:     page.Date,
+		Path:     page.FilePath,
+		Tags:     tagNames,
+		Title:    page.Title,
+	}
+}
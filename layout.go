@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errMigrateCollisions = "%d pages can't be moved, because there's already a file where they'd go: %s"
+	errMigrateLayout     = "--layout can only be yearly, not '%s'"
+
+	statusLayoutConfig = "set layout: yearly in the config, or til won't find the pages in their year directories"
+	statusLayoutMove   = "%s -> %s"
+	statusLayoutUndate = "%s has no date, so it stays where it is"
+)
+
+// pageMove is a page file that's moving to another directory
+type pageMove struct {
+	from string
+	to   string
+}
+
+// isYearlyLayout returns true if the layout config keeps the pages in year
+// directories. A broken layout config stops til when the config is loaded
+func isYearlyLayout() bool {
+	layout, _ := src.PageLayout(src.GlobalConfig)
+
+	return layout == src.LayoutYearly
+}
+
+// newPageDir returns the directory that a page created at now goes in: the
+// target directory, or its directory for now's year with the yearly layout
+func newPageDir(tDir string, now time.Time, yearly bool) string {
+	if !yearly {
+		return tDir
+	}
+
+	return filepath.Join(tDir, strconv.Itoa(now.Year()))
+}
+
+// migrateLayout moves the content pages that are directly in the target
+// directory into their year directories, leaving what's in them as it is.
+// Nothing is moved if any of them would land on a file that's already there.
+// With dryRun, it only lists the moves
+func migrateLayout(pageSet []*pages.Page, tDir, layout string, dryRun bool) error {
+	if layout != src.LayoutYearly {
+		return &src.UsageError{Err: fmt.Errorf(errMigrateLayout, layout)}
+	}
+
+	moves, undated := yearlyMoves(pageSet, tDir)
+
+	for _, page := range undated {
+		src.Warn(fmt.Sprintf(statusLayoutUndate, page.FilePath))
+	}
+
+	collisions := moveCollisions(moves)
+	if len(collisions) > 0 {
+		filePaths := []string{}
+		for _, move := range collisions {
+			filePaths = append(filePaths, move.to)
+		}
+
+		return fmt.Errorf(errMigrateCollisions, len(collisions), strings.Join(filePaths, ", "))
+	}
+
+	for _, move := range moves {
+		src.Progress(fmt.Sprintf(statusLayoutMove, move.from, move.to))
+
+		if dryRun {
+			continue
+		}
+
+		err := fileSystem.MkdirAll(filepath.Dir(move.to), os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		err = fileSystem.Rename(move.from, move.to)
+		if err != nil {
+			return err
+		}
+	}
+
+	verb := "moved"
+	if dryRun {
+		verb = "would be moved"
+	}
+
+	src.Info(fmt.Sprintf("%d pages %s", len(moves), verb))
+
+	if !isYearlyLayout() {
+		src.Warn(statusLayoutConfig)
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// yearlyMoves returns where each of the content pages directly in the target
+// directory moves to with the yearly layout, in filename order. Pages without
+// a date can't be put in a year, so they're returned on their own
+func yearlyMoves(pageSet []*pages.Page, tDir string) ([]pageMove, []*pages.Page) {
+	moves := []pageMove{}
+	undated := []*pages.Page{}
+
+	for _, page := range listedPages(pageSet, "") {
+		if filepath.Dir(page.FilePath) != filepath.Clean(tDir) {
+			continue
+		}
+
+		if page.CreatedAt().IsZero() {
+			undated = append(undated, page)
+			continue
+		}
+
+		dir := newPageDir(tDir, page.CreatedAt(), true)
+		moves = append(moves, pageMove{from: page.FilePath, to: filepath.Join(dir, filepath.Base(page.FilePath))})
+	}
+
+	sort.Slice(moves, func(i, j int) bool { return moves[i].from < moves[j].from })
+
+	return moves, undated
+}
+
+// moveCollisions returns the moves that would land on a file that's already
+// there
+func moveCollisions(moves []pageMove) []pageMove {
+	collisions := []pageMove{}
+
+	for _, move := range moves {
+		if _, err := fileSystem.Stat(move.to); err == nil {
+			collisions = append(collisions, move)
+		}
+	}
+
+	return collisions
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	statusBrokenLink = "%s links to %s, which doesn't exist"
+)
+
+var (
+	// linkTargetRegex finds the targets of Markdown links and images, like
+	// [text](target "title"), or [text](<target with spaces>)
+	linkTargetRegex = regexp.MustCompile(`\]\(\s*(?:<([^>]*)>|([^)\s]+))(?:\s+[^)]*)?\)`)
+
+	// linkDefinitionRegex finds the targets of reference links' definitions,
+	// like [text]: target
+	linkDefinitionRegex = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*(?:<([^>]*)>|(\S+))`)
+
+	// linkSchemeRegex finds the scheme of links that go outside the docs
+	// directory, like https: or mailto:
+	linkSchemeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*:`)
+)
+
+// checkLinks adds a warning for each link in a content page to a file in the
+// docs directory that isn't there. Links to other sites, anchors on the same
+// page, and links in code are left alone. It's run once the build has
+// written its pages, so that links to tag pages and the index are found
+func checkLinks(pageSet []*pages.Page, warnings *buildWarnings) {
+	for _, page := range listedPages(pageSet, "") {
+		for _, target := range internalLinks(page.Content) {
+			filePath := filepath.Join(filepath.Dir(page.FilePath), filepath.FromSlash(target))
+
+			if _, err := fileSystem.Stat(filePath); err != nil {
+				warnings.add(warningBrokenLink, fmt.Sprintf(statusBrokenLink, page.FilePath, target))
+			}
+		}
+	}
+}
+
+// internalLinks returns the targets of the links in the page content that
+// are to other files, relative to the page, without their anchors or
+// queries, in the order they're in the page
+func internalLinks(content string) []string {
+	targets := []string{}
+
+	for _, line := range proseLines(content) {
+		matches := linkTargetRegex.FindAllStringSubmatch(line.text, -1)
+		matches = append(matches, linkDefinitionRegex.FindAllStringSubmatch(line.text, -1)...)
+
+		for _, match := range matches {
+			if target, ok := internalLink(match[1] + match[2]); ok {
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	return targets
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// internalLink returns the file that a link target is to, or false if it's
+// to another site, an anchor on the same page, or the root of a site
+func internalLink(target string) (string, bool) {
+	if linkSchemeRegex.MatchString(target) || strings.HasPrefix(target, "/") {
+		return "", false
+	}
+
+	if i := strings.IndexAny(target, "#?"); i >= 0 {
+		target = target[:i]
+	}
+
+	if unescaped, err := url.PathUnescape(target); err == nil {
+		target = unescaped
+	}
+
+	return target, target != ""
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+// runList writes the content pages out to the terminal, newest first. With
+// --query, only the pages that match the query are listed; see
+// til.ParseQuery. Archived pages are left out, unless --archived lists only
+// those. --author lists the pages by an author, matched the way the author
+// pages group them.
+// Example:
+//
+//	> til list --tag go
+//	> til list --query 'tag:go AND NOT tag:til-meta AND after:2024-01-01'
+//	> til list --archived
+//	> til list --author "Zoë Ann"
+func runList(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	archived := flags.Bool("archived", false, "only lists the archived pages")
+	author := flags.String("author", "", "only lists pages by this author, or unattributed for the ones without one")
+	query := flags.String("query", "", "only lists pages that match this query, like 'tag:go AND after:2024-01-01'")
+	relative := flags.Bool("relative", src.GlobalConfig.UBool("relativeDates", false), "writes the dates relative to today, like 3 days ago")
+	tagName := flags.String("tag", "", "only lists pages with this tag (or one of its aliases)")
+	parseFlags(flags, args)
+
+	relativeDates = relative
+	checkRelativeDates()
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if *query != "" {
+		q, err := parseQuery(*query)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		q.SetTagMap(newTagMap(pageSet))
+		pageSet = til.FilterPages(pageSet, q)
+	}
+
+	pageSet = archivedOrNot(pagesByAuthor(pageSet, *author), *archived)
+
+	if jsonFlag {
+		writeJSON(listPagesJSON(pageSet, *tagName))
+		return
+	}
+
+	for _, line := range listPages(pageSet, *tagName) {
+		fmt.Println(line)
+	}
+}
+
+// listPages returns one line per content page, optionally limited to the
+// pages with the given tag
+func listPages(pageSet []*pages.Page, tagName string) []string {
+	lines := []string{}
+
+	for _, page := range listedPages(pageSet, tagName) {
+		lines = append(lines, fmt.Sprintf("%s  %s  (%s)", listDate(page), page.Title, page.FilePath))
+	}
+
+	return lines
+}
+
+// listPagesJSON returns the same pages as listPages, for the --json flag
+func listPagesJSON(pageSet []*pages.Page, tagName string) src.JSONPageList {
+	list := src.JSONPageList{Version: src.JSONVersion, Pages: []src.JSONPage{}}
+
+	for _, page := range listedPages(pageSet, tagName) {
+		list.Pages = append(list.Pages, jsonPage(page))
+	}
+
+	return list
+}
+
+// archivedOrNot returns the pages that are archived, or the ones that aren't
+func archivedOrNot(pageSet []*pages.Page, archived bool) []*pages.Page {
+	kept := []*pages.Page{}
+
+	for _, page := range pageSet {
+		if page.Archived == archived {
+			kept = append(kept, page)
+		}
+	}
+
+	return kept
+}
+
+// listedPages returns the content pages, optionally limited to the pages with
+// the given tag
+func listedPages(pageSet []*pages.Page, tagName string) []*pages.Page {
+	if tagName != "" {
+		pageSet = newTagMap(pageSet).PagesFor(tagName)
+	}
+
+	listed := []*pages.Page{}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() {
+			listed = append(listed, page)
+		}
+	}
+
+	return listed
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errLocked = "another til process is running (pid %d)"
+
+	// The file in the docs directory that is locked while til writes to it
+	lockFileName = ".til.lock"
+
+	// How often a waiting til checks whether the lock has been released
+	lockPollInterval = 100 * time.Millisecond
+
+	statusLockStale = "cleaned up the lock left by til process %d, which is no longer running"
+	statusLockWait  = "waiting for another til process (pid %d) to finish"
+)
+
+// docsLock is an advisory lock on the docs directory, held while til writes
+// to it so that overlapping runs (a cron job and a build by hand, say) don't
+// interleave their output. The lock file holds the pid of the process that
+// has the lock
+type docsLock struct {
+	file *os.File
+	path string
+
+	// stalePID is the process that left the lock file behind without
+	// releasing it, if there was one
+	stalePID int
+}
+
+// lockDocs takes the lock on the docs directory. If another process has it,
+// lockDocs fails straight away unless wait is set, in which case it waits for
+// the lock to be released
+func lockDocs(docsDir string, wait bool) (*docsLock, error) {
+	path := filepath.Join(docsDir, lockFileName)
+	waiting := false
+
+	for {
+		lock, holder, err := tryLock(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if lock != nil {
+			if lock.stalePID != 0 {
+				src.Progress(fmt.Sprintf(statusLockStale, lock.stalePID))
+			}
+
+			return lock, nil
+		}
+
+		if !wait {
+			return nil, fmt.Errorf(errLocked, holder)
+		}
+
+		if !waiting {
+			src.Info(fmt.Sprintf(statusLockWait, holder))
+			waiting = true
+		}
+
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// lockTargetDocs takes the lock on the target directory's docs directory,
+// waiting for it with -wait, and returns the function that releases it.
+// Everything that writes to the docs directory takes the lock first, so it's
+// where a missing docs directory is found, and created with -create-dir
+func lockTargetDocs() (func(), error) {
+	docsDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkDocsDir(docsDir, createDirFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := lockDocs(docsDir, waitFlag)
+	if err != nil {
+		return nil, newDocsDirError(docsDir, err)
+	}
+
+	return lock.release, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// readLockPID returns the pid written in a lock file, or 0 if there isn't one
+func readLockPID(r io.Reader) int {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+
+	return pid
+}
+
+// writeLockPID replaces the contents of the lock file with this process's pid
+func writeLockPID(file *os.File) error {
+	err := file.Truncate(0)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.WriteAt([]byte(fmt.Sprintf("%d\n", os.Getpid())), 0)
+
+	return err
+}
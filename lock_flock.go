@@ -0,0 +1,74 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// tryLock takes the lock with flock, which the operating system releases when
+// the process holding it exits, however it exits. A lock file that exists but
+// isn't locked was left behind by a process that died, and is taken over.
+// If another process holds the lock, tryLock returns its pid instead
+func tryLock(path string) (*docsLock, int, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		holder := readLockPID(file)
+		file.Close()
+
+		return nil, holder, nil
+	}
+
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	// The process that held the lock removes the file when it's done with it,
+	// so if that happened after the file was opened, this lock is on a file
+	// nobody else can see. Start again with the new one
+	if !isLockFile(file, path) {
+		file.Close()
+		return tryLock(path)
+	}
+
+	stalePID := readLockPID(file)
+
+	err = writeLockPID(file)
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	return &docsLock{file: file, path: path, stalePID: stalePID}, 0, nil
+}
+
+// release gives up the lock and removes the lock file. The file is removed
+// before it is unlocked, so that a process waiting on it can tell that it has
+// gone and start again with a new one
+func (lock *docsLock) release() {
+	os.Remove(lock.path)
+	lock.file.Close()
+}
+
+// isLockFile returns true if the open file is still the one at path
+func isLockFile(file *os.File, path string) bool {
+	openInfo, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(openInfo, pathInfo)
+}
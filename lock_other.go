@@ -0,0 +1,98 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+)
+
+// A lock file with no pid in it yet is treated as stale once it's this old
+const lockWriteGrace = time.Second
+
+// tryLock takes the lock by creating the lock file, which fails if it already
+// exists. Without flock the lock isn't released when a process dies, so a lock
+// file whose process is no longer running is removed and taken over.
+// If another process holds the lock, tryLock returns its pid instead
+func tryLock(path string) (*docsLock, int, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		stalePID, isHeld := lockHolder(path)
+		if isHeld {
+			return nil, stalePID, nil
+		}
+
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, 0, err
+		}
+
+		lock, holder, err := tryLock(path)
+		if lock != nil {
+			lock.stalePID = stalePID
+		}
+
+		return lock, holder, err
+	}
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = writeLockPID(file)
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+
+		return nil, 0, err
+	}
+
+	return &docsLock{file: file, path: path}, 0, nil
+}
+
+// release gives up the lock by closing and removing the lock file
+func (lock *docsLock) release() {
+	lock.file.Close()
+	os.Remove(lock.path)
+}
+
+// lockHolder returns the pid in the lock file, and whether that process is
+// still running
+func lockHolder(path string) (int, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, false
+	}
+	defer file.Close()
+
+	pid := readLockPID(file)
+	if pid == 0 {
+		// The process that created it might not have written its pid yet
+		info, err := file.Stat()
+		return 0, err == nil && time.Since(info.ModTime()) < lockWriteGrace
+	}
+
+	return pid, processExists(pid)
+}
+
+// processExists returns true if there is a running process with the pid.
+// FindProcess only fails for a missing process on Windows, so the other
+// systems that get here (Solaris, Plan 9...) are asked through /proc instead
+func processExists(pid int) bool {
+	if runtime.GOOS != "windows" {
+		_, err := os.Stat(fmt.Sprintf("/proc/%d", pid))
+		return err == nil
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	process.Release()
+
+	return true
+}
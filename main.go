@@ -1,72 +1,161 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"runtime"
 	"strings"
-	"sync"
+	"syscall"
 	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/olebedev/config"
 	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
 	"github.com/senorprogrammer/til/src"
 )
 
 const (
 	defaultCommitMsg = "build, save, push"
 
-	defaultEditor = "open"
+	defaultEditor        = "open"
+	defaultWindowsEditor = "notepad"
+
+	// The number of pages a tag needs to get a tag page
+	defaultMinTagCount = 1
+
+	// The maximum length of the title part of a new page's filename
+	defaultSlugMaxLength = 80
+
+	// The file in the docs directory that describes the tags
+	defaultTagDescriptionsFile = "_tags.yml"
 
 	/* -------------------- Messages -------------------- */
 
 	errConfigValueRead = "could not read a required configuration value"
+	errEditorMissing   = "the editor '%s' isn't installed, or isn't on the PATH. Set editor in the config to one that is (ie: editor: \"code --wait\"), or pass -no-edit to write the page without opening it"
+	errIndexLayout     = "unknown indexLayout '%s' in the config. It can be list or years"
+	errIndexSort       = "unknown sortOrder '%s' in the config. It can be newest, alphabetical, or by-tag"
+	errIndexSortLayout = "indexLayout years only works with the newest sortOrder, not %s"
 	errNoTitle         = "title must not be blank"
-
-	statusDone     = "done"
-	statusIdxBuild = "building index page"
-	statusRepoPush = "pushing to remote"
-	statusRepoSave = "saving uncommitted files"
-	statusTagBuild = "building tag pages"
+	errReservedTag     = "'%s' can't be used as a tag because til generates a page with that name"
+
+	statusCanceling    = "stopping, press Ctrl-C again to stop right away"
+	statusDone         = "done"
+	statusIdxBuild     = "building index page"
+	statusPageSkipped  = "skipping %s"
+	statusPageUnedited = "%s is still empty after the editor closed. If your editor runs in the background, add its wait flag to the editor config, ie: \"code --wait\", \"mvim -f\", \"subl -w\", \"gedit -s\", or \"open -W\""
+	statusRepoPush     = "pushing to remote"
+	statusRepoSave     = "saving uncommitted files"
+	statusTagBuild     = "building tag pages"
 )
 
 var (
-	buildFlag     bool
-	listFlag      bool
-	saveFlag      bool
-	targetDirFlag string
+	buildFlag        bool
+	clipboardFlag    bool
+	copyFlag         bool
+	createDirFlag    bool
+	discardEmptyFlag bool
+	fromURLFlag      string
+	jsonFlag         bool
+	keepCaseFlag     bool
+	keepEmptyFlag    bool
+	langFlag         string
+	listFlag         bool
+	noEditFlag       bool
+	noPushFlag       bool
+	pushFlag         bool
+	saveFlag         bool
+	stdinFlag        bool
+	strictFlag       bool
+	tagsFlag         string
+	targetDirFlag    string
+	waitFlag         bool
 )
 
+// reservedNames are the names of the pages that til generates, or may
+// generate, in the docs directory. A top-level tag with one of these names
+// would have its tag page overwrite the generated page, or vice versa
+var reservedNames = []string{"activity", "all", "archive", "changelog", "feed", "graph", "index", "sitemap", "tags"}
+
+// fileSystem is where the pages are read from and the generated pages are
+// written to. It is a variable so that tests can swap in a pages.MemFS
+var fileSystem pages.FS = pages.OSFS{}
+
 func init() {
 	src.LL = log.New(os.Stdout, "", log.LstdFlags|log.Lshortfile)
 
 	flag.BoolVar(&buildFlag, "b", false, "builds the index and tag pages (short-hand)")
 	flag.BoolVar(&buildFlag, "build", false, "builds the index and tag pages")
 
+	flag.BoolVar(&clipboardFlag, "clipboard", false, "fills the new page with the text on the clipboard")
+
+	flag.BoolVar(&copyFlag, "copy", false, "copies the new page's permalink to the clipboard (needs baseURL)")
+
+	flag.BoolVar(&createDirFlag, "create-dir", false, "creates the docs directory if it doesn't exist yet")
+
+	flag.BoolVar(&discardEmptyFlag, "discard-empty", false, "removes a new page that's still empty after the editor closes, without asking")
+
+	flag.StringVar(&fromURLFlag, "from-url", "", "creates a page about a web page, titled with its title unless one is given")
+
+	flag.BoolVar(&jsonFlag, "json", false, "writes the output of list, tags, validate, and new pages as JSON, and everything else to stderr")
+
+	flag.BoolVar(&keepCaseFlag, "keep-case", false, "leaves the title of a new page exactly as typed")
+
+	flag.BoolVar(&keepEmptyFlag, "keep-empty", false, "keeps a new page that's still empty after the editor closes, without asking")
+
+	flag.StringVar(&langFlag, "lang", "", "starts the new page with a code block in the language, which it's tagged with too")
+
 	flag.BoolVar(&listFlag, "l", false, "lists the configured target directories (short-hand)")
 	flag.BoolVar(&listFlag, "list", false, "lists the configured target directories")
 
+	flag.BoolVar(&noEditFlag, "no-edit", false, "writes the new page without opening it in the editor")
+
+	flag.BoolVar(&noPushFlag, "no-push", false, "never pushes an automatic commit, whatever the config says")
+	flag.BoolVar(&pushFlag, "push", false, "pushes an automatic commit to the remote")
+
 	flag.BoolVar(&saveFlag, "s", false, "builds, saves, and pushes (short-hand)")
 	flag.BoolVar(&saveFlag, "save", false, "builds, saves, and pushes")
 
+	flag.BoolVar(&stdinFlag, "stdin", false, "fills the new page with the text piped into til, in the -lang code block if there is one")
+
+	flag.BoolVar(&strictFlag, "strict", false, "fails the build if any page can't be parsed, or has a tag that isn't in allowedTags, or if the build has any warnings")
+
+	flag.StringVar(&tagsFlag, "tags", "", "comma-separated tags to give a new page")
+
 	flag.StringVar(&targetDirFlag, "t", "", "specifies the target directory key (short-hand)")
 	flag.StringVar(&targetDirFlag, "target", "", "specifies the target directory key")
+
+	flag.BoolVar(&waitFlag, "wait", false, "waits for another til process to finish writing, instead of failing")
 }
 
 /* -------------------- Main -------------------- */
 
 func main() {
-	flag.Parse()
+	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+	parseFlags(flag.CommandLine, os.Args[1:])
+
+	// Keep stdout for the JSON, so that scripts can read it as it is
+	if jsonFlag {
+		src.LL.SetOutput(os.Stderr)
+	}
 
 	cnf := &src.Config{}
 	cnf.Load()
 
+	// The config has been validated by now
+	pages.DateFormat, pages.DateLocale, _ = src.DateDisplay(src.GlobalConfig)
+
+	ctx, cancel := cancelOnSignal()
+	defer cancel()
+
 	/* Flaghandling */
 	/* I personally think "flag handling" should be spelled flag-handling
 	   but precedence has been set and we will defer to it.
@@ -79,156 +168,598 @@ func main() {
 	}
 
 	if buildFlag {
-		buildContent()
+		err := lockedBuildContent(ctx)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		autoCommitBuild()
 		src.Victory(statusDone)
 	}
 
 	if saveFlag {
 		commitMsg := determineCommitMessage(src.GlobalConfig, os.Args)
 
-		buildContent()
+		err := lockedBuildContent(ctx)
+		if err != nil {
+			src.Defeat(err)
+		}
+
 		save(commitMsg)
 		push()
 		src.Victory(statusDone)
 	}
 
+	if cmd, ok := commands[flag.Arg(0)]; ok {
+		cmd(ctx, flag.Args()[1:])
+		src.Victory(statusDone)
+	}
+
 	src.BuildTargetDirectory()
 
 	/* Page creation */
 
-	title := parseTitle(targetDirFlag, os.Args)
+	tags := parseTags(tagsFlag)
+
+	if langFlag != "" {
+		err := validateLang(langFlag)
+		if err != nil {
+			src.Defeat(&src.UsageError{Err: err})
+		}
+
+		tags = withLangTag(tags, langFlag)
+	}
+
+	err := validateNewTags(tags)
+	if err != nil {
+		src.Defeat(&src.UsageError{Err: err})
+	}
+
+	title := parseTitle(flag.Args(), keepCaseFlag)
+	opts := pages.PageOptions{Tags: tags}
+
+	if fromURLFlag != "" {
+		title, opts, err = fromURLPage(ctx, fromURLFlag, title, opts)
+		if err != nil {
+			src.Defeat(err)
+		}
+	}
+
+	if clipboardFlag {
+		text, err := clipboardText(clipboard)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		opts.Body = joinBody(opts.Body, text)
+	}
+
+	code := ""
+
+	if stdinFlag {
+		code, err = stdinText(os.Stdin)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		if langFlag == "" {
+			opts.Body = joinBody(opts.Body, code)
+		}
+	}
+
+	// The code goes in a code block, with the cursor in it
+	if langFlag != "" {
+		opts.Body, opts.BodyLine = withCodeBlock(opts.Body, langFlag, code)
+	}
+
 	if title == "" {
 		// Every non-dash argument is considered a part of the title. If there are no arguments, we have no title
 		// Can't have a page without a title
-		src.Defeat(errors.New(errNoTitle))
+		src.Defeat(&src.UsageError{Err: errors.New(errNoTitle)})
 	}
 
-	createNewPage(title)
+	err = createNewPage(ctx, title, opts)
+	if err != nil {
+		src.Defeat(err)
+	}
 
 	src.Victory(statusDone)
 }
 
 /* -------------------- Helper functions -------------------- */
 
-func buildContent() {
-	pages := loadPages()
-	tagMap := buildTagPages(pages)
+// buildContent builds the pages, adding the problems it notices along the
+// way to warnings, and writes them out once it's done. With -strict, any
+// warning fails the build
+func buildContent(ctx context.Context, warnings *buildWarnings) error {
+	runPreHook(src.ActionBuild, "")
 
-	buildIndexPage(pages, tagMap)
-}
+	pages, skipped, err := loadPagesSkipping(ctx)
+	if err != nil {
+		return err
+	}
 
-// buildIndexPage creates the main index.md page that is the root of the site
-func buildIndexPage(pageSet []*pages.Page, tagMap *pages.TagMap) {
-	src.Info(statusIdxBuild)
+	for _, file := range skipped {
+		warnings.add(warningParseSkipped, fmt.Sprintf(statusPageSkipped, file.Err))
+	}
 
-	content := ""
+	if strictFlag {
+		err = checkStrict(pages)
+		if err != nil {
+			return err
+		}
+	}
 
-	// Write the tag list into the top of the index
-	tagLinks := []string{}
+	checkPages(pages, warnings)
 
-	for _, tagName := range tagMap.SortedTagNames() {
-		tags := tagMap.Get(tagName)
-		if len(tags) > 0 {
-			tagLinks = append(tagLinks, tags[0].Link())
-		}
+	gens, err := configuredGenerators(warnings)
+	if err != nil {
+		return err
 	}
 
-	content += strings.Join(tagLinks, ", ")
-	content += "\n"
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return err
+	}
 
-	// Write the page list into the middle of the page
-	content += pagesToHTMLUnorderedList(pageSet)
-	content += "\n"
+	tagMap := newTagMap(pages)
 
-	// Write the footer content into the bottom of the index
-	content += "\n"
-	content += src.Footer()
+	err = til.Generate(ctx, gens, pages, tagMap, tDir)
+	if err != nil {
+		return err
+	}
 
-	// And write the file to disk
-	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	updateSearchIndex(ctx)
+
+	// Pages link to the pages the build writes, so those are checked once
+	// they've been written
+	checkLinks(pages, warnings)
+	checkStaleTagPages(pages, tagMap, tDir, warnings)
+
+	warnings.report()
+
+	err = reportGoal(pages)
 	if err != nil {
-		src.Defeat(err)
+		return err
+	}
+
+	if jsonFlag {
+		writeJSON(warnings.json())
+	}
+
+	if strictFlag && warnings.len() > 0 {
+		return &src.StrictError{Warnings: warnings.len()}
 	}
 
-	filePath := fmt.Sprintf(
-		"%s/index.%s",
-		tDir,
-		pages.FileExtension,
-	)
+	runPostHook(src.ActionBuild, "")
+
+	return nil
+}
 
-	err = ioutil.WriteFile(filePath, []byte(content), 0644)
+// lockedBuildContent builds the pages while holding the lock on the docs
+// directory. The lock is released before anything is committed, so that the
+// lock file never ends up in the repo
+func lockedBuildContent(ctx context.Context) error {
+	unlock, err := lockTargetDocs()
 	if err != nil {
-		src.Defeat(err)
+		return err
+	}
+	defer unlock()
+
+	return buildContent(ctx, newBuildWarnings())
+}
+
+// buildIndexPage creates the main index.md page that is the root of the site,
+// the all.md page when the index doesn't list every page, the archive.md
+// page when some pages are archived, and the author pages when they have
+// authors
+func buildIndexPage(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, tDir string, warnings *buildWarnings) error {
+	src.Info(statusIdxBuild)
+
+	opts, err := buildOptions(pageSet)
+	if err != nil {
+		return err
+	}
+
+	// Write who wrote what above the footer, if asked to
+	if src.GlobalConfig.UBool("authorStats", false) {
+		opts.IndexNote = authorsSummary(pageSet)
+	}
+
+	filePath, err := til.BuildIndex(ctx, tDir, pageSet, tagMap, opts)
+	if err != nil {
+		return err
 	}
 
 	src.Progress(filePath)
+
+	// With an indexLimit, the pages that don't fit on the index are on the
+	// all page
+	filePath, err = til.BuildAllPage(ctx, tDir, pageSet, tagMap, opts)
+	if err != nil {
+		return err
+	}
+
+	if filePath != "" {
+		src.Progress(filePath)
+	}
+
+	// Archived pages are on the archive page, rather than the index
+	filePath, err = til.BuildArchivePage(ctx, tDir, pageSet, tagMap, opts)
+	if err != nil {
+		return err
+	}
+
+	if filePath != "" {
+		src.Progress(filePath)
+	}
+
+	// The index links to each author's page
+	return buildAuthorPages(ctx, pageSet, tDir, opts)
 }
 
 // buildTagPages creates the tag pages, with links to posts tagged with those names
-func buildTagPages(pageSet []*pages.Page) *pages.TagMap {
+func buildTagPages(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, tDir string, warnings *buildWarnings) error {
 	src.Info(statusTagBuild)
 
-	tagMap := pages.NewTagMap(pageSet)
+	opts, err := buildOptions(pageSet)
+	if err != nil {
+		return err
+	}
+
+	report, err := til.BuildTagPages(ctx, tDir, tagMap, opts)
 
-	var wGroup sync.WaitGroup
+	for _, tagName := range report.Reserved {
+		warnings.add(warningReservedTag, fmt.Sprintf(statusReservedTagPage, tagName))
+	}
 
-	for _, tagName := range tagMap.SortedTagNames() {
-		wGroup.Add(1)
+	for _, warning := range report.Warnings {
+		warnings.add(warningTagCollision, warning)
+	}
 
-		go func(tagName string) {
-			defer wGroup.Done()
+	for _, filePath := range report.Removed {
+		warnings.add(warningStaleTagPage, fmt.Sprintf(statusStaleTagPruned, filePath))
+	}
 
-			content := fmt.Sprintf("## %s\n\n", tagName)
+	for _, filePath := range report.Written {
+		src.Progress(filePath)
+	}
 
-			// Write the page list into the middle of the page
-			content += pagesToHTMLUnorderedList(tagMap.PagesFor(tagName))
+	if err != nil {
+		return err
+	}
 
-			// Write the footer content into the bottom of the page
-			content += "\n"
-			content += src.Footer()
+	// The tags page lists the tags that got a tag page
+	filePath, err := til.BuildTagsPage(ctx, tDir, tagMap, opts)
+	if err != nil {
+		return err
+	}
 
-			// And write the file to disk
-			tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
-			if err != nil {
-				src.Defeat(err)
-			}
+	src.Progress(filePath)
 
-			filePath := fmt.Sprintf(
-				"%s/%s.%s",
-				tDir,
-				tagName,
-				pages.FileExtension,
-			)
+	return nil
+}
 
-			err = ioutil.WriteFile(filePath, []byte(content), 0644)
-			if err != nil {
-				src.Defeat(err)
-			}
+// buildOptions returns the options that the index and tag pages are built
+// with, as defined in the configuration. The footer is worked out from the
+// pages, for the entries footerStyle
+func buildOptions(pageSet []*pages.Page) (til.Options, error) {
+	descs, err := loadTagDescriptions()
+	if err != nil {
+		return til.Options{}, err
+	}
 
-			src.Progress(filePath)
-		}(tagName)
+	aliases, err := loadAliases()
+	if err != nil {
+		return til.Options{}, err
+	}
+
+	layout := src.GlobalConfig.UString("indexLayout", til.IndexLayoutList)
+	if layout != til.IndexLayoutList && layout != til.IndexLayoutYears {
+		return til.Options{}, fmt.Errorf(errIndexLayout, layout)
 	}
 
-	wGroup.Wait()
+	order := src.GlobalConfig.UString("sortOrder", til.IndexSortNewest)
+	switch {
+	case order != til.IndexSortNewest && order != til.IndexSortAlphabetical && order != til.IndexSortByTag:
+		return til.Options{}, fmt.Errorf(errIndexSort, order)
+	case order != til.IndexSortNewest && layout == til.IndexLayoutYears:
+		return til.Options{}, fmt.Errorf(errIndexSortLayout, order)
+	}
 
-	return tagMap
+	opts := til.Options{
+		Aliases:           aliases,
+		Descriptions:      descs,
+		FS:                fileSystem,
+		Footer:            siteFooter(pageSet),
+		Icons:             src.TagIcons(src.GlobalConfig),
+		IndexLayout:       layout,
+		IndexLimit:        src.GlobalConfig.UInt("indexLimit", 0),
+		IndexSort:         order,
+		IndexSortFirstTag: src.GlobalConfig.UBool("sortOrderFirstTag", false),
+		IndexTags:         src.GlobalConfig.UBool("indexTags", false),
+		LowercaseTags:     src.GlobalConfig.UBool("lowercaseTags", false),
+		MinTagCount:       src.GlobalConfig.UInt("minTagCount", defaultMinTagCount),
+		OmitTagList:       !src.GlobalConfig.UBool("indexTagList", true),
+		ReservedNames:     reservedNames,
+		TagsPage:          isGeneratorConfigured("tags"),
+		Unattributed:      src.GlobalConfig.UBool("unattributedAuthor", false),
+		Workers:           src.GlobalConfig.UInt("tagPageWorkers", til.DefaultWorkers),
+	}
+
+	return opts, nil
 }
 
-func createNewPage(title string) {
+// createNewPage creates a page with the title, and the tags, body, and source
+// in opts, then opens it in the editor. The rest of opts comes from the
+// configuration
+func createNewPage(ctx context.Context, title string, opts pages.PageOptions) error {
+	if keepEmptyFlag && discardEmptyFlag {
+		return &src.UsageError{Err: errors.New(errEmptyPageFlags)}
+	}
+
+	// Checked before anything's written, rather than leaving an empty
+	// page behind once the editor fails to start
+	if !noEditFlag {
+		err := checkEditor(pages.ConfiguredEditor(defaultEditorFor(runtime.GOOS)))
+		if err != nil {
+			return err
+		}
+	}
+
+	runPreHook(src.ActionNew, "")
+
 	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
 	if err != nil {
-		src.Defeat(err)
+		return err
 	}
 
-	page := pages.NewPage(title, tDir)
+	// The lock is only held while the file is created, not while it's being
+	// edited, which could take a while
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		return err
+	}
 
-	err = page.Open(defaultEditor)
+	pageSet, err := loadPages(ctx)
 	if err != nil {
-		src.Defeat(err)
+		unlock()
+		return err
+	}
+
+	opts.BodyTemplate, err = src.PageBodyTemplate(src.GlobalConfig)
+	if err != nil {
+		unlock()
+		return err
+	}
+
+	opts.DateFormat = src.GlobalConfig.UString("filenameDateFormat", "")
+	opts.FS = fileSystem
+	opts.OmitDate = !src.GlobalConfig.UBool("filenameDatePrefix", true)
+	opts.ReservedNames = newPageReservedNames(pageSet, title, opts.Tags)
+	opts.SlugMaxLength = src.GlobalConfig.UInt("slugMaxLength", defaultSlugMaxLength)
+
+	// With the yearly layout, the page goes in this year's directory
+	pageDir := newPageDir(tDir, pages.Now(), isYearlyLayout())
+
+	err = fileSystem.MkdirAll(pageDir, os.ModePerm)
+	if err != nil {
+		unlock()
+		return err
+	}
+
+	page, err := pages.NewPage(title, pageDir, opts)
+
+	unlock()
+
+	if err != nil {
+		return err
 	}
 
+	if !noEditFlag {
+		written, _ := fileSystem.ReadFile(page.FilePath)
+
+		err = page.OpenAt(defaultEditorFor(runtime.GOOS), page.StubBodyLine())
+		if err != nil {
+			return err
+		}
+
+		// GUI editors return straight away unless they're told to wait, which
+		// would commit the page before anything's been written in it
+		if edited, _ := fileSystem.ReadFile(page.FilePath); isUnedited(written, edited) && isBlankBody(opts.Body, langFlag) {
+			src.Warn(fmt.Sprintf(statusPageUnedited, page.FilePath))
+
+			// A page that's only its heading would clutter the index forever
+			if !keepEmptyPage(os.Stdin, src.LL.Writer(), isTerminal(os.Stdin)) {
+				err = discardPage(page)
+				if err != nil {
+					return err
+				}
+
+				src.Info(fmt.Sprintf(statusPageDiscarded, page.FilePath))
+
+				return nil
+			}
+		}
+	}
+
+	updateSearchIndex(ctx)
+
+	autoCommit(src.CommitInfo{
+		Action:   src.ActionNew,
+		FilePath: repoRelativePath(page.FilePath),
+		Tags:     opts.Tags,
+		Title:    page.Title,
+	}, page.FilePath)
+
+	runPostHook(src.ActionNew, page.FilePath)
+
+	notifyNewPage(ctx, page)
+
 	// Write the page path to the console. This makes it easy to know which file we just created
 	src.Info(page.FilePath)
+
+	printPermalink(page)
+
+	if jsonFlag {
+		writeJSON(newPageJSON(page, src.GlobalConfig.UString("baseURL", "")))
+	}
+
+	return nil
+}
+
+// joinBody adds more to a new page's body, in a paragraph of its own
+func joinBody(body, more string) string {
+	if body == "" {
+		return more
+	}
+
+	return body + "\n" + more
+}
+
+// newPageJSON returns the newly created page, for the --json flag
+func newPageJSON(page *pages.Page, baseURL string) src.JSONNewPage {
+	newPage := src.JSONNewPage{Version: src.JSONVersion, JSONPage: jsonPage(page)}
+
+	if baseURL != "" {
+		newPage.Permalink = page.Permalink(baseURL)
+	}
+
+	return newPage
+}
+
+// generatedPageNames returns the names (without extension) of the pages that
+// a build generates, so that new pages can avoid overwriting them
+func generatedPageNames(pageSet []*pages.Page) []string {
+	names := append([]string{}, reservedNames...)
+
+	// A broken config is reported by the build
+	searches, _ := src.SavedSearches(src.GlobalConfig)
+	for _, search := range searches {
+		names = append(names, pages.Slug(search.Name))
+	}
+
+	for _, tagName := range newTagMap(pageSet).SortedTagNames() {
+		// Child tag pages are written into a sub-directory, so can't collide
+		slug := pages.TagSlug(tagName)
+		if !strings.Contains(slug, pages.TagSeparator) {
+			names = append(names, slug)
+		}
+	}
+
+	return names
+}
+
+// newPageReservedNames returns the names that a new page with the title and
+// tags can't have: the generated pages' names, counting the pages of its own
+// tags, which the next build writes over it otherwise
+func newPageReservedNames(pageSet []*pages.Page, title string, tags []string) []string {
+	newPage := &pages.Page{Title: title, TagsStr: pages.TagsString(strings.Join(tags, ", "))}
+
+	return generatedPageNames(append(append([]*pages.Page{}, pageSet...), newPage))
+}
+
+// isReservedTagName returns true if a tag's page would have the same name as
+// one of the pages that til generates
+func isReservedTagName(tagName string) bool {
+	return til.IsReservedTagName(tagName, reservedNames)
+}
+
+// loadAliases returns the tag aliases defined in the configuration
+func loadAliases() (map[string]string, error) {
+	return src.TagAliases(src.GlobalConfig)
+}
+
+// newTagMap creates a TagMap from the pages, grouping the tags as defined in
+// the configuration
+func newTagMap(pageSet []*pages.Page) *pages.TagMap {
+	// Aliases that can't be read stop til when the config is loaded
+	aliases, _ := loadAliases()
+
+	return til.NewTagMap(pageSet, til.Options{
+		Aliases:       aliases,
+		LowercaseTags: src.GlobalConfig.UBool("lowercaseTags", false),
+	})
+}
+
+// printPermalink writes the page's public URL to the console, if a baseURL is
+// configured, and with -copy also puts it on the clipboard. Not having a
+// clipboard tool isn't worth failing over, since the URL has been printed
+func printPermalink(page *pages.Page) {
+	baseURL := src.GlobalConfig.UString("baseURL", "")
+	if baseURL == "" {
+		return
+	}
+
+	permalink := page.Permalink(baseURL)
+	src.Info(permalink)
+
+	if !copyFlag {
+		return
+	}
+
+	err := copyToClipboard(permalink)
+	if err != nil {
+		src.Warn(fmt.Sprintf("could not copy the permalink: %s", err))
+		return
+	}
+
+	src.Progress("copied to the clipboard")
+}
+
+// cancelOnSignal returns a context that is cancelled when til is interrupted
+// (with Ctrl-C, say) or asked to terminate, so that long operations can stop
+// cleanly rather than being killed part-way through writing a file. A second
+// signal is left to the default handling, which stops til straight away
+func cancelOnSignal() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+			src.Warn(statusCanceling)
+			cancel()
+		case <-ctx.Done():
+		}
+
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
+// defaultEditorFor returns the editor to open new pages in when the user
+// hasn't configured one, for the given operating system (as in runtime.GOOS)
+func defaultEditorFor(goos string) string {
+	if goos == "windows" {
+		return defaultWindowsEditor
+	}
+
+	return defaultEditor
+}
+
+// checkEditor returns an error if the editor, which can have arguments like
+// "code --wait", can't be found. An editor given as a path, like
+// ./bin/edit, is looked for there rather than on the PATH
+func checkEditor(editor string) error {
+	args, err := src.SplitCommandLine(editor)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	_, err = lookPath(args[0])
+	if err != nil {
+		return fmt.Errorf(errEditorMissing, args[0])
+	}
+
+	return nil
 }
 
 // determineCommitMessage figures out which commit message to save the repo with
@@ -262,30 +793,161 @@ func listTargetDirectories(cfg *config.Config) {
 	}
 }
 
-// loadPages reads the page files from disk (in reverse chronological order) and
-// creates Page instances from them
-func loadPages() []*pages.Page {
-	pageSet := []*pages.Page{}
+// loadPages reads the page files from disk and creates Page instances from
+// them, in reverse chronological order of their front-matter dates. A page
+// that can't be parsed is skipped with a warning, unless -strict is set, in
+// which case it's an error
+func loadPages(ctx context.Context) ([]*pages.Page, error) {
+	pageSet, skipped, err := loadPagesSkipping(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	warnSkipped(skipped)
+
+	return pageSet, nil
+}
+
+// loadPagesSkipping is loadPages, except that it returns the pages it skipped
+// instead of warning about them
+func loadPagesSkipping(ctx context.Context) ([]*pages.Page, []til.SkippedFile, error) {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if strictFlag {
+		pageSet, err := til.LoadPagesWithOptions(ctx, fileSystem, tDir, loadOptions())
+		return pageSet, []til.SkippedFile{}, err
+	}
+
+	return til.LoadPagesSkipping(ctx, fileSystem, tDir, loadOptions())
+}
+
+// warnSkipped writes a warning for each page that couldn't be parsed
+func warnSkipped(skipped []til.SkippedFile) {
+	for _, file := range skipped {
+		src.Warn(fmt.Sprintf(statusPageSkipped, file.Err))
+	}
+}
+
+// loadOptions returns which of the files in the target directory are pages
+func loadOptions() til.LoadOptions {
+	return til.LoadOptions{
+		Exclude:    nonPageFilePaths(),
+		Extensions: sourceExtensions(),
+		MaxSize:    maxPageBytes(),
+		YearDirs:   isYearlyLayout(),
+	}
+}
+
+// maxPageBytes returns the size in bytes of the biggest file that's loaded as
+// a page, from the maxPageBytes config
+func maxPageBytes() int64 {
+	return int64(src.GlobalConfig.UInt("maxPageBytes", til.DefaultMaxPageSize))
+}
+
+// sourceExtensions returns the file extensions of the pages, from the
+// sourceExtensions config. Without it, pages are Markdown only
+func sourceExtensions() []string {
+	list, err := src.GlobalConfig.List("sourceExtensions")
+	if err != nil {
+		return []string{pages.FileExtension}
+	}
+
+	exts := []string{}
+	for _, ext := range list {
+		exts = append(exts, strings.TrimSpace(fmt.Sprintf("%v", ext)))
+	}
+
+	return exts
+}
+
+// pageFilePaths returns the paths to all the page files in the target directory
+func pageFilePaths() []string {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	filePaths, err := til.PageFilePathsWithOptions(fileSystem, tDir, til.LoadOptions{Exclude: nonPageFilePaths(), YearDirs: isYearlyLayout()})
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	return filePaths
+}
+
+// nonPageFilePaths returns the paths to the files in the target directory that
+// til uses for its own purposes, like the tag descriptions, which aren't pages
+func nonPageFilePaths() []string {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
 
+	filePaths := append([]string{tagDescriptionsFilePath(), allowedTagsFilePath()}, generatedFilePaths(tDir)...)
+
+	return append(filePaths, savedSearchFilePaths(tDir)...)
+}
+
+// generatedFilePaths returns the paths to the pages that the build writes
+// into the target directory under a fixed name, like the index. Tag pages
+// depend on the tags, so they're told apart by their GeneratedMarker instead
+func generatedFilePaths(tDir string) []string {
+	filePaths := []string{}
+
+	// A broken generators config is reported by the build
+	gens, _ := configuredGenerators(newBuildWarnings())
+
+	for _, gen := range gens {
+		switch gen.Name() {
+		case "activity", "changelog", "graph":
+			filePaths = append(filePaths, filepath.Join(tDir, fmt.Sprintf("%s.%s", gen.Name(), pages.FileExtension)))
+		case "tags":
+			filePaths = append(filePaths, filepath.Join(tDir, fmt.Sprintf("%s.%s", til.TagsPageName, pages.FileExtension)))
+		case "index":
+			filePaths = append(
+				filePaths,
+				filepath.Join(tDir, fmt.Sprintf("index.%s", pages.FileExtension)),
+				filepath.Join(tDir, fmt.Sprintf("%s.%s", til.AllPageName, pages.FileExtension)),
+			)
+		}
+	}
+
+	return filePaths
+}
+
+// tagDescriptionsFilePath returns the path to the file that describes the tags
+func tagDescriptionsFilePath() string {
 	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
 	if err != nil {
 		src.Defeat(err)
 	}
 
-	filePaths, _ := filepath.Glob(
-		fmt.Sprintf(
-			"%s/*.%s",
-			tDir,
-			pages.FileExtension,
-		),
-	)
+	return filepath.Join(tDir, src.GlobalConfig.UString("tagDescriptionsFile", defaultTagDescriptionsFile))
+}
+
+// loadTagDescriptions reads the tag descriptions file. The file is optional,
+// so if it doesn't exist there are simply no descriptions
+func loadTagDescriptions() (pages.TagDescriptions, error) {
+	filePath := tagDescriptionsFilePath()
+
+	data, err := fileSystem.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return pages.TagDescriptions{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
 
-	for i := len(filePaths) - 1; i >= 0; i-- {
-		page := pages.PageFromFilePath(filePaths[i])
-		pageSet = append(pageSet, page)
+	descs, err := pages.ParseTagDescriptions(data)
+	if err != nil {
+		return nil, &src.ParseError{FilePath: filePath, Err: err}
 	}
 
-	return pageSet
+	return descs, nil
 }
 
 // // open tll the OS to open the newly-created page in the editor (as specified in the config)
@@ -302,37 +964,41 @@ func loadPages() []*pages.Page {
 // 	return err
 // }
 
-// pagesToHTMLUnorderedList creates the unordered list of page links that appear
-// on the index and tag pages
-func pagesToHTMLUnorderedList(pageSet []*pages.Page) string {
-	content := ""
-	prevPage := &pages.Page{}
+// parseTags splits the comma-separated tags passed in for a new page
+func parseTags(tagsStr string) []string {
+	tags := []string{}
 
-	for _, page := range pageSet {
-		if !page.IsContentPage() {
-			continue
-		}
-
-		// This breaks the page list up by month
-		if prevPage.CreatedMonth() != page.CreatedMonth() {
-			content += "\n"
+	for _, tag := range strings.Split(tagsStr, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
 		}
+	}
 
-		content += fmt.Sprintf("* %s\n", page.Link())
+	return tags
+}
 
-		prevPage = page
+// parseTitle turns the non-flag arguments into the title of a new page,
+// title-casing it unless keepCase is set
+func parseTitle(args []string, keepCase bool) string {
+	title := strings.Join(args, " ")
+	if keepCase {
+		return title
 	}
 
-	return content
+	return pages.TitleCase(title)
 }
 
-func parseTitle(targetFlag string, args []string) string {
-	titleOffset := 3
-	if targetFlag == "" {
-		titleOffset = 1
+// validateNewTags checks the tags passed in for a new page, before anything
+// is written to disk
+func validateNewTags(tags []string) error {
+	for _, tag := range tags {
+		if isReservedTagName(tag) {
+			return fmt.Errorf(errReservedTag, tag)
+		}
 	}
 
-	return strings.Title(strings.Join(args[titleOffset:], " "))
+	return checkAllowedTags(tags)
 }
 
 // push pushes up to the remote git repo
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	statusMigrate       = "migrating page front-matter"
+	statusMigrateLayout = "moving pages into their directories"
+)
+
+// runMigrate rewrites the front-matter of every page into the current
+// canonical shape. With --tags-to-list, only comma-separated tags are
+// rewritten, as YAML lists. With --dates-from-filenames, pages missing a date
+// get the one their file name starts with, and the ones whose names don't
+// start with one are reported. With --dates-from-git, pages missing a date or
+// modified field get one from the git history. With --layout yearly, the
+// pages are moved into year directories instead, and their front-matter is
+// left alone. Running it a second time is a no-op. Example:
+//
+//	> til migrate --dry-run --dates-from-git
+//	> til migrate --dates-from-filenames
+//	> til migrate --tags-to-list
+//	> til migrate --layout yearly --dry-run
+func runMigrate(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "lists the changes that would be made without writing them")
+	datesFromGitFlag := flags.Bool("dates-from-git", false, "fills in missing date and modified fields from the git history")
+	datesFromFileNames := flags.Bool("dates-from-filenames", false, "fills in missing date fields from the 2006-01-02T15-04-05 that file names start with")
+	tagsToList := flags.Bool("tags-to-list", false, "only rewrites comma-separated tags as YAML lists, leaving the rest of the front-matter as it is")
+	layout := flags.String("layout", "", "moves the pages into the layout's directories (yearly), leaving what's in them as it is")
+	parseFlags(flags, args)
+
+	if *layout != "" {
+		runMigrateLayout(ctx, *layout, *dryRun)
+		return
+	}
+
+	migrate := pages.MigrateFrontMatter
+	if *tagsToList {
+		migrate = pages.ConvertTagsToList
+	}
+
+	src.Info(statusMigrate)
+
+	gitDir := ""
+	if *datesFromGitFlag {
+		gitDir = gitDatesTargetDir()
+	}
+
+	var loc *time.Location
+	if *datesFromFileNames {
+		var err error
+
+		loc, err = configuredLocation()
+		if err != nil {
+			src.Defeat(err)
+		}
+	}
+
+	if !*dryRun {
+		unlock, err := lockTargetDocs()
+		if err != nil {
+			src.Defeat(err)
+		}
+		defer unlock()
+	}
+
+	filePaths := pageFilePaths()
+	migrated := 0
+	undated := []string{}
+
+	for _, filePath := range filePaths {
+		data, err := fileSystem.ReadFile(filePath)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		newData, changes, err := migrate(data)
+		if err != nil {
+			src.Defeat(fmt.Errorf("%s: %w", filePath, err))
+		}
+
+		// The file name's date is when the page was written, so it goes in
+		// before the git history's
+		if loc != nil && pages.HasFrontMatter(newData) {
+			var dated bool
+
+			newData, changes, dated, err = backfillFileNameDate(filePath, newData, changes, loc)
+			if err != nil {
+				src.Defeat(fmt.Errorf("%s: %w", filePath, err))
+			}
+
+			// The git history has a date for it, if there's one to have
+			if !dated && gitDir == "" {
+				undated = append(undated, filePath)
+			}
+		}
+
+		if gitDir != "" && pages.HasFrontMatter(newData) {
+			newData, changes = backfillPageDates(gitDir, filePath, newData, changes)
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		migrated++
+
+		src.Progress(fmt.Sprintf("%s: %s", filePath, strings.Join(changes, ", ")))
+
+		if *dryRun {
+			continue
+		}
+
+		err = rewritePage(filePath, newData)
+		if err != nil {
+			src.Defeat(err)
+		}
+	}
+
+	verb := "migrated"
+	if *dryRun {
+		verb = "would be migrated"
+	}
+
+	for _, filePath := range undated {
+		src.Warn(fmt.Sprintf(statusNoFileNameDate, filePath))
+	}
+
+	src.Info(fmt.Sprintf("%d of %d pages %s", migrated, len(filePaths), verb))
+}
+
+// runMigrateLayout moves the pages into the directories of the layout
+func runMigrateLayout(ctx context.Context, layout string, dryRun bool) {
+	src.Info(statusMigrateLayout)
+
+	if !dryRun {
+		unlock, err := lockTargetDocs()
+		if err != nil {
+			src.Defeat(err)
+		}
+		defer unlock()
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	err = migrateLayout(pageSet, tDir, layout, dryRun)
+	if err != nil {
+		src.Defeat(err)
+	}
+}
+
+// backfillPageDates fills in the page's missing dates from the git history,
+// adding what it changed to changes
+func backfillPageDates(gitDir, filePath string, data []byte, changes []string) ([]byte, []string) {
+	dates, err := datesFromGit(gitDir, filePath)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	data, dateChanges, err := backfillDates(data, dates)
+	if err != nil {
+		src.Defeat(fmt.Errorf("%s: %w", filePath, err))
+	}
+
+	return data, append(changes, dateChanges...)
+}
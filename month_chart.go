@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// defaultChartMonths is the number of months that til stats charts, and
+	// maxChartMonths is the most it can
+	defaultChartMonths = 12
+	maxChartMonths     = 24
+
+	// monthChartLabel is how the months of the chart are labelled
+	monthChartLabel = "Jan 2006"
+
+	errChartMonths = "--months needs to be between 1 and %d"
+)
+
+// barEighths are the block characters that draw the end of a bar, from an
+// eighth of a character wide up to a whole one
+var barEighths = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+
+// monthCount is the number of content pages created in a month
+type monthCount struct {
+	month time.Time
+	count int
+}
+
+// monthCounts returns the number of content pages created in each of the
+// months up to and including now's, which is usually only partway through,
+// oldest first. Every month is there, even the ones without any pages. The
+// pages' dates are looked at in loc
+func monthCounts(pageSet []*pages.Page, now time.Time, loc *time.Location, months int) []monthCount {
+	now = now.In(loc)
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+
+	counts := make([]monthCount, months)
+	for i := range counts {
+		counts[i].month = current.AddDate(0, i-months+1, 0)
+	}
+
+	for _, page := range listedPages(pageSet, "") {
+		created := page.CreatedAt()
+		if created.IsZero() {
+			continue
+		}
+
+		created = created.In(loc)
+		i := (created.Year()-counts[0].month.Year())*12 + int(created.Month()-counts[0].month.Month())
+
+		if i >= 0 && i < months {
+			counts[i].count++
+		}
+	}
+
+	return counts
+}
+
+// bar draws a bar for the count, scaled so that the max count is width
+// characters wide, in eighths of a character. A count that isn't 0 always
+// gets at least an eighth, so that it doesn't look like nothing
+func bar(count, max, width int) string {
+	if count <= 0 || max <= 0 || width <= 0 {
+		return ""
+	}
+
+	eighths := (count*width*8 + max/2) / max
+	if eighths == 0 {
+		eighths = 1
+	}
+
+	full, rest := eighths/8, eighths%8
+
+	drawn := strings.Repeat(string(barEighths[7]), full)
+	if rest > 0 {
+		drawn += string(barEighths[rest-1])
+	}
+
+	return drawn
+}
+
+// monthChart draws a bar chart of the counts, a row for each month, that
+// fits in width characters. The last month is marked as being so far, since
+// it isn't over yet
+func monthChart(counts []monthCount, width int) string {
+	max := 0
+	for _, mc := range counts {
+		if mc.count > max {
+			max = mc.count
+		}
+	}
+
+	labels := make([]string, len(counts))
+	labelWidth := 0
+
+	for i, mc := range counts {
+		labels[i] = src.FormatDate(mc.month, monthChartLabel, pages.DateLocale)
+		if n := len([]rune(labels[i])); n > labelWidth {
+			labelWidth = n
+		}
+	}
+
+	countWidth := len(fmt.Sprintf("%d", max))
+
+	// The label, the count, the spaces between them and the bar, and room
+	// for " so far" after the last count
+	barWidth := width - labelWidth - countWidth - 11
+	if barWidth < 1 {
+		barWidth = 1
+	}
+
+	chart := ""
+
+	for i, mc := range counts {
+		label := labels[i] + strings.Repeat(" ", labelWidth-len([]rune(labels[i])))
+		drawn := bar(mc.count, max, barWidth)
+		padding := strings.Repeat(" ", barWidth-len([]rune(drawn)))
+
+		row := fmt.Sprintf("%s  %s%s  %*d", label, drawn, padding, countWidth, mc.count)
+		if i == len(counts)-1 {
+			row += " so far"
+		}
+
+		chart += strings.TrimRight(row, " ") + "\n"
+	}
+
+	return chart
+}
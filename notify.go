@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errNotifyNoWebhook = "til notify needs notify.webhookURL in the config, to know where to send the notification"
+	errNotifyStatus    = "the webhook answered %s"
+
+	// excerptMaxLength is the most characters of a page that go in its
+	// notification's excerpt
+	excerptMaxLength = 200
+
+	// notifyTimeout is how long the webhook gets to answer
+	notifyTimeout = 10 * time.Second
+
+	statusNotified     = "sent a notification for %s"
+	statusNotifyFailed = "couldn't send a notification for %s: %s"
+)
+
+// excerptLinkRegex matches a Markdown link or image, to keep only its text
+var excerptLinkRegex = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+
+// runNotify sends the notification for the page that matches the query to
+// the notify.webhookURL, as a new page does once it's been written. It's for
+// pages that were written before the webhook was set up, or whose
+// notification didn't get through.
+// Example:
+//
+//	> til notify go contexts
+func runNotify(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("notify", flag.ContinueOnError)
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	webhookURL := src.GlobalConfig.UString("notify.webhookURL", "")
+	if webhookURL == "" {
+		src.Defeat(&src.UsageError{Err: errors.New(errNotifyNoWebhook)})
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	err = notifyPage(ctx, webhookURL, page)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(fmt.Sprintf(statusNotified, page.FilePath))
+}
+
+// notifyNewPage sends the notification for a page that's just been written,
+// if a notify.webhookURL is configured. The page is read again first, for
+// what was written in the editor. The page is there whether the notification
+// gets through or not, so a failure is only a warning
+func notifyNewPage(ctx context.Context, page *pages.Page) {
+	webhookURL := src.GlobalConfig.UString("notify.webhookURL", "")
+	if webhookURL == "" {
+		return
+	}
+
+	written, err := pages.ReadPageFS(fileSystem, page.FilePath)
+	if err == nil {
+		page = written
+	}
+
+	err = notifyPage(ctx, webhookURL, page)
+	if err != nil {
+		src.Warn(fmt.Sprintf(statusNotifyFailed, page.FilePath, err))
+		return
+	}
+
+	src.Progress(fmt.Sprintf(statusNotified, page.FilePath))
+}
+
+// notifyPage sends the page's notification to the webhook
+func notifyPage(ctx context.Context, webhookURL string, page *pages.Page) error {
+	payload, err := src.NotifyPayload(src.GlobalConfig, notifyInfo(page, src.GlobalConfig.UString("baseURL", "")))
+	if err != nil {
+		return err
+	}
+
+	return sendNotification(ctx, webhookURL, payload)
+}
+
+// notifyInfo returns what a notification says about the page. It only has a
+// permalink when a baseURL is configured
+func notifyInfo(page *pages.Page, baseURL string) src.NotifyInfo {
+	info := src.NotifyInfo{
+		Title:   page.Title,
+		Tags:    jsonPage(page).Tags,
+		Excerpt: pageExcerpt(page, excerptMaxLength),
+	}
+
+	if baseURL != "" {
+		info.Permalink = page.Permalink(baseURL)
+	}
+
+	return info
+}
+
+// pageExcerpt returns the first paragraph of the page's writing, as plain
+// text on a single line, cut short at a word at about maxLength characters.
+// Headings and code blocks are skipped, and links are left as their text
+func pageExcerpt(page *pages.Page, maxLength int) string {
+	paragraph := []string{}
+	fence := ""
+
+	for _, line := range strings.Split(page.Content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"), strings.HasPrefix(trimmed, "~~~"):
+			fence = trimmed[:3]
+		case trimmed != "" && !strings.HasPrefix(trimmed, "#"):
+			paragraph = append(paragraph, trimmed)
+			continue
+		}
+
+		// A blank line, a heading, or a code block ends the paragraph
+		if len(paragraph) > 0 {
+			break
+		}
+	}
+
+	return shortenExcerpt(strings.Join(paragraph, " "), maxLength)
+}
+
+// sendNotification posts the payload to the webhook, and turns anything other
+// than success into an error
+func sendNotification(ctx context.Context, webhookURL string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "til")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errNotifyStatus, resp.Status)
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// shortenExcerpt takes the Markdown out of the text, and cuts it short at the
+// last word that fits in maxLength characters
+func shortenExcerpt(text string, maxLength int) string {
+	text = excerptLinkRegex.ReplaceAllString(text, "$1")
+	text = strings.NewReplacer("**", "", "__", "", "`", "").Replace(text)
+	text = strings.Join(strings.Fields(text), " ")
+
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+
+	cut := string(runes[:maxLength])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRight(cut, ",.;:") + "…"
+}
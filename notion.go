@@ -0,0 +1,457 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// importAssetsDir is the directory in the docs directory that the files an
+// imported page links to, like its pictures, are copied into. Each page's
+// files go in a directory of their own, named after the page's file
+const importAssetsDir = "assets"
+
+var (
+	// notionHashRegex matches the ID that Notion puts on the end of the name
+	// of every page, database, and directory it exports
+	notionHashRegex = regexp.MustCompile(`\s+[0-9a-f]{32}$`)
+
+	// notionPropertyRegex matches a line of the property block that Notion
+	// writes under a page's heading, like "Created time: May 14, 2024 9:00 AM"
+	notionPropertyRegex = regexp.MustCompile(`^([A-Z][A-Za-z0-9 ]{0,39}): (.+)$`)
+
+	// notionLinkRegex matches a Markdown link or picture, with the target
+	// that Notion escapes the spaces of
+	notionLinkRegex = regexp.MustCompile(`(!?)\[([^\]]*)\]\(\s*(?:<([^>]*)>|([^)\s]+))\s*\)`)
+)
+
+// notionDateLayouts are the ways Notion writes dates in the property block
+// and the databases' CSVs, depending on the workspace's settings
+var notionDateLayouts = []string{
+	"January 2, 2006 3:04 PM",
+	"January 2, 2006",
+	"2006/01/02 15:04",
+	"2006/01/02",
+	"01/02/2006 3:04 PM",
+	"01/02/2006",
+}
+
+// notionProperties are how the properties of Notion pages are carried over.
+// The other properties, like Status, have nowhere to go in a page
+var notionProperties = map[string]string{
+	"created":      "date",
+	"created time": "date",
+	"date":         "date",
+	"tag":          "tags",
+	"tags":         "tags",
+	"source":       "source",
+	"url":          "source",
+}
+
+// notionProperty is a single property of a Notion page
+type notionProperty struct {
+	name  string
+	value string
+}
+
+// notionExport is what's in a Notion export besides its pages: the
+// properties in its databases' CSVs, and the files its pages can link to
+type notionExport struct {
+	// files are the paths of the files that aren't pages or databases
+	files map[string]bool
+
+	// properties are the properties of the databases' pages, by their lower
+	// case titles
+	properties map[string][]notionProperty
+}
+
+// importedAsset is a file that an imported page links to, which is copied
+// into the docs directory along with it
+type importedAsset struct {
+	source   string
+	filePath string
+}
+
+// readNotionExport reads the databases' CSVs in the export, and finds the
+// files its pages can link to
+func readNotionExport(exportDir string) (*notionExport, error) {
+	export := &notionExport{files: map[string]bool{}, properties: map[string][]notionProperty{}}
+
+	err := filepath.Walk(exportDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case strings.HasPrefix(info.Name(), ".") && info.IsDir() && filePath != exportDir:
+			return filepath.SkipDir
+		case info.IsDir(), strings.HasPrefix(info.Name(), "."):
+			return nil
+		case filepath.Ext(filePath) == ".csv":
+			return readNotionDatabase(filePath, export.properties)
+		case filepath.Ext(filePath) != "."+pages.FileExtension:
+			export.files[filepath.Clean(filePath)] = true
+		}
+
+		return nil
+	})
+
+	return export, err
+}
+
+// importNotionNote works out the page that a page in a Notion export becomes.
+// Its title comes from its heading, or else its file name without the ID,
+// and its date, tags, and source from its property block, or its database's
+// CSV. relDir is the page's directory within the export, with forward slashes
+func importNotionNote(filePath, relDir string, info os.FileInfo, docsDir string, export *notionExport, opts importOptions) (*importedNote, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &importedNote{assets: []importedAsset{}, lossy: []string{}, notes: []string{}, page: &pages.Page{}, source: filePath}
+
+	name := notionName(strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)))
+
+	props, body := splitNotionProperties(string(data))
+	note.page.Title, body = importedTitle(name+"."+pages.FileExtension, "", body, &note.notes)
+
+	// A database's pages have their properties in the CSV too, which
+	// fills in whatever the page leaves out
+	if inCSV := export.properties[strings.ToLower(note.page.Title)]; len(inCSV) > 0 {
+		props = mergeNotionProperties(props, inCSV)
+		note.notes = append(note.notes, "properties from its database")
+	}
+
+	tags := []string{}
+
+	for _, prop := range props {
+		switch notionProperties[strings.ToLower(prop.name)] {
+		case "date":
+			if note.page.Date != "" {
+				continue
+			}
+
+			if date, ok := parseNotionDate(prop.value); ok {
+				note.page.Date = date.Format(time.RFC3339)
+				note.notes = append(note.notes, fmt.Sprintf("date from its %s", prop.name))
+			} else {
+				note.lossy = append(note.lossy, fmt.Sprintf("property %s isn't a date til knows: %s", prop.name, prop.value))
+			}
+		case "tags":
+			tags = append(tags, parseTags(prop.value)...)
+		case "source":
+			note.page.Source = prop.value
+		default:
+			note.lossy = append(note.lossy, fmt.Sprintf("property %s was left out", prop.name))
+		}
+	}
+
+	if note.page.Date == "" {
+		note.page.Date = info.ModTime().Format(time.RFC3339)
+		note.notes = append(note.notes, "date from its file time")
+	}
+
+	switch {
+	case len(opts.Tags) > 0:
+		tags = append(tags, opts.Tags...)
+	case relDir != ".":
+		tags = append(tags, notionPath(relDir))
+	}
+
+	err = placeNote(note, tags, body, docsDir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// convertNotionLinks rewrites the links between the pages of the export to
+// the pages' new file names, and points the links to the export's other
+// files, like pictures, at the copies of them that go into the assets
+// directory. It returns the export's files that no page links to, which
+// aren't imported
+func convertNotionLinks(export *notionExport, notes []*importedNote, docsDir string) []string {
+	imported := map[string]*importedNote{}
+	for _, note := range notes {
+		imported[filepath.Clean(note.source)] = note
+	}
+
+	linked := map[string]bool{}
+
+	for _, note := range notes {
+		note.body = replaceInProse(note.body, notionLinkRegex, func(match []string) string {
+			return notionLink(note, match, export, imported, linked, docsDir)
+		})
+
+		if len(note.assets) > 0 {
+			note.notes = append(note.notes, fmt.Sprintf("%s copied into %s", pluralFiles(len(note.assets)), filepath.Dir(note.assets[0].filePath)))
+		}
+	}
+
+	unlinked := []string{}
+
+	for filePath := range export.files {
+		if !linked[filePath] {
+			unlinked = append(unlinked, fmt.Sprintf("%s (not linked from any page)", filePath))
+		}
+	}
+
+	sort.Strings(unlinked)
+
+	return unlinked
+}
+
+// content returns what the asset is copied with
+func (asset importedAsset) content() ([]byte, error) {
+	return ioutil.ReadFile(asset.source)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// notionLink returns the Markdown for a single link in a Notion page, noting
+// anything that had to be given up on in the note's lossy
+func notionLink(note *importedNote, match []string, export *notionExport, imported map[string]*importedNote, linked map[string]bool, docsDir string) string {
+	embed, text, raw := match[1] == "!", match[2], match[3]+match[4]
+
+	target, ok := internalLink(raw)
+	if !ok {
+		return match[0]
+	}
+
+	filePath := filepath.Clean(filepath.Join(filepath.Dir(note.source), filepath.FromSlash(target)))
+	name := notionPath(filepath.ToSlash(target))
+
+	switch {
+	case imported[filePath] != nil:
+		if strings.Contains(raw, "#") {
+			note.lossy = append(note.lossy, fmt.Sprintf("the link to a block in %s now goes to the page", name))
+		}
+
+		return fmt.Sprintf("[%s](%s)", text, path.Base(filepath.ToSlash(imported[filePath].page.FilePath)))
+	case filepath.Ext(filePath) == ".csv":
+		note.lossy = append(note.lossy, fmt.Sprintf("the link to the database %s is plain text, as its pages are imported without it", name))
+		return text
+	case export.files[filePath]:
+		linked[filePath] = true
+
+		return fmt.Sprintf("%s[%s](%s)", match[1], text, assetLink(note, filePath, docsDir))
+	case embed:
+		note.lossy = append(note.lossy, fmt.Sprintf("the picture %s isn't in the export, so it was left out", name))
+		return text
+	}
+
+	note.lossy = append(note.lossy, fmt.Sprintf("the link to %s isn't imported, so it's plain text", name))
+
+	return text
+}
+
+// assetLink adds the file to the note's assets, if it isn't already one, and
+// returns the link to where it's copied to, relative to the page. Two files
+// with the same name get different copies
+func assetLink(note *importedNote, source, docsDir string) string {
+	dir := filepath.Join(docsDir, importAssetsDir, pageFileName(note.page))
+	ext := filepath.Ext(source)
+	base := strings.TrimSuffix(filepath.Base(source), ext)
+
+	filePath := ""
+
+	for n := 1; filePath == ""; n++ {
+		candidate := filepath.Join(dir, base+ext)
+		if n > 1 {
+			candidate = filepath.Join(dir, fmt.Sprintf("%s-%d%s", base, n, ext))
+		}
+
+		taken := false
+
+		for _, asset := range note.assets {
+			if asset.filePath != candidate {
+				continue
+			}
+
+			if asset.source == source {
+				filePath = candidate
+			}
+
+			taken = true
+		}
+
+		if !taken {
+			note.assets = append(note.assets, importedAsset{source: source, filePath: candidate})
+			filePath = candidate
+		}
+	}
+
+	rel, _ := filepath.Rel(docsDir, filePath)
+
+	return (&url.URL{Path: filepath.ToSlash(rel)}).String()
+}
+
+// splitNotionProperties takes the property block out from under the page's
+// heading, and returns its properties and what's left of the page. Lines that
+// only look like properties are left where they are, unless one of them is a
+// property til carries over
+func splitNotionProperties(markdown string) ([]notionProperty, string) {
+	lines := strings.Split(markdown, "\n")
+	start := 0
+
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+
+	if start < len(lines) && strings.HasPrefix(lines[start], "# ") {
+		start++
+	}
+
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+
+	props := []notionProperty{}
+	end := start
+	known := false
+
+	for ; end < len(lines); end++ {
+		match := notionPropertyRegex.FindStringSubmatch(strings.TrimRight(lines[end], " \r"))
+		if match == nil {
+			break
+		}
+
+		props = append(props, notionProperty{name: match[1], value: strings.TrimSpace(match[2])})
+		known = known || notionProperties[strings.ToLower(match[1])] != ""
+	}
+
+	// The block is a paragraph of its own
+	if !known || (end < len(lines) && strings.TrimSpace(lines[end]) != "") {
+		return []notionProperty{}, markdown
+	}
+
+	for end < len(lines) && strings.TrimSpace(lines[end]) == "" {
+		end++
+	}
+
+	kept := append(append([]string{}, lines[:start]...), lines[end:]...)
+
+	return props, strings.Join(kept, "\n")
+}
+
+// readNotionDatabase reads the properties of a database's pages from its CSV
+// into properties, by the pages' lower case titles. The first column is the
+// title
+func readNotionDatabase(filePath string, properties map[string][]notionProperty) error {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "\ufeff")))
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return &src.ParseError{FilePath: filePath, Err: err}
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	header := rows[0]
+
+	for _, row := range rows[1:] {
+		if len(row) == 0 || strings.TrimSpace(row[0]) == "" {
+			continue
+		}
+
+		props := []notionProperty{}
+		for i := 1; i < len(row) && i < len(header); i++ {
+			if value := strings.TrimSpace(row[i]); value != "" {
+				props = append(props, notionProperty{name: strings.TrimSpace(header[i]), value: value})
+			}
+		}
+
+		title := strings.ToLower(strings.TrimSpace(row[0]))
+		properties[title] = mergeNotionProperties(properties[title], props)
+	}
+
+	return nil
+}
+
+// mergeNotionProperties returns the properties, with the ones from more that
+// they don't have already
+func mergeNotionProperties(props, more []notionProperty) []notionProperty {
+	merged := append([]notionProperty{}, props...)
+
+	for _, prop := range more {
+		had := false
+		for _, existing := range props {
+			had = had || strings.EqualFold(existing.name, prop.name)
+		}
+
+		if !had {
+			merged = append(merged, prop)
+		}
+	}
+
+	return merged
+}
+
+// parseNotionDate reads a date the way Notion writes it, in the local time.
+// A range of dates is read as its start
+func parseNotionDate(value string) (time.Time, bool) {
+	if i := strings.Index(value, "→"); i >= 0 {
+		value = value[:i]
+	}
+
+	value = strings.TrimSpace(value)
+
+	for _, layout := range notionDateLayouts {
+		if date, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return date, true
+		}
+	}
+
+	return pages.ParseDate(value)
+}
+
+// notionName returns the name of a page, database, or directory in a Notion
+// export, without the ID on the end of it
+func notionName(name string) string {
+	return notionHashRegex.ReplaceAllString(name, "")
+}
+
+// notionPath returns the path with the IDs taken out of every part of it
+func notionPath(slashed string) string {
+	parts := strings.Split(slashed, "/")
+	for i, part := range parts {
+		ext := path.Ext(part)
+		if ext != "."+pages.FileExtension && ext != ".csv" {
+			ext = ""
+		}
+
+		parts[i] = notionName(strings.TrimSuffix(part, ext)) + ext
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// pluralFiles returns the number of files, like "1 file" or "3 files"
+func pluralFiles(count int) string {
+	if count == 1 {
+		return "1 file"
+	}
+
+	return fmt.Sprintf("%d files", count)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	// obsidianConfigDir is where Obsidian keeps a vault's settings
+	obsidianConfigDir = ".obsidian"
+
+	// defaultObsidianTemplatesDir is the templates folder used when the vault's
+	// settings don't name one
+	defaultObsidianTemplatesDir = "templates"
+)
+
+// wikiLinkRegex matches Obsidian's wiki links: [[Note]], [[Note|shown text]],
+// [[Note#Heading]], and embeds like ![[picture.png]]
+var wikiLinkRegex = regexp.MustCompile(`(!?)\[\[([^\[\]|]+?)(?:\|([^\[\]]*))?\]\]`)
+
+// obsidianTemplatesDir returns the vault's templates folder, relative to the
+// vault and with forward slashes, from the settings of Obsidian's templates
+// plugin
+func obsidianTemplatesDir(vaultDir string) string {
+	data, err := ioutil.ReadFile(filepath.Join(vaultDir, obsidianConfigDir, "templates.json"))
+	if err != nil {
+		return defaultObsidianTemplatesDir
+	}
+
+	settings := struct {
+		Folder string `json:"folder"`
+	}{}
+
+	if err := json.Unmarshal(data, &settings); err != nil || strings.Trim(settings.Folder, "/") == "" {
+		return defaultObsidianTemplatesDir
+	}
+
+	return strings.Trim(filepath.ToSlash(settings.Folder), "/")
+}
+
+// convertWikiLinks turns the wiki links in the imported notes into Markdown
+// links to the pages they point to. Links to notes that weren't imported
+// can't point anywhere, so they become plain text
+func convertWikiLinks(vaultDir string, notes []*importedNote) {
+	linked := wikiLinkTargets(vaultDir, notes)
+
+	for _, note := range notes {
+		note.body = replaceWikiLinks(note.body, func(embed bool, target, alias string) string {
+			return wikiLink(embed, target, alias, linked, &note.lossy)
+		})
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// wikiLinkTargets returns the imported notes by the names a wiki link can
+// use for them: their path in the vault, or just their name. Like Obsidian,
+// the names are matched in any case
+func wikiLinkTargets(vaultDir string, notes []*importedNote) map[string]*importedNote {
+	linked := map[string]*importedNote{}
+
+	for _, note := range notes {
+		rel, err := filepath.Rel(vaultDir, note.source)
+		if err == nil {
+			linked[wikiLinkKey(filepath.ToSlash(rel))] = note
+		}
+	}
+
+	// A path always wins over a name that's the same
+	for _, note := range notes {
+		key := wikiLinkKey(filepath.Base(note.source))
+		if _, ok := linked[key]; !ok {
+			linked[key] = note
+		}
+	}
+
+	return linked
+}
+
+// wikiLinkKey returns the name a note is found under for a wiki link
+func wikiLinkKey(name string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."+pages.FileExtension))
+}
+
+// replaceWikiLinks replaces each wiki link in the Markdown with what replace
+// returns for it. Links in code are left alone
+func replaceWikiLinks(markdown string, replace func(embed bool, target, alias string) string) string {
+	return replaceInProse(markdown, wikiLinkRegex, func(match []string) string {
+		return replace(match[1] == "!", match[2], match[3])
+	})
+}
+
+// wikiLink returns the Markdown for a single wiki link, noting anything that
+// had to be given up on in lossy
+func wikiLink(embed bool, target, alias string, linked map[string]*importedNote, lossy *[]string) string {
+	// In a table, the | before the alias is escaped
+	target = strings.TrimSuffix(target, `\`)
+
+	name, heading := strings.TrimSpace(target), ""
+	if i := strings.Index(target, "#"); i >= 0 {
+		name, heading = strings.TrimSpace(target[:i]), strings.TrimSpace(target[i+1:])
+	}
+
+	text := strings.TrimSpace(alias)
+	switch {
+	case text != "":
+	case name == "":
+		text = heading
+	case heading != "" && !strings.HasPrefix(heading, "^"):
+		text = fmt.Sprintf("%s > %s", name, heading)
+	default:
+		text = name
+	}
+
+	anchor := ""
+	switch {
+	case strings.HasPrefix(heading, "^"):
+		*lossy = append(*lossy, fmt.Sprintf("the link to block %s in %s now goes to the page", heading, wikiLinkName(name)))
+	case heading != "":
+		anchor = "#" + pages.Slug(heading)
+	}
+
+	if name == "" {
+		if anchor == "" {
+			return text
+		}
+
+		return fmt.Sprintf("[%s](%s)", text, anchor)
+	}
+
+	note, ok := linked[wikiLinkKey(name)]
+	if !ok {
+		if embed {
+			*lossy = append(*lossy, fmt.Sprintf("the embedded %s was left out", name))
+		} else {
+			*lossy = append(*lossy, fmt.Sprintf("the link to %s isn't imported, so it's plain text", name))
+		}
+
+		return text
+	}
+
+	if embed {
+		*lossy = append(*lossy, fmt.Sprintf("the embedded %s is a link instead", name))
+	}
+
+	return fmt.Sprintf("[%s](%s%s)", text, path.Base(filepath.ToSlash(note.page.FilePath)), anchor)
+}
+
+// wikiLinkName is the note a link's name refers to, for the import's report
+func wikiLinkName(name string) string {
+	if name == "" {
+		return "the same note"
+	}
+
+	return name
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errOnThisDayDate = "--date needs a month and day, like 05-14, not '%s'"
+	errTimezone      = "the timezone '%s' in the config isn't one til knows, like Europe/Berlin: %w"
+)
+
+// runOnThisDay writes the content pages that were created on today's month
+// and day in earlier years out to the terminal, grouped by year, newest
+// first. --date looks at another day instead. With --leap, Feb 29 includes
+// the pages from Feb 28 and Mar 1 of the years without one, and Feb 28 in a
+// year without one includes the pages from Feb 29.
+// Example:
+//
+//	> til onthisday --date 05-14
+func runOnThisDay(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("onthisday", flag.ContinueOnError)
+	date := flags.String("date", "", "the month and day to look at instead of today, like 05-14")
+	leap := flags.Bool("leap", false, "match Feb 29 with Feb 28 and Mar 1 in the years without one")
+	parseFlags(flags, args)
+
+	loc, err := configuredLocation()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	today := pages.Now().In(loc)
+	day := monthDay{month: today.Month(), day: today.Day()}
+
+	if *date != "" {
+		day, err = parseMonthDay(*date)
+		if err != nil {
+			src.Defeat(&src.UsageError{Err: err})
+		}
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	found := onThisDay(pageSet, day, today.Year(), loc, *leap)
+
+	if jsonFlag {
+		writeJSON(listPagesJSON(found, ""))
+		return
+	}
+
+	for _, line := range onThisDayLines(found, loc) {
+		fmt.Println(line)
+	}
+}
+
+// monthDay is a day of the year, without the year
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+// The days around Feb 29 that --leap matches it with
+var (
+	feb28 = monthDay{month: time.February, day: 28}
+	feb29 = monthDay{month: time.February, day: 29}
+	mar1  = monthDay{month: time.March, day: 1}
+)
+
+// onThisDay returns the content pages created on the day in the years before
+// year, newest first. Their dates are looked at in loc
+func onThisDay(pageSet []*pages.Page, day monthDay, year int, loc *time.Location, leap bool) []*pages.Page {
+	found := []*pages.Page{}
+
+	for _, page := range listedPages(pageSet, "") {
+		created := page.CreatedAt()
+		if created.IsZero() {
+			continue
+		}
+
+		created = created.In(loc)
+
+		if created.Year() < year && sameDay(created, day, year, leap) {
+			found = append(found, page)
+		}
+	}
+
+	return found
+}
+
+// onThisDayLines returns the pages as til list shows them, under a line with
+// the year they're from
+func onThisDayLines(found []*pages.Page, loc *time.Location) []string {
+	lines := []string{}
+	year := 0
+
+	for _, page := range found {
+		if created := page.CreatedAt().In(loc); created.Year() != year {
+			if year != 0 {
+				lines = append(lines, "")
+			}
+
+			year = created.Year()
+			lines = append(lines, strconv.Itoa(year))
+		}
+
+		lines = append(lines, listPages([]*pages.Page{page}, "")...)
+	}
+
+	return lines
+}
+
+// configuredLocation returns the timezone in the config, or the local one if
+// there isn't one
+func configuredLocation() (*time.Location, error) {
+	name := src.GlobalConfig.UString("timezone", "")
+	if name == "" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf(errTimezone, name, err)
+	}
+
+	return loc, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// parseMonthDay reads a month and day, like 05-14
+func parseMonthDay(raw string) (monthDay, error) {
+	// 2000 was a leap year, so that 02-29 can be parsed
+	date, err := time.Parse("2006-01-02", "2000-"+raw)
+	if err != nil {
+		return monthDay{}, fmt.Errorf(errOnThisDayDate, raw)
+	}
+
+	return monthDay{month: date.Month(), day: date.Day()}, nil
+}
+
+// sameDay returns true if created is on the day. With leap, Feb 29 is also
+// the same day as Feb 28 and Mar 1 of a year without one, and Feb 28 in a
+// year without a Feb 29 is also the same day as Feb 29
+func sameDay(created time.Time, day monthDay, year int, leap bool) bool {
+	createdDay := monthDay{month: created.Month(), day: created.Day()}
+	if createdDay == day {
+		return true
+	}
+
+	if !leap {
+		return false
+	}
+
+	switch day {
+	case feb29:
+		return !isLeapYear(created.Year()) && (createdDay == feb28 || createdDay == mar1)
+	case feb28:
+		return !isLeapYear(year) && createdDay == feb29
+	}
+
+	return false
+}
+
+// isLeapYear returns true if the year has a Feb 29
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
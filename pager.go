@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/senorprogrammer/til/src"
+)
+
+// defaultPager is the pager used when $PAGER isn't set. -R lets the colors
+// of til search's matches through
+const defaultPager = "less -R"
+
+// runPager is how the pager is run, with the output as its input. It is a
+// variable so that tests can tell when the pager is used, without one running
+var runPager = startPager
+
+// pagedWriter holds on to what's written to it until it's closed, then sends
+// it through the pager if there's more of it than fits on the terminal, or
+// straight to out if there isn't
+type pagedWriter struct {
+	buf bytes.Buffer
+	out io.Writer
+
+	// height is the height of the terminal. 0 is for output that never goes
+	// through the pager, because it isn't going to a terminal
+	height int
+}
+
+// newPagedWriter returns a pagedWriter that writes to out, on a terminal with
+// the height. A height of 0 turns the pager off
+func newPagedWriter(out io.Writer, height int) *pagedWriter {
+	return &pagedWriter{out: out, height: height}
+}
+
+// openPager returns where til show and til search write what they find. The
+// pager is only used when stdout is a terminal, and noPager isn't set
+func openPager(noPager bool) *pagedWriter {
+	height := 0
+	if !noPager && isTerminal(os.Stdout) {
+		height, _ = terminalSize()
+	}
+
+	return newPagedWriter(os.Stdout, height)
+}
+
+// Write holds on to the data until the writer is closed
+func (w *pagedWriter) Write(data []byte) (int, error) {
+	return w.buf.Write(data)
+}
+
+// Close writes out everything that was written. When it's too much for the
+// terminal, it goes through the pager, unless the pager isn't installed or
+// won't start, in which case it's written straight out after all
+func (w *pagedWriter) Close() error {
+	if w.isPaged() {
+		if args := findPager(); args != nil && runPager(args, w.buf.Bytes(), w.out) == nil {
+			return nil
+		}
+	}
+
+	_, err := w.out.Write(w.buf.Bytes())
+
+	return err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// isPaged returns true if what's been written needs the pager: it's going to
+// a terminal, and there's more of it than the terminal has lines, leaving one
+// for the prompt
+func (w *pagedWriter) isPaged() bool {
+	return w.height > 0 && bytes.Count(w.buf.Bytes(), []byte("\n")) >= w.height
+}
+
+// findPager returns the pager's command line, from $PAGER or else the
+// defaultPager, with the path of the pager in place of its name. It's nil when
+// the pager isn't installed
+func findPager() []string {
+	line := os.Getenv("PAGER")
+	if line == "" {
+		line = defaultPager
+	}
+
+	args, err := src.SplitCommandLine(line)
+	if err != nil || len(args) == 0 {
+		return nil
+	}
+
+	path, err := lookPath(args[0])
+	if err != nil {
+		return nil
+	}
+
+	return append([]string{path}, args[1:]...)
+}
+
+// startPager runs the pager with the content as its input, and waits for it
+// to be quit. It's only an error if the pager couldn't be started, since by
+// then it's already shown the content
+func startPager(args []string, content []byte, out io.Writer) error {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	cmd.Wait()
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package pages
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Format is a kind of file that pages can be written in
+type Format struct {
+	// Name is what the format is called, for people
+	Name string
+
+	// Extensions are the file extensions of the format, without the dot
+	Extensions []string
+
+	// Lang is the format's name in a fenced code block's info string, and to
+	// pandoc
+	Lang string
+
+	// Rendered is true for the formats that til can render itself. Pages in
+	// the others are linked to as they are
+	Rendered bool
+
+	// meta finds the title, date, and tags of a page that has no YAML
+	// front-matter. Nil means that a file without front-matter isn't a page
+	meta func(content string) pageMeta
+}
+
+// pageMeta is what's found out about a page from its content alone
+type pageMeta struct {
+	date  string
+	tags  []string
+	title string
+}
+
+var (
+	asciiDocAttrRegex  = regexp.MustCompile(`^:([\w-]+):\s*(.*)$`)
+	asciiDocTitleRegex = regexp.MustCompile(`^=\s+(.+)$`)
+	orgHeadingRegex    = regexp.MustCompile(`^\*+\s+(.+)$`)
+	orgKeywordRegex    = regexp.MustCompile(`^#\+(\w+):\s*(.*)$`)
+)
+
+// Formats are the formats that pages can be written in. The first is the one
+// til writes, and the one that files with extensions not listed are read as
+var Formats = []Format{
+	{
+		Name:       "Markdown",
+		Extensions: []string{"md", "markdown"},
+		Lang:       "markdown",
+		Rendered:   true,
+
+		// Markdown files without front-matter are til's own generated pages,
+		// like the index, not pages
+		meta: nil,
+	},
+	{
+		Name:       "AsciiDoc",
+		Extensions: []string{"adoc", "asciidoc"},
+		Lang:       "asciidoc",
+		meta:       asciiDocMeta,
+	},
+	{
+		Name:       "Org",
+		Extensions: []string{"org"},
+		Lang:       "org",
+		meta:       orgMeta,
+	},
+}
+
+// FormatOf returns the format of the page file at filePath, going by its
+// extension. Files with an extension no format has are read as Markdown
+func FormatOf(filePath string) Format {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(filePath), "."))
+
+	for _, format := range Formats {
+		for _, formatExt := range format.Extensions {
+			if ext == formatExt {
+				return format
+			}
+		}
+	}
+
+	return Formats[0]
+}
+
+// Format returns the format the page is written in
+func (page *Page) Format() Format {
+	return FormatOf(page.FilePath)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// readMeta fills in the page from what its format can find in its content,
+// for a page without front-matter. A page without a date is dated when its
+// file last changed
+func (page *Page) readMeta(fsys FS, format Format, content string) {
+	meta := format.meta(content)
+
+	page.Content = content
+	page.TagsStr = TagsString(strings.Join(meta.tags, ", "))
+	page.Title = meta.title
+
+	if date, ok := ParseDate(meta.date); ok {
+		page.Date = date.Format(time.RFC3339)
+		return
+	}
+
+	if info, err := fsys.Stat(page.FilePath); err == nil && !info.ModTime().IsZero() {
+		page.Date = info.ModTime().Format(time.RFC3339)
+	}
+}
+
+// asciiDocMeta reads the document title (= Title) and the :revdate: and
+// :keywords: attributes from the header of an AsciiDoc page, or else takes
+// the title from its first section heading
+func asciiDocMeta(content string) pageMeta {
+	meta := pageMeta{tags: []string{}}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := asciiDocAttrRegex.FindStringSubmatch(line); match != nil {
+			switch strings.ToLower(match[1]) {
+			case "revdate":
+				meta.date = match[2]
+			case "keywords", "tags":
+				meta.tags = splitMetaTags(match[2], ",")
+			}
+
+			continue
+		}
+
+		if match := asciiDocTitleRegex.FindStringSubmatch(line); match != nil && meta.title == "" {
+			meta.title = strings.TrimSpace(match[1])
+		}
+
+		// A section heading (== Title) is only the title if there isn't one
+		if strings.HasPrefix(line, "==") && meta.title == "" {
+			meta.title = strings.TrimSpace(strings.TrimLeft(line, "="))
+		}
+	}
+
+	return meta
+}
+
+// orgMeta reads the #+TITLE, #+DATE, and #+FILETAGS keywords of an Org page,
+// or else takes the title from its first heading
+func orgMeta(content string) pageMeta {
+	meta := pageMeta{tags: []string{}}
+	heading := ""
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := orgKeywordRegex.FindStringSubmatch(line); match != nil {
+			switch strings.ToLower(match[1]) {
+			case "title":
+				meta.title = strings.TrimSpace(match[2])
+			case "date":
+				meta.date = orgDate(match[2])
+			case "filetags":
+				meta.tags = splitMetaTags(match[2], ":")
+			}
+
+			continue
+		}
+
+		if match := orgHeadingRegex.FindStringSubmatch(line); match != nil && heading == "" {
+			heading = strings.TrimSpace(match[1])
+		}
+	}
+
+	if meta.title == "" {
+		meta.title = heading
+	}
+
+	return meta
+}
+
+// orgDate turns an Org timestamp, like <2021-04-26 Mon 09:30>, into a date
+// that ParseDate can read
+func orgDate(raw string) string {
+	fields := strings.Fields(strings.Trim(strings.TrimSpace(raw), "<>[]"))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	date := fields[0]
+	for _, field := range fields[1:] {
+		if strings.Contains(field, ":") {
+			return date + " " + field
+		}
+	}
+
+	return date
+}
+
+// splitMetaTags splits a list of tags on sep, leaving out the empty ones
+func splitMetaTags(raw, sep string) []string {
+	tags := []string{}
+
+	for _, tag := range strings.Split(raw, sep) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,291 @@
+package pages
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// SetFrontMatterTags sets the tags in a page's front-matter to the given
+// list, written in list form. Only the tags field is rewritten; every other
+// line of the file is left exactly as it was. If the page has no tags field,
+// one is added after the date and title
+func SetFrontMatterTags(data []byte, tags []string) ([]byte, error) {
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return data, err
+	}
+
+	tagsLine, err := marshalTagList(tags)
+	if err != nil {
+		return data, err
+	}
+
+	value := strings.TrimPrefix(tagsLine, "tags: ")
+
+	return joinFrontMatter(data, setLine(strings.Split(meta, "\n"), "tags", value), body), nil
+}
+
+// ConvertTagsToList rewrites comma-separated tags in the page's front-matter
+// (tags: go, cli) as a YAML list (tags: [go, cli]). Nothing else in the file
+// is touched, not even the order of the fields. Like MigrateFrontMatter, it
+// returns a list of what changed, which is empty when the tags were already a
+// list, or the page has none or no front-matter at all
+func ConvertTagsToList(data []byte) ([]byte, []string, error) {
+	if !HasFrontMatter(data) {
+		return data, []string{}, nil
+	}
+
+	meta, _, err := splitFrontMatter(data)
+	if err != nil {
+		return data, []string{}, err
+	}
+
+	parsed := yaml.MapSlice{}
+	err = yaml.Unmarshal([]byte(meta), &parsed)
+	if err != nil {
+		return data, []string{}, err
+	}
+
+	if !hasKeyLine(strings.Split(meta, "\n"), "tags") {
+		return data, []string{}, nil
+	}
+
+	val := metaValue(parsed, "tags")
+	if _, isList := val.([]interface{}); isList {
+		return data, []string{}, nil
+	}
+
+	tags := tagNames(val)
+
+	converted, err := SetFrontMatterTags(data, tags)
+	if err != nil {
+		return data, []string{}, err
+	}
+
+	raw := ""
+	if val != nil {
+		raw = fmt.Sprintf("%v", val)
+	}
+
+	change := fmt.Sprintf("tags '%s' converted to [%s]", raw, strings.Join(tags, ", "))
+
+	return converted, []string{change}, nil
+}
+
+// SetMissingFrontMatterField sets a top-level front-matter field to the given
+// value, but only if the page doesn't have the field or has it but empty.
+// The value is written exactly as given, so it has to be valid YAML. A known
+// field (like date) is put in its canonical place, anything else goes at the
+// end. It returns true if the page was changed
+func SetMissingFrontMatterField(data []byte, key, value string) ([]byte, bool, error) {
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return data, false, err
+	}
+
+	parsed := yaml.MapSlice{}
+	err = yaml.Unmarshal([]byte(meta), &parsed)
+	if err != nil {
+		return data, false, err
+	}
+
+	if !isEmptyValue(metaValue(parsed, key)) {
+		return data, false, nil
+	}
+
+	return joinFrontMatter(data, setLine(strings.Split(meta, "\n"), key, value), body), true, nil
+}
+
+// SetFrontMatterField sets a top-level front-matter field to the given value,
+// whether the page has it already or not. The value is written exactly as
+// given, so it has to be valid YAML. Every other line of the file is left as
+// it was
+func SetFrontMatterField(data []byte, key, value string) ([]byte, error) {
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return data, err
+	}
+
+	return joinFrontMatter(data, setLine(strings.Split(meta, "\n"), key, value), body), nil
+}
+
+// RemoveFrontMatterField takes a top-level front-matter field out of the page,
+// along with any lines its value carries on over. Every other line of the file
+// is left as it was, and a page without the field is returned unchanged
+func RemoveFrontMatterField(data []byte, key string) ([]byte, error) {
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return data, err
+	}
+
+	lines := strings.Split(meta, "\n")
+	if !hasKeyLine(lines, key) {
+		return data, nil
+	}
+
+	kept := []string{}
+
+	for i := 0; i < len(lines); i++ {
+		if lineKey(lines[i]) != key {
+			kept = append(kept, lines[i])
+			continue
+		}
+
+		for i+1 < len(lines) && isContinuationLine(lines[i+1]) {
+			i++
+		}
+	}
+
+	return joinFrontMatter(data, kept, body), nil
+}
+
+// HasFrontMatter returns true if the page source starts with front-matter,
+// after any byte order mark, whatever its line endings are
+func HasFrontMatter(data []byte) bool {
+	return strings.HasPrefix(string(normalizeLineEndings(data)), frontMatterHeader)
+}
+
+// FrontMatterField returns the value of a top-level front-matter field, or
+// an empty string if the page doesn't have it
+func FrontMatterField(data []byte, key string) (string, error) {
+	meta, _, err := splitFrontMatter(data)
+	if err != nil {
+		return "", err
+	}
+
+	parsed := yaml.MapSlice{}
+	err = yaml.Unmarshal([]byte(meta), &parsed)
+	if err != nil {
+		return "", err
+	}
+
+	val := metaValue(parsed, key)
+	if isEmptyValue(val) {
+		return "", nil
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%v", val)), nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// setLine sets the key to value in the front-matter lines. A key that is
+// already there keeps its place (and loses any lines its old value carried
+// on over). A known key that isn't is put in its canonical place, anything
+// else goes at the end
+func setLine(lines []string, key, value string) []string {
+	line := fmt.Sprintf("%s: %s", key, value)
+	updated := []string{}
+	placed := false
+
+	// A field that is present but empty keeps its place
+	present := hasKeyLine(lines, key)
+
+	for i := 0; i < len(lines); i++ {
+		existing := lines[i]
+		existingKey := lineKey(existing)
+
+		switch {
+		case present && existingKey == key:
+			for i+1 < len(lines) && isContinuationLine(lines[i+1]) {
+				i++
+			}
+
+			existing = line
+			placed = true
+		case !present && !placed && existingKey != "" && keyRank(existingKey) > keyRank(key):
+			updated = append(updated, line)
+			placed = true
+		}
+
+		updated = append(updated, existing)
+	}
+
+	if !placed {
+		updated = append(updated, line)
+	}
+
+	return updated
+}
+
+// splitFrontMatter returns the front-matter of a page, without the lines
+// around it, and everything after it. Like ReadPage, it reads past a byte
+// order mark and CRLF line endings; joinFrontMatter puts them back
+func splitFrontMatter(data []byte) (string, string, error) {
+	txt := string(normalizeLineEndings(data))
+	if !strings.HasPrefix(txt, frontMatterHeader) {
+		return "", "", errors.New(errMissingFrontMatter)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(txt, frontMatterHeader), frontMatterSeparator, 2)
+	if len(parts) != 2 {
+		return "", "", errors.New(errMissingSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// joinFrontMatter puts a page split by splitFrontMatter back together, with
+// the byte order mark and line endings of its source in data
+func joinFrontMatter(data []byte, lines []string, body string) []byte {
+	return restoreLineEndings(data, []byte(frontMatterHeader+strings.Join(lines, "\n")+frontMatterSeparator+body))
+}
+
+// isContinuationLine returns true if the line is part of the value of the
+// key above it, rather than a key of its own
+func isContinuationLine(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "-")
+}
+
+// lineKey returns the key of a top-level front-matter line, or an empty
+// string if the line doesn't start a key
+func lineKey(line string) string {
+	if line == "" || isContinuationLine(line) || strings.HasPrefix(line, "#") {
+		return ""
+	}
+
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return ""
+	}
+
+	return line[:idx]
+}
+
+// hasKeyLine returns true if one of the lines starts the given key
+func hasKeyLine(lines []string, key string) bool {
+	for _, line := range lines {
+		if lineKey(line) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keyRank returns the position of a key in the canonical key order. Keys
+// that aren't in it come after all the ones that are
+func keyRank(key string) int {
+	for i, known := range canonicalKeyOrder {
+		if known == key {
+			return i
+		}
+	}
+
+	return len(canonicalKeyOrder)
+}
+
+// marshalTagList writes the tags as a YAML flow-style list (e.g.: tags: [go, cli])
+func marshalTagList(tags []string) (string, error) {
+	out, err := yaml.Marshal(struct {
+		Tags []string `yaml:"tags,flow"`
+	}{tags})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,361 @@
+package pages
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	errFileExists   = "won't write over %s: %w"
+	errFileTooLarge = "it's more than %d bytes, which is too big to be a page"
+)
+
+// FS is the filesystem that pages are read from and written to. OSFS is the
+// real disk, and MemFS keeps everything in memory, which is handy for tests
+type FS interface {
+	Glob(pattern string) ([]string, error)
+	MkdirAll(path string, perm os.FileMode) error
+	ReadFile(name string) ([]byte, error)
+	Remove(name string) error
+	Rename(oldPath, newPath string) error
+	Stat(name string) (os.FileInfo, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+// OSFS is the FS of the real disk
+type OSFS struct{}
+
+// WriteNewFile writes data to a file that doesn't exist yet. If something's
+// already at name, it's left as it is and WriteNewFile returns an error that
+// is os.ErrExist, so that a new page never silently replaces another one.
+// OSFS and MemFS create the file in one step, so that nothing can get there
+// in between it being looked for and written. Other filesystems are looked
+// in first
+func WriteNewFile(fsys FS, name string, data []byte, perm os.FileMode) error {
+	var err error
+
+	if creator, ok := fsys.(interface {
+		CreateFile(name string, data []byte, perm os.FileMode) error
+	}); ok {
+		err = creator.CreateFile(name, data, perm)
+	} else if _, err = fsys.Stat(name); err == nil {
+		err = os.ErrExist
+	} else if os.IsNotExist(err) {
+		err = fsys.WriteFile(name, data, perm)
+	}
+
+	if os.IsExist(err) {
+		return fmt.Errorf(errFileExists, name, os.ErrExist)
+	}
+
+	return err
+}
+
+// tooLargeError is a file that's more than the limit ReadFileLimit read it with
+type tooLargeError struct {
+	limit int64
+}
+
+func (e *tooLargeError) Error() string {
+	return fmt.Sprintf(errFileTooLarge, e.limit)
+}
+
+// ReadFileLimit returns the contents of the file, unless it's more than limit
+// bytes, in which case it returns an error without reading any more of it
+// than that. A limit of 0 or less is no limit
+func ReadFileLimit(fsys FS, name string, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return fsys.ReadFile(name)
+	}
+
+	// The real disk is read a bit at a time, rather than trusting the size
+	// the file had when it was looked at
+	if opener, ok := fsys.(interface {
+		Open(name string) (io.ReadCloser, error)
+	}); ok {
+		file, err := opener.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		data, err := ioutil.ReadAll(io.LimitReader(file, limit+1))
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(len(data)) > limit {
+			return nil, &tooLargeError{limit: limit}
+		}
+
+		return data, nil
+	}
+
+	info, err := fsys.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() > limit {
+		return nil, &tooLargeError{limit: limit}
+	}
+
+	return fsys.ReadFile(name)
+}
+
+// CreateFile writes data to a file that doesn't exist yet, failing with an
+// error that is os.ErrExist if it does. A file it can't finish writing is
+// removed again
+func (OSFS) CreateFile(name string, data []byte, perm os.FileMode) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	err = writeTempFile(file, data, perm)
+	if err != nil {
+		os.Remove(name)
+		return err
+	}
+
+	return nil
+}
+
+// Glob returns the names of the files matching the pattern
+func (OSFS) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+// MkdirAll creates the directory and any parents it needs
+func (OSFS) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+// Open opens the file for reading
+func (OSFS) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+// ReadFile returns the contents of the file
+func (OSFS) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(name)
+}
+
+// Remove removes the file
+func (OSFS) Remove(name string) error {
+	return os.Remove(name)
+}
+
+// Rename moves the file from oldPath to newPath
+func (OSFS) Rename(oldPath, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
+
+// Stat returns the file's info
+func (OSFS) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+// WriteFile writes data to the file, creating it if need be. The data is
+// written to a temporary file in the same directory first, which is then
+// renamed over the file, so the file is never left half-written. An existing
+// file keeps its permissions
+func (OSFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	if info, err := os.Stat(name); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(name), fmt.Sprintf(".%s.tmp*", filepath.Base(name)))
+	if err != nil {
+		return err
+	}
+
+	err = writeTempFile(tmpFile, data, perm)
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), name)
+	}
+
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	return nil
+}
+
+// MemFS is an FS that keeps its files in memory. Directories exist as soon as
+// a file is written into them. It is safe for concurrent use
+type MemFS struct {
+	files map[string][]byte
+	mutex sync.Mutex
+}
+
+// NewMemFS creates and returns an empty MemFS
+func NewMemFS() *MemFS {
+	return &MemFS{files: map[string][]byte{}}
+}
+
+// CreateFile writes data to a file that doesn't exist yet, failing with an
+// error that is os.ErrExist if it does
+func (mfs *MemFS) CreateFile(name string, data []byte, perm os.FileMode) error {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	if _, ok := mfs.files[filepath.Clean(name)]; ok {
+		return &os.PathError{Op: "open", Path: name, Err: os.ErrExist}
+	}
+
+	mfs.files[filepath.Clean(name)] = append([]byte{}, data...)
+
+	return nil
+}
+
+// Glob returns the names of the files matching the pattern, in order
+func (mfs *MemFS) Glob(pattern string) ([]string, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	matches := []string{}
+	for name := range mfs.files {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			matches = append(matches, name)
+		}
+	}
+
+	sort.Strings(matches)
+
+	return matches, nil
+}
+
+// MkdirAll does nothing, because directories don't need creating in a MemFS
+func (mfs *MemFS) MkdirAll(path string, perm os.FileMode) error {
+	return nil
+}
+
+// ReadFile returns the contents of the file
+func (mfs *MemFS) ReadFile(name string) ([]byte, error) {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	data, ok := mfs.files[filepath.Clean(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+
+	return append([]byte{}, data...), nil
+}
+
+// Remove removes the file
+func (mfs *MemFS) Remove(name string) error {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	if _, ok := mfs.files[filepath.Clean(name)]; !ok {
+		return &os.PathError{Op: "remove", Path: name, Err: os.ErrNotExist}
+	}
+
+	delete(mfs.files, filepath.Clean(name))
+
+	return nil
+}
+
+// Rename moves the file from oldPath to newPath
+func (mfs *MemFS) Rename(oldPath, newPath string) error {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	data, ok := mfs.files[filepath.Clean(oldPath)]
+	if !ok {
+		return &os.LinkError{Op: "rename", Old: oldPath, New: newPath, Err: os.ErrNotExist}
+	}
+
+	delete(mfs.files, filepath.Clean(oldPath))
+	mfs.files[filepath.Clean(newPath)] = data
+
+	return nil
+}
+
+// Stat returns the info of the file, or of a directory that holds files
+func (mfs *MemFS) Stat(name string) (os.FileInfo, error) {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	name = filepath.Clean(name)
+
+	if data, ok := mfs.files[name]; ok {
+		return memFileInfo{name: filepath.Base(name), size: int64(len(data))}, nil
+	}
+
+	prefix := name + string(filepath.Separator)
+	for filePath := range mfs.files {
+		if strings.HasPrefix(filePath, prefix) {
+			return memFileInfo{name: filepath.Base(name), isDir: true}, nil
+		}
+	}
+
+	return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+}
+
+// WriteFile writes data to the file, creating it if need be
+func (mfs *MemFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	mfs.mutex.Lock()
+	defer mfs.mutex.Unlock()
+
+	mfs.files[filepath.Clean(name)] = append([]byte{}, data...)
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// writeTempFile writes data to the temporary file, syncing it to disk before
+// it's renamed over its target, and closes it. CreateFile writes new files
+// with it too
+func writeTempFile(tmpFile *os.File, data []byte, perm os.FileMode) error {
+	_, err := tmpFile.Write(data)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Chmod(tmpFile.Name(), perm)
+}
+
+// memFileInfo is the os.FileInfo of a file in a MemFS
+type memFileInfo struct {
+	isDir bool
+	name  string
+	size  int64
+}
+
+func (info memFileInfo) IsDir() bool        { return info.isDir }
+func (info memFileInfo) ModTime() time.Time { return time.Time{} }
+func (info memFileInfo) Name() string       { return info.name }
+func (info memFileInfo) Size() int64        { return info.size }
+func (info memFileInfo) Sys() interface{}   { return nil }
+
+func (info memFileInfo) Mode() os.FileMode {
+	if info.isDir {
+		return os.ModeDir | 0755
+	}
+
+	return 0644
+}
@@ -0,0 +1,457 @@
+package pages
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// HTMLOptions defines how Markdown is rendered as HTML
+type HTMLOptions struct {
+	// ImageSrc returns what an image's src is rendered as, given the path or
+	// URL it has in the Markdown. Nil leaves them as they are
+	ImageSrc func(src string) string
+}
+
+var (
+	headingRegex    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	hrRegex         = regexp.MustCompile(`^\s{0,3}((\*\s*){3,}|(-\s*){3,}|(_\s*){3,})$`)
+	listItemRegex   = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	orderedRegex    = regexp.MustCompile(`^\d+[.)]$`)
+	placeholderRe   = regexp.MustCompile(`\x00(\d+)\x00`)
+	imageRegex      = regexp.MustCompile(`!\[([^\]]*)\]\(\s*([^)\s]+)(?:\s+"([^"]*)")?\s*\)`)
+	linkRegex       = regexp.MustCompile(`\[([^\]]+)\]\(\s*([^)\s]+)(?:\s+"([^"]*)")?\s*\)`)
+	autolinkRegex   = regexp.MustCompile(`<(https?://[^>\s]+)>`)
+	strongRegex     = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	emRegex         = regexp.MustCompile(`\*([^*\s][^*]*)\*|(^|[^\pL\pN_])_([^_\s][^_]*)_([^\pL\pN_]|$)`)
+	codeSpanRegex   = regexp.MustCompile("(`+)(.+?)(`+)")
+	strikeRegex     = regexp.MustCompile(`~~([^~]+)~~`)
+	lineBreakRegex  = regexp.MustCompile(` {2,}\n`)
+	fenceStartRegex = regexp.MustCompile("^\\s{0,3}(```+|~~~+)\\s*([^`\\s]*)")
+)
+
+// RenderHTML renders the Markdown as HTML. It covers what pages are usually
+// written with: headings, paragraphs, lists, block quotes, rules, fenced code
+// (highlighted for the languages HighlightCode knows), and inline code,
+// emphasis, links, and images. Anything else is rendered as text
+func RenderHTML(markdown string, opts HTMLOptions) string {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	return strings.Join(renderBlocks(lines, opts), "\n") + "\n"
+}
+
+// HighlightCode returns the code as HTML, with the comments, strings,
+// numbers, and keywords of the language wrapped in spans with the classes
+// c, s, n, and k. Code in a language it doesn't know is only escaped
+func HighlightCode(code, lang string) string {
+	syntax, ok := syntaxes[strings.ToLower(lang)]
+	if !ok {
+		return html.EscapeString(code)
+	}
+
+	var builder strings.Builder
+
+	runes := []rune(code)
+
+	for i := 0; i < len(runes); {
+		rest := string(runes[i:])
+
+		switch {
+		case syntax.blockComment != [2]string{} && strings.HasPrefix(rest, syntax.blockComment[0]):
+			end := strings.Index(rest[len(syntax.blockComment[0]):], syntax.blockComment[1])
+			length := len(rest)
+			if end >= 0 {
+				length = len(syntax.blockComment[0]) + end + len(syntax.blockComment[1])
+			}
+
+			i += writeSpan(&builder, "c", rest[:length])
+		case hasAnyPrefix(rest, syntax.lineComments):
+			length := strings.IndexByte(rest, '\n')
+			if length < 0 {
+				length = len(rest)
+			}
+
+			i += writeSpan(&builder, "c", rest[:length])
+		case strings.ContainsRune(syntax.quotes, runes[i]):
+			i += writeSpan(&builder, "s", quotedString(runes[i:]))
+		case isDigit(runes[i]) && (i == 0 || !isCodeWordRune(runes[i-1])):
+			i += writeSpan(&builder, "n", wordAt(runes[i:]))
+		case isCodeWordRune(runes[i]) && (i == 0 || !isCodeWordRune(runes[i-1])):
+			word := wordAt(runes[i:])
+			if syntax.keywords[word] {
+				i += writeSpan(&builder, "k", word)
+			} else {
+				builder.WriteString(html.EscapeString(word))
+				i += len([]rune(word))
+			}
+		default:
+			builder.WriteString(html.EscapeString(string(runes[i])))
+			i++
+		}
+	}
+
+	return builder.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// syntax is what HighlightCode needs to know about a language
+type syntax struct {
+	blockComment [2]string
+	keywords     map[string]bool
+	lineComments []string
+	quotes       string
+}
+
+func keywordSet(words string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+
+	return set
+}
+
+var (
+	goSyntax = syntax{
+		blockComment: [2]string{"/*", "*/"},
+		keywords: keywordSet("break case chan const continue default defer else fallthrough for func go goto if " +
+			"import interface map package range return select struct switch type var nil true false"),
+		lineComments: []string{"//"},
+		quotes:       "\"'`",
+	}
+
+	jsSyntax = syntax{
+		blockComment: [2]string{"/*", "*/"},
+		keywords: keywordSet("async await break case catch class const continue default delete do else export extends " +
+			"finally for from function if import in instanceof let new null return super switch this throw true false " +
+			"try typeof undefined var void while yield"),
+		lineComments: []string{"//"},
+		quotes:       "\"'`",
+	}
+
+	pythonSyntax = syntax{
+		keywords: keywordSet("and as assert async await break class continue def del elif else except False finally " +
+			"for from global if import in is lambda None nonlocal not or pass raise return True try while with yield"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	shellSyntax = syntax{
+		keywords:     keywordSet("case do done elif else esac export fi for function if in local return then until while"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	rubySyntax = syntax{
+		keywords: keywordSet("begin break case class def do else elsif end ensure false for if in module next nil " +
+			"not or puts raise rescue return self super then true unless until when while yield"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	// syntaxes are the languages that code is highlighted for, by the names
+	// that fenced code blocks give them
+	syntaxes = map[string]syntax{
+		"bash":       shellSyntax,
+		"go":         goSyntax,
+		"golang":     goSyntax,
+		"javascript": jsSyntax,
+		"js":         jsSyntax,
+		"py":         pythonSyntax,
+		"python":     pythonSyntax,
+		"rb":         rubySyntax,
+		"ruby":       rubySyntax,
+		"sh":         shellSyntax,
+		"shell":      shellSyntax,
+		"ts":         jsSyntax,
+		"typescript": jsSyntax,
+		"zsh":        shellSyntax,
+	}
+)
+
+// renderBlocks renders the lines of Markdown as HTML blocks
+func renderBlocks(lines []string, opts HTMLOptions) []string {
+	blocks := []string{}
+	paragraph := []string{}
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, "<p>"+renderInline(strings.Join(paragraph, "\n"), opts)+"</p>")
+			paragraph = []string{}
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if match := fenceStartRegex.FindStringSubmatch(line); match != nil {
+			flush()
+
+			code := []string{}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), match[1]); i++ {
+				code = append(code, lines[i])
+			}
+
+			class := ""
+			if match[2] != "" {
+				class = fmt.Sprintf(` class="language-%s"`, html.EscapeString(match[2]))
+			}
+
+			blocks = append(blocks, fmt.Sprintf("<pre><code%s>%s</code></pre>", class, HighlightCode(strings.Join(code, "\n"), match[2])))
+
+			continue
+		}
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case headingRegex.MatchString(line):
+			flush()
+
+			match := headingRegex.FindStringSubmatch(line)
+			level := len(match[1])
+			blocks = append(blocks, fmt.Sprintf(`<h%d id="%s">%s</h%d>`, level, Slug(match[2]), renderInline(match[2], opts), level))
+		case hrRegex.MatchString(line) && len(paragraph) == 0:
+			blocks = append(blocks, "<hr>")
+		case strings.HasPrefix(strings.TrimSpace(line), ">"):
+			flush()
+
+			quoted := []string{}
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimPrefix(text, " "))
+			}
+			i--
+
+			blocks = append(blocks, "<blockquote>\n"+strings.Join(renderBlocks(quoted, opts), "\n")+"\n</blockquote>")
+		case listItemRegex.MatchString(line) && (len(paragraph) == 0 || !orderedRegex.MatchString(listItemRegex.FindStringSubmatch(line)[2])):
+			// A bulleted list can start straight after a paragraph, but a
+			// numbered one needs a blank line, so that a line starting with a
+			// year isn't taken for one
+			flush()
+
+			var list string
+			list, i = renderList(lines, i, opts)
+
+			blocks = append(blocks, list)
+		default:
+			paragraph = append(paragraph, strings.TrimLeft(line, " \t"))
+		}
+	}
+
+	flush()
+
+	return blocks
+}
+
+// renderList renders the list that starts at lines[start], returning it and
+// the index of its last line. Lines indented under an item belong to it, so
+// lists can be nested
+func renderList(lines []string, start int, opts HTMLOptions) (string, int) {
+	first := listItemRegex.FindStringSubmatch(lines[start])
+	indent := len(first[1])
+	ordered := orderedRegex.MatchString(first[2])
+
+	// How far in an item's text starts, which lines under it are indented to
+	contentIndent := len(first[0]) - len(first[3])
+
+	items := [][]string{}
+	i := start
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+
+		if match := listItemRegex.FindStringSubmatch(line); match != nil && len(match[1]) == indent && orderedRegex.MatchString(match[2]) == ordered {
+			items = append(items, []string{match[3]})
+			continue
+		}
+
+		// A blank line only ends the list if what follows isn't part of it
+		if strings.TrimSpace(line) == "" {
+			if i+1 < len(lines) && (leadingSpaces(lines[i+1]) > indent || isListItemAt(lines[i+1], indent, ordered)) {
+				items[len(items)-1] = append(items[len(items)-1], "")
+				continue
+			}
+
+			break
+		}
+
+		if leadingSpaces(line) <= indent {
+			break
+		}
+
+		items[len(items)-1] = append(items[len(items)-1], trimIndent(line, contentIndent))
+	}
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	rendered := []string{"<" + tag + ">"}
+
+	for _, item := range items {
+		blocks := renderBlocks(item, opts)
+
+		// A single line of text doesn't need a paragraph around it
+		if len(blocks) > 0 && strings.HasPrefix(blocks[0], "<p>") && !strings.Contains(strings.Join(item, "\n"), "\n\n") {
+			blocks[0] = strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>")
+		}
+
+		rendered = append(rendered, "<li>"+strings.Join(blocks, "\n")+"</li>")
+	}
+
+	rendered = append(rendered, "</"+tag+">")
+
+	return strings.Join(rendered, "\n"), i - 1
+}
+
+// trimIndent removes up to n spaces from the start of the line
+func trimIndent(line string, n int) string {
+	if spaces := leadingSpaces(line); spaces < n {
+		n = spaces
+	}
+
+	return line[n:]
+}
+
+func isListItemAt(line string, indent int, ordered bool) bool {
+	match := listItemRegex.FindStringSubmatch(line)
+
+	return match != nil && len(match[1]) == indent && orderedRegex.MatchString(match[2]) == ordered
+}
+
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// renderInline renders the Markdown inside a block. Code spans, images, and
+// links are swapped out for placeholders while emphasis is worked out, so
+// that the underscores in a URL don't turn into italics
+func renderInline(text string, opts HTMLOptions) string {
+	held := []string{}
+	hold := func(rendered string) string {
+		held = append(held, rendered)
+		return fmt.Sprintf("\x00%d\x00", len(held)-1)
+	}
+
+	text = codeSpanRegex.ReplaceAllStringFunc(text, func(span string) string {
+		match := codeSpanRegex.FindStringSubmatch(span)
+		if match[1] != match[3] {
+			return span
+		}
+
+		return hold("<code>" + html.EscapeString(strings.TrimSpace(match[2])) + "</code>")
+	})
+
+	text = imageRegex.ReplaceAllStringFunc(text, func(image string) string {
+		match := imageRegex.FindStringSubmatch(image)
+
+		src := match[2]
+		if opts.ImageSrc != nil {
+			src = opts.ImageSrc(src)
+		}
+
+		return hold(fmt.Sprintf(`<img src="%s" alt="%s"%s>`, html.EscapeString(src), html.EscapeString(match[1]), titleAttr(match[3])))
+	})
+
+	text = linkRegex.ReplaceAllStringFunc(text, func(link string) string {
+		match := linkRegex.FindStringSubmatch(link)
+
+		return hold(fmt.Sprintf(`<a href="%s"%s>%s</a>`, html.EscapeString(match[2]), titleAttr(match[3]), renderEmphasis(html.EscapeString(match[1]))))
+	})
+
+	text = autolinkRegex.ReplaceAllStringFunc(text, func(link string) string {
+		url := html.EscapeString(strings.Trim(link, "<>"))
+
+		return hold(fmt.Sprintf(`<a href="%s">%s</a>`, url, url))
+	})
+
+	text = renderEmphasis(html.EscapeString(text))
+	text = lineBreakRegex.ReplaceAllString(text, "<br>\n")
+
+	// Held spans can hold other held spans, like an image in a link
+	for placeholderRe.MatchString(text) {
+		text = placeholderRe.ReplaceAllStringFunc(text, func(placeholder string) string {
+			var index int
+			fmt.Sscanf(strings.Trim(placeholder, "\x00"), "%d", &index)
+
+			return held[index]
+		})
+	}
+
+	return text
+}
+
+// renderEmphasis renders the bold, italic, and struck-through text in
+// already escaped HTML
+func renderEmphasis(text string) string {
+	text = strongRegex.ReplaceAllString(text, "<strong>$1$2</strong>")
+	text = emRegex.ReplaceAllString(text, "$2<em>$1$3</em>$4")
+
+	return strikeRegex.ReplaceAllString(text, "<del>$1</del>")
+}
+
+func titleAttr(title string) string {
+	if title == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(` title="%s"`, html.EscapeString(title))
+}
+
+// writeSpan writes the text in a span with the class, and returns how many
+// characters it was
+func writeSpan(builder *strings.Builder, class, text string) int {
+	builder.WriteString(fmt.Sprintf(`<span class="%s">%s</span>`, class, html.EscapeString(text)))
+	return len([]rune(text))
+}
+
+// quotedString returns the string that starts at runes[0], up to and
+// including its closing quote, or the end of the line if it isn't closed
+func quotedString(runes []rune) string {
+	quote := runes[0]
+
+	for i := 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && quote != '`':
+			i++
+		case runes[i] == quote:
+			return string(runes[:i+1])
+		case runes[i] == '\n' && quote != '`':
+			return string(runes[:i])
+		}
+	}
+
+	return string(runes)
+}
+
+func wordAt(runes []rune) string {
+	end := 0
+	for end < len(runes) && (isCodeWordRune(runes[end]) || (end > 0 && runes[end] == '.' && end+1 < len(runes) && isDigit(runes[end+1]))) {
+		end++
+	}
+
+	return string(runes[:end])
+}
+
+func hasAnyPrefix(text string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// isCodeWordRune returns true if the character can be part of a name in code
+func isCodeWordRune(r rune) bool {
+	return r == '_' || isDigit(r) || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
@@ -0,0 +1,334 @@
+package pages
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	frontMatterHeader    = "---\n"
+	frontMatterSeparator = "\n---\n"
+)
+
+const (
+	errMissingFrontMatter = "the page has no front-matter"
+	errMissingSeparator   = "found a front-matter header without a closing '---'"
+)
+
+// canonicalKeyOrder defines the order in which known front-matter keys are
+// written. Keys not in this list follow after, in the order they were found
+var canonicalKeyOrder = []string{"date", "title", "tags"}
+
+// requiredKeys are never removed from the front-matter, even when empty
+var requiredKeys = map[string]bool{
+	"date":  true,
+	"title": true,
+}
+
+// dateFormats are the date layouts that older pages have been seen using.
+// They are tried in order when normalizing a date to RFC3339
+var dateFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15-04-05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// MigrateFrontMatter rewrites the front-matter of the page source in data into
+// the current canonical shape:
+//   - the date is normalized to RFC3339
+//   - tags are written as a YAML list
+//   - optional fields that are present but empty are removed
+//   - keys are written in a stable order
+//
+// The body of the page is never modified, and a byte order mark and CRLF line
+// endings are kept as they are. It returns the migrated source and
+// a human-readable list of what changed. If nothing changed, the list is empty
+// and the returned source is identical to data. Files without front-matter
+// (like the generated index and tag pages) are returned untouched
+func MigrateFrontMatter(data []byte) ([]byte, []string, error) {
+	txt := string(normalizeLineEndings(data))
+	if !strings.HasPrefix(txt, frontMatterHeader) {
+		return data, []string{}, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(txt, frontMatterHeader), frontMatterSeparator, 2)
+	if len(parts) != 2 {
+		return data, []string{}, errors.New(errMissingSeparator)
+	}
+
+	meta := yaml.MapSlice{}
+	err := yaml.Unmarshal([]byte(parts[0]), &meta)
+	if err != nil {
+		return data, []string{}, err
+	}
+
+	changes := []string{}
+	lines := []string{}
+	keys := []string{}
+
+	for _, item := range meta {
+		keys = append(keys, fmt.Sprintf("%v", item.Key))
+	}
+
+	ordered := orderedKeys(keys)
+	if strings.Join(ordered, ",") != strings.Join(keys, ",") {
+		changes = append(changes, "keys reordered")
+	}
+
+	for _, key := range ordered {
+		val := metaValue(meta, key)
+
+		if !requiredKeys[key] && (isEmptyValue(val) || (key == "tags" && len(tagNames(val)) == 0)) {
+			changes = append(changes, fmt.Sprintf("removed empty field '%s'", key))
+			continue
+		}
+
+		var line string
+
+		switch key {
+		case "date":
+			line, err = migrateDate(val, &changes)
+		case "tags":
+			line, err = migrateTags(val, &changes)
+		default:
+			line, err = marshalField(key, val)
+		}
+
+		if err != nil {
+			return data, []string{}, err
+		}
+
+		lines = append(lines, line)
+	}
+
+	migrated := frontMatterHeader + strings.Join(lines, "\n") + frontMatterSeparator + parts[1]
+
+	if migrated == txt {
+		return data, []string{}, nil
+	}
+
+	if len(changes) == 0 {
+		changes = append(changes, "formatting normalized")
+	}
+
+	return restoreLineEndings(data, []byte(migrated)), changes, nil
+}
+
+// CanonicalFrontMatter returns the page's front-matter in the shape that
+// MigrateFrontMatter leaves it in, with the title quoted where YAML needs it
+// to be and the tags as a list, followed by a blank line. The source is only
+// written if the page has one
+func (page *Page) CanonicalFrontMatter() (string, error) {
+	title, err := marshalField("title", page.Title)
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{fmt.Sprintf("date: %s", page.Date), title}
+
+	if tags := tagNames(string(page.TagsStr)); len(tags) > 0 {
+		tagList, err := marshalTagList(tags)
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, tagList)
+	}
+
+	if page.Source != "" {
+		source, err := marshalField("source", page.Source)
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, source)
+	}
+
+	return frontMatterHeader + strings.Join(lines, "\n") + frontMatterSeparator + "\n", nil
+}
+
+// ParseDate parses a front-matter date written in any of the formats that
+// pages have been seen using
+func ParseDate(raw string) (time.Time, bool) {
+	for _, format := range dateFormats {
+		date, err := time.ParseInLocation(format, strings.TrimSpace(raw), time.Local)
+		if err == nil {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// SplitFrontMatter splits page source into its front-matter, parsed, and the
+// body after it. Source without front-matter is all body, and ok is false
+func SplitFrontMatter(data []byte) (meta yaml.MapSlice, body string, ok bool, err error) {
+	if !HasFrontMatter(data) {
+		return yaml.MapSlice{}, string(data), false, nil
+	}
+
+	header, body, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	meta = yaml.MapSlice{}
+
+	err = yaml.Unmarshal([]byte(header), &meta)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return meta, body, true, nil
+}
+
+// FrontMatterValue returns the value of the key in the front-matter, or nil
+func FrontMatterValue(meta yaml.MapSlice, key string) interface{} {
+	return metaValue(meta, key)
+}
+
+// FrontMatterTags returns the tag names in a front-matter tags value, which
+// can be a comma-separated string or a list
+func FrontMatterTags(val interface{}) []string {
+	return tagNames(val)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// orderedKeys sorts the keys into canonical order. Known keys come first,
+// unknown keys follow in the order they were given
+func orderedKeys(keys []string) []string {
+	present := map[string]bool{}
+	for _, key := range keys {
+		present[key] = true
+	}
+
+	ordered := []string{}
+	known := map[string]bool{}
+
+	for _, key := range canonicalKeyOrder {
+		known[key] = true
+
+		if present[key] {
+			ordered = append(ordered, key)
+		}
+	}
+
+	for _, key := range keys {
+		if !known[key] {
+			ordered = append(ordered, key)
+		}
+	}
+
+	return ordered
+}
+
+func metaValue(meta yaml.MapSlice, key string) interface{} {
+	for _, item := range meta {
+		if fmt.Sprintf("%v", item.Key) == key {
+			return item.Value
+		}
+	}
+
+	return nil
+}
+
+func isEmptyValue(val interface{}) bool {
+	switch v := val.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case yaml.MapSlice:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// marshalField writes a single key/value pair as a line of YAML
+func marshalField(key string, val interface{}) (string, error) {
+	out, err := yaml.Marshal(yaml.MapSlice{{Key: key, Value: val}})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func migrateDate(val interface{}, changes *[]string) (string, error) {
+	raw := ""
+
+	switch v := val.(type) {
+	case time.Time:
+		raw = v.Format(time.RFC3339)
+	case nil:
+		raw = ""
+	default:
+		raw = strings.TrimSpace(fmt.Sprintf("%v", v))
+	}
+
+	date := normalizeDate(raw)
+	if date != raw {
+		*changes = append(*changes, fmt.Sprintf("date normalized from '%s' to '%s'", raw, date))
+	}
+
+	return fmt.Sprintf("date: %s", date), nil
+}
+
+// normalizeDate returns the date as RFC3339 if it can be parsed by any of
+// the known formats. Dates that cannot be parsed are returned unchanged
+func normalizeDate(raw string) string {
+	if date, ok := ParseDate(raw); ok {
+		return date.Format(time.RFC3339)
+	}
+
+	return raw
+}
+
+func migrateTags(val interface{}, changes *[]string) (string, error) {
+	if _, isList := val.([]interface{}); !isList {
+		*changes = append(*changes, "tags converted to a list")
+	}
+
+	return marshalTagList(tagNames(val))
+}
+
+// tagNames returns the trimmed, non-empty tag names from either the string
+// or the list form of the tags value
+func tagNames(val interface{}) []string {
+	names := []string{}
+
+	switch v := val.(type) {
+	case nil:
+		return names
+	case []interface{}:
+		for _, name := range v {
+			names = append(names, fmt.Sprintf("%v", name))
+		}
+	default:
+		names = strings.Split(fmt.Sprintf("%v", v), ",")
+	}
+
+	tags := []string{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			tags = append(tags, name)
+		}
+	}
+
+	return tags
+}
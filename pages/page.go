@@ -1,11 +1,17 @@
 package pages
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/ericaro/frontmatter"
@@ -18,55 +24,295 @@ const (
 
 	// FileExtension defines the extension to write on the generated file
 	FileExtension = "md"
+
+	// GeneratedMarker is the first line of every page that til generates, so
+	// that they can be told apart from the pages that people write. It's an
+	// HTML comment, so it doesn't show up on the site
+	GeneratedMarker = "<!-- generated by til, changes to this page will be lost on the next build -->"
+
+	// binarySniffSize is how much of the start of a file is looked at to
+	// tell whether it's text
+	binarySniffSize = 8000
+
+	// maxFreeFileTries is how many numbered names freeFilePath tries before
+	// giving up on finding a free one
+	maxFreeFileTries = 1000
+
+	errNoFreeFilePath = "couldn't find a free file name for %s in %s after %d tries"
+	errPageBinary     = "it looks like a binary file, not a page"
+)
+
+// DateFormat is the Go layout that PrettyDate writes dates with, and
+// DateLocale is the locale whose month names it uses. til sets them from the
+// dateFormat and dateLocale config
+var (
+	DateFormat = src.DefaultDateFormat
+	DateLocale = ""
 )
 
+// defaultBodyTemplate is what new pages start out as when they're created
+// without a BodyTemplate
+var defaultBodyTemplate = template.Must(template.New("newPageBody").Parse(src.DefaultPageBody))
+
+// Now returns the current time. It is a variable so that tests can pin the
+// clock to a specific moment
+var Now = time.Now
+
 // Page represents a TIL page
 type Page struct {
-	Content  string `fm:"content" yaml:"-"`
-	Date     string `yaml:"date"`
-	FilePath string `yaml:"filepath"`
-	TagsStr  string `yaml:"tags"`
-	Title    string `yaml:"title"`
+	Archived bool       `yaml:"archived"`
+	Author   string     `yaml:"author"`
+	Content  string     `fm:"content" yaml:"-"`
+	Date     string     `yaml:"date"`
+	FilePath string     `yaml:"filepath"`
+	Reviewed string     `yaml:"reviewed"`
+	Source   string     `yaml:"source"`
+	TagsStr  TagsString `yaml:"tags"`
+	Title    string     `yaml:"title"`
+
+	// bodyTemplate is what the page started out as under its front-matter,
+	// when it was created by NewPage
+	bodyTemplate *template.Template
+
+	// bodyLine is the line of the body it was created with that the
+	// editor's cursor goes on
+	bodyLine int
+}
+
+// TagsString is the comma-separated list of tags assigned to a page. In the
+// front-matter the tags can be written either as a plain string (tags: go, cli)
+// or as a YAML list (tags: [go, cli]), and both end up here
+type TagsString string
+
+// UnmarshalYAML accepts both the string and the list form of the tags
+func (ts *TagsString) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	list := []string{}
+	if err := unmarshal(&list); err == nil {
+		*ts = TagsString(strings.Join(list, ", "))
+		return nil
+	}
+
+	str := ""
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+
+	*ts = TagsString(str)
+
+	return nil
+}
+
+// PageOptions defines how a new page gets created
+type PageOptions struct {
+	// FS is the filesystem the page is written to. Nil means the real disk
+	FS FS
+
+	// ReservedNames are file names (without the extension) that the page must
+	// not be written to, such as the names of the generated index and tag pages
+	ReservedNames []string
+
+	// DateFormat is the Go time layout used for the date prefix of the file
+	// name. Empty means the default, GitHub Pages-friendly, format
+	DateFormat string
+
+	// OmitDate leaves the date prefix off the file name entirely, so that the
+	// file is named after the title alone
+	OmitDate bool
+
+	// SlugMaxLength is the maximum length, in bytes, of the slug portion of the
+	// file name. Zero means no limit
+	SlugMaxLength int
+
+	// Tags are the tags to give the page
+	Tags []string
+
+	// Body is written under the page's heading, or where the BodyTemplate
+	// puts the cursor
+	Body string
+
+	// BodyLine is the line of the Body, counting from 1, that the editor's
+	// cursor goes on when the page is opened. 0 is its first line
+	BodyLine int
+
+	// BodyTemplate is what the page starts out as under its front-matter,
+	// as src.PageBodyTemplate returns it. Nil means src.DefaultPageBody
+	BodyTemplate *template.Template
+
+	// Source is the URL the page is about, for its source front-matter
+	Source string
 }
 
-// NewPage creates and returns an instance of page
-func NewPage(title string, targetDir string) *Page {
-	date := time.Now()
+// NewPage creates a page and writes it to a file of its own in targetDir,
+// and returns it
+func NewPage(title string, targetDir string, opts PageOptions) (*Page, error) {
+	date := Now()
+
+	fsys := opts.FS
+	if fsys == nil {
+		fsys = OSFS{}
+	}
+
+	filePath, err := freeFilePath(fsys, targetDir, FileName(title, date, opts), opts.ReservedNames)
+	if err != nil {
+		return nil, err
+	}
 
 	page := &Page{
-		Date: date.Format(time.RFC3339),
-		FilePath: fmt.Sprintf(
-			"%s/%s-%s.%s",
-			targetDir,
-			date.Format(ghFriendlyDateFormat),
-			strings.ReplaceAll(strings.ToLower(title), " ", "-"),
-			FileExtension,
-		),
-		Title: title,
+		Date:     date.Format(time.RFC3339),
+		FilePath: filePath,
+		Source:   opts.Source,
+		TagsStr:  TagsString(strings.Join(opts.Tags, ", ")),
+		Title:    title,
+
+		bodyLine:     opts.BodyLine,
+		bodyTemplate: opts.BodyTemplate,
+	}
+
+	// The file path was free when it was picked, but something could have
+	// got there since
+	err = WriteNewFile(fsys, page.FilePath, []byte(page.stub(opts.Body)), 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// FileName returns the name, without the extension, of the file that a page
+// with the title and date is written to: the title's slug, after the date
+// unless the options leave it off
+func FileName(title string, date time.Time, opts PageOptions) string {
+	dateFormat := opts.DateFormat
+	if dateFormat == "" {
+		dateFormat = ghFriendlyDateFormat
+	}
+
+	name := TruncateSlug(Slug(title), opts.SlugMaxLength)
+	if !opts.OmitDate {
+		name = fmt.Sprintf("%s-%s", date.Format(dateFormat), name)
+	}
+
+	return name
+}
+
+// FileNameDate returns the date that a page's file name starts with, the way
+// FileName writes it without a DateFormat (ie: 2020-05-06T13-13-08-closures.md),
+// read in loc. It returns false if the name doesn't start with one
+func FileNameDate(filePath string, loc *time.Location) (time.Time, bool) {
+	name := filepath.Base(filePath)
+	if len(name) < len(ghFriendlyDateFormat) {
+		return time.Time{}, false
+	}
+
+	date, err := time.ParseInLocation(ghFriendlyDateFormat, name[:len(ghFriendlyDateFormat)], loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// The date is followed by the title, or by nothing but the extension
+	if rest := name[len(ghFriendlyDateFormat):]; rest != "" && rest[0] != '-' && rest[0] != '.' {
+		return time.Time{}, false
 	}
 
-	page.Save()
+	return date, true
+}
+
+// FreeFilePath returns the path to a page file named name in targetDir. If a
+// file with that name already exists, or the name is reserved, it appends -2,
+// -3, and so on to the name until it finds one that is free. It's an error if
+// targetDir can't be looked in, or if none of the first thousand names are free
+func FreeFilePath(targetDir string, name string, reservedNames []string) (string, error) {
+	return freeFilePath(OSFS{}, targetDir, name, reservedNames)
+}
+
+// FreeFilePathFS is FreeFilePath, looking for existing files in fsys
+func FreeFilePathFS(fsys FS, targetDir string, name string, reservedNames []string) (string, error) {
+	return freeFilePath(fsys, targetDir, name, reservedNames)
+}
 
-	return page
+// ReadPage reads the file at filePath and creates a Page instance from it.
+// A page with front-matter that can't be parsed returns a src.ParseError.
+// Pages in other formats than Markdown can do without front-matter; see
+// Formats. Pages written on Windows, with CRLF line endings or a byte order
+// mark, are read as if they weren't, but the file itself is left as it is
+func ReadPage(filePath string) (*Page, error) {
+	return ReadPageFS(OSFS{}, filePath)
 }
 
-// PageFromFilePath creates and returns a Page instance from a file path
-func PageFromFilePath(filePath string) *Page {
+// ReadPageFS is ReadPage, reading the file from fsys
+func ReadPageFS(fsys FS, filePath string) (*Page, error) {
+	return ReadPageLimit(fsys, filePath, 0)
+}
+
+// ReadPageLimit is ReadPageFS for a file that's no more than maxSize bytes.
+// A file that's bigger, or that looks binary, isn't read as a page and is a
+// *src.ParseError. A maxSize of 0 or less is no limit
+func ReadPageLimit(fsys FS, filePath string, maxSize int64) (*Page, error) {
 	page := new(Page)
 
-	data, err := ioutil.ReadFile(filePath)
+	data, err := ReadFileLimit(fsys, filePath, maxSize)
+
+	var tooLarge *tooLargeError
+	if errors.As(err, &tooLarge) {
+		return nil, &src.ParseError{FilePath: filePath, Err: err}
+	}
+
 	if err != nil {
-		src.Defeat(err)
+		return nil, err
+	}
+
+	if isBinary(data) {
+		return nil, &src.ParseError{FilePath: filePath, Err: errors.New(errPageBinary)}
+	}
+
+	data = normalizeLineEndings(data)
+
+	page.FilePath = filePath
+
+	// Pages in formats other than Markdown don't need front-matter, since
+	// their own headers can say what the page is called
+	format := FormatOf(filePath)
+	if format.meta != nil && !strings.HasPrefix(string(data), frontMatterHeader) {
+		page.readMeta(fsys, format, string(data))
+		return page, nil
 	}
 
 	err = frontmatter.Unmarshal(data, page)
 	if err != nil {
-		src.Defeat(err)
+		return nil, &src.ParseError{FilePath: filePath, Err: err}
 	}
 
 	page.FilePath = filePath
 
-	return page
+	return page, nil
+}
+
+// MarkGenerated returns the content of a generated page with the
+// GeneratedMarker above it
+func MarkGenerated(content string) string {
+	return fmt.Sprintf("%s\n%s", GeneratedMarker, content)
+}
+
+// PageFromFilePath creates and returns a Page instance from a file path. It's
+// ReadPage, under the name it used to have
+func PageFromFilePath(filePath string) (*Page, error) {
+	return ReadPage(filePath)
+}
+
+// SortNewestFirst sorts the pages in reverse chronological order of their
+// front-matter dates, which is the order that everything til writes lists
+// them in. Filenames aren't relied on, because their date format is
+// configurable and imported or backdated pages don't follow it. Pages with
+// the same date are in reverse filename order, and pages without one go last
+func SortNewestFirst(pageSet []*Page) {
+	sort.SliceStable(pageSet, func(i, j int) bool {
+		created, other := pageSet[i].CreatedAt(), pageSet[j].CreatedAt()
+		if !created.Equal(other) {
+			return created.After(other)
+		}
+
+		return pageSet[i].FilePath > pageSet[j].FilePath
+	})
 }
 
 // CreatedAt returns a time instance representing when the page was created
@@ -79,6 +325,17 @@ func (page *Page) CreatedAt() time.Time {
 	return date
 }
 
+// ReviewedAt returns when the page was last marked reviewed by til review,
+// or the zero time if it never has been
+func (page *Page) ReviewedAt() time.Time {
+	date, err := time.Parse(time.RFC3339, page.Reviewed)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return date
+}
+
 // CreatedMonth returns the month the page was created
 func (page *Page) CreatedMonth() time.Month {
 	if page.CreatedAt().IsZero() {
@@ -88,14 +345,27 @@ func (page *Page) CreatedMonth() time.Month {
 	return page.CreatedAt().Month()
 }
 
-// FrontMatter returns the front-matter of the page
+// FrontMatter returns the front-matter of the page. The title is quoted if
+// YAML needs it to be, like when it has a colon in it, and the source is
+// only written if the page has one
 func (page *Page) FrontMatter() string {
-	return fmt.Sprintf(
-		"---\ndate: %s\ntitle: %s\ntags: %s\n---\n\n",
-		page.Date,
-		page.Title,
-		page.TagsStr,
-	)
+	title, err := marshalField("title", page.Title)
+	if err != nil {
+		title = fmt.Sprintf("title: %s", page.Title)
+	}
+
+	frontMatter := fmt.Sprintf("---\ndate: %s\n%s\ntags: %s\n", page.Date, title, page.TagsStr)
+
+	if page.Source != "" {
+		source, err := marshalField("source", page.Source)
+		if err != nil {
+			source = fmt.Sprintf("source: %s", page.Source)
+		}
+
+		frontMatter += source + "\n"
+	}
+
+	return frontMatter + "---\n\n"
 }
 
 // IsContentPage returns true if the page is a valid entry page, false if it is not
@@ -103,54 +373,300 @@ func (page *Page) IsContentPage() bool {
 	return page.Title != ""
 }
 
-// Link returns a link string suitable for embedding in a Markdown page
+// IsGenerated returns true if the page is one that til generates, like the
+// index or a tag page, rather than one that someone wrote
+func (page *Page) IsGenerated() bool {
+	return !page.IsContentPage() && strings.HasPrefix(page.Content, GeneratedMarker)
+}
+
+// IsYearDir returns true if name is the name of one of the year directories
+// that the yearly layout keeps the pages in: four digits, like 2024
+func IsYearDir(name string) bool {
+	if len(name) != 4 {
+		return false
+	}
+
+	for _, r := range name {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LinkPath returns the path of the page's file relative to the docs
+// directory, for linking to it. It's the file's name, after the year
+// directory that it's in with the yearly layout (e.g.: 2024/zombies.md).
+// Links are URLs, so it always uses forward slashes
+func (page *Page) LinkPath() string {
+	dir, name := path.Split(strings.ReplaceAll(page.FilePath, `\`, "/"))
+
+	if year := path.Base(strings.TrimSuffix(dir, "/")); IsYearDir(year) {
+		return year + "/" + name
+	}
+
+	return name
+}
+
+// Link returns a link string suitable for embedding in a Markdown page.
+// Links are URLs, so they always use forward slashes no matter what the
+// operating system's path separator is
 func (page *Page) Link() string {
-	return fmt.Sprintf(
-		"<code>%s</code> [%s](%s)",
+	return page.LinkFrom("")
+}
+
+// LinkFrom returns a link string suitable for embedding in a Markdown page
+// that lives somewhere other than the docs directory. prefix is the relative
+// path from that page back to the docs directory (e.g.: ../../). Pages in a
+// format that isn't rendered have it after the link, since the link goes to
+// the file as it is
+func (page *Page) LinkFrom(prefix string) string {
+	link := fmt.Sprintf(
+		"<code>%s</code> [%s](%s%s)",
 		page.PrettyDate(),
 		page.Title,
-		filepath.Base(page.FilePath),
+		prefix,
+		page.LinkPath(),
 	)
+
+	if format := page.Format(); !format.Rendered {
+		link += fmt.Sprintf(" <sub>%s</sub>", format.Name)
+	}
+
+	return link
 }
 
 // Open tll the OS to open the newly-created page in the editor (as specified in the config)
 // If there's no editor explicitly defined by the user, tell the OS to try and open it
 func (page *Page) Open(defaultEditor string) error {
+	return page.OpenAt(defaultEditor, 0)
+}
+
+// OpenAt is Open, with the editor's cursor put on the given line if the
+// editorLineFlag config says how to. A line of 0 or less opens the page as
+// Open does
+func (page *Page) OpenAt(defaultEditor string, line int) error {
+	editor := ConfiguredEditor(defaultEditor)
+
+	args, err := EditorCommand(editor, src.GlobalConfig.UString("editorLineFlag", ""), line, page.FilePath)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		args = []string{defaultEditor, page.FilePath}
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+
+	// Editors that run in the terminal need it
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// ConfiguredEditor returns the editor in the config, with any arguments it
+// has, or defaultEditor if there isn't one
+func ConfiguredEditor(defaultEditor string) string {
 	editor := src.GlobalConfig.UString("editor", defaultEditor)
 	if editor == "" {
 		editor = defaultEditor
 	}
 
-	cmd := exec.Command(editor, page.FilePath)
-	err := cmd.Run()
+	return editor
+}
+
+// EditorCommand returns the command line that opens filePath in the editor.
+// The editor can have arguments, like "code --wait". lineFlag is how the
+// editor is told which line to start on, with {line} standing for the line,
+// like "+{line}" for vim, nano, and emacs. If it also has {file} in it, like
+// "--goto {file}:{line}" for VS Code, it replaces the file argument rather
+// than going before it. Without a lineFlag or a line, the file just follows
+// the editor. An empty editor gives an empty command line
+func EditorCommand(editor string, lineFlag string, line int, filePath string) ([]string, error) {
+	args, err := src.SplitCommandLine(editor)
+	if err != nil || len(args) == 0 {
+		return args, err
+	}
+
+	flagArgs := []string{}
+	if lineFlag != "" && line > 0 {
+		flagArgs, err = src.SplitCommandLine(lineFlag)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hasFile := false
+
+	for _, arg := range flagArgs {
+		hasFile = hasFile || strings.Contains(arg, "{file}")
+
+		arg = strings.ReplaceAll(arg, "{line}", strconv.Itoa(line))
+		args = append(args, strings.ReplaceAll(arg, "{file}", filePath))
+	}
+
+	if !hasFile {
+		args = append(args, filePath)
+	}
+
+	return args, nil
+}
+
+// StubBodyLine returns the line of a newly-created page that its content
+// should be written on: where its body template has the {{.Cursor}}, or
+// otherwise the last line of the template, which by default is the line
+// after the heading and its blank line. It's where any body it was created
+// with starts, or the body's BodyLine if it was given one
+func (page *Page) StubBodyLine() int {
+	content, line := page.renderStub("")
+
+	switch {
+	case line > 0 && page.bodyLine > 0:
+		return line + page.bodyLine - 1
+	case line > 0:
+		return line
+	case page.bodyLine > 0:
+		// The body starts on the line after the end of the template
+		return strings.Count(content, "\n") + page.bodyLine
+	}
 
-	return err
+	return strings.Count(content, "\n")
 }
 
 // PrettyDate returns a human-friendly representation of the CreatedAt date
 func (page *Page) PrettyDate() string {
-	return page.CreatedAt().Format("Jan 02, 2006")
+	return FormatDate(page.CreatedAt())
 }
 
-// Save writes the content of the page to file
-func (page *Page) Save() {
-	pageSrc := page.FrontMatter()
-	pageSrc += fmt.Sprintf("# %s\n\n", page.Title)
+// FormatDate writes the date the way PrettyDate does, with the DateFormat and
+// DateLocale, so that dates look the same everywhere
+func FormatDate(date time.Time) string {
+	return src.FormatDate(date, DateFormat, DateLocale)
+}
 
-	err := ioutil.WriteFile(page.FilePath, []byte(pageSrc), 0644)
-	if err != nil {
-		src.Defeat(err)
-	}
+// Save writes the content of the page to file
+func (page *Page) Save() error {
+	return page.save(OSFS{}, "")
 }
 
-// Tags returns a slice of tags assigned to this page
+// Tags returns a slice of tags assigned to this page. Tags that are empty once
+// they're cleaned up, like the ones in "go, , " or " , ", are left out
 func (page *Page) Tags() []*Tag {
-	tags := []*Tag{}
+	names := strings.Split(string(page.TagsStr), ",")
+	tags := make([]*Tag, 0, len(names))
 
-	names := strings.Split(page.TagsStr, ",")
 	for _, name := range names {
-		tags = append(tags, NewTag(name, page))
+		tag := NewTag(name, page)
+		if tag.IsValid() {
+			tags = append(tags, tag)
+		}
 	}
 
 	return tags
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+func freeFilePath(fsys FS, targetDir string, name string, reservedNames []string) (string, error) {
+	reserved := make(map[string]bool, len(reservedNames))
+	for _, reservedName := range reservedNames {
+		reserved[reservedName] = true
+	}
+
+	candidate := name
+
+	for i := 2; i < maxFreeFileTries+2; i++ {
+		filePath := filepath.Join(targetDir, fmt.Sprintf("%s.%s", candidate, FileExtension))
+
+		_, err := fsys.Stat(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if err != nil && !reserved[candidate] {
+			return filePath, nil
+		}
+
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+
+	return "", fmt.Errorf(errNoFreeFilePath, name, targetDir, maxFreeFileTries)
+}
+
+// save writes the content of the page to its file in fsys
+func (page *Page) save(fsys FS, body string) error {
+	return fsys.WriteFile(page.FilePath, []byte(page.stub(body)), 0644)
+}
+
+// stub returns what a newly-created page starts out as: its front-matter, its
+// body template, and the body it was created with. It always has LF line endings,
+// even if the body came from somewhere with CRLF ones
+func (page *Page) stub(body string) string {
+	content, _ := page.renderStub(body)
+
+	return string(normalizeLineEndings([]byte(content)))
+}
+
+// renderStub returns the page's stub, before its line endings are fixed,
+// and the line of the {{.Cursor}} in it, or 0 if its template hasn't got
+// one. A template that fails to write the page, which the config checks
+// should have caught, gives way to the default one
+func (page *Page) renderStub(body string) (string, int) {
+	frontMatter := page.FrontMatter()
+
+	tmpl := page.bodyTemplate
+	if tmpl == nil {
+		tmpl = defaultBodyTemplate
+	}
+
+	info := src.PageBodyInfo{Date: page.PrettyDate(), Tags: string(page.TagsStr), Title: page.Title}
+
+	rendered, line, err := src.RenderPageBody(tmpl, info, body)
+	if err != nil {
+		rendered, line, _ = src.RenderPageBody(defaultBodyTemplate, info, body)
+	}
+
+	if line > 0 {
+		line += strings.Count(frontMatter, "\n")
+	}
+
+	return frontMatter + rendered, line
+}
+
+// isBinary returns true if the start of the data has a NUL byte in it, which
+// text never does
+func isBinary(data []byte) bool {
+	if len(data) > binarySniffSize {
+		data = data[:binarySniffSize]
+	}
+
+	return bytes.IndexByte(data, 0) >= 0
+}
+
+// normalizeLineEndings returns the data without a UTF-8 byte order mark at the
+// start, and with its CRLF line endings made into LF ones
+func normalizeLineEndings(data []byte) []byte {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	return bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+}
+
+// restoreLineEndings returns data, which has had its line endings normalized,
+// with the byte order mark and CRLF line endings of the original, so that a
+// page that's rewritten keeps them. The original's first line says which
+// line endings it has
+func restoreLineEndings(original, data []byte) []byte {
+	if end := bytes.IndexByte(original, '\n'); end > 0 && original[end-1] == '\r' {
+		data = bytes.ReplaceAll(data, []byte("\n"), []byte("\r\n"))
+	}
+
+	if bytes.HasPrefix(original, []byte("\ufeff")) {
+		data = append([]byte("\ufeff"), data...)
+	}
+
+	return data
+}
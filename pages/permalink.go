@@ -0,0 +1,32 @@
+package pages
+
+import (
+	"net/url"
+	"strings"
+)
+
+// permalinkExtension is the extension a Markdown page has once GitHub Pages
+// has rendered it
+const permalinkExtension = "html"
+
+// Permalink returns the public URL of a file in the docs directory, given the
+// URL that the docs directory is published at (e.g.: a baseURL of
+// https://me.github.io/til and a relPath of tags/go.md give
+// https://me.github.io/til/tags/go.html). Everything that needs a page's
+// public URL builds it here, so that they always agree
+func Permalink(baseURL, relPath string) string {
+	relPath = strings.TrimSuffix(strings.ReplaceAll(relPath, `\`, "/"), "."+FileExtension)
+
+	parts := []string{}
+	for _, part := range strings.Split(strings.Trim(relPath, "/"), "/") {
+		parts = append(parts, url.PathEscape(part))
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.Join(parts, "/") + "." + permalinkExtension
+}
+
+// Permalink returns the public URL of the page, given the URL that the docs
+// directory is published at
+func (page *Page) Permalink(baseURL string) string {
+	return Permalink(baseURL, page.LinkPath())
+}
@@ -0,0 +1,146 @@
+package pages
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// slugHashLen is the number of hex characters used for a hashed slug
+const slugHashLen = 8
+
+// transliterations maps lower-case non-ASCII characters to their closest
+// ASCII equivalents. Characters not in here are dropped from slugs
+var transliterations = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae",
+	'ç': "c", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c",
+	'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g",
+	'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i",
+	'ĵ': "j",
+	'ķ': "k",
+	'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ŏ': "o", 'ő': "o",
+	'œ': "oe",
+	'ŕ': "r", 'ŗ': "r", 'ř': "r",
+	'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ș': "s",
+	'ß': "ss",
+	'ţ': "t", 'ť': "t", 'ŧ': "t", 'ț': "t",
+	'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w",
+	'ý': "y", 'ÿ': "y", 'ŷ': "y",
+	'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// elisions are characters that are removed outright rather than being turned
+// into a hyphen, so that "what's" becomes "whats" and not "what-s"
+var elisions = map[rune]bool{
+	'\'': true, '"': true, '`': true, '‘': true, '’': true, '“': true, '”': true,
+}
+
+// Slug returns the filename-friendly version of a title. The result only
+// contains lower-case ASCII letters, digits, and single hyphens, which makes
+// it safe to use as a filename on Linux, macOS, and Windows.
+// Non-ASCII letters are transliterated to ASCII where possible and dropped
+// where not. Whitespace, punctuation, and path separators become hyphens.
+// A title with nothing left that can be used (all CJK or emoji, for example)
+// falls back to a short hash of the title so that the slug is never empty
+func Slug(title string) string {
+	var builder strings.Builder
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case elisions[r]:
+			continue
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			builder.WriteRune(r)
+		case r < utf8.RuneSelf, unicode.IsSpace(r), unicode.IsPunct(r), unicode.IsSymbol(r):
+			builder.WriteRune('-')
+		default:
+			builder.WriteString(transliterations[r])
+		}
+	}
+
+	slug := collapseHyphens(builder.String())
+	if slug == "" {
+		return hashedSlug(title)
+	}
+
+	return slug
+}
+
+// tagSlugReplacer spells out the symbols that commonly give a programming tag
+// its meaning, so that c++ and c# don't both end up as plain c
+var tagSlugReplacer = strings.NewReplacer("+", "plus", "#", "sharp")
+
+// TagSlug returns the filename- and URL-friendly version of a tag name (e.g.:
+// "Machine Learning" becomes machine-learning, c++ becomes cplusplus). Each
+// part of a hierarchical tag is slugged separately, keeping the separators
+func TagSlug(name string) string {
+	parts := []string{}
+
+	for _, part := range strings.Split(name, TagSeparator) {
+		parts = append(parts, Slug(tagSlugReplacer.Replace(part)))
+	}
+
+	return strings.Join(parts, TagSeparator)
+}
+
+// AuthorSlug returns the filename- and URL-friendly version of an author's
+// name, slugged the way a tag's name is (e.g.: "Zoë Ann" becomes zoe-ann).
+// Authors whose names only differ in their case, accents, or spacing have
+// the same slug, so their pages are grouped together
+func AuthorSlug(name string) string {
+	return Slug(tagSlugReplacer.Replace(strings.TrimSpace(name)))
+}
+
+// TruncateSlug shortens slug to at most maxLen bytes, cutting at a hyphen so
+// that words are kept whole. A single word longer than maxLen is cut at the
+// last full character that fits. A maxLen of zero or less means no limit
+func TruncateSlug(slug string, maxLen int) string {
+	if maxLen <= 0 || len(slug) <= maxLen {
+		return slug
+	}
+
+	if slug[maxLen] == '-' {
+		return strings.Trim(slug[:maxLen], "-")
+	}
+
+	cut := slug[:maxLen]
+
+	if idx := strings.LastIndex(cut, "-"); idx > 0 {
+		return strings.Trim(cut[:idx], "-")
+	}
+
+	// No word boundary to cut at, so make sure we don't split a multi-byte
+	// character in two
+	end := maxLen
+	for end > 0 && !utf8.RuneStart(slug[end]) {
+		end--
+	}
+
+	return slug[:end]
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// collapseHyphens replaces runs of hyphens with a single hyphen, and removes
+// leading and trailing hyphens
+func collapseHyphens(str string) string {
+	for strings.Contains(str, "--") {
+		str = strings.ReplaceAll(str, "--", "-")
+	}
+
+	return strings.Trim(str, "-")
+}
+
+func hashedSlug(title string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(title)))[:slugHashLen]
+}
@@ -0,0 +1,156 @@
+package pages
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// mentionWeight is how much each mention of a tag in the page's content
+	// counts for, relative to a single co-occurrence
+	mentionWeight = 2
+)
+
+// TagSuggestion is a tag that might suit a page, along with how strongly it
+// is suggested. A higher score is a better suggestion
+type TagSuggestion struct {
+	Name  string
+	Score int
+}
+
+// SuggestTags returns the tags already in use in tm that the page doesn't have
+// but might suit it, best suggestion first.
+// A tag scores for every time its name (or one of its aliases, or the last
+// part of a hierarchical tag) is mentioned in the page's content. It also
+// scores for every other page that has both the tag and one of the tags that
+// were mentioned, so tags that usually go together get suggested together.
+// Tags with the same score are sorted by name, so the result is always the
+// same for the same pages
+func SuggestTags(page *Page, tm *TagMap) []TagSuggestion {
+	existing := map[string]bool{}
+	for _, tag := range page.Tags() {
+		existing[tm.CanonicalName(tag.Name)] = true
+
+		for _, ancestor := range tag.Ancestors() {
+			existing[tm.CanonicalName(ancestor)] = true
+		}
+	}
+
+	tokens := tokenize(page.Content)
+	scores := map[string]int{}
+
+	for term, names := range tm.suggestionTerms() {
+		if count := countPhrase(tokens, tokenize(term)); count > 0 {
+			for _, name := range names {
+				scores[name] += mentionWeight * count
+			}
+		}
+	}
+
+	mentioned := []string{}
+	for name := range scores {
+		mentioned = append(mentioned, name)
+	}
+
+	for _, name := range mentioned {
+		for _, other := range tm.PagesFor(name) {
+			if other.FilePath == page.FilePath {
+				continue
+			}
+
+			for _, related := range relatedTagNames(other, name, tm) {
+				scores[related]++
+			}
+		}
+	}
+
+	suggestions := []TagSuggestion{}
+	for name, score := range scores {
+		if !existing[name] {
+			suggestions = append(suggestions, TagSuggestion{Name: name, Score: score})
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+
+		return strings.ToLower(suggestions[i].Name) < strings.ToLower(suggestions[j].Name)
+	})
+
+	return suggestions
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// suggestionTerms returns the words that count as a mention of a tag, mapped
+// to the canonical names of the tags they stand for. A hierarchical tag is
+// mentioned by the last part of its name, so go/concurrency is mentioned by
+// "concurrency"
+func (tm *TagMap) suggestionTerms() map[string][]string {
+	terms := map[string][]string{}
+
+	for name, tags := range tm.Tags {
+		term := strings.ToLower(tags[0].ShortName())
+		terms[term] = append(terms[term], name)
+	}
+
+	for alias, name := range tm.options.Aliases {
+		if canonical := tm.CanonicalName(name); len(tm.Tags[canonical]) > 0 {
+			terms[alias] = append(terms[alias], canonical)
+		}
+	}
+
+	return terms
+}
+
+// relatedTagNames returns the canonical names of the page's tags, other than
+// the given one and its own ancestors
+func relatedTagNames(page *Page, name string, tm *TagMap) []string {
+	names := []string{}
+
+	for _, canonical := range tm.pageTagNames(page) {
+		if canonical != name && !isAncestor(canonical, name) {
+			names = append(names, canonical)
+		}
+	}
+
+	return names
+}
+
+// tokenize splits text into lower-case words. The + and # characters are
+// kept as part of a word so that c++ and c# are not mistaken for c
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#')
+	})
+}
+
+// countPhrase returns the number of times the phrase appears in tokens as a
+// run of whole words
+func countPhrase(tokens, phrase []string) int {
+	if len(phrase) == 0 {
+		return 0
+	}
+
+	count := 0
+
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		matched := true
+
+		for j, word := range phrase {
+			if tokens[i+j] != word {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			count++
+		}
+	}
+
+	return count
+}
@@ -2,10 +2,51 @@ package pages
 
 import (
 	"fmt"
+	"path"
 	"strings"
+	"time"
 )
 
-// Tag represents a page tag (e.g.: linux, zombies)
+const (
+	// TagSeparator splits a hierarchical tag into its parts (e.g.: go/concurrency)
+	TagSeparator = "/"
+
+	// tagsDir is the directory, under the docs directory, that the pages for
+	// child tags are written into
+	tagsDir = "tags"
+)
+
+// TagStats are the usage statistics for a tag
+type TagStats struct {
+	// Count is the number of pages with the tag
+	Count int
+
+	// FirstUsed is the date of the earliest page with the tag. Pages with
+	// unparseable dates are counted, but don't affect the dates
+	FirstUsed time.Time
+
+	// LastUsed is the date of the most recent page with the tag
+	LastUsed time.Time
+}
+
+// Summary returns a human-friendly description of the stats
+// (e.g.: 42 entries, last updated May 2024)
+func (stats TagStats) Summary() string {
+	noun := "entries"
+	if stats.Count == 1 {
+		noun = "entry"
+	}
+
+	if stats.LastUsed.IsZero() {
+		return fmt.Sprintf("%d %s", stats.Count, noun)
+	}
+
+	return fmt.Sprintf("%d %s, last updated %s", stats.Count, noun, stats.LastUsed.Format("Jan 2006"))
+}
+
+// Tag represents a page tag (e.g.: linux, zombies).
+// Tags can be hierarchical (e.g.: go/concurrency), in which case every
+// ancestor (go) is an implicit parent tag
 type Tag struct {
 	Name  string
 	Pages []*Page
@@ -14,7 +55,7 @@ type Tag struct {
 // NewTag creates and returns an instance of Tag
 func NewTag(name string, page *Page) *Tag {
 	tag := &Tag{
-		Name:  strings.TrimSpace(name),
+		Name:  cleanTagName(name),
 		Pages: []*Page{page},
 	}
 
@@ -26,12 +67,78 @@ func (tag *Tag) AddPage(page *Page) {
 	tag.Pages = append(tag.Pages, page)
 }
 
+// Ancestors returns the names of the tag's implicit parent tags, from the
+// top down. For go/concurrency/channels that is go and go/concurrency
+func (tag *Tag) Ancestors() []string {
+	if !strings.Contains(tag.Name, TagSeparator) {
+		return []string{}
+	}
+
+	parts := strings.Split(tag.Name, TagSeparator)
+	ancestors := []string{}
+
+	for i := 1; i < len(parts); i++ {
+		ancestors = append(ancestors, strings.Join(parts[:i], TagSeparator))
+	}
+
+	return ancestors
+}
+
+// Stats returns the usage statistics for the tag, computed from its pages
+func (tag *Tag) Stats() TagStats {
+	stats := TagStats{}
+	seen := map[*Page]bool{}
+
+	for _, page := range tag.Pages {
+		if seen[page] {
+			continue
+		}
+
+		seen[page] = true
+		stats.Count++
+
+		date := page.CreatedAt()
+		if date.IsZero() {
+			continue
+		}
+
+		if stats.FirstUsed.IsZero() || date.Before(stats.FirstUsed) {
+			stats.FirstUsed = date
+		}
+
+		if date.After(stats.LastUsed) {
+			stats.LastUsed = date
+		}
+	}
+
+	return stats
+}
+
+// Breadcrumb returns a Markdown trail of links from the top-level parent tag
+// down to this tag, for display on the tag's page. Top-level tags have no
+// parents, so their breadcrumb is just their name
+func (tag *Tag) Breadcrumb() string {
+	crumbs := []string{}
+	prefix := tag.RootPrefix()
+
+	for _, ancestor := range tag.Ancestors() {
+		parent := &Tag{Name: ancestor}
+		crumbs = append(crumbs, fmt.Sprintf("[%s](%s%s)", parent.ShortName(), prefix, parent.linkPath()))
+	}
+
+	crumbs = append(crumbs, tag.ShortName())
+
+	return strings.Join(crumbs, " / ")
+}
+
 // IsValid returns true if this is a valid tag, false if it is not
 func (tag *Tag) IsValid() bool {
 	return tag.Name != ""
 }
 
 // Link returns a link string suitable for embedding in a Markdown page
+// that lives in the docs directory. The link text is the tag's name as
+// written, the link itself uses the tag's slug
 func (tag *Tag) Link() string {
 	if tag.Name == "" {
 		return ""
@@ -40,6 +147,70 @@ func (tag *Tag) Link() string {
 	return fmt.Sprintf(
 		"[%s](%s)",
 		tag.Name,
-		fmt.Sprintf("./%s", tag.Name),
+		fmt.Sprintf("./%s", tag.linkPath()),
 	)
 }
+
+// PagePath returns the path of the tag's page, relative to the docs
+// directory and using forward slashes. Top-level tags live in the docs
+// directory itself, child tags live in a tree under docs/tags/
+func (tag *Tag) PagePath() string {
+	return fmt.Sprintf("%s.%s", tag.linkPath(), FileExtension)
+}
+
+// RootPrefix returns the relative path from the tag's page back up to the
+// docs directory (e.g.: ../../), for building links to other pages
+func (tag *Tag) RootPrefix() string {
+	depth := strings.Count(tag.PagePath(), "/")
+
+	return strings.Repeat("../", depth)
+}
+
+// ShortName returns the last part of a hierarchical tag's name. For
+// go/concurrency that is concurrency
+func (tag *Tag) ShortName() string {
+	return path.Base(tag.Name)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// linkPath returns the path of the tag's page, relative to the docs directory,
+// without the file extension
+func (tag *Tag) linkPath() string {
+	slug := TagSlug(tag.Name)
+
+	if !strings.Contains(slug, TagSeparator) {
+		return slug
+	}
+
+	return path.Join(tagsDir, slug)
+}
+
+// cleanTagName trims the whitespace from a tag name and every part of a
+// hierarchical tag name. Empty parts, and parts that would navigate around
+// the file system ("." and ".."), are dropped
+func cleanTagName(name string) string {
+	// Most tags are top-level ones, with nothing to split up
+	if !strings.Contains(name, TagSeparator) {
+		name = strings.TrimSpace(name)
+		if name == "." || name == ".." {
+			return ""
+		}
+
+		return name
+	}
+
+	parts := []string{}
+
+	for _, part := range strings.Split(name, TagSeparator) {
+		part = strings.TrimSpace(part)
+
+		if part == "" || part == "." || part == ".." {
+			continue
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, TagSeparator)
+}
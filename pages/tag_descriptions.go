@@ -0,0 +1,50 @@
+package pages
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// TagDescription is the optional extra information about a tag that is shown
+// on its tag page
+type TagDescription struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+}
+
+// TagDescriptions maps tag names to their descriptions. They are read from a
+// YAML file that looks like:
+//
+//	go:
+//	  title: Go
+//	  description: Notes on the Go programming language
+type TagDescriptions map[string]TagDescription
+
+// ParseTagDescriptions reads tag descriptions from YAML
+func ParseTagDescriptions(data []byte) (TagDescriptions, error) {
+	descs := TagDescriptions{}
+
+	err := yaml.Unmarshal(data, &descs)
+	if err != nil {
+		return TagDescriptions{}, err
+	}
+
+	return descs, nil
+}
+
+// For returns the description for a tag name, matched case-insensitively.
+// Tags without a description return an empty TagDescription
+func (descs TagDescriptions) For(tagName string) TagDescription {
+	if desc, ok := descs[tagName]; ok {
+		return desc
+	}
+
+	for name, desc := range descs {
+		if strings.EqualFold(name, tagName) {
+			return desc
+		}
+	}
+
+	return TagDescription{}
+}
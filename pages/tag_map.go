@@ -2,17 +2,49 @@ package pages
 
 import (
 	"sort"
+	"strings"
 )
 
-// TagMap is a map of tag name to Tag instance
+// TagMap is a map of tag name to Tag instance.
+// Tag names are grouped case-insensitively, so "Go" and "go" end up in the
+// same bucket under a single canonical name
 type TagMap struct {
 	Tags map[string][]*Tag
+
+	options   TagMapOptions
+	canonical map[string]string
+}
+
+// TagCount is a tag name along with a number of pages
+type TagCount struct {
+	Name  string
+	Count int
+}
+
+// TagMapOptions defines how tags are grouped in a TagMap
+type TagMapOptions struct {
+	// Aliases maps lower-case alias names to the tags they stand for. Pages
+	// tagged with an alias are grouped under the tag instead
+	Aliases map[string]string
+
+	// LowercaseNames uses the lower-case form of a tag as its canonical name.
+	// Otherwise the canonical name is the form the tag was first seen in
+	LowercaseNames bool
 }
 
 // NewTagMap creates and returns an instance of TagMap
 func NewTagMap(pageSet []*Page) *TagMap {
+	return NewTagMapWithOptions(pageSet, TagMapOptions{})
+}
+
+// NewTagMapWithOptions creates and returns an instance of TagMap that groups
+// its tags as defined by opts
+func NewTagMapWithOptions(pageSet []*Page, opts TagMapOptions) *TagMap {
 	tm := &TagMap{
-		Tags: make(map[string][]*Tag),
+		Tags: make(map[string][]*Tag, tagMapSize(pageSet)),
+
+		options:   opts,
+		canonical: make(map[string]string, tagMapSize(pageSet)),
 	}
 
 	tm.BuildFromPages(pageSet)
@@ -26,21 +58,120 @@ func (tm *TagMap) Add(tag *Tag) {
 		return
 	}
 
-	tm.Tags[tag.Name] = append(tm.Tags[tag.Name], tag)
+	if tm.canonical == nil {
+		tm.canonical = make(map[string]string)
+	}
+
+	name := tm.resolveAlias(tag.Name)
+	key := strings.ToLower(name)
+
+	canonical, ok := tm.canonical[key]
+	if !ok {
+		canonical = name
+		if tm.options.LowercaseNames {
+			canonical = key
+		}
+
+		tm.canonical[key] = canonical
+	}
+
+	tag.Name = canonical
+
+	tm.Tags[canonical] = append(tm.Tags[canonical], tag)
 }
 
-// BuildFromPages populates the tag map from a slice of Page instances
+// BuildFromPages populates the tag map from a slice of Page instances.
+// A page with a hierarchical tag (go/concurrency) is also added to each of
+// the tag's implicit parents (go)
 func (tm *TagMap) BuildFromPages(pages []*Page) {
 	for _, page := range pages {
 		for _, tag := range page.Tags() {
 			tm.Add(tag)
+
+			for _, ancestor := range tag.Ancestors() {
+				tm.Add(NewTag(ancestor, page))
+			}
 		}
 	}
 }
 
-// Get returns the tags for a given tag name
+// CanonicalName returns the name that a tag is grouped under, regardless of
+// the case it is given in or whether it is an alias. If the tag isn't in the
+// map, it returns the tag an alias stands for, or else the name unchanged
+func (tm *TagMap) CanonicalName(name string) string {
+	resolved := tm.resolveAlias(strings.TrimSpace(name))
+
+	if canonical, ok := tm.canonical[strings.ToLower(resolved)]; ok {
+		return canonical
+	}
+
+	if resolved != strings.TrimSpace(name) {
+		return resolved
+	}
+
+	return name
+}
+
+// CoOccurrences returns, for each tag, the other tags that are on the same
+// pages and the number of pages they share, most shared first. Tags sharing
+// the same number of pages are sorted by name. A tag never lists itself, or
+// its own parent tags, which are on every one of its pages anyway
+func (tm *TagMap) CoOccurrences() map[string][]TagCount {
+	counts := map[string]map[string]int{}
+	seen := map[*Page]bool{}
+
+	for _, tags := range tm.Tags {
+		for _, tag := range tags {
+			for _, page := range tag.Pages {
+				if seen[page] {
+					continue
+				}
+
+				seen[page] = true
+				names := tm.pageTagNames(page)
+
+				for _, name := range names {
+					for _, other := range names {
+						if other == name || isAncestor(other, name) {
+							continue
+						}
+
+						if counts[name] == nil {
+							counts[name] = map[string]int{}
+						}
+
+						counts[name][other]++
+					}
+				}
+			}
+		}
+	}
+
+	coOccurrences := map[string][]TagCount{}
+
+	for name, others := range counts {
+		related := []TagCount{}
+		for other, count := range others {
+			related = append(related, TagCount{Name: other, Count: count})
+		}
+
+		sort.Slice(related, func(i, j int) bool {
+			if related[i].Count != related[j].Count {
+				return related[i].Count > related[j].Count
+			}
+
+			return strings.ToLower(related[i].Name) < strings.ToLower(related[j].Name)
+		})
+
+		coOccurrences[name] = related
+	}
+
+	return coOccurrences
+}
+
+// Get returns the tags for a given tag name, in any case
 func (tm *TagMap) Get(name string) []*Tag {
-	return tm.Tags[name]
+	return tm.Tags[tm.CanonicalName(name)]
 }
 
 // Len returns the number of tags in the map
@@ -48,24 +179,137 @@ func (tm *TagMap) Len() int {
 	return len(tm.Tags)
 }
 
+// PageCount returns the number of pages with a given tag name, counting a page
+// that's tagged with both a tag and one of its children once. It's
+// len(PagesFor), without the sorting
+func (tm *TagMap) PageCount(tagName string) int {
+	tags := tm.Get(tagName)
+	seen := make(map[*Page]bool, len(tags))
+
+	for _, tag := range tags {
+		for _, page := range tag.Pages {
+			seen[page] = true
+		}
+	}
+
+	return len(seen)
+}
+
 // PagesFor returns a flattened slice of pages for a given tag name, sorted
-// in reverse-chronological order
+// in reverse-chronological order. A page appears only once, even if it is
+// tagged with both a tag and one of its children
 func (tm *TagMap) PagesFor(tagName string) []*Page {
 	pages := []*Page{}
+	seen := map[*Page]bool{}
 	tags := tm.Get(tagName)
 
 	for _, tag := range tags {
-		pages = append(pages, tag.Pages...)
+		for _, page := range tag.Pages {
+			if seen[page] {
+				continue
+			}
 
-		sort.Slice(pages, func(i, j int) bool {
-			return pages[i].CreatedAt().After(pages[j].CreatedAt())
-		})
+			seen[page] = true
+			pages = append(pages, page)
+		}
 	}
 
+	SortNewestFirst(pages)
+
 	return pages
 }
 
-// SortedTagNames returns the tag names in alphabetical order
+// PagePathCollisions returns the tags whose names are different but whose tag
+// pages would be written to the same file (e.g.: "Machine Learning" and
+// machine-learning), as a map of page path to the colliding tag names
+func (tm *TagMap) PagePathCollisions() map[string][]string {
+	byPath := map[string][]string{}
+
+	for _, name := range tm.SortedTagNames() {
+		tag := &Tag{Name: name}
+		byPath[tag.PagePath()] = append(byPath[tag.PagePath()], name)
+	}
+
+	collisions := map[string][]string{}
+	for pagePath, names := range byPath {
+		if len(names) > 1 {
+			collisions[pagePath] = names
+		}
+	}
+
+	return collisions
+}
+
+// Stats returns the usage statistics for a given tag name, across all the
+// pages with that tag
+func (tm *TagMap) Stats(tagName string) TagStats {
+	tag := &Tag{
+		Name:  tm.CanonicalName(tagName),
+		Pages: tm.PagesFor(tagName),
+	}
+
+	return tag.Stats()
+}
+
+// Rebuild throws away every tag in the map and builds it again from the given
+// pages, keeping the map's options. Canonical names are chosen afresh, so a
+// tag is named after the first form seen in the new pages
+func (tm *TagMap) Rebuild(pages []*Page) {
+	tm.Tags = make(map[string][]*Tag, tagMapSize(pages))
+	tm.canonical = make(map[string]string, tagMapSize(pages))
+
+	tm.BuildFromPages(pages)
+}
+
+// Remove removes a tag from the map, given its name in any case or one of its
+// aliases. Its child tags are removed along with it, because a page with a
+// child tag always has the parent tag as well
+func (tm *TagMap) Remove(name string) {
+	canonical := strings.ToLower(tm.CanonicalName(name))
+
+	for tagName := range tm.Tags {
+		key := strings.ToLower(tagName)
+
+		if key == canonical || strings.HasPrefix(key, canonical+TagSeparator) {
+			tm.delete(tagName)
+		}
+	}
+}
+
+// RemovePage removes a page from every tag in the map. A tag that loses its
+// last page is removed too
+func (tm *TagMap) RemovePage(page *Page) {
+	for tagName, tags := range tm.Tags {
+		kept := []*Tag{}
+
+		for _, tag := range tags {
+			pages := []*Page{}
+
+			for _, tagPage := range tag.Pages {
+				if tagPage != page {
+					pages = append(pages, tagPage)
+				}
+			}
+
+			if len(pages) == 0 && len(tag.Pages) > 0 {
+				continue
+			}
+
+			tag.Pages = pages
+			kept = append(kept, tag)
+		}
+
+		if len(kept) == 0 {
+			tm.delete(tagName)
+			continue
+		}
+
+		tm.Tags[tagName] = kept
+	}
+}
+
+// SortedTagNames returns the canonical tag names in case-insensitive
+// alphabetical order
 func (tm *TagMap) SortedTagNames() []string {
 	tagArr := make([]string, tm.Len())
 	i := 0
@@ -75,7 +319,64 @@ func (tm *TagMap) SortedTagNames() []string {
 		i++
 	}
 
-	sort.Strings(tagArr)
+	sort.Slice(tagArr, func(i, j int) bool {
+		return strings.ToLower(tagArr[i]) < strings.ToLower(tagArr[j])
+	})
 
 	return tagArr
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// delete removes everything the map knows about a canonical tag name
+func (tm *TagMap) delete(tagName string) {
+	delete(tm.Tags, tagName)
+	delete(tm.canonical, strings.ToLower(tagName))
+}
+
+// pageTagNames returns the canonical names of the page's tags, including the
+// implicit parents of any child tags, without duplicates
+func (tm *TagMap) pageTagNames(page *Page) []string {
+	names := []string{}
+	seen := map[string]bool{}
+
+	for _, tag := range page.Tags() {
+		if !tag.IsValid() {
+			continue
+		}
+
+		for _, name := range append([]string{tag.Name}, tag.Ancestors()...) {
+			canonical := tm.CanonicalName(name)
+
+			if !seen[canonical] {
+				seen[canonical] = true
+				names = append(names, canonical)
+			}
+		}
+	}
+
+	return names
+}
+
+// tagMapSize guesses at the number of tags the pages have, to size a tag
+// map's maps with. Pages share most of their tags, so it's far fewer than
+// there are pages
+func tagMapSize(pageSet []*Page) int {
+	return len(pageSet)/16 + 8
+}
+
+// isAncestor returns true if the tag named parent is one of the implicit
+// parents of the tag named child (e.g.: go is an ancestor of go/concurrency)
+func isAncestor(parent, child string) bool {
+	return strings.HasPrefix(strings.ToLower(child), strings.ToLower(parent)+TagSeparator)
+}
+
+// resolveAlias returns the tag that name is an alias for, or name itself if
+// it isn't an alias
+func (tm *TagMap) resolveAlias(name string) string {
+	if tag, ok := tm.options.Aliases[strings.ToLower(name)]; ok {
+		return tag
+	}
+
+	return name
+}
@@ -0,0 +1,82 @@
+package pages
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// smallWords are left lower-case in a title, unless they are the first word
+var smallWords = map[string]bool{
+	"a": true, "an": true, "and": true, "as": true, "at": true, "but": true,
+	"by": true, "for": true, "from": true, "in": true, "into": true, "nor": true,
+	"of": true, "on": true, "or": true, "the": true, "to": true, "vs": true,
+	"via": true, "with": true,
+}
+
+// knownCasings are words that have a conventional casing which can't be
+// derived from simply capitalizing the first letter
+var knownCasings = map[string]string{
+	"api": "API", "aws": "AWS", "cli": "CLI", "cpu": "CPU", "css": "CSS",
+	"dns": "DNS", "github": "GitHub", "gpu": "GPU", "grpc": "gRPC", "html": "HTML",
+	"http": "HTTP", "https": "HTTPS", "io": "IO", "ios": "iOS", "ip": "IP",
+	"javascript": "JavaScript", "json": "JSON", "jwt": "JWT", "macos": "macOS",
+	"os": "OS", "sql": "SQL", "ssh": "SSH", "tcp": "TCP", "til": "TIL",
+	"tls": "TLS", "typescript": "TypeScript", "udp": "UDP", "ui": "UI",
+	"url": "URL", "xml": "XML", "yaml": "YAML",
+}
+
+// TitleCase capitalizes a title for display. Small words (a, an, the, of,
+// in...) are lower-cased, words that already contain an upper-case letter
+// (JSON, gRPC, macOS) are left exactly as they are, well-known acronyms are
+// upper-cased, and the first word (and the first word after a colon, as in
+// "Go: The Good Parts") is always capitalized
+func TitleCase(title string) string {
+	words := strings.Split(title, " ")
+	isFirst := true
+
+	for i, word := range words {
+		words[i] = titleCaseWord(word, isFirst)
+
+		if strings.TrimSpace(word) != "" {
+			isFirst = strings.HasSuffix(word, ":")
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// titleCaseWord cases a single word. Leading and trailing punctuation, as in
+// "(json)" or "go:", is kept but ignored when deciding how to case the word
+func titleCaseWord(word string, isFirst bool) string {
+	start := strings.IndexFunc(word, isWordRune)
+	if start < 0 {
+		return word
+	}
+
+	end := strings.LastIndexFunc(word, isWordRune)
+	_, size := utf8.DecodeRuneInString(word[end:])
+	end += size
+
+	prefix, core, suffix := word[:start], word[start:end], word[end:]
+
+	switch {
+	case strings.IndexFunc(core, unicode.IsUpper) >= 0:
+		// Already cased by the author, so trust them
+	case knownCasings[core] != "":
+		core = knownCasings[core]
+	case smallWords[core] && !isFirst:
+		// Small words stay lower-case
+	default:
+		r, size := utf8.DecodeRuneInString(core)
+		core = string(unicode.ToUpper(r)) + core[size:]
+	}
+
+	return prefix + core + suffix
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
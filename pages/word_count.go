@@ -0,0 +1,73 @@
+package pages
+
+import (
+	"regexp"
+	"strings"
+)
+
+// linkTargetRegex matches the target of a Markdown link or image, the
+// (https://...) after its text, which isn't anything that was written
+var linkTargetRegex = regexp.MustCompile(`\]\([^)]*\)`)
+
+// orderedListRegex matches the number that starts an item of an ordered list
+var orderedListRegex = regexp.MustCompile(`^\d+[.)]\s`)
+
+// WordCount returns the number of words of prose in the page, which is
+// everything but its fenced code blocks. Markdown's markup, like the # of a
+// heading, a list's bullets, and the addresses of links, isn't counted
+func (page *Page) WordCount() int {
+	words, _ := CountWords(page.Content)
+
+	return words
+}
+
+// CodeLines returns the number of lines in the page's fenced code blocks,
+// not counting the blank ones
+func (page *Page) CodeLines() int {
+	_, lines := CountWords(page.Content)
+
+	return lines
+}
+
+// CountWords returns the number of words of prose in the content, and the
+// number of lines of code in its fenced code blocks. A word is anything
+// between spaces with a letter or a digit in it. Pasting in a long piece of
+// code adds to the code lines, not the words
+func CountWords(content string) (words, codeLines int) {
+	fence := ""
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case fence == "" && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")):
+			fence = trimmed[:3]
+		case fence != "" && strings.HasPrefix(trimmed, fence):
+			fence = ""
+		case fence != "":
+			if trimmed != "" {
+				codeLines++
+			}
+		default:
+			words += countLineWords(trimmed)
+		}
+	}
+
+	return words, codeLines
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// countLineWords returns the number of words in a line of prose
+func countLineWords(line string) int {
+	count := 0
+	line = orderedListRegex.ReplaceAllString(line, "")
+
+	for _, field := range strings.Fields(linkTargetRegex.ReplaceAllString(line, "]")) {
+		if strings.IndexFunc(field, isWordRune) >= 0 {
+			count++
+		}
+	}
+
+	return count
+}
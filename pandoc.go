@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	errPandocFailed  = "pandoc couldn't export to %s: %s"
+	errPandocFormat  = "--via-pandoc needs --to, with the format to export to, like docx, pdf, or epub"
+	errPandocMissing = "--via-pandoc needs pandoc, which isn't installed. See https://pandoc.org/installing.html"
+	errPandocNoPages = "there are no pages to export"
+
+	// pandocBookTitle is the title of the book that --all exports
+	pandocBookTitle = "TIL"
+
+	statusPandocExport = "exported %s to %s"
+)
+
+// pandocExtensions are the file extensions of the formats whose names aren't
+// the extension themselves
+var pandocExtensions = map[string]string{
+	"asciidoc":   "adoc",
+	"commonmark": "md",
+	"gfm":        "md",
+	"html5":      "html",
+	"latex":      "tex",
+	"markdown":   "md",
+	"plain":      "txt",
+	"revealjs":   "html",
+}
+
+// pandocMetadata is the front-matter of a page, as pandoc's metadata
+type pandocMetadata struct {
+	Title    string   `yaml:"title"`
+	Date     string   `yaml:"date,omitempty"`
+	Keywords []string `yaml:"keywords,omitempty"`
+}
+
+// exportPandoc exports the page that matches the query, or with all, every
+// page as one book, to the format with pandoc. The file is written to outPath,
+// or else to the slug of the page's title, or the book's, in the working
+// directory
+func exportPandoc(ctx context.Context, query string, all bool, format, outPath string) error {
+	if format == "" {
+		return &src.UsageError{Err: errors.New(errPandocFormat)}
+	}
+
+	_, err := lookPath("pandoc")
+	if err != nil {
+		return errors.New(errPandocMissing)
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		return err
+	}
+
+	var document, title string
+
+	if all {
+		document, err = pandocBook(pageSet)
+		if err != nil {
+			return err
+		}
+
+		title = pandocBookTitle
+	} else {
+		page, err := pickPage(pageSet, query)
+		if err != nil {
+			return err
+		}
+
+		document = pandocPage(page)
+		title = page.Title
+	}
+
+	if outPath == "" {
+		outPath = pandocFileName(title, format)
+	}
+
+	// pandoc runs in the docs directory so that it finds the pages' images,
+	// which means the output has to be somewhere it can find from there
+	outPath, err = filepath.Abs(outPath)
+	if err != nil {
+		return err
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return err
+	}
+
+	input, err := ioutil.TempFile("", "til-*."+pages.FileExtension)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(input.Name())
+
+	_, err = input.WriteString(document)
+	if closeErr := input.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	out, err := runCommand(tDir, "pandoc", pandocArgs(format, input.Name(), outPath)...)
+	if err != nil {
+		return fmt.Errorf(errPandocFailed, format, pandocError(out, err))
+	}
+
+	src.Info(fmt.Sprintf(statusPandocExport, title, outPath))
+
+	return nil
+}
+
+// pandocArgs returns the arguments pandoc is run with to turn the Markdown in
+// inputPath into a standalone document in the format at outPath. pandoc
+// can't be told --to pdf, since it makes PDFs by way of LaTeX, so it goes by
+// outPath's extension for those
+func pandocArgs(format, inputPath, outPath string) []string {
+	args := []string{"--from", "markdown", "--standalone"}
+
+	if format != "pdf" {
+		args = append(args, "--to", format)
+	}
+
+	return append(args, "--output", outPath, inputPath)
+}
+
+// pandocPage returns the Markdown that pandoc is given for a page: its
+// front-matter as pandoc's metadata, and its body without the heading, which
+// pandoc makes from the title
+func pandocPage(page *pages.Page) string {
+	return pandocMetadataBlock(pandocMetadata{
+		Title:    page.Title,
+		Date:     pandocDate(page.CreatedAt()),
+		Keywords: pandocKeywords([]*pages.Page{page}),
+	}) + exportBody(page)
+}
+
+// pandocBook returns the Markdown that pandoc is given for a book of all the
+// pages, from the oldest to the newest, with a chapter for each
+func pandocBook(pageSet []*pages.Page) (string, error) {
+	chapters := []*pages.Page{}
+
+	// The pages are loaded newest first
+	for i := len(pageSet) - 1; i >= 0; i-- {
+		if pageSet[i].IsContentPage() {
+			chapters = append(chapters, pageSet[i])
+		}
+	}
+
+	if len(chapters) == 0 {
+		return "", errors.New(errPandocNoPages)
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(pandocMetadataBlock(pandocMetadata{
+		Title:    pandocBookTitle,
+		Date:     pandocDate(chapters[len(chapters)-1].CreatedAt()),
+		Keywords: pandocKeywords(chapters),
+	}))
+
+	for _, page := range chapters {
+		builder.WriteString(fmt.Sprintf("# %s\n\n", page.Title))
+
+		if !page.CreatedAt().IsZero() {
+			builder.WriteString(fmt.Sprintf("*%s*\n\n", page.PrettyDate()))
+		}
+
+		builder.WriteString(strings.TrimRight(exportBody(page), "\n"))
+		builder.WriteString("\n\n")
+	}
+
+	return builder.String(), nil
+}
+
+// pandocFileName returns the file a document is exported to when no other is
+// given: its title's slug, with the extension of the format
+func pandocFileName(title, format string) string {
+	ext, ok := pandocExtensions[format]
+	if !ok {
+		ext = format
+	}
+
+	return pages.Slug(title) + "." + ext
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// pandocMetadataBlock returns the metadata as the YAML block that starts the
+// Markdown pandoc is given
+func pandocMetadataBlock(meta pandocMetadata) string {
+	data, err := yaml.Marshal(meta)
+	if err != nil {
+		// There's nothing in the metadata that can't be marshalled
+		return ""
+	}
+
+	return fmt.Sprintf("---\n%s---\n\n", data)
+}
+
+// pandocDate returns the date for pandoc's metadata, which it shows as it is
+func pandocDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006-01-02")
+}
+
+// pandocKeywords returns the tags of the pages, each only once, in the order
+// they're first used
+func pandocKeywords(pageSet []*pages.Page) []string {
+	keywords := []string{}
+	seen := map[string]bool{}
+
+	for _, page := range pageSet {
+		for _, tag := range page.Tags() {
+			if tag.Name != "" && !seen[tag.Name] {
+				seen[tag.Name] = true
+				keywords = append(keywords, tag.Name)
+			}
+		}
+	}
+
+	return keywords
+}
+
+// pandocError returns what pandoc said went wrong, or the error running it if
+// it didn't say
+func pandocError(out []byte, err error) string {
+	if msg := strings.TrimSpace(string(out)); msg != "" {
+		return msg
+	}
+
+	return err.Error()
+}
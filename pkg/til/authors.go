@@ -0,0 +1,204 @@
+package til
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+// AuthorsDir is the directory in the docs directory that the author pages
+// are written into
+const AuthorsDir = "authors"
+
+// UnattributedAuthor is the author page of the pages without an author, when
+// Options.Unattributed asks for one
+const UnattributedAuthor = "unattributed"
+
+// Author is someone who wrote pages, by the author field in their
+// front-matter
+type Author struct {
+	// Name is the author's name as it's written on their newest page
+	Name string
+
+	// Slug is the AuthorSlug that all the author's pages have
+	Slug string
+
+	// Pages are the author's pages, newest first
+	Pages []*Page
+}
+
+// Link returns the Markdown link to the author's page
+func (author *Author) Link() string {
+	return fmt.Sprintf("[%s](./%s/%s)", author.Name, AuthorsDir, author.Slug)
+}
+
+// PagePath returns the path of the author's page, relative to the docs
+// directory (e.g.: authors/ann.md)
+func (author *Author) PagePath() string {
+	return fmt.Sprintf("%s/%s.%s", AuthorsDir, author.Slug, pages.FileExtension)
+}
+
+// Authors returns the authors of the content pages, with the most pages first
+// and then by name. Names are grouped by their pages.AuthorSlug, so Zoë and
+// zoe are the same author. The pages without an author aren't anyone's,
+// unless unattributed groups them under UnattributedAuthor, which comes last
+func Authors(pageSet []*Page, unattributed bool) []*Author {
+	bySlug := map[string]*Author{}
+	authors := []*Author{}
+
+	for _, page := range contentPages(pageSet) {
+		name := strings.TrimSpace(page.Author)
+		if name == "" {
+			if !unattributed {
+				continue
+			}
+
+			name = UnattributedAuthor
+		}
+
+		slug := pages.AuthorSlug(name)
+
+		author := bySlug[slug]
+		if author == nil {
+			author = &Author{Name: name, Slug: slug}
+			bySlug[slug] = author
+			authors = append(authors, author)
+		}
+
+		author.Pages = append(author.Pages, page)
+	}
+
+	sort.SliceStable(authors, func(i, j int) bool {
+		if (authors[i].Slug == UnattributedAuthor) != (authors[j].Slug == UnattributedAuthor) {
+			return authors[j].Slug == UnattributedAuthor
+		}
+
+		if len(authors[i].Pages) != len(authors[j].Pages) {
+			return len(authors[i].Pages) > len(authors[j].Pages)
+		}
+
+		return strings.ToLower(authors[i].Name) < strings.ToLower(authors[j].Name)
+	})
+
+	return authors
+}
+
+// PagesBy returns the content pages by the author, matched by their
+// pages.AuthorSlug, so "zoe" finds Zoë's pages. UnattributedAuthor finds the
+// pages without an author
+func PagesBy(pageSet []*Page, author string) []*Page {
+	slug := pages.AuthorSlug(author)
+	matched := []*Page{}
+
+	for _, page := range contentPages(pageSet) {
+		name := strings.TrimSpace(page.Author)
+
+		if (name == "" && slug == UnattributedAuthor) || (name != "" && pages.AuthorSlug(name) == slug) {
+			matched = append(matched, page)
+		}
+	}
+
+	return matched
+}
+
+// BuildAuthorPages writes a page for each of the Authors into the AuthorsDir
+// in dir, listing their pages. It returns the paths of the pages it wrote,
+// and of the ones left over from an earlier build for authors who don't have
+// any pages now, which are removed. Nothing is written if ctx has already been
+// cancelled
+func BuildAuthorPages(ctx context.Context, dir string, pageSet []*Page, opts Options) (written, removed []string, err error) {
+	written, removed = []string{}, []string{}
+
+	if err := ctx.Err(); err != nil {
+		return written, removed, err
+	}
+
+	current := map[string]bool{}
+
+	for _, author := range Authors(pageSet, opts.Unattributed) {
+		filePath := filepath.Join(dir, filepath.FromSlash(author.PagePath()))
+		current[filePath] = true
+
+		err := opts.fs().MkdirAll(filepath.Dir(filePath), os.ModePerm)
+		if err != nil {
+			return written, removed, err
+		}
+
+		err = opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(AuthorPageContent(author, opts.Footer))), 0644)
+		if err != nil {
+			return written, removed, err
+		}
+
+		written = append(written, filePath)
+	}
+
+	matches, err := opts.fs().Glob(filepath.Join(dir, AuthorsDir, "*."+pages.FileExtension))
+	if err != nil {
+		return written, removed, err
+	}
+
+	for _, filePath := range matches {
+		if current[filePath] {
+			continue
+		}
+
+		data, err := opts.fs().ReadFile(filePath)
+		if err != nil || !strings.HasPrefix(string(data), pages.GeneratedMarker) {
+			continue
+		}
+
+		err = opts.fs().Remove(filePath)
+		if err != nil {
+			return written, removed, err
+		}
+
+		removed = append(removed, filePath)
+	}
+
+	return written, removed, nil
+}
+
+// AuthorPageContent creates the content of an author's page: a heading, the
+// number of pages they wrote, a list of links to the pages, and the footer.
+// Archived pages are listed, marked as archived, as they are on tag pages
+func AuthorPageContent(author *Author, footer string) string {
+	var content strings.Builder
+
+	fmt.Fprintf(&content, "## %s\n\n", author.Name)
+
+	stats := (&pages.Tag{Name: author.Name, Pages: author.Pages}).Stats()
+	fmt.Fprintf(&content, "_%s_\n", stats.Summary())
+
+	// Write the page list into the middle of the page
+	pageList(&content, author.Pages, func(page *Page) string {
+		if page.Archived {
+			return page.LinkFrom("../") + archivedSuffix
+		}
+
+		return page.LinkFrom("../")
+	})
+
+	// Write the footer content into the bottom of the page
+	content.WriteString("\n")
+	content.WriteString(footer)
+
+	return content.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// authorLinks returns the line of the index that links to the author pages,
+// with the number of pages each author wrote (e.g.: Authors: [Ann](...) (12))
+func authorLinks(authors []*Author) string {
+	links := []string{}
+	for _, author := range authors {
+		links = append(links, fmt.Sprintf("%s (%d)", author.Link(), len(author.Pages)))
+	}
+
+	return fmt.Sprintf("Authors: %s", strings.Join(links, ", "))
+}
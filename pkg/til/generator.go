@@ -0,0 +1,69 @@
+package til
+
+import (
+	"context"
+	"fmt"
+)
+
+// Generator writes one kind of output, like the index or the tag pages, from
+// the pages and their tags into dir. A build runs each of its generators in
+// turn. The pages are newest first, as LoadPages returns them, and
+// generators list them in that order rather than sorting them again
+type Generator interface {
+	Name() string
+	Generate(ctx context.Context, pageSet []*Page, tagMap *TagMap, dir string) error
+}
+
+// IndexGenerator is the Generator of the index page
+type IndexGenerator struct {
+	Options Options
+}
+
+// Name returns the name of the generator
+func (gen *IndexGenerator) Name() string {
+	return "index"
+}
+
+// Generate writes the index page into dir
+func (gen *IndexGenerator) Generate(ctx context.Context, pageSet []*Page, tagMap *TagMap, dir string) error {
+	_, err := BuildIndex(ctx, dir, pageSet, tagMap, gen.Options)
+	return err
+}
+
+// TagPagesGenerator is the Generator of the tag pages
+type TagPagesGenerator struct {
+	Options Options
+
+	// Report is what the last Generate did to the docs directory
+	Report BuildReport
+}
+
+// Name returns the name of the generator
+func (gen *TagPagesGenerator) Name() string {
+	return "tags"
+}
+
+// Generate writes the tag pages into dir
+func (gen *TagPagesGenerator) Generate(ctx context.Context, pageSet []*Page, tagMap *TagMap, dir string) error {
+	report, err := BuildTagPages(ctx, dir, tagMap, gen.Options)
+	gen.Report = report
+
+	return err
+}
+
+// Generate runs the generators in order, and stops at the first one that
+// fails. The error says which generator it was
+func Generate(ctx context.Context, generators []Generator, pageSet []*Page, tagMap *TagMap, dir string) error {
+	for _, gen := range generators {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := gen.Generate(ctx, pageSet, tagMap, dir)
+		if err != nil {
+			return fmt.Errorf("%s: %w", gen.Name(), err)
+		}
+	}
+
+	return nil
+}
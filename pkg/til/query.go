@@ -0,0 +1,472 @@
+package til
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/senorprogrammer/til/pages"
+)
+
+const (
+	errQueryClose    = "there's a ) without a ( before it"
+	errQueryDate     = "%s: needs a date, like %s:2024-01-01"
+	errQueryEmpty    = "the query is empty"
+	errQueryField    = "there's no %s: field; use tag:, title:, author:, before:, or after:"
+	errQueryOpen     = "the ( isn't closed"
+	errQueryOperator = "%s needs something to search for after it"
+	errQueryPhrase   = "there's nothing between the quotes"
+	errQueryQuote    = "the quote isn't closed"
+	errQueryStart    = "%s needs something to search for before it"
+	errQueryValue    = "%s: needs something after it"
+	errQueryWord     = "%s has no letters or digits to search for"
+)
+
+// queryFields are the fields a query can match against, besides the content
+var queryFields = map[string]bool{
+	"after":  true,
+	"author": true,
+	"before": true,
+	"tag":    true,
+	"title":  true,
+}
+
+// Query is a parsed search query. It's made of terms, which are either bare
+// words (or "quoted phrases") that are looked for in a page's content, or a
+// field and a value: tag:go, title:closures, author:ann, before:2024-01-01,
+// and after:2024-01-01. Terms are combined with AND, OR, NOT, and
+// parentheses. Terms side by side are ANDed, and NOT binds tighter than AND,
+// which binds tighter than OR
+type Query struct {
+	root queryNode
+
+	// tagMap is how tag: terms match tags; see SetTagMap
+	tagMap *TagMap
+}
+
+// QueryError is a query that can't be parsed, and where in it the problem is
+type QueryError struct {
+	// Query is the query as it was given
+	Query string
+
+	// Pos is the byte offset of the problem in Query
+	Pos int
+
+	// Msg says what the problem is
+	Msg string
+}
+
+// Error returns what's wrong with the query, and where
+func (err *QueryError) Error() string {
+	if err.Pos >= len(err.Query) {
+		return fmt.Sprintf("%s, at the end of the query", err.Msg)
+	}
+
+	column := utf8.RuneCountInString(err.Query[:err.Pos]) + 1
+
+	return fmt.Sprintf("%s, at column %d: %s", err.Msg, column, err.Query[err.Pos:])
+}
+
+// ParseQuery parses the query. A query that can't be parsed returns a
+// QueryError
+func ParseQuery(query string) (*Query, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, &QueryError{Query: query, Pos: len(query), Msg: errQueryEmpty}
+	}
+
+	parser := &queryParser{query: query, tokens: tokens}
+
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := parser.peek(); tok != nil {
+		// The only thing that can stop an expression early is a )
+		return nil, &QueryError{Query: query, Pos: tok.pos, Msg: errQueryClose}
+	}
+
+	return &Query{root: root}, nil
+}
+
+// Match returns true if the page matches the query
+func (q *Query) Match(page *Page) bool {
+	return q.root.match(&queryPage{page: page, tagMap: q.tagMap})
+}
+
+// SetTagMap makes tag: terms match tags by their canonical names in the tag
+// map, the way til list --tag does, so that tag:go finds the pages tagged
+// with any of go's aliases. Without one, tags only match by name, in any
+// case
+func (q *Query) SetTagMap(tagMap *TagMap) {
+	q.tagMap = tagMap
+}
+
+// RequiredTerms returns the tokens of the bare words that every page the
+// query matches has to have in it. A search index can use them to find the
+// pages worth matching the query against. An empty list means that any page
+// might match
+func (q *Query) RequiredTerms() []string {
+	return requiredTerms(q.root)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// queryNode is a part of a parsed query
+type queryNode interface {
+	match(page *queryPage) bool
+}
+
+// queryPage is a page being matched against a query. Its tokens are only
+// worked out if a term needs them, and then only once
+type queryPage struct {
+	page   *Page
+	tagMap *TagMap
+	tokens map[string]bool
+}
+
+type andNode struct{ left, right queryNode }
+type orNode struct{ left, right queryNode }
+type notNode struct{ node queryNode }
+
+// termNode is a single term: a bare word or phrase when field is empty
+type termNode struct {
+	field  string
+	value  string
+	phrase bool
+	date   time.Time
+}
+
+func (node andNode) match(page *queryPage) bool {
+	return node.left.match(page) && node.right.match(page)
+}
+
+func (node orNode) match(page *queryPage) bool {
+	return node.left.match(page) || node.right.match(page)
+}
+
+func (node notNode) match(page *queryPage) bool {
+	return !node.node.match(page)
+}
+
+func (node termNode) match(qp *queryPage) bool {
+	page := qp.page
+	value := strings.ToLower(node.value)
+
+	switch node.field {
+	case "after":
+		return !page.CreatedAt().IsZero() && !page.CreatedAt().Before(node.date)
+	case "author":
+		return strings.Contains(strings.ToLower(page.Author), value)
+	case "before":
+		return !page.CreatedAt().IsZero() && page.CreatedAt().Before(node.date)
+	case "tag":
+		value = qp.tagName(node.value)
+
+		for _, tag := range page.Tags() {
+			name := qp.tagName(tag.Name)
+			if name == value || strings.HasPrefix(name, value+pages.TagSeparator) {
+				return true
+			}
+		}
+
+		return false
+	case "title":
+		return strings.Contains(strings.ToLower(page.Title), value)
+	}
+
+	if node.phrase {
+		return strings.Contains(strings.ToLower(page.Content), value)
+	}
+
+	if qp.tokens == nil {
+		qp.tokens = map[string]bool{}
+		for _, token := range pageTokens(page) {
+			qp.tokens[token] = true
+		}
+	}
+
+	for _, token := range Tokenize(value) {
+		if !qp.tokens[token] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tagName returns the tag's name, as tag: terms compare it: its canonical
+// name in the tag map, if there is one, in lower case
+func (qp *queryPage) tagName(name string) string {
+	if qp.tagMap != nil {
+		name = qp.tagMap.CanonicalName(name)
+	}
+
+	return strings.ToLower(name)
+}
+
+// requiredTerms returns the tokens that any page matching the node must have
+func requiredTerms(node queryNode) []string {
+	switch n := node.(type) {
+	case andNode:
+		return append(requiredTerms(n.left), requiredTerms(n.right)...)
+	case termNode:
+		if n.field == "" && !n.phrase {
+			return Tokenize(n.value)
+		}
+	}
+
+	// Either side of an OR could match on its own, and a NOT matches pages
+	// without the term, so neither says what a page must have
+	return []string{}
+}
+
+// queryToken is a single token of a query
+type queryToken struct {
+	// kind is (, ), AND, OR, NOT, or term
+	kind   string
+	field  string
+	value  string
+	phrase bool
+	pos    int
+}
+
+// lexQuery splits the query into its tokens
+func lexQuery(query string) ([]queryToken, error) {
+	tokens := []queryToken{}
+
+	for i := 0; i < len(query); {
+		r, size := utf8.DecodeRuneInString(query[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(' || r == ')':
+			tokens = append(tokens, queryToken{kind: string(r), pos: i})
+			i++
+		default:
+			tok, next, err := lexTerm(query, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, tok)
+			i = next
+		}
+	}
+
+	return tokens, nil
+}
+
+// lexTerm reads the term that starts at start, returning it and where the
+// query carries on after it
+func lexTerm(query string, start int) (queryToken, int, error) {
+	tok := queryToken{kind: "term", pos: start}
+	i := start
+
+	// A field is a word of letters followed by a colon
+	j := i
+	for j < len(query) && isASCIILetter(query[j]) {
+		j++
+	}
+
+	if j > i && j < len(query) && query[j] == ':' {
+		tok.field = strings.ToLower(query[i:j])
+		if !queryFields[tok.field] {
+			return tok, 0, &QueryError{Query: query, Pos: start, Msg: fmt.Sprintf(errQueryField, query[i:j])}
+		}
+
+		i = j + 1
+	}
+
+	if i < len(query) && query[i] == '"' {
+		end := strings.IndexByte(query[i+1:], '"')
+		if end < 0 {
+			return tok, 0, &QueryError{Query: query, Pos: i, Msg: errQueryQuote}
+		}
+
+		tok.value = query[i+1 : i+1+end]
+		tok.phrase = true
+
+		return tok, i + end + 2, nil
+	}
+
+	end := i
+	for end < len(query) {
+		r, size := utf8.DecodeRuneInString(query[end:])
+		if unicode.IsSpace(r) || r == '(' || r == ')' {
+			break
+		}
+
+		end += size
+	}
+
+	tok.value = query[i:end]
+
+	if tok.field == "" {
+		switch tok.value {
+		case "AND", "OR", "NOT":
+			tok.kind = tok.value
+		}
+	}
+
+	return tok, end, nil
+}
+
+// queryParser parses the tokens of a query, by recursive descent
+type queryParser struct {
+	query  string
+	tokens []queryToken
+	next   int
+}
+
+// peek returns the next token, or nil at the end
+func (parser *queryParser) peek() *queryToken {
+	if parser.next >= len(parser.tokens) {
+		return nil
+	}
+
+	return &parser.tokens[parser.next]
+}
+
+// parseOr parses terms joined by OR
+func (parser *queryParser) parseOr() (queryNode, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for tok := parser.peek(); tok != nil && tok.kind == "OR"; tok = parser.peek() {
+		parser.next++
+
+		right, err := parser.parseOperand(tok, parser.parseAnd)
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses terms joined by AND, or simply side by side
+func (parser *queryParser) parseAnd() (queryNode, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for tok := parser.peek(); tok != nil && tok.kind != "OR" && tok.kind != ")"; tok = parser.peek() {
+		var right queryNode
+
+		if tok.kind == "AND" {
+			parser.next++
+			right, err = parser.parseOperand(tok, parser.parseNot)
+		} else {
+			right, err = parser.parseNot()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseNot parses a term, with any NOTs before it
+func (parser *queryParser) parseNot() (queryNode, error) {
+	tok := parser.peek()
+	if tok == nil {
+		return nil, &QueryError{Query: parser.query, Pos: len(parser.query), Msg: errQueryEmpty}
+	}
+
+	switch tok.kind {
+	case "NOT":
+		parser.next++
+
+		node, err := parser.parseOperand(tok, parser.parseNot)
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{node: node}, nil
+	case "(":
+		parser.next++
+
+		node, err := parser.parseOperand(tok, parser.parseOr)
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := parser.peek(); closing == nil || closing.kind != ")" {
+			return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: errQueryOpen}
+		}
+
+		parser.next++
+
+		return node, nil
+	case ")":
+		return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: errQueryClose}
+	case "AND", "OR":
+		return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: fmt.Sprintf(errQueryStart, tok.kind)}
+	}
+
+	parser.next++
+
+	return parser.term(tok)
+}
+
+// parseOperand parses what follows the operator tok with parse, failing if
+// there's nothing there to parse
+func (parser *queryParser) parseOperand(tok *queryToken, parse func() (queryNode, error)) (queryNode, error) {
+	next := parser.peek()
+	if next == nil || next.kind == ")" || next.kind == "AND" || next.kind == "OR" {
+		return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: fmt.Sprintf(errQueryOperator, tok.kind)}
+	}
+
+	return parse()
+}
+
+// term turns a term token into a node
+func (parser *queryParser) term(tok *queryToken) (queryNode, error) {
+	node := termNode{field: tok.field, value: tok.value, phrase: tok.phrase}
+
+	if strings.TrimSpace(tok.value) == "" {
+		if tok.field == "" {
+			return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: errQueryPhrase}
+		}
+
+		return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: fmt.Sprintf(errQueryValue, tok.field)}
+	}
+
+	if tok.field == "" && !tok.phrase && len(Tokenize(tok.value)) == 0 {
+		return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: fmt.Sprintf(errQueryWord, tok.value)}
+	}
+
+	if tok.field == "before" || tok.field == "after" {
+		date, ok := pages.ParseDate(tok.value)
+		if !ok {
+			return nil, &QueryError{Query: parser.query, Pos: tok.pos, Msg: fmt.Sprintf(errQueryDate, tok.field, tok.field)}
+		}
+
+		node.date = date
+	}
+
+	return node, nil
+}
+
+// isASCIILetter returns true for the letters a field name can be made of
+func isASCIILetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,227 @@
+package til
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// A word in a page's title counts for more than one in its tags, which
+	// counts for more than one in its content
+	titleWeight = 5.0
+	tagWeight   = 3.0
+	bodyWeight  = 1.0
+
+	// recencyBoost is how much more a page created today scores than an old
+	// one. The boost halves every recencyHalfLife
+	recencyBoost    = 0.25
+	recencyHalfLife = 365 * 24 * time.Hour
+
+	// snippetRadius is how many words a snippet shows either side of the
+	// best match
+	snippetRadius = 8
+)
+
+// SearchResult is a page that matches a query, and how well
+type SearchResult struct {
+	Page  *Page
+	Score float64
+}
+
+// RankPages returns the content pages that match the query, the most relevant
+// first. Pages that score the same are newest first, as LoadPages has them.
+// now is when the search is, which recent pages are boosted from
+func RankPages(pageSet []*Page, q *Query, now time.Time) []SearchResult {
+	results := []SearchResult{}
+
+	for _, page := range FilterPages(pageSet, q) {
+		results = append(results, SearchResult{Page: page, Score: q.Score(page, now)})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		if !results[i].Page.CreatedAt().Equal(results[j].Page.CreatedAt()) {
+			return results[i].Page.CreatedAt().After(results[j].Page.CreatedAt())
+		}
+
+		return results[i].Page.FilePath < results[j].Page.FilePath
+	})
+
+	return results
+}
+
+// Score returns how relevant the page is to the query's words: how often each
+// is in the page's title, tags, and content, weighted in that order, with a
+// small boost for pages created not long before now. A query without words to
+// search for, like tag:go, scores every page 0
+func (q *Query) Score(page *Page, now time.Time) float64 {
+	terms := scoringTerms(q.root, false)
+	if len(terms) == 0 {
+		return 0
+	}
+
+	score := 0.0
+
+	for _, field := range []struct {
+		text   string
+		weight float64
+	}{
+		{text: page.Title, weight: titleWeight},
+		{text: string(page.TagsStr), weight: tagWeight},
+		{text: page.Content, weight: bodyWeight},
+	} {
+		counts := map[string]int{}
+		for _, token := range Tokenize(field.text) {
+			if terms[token] {
+				counts[token]++
+			}
+		}
+
+		for _, count := range counts {
+			// The tenth mention of a word says less than the first
+			score += field.weight * (1 + math.Log(float64(count)))
+		}
+	}
+
+	if created := page.CreatedAt(); !created.IsZero() && score > 0 {
+		age := now.Sub(created)
+		if age < 0 {
+			age = 0
+		}
+
+		score *= 1 + recencyBoost*math.Pow(0.5, float64(age)/float64(recencyHalfLife))
+	}
+
+	return score
+}
+
+// Snippet returns a few words of the page's content, around where the most
+// of the query's words are. Each word that matches is passed through mark, so
+// that it can be highlighted. A page without any of the words starts the
+// snippet at the beginning of the content
+func (q *Query) Snippet(page *Page, mark func(string) string) string {
+	terms := scoringTerms(q.root, false)
+	words := strings.Fields(snippetContent(page))
+
+	hits := make([]bool, len(words))
+	for i, word := range words {
+		for _, token := range Tokenize(word) {
+			if terms[token] {
+				hits[i] = true
+				break
+			}
+		}
+	}
+
+	// Find the words with the most matches around them. Of the first run of
+	// those, the one in the middle is the snippet's centre
+	first, last, bestCount := 0, 0, 0
+
+	for i := range words {
+		count := 0
+		for j := i - snippetRadius; j <= i+snippetRadius; j++ {
+			if j >= 0 && j < len(words) && hits[j] {
+				count++
+			}
+		}
+
+		switch {
+		case count > bestCount:
+			first, last, bestCount = i, i, count
+		case count == bestCount && last == i-1:
+			last = i
+		}
+	}
+
+	centre := (first + last) / 2
+	start, end := centre-snippetRadius, centre+snippetRadius+1
+	if bestCount == 0 {
+		start, end = 0, 2*snippetRadius+1
+	}
+
+	if start < 0 {
+		start = 0
+	}
+
+	if end > len(words) {
+		end = len(words)
+	}
+
+	shown := []string{}
+	for i := start; i < end; i++ {
+		if hits[i] {
+			shown = append(shown, mark(words[i]))
+		} else {
+			shown = append(shown, words[i])
+		}
+	}
+
+	snippet := strings.Join(shown, " ")
+
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+
+	if end < len(words) {
+		snippet += "…"
+	}
+
+	return snippet
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// scoringTerms returns the tokens of the words and phrases in the node that a
+// page is better for having. Those under a NOT are the ones a page mustn't
+// have, so they don't count
+func scoringTerms(node queryNode, negated bool) map[string]bool {
+	terms := map[string]bool{}
+
+	switch n := node.(type) {
+	case andNode:
+		return mergeTerms(scoringTerms(n.left, negated), scoringTerms(n.right, negated))
+	case orNode:
+		return mergeTerms(scoringTerms(n.left, negated), scoringTerms(n.right, negated))
+	case notNode:
+		return scoringTerms(n.node, !negated)
+	case termNode:
+		if n.field == "" && !negated {
+			for _, token := range Tokenize(n.value) {
+				terms[token] = true
+			}
+		}
+	}
+
+	return terms
+}
+
+// mergeTerms adds the terms in other to terms, and returns them
+func mergeTerms(terms, other map[string]bool) map[string]bool {
+	for token := range other {
+		terms[token] = true
+	}
+
+	return terms
+}
+
+// snippetContent returns the page's content without the heading that repeats
+// its title, which is shown with the snippet anyway
+func snippetContent(page *Page) string {
+	body := strings.TrimLeft(page.Content, "\n")
+
+	first, rest := body, ""
+	if i := strings.Index(body, "\n"); i >= 0 {
+		first, rest = body[:i], body[i+1:]
+	}
+
+	if strings.HasPrefix(first, "# ") && strings.TrimSpace(first[2:]) == page.Title {
+		return rest
+	}
+
+	return body
+}
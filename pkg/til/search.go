@@ -0,0 +1,428 @@
+package til
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// SearchIndexDir is the directory in the docs directory that the search
+	// index is kept in. It has a .gitignore of its own, so it's never
+	// committed
+	SearchIndexDir = ".til"
+
+	// SearchIndexFile is the name of the search index file
+	SearchIndexFile = "index.json"
+
+	// searchIndexVersion changes whenever what's in the index does, so that
+	// an index written by an older til is rebuilt rather than trusted
+	searchIndexVersion = 1
+)
+
+// SearchIndex is an inverted index of the words in the pages: for each
+// token, the pages it's in and where. It remembers when each page last
+// changed, so that only the pages that have changed since need to be indexed
+// again, and so that an index that's out of date can be told apart
+type SearchIndex struct {
+	Version int `json:"version"`
+
+	// Files are the indexed pages, by their path in the docs directory
+	Files map[string]IndexedFile `json:"files"`
+
+	// Tokens maps each token to the pages it's in, and to the positions in
+	// those pages' tokens that it's found at
+	Tokens map[string]map[string][]int `json:"tokens"`
+}
+
+// IndexedFile is what the index knows about a page's file from when it was
+// indexed
+type IndexedFile struct {
+	ModTime time.Time `json:"modTime"`
+	Size    int64     `json:"size"`
+}
+
+// NewSearchIndex returns an empty search index
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{
+		Version: searchIndexVersion,
+		Files:   map[string]IndexedFile{},
+		Tokens:  map[string]map[string][]int{},
+	}
+}
+
+// ReadSearchIndex reads the search index of the docs directory in dir. An
+// index that doesn't exist returns an error that os.IsNotExist reports. An
+// index of an older version comes back empty
+func ReadSearchIndex(fsys pages.FS, dir string) (*SearchIndex, error) {
+	data, err := fsys.ReadFile(searchIndexPath(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	idx := NewSearchIndex()
+
+	err = json.Unmarshal(data, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx.Version != searchIndexVersion || idx.Files == nil || idx.Tokens == nil {
+		return NewSearchIndex(), nil
+	}
+
+	return idx, nil
+}
+
+// Write saves the index in the docs directory in dir
+func (idx *SearchIndex) Write(fsys pages.FS, dir string) error {
+	indexDir := filepath.Join(dir, SearchIndexDir)
+
+	err := fsys.MkdirAll(indexDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	// Everything in the directory can be made again, so none of it belongs
+	// in the repo
+	ignorePath := filepath.Join(indexDir, ".gitignore")
+	if _, err := fsys.Stat(ignorePath); os.IsNotExist(err) {
+		err = fsys.WriteFile(ignorePath, []byte("*\n"), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+
+	return fsys.WriteFile(searchIndexPath(dir), data, 0644)
+}
+
+// Update indexes the page files in filePaths that have changed since they
+// were last indexed, and forgets the pages that are gone. It returns true if
+// the index changed. A page file that can't be parsed, or that's bigger than
+// opts allows, is skipped the same way LoadPagesSkipping does, and returned
+// with the others it skipped. It's remembered without any of its words, as
+// generated pages are, so that it isn't read again until it changes
+func (idx *SearchIndex) Update(ctx context.Context, fsys pages.FS, dir string, filePaths []string, opts LoadOptions) (bool, []SkippedFile, error) {
+	changed := false
+	present := map[string]bool{}
+	skipped := []SkippedFile{}
+
+	for _, filePath := range filePaths {
+		if err := ctx.Err(); err != nil {
+			return false, nil, err
+		}
+
+		name := indexedName(dir, filePath)
+		present[name] = true
+
+		info, err := fsys.Stat(filePath)
+		if err != nil {
+			return false, nil, err
+		}
+
+		file := IndexedFile{ModTime: info.ModTime(), Size: info.Size()}
+		if indexed, ok := idx.Files[name]; ok && indexed.same(file) {
+			continue
+		}
+
+		page, err := pages.ReadPageLimit(fsys, filePath, opts.maxSize())
+
+		var parseErr *src.ParseError
+		if errors.As(err, &parseErr) {
+			skipped = append(skipped, SkippedFile{FilePath: filePath, Err: err})
+			page = nil
+		} else if err != nil {
+			return false, nil, err
+		}
+
+		idx.remove(name)
+		if page != nil && !page.IsGenerated() {
+			idx.add(name, page)
+		}
+		idx.Files[name] = file
+
+		changed = true
+	}
+
+	for name := range idx.Files {
+		if !present[name] {
+			idx.remove(name)
+			delete(idx.Files, name)
+
+			changed = true
+		}
+	}
+
+	return changed, skipped, nil
+}
+
+// Stale returns true if the page files in filePaths aren't the ones that
+// were indexed, or have changed since
+func (idx *SearchIndex) Stale(fsys pages.FS, dir string, filePaths []string) bool {
+	if len(filePaths) != len(idx.Files) {
+		return true
+	}
+
+	for _, filePath := range filePaths {
+		indexed, ok := idx.Files[indexedName(dir, filePath)]
+		if !ok {
+			return true
+		}
+
+		info, err := fsys.Stat(filePath)
+		if err != nil || !indexed.same(IndexedFile{ModTime: info.ModTime(), Size: info.Size()}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Search returns the paths, in the docs directory in dir, of the pages that
+// have every one of the tokens in them
+func (idx *SearchIndex) Search(dir string, tokens []string) []string {
+	var matches map[string]bool
+
+	for _, token := range tokens {
+		found := map[string]bool{}
+
+		for name := range idx.Tokens[token] {
+			if matches == nil || matches[name] {
+				found[name] = true
+			}
+		}
+
+		matches = found
+	}
+
+	filePaths := []string{}
+	for name := range matches {
+		filePaths = append(filePaths, filepath.Join(dir, filepath.FromSlash(name)))
+	}
+
+	sort.Strings(filePaths)
+
+	return filePaths
+}
+
+// UpdateSearchIndex brings the search index of the docs directory in dir up
+// to date with its pages, writing it if anything changed. It returns the
+// page files it skipped, as Update does
+func UpdateSearchIndex(ctx context.Context, fsys pages.FS, dir string, opts LoadOptions) ([]SkippedFile, error) {
+	filePaths, err := PageFilePathsWithOptions(fsys, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := ReadSearchIndex(fsys, dir)
+	if err != nil {
+		// An index that's missing or broken is simply made again
+		idx = NewSearchIndex()
+	}
+
+	changed, skipped, err := idx.Update(ctx, fsys, dir, filePaths, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		err = idx.Write(fsys, dir)
+	}
+
+	return skipped, err
+}
+
+// SearchPages returns the content pages in the docs directory in dir that
+// match the query, newest first. The search index narrows
+// down the pages to match the query against, if it's up to date and the
+// query has words that every match must have. Otherwise every page is read,
+// and indexed is false. Either way, pages that can't be parsed are left
+// out, as LoadPagesSkipping leaves them out
+func SearchPages(ctx context.Context, fsys pages.FS, dir string, q *Query, opts LoadOptions) (found []*Page, indexed bool, err error) {
+	filePaths, err := PageFilePathsWithOptions(fsys, dir, opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	terms := q.RequiredTerms()
+
+	idx, err := ReadSearchIndex(fsys, dir)
+	if err != nil || len(terms) == 0 || idx.Stale(fsys, dir, filePaths) {
+		pageSet, _, err := LoadPagesSkipping(ctx, fsys, dir, opts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return FilterPages(pageSet, q), false, nil
+	}
+
+	matches := idx.Search(dir, terms)
+	pageSet := []*Page{}
+
+	for _, filePath := range matches {
+		page, err := pages.ReadPageLimit(fsys, filePath, opts.maxSize())
+
+		var parseErr *src.ParseError
+		if errors.As(err, &parseErr) {
+			continue
+		}
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		pageSet = append(pageSet, page)
+	}
+
+	// Sorted the same way as LoadPages
+	pages.SortNewestFirst(pageSet)
+
+	return FilterPages(pageSet, q), true, nil
+}
+
+// FilterPages returns the content pages that match the query, in the order
+// they're in
+func FilterPages(pageSet []*Page, q *Query) []*Page {
+	found := []*Page{}
+
+	for _, page := range contentPages(pageSet) {
+		if q.Match(page) {
+			found = append(found, page)
+		}
+	}
+
+	return found
+}
+
+// Tokenize splits the text into lower-case tokens at anything that isn't a
+// letter or a digit. Code identifiers stay whole: underscores don't split a
+// token, and a dotted name like http.Client is a token as well as each of
+// its parts
+func Tokenize(text string) []string {
+	tokens := []string{}
+
+	flush := func(word string) {
+		if word == "" {
+			return
+		}
+
+		tokens = append(tokens, word)
+
+		if strings.Contains(word, ".") {
+			for _, part := range strings.Split(word, ".") {
+				tokens = append(tokens, part)
+			}
+		}
+	}
+
+	runes := []rune(strings.ToLower(text))
+	start := -1
+
+	for i, r := range runes {
+		switch {
+		case isTokenRune(r):
+			if start < 0 {
+				start = i
+			}
+		case r == '.' && start >= 0 && i+1 < len(runes) && isTokenRune(runes[i+1]):
+			// A dot between two words joins them
+		default:
+			if start >= 0 {
+				flush(string(runes[start:i]))
+				start = -1
+			}
+		}
+	}
+
+	if start >= 0 {
+		flush(string(runes[start:]))
+	}
+
+	return tokens
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// add indexes the page's tokens under name
+func (idx *SearchIndex) add(name string, page *Page) {
+	for pos, token := range pageTokens(page) {
+		if idx.Tokens[token] == nil {
+			idx.Tokens[token] = map[string][]int{}
+		}
+
+		idx.Tokens[token][name] = append(idx.Tokens[token][name], pos)
+	}
+}
+
+// remove forgets the tokens of the page indexed under name
+func (idx *SearchIndex) remove(name string) {
+	for token, postings := range idx.Tokens {
+		if _, ok := postings[name]; !ok {
+			continue
+		}
+
+		delete(postings, name)
+
+		if len(postings) == 0 {
+			delete(idx.Tokens, token)
+		}
+	}
+}
+
+// same returns true if two looks at a file saw the same file
+func (file IndexedFile) same(other IndexedFile) bool {
+	return file.ModTime.Equal(other.ModTime) && file.Size == other.Size
+}
+
+// pageTokens returns the tokens of the page's title, tags, and content
+func pageTokens(page *Page) []string {
+	return Tokenize(strings.Join([]string{page.Title, string(page.TagsStr), page.Content}, "\n"))
+}
+
+// indexedName returns the name that a page file is indexed under: its path
+// in the docs directory, with forward slashes
+func indexedName(dir, filePath string) string {
+	rel, err := filepath.Rel(dir, filePath)
+	if err != nil {
+		rel = filePath
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// searchIndexPath returns the path to the search index of the docs directory
+func searchIndexPath(dir string) string {
+	return filepath.Join(dir, SearchIndexDir, SearchIndexFile)
+}
+
+// contentPages returns the pages that are content pages
+func contentPages(pageSet []*Page) []*Page {
+	content := []*Page{}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() {
+			content = append(content, page)
+		}
+	}
+
+	return content
+}
+
+// isTokenRune returns true if the rune can be part of a token
+func isTokenRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
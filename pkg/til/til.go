@@ -0,0 +1,1275 @@
+// Package til is the core of the til command: it loads the pages in a docs
+// directory, groups them by tag, and generates the index and tag pages.
+// It doesn't read the til configuration or write to the console, so it can be
+// used to build other tools over a docs directory
+package til
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// AllPageName is the name of the page that lists every page, when the index
+// only lists the most recent ones
+const AllPageName = "all"
+
+// TagsPageName is the name of the page that lists every tag, with the number
+// of pages each one has
+const TagsPageName = "tags"
+
+// ArchivePageName is the name of the page that lists the archived pages, which
+// are left off the index and the all page
+const ArchivePageName = "archive"
+
+// archivedSuffix comes after an archived page's link on its tags' pages
+const archivedSuffix = " <sub>archived</sub>"
+
+// errTagPageIsContent is the error for a tag whose page would be written over
+// a page that someone wrote
+const errTagPageIsContent = "the %s tag's page would overwrite %s, which isn't a generated page. Rename the page or the tag"
+
+// The layouts the index can list its pages in
+const (
+	// IndexLayoutList lists the pages in one long list, broken up by month
+	IndexLayoutList = "list"
+
+	// IndexLayoutYears lists each year's pages in a section of their own.
+	// All but the current year's are collapsed
+	IndexLayoutYears = "years"
+)
+
+// The orders the index can list its pages in
+const (
+	// IndexSortNewest lists the pages newest first
+	IndexSortNewest = "newest"
+
+	// IndexSortAlphabetical lists the pages by title, in any case
+	IndexSortAlphabetical = "alphabetical"
+
+	// IndexSortByTag lists the pages under a heading for each of their tags,
+	// newest first, with the untagged pages last
+	IndexSortByTag = "by-tag"
+)
+
+// untaggedHeading is the heading of the untagged pages on an index that's
+// sorted by tag
+const untaggedHeading = "Untagged"
+
+// indexTagsSeparator comes between a page's link and its tags on the index
+const indexTagsSeparator = " — "
+
+// DefaultWorkers is the number of tag pages that are written at once, when
+// the Options don't say otherwise. Writing a page is mostly waiting on the
+// disk, so it's more than most machines have CPUs
+const DefaultWorkers = 8
+
+// DefaultMaxPageSize is the size in bytes of the biggest file that's loaded
+// as a page, when the LoadOptions don't say otherwise
+const DefaultMaxPageSize = 4 << 20
+
+// MaxSeeAlsoTags is the number of related tags listed in a tag page's
+// "See also" line
+const MaxSeeAlsoTags = 5
+
+// Page is a single TIL entry in the docs directory
+type Page = pages.Page
+
+// TagMap groups the pages by the tags they're tagged with
+type TagMap = pages.TagMap
+
+// Options defines how the index and tag pages are generated
+type Options struct {
+	// Aliases maps tag aliases to the tags they stand for
+	Aliases map[string]string
+
+	// Descriptions are the optional titles and descriptions of the tags
+	Descriptions pages.TagDescriptions
+
+	// Footer is written at the bottom of every generated page
+	Footer string
+
+	// FS is the filesystem the pages are written to. Nil means the real disk
+	FS pages.FS
+
+	// Icons are the emoji or other icons that go in front of the pages on
+	// the index and the all page, by lower-case tag name. A page gets the
+	// icon of the first of its tags that has one
+	Icons map[string]string
+
+	// IndexLayout is how the index lists its pages, IndexLayoutList or
+	// IndexLayoutYears. Empty means IndexLayoutList. It only applies to
+	// pages sorted IndexSortNewest
+	IndexLayout string
+
+	// IndexLimit is the number of the most recent pages that the index
+	// lists, with a link to the all page for the rest. 0 lists them all
+	IndexLimit int
+
+	// IndexNote is an extra line written above the footer of the index
+	IndexNote string
+
+	// IndexSort is the order the index lists its pages in, IndexSortNewest,
+	// IndexSortAlphabetical, or IndexSortByTag. Empty means IndexSortNewest
+	IndexSort string
+
+	// IndexSortFirstTag lists each page under only the first of its tags,
+	// rather than under every one of them, with IndexSortByTag
+	IndexSortFirstTag bool
+
+	// IndexTags lists each page's tags after it on the index and the all
+	// page, linked to their tag pages
+	IndexTags bool
+
+	// LowercaseTags groups tags without regard to their case
+	LowercaseTags bool
+
+	// MinTagCount is the number of pages a tag needs to get a tag page and a
+	// link in the index
+	MinTagCount int
+
+	// OmitTagList leaves the list of tags off the top of the index, for
+	// when the tags page lists them instead
+	OmitTagList bool
+
+	// ReservedNames are the names of the pages that are generated alongside
+	// the index. Top-level tags with one of these names don't get a tag page
+	ReservedNames []string
+
+	// TagsPage links the index to the tags page
+	TagsPage bool
+
+	// Unattributed gives the pages without an author an author page of their
+	// own, UnattributedAuthor. Otherwise they aren't on any author page
+	Unattributed bool
+
+	// Workers is the number of tag pages that BuildTagPages writes at once.
+	// 0 means DefaultWorkers
+	Workers int
+}
+
+// LoadOptions defines which of the files in a docs directory are pages
+type LoadOptions struct {
+	// Exclude are the paths of files that aren't pages, whatever their
+	// extension
+	Exclude []string
+
+	// Extensions are the file extensions of the pages, without the dot. The
+	// pages can be in any of the pages.Formats. Empty means Markdown only
+	Extensions []string
+
+	// MaxSize is the size in bytes of the biggest file that's loaded as a
+	// page. Bigger files aren't read, and are skipped like pages that can't
+	// be parsed. 0 means DefaultMaxPageSize
+	MaxSize int64
+
+	// YearDirs also looks for pages in the year directories in the docs
+	// directory (e.g.: docs/2024/), where the yearly layout keeps them
+	YearDirs bool
+}
+
+// SkippedFile is a page file that LoadPagesSkipping left out, because it
+// couldn't be read as a page
+type SkippedFile struct {
+	FilePath string
+
+	// Err is why, a *src.ParseError for front-matter that isn't YAML, or for
+	// a file that's too big or binary
+	Err error
+}
+
+// BuildReport describes what BuildTagPages did to the docs directory
+type BuildReport struct {
+	// Removed are the paths of tag pages that were removed because their tags
+	// fell below the MinTagCount
+	Removed []string
+
+	// Reserved are the tags that didn't get a tag page because til generates
+	// a page with that name
+	Reserved []string
+
+	// Warnings are the other tags that didn't get a tag page, and why
+	Warnings []string
+
+	// Written are the paths of tag pages that were written
+	Written []string
+
+	// Failed are the tag pages that couldn't be written, or removed, by tag
+	// name. BuildTagPages carries on with the others, and returns these as
+	// its error
+	Failed TagPageErrors
+}
+
+// TagPageError is a tag page that BuildTagPages couldn't write or remove
+type TagPageError struct {
+	Tag string
+	Err error
+}
+
+// Error says which tag's page it was, and what went wrong
+func (err *TagPageError) Error() string {
+	return fmt.Sprintf("the %s tag page: %s", err.Tag, err.Err)
+}
+
+// Unwrap returns what went wrong
+func (err *TagPageError) Unwrap() error {
+	return err.Err
+}
+
+// TagPageErrors are all the tag pages that a build couldn't write, in the
+// order of their tag names
+type TagPageErrors []*TagPageError
+
+// Error lists every tag page that couldn't be written
+func (errs TagPageErrors) Error() string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	noun := "tag pages"
+	if len(errs) == 1 {
+		noun = "tag page"
+	}
+
+	return fmt.Sprintf("couldn't write %d %s: %s", len(errs), noun, strings.Join(messages, "; "))
+}
+
+// LoadPages reads the page files in dir and creates Page instances from them,
+// in reverse chronological order of their front-matter dates. Filenames can't
+// be relied on for ordering because their date format is configurable.
+// Any files in exclude are not pages, and are left out, as are the pages that
+// til generated, like the index. If ctx is cancelled, LoadPages stops and
+// returns ctx's error
+func LoadPages(ctx context.Context, dir string, exclude ...string) ([]*Page, error) {
+	return LoadPagesFS(ctx, pages.OSFS{}, dir, exclude...)
+}
+
+// LoadPagesFS is LoadPages, reading the pages from fsys
+func LoadPagesFS(ctx context.Context, fsys pages.FS, dir string, exclude ...string) ([]*Page, error) {
+	return LoadPagesWithOptions(ctx, fsys, dir, LoadOptions{Exclude: exclude})
+}
+
+// LoadPagesWithOptions is LoadPagesFS, reading the files that the options
+// say are pages
+func LoadPagesWithOptions(ctx context.Context, fsys pages.FS, dir string, opts LoadOptions) ([]*Page, error) {
+	pageSet, _, err := loadPages(ctx, fsys, dir, opts, false)
+	return pageSet, err
+}
+
+// LoadPagesSkipping is LoadPagesWithOptions, except that a page file that
+// can't be parsed is skipped instead of failing the load, so that one bad
+// page doesn't stop everything else. It returns the files it skipped, in
+// filename order. Files that can't be read at all still fail it
+func LoadPagesSkipping(ctx context.Context, fsys pages.FS, dir string, opts LoadOptions) ([]*Page, []SkippedFile, error) {
+	return loadPages(ctx, fsys, dir, opts, true)
+}
+
+// PageFilePaths returns the paths to all the page files in dir, leaving out
+// any files in exclude
+func PageFilePaths(dir string, exclude ...string) ([]string, error) {
+	return PageFilePathsFS(pages.OSFS{}, dir, exclude...)
+}
+
+// PageFilePathsFS is PageFilePaths, looking for the files in fsys
+func PageFilePathsFS(fsys pages.FS, dir string, exclude ...string) ([]string, error) {
+	return PageFilePathsWithOptions(fsys, dir, LoadOptions{Exclude: exclude})
+}
+
+// PageFilePathsWithOptions is PageFilePathsFS, looking for the files that the
+// options say are pages. The paths are in filename order
+func PageFilePathsWithOptions(fsys pages.FS, dir string, opts LoadOptions) ([]string, error) {
+	globbed := []string{}
+
+	patterns := []string{"*.%s"}
+	if opts.YearDirs {
+		patterns = append(patterns, filepath.Join("[0-9][0-9][0-9][0-9]", "*.%s"))
+	}
+
+	for _, pattern := range patterns {
+		for _, ext := range opts.extensions() {
+			matches, err := fsys.Glob(filepath.Join(dir, fmt.Sprintf(pattern, ext)))
+			if err != nil {
+				return nil, err
+			}
+
+			globbed = append(globbed, matches...)
+		}
+	}
+
+	sort.Strings(globbed)
+
+	excluded := map[string]bool{}
+	for _, filePath := range opts.Exclude {
+		excluded[filePath] = true
+	}
+
+	filePaths := []string{}
+	for i, filePath := range globbed {
+		// The same extension can be listed twice
+		if !excluded[filePath] && (i == 0 || globbed[i-1] != filePath) {
+			filePaths = append(filePaths, filePath)
+		}
+	}
+
+	return filePaths, nil
+}
+
+// NewTagMap creates a TagMap from the pages, grouping the tags as defined in
+// the options
+func NewTagMap(pageSet []*Page, opts Options) *TagMap {
+	return pages.NewTagMapWithOptions(pageSet, pages.TagMapOptions{
+		Aliases:        opts.Aliases,
+		LowercaseNames: opts.LowercaseTags,
+	})
+}
+
+// BuildIndex writes the index.md page that is the root of the site into dir,
+// and returns its path. Nothing is written if ctx has already been cancelled
+func BuildIndex(ctx context.Context, dir string, pageSet []*Page, tagMap *TagMap, opts Options) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(dir, fmt.Sprintf("index.%s", pages.FileExtension))
+
+	err := opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(IndexContent(pageSet, tagMap, opts))), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// IndexContent creates the content of the index page: the list of tags, the
+// list of pages, and the footer. Archived pages are left off, with a link to
+// the archive page instead. When the pages have authors, the author pages are
+// linked above the footer
+func IndexContent(pageSet []*Page, tagMap *TagMap, opts Options) string {
+	var content strings.Builder
+
+	allPages := indexedPages(pageSet)
+	belowMin := belowMinTagCount(tagMap, opts)
+
+	// Write the tag list into the top of the index
+	if !opts.OmitTagList {
+		tagNames := tagMap.SortedTagNames()
+		tagLinks := make([]string, 0, len(tagNames))
+
+		for _, tagName := range tagNames {
+			if IsReservedTagName(tagName, opts.ReservedNames) || belowMin(tagName) {
+				continue
+			}
+
+			tags := tagMap.Get(tagName)
+			if len(tags) > 0 {
+				tagLinks = append(tagLinks, tags[0].Link())
+			}
+		}
+
+		content.WriteString(strings.Join(tagLinks, ", "))
+		content.WriteString("\n")
+	}
+
+	// Write the page list into the middle of the page
+	listed := allPages
+	limited := opts.IndexLimit > 0 && len(listed) > opts.IndexLimit
+	if limited {
+		listed = listed[:opts.IndexLimit]
+	}
+
+	entry := indexEntry(EntryAnchors(pageSet), tagMap, opts, belowMin)
+
+	switch {
+	case opts.IndexSort == IndexSortAlphabetical:
+		plainPageList(&content, alphabetical(listed), entry)
+	case opts.IndexSort == IndexSortByTag:
+		tagPageList(&content, listed, tagMap, opts.IndexSortFirstTag, entry)
+	case opts.IndexLayout == IndexLayoutYears:
+		yearPageList(&content, listed, pages.Now().Year(), entry)
+	default:
+		pageList(&content, listed, entry)
+	}
+
+	if limited {
+		fmt.Fprintf(&content, "\n[See all %d entries →](%s.%s)\n", len(allPages), AllPageName, pages.FileExtension)
+	}
+
+	if opts.TagsPage {
+		fmt.Fprintf(&content, "\n[All tags →](%s.%s)\n", TagsPageName, pages.FileExtension)
+	}
+
+	if archived := archivedPages(pageSet); len(archived) > 0 {
+		fmt.Fprintf(&content, "\n[Archive, %s →](%s.%s)\n", pluralEntries(len(archived)), ArchivePageName, pages.FileExtension)
+	}
+
+	content.WriteString("\n")
+
+	if authors := Authors(pageSet, opts.Unattributed); len(authors) > 0 {
+		fmt.Fprintf(&content, "\n%s\n", authorLinks(authors))
+	}
+
+	if opts.IndexNote != "" {
+		fmt.Fprintf(&content, "\n_%s_\n", opts.IndexNote)
+	}
+
+	// Write the footer content into the bottom of the index
+	content.WriteString("\n")
+	content.WriteString(opts.Footer)
+
+	return content.String()
+}
+
+// BuildAllPage writes the all page, which lists every page, into dir and
+// returns its path. It's only written when the IndexLimit leaves pages off the
+// index. Without an IndexLimit, an all page left over from an earlier build is
+// removed instead, and the path is empty
+func BuildAllPage(ctx context.Context, dir string, pageSet []*Page, tagMap *TagMap, opts Options) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(dir, fmt.Sprintf("%s.%s", AllPageName, pages.FileExtension))
+
+	if opts.IndexLimit <= 0 {
+		return "", pruneGeneratedPage(opts.fs(), filePath)
+	}
+
+	err := opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(AllContent(pageSet, tagMap, opts))), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// AllContent creates the content of the all page: every page that isn't
+// archived, newest first, in the same list as the index
+func AllContent(pageSet []*Page, tagMap *TagMap, opts Options) string {
+	var content strings.Builder
+
+	listed := indexedPages(pageSet)
+
+	content.WriteString("## All entries\n\n")
+	fmt.Fprintf(&content, "_%s_\n", pluralEntries(len(listed)))
+
+	// Write the page list into the middle of the page
+	pageList(&content, listed, indexEntry(EntryAnchors(pageSet), tagMap, opts, belowMinTagCount(tagMap, opts)))
+
+	// Write the footer content into the bottom of the page
+	content.WriteString("\n")
+	content.WriteString(opts.Footer)
+
+	return content.String()
+}
+
+// BuildArchivePage writes the archive page, which lists the archived pages,
+// into dir and returns its path. It's only written when there are archived
+// pages. Without any, an archive page left over from an earlier build is
+// removed instead, and the path is empty
+func BuildArchivePage(ctx context.Context, dir string, pageSet []*Page, tagMap *TagMap, opts Options) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(dir, fmt.Sprintf("%s.%s", ArchivePageName, pages.FileExtension))
+
+	if len(archivedPages(pageSet)) == 0 {
+		return "", pruneGeneratedPage(opts.fs(), filePath)
+	}
+
+	err := opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(ArchiveContent(pageSet, tagMap, opts))), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// ArchiveContent creates the content of the archive page: the archived pages,
+// newest first, listed the way the index lists its pages, and the footer.
+// They keep the anchors they had on the index
+func ArchiveContent(pageSet []*Page, tagMap *TagMap, opts Options) string {
+	var content strings.Builder
+
+	archived := archivedPages(pageSet)
+
+	content.WriteString("## Archive\n\n")
+	fmt.Fprintf(&content, "_%s_\n", pluralEntries(len(archived)))
+
+	// Write the page list into the middle of the page
+	pageList(&content, archived, indexEntry(EntryAnchors(pageSet), tagMap, opts, belowMinTagCount(tagMap, opts)))
+
+	// Write the footer content into the bottom of the page
+	content.WriteString("\n")
+	content.WriteString(opts.Footer)
+
+	return content.String()
+}
+
+// BuildTagsPage writes the tags page, which lists every tag that has a tag
+// page, into dir and returns its path
+func BuildTagsPage(ctx context.Context, dir string, tagMap *TagMap, opts Options) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(dir, fmt.Sprintf("%s.%s", TagsPageName, pages.FileExtension))
+
+	err := opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(TagsContent(tagMap, opts))), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// TagsContent creates the content of the tags page: a table of the tags that
+// have a tag page, in alphabetical order, with the number of pages each one
+// has and the date of its most recent page
+func TagsContent(tagMap *TagMap, opts Options) string {
+	tagNames := tagsWithPages(tagMap, opts)
+
+	noun := "tags"
+	if len(tagNames) == 1 {
+		noun = "tag"
+	}
+
+	var content strings.Builder
+
+	content.WriteString("## Tags\n\n")
+	fmt.Fprintf(&content, "_%d %s_\n", len(tagNames), noun)
+
+	// Write the table into the middle of the page
+	if len(tagNames) > 0 {
+		content.WriteString("\n| Tag | Entries | Most recent |\n")
+		content.WriteString("| --- | ---: | --- |\n")
+	}
+
+	for _, tagName := range tagNames {
+		stats := tagMap.Stats(tagName)
+
+		lastUsed := ""
+		if !stats.LastUsed.IsZero() {
+			lastUsed = pages.FormatDate(stats.LastUsed)
+		}
+
+		link := strings.ReplaceAll(tagMap.Get(tagName)[0].Link(), "|", "\\|")
+		fmt.Fprintf(&content, "| %s | %d | %s |\n", link, stats.Count, lastUsed)
+	}
+
+	// Write the footer content into the bottom of the page
+	content.WriteString("\n")
+	content.WriteString(opts.Footer)
+
+	return content.String()
+}
+
+// BuildTagPages writes a page for each tag in the TagMap into dir, with links
+// to the pages tagged with it. Tags below the MinTagCount have any tag page
+// left over from an earlier build removed instead. Reserved tags, and all but
+// the first of several tags that would write to the same file, are skipped.
+// The pages are written by a pool of opts.Workers at once. A page that can't
+// be written doesn't stop the others: they're all in the report's Failed,
+// which is returned as the error once every page has been tried. If ctx is
+// cancelled, the pages already being written are finished but no more are
+// started, and BuildTagPages returns ctx's error once they're done
+func BuildTagPages(ctx context.Context, dir string, tagMap *TagMap, opts Options) (BuildReport, error) {
+	report := BuildReport{Removed: []string{}, Reserved: []string{}, Warnings: []string{}, Written: []string{}}
+	coOccurrences := tagMap.CoOccurrences()
+
+	// When several tags would write to the same file, only the first gets to
+	// write it, and the rest are left out with a warning
+	collisions := tagMap.PagePathCollisions()
+	skipped := collidingTags(collisions)
+
+	pagePaths := []string{}
+	for pagePath := range collisions {
+		pagePaths = append(pagePaths, pagePath)
+	}
+
+	sort.Strings(pagePaths)
+
+	for _, pagePath := range pagePaths {
+		names := collisions[pagePath]
+
+		report.Warnings = append(report.Warnings, fmt.Sprintf("tags %s all have the tag page %s, so only '%s' gets one. Please rename the others", strings.Join(names, ", "), pagePath, names[0]))
+	}
+
+	tagNames := []string{}
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		if IsReservedTagName(tagName, opts.ReservedNames) {
+			report.Reserved = append(report.Reserved, tagName)
+			continue
+		}
+
+		if !skipped[tagName] {
+			tagNames = append(tagNames, tagName)
+		}
+	}
+
+	var wGroup sync.WaitGroup
+	var mutex sync.Mutex
+
+	jobs := make(chan string)
+
+	for i := 0; i < opts.workers(); i++ {
+		wGroup.Add(1)
+
+		go func() {
+			defer wGroup.Done()
+
+			for tagName := range jobs {
+				// A page that's waiting when the build is cancelled isn't
+				// started on at all
+				if ctx.Err() != nil {
+					continue
+				}
+
+				written, removed, err := buildTagPage(dir, tagMap, tagName, coOccurrences[tagName], opts)
+
+				mutex.Lock()
+
+				switch {
+				case err != nil:
+					report.Failed = append(report.Failed, &TagPageError{Tag: tagName, Err: err})
+				case written != "":
+					report.Written = append(report.Written, written)
+				case removed != "":
+					report.Removed = append(report.Removed, removed)
+				}
+
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for _, tagName := range tagNames {
+		if ctx.Err() != nil {
+			break
+		}
+
+		jobs <- tagName
+	}
+
+	close(jobs)
+	wGroup.Wait()
+
+	// The workers finish in any order, so the report is sorted to be the
+	// same from build to build
+	sort.Strings(report.Written)
+	sort.Strings(report.Removed)
+	sort.Slice(report.Failed, func(i, j int) bool { return report.Failed[i].Tag < report.Failed[j].Tag })
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+
+	if len(report.Failed) > 0 {
+		return report, report.Failed
+	}
+
+	return report, nil
+}
+
+// TagPageContent creates the content of a tag's page: a heading (with a
+// breadcrumb back up to the parent tags for child tags), the tag's
+// description if it has one, a summary of the tag's stats, the tags most
+// often used alongside it, a list of links to the tagged pages, and the footer
+func TagPageContent(tag *pages.Tag, pageSet []*Page, desc pages.TagDescription, related []pages.TagCount, footer string) string {
+	heading := tag.Breadcrumb()
+	if desc.Title != "" {
+		heading = strings.TrimSuffix(heading, tag.ShortName()) + desc.Title
+	}
+
+	var content strings.Builder
+
+	fmt.Fprintf(&content, "## %s\n\n", heading)
+
+	if desc.Description != "" {
+		fmt.Fprintf(&content, "%s\n\n", strings.TrimSpace(desc.Description))
+	}
+
+	stats := (&pages.Tag{Name: tag.Name, Pages: pageSet}).Stats()
+	fmt.Fprintf(&content, "_%s_\n", stats.Summary())
+
+	if len(related) > 0 {
+		fmt.Fprintf(&content, "\n%s\n", seeAlso(related))
+	}
+
+	// Write the page list into the middle of the page. Archived pages are
+	// still listed, but marked as archived
+	prefix := tag.RootPrefix()
+	pageList(&content, pageSet, func(page *Page) string {
+		if page.Archived {
+			return page.LinkFrom(prefix) + archivedSuffix
+		}
+
+		return page.LinkFrom(prefix)
+	})
+
+	// Write the footer content into the bottom of the page
+	content.WriteString("\n")
+	content.WriteString(footer)
+
+	return content.String()
+}
+
+// PageList creates the unordered list of page links that appear on the index
+// and tag pages, broken up by month. The pages are listed in the order they're
+// in, so they need to be sorted newest first, as LoadPages does. prefix is the relative path from the page
+// the list is written into back to the docs directory
+func PageList(pageSet []*Page, prefix string) string {
+	var content strings.Builder
+
+	pageList(&content, pageSet, func(page *Page) string { return page.LinkFrom(prefix) })
+
+	return content.String()
+}
+
+// EntryAnchors returns the anchor of each content page's entry on the index
+// and the all page, by the page's file path. An anchor is the slug of the
+// page's title, so it stays the same from build to build for as long as the
+// title does. Pages whose titles have the same slug are numbered in the order
+// they were written, oldest first, so that a new page never takes the anchor
+// of one that's already been linked to
+func EntryAnchors(pageSet []*Page) map[string]string {
+	// The dates are parsed once, rather than every time two pages are compared
+	type datedPage struct {
+		page    *Page
+		created time.Time
+	}
+
+	oldestFirst := []datedPage{}
+	for _, page := range contentPages(pageSet) {
+		oldestFirst = append(oldestFirst, datedPage{page: page, created: page.CreatedAt()})
+	}
+
+	sort.SliceStable(oldestFirst, func(i, j int) bool {
+		a, b := oldestFirst[i].created, oldestFirst[j].created
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+
+		return oldestFirst[i].page.FilePath < oldestFirst[j].page.FilePath
+	})
+
+	anchors := make(map[string]string, len(oldestFirst))
+	used := make(map[string]bool, len(oldestFirst))
+
+	for _, dated := range oldestFirst {
+		page := dated.page
+		slug := pages.Slug(page.Title)
+
+		anchor := slug
+		for n := 2; used[anchor]; n++ {
+			anchor = fmt.Sprintf("%s-%d", slug, n)
+		}
+
+		used[anchor] = true
+		anchors[page.FilePath] = anchor
+	}
+
+	return anchors
+}
+
+// IsReservedTagName returns true if a tag's page would have the same name as
+// one of the reserved names. Child tag pages live in their own directory tree,
+// so only top-level tags can conflict
+func IsReservedTagName(tagName string, reserved []string) bool {
+	slug := pages.TagSlug(tagName)
+	if strings.Contains(slug, pages.TagSeparator) {
+		return false
+	}
+
+	for _, name := range reserved {
+		if slug == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// loadPages reads the pages, newest first, leaving out the ones that til
+// generated. With skip, the files that can't be
+// parsed are left out and returned, rather than failing the load
+func loadPages(ctx context.Context, fsys pages.FS, dir string, opts LoadOptions, skip bool) ([]*Page, []SkippedFile, error) {
+	filePaths, err := PageFilePathsWithOptions(fsys, dir, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageSet := []*Page{}
+	skipped := []SkippedFile{}
+
+	for _, filePath := range filePaths {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		page, err := pages.ReadPageLimit(fsys, filePath, opts.maxSize())
+
+		var parseErr *src.ParseError
+		if skip && errors.As(err, &parseErr) {
+			skipped = append(skipped, SkippedFile{FilePath: filePath, Err: err})
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The index and top-level tag pages are in dir alongside the pages
+		if page.IsGenerated() {
+			continue
+		}
+
+		pageSet = append(pageSet, page)
+	}
+
+	pages.SortNewestFirst(pageSet)
+
+	return pageSet, skipped, nil
+}
+
+// extensions returns the file extensions of the pages
+func (opts LoadOptions) extensions() []string {
+	if len(opts.Extensions) == 0 {
+		return []string{pages.FileExtension}
+	}
+
+	exts := []string{}
+	for _, ext := range opts.Extensions {
+		exts = append(exts, strings.TrimPrefix(strings.TrimSpace(ext), "."))
+	}
+
+	return exts
+}
+
+// maxSize returns the size in bytes of the biggest file that's a page
+func (opts LoadOptions) maxSize() int64 {
+	if opts.MaxSize <= 0 {
+		return DefaultMaxPageSize
+	}
+
+	return opts.MaxSize
+}
+
+// workers returns the number of tag pages that are written at once
+func (opts Options) workers() int {
+	if opts.Workers <= 0 {
+		return DefaultWorkers
+	}
+
+	return opts.Workers
+}
+
+// fs returns the filesystem the pages are written to
+func (opts Options) fs() pages.FS {
+	if opts.FS == nil {
+		return pages.OSFS{}
+	}
+
+	return opts.FS
+}
+
+// isBelowMinTagCount returns true if the tag has too few pages to get a tag
+// page and a link in the index
+func isBelowMinTagCount(tagMap *TagMap, tagName string, opts Options) bool {
+	return tagMap.PageCount(tagName) < opts.MinTagCount
+}
+
+// belowMinTagCount returns isBelowMinTagCount for the tag map and options,
+// remembering each tag's answer. The index asks about a tag once for every
+// page that has it
+func belowMinTagCount(tagMap *TagMap, opts Options) func(tagName string) bool {
+	below := map[string]bool{}
+
+	return func(tagName string) bool {
+		isBelow, ok := below[tagName]
+		if !ok {
+			isBelow = isBelowMinTagCount(tagMap, tagName, opts)
+			below[tagName] = isBelow
+		}
+
+		return isBelow
+	}
+}
+
+// indexedPages returns the content pages that the index and the all page
+// list, which are the ones that aren't archived
+func indexedPages(pageSet []*Page) []*Page {
+	indexed := []*Page{}
+
+	for _, page := range contentPages(pageSet) {
+		if !page.Archived {
+			indexed = append(indexed, page)
+		}
+	}
+
+	return indexed
+}
+
+// archivedPages returns the content pages that are archived
+func archivedPages(pageSet []*Page) []*Page {
+	archived := []*Page{}
+
+	for _, page := range contentPages(pageSet) {
+		if page.Archived {
+			archived = append(archived, page)
+		}
+	}
+
+	return archived
+}
+
+// collidingTags returns the names of the tags that don't get a tag page
+// because an earlier tag in the collisions writes to the same file
+func collidingTags(collisions map[string][]string) map[string]bool {
+	skipped := map[string]bool{}
+
+	for _, names := range collisions {
+		for _, name := range names[1:] {
+			skipped[name] = true
+		}
+	}
+
+	return skipped
+}
+
+// tagsWithPages returns the names of the tags that BuildTagPages writes a
+// page for, in alphabetical order
+func tagsWithPages(tagMap *TagMap, opts Options) []string {
+	skipped := collidingTags(tagMap.PagePathCollisions())
+	tagNames := []string{}
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		if IsReservedTagName(tagName, opts.ReservedNames) || skipped[tagName] || isBelowMinTagCount(tagMap, tagName, opts) {
+			continue
+		}
+
+		tagNames = append(tagNames, tagName)
+	}
+
+	return tagNames
+}
+
+// buildTagPage writes the tag's page into dir, and returns its path. A tag
+// below the MinTagCount doesn't get one. One might be left over from a build
+// with a lower MinTagCount though, so it's removed instead, and its path is
+// returned as removed. A file with front-matter where the page goes is a
+// content page, and is an error rather than being written over
+func buildTagPage(dir string, tagMap *TagMap, tagName string, related []pages.TagCount, opts Options) (written, removed string, err error) {
+	tag := tagMap.Get(tagName)[0]
+
+	if isBelowMinTagCount(tagMap, tagName, opts) {
+		removed, err = pruneTagPage(opts.fs(), dir, tag)
+		return "", removed, err
+	}
+
+	content := TagPageContent(tag, tagMap.PagesFor(tagName), opts.Descriptions.For(tagName), related, opts.Footer)
+
+	// Child tag pages live in a sub-directory tree, which might not exist yet
+	filePath := filepath.Join(dir, filepath.FromSlash(tag.PagePath()))
+
+	if data, err := opts.fs().ReadFile(filePath); err == nil && strings.HasPrefix(string(data), "---") {
+		return "", "", fmt.Errorf(errTagPageIsContent, tagName, filePath)
+	}
+
+	err = opts.fs().MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = opts.fs().WriteFile(filePath, []byte(pages.MarkGenerated(content)), 0644)
+	if err != nil {
+		return "", "", err
+	}
+
+	return filePath, "", nil
+}
+
+// pruneTagPage removes a tag's page from dir, if there is one, and returns
+// its path. Only generated pages are removed: a file with front-matter is a
+// content page, and is left alone
+func pruneTagPage(fsys pages.FS, dir string, tag *pages.Tag) (string, error) {
+	filePath := filepath.Join(dir, filepath.FromSlash(tag.PagePath()))
+
+	data, err := fsys.ReadFile(filePath)
+	if err != nil || strings.HasPrefix(string(data), "---") {
+		return "", nil
+	}
+
+	err = fsys.Remove(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// pageList writes PageList into content, with each page's line in the list
+// made by entry
+func pageList(content *strings.Builder, pageSet []*Page, entry func(*Page) string) {
+	prevMonth := time.Month(0)
+
+	for _, page := range pageSet {
+		if !page.IsContentPage() {
+			continue
+		}
+
+		// This breaks the page list up by month
+		month := page.CreatedMonth()
+		if month != prevMonth {
+			content.WriteString("\n")
+		}
+
+		writeEntry(content, entry(page))
+
+		prevMonth = month
+	}
+}
+
+// indexEntry returns what makes a page's line in the lists on the index and
+// the all page: an anchor to link to it by, its link, and then its tags if
+// the IndexTags option is set. A page that's listed more than once, under
+// each of its tags, only has the anchor the first time
+func indexEntry(anchors map[string]string, tagMap *TagMap, opts Options, belowMin func(string) bool) func(*Page) string {
+	anchored := make(map[string]bool, len(anchors))
+
+	return func(page *Page) string {
+		link := page.Link()
+
+		// Only the icons and the tag links need the page's tags
+		tagNames := []string{}
+		if len(opts.Icons) > 0 || opts.IndexTags {
+			tagNames = pageTagNames(page, tagMap)
+		}
+
+		if icon := tagIcon(tagNames, opts.Icons); icon != "" {
+			link = icon + " " + link
+		}
+
+		if !anchored[page.FilePath] {
+			anchored[page.FilePath] = true
+			link = fmt.Sprintf(`<a id="%s"></a>%s`, anchors[page.FilePath], link)
+		}
+
+		if !opts.IndexTags {
+			return link
+		}
+
+		if tagLinks := pageTagLinks(tagNames, tagMap, opts, belowMin); len(tagLinks) > 0 {
+			link += indexTagsSeparator + strings.Join(tagLinks, ", ")
+		}
+
+		return link
+	}
+}
+
+// pageTagLinks returns the links to the tag pages of a page's tags, given by
+// their canonical names. Tags that don't get a tag page are just their name
+func pageTagLinks(tagNames []string, tagMap *TagMap, opts Options, belowMin func(string) bool) []string {
+	links := make([]string, 0, len(tagNames))
+
+	for _, name := range tagNames {
+		tags := tagMap.Get(name)
+		if len(tags) == 0 || IsReservedTagName(name, opts.ReservedNames) || belowMin(name) {
+			links = append(links, name)
+			continue
+		}
+
+		links = append(links, tags[0].Link())
+	}
+
+	return links
+}
+
+// pageTagNames returns the canonical names of the page's tags, in the order
+// they're in its front-matter, without duplicates
+func pageTagNames(page *Page, tagMap *TagMap) []string {
+	tags := page.Tags()
+	names := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		name := tagMap.CanonicalName(tag.Name)
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// tagIcon returns the icon of the first of the tags that has one in icons,
+// which are by lower-case tag name, or nothing if none of them do
+func tagIcon(tagNames []string, icons map[string]string) string {
+	for _, name := range tagNames {
+		if icon, ok := icons[strings.ToLower(name)]; ok {
+			return icon
+		}
+	}
+
+	return ""
+}
+
+// plainPageList is pageList without the breaks between the months, for pages
+// that aren't in date order
+func plainPageList(content *strings.Builder, pageSet []*Page, entry func(*Page) string) {
+	content.WriteString("\n")
+
+	for _, page := range pageSet {
+		writeEntry(content, entry(page))
+	}
+}
+
+// writeEntry writes a page's line in a list into content
+func writeEntry(content *strings.Builder, entry string) {
+	content.WriteString("* ")
+	content.WriteString(entry)
+	content.WriteString("\n")
+}
+
+// alphabetical returns the pages sorted by title, in any case. Pages with the
+// same title stay newest first
+func alphabetical(pageSet []*Page) []*Page {
+	sorted := append([]*Page{}, pageSet...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Title) < strings.ToLower(sorted[j].Title)
+	})
+
+	return sorted
+}
+
+// tagPageList lists the pages under a heading for each of their tags, in
+// alphabetical order, and then the untagged pages. Within each heading the
+// pages stay in the order they're in. With firstOnly, a page is only listed
+// under the first of its tags
+func tagPageList(content *strings.Builder, pageSet []*Page, tagMap *TagMap, firstOnly bool, entry func(*Page) string) {
+	byTag := map[string][]*Page{}
+	untagged := []*Page{}
+
+	for _, page := range pageSet {
+		tagNames := pageTagNames(page, tagMap)
+		if len(tagNames) == 0 {
+			untagged = append(untagged, page)
+			continue
+		}
+
+		if firstOnly {
+			tagNames = tagNames[:1]
+		}
+
+		for _, tagName := range tagNames {
+			byTag[tagName] = append(byTag[tagName], page)
+		}
+	}
+
+	tagNames := make([]string, 0, len(byTag))
+	for tagName := range byTag {
+		tagNames = append(tagNames, tagName)
+	}
+
+	sort.Slice(tagNames, func(i, j int) bool {
+		return strings.ToLower(tagNames[i]) < strings.ToLower(tagNames[j])
+	})
+
+	for _, tagName := range tagNames {
+		fmt.Fprintf(content, "\n### %s\n", tagName)
+		plainPageList(content, byTag[tagName], entry)
+	}
+
+	if len(untagged) > 0 {
+		fmt.Fprintf(content, "\n### %s\n", untaggedHeading)
+		plainPageList(content, untagged, entry)
+	}
+}
+
+// yearPageList is PageList with each year's pages in a <details> section that
+// GitHub can collapse, apart from the current year's, which are listed as
+// they are. Undated pages are a section of their own. GitHub only renders the
+// list inside a <details> as a list when there are blank lines around it
+func yearPageList(content *strings.Builder, pageSet []*Page, currentYear int, entry func(*Page) string) {
+	start := 0
+
+	for i := range pageSet {
+		created := pageSet[i].CreatedAt()
+		year := created.Year()
+		if i+1 < len(pageSet) && pageSet[i+1].CreatedAt().Year() == year {
+			continue
+		}
+
+		yearPages := pageSet[start : i+1]
+		start = i + 1
+
+		if year == currentYear {
+			pageList(content, yearPages, entry)
+			continue
+		}
+
+		label := strconv.Itoa(year)
+		if created.IsZero() {
+			label = "Undated"
+		}
+
+		var section strings.Builder
+		pageList(&section, yearPages, entry)
+
+		content.WriteString("\n<details>\n")
+		fmt.Fprintf(content, "<summary>%s (%s)</summary>\n\n", label, pluralEntries(len(yearPages)))
+		content.WriteString(strings.TrimPrefix(section.String(), "\n"))
+		content.WriteString("\n</details>\n")
+	}
+}
+
+// pluralEntries returns the number of entries, like "1 entry" or "3 entries"
+func pluralEntries(count int) string {
+	if count == 1 {
+		return "1 entry"
+	}
+
+	return fmt.Sprintf("%d entries", count)
+}
+
+// pruneGeneratedPage removes the page at filePath if it's one that til
+// generated
+func pruneGeneratedPage(fsys pages.FS, filePath string) error {
+	data, err := fsys.ReadFile(filePath)
+	if err != nil || !strings.HasPrefix(string(data), pages.GeneratedMarker) {
+		return nil
+	}
+
+	return fsys.Remove(filePath)
+}
+
+// seeAlso returns the "See also" line of a tag page, listing the top related
+// tags with the number of pages they share (e.g.: See also: concurrency (12))
+func seeAlso(related []pages.TagCount) string {
+	if len(related) > MaxSeeAlsoTags {
+		related = related[:MaxSeeAlsoTags]
+	}
+
+	names := []string{}
+	for _, tagCount := range related {
+		names = append(names, fmt.Sprintf("%s (%d)", tagCount.Name, tagCount.Count))
+	}
+
+	return fmt.Sprintf("See also: %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,2003 @@
+package til
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+	"github.com/stretchr/testify/assert"
+)
+
+// benchmarkPages is the number of pages the tag map and index benchmarks are
+// run over
+const benchmarkPages = 5000
+
+// updateGolden writes the golden files in testdata/golden again, rather than
+// comparing to them
+var updateGolden = flag.Bool("update", false, "write the golden files again")
+
+func Test_LoadPages_MixedFilenameFormats(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+	defer func() { pages.Now = time.Now }()
+
+	dates := []time.Time{
+		time.Date(2020, 5, 8, 7, 0, 0, 0, time.UTC),
+		time.Date(2020, 5, 8, 20, 0, 0, 0, time.UTC),
+		time.Date(2020, 5, 9, 9, 0, 0, 0, time.UTC),
+	}
+	formats := []string{"", "2006-01-02", ""}
+	titles := []string{"First", "Second", "Third"}
+
+	for i, date := range dates {
+		date := date
+		pages.Now = func() time.Time { return date }
+
+		_, err := pages.NewPage(titles[i], docsDir, pages.PageOptions{DateFormat: formats[i]})
+		assert.NoError(t, err)
+	}
+
+	// The date-only filename sorts before the timestamped one from earlier on
+	// the same day, so filename order alone would get this wrong
+	_, err := os.Stat(filepath.Join(docsDir, "2020-05-08-second.md"))
+	assert.NoError(t, err)
+
+	pageSet, err := LoadPages(context.Background(), docsDir)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(pageSet))
+	assert.Equal(t, "Third", pageSet[0].Title)
+	assert.Equal(t, "Second", pageSet[1].Title)
+	assert.Equal(t, "First", pageSet[2].Title)
+}
+
+func Test_LoadPages_WithoutDatePrefix(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+	defer func() { pages.Now = time.Now }()
+
+	// Alphabetical filename order is the opposite of creation order here
+	titles := []string{"Zebras", "Monkeys", "Aardvarks"}
+	for i, title := range titles {
+		date := time.Date(2020, 5, 7+i, 9, 0, 0, 0, time.UTC)
+		pages.Now = func() time.Time { return date }
+
+		_, err := pages.NewPage(title, docsDir, pages.PageOptions{OmitDate: true})
+		assert.NoError(t, err)
+	}
+
+	pageSet, err := LoadPages(context.Background(), docsDir)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Aardvarks", pageSet[0].Title)
+	assert.Equal(t, "Monkeys", pageSet[1].Title)
+	assert.Equal(t, "Zebras", pageSet[2].Title)
+}
+
+func Test_TagPageContent(t *testing.T) {
+	tag := pages.NewTag("go/concurrency", &pages.Page{})
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md"},
+	}
+
+	actual := TagPageContent(tag, pageSet, pages.TagDescription{}, nil, "")
+
+	assert.True(t, strings.HasPrefix(actual, "## [go](../../go) / concurrency\n\n"))
+	assert.Contains(t, actual, "* <code>May 07, 2020</code> [Channels](../../channels.md)\n")
+}
+
+func Test_TagPageContent_SeeAlso(t *testing.T) {
+	tag := pages.NewTag("go", &pages.Page{})
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md"},
+	}
+
+	related := []pages.TagCount{
+		{Name: "concurrency", Count: 12}, {Name: "testing", Count: 8}, {Name: "cli", Count: 3},
+		{Name: "errors", Count: 3}, {Name: "json", Count: 2}, {Name: "yaml", Count: 1},
+	}
+
+	actual := TagPageContent(tag, pageSet, pages.TagDescription{}, related, "")
+
+	expected := "## go\n\n_1 entry, last updated May 2020_\n\nSee also: concurrency (12), testing (8), cli (3), errors (3), json (2)\n\n* "
+	assert.True(t, strings.HasPrefix(actual, expected), actual)
+}
+
+func Test_TagPageContent_Description(t *testing.T) {
+	tests := []struct {
+		name           string
+		tagName        string
+		desc           pages.TagDescription
+		expectedPrefix string
+	}{
+		{
+			name:           "with no description",
+			tagName:        "go",
+			desc:           pages.TagDescription{},
+			expectedPrefix: "## go\n\n_1 entry, last updated May 2020_\n\n* ",
+		},
+		{
+			name:           "with a description",
+			tagName:        "go",
+			desc:           pages.TagDescription{Description: "Notes on Go"},
+			expectedPrefix: "## go\n\nNotes on Go\n\n_1 entry, last updated May 2020_\n\n* ",
+		},
+		{
+			name:           "with a title and description",
+			tagName:        "go",
+			desc:           pages.TagDescription{Title: "The Go Language", Description: "Notes on Go\n"},
+			expectedPrefix: "## The Go Language\n\nNotes on Go\n\n_1 entry, last updated May 2020_\n\n* ",
+		},
+		{
+			name:           "with a title on a child tag",
+			tagName:        "go/concurrency",
+			desc:           pages.TagDescription{Title: "Concurrency in Go"},
+			expectedPrefix: "## [go](../../go) / Concurrency in Go\n\n_1 entry, last updated May 2020_\n\n* ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := pages.NewTag(tt.tagName, &pages.Page{})
+			pageSet := []*Page{
+				{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md"},
+			}
+
+			actual := TagPageContent(tag, pageSet, tt.desc, nil, "")
+
+			assert.True(t, strings.HasPrefix(actual, tt.expectedPrefix), actual)
+		})
+	}
+}
+
+func Test_PageFilePaths(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+
+	for _, name := range []string{"_tags.yml.md", "zombies.md", "notes.txt"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, name), []byte("# Zombies\n"), 0644))
+	}
+
+	actual, err := PageFilePaths(docsDir, filepath.Join(docsDir, "_tags.yml.md"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(docsDir, "zombies.md")}, actual)
+}
+
+func Test_LoadPagesWithOptions_Formats(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "formats", "*"))
+	assert.NoError(t, err)
+
+	for _, fixture := range fixtures {
+		data, err := ioutil.ReadFile(fixture)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, filepath.Base(fixture)), data, 0644))
+	}
+
+	// The Org page without a date is dated when it was last changed
+	modTime := time.Date(2021, 1, 5, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(filepath.Join(docsDir, "untitled.org"), modTime, modTime))
+
+	pageSet, err := LoadPagesWithOptions(context.Background(), pages.OSFS{}, docsDir, LoadOptions{Extensions: []string{"md", ".adoc", "org"}})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		fileName string
+		format   string
+		date     string
+		tags     string
+		title    string
+	}{
+		{
+			fileName: "zombies.adoc",
+			format:   "AsciiDoc",
+			date:     "2021-05-02T10:00:00Z",
+			tags:     "horror",
+			title:    "Zombies",
+		},
+		{
+			fileName: "go-closures.md",
+			format:   "Markdown",
+			date:     "2021-04-26T09:30:00Z",
+			tags:     "go",
+			title:    "Go Closures",
+		},
+		{
+			fileName: "lava-lamps.adoc",
+			format:   "AsciiDoc",
+			date:     time.Date(2021, 3, 14, 0, 0, 0, 0, time.Local).Format(time.RFC3339),
+			tags:     "physics, lamps",
+			title:    "Lava Lamps",
+		},
+		{
+			fileName: "emacs-macros.org",
+			format:   "Org",
+			date:     time.Date(2021, 2, 1, 8, 15, 0, 0, time.Local).Format(time.RFC3339),
+			tags:     "emacs, editors",
+			title:    "Emacs Macros",
+		},
+		{
+			fileName: "untitled.org",
+			format:   "Org",
+			date:     modTime.Local().Format(time.RFC3339),
+			tags:     "",
+			title:    "Tea Steeping",
+		},
+	}
+
+	assert.Equal(t, len(tests), len(pageSet))
+
+	for i, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			page := pageSet[i]
+
+			assert.Equal(t, tt.fileName, filepath.Base(page.FilePath))
+			assert.Equal(t, tt.format, page.Format().Name)
+			assert.Equal(t, tt.date, page.Date)
+			assert.Equal(t, tt.tags, string(page.TagsStr))
+			assert.Equal(t, tt.title, page.Title)
+		})
+	}
+}
+
+func Test_PageFilePathsWithOptions(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	for _, name := range []string{"b.org", "a.md", "c.adoc", "d.txt", "_tags.yml.md"} {
+		assert.NoError(t, memFS.WriteFile(filepath.Join("docs", name), []byte("* B\n"), 0644))
+	}
+
+	actual, err := PageFilePathsWithOptions(memFS, "docs", LoadOptions{
+		Exclude:    []string{filepath.Join("docs", "_tags.yml.md")},
+		Extensions: []string{"md", "org", "adoc", "org"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "a.md"), filepath.Join("docs", "b.org"), filepath.Join("docs", "c.adoc")}, actual)
+
+	// Only Markdown without any extensions
+	actual, err = PageFilePathsWithOptions(memFS, "docs", LoadOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "_tags.yml.md"), filepath.Join("docs", "a.md")}, actual)
+}
+
+func Test_PageFilePathsWithOptions_YearDirs(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	for _, name := range []string{"a.md", "2024/b.md", "2023/c.md", "tags/go/concurrency.md", "drafts/d.md", "20245/e.md"} {
+		assert.NoError(t, memFS.WriteFile(filepath.Join("docs", filepath.FromSlash(name)), []byte("# B\n"), 0644))
+	}
+
+	actual, err := PageFilePathsWithOptions(memFS, "docs", LoadOptions{YearDirs: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join("docs", "2023", "c.md"),
+		filepath.Join("docs", "2024", "b.md"),
+		filepath.Join("docs", "a.md"),
+	}, actual)
+
+	// The year directories are left alone with the flat layout
+	actual, err = PageFilePathsWithOptions(memFS, "docs", LoadOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "a.md")}, actual)
+}
+
+func Test_LoadPages_Malformed(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "zombies.md")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("---\ntitle: [Zombies\n---\n"), 0644))
+
+	_, err := LoadPages(context.Background(), docsDir)
+
+	var parseErr *src.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, filePath, parseErr.FilePath)
+}
+
+func Test_LoadPagesSkipping(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "a.md"), []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "b.md"), []byte("---\ntitle: [Boxes\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "c.md"), []byte("---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Cats\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "d.md"), []byte("---\ntags: [go\n---\n"), 0644))
+
+	pageSet, skipped, err := LoadPagesSkipping(context.Background(), memFS, "docs", LoadOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Cats", "Arrays"}, pageTitles(pageSet))
+
+	assert.Equal(t, 2, len(skipped))
+	assert.Equal(t, filepath.Join("docs", "b.md"), skipped[0].FilePath)
+	assert.Equal(t, filepath.Join("docs", "d.md"), skipped[1].FilePath)
+
+	var parseErr *src.ParseError
+	assert.True(t, errors.As(skipped[0].Err, &parseErr))
+
+	// Without skipping, the first bad page fails the load
+	_, err = LoadPagesWithOptions(context.Background(), memFS, "docs", LoadOptions{})
+	assert.True(t, errors.As(err, &parseErr))
+}
+
+func Test_LoadPagesSkipping_LargeAndBinary(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	page := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n"
+	large := page + strings.Repeat("All work and no play makes Jack a dull boy.\n", 100)
+	binary := append([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), make([]byte, 64)...)
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "a.md"), []byte(page), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "b.md"), []byte(large), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "c.md"), binary, 0644))
+
+	pageSet, skipped, err := LoadPagesSkipping(context.Background(), memFS, "docs", LoadOptions{MaxSize: 1024})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Arrays"}, pageTitles(pageSet))
+
+	assert.Equal(t, 2, len(skipped))
+	assert.EqualError(t, skipped[0].Err, filepath.Join("docs", "b.md")+": it's more than 1024 bytes, which is too big to be a page")
+	assert.EqualError(t, skipped[1].Err, filepath.Join("docs", "c.md")+": it looks like a binary file, not a page")
+
+	// The default limit is big enough for any page someone wrote
+	pageSet, skipped, err = LoadPagesSkipping(context.Background(), memFS, "docs", LoadOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pageSet))
+	assert.Equal(t, 1, len(skipped))
+}
+
+func Test_LoadPagesFS_DateOrder(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	// The filename prefixes disagree with the front-matter dates: an imported
+	// page, a backdated one, and one without a date in its name at all
+	files := map[string]string{
+		"2020-05-01-imported.md":  "---\ndate: 2018-03-02T09:00:00Z\ntitle: Imported\n---\n",
+		"2020-05-02-backdated.md": "---\ndate: 2019-11-30T09:00:00Z\ntitle: Backdated\n---\n",
+		"2020-05-03-newest.md":    "---\ndate: 2020-05-03T09:00:00Z\ntitle: Newest\n---\n",
+		"a-undated.md":            "---\ntitle: Undated\n---\n",
+		"b-same-day.md":           "---\ndate: 2019-11-30T09:00:00Z\ntitle: Same Day B\n---\n",
+		"c-same-day.md":           "---\ndate: 2019-11-30T09:00:00Z\ntitle: Same Day C\n---\n",
+		"zombies.md":              "---\ndate: 2021-01-01T09:00:00+09:00\ntitle: Zombies\n---\n",
+	}
+
+	for name, content := range files {
+		assert.NoError(t, memFS.WriteFile(filepath.Join("docs", name), []byte(content), 0644))
+	}
+
+	pageSet, err := LoadPagesFS(context.Background(), memFS, "docs")
+
+	assert.NoError(t, err)
+
+	// Pages with the same date are in reverse filename order, and a page
+	// without a date goes last
+	assert.Equal(t, []string{"Zombies", "Newest", "Same Day C", "Same Day B", "Backdated", "Imported", "Undated"}, pageTitles(pageSet))
+
+	// The index lists them in that order
+	content := PageList(pageSet, "")
+	assert.Less(t, strings.Index(content, "[Newest]"), strings.Index(content, "[Backdated]"))
+	assert.Less(t, strings.Index(content, "[Backdated]"), strings.Index(content, "[Imported]"))
+}
+
+func Test_LoadPagesFS_Generated(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "channels.md"), []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntags: go, cli\ntitle: Channels\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "mutexes.md"), []byte("---\ndate: 2020-05-06T13:13:08-07:00\ntags: go\ntitle: Mutexes\n---\n"), 0644))
+
+	pageSet, err := LoadPagesFS(context.Background(), memFS, "docs")
+	assert.NoError(t, err)
+
+	opts := Options{FS: memFS}
+	tagMap := NewTagMap(pageSet, opts)
+
+	_, err = BuildIndex(context.Background(), "docs", pageSet, tagMap, opts)
+	assert.NoError(t, err)
+
+	_, err = BuildTagPages(context.Background(), "docs", tagMap, opts)
+	assert.NoError(t, err)
+
+	// The index and the tag pages are in docs too, but they aren't pages
+	reloaded, err := LoadPagesFS(context.Background(), memFS, "docs")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Channels", "Mutexes"}, pageTitles(reloaded))
+}
+
+func Test_LoadPagesFS(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "a.md"), []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "b.md"), []byte("---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Boxes\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "tags", "go.md"), []byte("## go\n"), 0644))
+
+	pageSet, err := LoadPagesFS(context.Background(), memFS, "docs")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pageSet))
+	assert.Equal(t, "Boxes", pageSet[0].Title)
+	assert.Equal(t, "Arrays", pageSet[1].Title)
+}
+
+func Test_IndexContent(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go, cli, index"},
+		{Title: "Mutexes", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "go"},
+	}
+
+	opts := Options{Footer: "footer\n", IndexNote: "2 TILs", MinTagCount: 2, ReservedNames: []string{"index"}}
+
+	actual := IndexContent(pageSet, NewTagMap(pageSet, opts), opts)
+
+	expected := "[go](./go)\n\n* <a id=\"channels\"></a><code>May 07, 2020</code> [Channels](channels.md)\n* <a id=\"mutexes\"></a><code>May 06, 2020</code> [Mutexes](mutexes.md)\n\n\n_2 TILs_\n\nfooter\n"
+	assert.Equal(t, expected, actual)
+}
+
+func Test_IndexContent_Limit(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+		{Title: "Goroutines", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/goroutines.md"},
+		{FilePath: "docs/go.md", Content: "## go\n"},
+	}
+
+	tagMap := NewTagMap(pageSet, Options{})
+
+	// Exactly the limit fits on the index, so there's nothing to link to
+	actual := IndexContent(pageSet, tagMap, Options{IndexLimit: 3})
+	assert.Equal(t, IndexContent(pageSet, tagMap, Options{}), actual)
+	assert.NotContains(t, actual, "See all")
+
+	actual = IndexContent(pageSet, tagMap, Options{IndexLimit: 2})
+
+	expected := "\n\n* <a id=\"channels\"></a><code>May 08, 2020</code> [Channels](channels.md)\n* <a id=\"mutexes\"></a><code>May 07, 2020</code> [Mutexes](mutexes.md)\n\n[See all 3 entries →](all.md)\n\n\n"
+	assert.Equal(t, expected, actual)
+}
+
+func Test_IndexContent_Years(t *testing.T) {
+	pages.Now = func() time.Time { return time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC) }
+	defer func() { pages.Now = time.Now }()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md"},
+		{Title: "Mutexes", Date: "2020-04-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+		{Title: "Goroutines", Date: "2019-12-06T13:13:08-07:00", FilePath: "docs/goroutines.md"},
+		{Title: "Slices", Date: "2019-11-06T13:13:08-07:00", FilePath: "docs/slices.md"},
+		{Title: "Maps", Date: "2019-11-05T13:13:08-07:00", FilePath: "docs/maps.md"},
+	}
+
+	opts := Options{Footer: "footer\n", IndexLayout: IndexLayoutYears}
+
+	actual := IndexContent(pageSet, NewTagMap(pageSet, opts), opts)
+
+	expected := "\n" +
+		"\n" +
+		"* <a id=\"channels\"></a><code>May 08, 2020</code> [Channels](channels.md)\n" +
+		"\n" +
+		"* <a id=\"mutexes\"></a><code>Apr 07, 2020</code> [Mutexes](mutexes.md)\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary>2019 (3 entries)</summary>\n" +
+		"\n" +
+		"* <a id=\"goroutines\"></a><code>Dec 06, 2019</code> [Goroutines](goroutines.md)\n" +
+		"\n" +
+		"* <a id=\"slices\"></a><code>Nov 06, 2019</code> [Slices](slices.md)\n" +
+		"* <a id=\"maps\"></a><code>Nov 05, 2019</code> [Maps](maps.md)\n" +
+		"\n" +
+		"</details>\n" +
+		"\n" +
+		"\n" +
+		"footer\n"
+	assert.Equal(t, expected, actual)
+
+	// A year later, both years are collapsed
+	pages.Now = func() time.Time { return time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC) }
+
+	actual = IndexContent(pageSet[:3], NewTagMap(pageSet[:3], opts), opts)
+
+	expected = "\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary>2020 (2 entries)</summary>\n" +
+		"\n" +
+		"* <a id=\"channels\"></a><code>May 08, 2020</code> [Channels](channels.md)\n" +
+		"\n" +
+		"* <a id=\"mutexes\"></a><code>Apr 07, 2020</code> [Mutexes](mutexes.md)\n" +
+		"\n" +
+		"</details>\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary>2019 (1 entry)</summary>\n" +
+		"\n" +
+		"* <a id=\"goroutines\"></a><code>Dec 06, 2019</code> [Goroutines](goroutines.md)\n" +
+		"\n" +
+		"</details>\n" +
+		"\n" +
+		"\n" +
+		"footer\n"
+	assert.Equal(t, expected, actual)
+}
+
+func Test_IndexContent_Tags(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "Go, go/concurrency, golang"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+		{Title: "Sitemaps", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/sitemaps.md", TagsStr: "go, sitemap, Web Dev"},
+	}
+
+	opts := Options{
+		Aliases:       map[string]string{"golang": "go"},
+		IndexTags:     true,
+		ReservedNames: []string{"sitemap"},
+	}
+
+	tagMap := NewTagMap(pageSet, opts)
+	actual := IndexContent(pageSet, tagMap, opts)
+
+	expected := "[Go](./go), [go/concurrency](./tags/go/concurrency), [Web Dev](./web-dev)\n" +
+		"\n" +
+		"* <a id=\"channels\"></a><code>May 08, 2020</code> [Channels](channels.md) — [Go](./go), [go/concurrency](./tags/go/concurrency)\n" +
+		"* <a id=\"mutexes\"></a><code>May 07, 2020</code> [Mutexes](mutexes.md)\n" +
+		"* <a id=\"sitemaps\"></a><code>May 06, 2020</code> [Sitemaps](sitemaps.md) — [Go](./go), sitemap, [Web Dev](./web-dev)\n" +
+		"\n" +
+		"\n"
+	assert.Equal(t, expected, actual)
+
+	// The all page lists them the same way
+	assert.Contains(t, AllContent(pageSet, tagMap, opts), "[Channels](channels.md) — [Go](./go), [go/concurrency](./tags/go/concurrency)\n")
+}
+
+func Test_tagIcon(t *testing.T) {
+	icons := map[string]string{"go": "🐹", "security": "🔒"}
+
+	tests := []struct {
+		name     string
+		tagNames []string
+		expected string
+	}{
+		{name: "no tags", tagNames: []string{}, expected: ""},
+		{name: "unmapped tags", tagNames: []string{"cli", "linux"}, expected: ""},
+		{name: "one mapped tag", tagNames: []string{"cli", "security"}, expected: "🔒"},
+		{name: "first mapped tag wins", tagNames: []string{"security", "go"}, expected: "🔒"},
+		{name: "in any case", tagNames: []string{"Go", "security"}, expected: "🐹"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tagIcon(tt.tagNames, icons))
+		})
+	}
+
+	assert.Equal(t, "", tagIcon([]string{"go"}, nil))
+}
+
+func Test_IndexContent_Icons(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "golang, security"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "cli"},
+	}
+
+	opts := Options{Aliases: map[string]string{"golang": "go"}, Icons: map[string]string{"go": "🐹", "security": "🔒"}}
+
+	actual := IndexContent(pageSet, NewTagMap(pageSet, opts), opts)
+
+	assert.Contains(t, actual, "* <a id=\"channels\"></a>🐹 <code>May 08, 2020</code> [Channels](channels.md)\n")
+	assert.Contains(t, actual, "* <a id=\"mutexes\"></a><code>May 07, 2020</code> [Mutexes](mutexes.md)\n")
+}
+
+func Test_EntryAnchors(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "Go: Contexts", Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md"},
+		{Title: "Go contexts", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md"},
+		{Title: "Go Contexts", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/a.md"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+		{FilePath: "docs/go.md", Content: "## go\n"},
+	}
+
+	anchors := EntryAnchors(pageSet)
+
+	// The oldest page keeps the plain slug, with a tie going by filename
+	assert.Equal(t, map[string]string{
+		"docs/a.md":       "go-contexts",
+		"docs/b.md":       "go-contexts-2",
+		"docs/c.md":       "go-contexts-3",
+		"docs/mutexes.md": "mutexes",
+	}, anchors)
+
+	// A newer page with the same slug doesn't move the others' anchors
+	newer := append([]*Page{{Title: "Go Contexts!", Date: "2020-06-01T13:13:08-07:00", FilePath: "docs/d.md"}}, pageSet...)
+	anchors = EntryAnchors(newer)
+
+	assert.Equal(t, "go-contexts", anchors["docs/a.md"])
+	assert.Equal(t, "go-contexts-3", anchors["docs/c.md"])
+	assert.Equal(t, "go-contexts-4", anchors["docs/d.md"])
+}
+
+func Test_BuildAllPage(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+	}
+
+	filePath, err := BuildAllPage(context.Background(), "docs", pageSet, NewTagMap(pageSet, Options{}), Options{FS: memFS, Footer: "footer\n", IndexLimit: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("docs", "all.md"), filePath)
+
+	data, err := memFS.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, pages.GeneratedMarker+"\n## All entries\n\n_2 entries_\n\n* <a id=\"channels\"></a><code>May 08, 2020</code> [Channels](channels.md)\n* <a id=\"mutexes\"></a><code>May 07, 2020</code> [Mutexes](mutexes.md)\n\nfooter\n", string(data))
+
+	// Without a limit, the index lists everything, so the all page goes
+	filePath, err = BuildAllPage(context.Background(), "docs", pageSet, NewTagMap(pageSet, Options{}), Options{FS: memFS})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", filePath)
+
+	_, err = memFS.Stat(filepath.Join("docs", "all.md"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_BuildArchivePage(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go"},
+		{Title: "Vagrant Boxes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/vagrant.md", TagsStr: "go", Archived: true},
+	}
+	tagMap := NewTagMap(pageSet, Options{})
+
+	filePath, err := BuildArchivePage(context.Background(), "docs", pageSet, tagMap, Options{FS: memFS, Footer: "footer\n"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("docs", "archive.md"), filePath)
+
+	data, err := memFS.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, pages.GeneratedMarker+"\n## Archive\n\n_1 entry_\n\n* <a id=\"vagrant-boxes\"></a><code>May 07, 2020</code> [Vagrant Boxes](vagrant.md)\n\nfooter\n", string(data))
+
+	// The index and the all page leave it off, and link to the archive
+	opts := Options{IndexLimit: 1}
+	index := IndexContent(pageSet, tagMap, opts)
+	assert.NotContains(t, index, "Vagrant Boxes")
+	assert.Contains(t, index, "\n[Archive, 1 entry →](archive.md)\n")
+	assert.NotContains(t, AllContent(pageSet, tagMap, opts), "Vagrant Boxes")
+
+	// Its tag's page still lists it, marked as archived
+	tagPage := TagPageContent(tagMap.Get("go")[0], tagMap.PagesFor("go"), pages.TagDescription{}, nil, "")
+	assert.Contains(t, tagPage, "[Vagrant Boxes](vagrant.md) <sub>archived</sub>\n")
+	assert.Contains(t, tagPage, "[Channels](channels.md)\n")
+
+	// Once nothing's archived, the archive page goes
+	pageSet[1].Archived = false
+
+	filePath, err = BuildArchivePage(context.Background(), "docs", pageSet, tagMap, Options{FS: memFS})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", filePath)
+
+	_, err = memFS.Stat(filepath.Join("docs", "archive.md"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NotContains(t, IndexContent(pageSet, tagMap, opts), "archive.md")
+}
+
+func Test_Authors(t *testing.T) {
+	pageSet := []*Page{
+		{Title: "A", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/a.md", Author: "Zoë Ann"},
+		{Title: "B", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/b.md", Author: "bo"},
+		{Title: "C", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/c.md"},
+		{Title: "D", Date: "2020-05-05T13:13:08-07:00", FilePath: "docs/d.md", Author: " zoe  ann "},
+		{Title: "E", Date: "2020-05-04T13:13:08-07:00", FilePath: "docs/e.md", Author: "Al"},
+		{FilePath: "docs/index.md", Author: "Al"},
+	}
+
+	authors := Authors(pageSet, false)
+
+	names := []string{}
+	for _, author := range authors {
+		names = append(names, fmt.Sprintf("%s %s %d", author.Name, author.Slug, len(author.Pages)))
+	}
+
+	assert.Equal(t, []string{"Zoë Ann zoe-ann 2", "Al al 1", "bo bo 1"}, names, "the name is the newest page's, and ties go by name")
+	assert.Equal(t, []*Page{pageSet[0], pageSet[3]}, authors[0].Pages)
+	assert.Equal(t, "[Zoë Ann](./authors/zoe-ann)", authors[0].Link())
+	assert.Equal(t, "authors/zoe-ann.md", authors[0].PagePath())
+
+	// Pages without an author are only grouped when asked to, and come last
+	authors = Authors(pageSet, true)
+	assert.Equal(t, UnattributedAuthor, authors[len(authors)-1].Slug)
+	assert.Equal(t, []*Page{pageSet[2]}, authors[len(authors)-1].Pages)
+
+	assert.Equal(t, []*Page{pageSet[0], pageSet[3]}, PagesBy(pageSet, "zoe ann"))
+	assert.Equal(t, []*Page{pageSet[0], pageSet[3]}, PagesBy(pageSet, "ZOË-ANN"))
+	assert.Equal(t, []*Page{pageSet[2]}, PagesBy(pageSet, "unattributed"))
+	assert.Equal(t, []*Page{}, PagesBy(pageSet, "nobody"))
+}
+
+func Test_BuildAuthorPages(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md", Author: "Ann"},
+		{Title: "Vagrant Boxes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/vagrant.md", Author: "Ann", Archived: true},
+		{Title: "Pipes", Date: "2020-04-02T13:13:08-07:00", FilePath: "docs/pipes.md"},
+	}
+	tagMap := NewTagMap(pageSet, Options{})
+
+	// A hand-written page in the directory is left alone
+	assert.NoError(t, memFS.MkdirAll(filepath.Join("docs", "authors"), os.ModePerm))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "authors", "about.md"), []byte("---\ntitle: About\n---\n"), 0644))
+
+	opts := Options{FS: memFS, Footer: "footer\n"}
+
+	written, removed, err := BuildAuthorPages(context.Background(), "docs", pageSet, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "authors", "ann.md")}, written)
+	assert.Equal(t, []string{}, removed)
+
+	data, err := memFS.ReadFile(filepath.Join("docs", "authors", "ann.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, pages.GeneratedMarker+"\n## Ann\n\n_2 entries, last updated May 2020_\n\n* <code>May 08, 2020</code> [Channels](../channels.md)\n* <code>May 07, 2020</code> [Vagrant Boxes](../vagrant.md) <sub>archived</sub>\n\nfooter\n", string(data))
+
+	assert.Contains(t, IndexContent(pageSet, tagMap, Options{}), "\nAuthors: [Ann](./authors/ann) (2)\n")
+
+	// Opting in gives the pages without an author a page too
+	opts.Unattributed = true
+
+	written, _, err = BuildAuthorPages(context.Background(), "docs", pageSet, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "authors", "ann.md"), filepath.Join("docs", "authors", "unattributed.md")}, written)
+	assert.Contains(t, IndexContent(pageSet, tagMap, opts), "\nAuthors: [Ann](./authors/ann) (2), [unattributed](./authors/unattributed) (1)\n")
+
+	// Once nobody has an author, their pages go
+	for _, page := range pageSet {
+		page.Author = ""
+	}
+
+	written, removed, err = BuildAuthorPages(context.Background(), "docs", pageSet, Options{FS: memFS})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, written)
+	assert.Equal(t, []string{filepath.Join("docs", "authors", "ann.md"), filepath.Join("docs", "authors", "unattributed.md")}, removed)
+
+	_, err = memFS.Stat(filepath.Join("docs", "authors", "about.md"))
+	assert.NoError(t, err)
+	assert.NotContains(t, IndexContent(pageSet, tagMap, Options{}), "Authors:")
+}
+
+func Test_BuildTagsPage(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "golang, sitemap"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "go, cli"},
+		{Title: "Pipes", Date: "2020-04-02T13:13:08-07:00", FilePath: "docs/pipes.md", TagsStr: "cli, go"},
+		{Title: "Regexes", Date: "2020-03-01T13:13:08-07:00", FilePath: "docs/regexes.md", TagsStr: "perl"},
+	}
+
+	// The alias counts towards go, perl is below the MinTagCount, and
+	// sitemap is reserved
+	opts := Options{
+		Aliases:       map[string]string{"golang": "go"},
+		FS:            memFS,
+		Footer:        "footer\n",
+		MinTagCount:   2,
+		ReservedNames: []string{"sitemap"},
+	}
+	tagMap := NewTagMap(pageSet, opts)
+
+	filePath, err := BuildTagsPage(context.Background(), "docs", tagMap, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("docs", "tags.md"), filePath)
+
+	expected := pages.GeneratedMarker + "\n## Tags\n\n_2 tags_\n\n" +
+		"| Tag | Entries | Most recent |\n| --- | ---: | --- |\n" +
+		"| [cli](./cli) | 2 | May 07, 2020 |\n" +
+		"| [go](./go) | 3 | May 08, 2020 |\n" +
+		"\nfooter\n"
+
+	data, err := memFS.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+
+	// Building it again writes the same page
+	_, err = BuildTagsPage(context.Background(), "docs", tagMap, opts)
+	assert.NoError(t, err)
+
+	data, _ = memFS.ReadFile(filePath)
+	assert.Equal(t, expected, string(data))
+
+	// The index links to it, and can leave its list of tags to it
+	opts.TagsPage = true
+	opts.OmitTagList = true
+	assert.Equal(t, "\n[All tags →](tags.md)\n\n\nfooter\n", IndexContent([]*Page{}, tagMap, opts))
+}
+
+func Test_BuildTagPages(t *testing.T) {
+	docsDir := "docs"
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go/concurrency, sitemap"},
+		{Title: "Mutexes", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "go, cli"},
+	}
+
+	opts := Options{FS: memFS, ReservedNames: []string{"sitemap"}}
+
+	report, err := BuildTagPages(context.Background(), docsDir, NewTagMap(pageSet, opts), opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(docsDir, "cli.md"),
+		filepath.Join(docsDir, "go.md"),
+		filepath.Join(docsDir, "tags", "go", "concurrency.md"),
+	}, report.Written)
+	assert.Equal(t, []string{"sitemap"}, report.Reserved)
+	assert.Empty(t, report.Warnings)
+
+	data, err := memFS.ReadFile(filepath.Join(docsDir, "go.md"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## go\n\n_2 entries, last updated May 2020_\n\nSee also: cli (1), go/concurrency (1), sitemap (1)\n"), string(data))
+
+	// Raising the threshold removes the pages that fall below it
+	opts.MinTagCount = 2
+
+	report, err = BuildTagPages(context.Background(), docsDir, NewTagMap(pageSet, opts), opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(docsDir, "go.md")}, report.Written)
+	assert.Equal(t, []string{filepath.Join(docsDir, "cli.md"), filepath.Join(docsDir, "tags", "go", "concurrency.md")}, report.Removed)
+}
+
+func Test_BuildTagPages_Canceled(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+
+	pageSet := []*Page{}
+	for i := 0; i < 200; i++ {
+		pageSet = append(pageSet, &Page{Title: "Zombies", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/zombies.md", TagsStr: pages.TagsString(fmt.Sprintf("tag%03d", i))})
+	}
+
+	// Cancel part-way through, once the first tag page has been written
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := Options{FS: &cancelingFS{FS: pages.OSFS{}, cancel: cancel}}
+
+	report, err := BuildTagPages(ctx, docsDir, NewTagMap(pageSet, opts), opts)
+
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.True(t, len(report.Written) > 0)
+	assert.True(t, len(report.Written) < len(pageSet))
+
+	// Every page that was written is whole, and no temporary files are left
+	for _, filePath := range report.Written {
+		data, err := ioutil.ReadFile(filePath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "[Zombies](zombies.md)")
+	}
+
+	files, err := ioutil.ReadDir(docsDir)
+	assert.NoError(t, err)
+	assert.Equal(t, len(report.Written), len(files))
+
+	for _, file := range files {
+		assert.NotContains(t, file.Name(), ".tmp")
+	}
+}
+
+func Test_BuildTagPages_Failures(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go, rust, zig"},
+		{Title: "Mutexes", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "cli"},
+	}
+
+	// The rust and zig pages can't be written, but the others still are
+	fsys := &failingFS{FS: memFS, failing: []string{"rust.md", "zig.md"}, err: errors.New("disk full")}
+	opts := Options{FS: fsys, Workers: 2}
+
+	report, err := BuildTagPages(context.Background(), "docs", NewTagMap(pageSet, opts), opts)
+
+	assert.Equal(t, []string{filepath.Join("docs", "cli.md"), filepath.Join("docs", "go.md")}, report.Written)
+	assert.EqualError(t, err, "couldn't write 2 tag pages: the rust tag page: disk full; the zig tag page: disk full")
+
+	var failed TagPageErrors
+	assert.True(t, errors.As(err, &failed))
+	assert.Equal(t, []string{"rust", "zig"}, []string{failed[0].Tag, failed[1].Tag})
+	assert.True(t, errors.Is(failed[0], fsys.err))
+	assert.Equal(t, report.Failed, failed)
+}
+
+func Test_BuildTagPages_ContentPage(t *testing.T) {
+	memFS := pages.NewMemFS()
+	memFS.WriteFile(filepath.Join("docs", "go.md"), []byte("---\ntitle: Go\n---\n\n# Go\n"), 0644)
+
+	pageSet := []*Page{
+		{Title: "Go", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/go.md", TagsStr: "go, cli"},
+	}
+
+	opts := Options{FS: memFS}
+
+	report, err := BuildTagPages(context.Background(), "docs", NewTagMap(pageSet, opts), opts)
+
+	// The page someone wrote is left as it is, and the other tags still get theirs
+	assert.EqualError(t, err, "couldn't write 1 tag page: the go tag page: the go tag's page would overwrite docs/go.md, which isn't a generated page. Rename the page or the tag")
+	assert.Equal(t, []string{filepath.Join("docs", "cli.md")}, report.Written)
+
+	data, err := memFS.ReadFile(filepath.Join("docs", "go.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "---\ntitle: Go\n---\n\n# Go\n", string(data))
+}
+
+func Test_BuildTagPages_Workers(t *testing.T) {
+	pageSet := generatedPages(300)
+
+	// However many pages are written at once, the same pages come out
+	built := []map[string]string{}
+
+	for _, workers := range []int{1, 4, 32} {
+		memFS := pages.NewMemFS()
+		opts := Options{FS: memFS, Workers: workers, MinTagCount: 30}
+
+		report, err := BuildTagPages(context.Background(), "docs", NewTagMap(pageSet, opts), opts)
+		assert.NoError(t, err)
+
+		files := map[string]string{}
+		for _, filePath := range report.Written {
+			data, err := memFS.ReadFile(filePath)
+			assert.NoError(t, err)
+
+			files[filePath] = string(data)
+		}
+
+		built = append(built, files)
+	}
+
+	assert.NotEmpty(t, built[0])
+	assert.Equal(t, built[0], built[1])
+	assert.Equal(t, built[0], built[2])
+}
+
+// BenchmarkBuildTagPages writes the tag pages of a few thousand generated
+// pages to a filesystem that's slow to write to, like a slow disk, a page at
+// a time and DefaultWorkers at once
+func BenchmarkBuildTagPages(b *testing.B) {
+	pageSet := make([]*Page, 0, benchmarkPages)
+	for i, page := range generatedPages(benchmarkPages) {
+		page.TagsStr = pages.TagsString(fmt.Sprintf("%s, topic%03d", page.TagsStr, i%300))
+		pageSet = append(pageSet, page)
+	}
+
+	tagMap := NewTagMap(pageSet, Options{})
+
+	for _, workers := range []int{1, 0} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			opts := Options{FS: &slowFS{FS: pages.NewMemFS(), delay: time.Millisecond}, Workers: workers}
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := BuildTagPages(context.Background(), "docs", tagMap, opts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Test_Tokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "with words",
+			text:     "Goroutines LEAK, sometimes!",
+			expected: []string{"goroutines", "leak", "sometimes"},
+		},
+		{
+			name:     "with snake_case",
+			text:     "max_open_conns = 10",
+			expected: []string{"max_open_conns", "10"},
+		},
+		{
+			name:     "with a dotted identifier",
+			text:     "call context.WithTimeout()",
+			expected: []string{"call", "context.withtimeout", "context", "withtimeout"},
+		},
+		{
+			name:     "with a full stop",
+			text:     "It works. Mostly.",
+			expected: []string{"it", "works", "mostly"},
+		},
+		{
+			name:     "with other alphabets",
+			text:     "größe café",
+			expected: []string{"größe", "café"},
+		},
+		{
+			name:     "with nothing to find",
+			text:     " -- ",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Tokenize(tt.text))
+		})
+	}
+}
+
+func Test_SearchIndex(t *testing.T) {
+	docsDir, cleanup := setUpDocsDir(t)
+	defer cleanup()
+
+	write := func(name, title, body string, modTime time.Time) string {
+		filePath := filepath.Join(docsDir, name)
+		content := fmt.Sprintf("---\ndate: %s\ntitle: %s\ntags: go\n---\n\n%s\n", modTime.Format(time.RFC3339), title, body)
+
+		assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+		assert.NoError(t, os.Chtimes(filePath, modTime, modTime))
+
+		return filePath
+	}
+
+	day := time.Date(2021, 4, 26, 9, 30, 0, 0, time.UTC)
+	closures := write("closures.md", "Closures", "Functions that capture variables.", day)
+	contexts := write("contexts.md", "Contexts", "Use context.WithTimeout to stop goroutines.", day.Add(24*time.Hour))
+
+	ctx := context.Background()
+
+	skipped, err := UpdateSearchIndex(ctx, pages.OSFS{}, docsDir, LoadOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, skipped)
+
+	data, err := ioutil.ReadFile(filepath.Join(docsDir, SearchIndexDir, ".gitignore"))
+	assert.NoError(t, err)
+	assert.Equal(t, "*\n", string(data))
+
+	idx, err := ReadSearchIndex(pages.OSFS{}, docsDir)
+	assert.NoError(t, err)
+
+	assert.False(t, idx.Stale(pages.OSFS{}, docsDir, []string{closures, contexts}))
+	assert.Equal(t, []string{contexts}, idx.Search(docsDir, Tokenize("withtimeout")))
+	assert.Equal(t, []string{contexts}, idx.Search(docsDir, Tokenize("Context.WithTimeout goroutines")))
+	assert.Equal(t, []string{closures, contexts}, idx.Search(docsDir, Tokenize("go")))
+	assert.Equal(t, []string{}, idx.Search(docsDir, Tokenize("closures goroutines")))
+	assert.Equal(t, map[string][]int{"closures.md": {5}}, idx.Tokens["variables"])
+
+	found, indexed, err := SearchPages(ctx, pages.OSFS{}, docsDir, mustParseQuery(t, "go"), LoadOptions{})
+	assert.NoError(t, err)
+	assert.True(t, indexed)
+	assert.Equal(t, []string{"Contexts", "Closures"}, pageTitles(found))
+
+	// An edited page makes the index stale, so the search reads every page
+	write("closures.md", "Closures", "Functions that capture goroutines.", day.Add(time.Hour))
+
+	found, indexed, err = SearchPages(ctx, pages.OSFS{}, docsDir, mustParseQuery(t, "goroutines"), LoadOptions{})
+	assert.NoError(t, err)
+	assert.False(t, indexed)
+	assert.Equal(t, []string{"Contexts", "Closures"}, pageTitles(found))
+
+	// Updating only indexes the page that changed, and forgets removed pages
+	assert.NoError(t, os.Remove(contexts))
+
+	idx, err = ReadSearchIndex(pages.OSFS{}, docsDir)
+	assert.NoError(t, err)
+
+	changed, _, err := idx.Update(ctx, pages.OSFS{}, docsDir, []string{closures}, LoadOptions{})
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	assert.Equal(t, []string{closures}, idx.Search(docsDir, Tokenize("goroutines")))
+	assert.Empty(t, idx.Search(docsDir, Tokenize("variables")))
+	assert.Empty(t, idx.Tokens["withtimeout"])
+	assert.Len(t, idx.Files, 1)
+
+	changed, _, err = idx.Update(ctx, pages.OSFS{}, docsDir, []string{closures}, LoadOptions{})
+	assert.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func Test_SearchPages_BadFrontMatter(t *testing.T) {
+	memFS := pages.NewMemFS()
+	assert.NoError(t, memFS.WriteFile("docs/a.md", []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n\nFixed size.\n"), 0644))
+	assert.NoError(t, memFS.WriteFile("docs/b.md", []byte("---\ntitle: [Slices\n---\n\nNot a fixed size.\n"), 0644))
+
+	ctx := context.Background()
+
+	// Without an index, every page is read, and the broken one is left out
+	found, indexed, err := SearchPages(ctx, memFS, "docs", mustParseQuery(t, "fixed"), LoadOptions{})
+	assert.NoError(t, err)
+	assert.False(t, indexed)
+	assert.Equal(t, []string{"Arrays"}, pageTitles(found))
+
+	// Indexing skips it too, and remembers it, so the index isn't stale
+	skipped, err := UpdateSearchIndex(ctx, memFS, "docs", LoadOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, skipped, 1)
+	assert.Equal(t, filepath.Join("docs", "b.md"), skipped[0].FilePath)
+
+	found, indexed, err = SearchPages(ctx, memFS, "docs", mustParseQuery(t, "fixed"), LoadOptions{})
+	assert.NoError(t, err)
+	assert.True(t, indexed)
+	assert.Equal(t, []string{"Arrays"}, pageTitles(found))
+}
+
+func Test_SearchIndex_Update_Skips(t *testing.T) {
+	memFS := pages.NewMemFS()
+	assert.NoError(t, memFS.WriteFile("docs/a.md", []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\ntags: go\n---\n\nFixed size.\n"), 0644))
+	assert.NoError(t, memFS.WriteFile("docs/big.md", []byte("---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Big\n---\n\n"+strings.Repeat("fixed ", 100)+"\n"), 0644))
+	assert.NoError(t, memFS.WriteFile("docs/binary.md", []byte("fixed\x00size"), 0644))
+
+	ctx := context.Background()
+	opts := LoadOptions{MaxSize: 200}
+
+	assert.NoError(t, memFS.WriteFile("docs/go.md", []byte(pages.GeneratedMarker+"\n\n## go\n\n* [Arrays](a.md) fixed\n"), 0644))
+
+	skipped, err := UpdateSearchIndex(ctx, memFS, "docs", opts)
+	assert.NoError(t, err)
+	assert.Len(t, skipped, 2)
+
+	idx, err := ReadSearchIndex(memFS, "docs")
+	assert.NoError(t, err)
+
+	// The tag page, the big page, and the binary one are known to the index,
+	// but none of their words are
+	assert.Len(t, idx.Files, 4)
+	assert.Equal(t, []string{filepath.Join("docs", "a.md")}, idx.Search("docs", Tokenize("fixed")))
+	assert.Equal(t, []string{filepath.Join("docs", "a.md")}, idx.Search("docs", Tokenize("arrays")))
+
+	found, indexed, err := SearchPages(ctx, memFS, "docs", mustParseQuery(t, "fixed"), opts)
+	assert.NoError(t, err)
+	assert.True(t, indexed)
+	assert.Equal(t, []string{"Arrays"}, pageTitles(found))
+}
+
+func Test_SearchPages_WithoutIndex(t *testing.T) {
+	memFS := pages.NewMemFS()
+	assert.NoError(t, memFS.WriteFile("docs/a.md", []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n\nFixed size.\n"), 0644))
+	assert.NoError(t, memFS.WriteFile("docs/b.md", []byte("---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Slices\n---\n\nNot a fixed size.\n"), 0644))
+
+	found, indexed, err := SearchPages(context.Background(), memFS, "docs", mustParseQuery(t, "FIXED size"), LoadOptions{})
+
+	assert.NoError(t, err)
+	assert.False(t, indexed)
+	assert.Equal(t, []string{"Slices", "Arrays"}, pageTitles(found))
+}
+
+// BenchmarkSearchPages compares searching with the index to searching by
+// reading every page, over a few thousand generated pages
+func BenchmarkSearchPages(b *testing.B) {
+	memFS := pages.NewMemFS()
+	words := strings.Fields("goroutine channel closure context mutex slice map interface struct pointer defer panic")
+
+	for i := 0; i < 3000; i++ {
+		body := []string{}
+		for j := 0; j < 200; j++ {
+			body = append(body, words[(i*7+j*j)%len(words)])
+		}
+
+		content := fmt.Sprintf("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Page %d\ntags: go\n---\n\n%s\n", i, strings.Join(body, " "))
+		if err := memFS.WriteFile(fmt.Sprintf("docs/page-%d.md", i), []byte(content), 0644); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+
+	b.Run("scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, _, err := SearchPages(ctx, memFS, "docs", mustParseQuery(b, "page 42"), LoadOptions{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	if _, err := UpdateSearchIndex(ctx, memFS, "docs", LoadOptions{}); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("indexed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, _, err := SearchPages(ctx, memFS, "docs", mustParseQuery(b, "page 42"), LoadOptions{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// goldenCases are the pages that Test_GeneratedContent_Golden compares to
+// the files in testdata/golden, over the same generated pages each time
+var goldenCases = []struct {
+	name    string
+	content func(pageSet []*Page, tagMap *TagMap) string
+}{
+	{
+		name: "index",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return IndexContent(pageSet, tagMap, Options{Footer: "footer", IndexNote: "A note", TagsPage: true})
+		},
+	},
+	{
+		name: "index-tags",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			opts := Options{
+				Footer:        "footer",
+				Icons:         map[string]string{"go": "🐹", "rust": "🦀"},
+				IndexLimit:    100,
+				IndexTags:     true,
+				MinTagCount:   3,
+				ReservedNames: []string{"tags"},
+			}
+
+			return IndexContent(pageSet, tagMap, opts)
+		},
+	},
+	{
+		name: "index-years",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return IndexContent(pageSet, tagMap, Options{IndexLayout: IndexLayoutYears, IndexTags: true})
+		},
+	},
+	{
+		name: "index-alphabetical",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return IndexContent(pageSet, tagMap, Options{IndexSort: IndexSortAlphabetical, OmitTagList: true})
+		},
+	},
+	{
+		name: "index-by-tag",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return IndexContent(pageSet, tagMap, Options{IndexSort: IndexSortByTag, IndexTags: true, MinTagCount: 2})
+		},
+	},
+	{
+		name: "all",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return AllContent(pageSet, tagMap, Options{Footer: "footer", IndexTags: true})
+		},
+	},
+	{
+		name: "tags",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			return TagsContent(tagMap, Options{Footer: "footer", MinTagCount: 2})
+		},
+	},
+	{
+		name: "tag-go",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			tag := tagMap.Get("go")[0]
+			desc := pages.TagDescription{Title: "The Go Language", Description: "Notes on Go"}
+
+			return TagPageContent(tag, tagMap.PagesFor("go"), desc, tagMap.CoOccurrences()["go"], "footer")
+		},
+	},
+	{
+		name: "tag-go-concurrency",
+		content: func(pageSet []*Page, tagMap *TagMap) string {
+			tag := tagMap.Get("go/concurrency")[0]
+
+			return TagPageContent(tag, tagMap.PagesFor("go/concurrency"), pages.TagDescription{}, tagMap.CoOccurrences()["go/concurrency"], "footer")
+		},
+	},
+}
+
+// Test_GeneratedContent_Golden checks that the generated pages are what
+// they've always been, byte for byte. Run it with -update to write the
+// golden files again, after a change that's meant to change them
+func Test_GeneratedContent_Golden(t *testing.T) {
+	defer func() { pages.Now = time.Now }()
+	pages.Now = func() time.Time { return time.Date(2018, 9, 1, 12, 0, 0, 0, time.UTC) }
+
+	pageSet := generatedPages(120)
+	tagMap := NewTagMap(pageSet, Options{Aliases: map[string]string{"golang": "go"}})
+
+	for _, tt := range goldenCases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.content(pageSet, tagMap)
+			goldenPath := filepath.Join("testdata", "golden", tt.name+".md")
+
+			if *updateGolden {
+				assert.NoError(t, os.MkdirAll(filepath.Dir(goldenPath), os.ModePerm))
+				assert.NoError(t, ioutil.WriteFile(goldenPath, []byte(actual), 0644))
+			}
+
+			expected, err := ioutil.ReadFile(goldenPath)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), actual)
+		})
+	}
+}
+
+// BenchmarkNewTagMap creates the tag map of a few thousand generated pages
+func BenchmarkNewTagMap(b *testing.B) {
+	pageSet := generatedPages(benchmarkPages)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		NewTagMap(pageSet, Options{})
+	}
+}
+
+// BenchmarkBuildFromPages rebuilds the tag map of a few thousand generated
+// pages, as the watcher does after a change
+func BenchmarkBuildFromPages(b *testing.B) {
+	pageSet := generatedPages(benchmarkPages)
+	tagMap := NewTagMap(pageSet, Options{})
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tagMap.Rebuild(pageSet)
+	}
+}
+
+// BenchmarkIndexContent writes the index of a few thousand generated pages,
+// plainly and with everything that looks up each page's tags turned on
+func BenchmarkIndexContent(b *testing.B) {
+	pageSet := generatedPages(benchmarkPages)
+	tagMap := NewTagMap(pageSet, Options{})
+
+	benchmarks := []struct {
+		name string
+		opts Options
+	}{
+		{name: "list", opts: Options{}},
+		{name: "tags", opts: Options{IndexTags: true, MinTagCount: 2, Icons: map[string]string{"go": "🐹"}}},
+		{name: "by-tag", opts: Options{IndexSort: IndexSortByTag, IndexTags: true, MinTagCount: 2}},
+		{name: "years", opts: Options{IndexLayout: IndexLayoutYears}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				IndexContent(pageSet, tagMap, bm.opts)
+			}
+		})
+	}
+}
+
+func Test_ParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "with nothing",
+			query:    "  ",
+			expected: "the query is empty, at the end of the query",
+		},
+		{
+			name:     "with an unknown field",
+			query:    "tag:go colour:red",
+			expected: "there's no colour: field; use tag:, title:, author:, before:, or after:, at column 8: colour:red",
+		},
+		{
+			name:     "with a field without a value",
+			query:    "goroutine tag:",
+			expected: "tag: needs something after it, at column 11: tag:",
+		},
+		{
+			name:     "with a bad date",
+			query:    "after:yesterday",
+			expected: "after: needs a date, like after:2024-01-01, at column 1: after:yesterday",
+		},
+		{
+			name:     "with an unclosed quote",
+			query:    `"goroutine leak`,
+			expected: `the quote isn't closed, at column 1: "goroutine leak`,
+		},
+		{
+			name:     "with an empty phrase",
+			query:    `go ""`,
+			expected: `there's nothing between the quotes, at column 4: ""`,
+		},
+		{
+			name:     "with an unclosed parenthesis",
+			query:    "(tag:go OR tag:rust",
+			expected: "the ( isn't closed, at column 1: (tag:go OR tag:rust",
+		},
+		{
+			name:     "with a stray parenthesis",
+			query:    "tag:go) AND closures",
+			expected: "there's a ) without a ( before it, at column 7: ) AND closures",
+		},
+		{
+			name:     "with an operator at the end",
+			query:    "tag:go AND",
+			expected: "AND needs something to search for after it, at column 8: AND",
+		},
+		{
+			name:     "with an operator at the start",
+			query:    "OR tag:go",
+			expected: "OR needs something to search for before it, at column 1: OR tag:go",
+		},
+		{
+			name:     "with NOT before an operator",
+			query:    "NOT OR tag:go",
+			expected: "NOT needs something to search for after it, at column 1: NOT OR tag:go",
+		},
+		{
+			name:     "with a word that's only punctuation",
+			query:    "go --",
+			expected: "-- has no letters or digits to search for, at column 4: --",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseQuery(tt.query)
+
+			var queryErr *QueryError
+			assert.True(t, errors.As(err, &queryErr))
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Query_Match(t *testing.T) {
+	pageSet := []*Page{
+		{
+			Author:  "Ann Author",
+			Content: "# Closures\n\nA closure captures the variables around it.\n",
+			Date:    "2024-03-01T10:00:00Z",
+			TagsStr: "go, til-meta",
+			Title:   "Closures",
+		},
+		{
+			Content: "# Leaks\n\nA goroutine that never returns leaks.\n",
+			Date:    "2024-02-01T10:00:00Z",
+			TagsStr: "go/concurrency",
+			Title:   "Goroutine leaks",
+		},
+		{
+			Author:  "Bo",
+			Content: "# Lifetimes\n\nBorrowed values can't outlive their owners.\n",
+			Date:    "2023-12-31T10:00:00Z",
+			TagsStr: "rust",
+			Title:   "Lifetimes",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "with a word",
+			query:    "goroutine",
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with words side by side",
+			query:    "closure variables",
+			expected: []string{"Closures"},
+		},
+		{
+			name:     "with a tag and its children",
+			query:    "tag:go",
+			expected: []string{"Closures", "Goroutine leaks"},
+		},
+		{
+			name:     "with a child tag",
+			query:    "tag:GO/Concurrency",
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with part of a tag",
+			query:    "tag:ru",
+			expected: []string{},
+		},
+		{
+			name:     "with a title",
+			query:    "title:leak",
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with an author",
+			query:    "author:ann",
+			expected: []string{"Closures"},
+		},
+		{
+			name:     "with after",
+			query:    "after:2024-02-01",
+			expected: []string{"Closures", "Goroutine leaks"},
+		},
+		{
+			name:     "with before",
+			query:    "before:2024-02-01",
+			expected: []string{"Lifetimes"},
+		},
+		{
+			name:     "with a phrase",
+			query:    `"never returns"`,
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with a phrase that's only in the words",
+			query:    `"returns never"`,
+			expected: []string{},
+		},
+		{
+			name:     "with NOT",
+			query:    "tag:go AND NOT tag:til-meta AND after:2024-01-01",
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with OR",
+			query:    "tag:rust OR title:closures",
+			expected: []string{"Closures", "Lifetimes"},
+		},
+		{
+			name:     "with AND binding tighter than OR",
+			query:    "tag:rust OR tag:go after:2024-02-15",
+			expected: []string{"Closures", "Lifetimes"},
+		},
+		{
+			name:     "with parentheses",
+			query:    "(tag:rust OR tag:go) after:2024-02-15",
+			expected: []string{"Closures"},
+		},
+		{
+			name:     "with NOT before parentheses",
+			query:    "NOT (tag:rust OR author:ann)",
+			expected: []string{"Goroutine leaks"},
+		},
+		{
+			name:     "with lower-case keywords as words",
+			query:    "closure and variables",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.NoError(t, err)
+
+			actual := []string{}
+			for _, page := range pageSet {
+				if q.Match(page) {
+					actual = append(actual, page.Title)
+				}
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_Query_SetTagMap(t *testing.T) {
+	golang := &Page{Title: "Closures", TagsStr: "golang"}
+	rust := &Page{Title: "Lifetimes", TagsStr: "Rust/Borrowing"}
+
+	// Only the aliases are needed, not the pages
+	tagMap := NewTagMap(nil, Options{Aliases: map[string]string{"golang": "go", "rs": "rust"}})
+
+	tests := []struct {
+		query    string
+		unmapped []string
+		expected []string
+	}{
+		{query: "tag:go", unmapped: []string{}, expected: []string{"Closures"}},
+		{query: "tag:GOLANG", unmapped: []string{"Closures"}, expected: []string{"Closures"}},
+		{query: "tag:rs", unmapped: []string{}, expected: []string{"Lifetimes"}},
+		{query: "tag:rust", unmapped: []string{"Lifetimes"}, expected: []string{"Lifetimes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q := mustParseQuery(t, tt.query)
+
+			assert.Equal(t, tt.unmapped, pageTitles(FilterPages([]*Page{golang, rust}, q)))
+
+			q.SetTagMap(tagMap)
+			assert.Equal(t, tt.expected, pageTitles(FilterPages([]*Page{golang, rust}, q)))
+		})
+	}
+}
+
+func Test_Query_RequiredTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "with words",
+			query:    "goroutine Leak",
+			expected: []string{"goroutine", "leak"},
+		},
+		{
+			name:     "with fields",
+			query:    "goroutine tag:concurrency after:2024-01-01",
+			expected: []string{"goroutine"},
+		},
+		{
+			name:     "with a dotted identifier",
+			query:    "context.WithTimeout",
+			expected: []string{"context.withtimeout", "context", "withtimeout"},
+		},
+		{
+			name:     "with OR",
+			query:    "closures (goroutine OR leak)",
+			expected: []string{"closures"},
+		},
+		{
+			name:     "with NOT",
+			query:    "NOT goroutine",
+			expected: []string{},
+		},
+		{
+			name:     "with a phrase",
+			query:    `"goroutine leak"`,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, q.RequiredTerms())
+		})
+	}
+}
+
+func Test_RankPages(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    string
+		pageSet  []*Page
+		expected []string
+	}{
+		{
+			name:  "with the word in different fields",
+			query: "goroutine",
+			pageSet: []*Page{
+				{Title: "Body", Date: "2024-01-01T10:00:00Z", Content: "A goroutine.\n"},
+				{Title: "Goroutine basics", Date: "2024-01-01T10:00:00Z", Content: "Basics.\n"},
+				{Title: "Tags", Date: "2024-01-01T10:00:00Z", TagsStr: "goroutine", Content: "Tagged.\n"},
+			},
+			expected: []string{"Goroutine basics", "Tags", "Body"},
+		},
+		{
+			name:  "with the word many times",
+			query: "goroutine",
+			pageSet: []*Page{
+				{Title: "Once", Date: "2024-01-01T10:00:00Z", Content: "A goroutine.\n"},
+				{Title: "Thrice", Date: "2024-01-01T10:00:00Z", Content: "A goroutine, a goroutine, a goroutine.\n"},
+			},
+			expected: []string{"Thrice", "Once"},
+		},
+		{
+			name:  "with many mentions in the body against one in the title",
+			query: "goroutine",
+			pageSet: []*Page{
+				{Title: "Body", Date: "2024-01-01T10:00:00Z", Content: strings.Repeat("goroutine ", 10)},
+				{Title: "Goroutine", Date: "2024-01-01T10:00:00Z", Content: "Once.\n"},
+			},
+			expected: []string{"Goroutine", "Body"},
+		},
+		{
+			name:  "with the same score",
+			query: "goroutine",
+			pageSet: []*Page{
+				{Title: "Old", Date: "2020-01-01T10:00:00Z", FilePath: "a.md", Content: "A goroutine.\n"},
+				{Title: "New", Date: "2024-05-01T10:00:00Z", FilePath: "b.md", Content: "A goroutine.\n"},
+			},
+			expected: []string{"New", "Old"},
+		},
+		{
+			name:  "with recency and relevance",
+			query: "goroutine",
+			pageSet: []*Page{
+				{Title: "New", Date: "2024-05-01T10:00:00Z", Content: "A goroutine.\n"},
+				{Title: "Old goroutine", Date: "2015-01-01T10:00:00Z", Content: "A goroutine.\n"},
+			},
+			expected: []string{"Old goroutine", "New"},
+		},
+		{
+			name:  "with more of the words",
+			query: "goroutine OR leak",
+			pageSet: []*Page{
+				{Title: "One", Date: "2024-01-01T10:00:00Z", Content: "A goroutine.\n"},
+				{Title: "Both", Date: "2024-01-01T10:00:00Z", Content: "A goroutine leak.\n"},
+			},
+			expected: []string{"Both", "One"},
+		},
+		{
+			name:  "with only fields",
+			query: "tag:go",
+			pageSet: []*Page{
+				{Title: "Old", Date: "2020-01-01T10:00:00Z", FilePath: "a.md", TagsStr: "go"},
+				{Title: "Not go", Date: "2024-01-01T10:00:00Z", FilePath: "b.md", TagsStr: "rust"},
+				{Title: "New", Date: "2024-01-01T10:00:00Z", FilePath: "c.md", TagsStr: "go"},
+			},
+			expected: []string{"New", "Old"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.NoError(t, err)
+
+			results := RankPages(tt.pageSet, q, now)
+
+			actual := []string{}
+			for _, result := range results {
+				actual = append(actual, result.Page.Title)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+
+			// The same pages at the same time always score the same
+			for _, result := range results {
+				assert.Equal(t, result.Score, q.Score(result.Page, now))
+			}
+		})
+	}
+}
+
+func Test_Query_Snippet(t *testing.T) {
+	mark := func(word string) string { return "[" + word + "]" }
+
+	long := "# Leaks\n\nOne two three four five six seven eight nine ten eleven twelve. " +
+		"A goroutine that blocks forever is a leak, and a leaked goroutine keeps its memory. " +
+		"Thirteen fourteen fifteen sixteen seventeen eighteen nineteen twenty.\n"
+
+	tests := []struct {
+		name     string
+		query    string
+		content  string
+		expected string
+	}{
+		{
+			name:     "with a short page",
+			query:    "goroutine",
+			content:  "# Leaks\n\nA goroutine leaks.\n",
+			expected: "A [goroutine] leaks.",
+		},
+		{
+			name:     "with the best match in the middle",
+			query:    "goroutine leak",
+			content:  long,
+			expected: "…eleven twelve. A [goroutine] that blocks forever is a [leak,] and a leaked [goroutine] keeps its memory.…",
+		},
+		{
+			name:     "with no match in the content",
+			query:    "tag:go",
+			content:  long,
+			expected: "One two three four five six seven eight nine ten eleven twelve. A goroutine that blocks forever…",
+		},
+		{
+			name:     "with a NOT",
+			query:    "leak NOT goroutine",
+			content:  "# Leaks\n\nA goroutine leak.\n",
+			expected: "A goroutine [leak.]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, q.Snippet(&Page{Title: "Leaks", Content: tt.content}, mark))
+		})
+	}
+}
+
+func Test_LoadPages_Canceled(t *testing.T) {
+	memFS := pages.NewMemFS()
+	assert.NoError(t, memFS.WriteFile("docs/a.md", []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: A\n---\n"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := LoadPagesFS(ctx, memFS, "docs")
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = BuildIndex(ctx, "docs", []*Page{}, pages.NewTagMap([]*Page{}), Options{FS: memFS})
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = memFS.Stat("docs/index.md")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_Generate(t *testing.T) {
+	docsDir := "docs"
+	memFS := pages.NewMemFS()
+
+	pageSet := []*Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go"},
+	}
+
+	opts := Options{FS: memFS}
+	tagPages := &TagPagesGenerator{Options: opts}
+
+	err := Generate(context.Background(), []Generator{tagPages, &IndexGenerator{Options: opts}}, pageSet, NewTagMap(pageSet, opts), docsDir)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(docsDir, "go.md")}, tagPages.Report.Written)
+
+	files, _ := memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "go.md"), filepath.Join(docsDir, "index.md")}, files)
+
+	// A failing generator stops the ones after it, and is named in the error
+	failing := &failingGenerator{err: errors.New("boom")}
+	index := &IndexGenerator{Options: Options{FS: pages.NewMemFS()}}
+
+	err = Generate(context.Background(), []Generator{failing, index}, pageSet, NewTagMap(pageSet, opts), docsDir)
+
+	assert.EqualError(t, err, "failing: boom")
+	assert.True(t, errors.Is(err, failing.err))
+
+	_, err = index.Options.FS.Stat(filepath.Join(docsDir, "index.md"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_IsReservedTagName(t *testing.T) {
+	reserved := []string{"index", "sitemap"}
+
+	assert.True(t, IsReservedTagName("Index", reserved))
+	assert.False(t, IsReservedTagName("index/pages", reserved))
+	assert.False(t, IsReservedTagName("go", reserved))
+}
+
+/* -------------------- Helpers -------------------- */
+
+// cancelingFS is an FS that cancels its context as soon as a file has been
+// written, to cancel a build part-way through
+type cancelingFS struct {
+	pages.FS
+
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+func (fsys *cancelingFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	err := fsys.FS.WriteFile(name, data, perm)
+	fsys.once.Do(fsys.cancel)
+
+	return err
+}
+
+// failingFS is an FS whose writes to the files ending with one of the failing
+// names fail with err
+type failingFS struct {
+	pages.FS
+
+	failing []string
+	err     error
+}
+
+func (fsys *failingFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	for _, failing := range fsys.failing {
+		if strings.HasSuffix(name, failing) {
+			return fsys.err
+		}
+	}
+
+	return fsys.FS.WriteFile(name, data, perm)
+}
+
+// slowFS is an FS that takes delay to write each file
+type slowFS struct {
+	pages.FS
+
+	delay time.Duration
+}
+
+func (fsys *slowFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	time.Sleep(fsys.delay)
+
+	return fsys.FS.WriteFile(name, data, perm)
+}
+
+// failingGenerator is a Generator that always fails
+type failingGenerator struct {
+	err error
+}
+
+func (gen *failingGenerator) Name() string {
+	return "failing"
+}
+
+func (gen *failingGenerator) Generate(ctx context.Context, pageSet []*Page, tagMap *TagMap, dir string) error {
+	return gen.err
+}
+
+func setUpDocsDir(t *testing.T) (string, func()) {
+	docsDir, err := ioutil.TempDir("", "til")
+	assert.NoError(t, err)
+
+	return docsDir, func() { os.RemoveAll(docsDir) }
+}
+
+// pageTitles returns the titles of the pages, in order
+func pageTitles(pageSet []*Page) []string {
+	titles := []string{}
+	for _, page := range pageSet {
+		titles = append(titles, page.Title)
+	}
+
+	return titles
+}
+
+// mustParseQuery returns the parsed query, failing the test if it can't be
+// parsed
+func mustParseQuery(tb testing.TB, query string) *Query {
+	q, err := ParseQuery(query)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return q
+}
+
+// generatedPages returns n made-up pages, newest first as LoadPages returns
+// them, four days apart. They have a mix of tags, hierarchical ones
+// and aliases among them, some titles that share a slug, some untagged
+// pages, and some in a format that isn't rendered
+func generatedPages(n int) []*Page {
+	tagSets := []string{
+		"go, go/concurrency", "go, testing", "rust", "golang, cli", "go/concurrency/channels",
+		"python, testing", "sql", "Go, json", "shell, cli", "rust, errors", "go/errors, errors", "",
+	}
+	words := strings.Fields("closures contexts channels slices maps interfaces structs pointers defers panics lifetimes traits")
+
+	first := time.Date(2017, 3, 1, 9, 30, 0, 0, time.UTC)
+	pageSet := make([]*Page, 0, n)
+
+	for i := n - 1; i >= 0; i-- {
+		ext := "md"
+		if i%17 == 0 {
+			ext = "txt"
+		}
+
+		pageSet = append(pageSet, &Page{
+			Date:     first.Add(time.Duration(i) * 96 * time.Hour).Format(time.RFC3339),
+			FilePath: fmt.Sprintf("docs/page-%d.%s", i, ext),
+			TagsStr:  pages.TagsString(tagSets[i%len(tagSets)]),
+			Title:    fmt.Sprintf("Notes on %s %d", words[i%len(words)], i%97),
+		})
+	}
+
+	return pageSet
+}
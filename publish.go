@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errGistAPI      = "GitHub couldn't %s the gist (%s): %s"
+	errGistAuth     = "GitHub didn't accept the token (%s). Check that it is valid and has the gist scope"
+	errGistMissing  = "the gist %s no longer exists. Remove the gist field from the page's front-matter to publish it as a new gist"
+	errGistNoToken  = "publishing a gist needs a GitHub token. Add githubToken to the config, or set GITHUB_TOKEN"
+	errPublishWhere = "til publish needs to know where to publish to: --gist"
+
+	gistFrontMatterKey = "gist"
+
+	statusPublished = "published %s to %s"
+)
+
+// githubAPIURL is where the GitHub API lives
+var githubAPIURL = "https://api.github.com"
+
+// httpDoer sends HTTP requests. *http.Client is one
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// httpClient is the HTTP client that til uses. Tests swap it out so that they
+// never talk to the real GitHub, or anywhere else
+var httpClient httpDoer = http.DefaultClient
+
+// gistFile is a single file in a gist
+type gistFile struct {
+	Content string `json:"content"`
+}
+
+// gistRequest is what gets sent to GitHub to create or update a gist
+type gistRequest struct {
+	Description string              `json:"description"`
+	Files       map[string]gistFile `json:"files"`
+	Public      bool                `json:"public,omitempty"`
+}
+
+// gistResponse is the part of GitHub's reply that til cares about
+type gistResponse struct {
+	HTMLURL string `json:"html_url"`
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// runPublish shares a single page somewhere outside the docs directory. At
+// the moment that somewhere is a GitHub gist. The first time a page is
+// published it gets a new gist, and the gist's URL is written into the page.
+// After that, publishing it again updates the same gist.
+// Example:
+//
+//	> til publish go contexts --gist
+func runPublish(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("publish", flag.ContinueOnError)
+	gist := flags.Bool("gist", false, "publishes the page as a GitHub gist")
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	if !*gist {
+		src.Defeat(errors.New(errPublishWhere))
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	gistURL, err := publishGist(page)
+	unlock()
+
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(fmt.Sprintf(statusPublished, page.FilePath, gistURL))
+	fmt.Println(gistURL)
+}
+
+// publishGist creates a gist for the page, or updates the gist it already
+// has, and records the gist's URL in the page's front-matter. It returns the
+// gist's URL
+func publishGist(page *pages.Page) (string, error) {
+	token := githubToken()
+	if token == "" {
+		return "", errors.New(errGistNoToken)
+	}
+
+	data, err := fileSystem.ReadFile(page.FilePath)
+	if err != nil {
+		return "", err
+	}
+
+	existingURL, err := pages.FrontMatterField(data, gistFrontMatterKey)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", page.FilePath, err)
+	}
+
+	gistReq := gistRequest{
+		Description: page.Title,
+		Files: map[string]gistFile{
+			filepath.Base(page.FilePath): {Content: gistContent(page)},
+		},
+	}
+
+	method, url, action := http.MethodPost, githubAPIURL+"/gists", "create"
+	if existingURL != "" {
+		method, url, action = http.MethodPatch, githubAPIURL+"/gists/"+path.Base(existingURL), "update"
+	}
+
+	gistResp, err := sendGistRequest(method, url, action, token, gistReq)
+	if err != nil {
+		return "", err
+	}
+
+	if gistResp.HTMLURL == existingURL {
+		return gistResp.HTMLURL, nil
+	}
+
+	data, err = pages.SetFrontMatterField(data, gistFrontMatterKey, gistResp.HTMLURL)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", page.FilePath, err)
+	}
+
+	return gistResp.HTMLURL, rewritePage(page.FilePath, data)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// githubToken returns the GitHub token from the config or, failing that, from
+// the GITHUB_TOKEN environment variable
+func githubToken() string {
+	if token := src.GlobalConfig.UString("githubToken", ""); token != "" {
+		return token
+	}
+
+	return os.Getenv("GITHUB_TOKEN")
+}
+
+// gistContent returns what the gist shows: the page as it renders, with a
+// title heading and without its front-matter
+func gistContent(page *pages.Page) string {
+	content := strings.TrimSpace(page.Content)
+
+	if !strings.HasPrefix(content, "# ") {
+		content = fmt.Sprintf("# %s\n\n%s", page.Title, content)
+	}
+
+	return content + "\n"
+}
+
+// sendGistRequest sends the request to GitHub, and turns anything other than
+// success into an error that says what to do about it
+func sendGistRequest(method, url, action, token string, gistReq gistRequest) (gistResponse, error) {
+	gistResp := gistResponse{}
+
+	body, err := json.Marshal(gistReq)
+	if err != nil {
+		return gistResp, err
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return gistResp, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return gistResp, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return gistResp, err
+	}
+
+	// Error replies have a message, so decode whatever comes back
+	json.Unmarshal(respBody, &gistResp)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusForbidden:
+		return gistResp, fmt.Errorf(errGistAuth, resp.Status)
+	case resp.StatusCode == http.StatusNotFound && method == http.MethodPatch:
+		return gistResp, fmt.Errorf(errGistMissing, path.Base(url))
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return gistResp, fmt.Errorf(errGistAPI, action, resp.Status, gistResp.Message)
+	}
+
+	return gistResp, nil
+}
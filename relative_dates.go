@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// relativeDates is the --relative flag of the commands that have one. Without
+// it, the relativeDates config decides
+var relativeDates *bool
+
+// listDate returns how a page's date is written in the lists in the console:
+// relative to today, like "3 days ago", with relative dates, or else the same
+// as on the pages
+func listDate(page *pages.Page) string {
+	relative := src.GlobalConfig.UBool("relativeDates", false)
+	if relativeDates != nil {
+		relative = *relativeDates
+	}
+
+	if !relative {
+		return page.PrettyDate()
+	}
+
+	// A broken timezone is reported before anything's listed
+	loc, err := configuredLocation()
+	if err != nil {
+		return page.PrettyDate()
+	}
+
+	return relativeDate(page.CreatedAt(), pages.Now(), loc)
+}
+
+// checkRelativeDates stops til if dates are to be relative to today, but the
+// timezone that days are counted in is broken
+func checkRelativeDates() {
+	if relativeDates == nil || !*relativeDates {
+		return
+	}
+
+	if _, err := configuredLocation(); err != nil {
+		src.Defeat(err)
+	}
+}
+
+// relativeDate returns how long before or after now the date is, in calendar
+// days in loc: "today", "yesterday", "3 days ago", "2 weeks ago", "5 months
+// ago", or for pages dated in the future, "tomorrow" and "in 2 days". Dates
+// more than a year away are written the way they are on the pages
+func relativeDate(date, now time.Time, loc *time.Location) string {
+	if date.IsZero() {
+		return pages.FormatDate(date)
+	}
+
+	// Days are counted between midnights, so that a page from late last night
+	// is from yesterday, however few hours ago that was
+	then := startOfDay(date.In(loc))
+	today := startOfDay(now.In(loc))
+
+	days := int(math.Round(today.Sub(then).Hours() / 24))
+
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "yesterday"
+	case -1:
+		return "tomorrow"
+	}
+
+	span := days
+	if span < 0 {
+		span = -span
+	}
+
+	var amount string
+
+	switch {
+	case span < 7:
+		amount = pluralDays(span)
+	case span < 30:
+		amount = pluralUnit(span/7, "week")
+	case span < 365:
+		amount = pluralUnit(span/30, "month")
+	default:
+		return pages.FormatDate(date)
+	}
+
+	if days < 0 {
+		return "in " + amount
+	}
+
+	return amount + " ago"
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// pluralUnit returns the number of units, like "1 week" or "3 weeks"
+func pluralUnit(count int, unit string) string {
+	if count == 1 {
+		return "1 " + unit
+	}
+
+	return fmt.Sprintf("%d %ss", count, unit)
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	promptReviewed = "Mark '%s' reviewed? [y/N] "
+
+	statusReviewNone = "no pages are due for review"
+	statusReviewed   = "marked %s reviewed"
+)
+
+// reviewIntervals are the number of days after a page is created that it's
+// due to be read again. Once the last one's been reviewed, it's never due
+// again
+var reviewIntervals = []int{7, 30, 90, 365}
+
+// runReview writes out the content pages that are due to be read again,
+// oldest first. --done marks the page that matches the query reviewed, once
+// it's been read. --ask goes through the due pages one at a time, asking
+// whether each one's been read, and --open opens each one in the editor
+// first.
+// Example:
+//
+//	> til review --done go contexts
+func runReview(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("review", flag.ContinueOnError)
+	done := flags.Bool("done", false, "marks the page that matches the query reviewed")
+	ask := flags.Bool("ask", false, "asks whether each due page has been read, and marks the ones that have reviewed")
+	open := flags.Bool("open", false, "opens each due page in the editor before asking whether it's been read")
+	positional := parseInterspersed(flags, args)
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if *done {
+		page, err := pickPage(pageSet, strings.Join(positional, " "))
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		err = markReviewed(page, pages.Now())
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		src.Info(fmt.Sprintf(statusReviewed, page.FilePath))
+
+		return
+	}
+
+	due := duePages(pageSet, pages.Now())
+	if len(due) == 0 {
+		src.Info(statusReviewNone)
+		return
+	}
+
+	if !*ask && !*open {
+		for _, line := range listPages(due, "") {
+			fmt.Println(line)
+		}
+
+		return
+	}
+
+	in := bufio.NewReader(os.Stdin)
+
+	for _, page := range due {
+		if *open {
+			err = page.Open(defaultEditorFor(runtime.GOOS))
+			if err != nil {
+				src.Defeat(err)
+			}
+		}
+
+		if !askReviewed(in, src.LL.Writer(), page) {
+			continue
+		}
+
+		err = markReviewed(page, pages.Now())
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		src.Info(fmt.Sprintf(statusReviewed, page.FilePath))
+	}
+}
+
+// nextReview returns when a page created and last reviewed at the given
+// times is next due to be read again: the first of the reviewIntervals after
+// its creation that it hasn't been reviewed since. A page that's never been
+// reviewed has a zero reviewed time. It returns false once the last interval
+// has been reviewed, or if the page has no creation date
+func nextReview(created, reviewed time.Time) (time.Time, bool) {
+	if created.IsZero() {
+		return time.Time{}, false
+	}
+
+	for _, days := range reviewIntervals {
+		due := created.AddDate(0, 0, days)
+
+		if reviewed.IsZero() || due.After(reviewed) {
+			return due, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// isReviewDue returns true if a page created and last reviewed at the given
+// times is due to be read again at now
+func isReviewDue(created, reviewed, now time.Time) bool {
+	due, ok := nextReview(created, reviewed)
+
+	return ok && !now.Before(due)
+}
+
+// duePages returns the content pages that are due to be read again at now,
+// oldest first
+func duePages(pageSet []*pages.Page, now time.Time) []*pages.Page {
+	due := []*pages.Page{}
+
+	for _, page := range listedPages(pageSet, "") {
+		if isReviewDue(page.CreatedAt(), page.ReviewedAt(), now) {
+			due = append(due, page)
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].CreatedAt().Before(due[j].CreatedAt())
+	})
+
+	return due
+}
+
+// markReviewed writes the time into the page's reviewed front-matter
+func markReviewed(page *pages.Page, now time.Time) error {
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	data, err := fileSystem.ReadFile(page.FilePath)
+	if err != nil {
+		return err
+	}
+
+	page.Reviewed = now.Format(time.RFC3339)
+
+	data, err = pages.SetFrontMatterField(data, "reviewed", page.Reviewed)
+	if err != nil {
+		return err
+	}
+
+	return rewritePage(page.FilePath, data)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// askReviewed asks on out whether the page has been read, and reads the
+// answer from in
+func askReviewed(in *bufio.Reader, out io.Writer, page *pages.Page) bool {
+	fmt.Fprintf(out, promptReviewed, page.Title)
+
+	answer, _ := in.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errSavedSearchPage     = "saved search '%s' would overwrite the page %s. Please rename the saved search"
+	errSavedSearchQuery    = "saved search '%s': %w"
+	errSavedSearchReserved = "saved search '%s' would overwrite the %s page that til generates. Please rename the saved search"
+	errSavedSearchSame     = "saved searches '%s' and '%s' would both write %s. Please rename one of them"
+	errSavedSearchTag      = "saved search '%s' would overwrite the tag page for '%s'. Please rename the saved search"
+
+	statusSavedSearchBuild = "building saved search pages"
+)
+
+// buildSavedSearchPages writes a page for each of the saved searches in the
+// config into the docs directory, listing the pages that match its query in
+// the same way as the index. The pages are written again on every build, so
+// they're never edited by hand. A saved search whose page would overwrite a
+// tag page, a page that til generates, or a page someone wrote fails the
+// build before anything's written
+func buildSavedSearchPages(ctx context.Context, pageSet []*pages.Page, tagMap *pages.TagMap, docsDir string, warnings *buildWarnings) error {
+	searches, err := src.SavedSearches(src.GlobalConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(searches) == 0 {
+		return nil
+	}
+
+	src.Info(statusSavedSearchBuild)
+
+	err = checkSavedSearches(searches, tagMap, docsDir)
+	if err != nil {
+		return err
+	}
+
+	for _, search := range searches {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		q, err := til.ParseQuery(search.Query)
+		if err != nil {
+			return fmt.Errorf(errSavedSearchQuery, search.Name, err)
+		}
+
+		q.SetTagMap(tagMap)
+
+		filePath := savedSearchFilePath(docsDir, search)
+
+		err = fileSystem.WriteFile(filePath, []byte(pages.MarkGenerated(savedSearchContent(search, til.FilterPages(pageSet, q), siteFooter(pageSet)))), 0644)
+		if err != nil {
+			return err
+		}
+
+		src.Progress(filePath)
+	}
+
+	return nil
+}
+
+// savedSearchContent returns the page for a saved search: its name, its query,
+// the pages that match it, and the footer
+func savedSearchContent(search src.SavedSearch, matches []*pages.Page, footer string) string {
+	content := fmt.Sprintf("## %s\n\n", search.Name)
+	content += fmt.Sprintf("_The pages that match `%s`_\n", search.Query)
+
+	// Write the page list into the middle of the page
+	content += til.PageList(matches, "")
+
+	// Write the footer content into the bottom of the page
+	content += "\n"
+	content += footer
+
+	return content
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// checkSavedSearches returns an error for the first saved search whose page
+// would be written over another page, or over another saved search's
+func checkSavedSearches(searches []src.SavedSearch, tagMap *pages.TagMap, docsDir string) error {
+	tagSlugs := map[string]string{}
+	for _, tagName := range tagMap.SortedTagNames() {
+		tagSlugs[pages.TagSlug(tagName)] = tagName
+	}
+
+	written := map[string]string{}
+
+	for _, search := range searches {
+		slug := pages.Slug(search.Name)
+
+		for _, name := range reservedNames {
+			if slug == name {
+				return fmt.Errorf(errSavedSearchReserved, search.Name, name)
+			}
+		}
+
+		if tagName, ok := tagSlugs[slug]; ok {
+			return fmt.Errorf(errSavedSearchTag, search.Name, tagName)
+		}
+
+		filePath := savedSearchFilePath(docsDir, search)
+
+		if other, ok := written[filePath]; ok {
+			return fmt.Errorf(errSavedSearchSame, other, search.Name, filePath)
+		}
+
+		written[filePath] = search.Name
+
+		// The page is left out of the loaded pages, so look at what's there.
+		// Something with front-matter was written by someone, not by a build
+		if page, err := pages.ReadPageFS(fileSystem, filePath); err == nil && page.IsContentPage() {
+			return fmt.Errorf(errSavedSearchPage, search.Name, filePath)
+		}
+	}
+
+	return nil
+}
+
+// savedSearchFilePath returns the path to the page of a saved search
+func savedSearchFilePath(docsDir string, search src.SavedSearch) string {
+	return filepath.Join(docsDir, fmt.Sprintf("%s.%s", pages.Slug(search.Name), pages.FileExtension))
+}
+
+// savedSearchFilePaths returns the paths to the pages of the saved searches.
+// They're generated, so they aren't pages themselves
+func savedSearchFilePaths(docsDir string) []string {
+	filePaths := []string{}
+
+	// A broken config is reported by the build
+	searches, _ := src.SavedSearches(src.GlobalConfig)
+
+	for _, search := range searches {
+		filePaths = append(filePaths, savedSearchFilePath(docsDir, search))
+	}
+
+	return filePaths
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errSearchPaging = "--limit and --offset can't be less than 0"
+	errSearchQuery  = "what should til search for? Give it some words, like til search goroutine leak"
+
+	statusSearchIndexFailed = "couldn't update the search index, so searches will be slower until it can be: %s"
+)
+
+// runSearch writes the content pages that match the query out to the
+// terminal, the most relevant first, each with a snippet of its content
+// around the words it matched. --limit and --offset page through them. Bare
+// words are looked for in the pages' titles,
+// tags, and content, and fields like tag: narrow the search down; see
+// til.ParseQuery. It uses the search index in the docs directory when that's
+// up to date, and reads every page when it isn't.
+// Example:
+//
+//	> til search context.WithTimeout
+//	> til search 'goroutine tag:concurrency'
+func runSearch(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("search", flag.ContinueOnError)
+	limit := flags.Int("limit", 0, "the most results to show; 0 shows them all")
+	offset := flags.Int("offset", 0, "the number of results to skip, to page through them")
+	noPager := flags.Bool("no-pager", !src.GlobalConfig.UBool("pager", true), "writes the results straight out, rather than through the pager")
+	relative := flags.Bool("relative", src.GlobalConfig.UBool("relativeDates", false), "writes the dates relative to today, like 3 days ago")
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	relativeDates = relative
+	checkRelativeDates()
+
+	if strings.TrimSpace(query) == "" {
+		src.Defeat(&src.UsageError{Err: errors.New(errSearchQuery)})
+	}
+
+	if *limit < 0 || *offset < 0 {
+		src.Defeat(&src.UsageError{Err: errors.New(errSearchPaging)})
+	}
+
+	q, results, err := searchPages(ctx, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	results = pageResults(results, *offset, *limit)
+
+	if jsonFlag {
+		found := []*pages.Page{}
+		for _, result := range results {
+			found = append(found, result.Page)
+		}
+
+		writeJSON(listPagesJSON(found, ""))
+		return
+	}
+
+	mark := func(word string) string { return "**" + word + "**" }
+	if isTerminal(os.Stdout) {
+		mark = func(word string) string { return src.Bold(word) }
+	}
+
+	out := openPager(*noPager)
+
+	for _, line := range searchLines(q, results, mark) {
+		fmt.Fprintln(out, line)
+	}
+
+	err = out.Close()
+	if err != nil {
+		src.Defeat(err)
+	}
+}
+
+// searchPages returns the parsed query and the content pages that match it,
+// the most relevant first
+func searchPages(ctx context.Context, query string) (*til.Query, []til.SearchResult, error) {
+	q, err := parseQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only the aliases are needed to match tags by their canonical names,
+	// not the pages
+	q.SetTagMap(newTagMap(nil))
+
+	found, _, err := til.SearchPages(ctx, fileSystem, tDir, q, loadOptions())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return q, til.RankPages(found, q, pages.Now()), nil
+}
+
+// searchLines returns two lines per result: the page, as til list shows it,
+// and an indented snippet of its content, with the words that matched passed
+// through mark
+func searchLines(q *til.Query, results []til.SearchResult, mark func(string) string) []string {
+	lines := []string{}
+
+	for _, result := range results {
+		page := result.Page
+		lines = append(lines, fmt.Sprintf("%s  %s  (%s)", listDate(page), page.Title, page.FilePath))
+
+		if snippet := q.Snippet(page, mark); snippet != "" {
+			lines = append(lines, "    "+snippet)
+		}
+	}
+
+	return lines
+}
+
+// pageResults returns the results after the first offset, and no more than
+// limit of them, unless limit is 0
+func pageResults(results []til.SearchResult, offset, limit int) []til.SearchResult {
+	if offset >= len(results) {
+		return []til.SearchResult{}
+	}
+
+	results = results[offset:]
+
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// parseQuery parses a query given on the command line. A query that can't be
+// parsed is a usage error
+func parseQuery(query string) (*til.Query, error) {
+	q, err := til.ParseQuery(query)
+	if err != nil {
+		return nil, &src.UsageError{Err: err}
+	}
+
+	return q, nil
+}
+
+// updateSearchIndex brings the search index up to date with the pages. The
+// index only makes searching faster, so failing to update it is a warning
+func updateSearchIndex(ctx context.Context) {
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err == nil {
+		// The pages it skips have already been warned about, when they were
+		// loaded
+		_, err = til.UpdateSearchIndex(ctx, fileSystem, tDir, loadOptions())
+	}
+
+	if err != nil {
+		src.Warn(fmt.Sprintf(statusSearchIndexFailed, err))
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	// defaultServeAddr is where til serve listens without --addr. It's only
+	// reachable from this machine, unless it's told otherwise
+	defaultServeAddr = "localhost:8080"
+
+	// serveShutdownTimeout is how long the requests that are being answered
+	// when til serve is stopped get to finish
+	serveShutdownTimeout = 5 * time.Second
+
+	errAPIArchived = "archived needs to be true or false, not '%s'"
+	errAPILimit    = "limit needs to be a number above 0, not '%s'"
+	errAPIMethod   = "the API only answers GET requests"
+	errAPINotFound = "there's nothing at %s"
+	errAPIPage     = "no page has the id or slug '%s'"
+	errAPISince    = "since needs to be a date, like 2024-05-14, not '%s'"
+
+	statusServing = "serving %s on http://%s, with the API under /api/"
+)
+
+// pageLoader loads the pages that the API answers with. It's called for each
+// request, so that the API has the pages as they are now
+type pageLoader func(ctx context.Context) ([]*pages.Page, error)
+
+// apiHandler answers the requests to til serve's API, under /api/
+type apiHandler struct {
+	load    pageLoader
+	baseURL string
+	loc     *time.Location
+}
+
+// runServe serves the docs directory over HTTP, along with a read-only JSON
+// API of the pages and tags, for things like a "latest TILs" box on a site.
+// --addr is the address it listens on.
+// Example:
+//
+//	> til serve --addr localhost:4000
+func runServe(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", defaultServeAddr, "the address to listen on")
+	parseFlags(flags, args)
+
+	tDir, err := src.GetTargetDir(src.GlobalConfig, targetDirFlag, true)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	loc, err := configuredLocation()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	api := newAPIHandler(loadServedPages, src.GlobalConfig.UString("baseURL", ""), loc)
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", api)
+	mux.Handle("/", hideDotFiles(http.FileServer(http.Dir(tDir))))
+
+	server := &http.Server{Addr: *addr, Handler: mux}
+
+	// Stopping til stops the server, once the requests it's answering are done
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+
+		server.Shutdown(shutdownCtx)
+	}()
+
+	src.Info(fmt.Sprintf(statusServing, tDir, *addr))
+
+	err = server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		src.Defeat(err)
+	}
+}
+
+// newAPIHandler returns the handler for til serve's API, answering with the
+// pages that load returns. Permalinks are only given with a baseURL, and
+// the since filter's dates are days in loc
+func newAPIHandler(load pageLoader, baseURL string, loc *time.Location) http.Handler {
+	return &apiHandler{load: load, baseURL: baseURL, loc: loc}
+}
+
+// ServeHTTP answers GET /api/pages, GET /api/pages/{id-or-slug}, and
+// GET /api/tags
+func (api *apiHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeAPIError(w, http.StatusMethodNotAllowed, errors.New(errAPIMethod))
+		return
+	}
+
+	route := strings.Trim(strings.TrimPrefix(req.URL.Path, "/api/"), "/")
+
+	pageSet, err := api.load(req.Context())
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch {
+	case route == "pages":
+		api.servePages(w, req, pageSet)
+	case strings.HasPrefix(route, "pages/") && !strings.Contains(route[len("pages/"):], "/"):
+		api.servePage(w, pageSet, route[len("pages/"):])
+	case route == "tags":
+		writeAPIJSON(w, http.StatusOK, tagsJSON(newTagMap(pageSet), true))
+	default:
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf(errAPINotFound, req.URL.Path))
+	}
+}
+
+// servePages answers with the content pages, newest first, filtered by the
+// tag, author, since, and limit query parameters. The author is matched
+// the way til list --author matches it. Like til list, it leaves out the
+// archived pages, unless archived=true asks for only them
+func (api *apiHandler) servePages(w http.ResponseWriter, req *http.Request, pageSet []*pages.Page) {
+	query := req.URL.Query()
+
+	archived := false
+	if raw := query.Get("archived"); raw != "" {
+		val, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf(errAPIArchived, raw))
+			return
+		}
+
+		archived = val
+	}
+
+	limit := 0
+	if raw := query.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf(errAPILimit, raw))
+			return
+		}
+
+		limit = n
+	}
+
+	var since time.Time
+	if raw := query.Get("since"); raw != "" {
+		date, err := time.ParseInLocation(activityDayFormat, raw, api.loc)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf(errAPISince, raw))
+			return
+		}
+
+		since = date
+	}
+
+	list := src.JSONPageList{Version: src.JSONVersion, Pages: []src.JSONPage{}}
+
+	for _, page := range archivedOrNot(listedPages(pagesByAuthor(pageSet, query.Get("author")), query.Get("tag")), archived) {
+		if !since.IsZero() && page.CreatedAt().Before(since) {
+			continue
+		}
+
+		if limit > 0 && len(list.Pages) == limit {
+			break
+		}
+
+		list.Pages = append(list.Pages, jsonPage(page))
+	}
+
+	writeAPIJSON(w, http.StatusOK, list)
+}
+
+// servePage answers with the page whose id, its file name without the
+// extension, or slug, its title's, is key. When pages share a slug, it's the
+// newest of them. Archived pages are still there to be got, as they're still
+// built
+func (api *apiHandler) servePage(w http.ResponseWriter, pageSet []*pages.Page, key string) {
+	page := apiPage(pageSet, key)
+	if page == nil {
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf(errAPIPage, key))
+		return
+	}
+
+	writeAPIJSON(w, http.StatusOK, pageDetailJSON(page, api.baseURL))
+}
+
+// apiPage returns the content page whose id or slug is key, or nil
+func apiPage(pageSet []*pages.Page, key string) *pages.Page {
+	listed := listedPages(pageSet, "")
+
+	for _, page := range listed {
+		name := filepath.Base(page.FilePath)
+		if strings.TrimSuffix(name, filepath.Ext(name)) == key {
+			return page
+		}
+	}
+
+	for _, page := range listed {
+		if pages.Slug(page.Title) == key {
+			return page
+		}
+	}
+
+	return nil
+}
+
+// pageDetailJSON returns the page with its Markdown and HTML. The HTML is
+// rendered the way til export --html renders it, without the heading that
+// repeats the title
+func pageDetailJSON(page *pages.Page, baseURL string) src.JSONPageDetail {
+	detail := src.JSONPageDetail{
+		Version:  src.JSONVersion,
+		JSONPage: jsonPage(page),
+		Markdown: page.Content,
+		HTML:     pages.RenderHTML(exportBody(page), pages.HTMLOptions{}),
+	}
+
+	if baseURL != "" {
+		detail.Permalink = page.Permalink(baseURL)
+	}
+
+	return detail
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// hideDotFiles answers with a 404 for anything under a file or directory whose
+// name starts with a dot, like the history and search index in .til and the
+// .til.lock, and leaves the rest to next
+func hideDotFiles(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for _, segment := range strings.Split(req.URL.Path, "/") {
+			if strings.HasPrefix(segment, ".") {
+				http.NotFound(w, req)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// loadServedPages loads the pages for the API, without warning about the
+// ones it skips every time it's asked
+func loadServedPages(ctx context.Context) ([]*pages.Page, error) {
+	pageSet, _, err := loadPagesSkipping(ctx)
+
+	return pageSet, err
+}
+
+// writeAPIJSON writes the value as the response's JSON
+func writeAPIJSON(w http.ResponseWriter, status int, val interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(val)
+}
+
+// writeAPIError writes the error as the response's JSON
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	writeAPIJSON(w, status, src.JSONError{Version: src.JSONVersion, Error: err.Error()})
+}
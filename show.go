@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errShowNoBaseURL = "--anchor needs baseURL in the config, to know where the index is published"
+)
+
+// runShow writes out the page that matches the query. With --anchor, it writes
+// the public URL of the page's entry on the index instead, for linking
+// someone straight to it. A page that's longer than the terminal goes through
+// the pager, unless --no-pager or the pager config turns it off.
+// Example:
+//
+//	> til show go contexts --anchor
+func runShow(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("show", flag.ContinueOnError)
+	anchor := flags.Bool("anchor", false, "writes the URL of the page's entry on the index")
+	noPager := flags.Bool("no-pager", !src.GlobalConfig.UBool("pager", true), "writes the page straight out, rather than through the pager")
+	positional := parseInterspersed(flags, args)
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, strings.Join(positional, " "))
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if !*anchor {
+		data, err := fileSystem.ReadFile(page.FilePath)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		out := openPager(*noPager)
+		out.Write(data)
+
+		err = out.Close()
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		return
+	}
+
+	baseURL := src.GlobalConfig.UString("baseURL", "")
+	if baseURL == "" {
+		src.Defeat(&src.UsageError{Err: errors.New(errShowNoBaseURL)})
+	}
+
+	fmt.Println(entryAnchorURL(pageSet, page, baseURL, src.GlobalConfig.UInt("indexLimit", 0)))
+}
+
+// entryAnchorURL returns the public URL of the page's entry on the index, or
+// on the all page if the index only lists the indexLimit most recent pages
+// and this isn't one of them
+func entryAnchorURL(pageSet []*pages.Page, page *pages.Page, baseURL string, indexLimit int) string {
+	name := "index"
+
+	if indexLimit > 0 {
+		listed := listedPages(pageSet, "")
+
+		for i, other := range listed {
+			if other.FilePath == page.FilePath && i >= indexLimit {
+				name = til.AllPageName
+			}
+		}
+	}
+
+	relPath := fmt.Sprintf("%s.%s", name, pages.FileExtension)
+
+	return pages.Permalink(baseURL, relPath) + "#" + til.EntryAnchors(pageSet)[page.FilePath]
+}
@@ -0,0 +1,571 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errDictionaryWord   = "'%s' can't go in the dictionary: a word is only letters and apostrophes"
+	errSpellcheckFailed = "found %d unknown words"
+
+	statusDictionaryAdded = "added '%s' to %s"
+	statusDictionaryKnown = "'%s' is already in %s"
+	statusSpellcheck      = "no unknown words"
+)
+
+//go:generate go run gen_spellcheck_words.go
+
+// technicalWords are the words of technical prose that aren't in the
+// English word list. Plurals of them are known as well, the same as any
+// other word's
+const technicalWords = `
+	api arg args argv async backend bool boolean cli codebase changelog
+	checkbox checksum chmod config cron crontab css deserialize dev devops
+	dir dirs dns dropdown emoji enum filename filesystem frontend frontmatter
+	gitlab golang goroutine gzip homebrew hostname https init inlined
+	inlining json kubernetes localhost macos markdown marshalling metadata
+	microservice middleware mutex mysql namespace navbar nginx npm param
+	params parsers pathname postgres pre prefetch preload readme rebase
+	rebased redis regex regexes regexp repo runtime scalable serverless sqlite
+	ssl stateful stderr stdin stdout struct submodule subcommand
+	subdirectories subdirectory symlink tcp til timestamp tls todo toml
+	tokenize tokenized tokenizer tooltip udp uint unmarshal unmarshaling
+	unmarshalled unmarshalling utf webhook webpack wget yaml zsh
+`
+
+const (
+	// spellOpening and spellClosing are the punctuation that's taken off
+	// the start and the end of a word
+	spellOpening = `"'‘“([{*_~<`
+	spellClosing = `"'’”)]}*_~.,;:!?…>`
+)
+
+var (
+	// spellCallRegex finds calls, like strlen()
+	spellCallRegex = regexp.MustCompile(`[A-Za-z0-9_]\(`)
+
+	// spellHTMLRegex finds HTML tags and comments, which aren't prose
+	spellHTMLRegex = regexp.MustCompile(`<!--.*?-->|</?[A-Za-z][^<>]*>`)
+
+	// spellLinkRegex finds the targets of Markdown links and images, and
+	// the targets of wiki links, which are file names rather than words
+	spellLinkRegex = regexp.MustCompile(`\]\([^)]*\)|\[\[[^\]]*\]\]`)
+
+	// spellURLRegex finds URLs and email addresses, autolinked or not
+	spellURLRegex = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.-]*://|www\.|mailto:)\S+|\S+@\S+\.\S+`)
+)
+
+// proseLine is a line of a page that's written rather than code, and its
+// number, counting from 1
+type proseLine struct {
+	number int
+	text   string
+}
+
+// spelledWord is a word in a page, and where it is: its line and column,
+// counting from 1, with the column counted in characters
+type spelledWord struct {
+	word   string
+	line   int
+	column int
+}
+
+// runSpellcheck checks the words of the content pages, or of the page that
+// matches the query, against the English word list and the user dictionary,
+// and writes out each word that's in neither, with the file, line, and
+// column it's on. Code, URLs, front-matter, and anything that looks like an
+// identifier aren't checked. It fails if it finds any unknown words. --add
+// puts a word in the user dictionary, which is dictionary.txt in the config
+// directory, so that it's known from then on.
+// Example:
+//
+//	> til spellcheck go contexts
+//	> til spellcheck --add goroutines
+func runSpellcheck(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("spellcheck", flag.ContinueOnError)
+	add := flags.String("add", "", "adds the word to the user dictionary, so that it's known from then on")
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	dictPath, err := src.GetDictionaryFilePath()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if *add != "" {
+		added, err := addToDictionary(dictPath, *add)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		status := statusDictionaryKnown
+		if added {
+			status = statusDictionaryAdded
+		}
+
+		src.Info(fmt.Sprintf(status, *add, dictPath))
+
+		return
+	}
+
+	userWords, err := readDictionary(dictPath)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	known, err := knownWords(userWords)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	checked := listedPages(pageSet, "")
+
+	if strings.TrimSpace(query) != "" {
+		page, err := pickPage(pageSet, query)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		checked = []*pages.Page{page}
+	}
+
+	unknown := 0
+
+	for _, page := range checked {
+		data, err := fileSystem.ReadFile(page.FilePath)
+		if err != nil {
+			src.Defeat(err)
+		}
+
+		for _, word := range unknownWords(string(data), known) {
+			fmt.Printf("%s:%d:%d: %s\n", page.FilePath, word.line, word.column, word.word)
+			unknown++
+		}
+	}
+
+	if unknown > 0 {
+		src.Defeat(fmt.Errorf(errSpellcheckFailed, unknown))
+	}
+
+	src.Info(statusSpellcheck)
+}
+
+// knownWords returns the English word list, the technical words, and the
+// user's own words, in lower case
+func knownWords(userWords []string) (map[string]bool, error) {
+	englishWords, err := englishWords()
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]bool{}
+
+	for _, words := range []string{englishWords, technicalWords} {
+		for _, word := range strings.Fields(words) {
+			known[word] = true
+		}
+	}
+
+	for _, word := range userWords {
+		known[strings.ToLower(word)] = true
+	}
+
+	return known, nil
+}
+
+// englishWords returns the English word list, one word to a line. It's
+// built into til compressed, by gen_spellcheck_words.go
+func englishWords() (string, error) {
+	reader, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(englishWordsGzip)))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	words, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	return string(words), nil
+}
+
+// isKnownWord returns true if the word, in any case, is known. A word with
+// a possessive 's, or that's the plural of a known word, is known too
+func isKnownWord(known map[string]bool, word string) bool {
+	word = strings.ToLower(word)
+	if known[word] {
+		return true
+	}
+
+	word = strings.TrimSuffix(word, "'s")
+	if known[word] {
+		return true
+	}
+
+	for _, suffix := range []string{"s", "es"} {
+		if strings.HasSuffix(word, suffix) && known[strings.TrimSuffix(word, suffix)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unknownWords returns the words of the page source that aren't known
+func unknownWords(source string, known map[string]bool) []spelledWord {
+	unknown := []spelledWord{}
+
+	for _, word := range spellWords(source) {
+		if !isKnownWord(known, word.word) {
+			unknown = append(unknown, word)
+		}
+	}
+
+	return unknown
+}
+
+// spellWords returns the words of the page source that are worth checking.
+// The front-matter, fenced code blocks, inline code, URLs, link targets,
+// and HTML are skipped, and so is anything that looks like an identifier,
+// a path, a flag, a number, or an acronym rather than a word
+func spellWords(source string) []spelledWord {
+	words := []spelledWord{}
+
+	for _, line := range proseLines(source) {
+		for _, word := range lineWords(maskLine(line.text)) {
+			word.line = line.number
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// proseLines returns the lines of the page source that aren't front-matter
+// or in a fenced code block, with their inline code blanked out
+func proseLines(source string) []proseLine {
+	prose := []proseLine{}
+
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	fence := ""
+
+	for i := frontMatterEnd(lines); i < len(lines); i++ {
+		trimmed := strings.TrimLeft(lines[i], " \t")
+
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]+" \t") == "" {
+				fence = ""
+			}
+
+			continue
+		}
+
+		if open := codeFence(trimmed); open != "" {
+			fence = open
+			continue
+		}
+
+		prose = append(prose, proseLine{number: i + 1, text: maskInlineCode(lines[i])})
+	}
+
+	return prose
+}
+
+// addToDictionary appends the word to the user dictionary, unless it's
+// already there. It returns false if it was
+func addToDictionary(dictPath, word string) (bool, error) {
+	if !isWordShaped(word) {
+		return false, &src.UsageError{Err: fmt.Errorf(errDictionaryWord, word)}
+	}
+
+	userWords, err := readDictionary(dictPath)
+	if err != nil {
+		return false, err
+	}
+
+	for _, userWord := range userWords {
+		if strings.EqualFold(userWord, word) {
+			return false, nil
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(dictPath), 0755)
+	if err != nil {
+		return false, err
+	}
+
+	file, err := os.OpenFile(dictPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = fmt.Fprintln(file, word)
+	if err != nil {
+		file.Close()
+		return false, err
+	}
+
+	return true, file.Close()
+}
+
+// readDictionary returns the words in the user dictionary, one to a line.
+// A dictionary that doesn't exist yet has no words in it
+func readDictionary(dictPath string) ([]string, error) {
+	data, err := ioutil.ReadFile(dictPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	words := []string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			words = append(words, word)
+		}
+	}
+
+	return words, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// frontMatterEnd returns the index of the first line after the YAML
+// front-matter, or 0 if there isn't any
+func frontMatterEnd(lines []string) int {
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return 0
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if end := strings.TrimSpace(lines[i]); end == "---" || end == "..." {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// codeFence returns the fence that the line opens a code block with, or ""
+// if it doesn't open one
+func codeFence(trimmed string) string {
+	for _, char := range []string{"`", "~"} {
+		fence := ""
+		for strings.HasPrefix(trimmed[len(fence):], char) {
+			fence += char
+		}
+
+		if len(fence) >= 3 {
+			return fence
+		}
+	}
+
+	return ""
+}
+
+// maskLine blanks out the parts of a prose line that still aren't words:
+// HTML, link targets, and URLs. Each character is replaced with a space, so
+// that the columns of the words after them don't change
+func maskLine(line string) string {
+	for _, regex := range []*regexp.Regexp{spellHTMLRegex, spellLinkRegex, spellURLRegex} {
+		line = regex.ReplaceAllStringFunc(line, blank)
+	}
+
+	return line
+}
+
+// maskInlineCode blanks out the inline code in the line, a space for each
+// character
+func maskInlineCode(line string) string {
+	runes := []rune(line)
+
+	// Inline code ends at the next run of exactly as many backticks as it
+	// started with. A run that's never closed is only backticks
+	for i := 0; i < len(runes); {
+		n := backtickRun(runes, i)
+		if n == 0 {
+			i++
+			continue
+		}
+
+		end := -1
+		for j := i + n; j < len(runes); {
+			m := backtickRun(runes, j)
+			if m == 0 {
+				j++
+				continue
+			}
+
+			if m == n {
+				end = j + m
+				break
+			}
+
+			j += m
+		}
+
+		if end < 0 {
+			i += n
+			continue
+		}
+
+		for j := i; j < end; j++ {
+			runes[j] = ' '
+		}
+
+		i = end
+	}
+
+	return string(runes)
+}
+
+// blank returns as many spaces as there are characters in the text
+func blank(text string) string {
+	return strings.Repeat(" ", len([]rune(text)))
+}
+
+// backtickRun returns how many backticks there are in a row at i
+func backtickRun(runes []rune, i int) int {
+	n := 0
+	for i+n < len(runes) && runes[i+n] == '`' {
+		n++
+	}
+
+	return n
+}
+
+// lineWords splits a masked line into the words worth checking, with the
+// column each one starts at. Words joined by hyphens or dashes are checked
+// a part at a time
+func lineWords(line string) []spelledWord {
+	words := []spelledWord{}
+	runes := []rune(strings.ReplaceAll(line, "’", "'"))
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+
+		chunk := runes[start:i]
+
+		// A call, like strlen(), is code even without backticks around it
+		if spellCallRegex.MatchString(string(chunk)) {
+			continue
+		}
+
+		// And so is a flag, like --timeout
+		if strings.HasPrefix(strings.TrimLeft(string(chunk), spellOpening), "-") {
+			continue
+		}
+
+		for j := 0; j < len(chunk); {
+			partStart := j
+			for j < len(chunk) && !isDash(chunk[j]) {
+				j++
+			}
+
+			if word, ok := spellWord(chunk[partStart:j], start+partStart); ok {
+				words = append(words, word)
+			}
+
+			j++
+		}
+	}
+
+	return words
+}
+
+// spellWord returns the part of a line that starts at the offset, counting
+// from 0, as a word, without the punctuation around it. It returns false if
+// it isn't worth checking
+func spellWord(part []rune, offset int) (spelledWord, bool) {
+	first, last := 0, len(part)
+	for first < last && strings.ContainsRune(spellOpening, part[first]) {
+		first++
+	}
+	for last > first && strings.ContainsRune(spellClosing, part[last-1]) {
+		last--
+	}
+
+	word := string(part[first:last])
+	if len(word) < 2 || !isWordShaped(word) || isAcronym(word) || isCamelCase(word) {
+		return spelledWord{}, false
+	}
+
+	return spelledWord{word: word, column: offset + first + 1}, true
+}
+
+// isWordShaped returns true if the text is only ASCII letters and
+// apostrophes, with a letter in it. Anything else, like digits,
+// dots, slashes, or underscores, means it's an identifier, a path, or a
+// number. Words with letters outside ASCII are left alone too, since the
+// word list is only in English
+func isWordShaped(text string) bool {
+	letters := 0
+
+	for _, char := range text {
+		switch {
+		case char <= unicode.MaxASCII && unicode.IsLetter(char):
+			letters++
+		case char == '\'':
+		default:
+			return false
+		}
+	}
+
+	return letters > 0
+}
+
+// isDash returns true for the hyphens and dashes that join words
+func isDash(char rune) bool {
+	return char == '-' || char == '–' || char == '—'
+}
+
+// isAcronym returns true if the word is all in capitals, like HTTP, with
+// maybe a plural s, like URLs
+func isAcronym(word string) bool {
+	word = strings.TrimSuffix(strings.TrimSuffix(word, "'s"), "s")
+
+	return len(word) > 1 && strings.ToUpper(word) == word
+}
+
+// isCamelCase returns true if the word has a capital after its first
+// letter, like camelCase, GitHub, or iPhone
+func isCamelCase(word string) bool {
+	for _, char := range word[1:] {
+		if unicode.IsUpper(char) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,5802 @@
+// Code generated by gen_spellcheck_words.go from words/english.txt. DO NOT EDIT.
+
+package main
+
+// englishWordsGzip is words/english.txt, gzipped and base64-encoded. See
+// words/README.md for where the words came from
+const englishWordsGzip = `
+H4sIAAAAAAAC/3S9a5brLM+1+786stpFjCrhDjZ5AKeWq/V7oDklyHq/PUYVuiTEwRhjjA8JX2H8
+jf9t/D/k+Arh8RVCTpfKGt+hPh2a0k/J3wZvUdtdnR+nKuVA+E59e3yF21e4hfGPcHtqeDYKUfju
+I8zlEJNqPmI5TEp0qkbpuBvucvSVPb3DT6iae2MYISwhqAErBLQHQiZ4SMwXifGsRnt4Xo+ZFyO7
+IIwQ5tlnqR2lduTaLV0vqTpo1N8U8le4aXveQkOYRsY30aAhNEH9QqhK6QipqFY1kDdFQpUN48Jt
+MmppSjk+FPNTOYrfxv/QYtqQPSA6eSQyBpZjQfUouxwmaUhHyJPyNdkdyiuH1pP8Y1DXc+sUEg3E
+oZFYq3OzSoEsulQHM0FmhDmMvjSylbCPcBQlWYNQoTTNWOpWjp8LVMt+S5qqRpHDoT1SNfM7HcAj
+9AfS1XB0B2mOeUabkZtjGPKiwOdq/efqWn3NFEk7Wks6W0Y62kClelzh2MTBTCOLh3o+Sq0SDeBr
+2Jz7pHwZW3IU/UCBKbJAAEzQtZgUNV2KjEJilfkyQtR/YbRJuoc0miNl0RZOObELDdIkxx3jVCp3
+OaSlZpw2pa7yP9k6BVqb1Ij5Ihw4dA21rP9O30mGbi71AgpFpKwm6Wo5YZszjsDM4y/z6Mt27GUr
+MHtxg97ikC9HxP5qHIKqYRubnEsZvT2fluHpGY4cRtw+tEPuqBMgOnkkagYsx4LwGGUepe4hO9jO
+Mu1yzhOHQxn/OAiLdpiCLlJyag+TEp3qJHebCVBRRQ71zvThBpBS2z+Utmr9Q1miFPd0sNGJl2GX
+SXGip2JzksuxsjrVdOewapivRZk+MglGnppL7QhRfsU5rtSevsOW2DC1uzMrUZftrutm11k19kRA
+vhx59JSKFOcRKcSApZ1HhIcWg8ze4n3gLffK1DVsIQ6xclMlCkWkrJSMRlk1PHS8rxYitmFzAWZ6
+i0O+HLlVU5GpKkmwYcWQZm3FmuJdTEYD9kznZko1MAs3I8X7ksaTlKBZFh69gGlqRsyl+NFr6B6l
+TlLj+eoUUg2amdA+58tGTEMtUf9D1pGnbXBQKdHJbahZ21JrwUGcmhGqbQhzOaJJmVQnuZuVUtDz
+mqRMIdGgEugrKcMVJ7Vmp8WG3TAE0x6YrwAwnDg3V5wqIQUDFsgz7IA92Sa58k8cG76xJ7R09IdJ
+cdCoks8uDvlytDxcWfyr07S1bsi9Ueb5BNz2BRdzX5Dmt5iMBtXAXNgypd4oOMMwbGRmUW9ybGla
+j+1y7JM8vjqYaRZos5LBjH35ZgOn+S0TcaJrPaTDpESnOqkZstCBsMmeyrlivhbF9tqi/vlHFzoc
+VmMizOmwPk2c5j6JZdawdQeJCy4OszsuqiyGOrHN7GzDq4+gzuxJU+3/qG3VF+UtzksFP6r3T+VK
+XXCaSWcTB8vwbDIzpCJTrU6o9VkjhVQDj+GGKk1bb4acRIEvUjawahDV9Rz/N8xLzv/CCA+bmpOa
+YXfQLM/G+Qy2+sSIzU0aokFCYPedPHGeft48l9Om859VQQZaeE9Z9/zZeX4DwAEhut2Q1SQjWIPf
+369wuxomnyq1HpfW4GqNdhR7tZaOFCYdX2H7ClvYUqBoQ0bZxaQZUnBw0+YQ8oL5WpTpjOKcPyLa
+I72m3nZn95oV8VgUg4odXReCCHB+nXkrAyqiRrBJFIpISbM26LZJlhq6LBgXbpM//DlkulqOD2VN
+9l7yLnVl9yq76A5fNUQenYK1Orwah2V2eE5HN3mGPEkPNlf6NZU8ucuCceGZ5VL0OTfZFPeryOnV
+KZaL2UW/XMuyIA6lD3VJmD2VHuKO7nF4oV4/srlIdPDcpDqYm23qa9nTyksFbc++bE+8fE+8uCea
+CRbrW9Na+mgW0y/Xskx0K2vFKaKRZ564WGmK5V1qCnmyLPZfWTmuyuo1s7VNHKT1SlG4NwZ1h5AX
+zNeiWBtSnayUQ9pNSnSqRqzKQPrvvreNEZF2dmtQnDjjLbe0ZJL+zSS1fyKbrBxXpX4oax5rqe2j
+3N9/sv+VleOq1A+lrdqqePZv72OZ87VtKzlEcYhOzYipy76XyCY0jqvSFuUzjR81ppfjU/tI+pZP
+zTpI2V/hSBIXrgsvPjt73tSWyNZXnhHXJK/9K6dNFmzO7bGgxFWpH8qa5CP9Woit2HyocK6RwkY5
+YnPuk9jK1fYjJl4ES2EF1zj3S422T5SskUxpUzOEpF+zrtB8z1uik/U7j8+xfxquqWZZ2Xf8ov9Z
+DDMhL35cW2L6gu7C2p5+AiW6A4EnAwVL06V6oi517qnTzhjl7FUcopPvY1OmtxOzrkFDiak7+HEz
+teaqREfLYjAdPHpmsSS3Q65yojAkok7amfwUmlmE1u3M/bQ5k3FclbYoTGhaOT61D1dU6tzPHPqK
+ceE22bKGUo4PZfVb8+XMfVGt08FQ6sqIqMF628CL0MUhT5vnZool5HlCwfybXU0uCv0b9sw5Tz3E
+5vyWBd3M0/LZGMtieWY67aR0ekHWjM0Hh7P1sjtIXNCrO1RP2Yuei0eJ6i05nzmMAuXoVa8btHip
+uEdJiE7NCFlKvTHRzS8wBnMoUVSbaj3czhyczh2YozwuPdFJD3ofTu89rxqTHyknc+wuEYl69HLj
+tf1g3nQZeIjJRmDMlbdHyemQT611qaGZUY6FNIO3xPIVtkeQMOr6EA1uEFHDQyiaynpoQz9E74lv
+D5obtTPjOuyBc+cj6fX4kG+OvgNdRgMOXc7NlOrgJgPsvEfKmUKCAVx2q7V5ssc9im50LXtAgz+G
+NUUN1JBi+vbbtNAkOjXSZRL5p5h2Yf9zDvlTy9fUid1z7LDQh50/xfJ6pDxJFmvaJtPcLL4lAMIz
+68rXhro+pe66o55H+cmiK+mTuatcjx9KvlaVe+5Db6uhfigfUavCelH7J1fLNJ9fYdvHv2gwbNpB
+cVWC3V3y1cWkGo4t3M+glLqY1Kh6IFSldXU6G7oFIOQF8+UKOjq4TRoTiP+dIR3dgTOrqbRPDasT
+n4bpItERbQSGQ5K2yaQ48VjtXia0vnK+pjadrKhU2RkGuowGbrGkPgtx9ojq4CYDL63xHuLkNpW3
+rJyvVePB8qFb9qyO1aTjYpE0jd5i3do8YWUMhDpqG1QJd5dNQUMdQipux6vUwa+miIG5zoO92tFe
+ebhXP95JdNvLkRYs5wfna9U8g0WfadW13EI3mbZJ7NnU2oJ/jGGcp9hasmzdIWRHeO5yDzltk1HA
+ce0m4fcSS6tEt9ej3FJYkM4lp4WwdaVR2CbZIVnPvyO8MsKw47w7sBPSZnIkGJvTg2gPRCfojy9d
+T/+yVfXN5vE94eQ7ZBQHi9J5QU+Hr7Y5wwNlpMIb3Yoo0ddVrPv39EZ9AdHJI83/HTzjd/hIXuok
+GpkjDqTlGPo4fGyyN+SPBOYifyGxWgpoRt3BTOz0dg9n66gj6sT6VGku4a0BLhm6Liz6xk2tuSqT
+4sQZzyY6/fGJbS5QKv1+ZP/7mf2vTIoTZ/zMPluOjORqGMliq9eh0tLFZDSgR/fMZw2X2rEdT9u1
+5/f3CG0zT2zjuctBodZXwSkKANNoe6yoOiPiPLZuEeQ1Avt80TRSN4j3RTe/Kbotd0Q3XgDjXqiK
+kdHPV9iuDYemSjbbteXyTnVS+wrxK8Qw/u+CsKlIhULVkSaGXYNwdJP5MlIvfdIEJujtmSBHo8eA
+QAt7dYTLisNUL1OyOJkNO9BRj59F64vGPCWaRPspV5M0aAdRsOxnBnqgE6wefrBD8TqPa41JaZtM
+31JN0qBCjUp4jjOOhKPekY3AJtVloxjlMBEoG+UYNKOePkeY5QJodNo6BXJJHJBB5Zhk7thyUr4m
+c9uHBgk/5nbcO3NDZKPW0wIhL5gvVxrpLQ4eqyMpCC0e0TgZG5QRnVmPgmv4GCtrO+TsYVSzGE9n
+mTbxlFInTaMTC1X93IQiUlZKRicWe25MdmLfnLZvTt83p++b03cInypVGDlKDgj1PBolI9RJU5Rc
+NCftmHKEUysjB2MPPJ4V5SiP61Vej6v9qyfqKZoM2YhRezDphu6mrpOuwe9ULek71cV6slAdF3iP
+R2WjQfuA2FM9RpZIxf9OrvsAL0IXhzxtlo8rmvAR6qj1Y2zoQ6pQmKoTXKNm2B3y5Wix1aQZKLF3
+H4JbQ4RGeotDvhxZ5an8+dBkRg5KgTNUIx5lCQNNkhMhlb9D6GCfXkj1KpvfK6UmoOZS/Ws5oj7C
+7zjM/43/sNljL+TLqDtorfSx13fICy52maRZlXRQSCSgPQfB46yHSfqc1Z3OylcDJnsqhVOv5yGj
+gcUwl9OeV3eMC7fJH/5ozqmsfu8ls1JXbqui7TJmCiYt4bGxMQchSZ0l1qW8KhSRkmarausUHB+J
+F5HJGg5TQCPNLKxarVtjE80VErcACDC9zfSm6YndmoOe+LIWm6WmcDhofLrhOam4j3+N3YMeNTp5
+i3timLYsRlj6VOYWGUpcFGZMrTnz+t61/qnED21N1tccfUet6qf3W/5R87UaSv3UPhLX9BcqrLPO
+dd7HoXaRskmLypyngc3Nq71WuTJzbn1lq1Zrt2oZWRNULi3GPX3ca1/0GZtlolvLMQkJO0IqXJ5w
+NLNEB2bWfcegzf7S5S/T/PVoTOmNNLYceueRIHFinTg9mZVxvlzjMTMVS2QNTnQzBgY84B3L+L8J
+wuFSsq9pTV4i+oL5WhT4fCPUbPNrbOCoFwXy0VN38Wl74cx7SNPNUapJGtAMxabfxaffxabfZU6/
+C873xTpxWfvwVMTVC2CZz45aKtKjouilhZ20VGbAunkP1RXPETLVYQ52TinzjIJ10fga/z7FrILJ
+r8p0TJIF22THfBnRJPWu52oOhEMESoHsjJeDlu9Rw1qSCakGzUwopiSbaxlqnfR/1B6PvqpAl7On
+XaM/1WrUiGiohsdW43xs1XGa37KgVkjL4J3GaLcZo91jjH6DMfrdxThvLUa7VRj9PiHpUuoIBW9H
+OTbnLgvGhRcXK77PadNU3E/qpGlsK85M08RyrpgvUwiPUuIkuprX4fmr2G+2QaA4ccZza/bb3Bjj
+xektK2t57/HPwVZlNODx4dxMqQ5uMkA1xjwACxSkOBENM5V8fajcdhomMmNWSQ48IU6att4MewoL
+5smsgCv5+lCtAnLMJrKNtwfwjOLEuuDiu2LZl6RtjWmL39zQs85t5JrT5JmgnB+cr1U71oKWJfL4
+lnqjsNZRytdk86NsXNqbnK+pyfQyq5iMBp5AvGJ1PvUMrRq4hVtem7VNbTbfADNXunUrr7s/nikz
+ihOtj0+tTbUuuJgnrvljlVyVMtsJbBEqEw61xIMmqWdq69Kaq5cpWZxoo0GiSY+wGMvKN3LdQts8
+3zbbMN+q1Eo16dUq1UGLK1sIncArR0WL7OIQnczL9k/xCy2gjlLvkS3e944/eD4q/ooGUUM1aA5y
+l3AMkRpCUVnUVksP3WFE5O+atq8gh4SmIsWvIAV38qXkcTzpLRHRsVNeV6k6e+KJxU4qdkLxk4mf
+SOZJxB5LFpvjC/qGCj2kjSwuXwQYBGGFoA3pigbz/q34XS6Z97UGmskWFcAsneiuBiWXO31reIoT
+t7TEquOZEZLF6wh72lb2Iql/OI41EalFcvBNIGvfl1q+c0E1v0vKDsjkLtkkDTXsDrs4IjanLg4w
+7eF+iJW8S8Td78ElSs5SF8U2/AinpQCG/KHka1HbytjY1yOwYV+Pcsgk+L5yOGQSjXjwxcgLUQ0u
+7SEZFWnFJaNeYZNJZsSK2cBunUX/t8eFY6BtZw4vhwRjUUt/SBeHZpS2SawlNRxdU1mS6GluUZYo
+IJaaAF2ccKx1vVaTrj02bQuG/KHky9XrK3x/he+QniOsX+H79hW+t6/wHUcgt5qyfIXv72VUNuUC
+Wrx2EkDrJLikSqGJZesUrPhki5fokC9HnCmhVIL2RSP3ndmmggvLRVm9LD8daY3cIc8CBy7lQ2IN
+lZAvR8aWIRJmlITo1Iy4BSmGd+qTEJ8Taw+KE2e8ZZCTt6cxnA7kcPjuG6i1TXWnmEnJbSpvWTlf
+q2ZurFrdrTZ1R9RfhIz/ywR/6ZZDP5sDInPaukmJRpYg+d7Nae7CnHwf5rTsxJy4r/Ip3A8gN3aH
+fDlqrD4grWLp7qplmehWZs9HpBVYZ5yzh+SBoeAtPrXpZVlI656JNNSrhosCXjVc9KnhggcXeI2m
+kUVWX7YdfH9orWo5XDLncljxA0c290c4KJLJ5tA9ctjSNg64WBZUs+SRe6qj+Bx2iF7+pmPScMwl
+jAplvqb2PaJLGSZd/xjhjpcwtbqjhUIi9Uc5sV3lzF/he6SviK3SHkOkLVAcJv1JA1Nlwbhwm4wG
+orJP7I6/n5n+yoJx4Znp75IprM8w6ZA6KZZ9KupRNPDHdZzbvir9Q0FChL2WF04Z38MJbd+l3lLt
+jwUb+ayH1JUZsYUqk2iMYX+RZHuCxM4JznS+5/Izica06wW1I805fYvR24x7qE/pK3tEf0wyY2n0
+PUo5JjHaRMiWfXuEtyzo5rI9FzTzyTx7aF0WZPzsslNh1E+ocZIZixvLaszxK9y/wj2EEaQD4di0
+e9hlP4pa9j3cc7mdOR0Bb2F+2NxU2mu0zNkW7Rp8aPzL3pC8h5cgVKVqoHfc7qGqqbVE8TtkF4Qa
+1x+a21sQDttAiRrkSwXOv3fRg+OOzwKogLV1ClWzNJf5MjoWq11s3+1W3x33+e54PEEFrFGqyo4Q
+teLQeMfyCEDda+in1mxo9zSi7rnsvGaaHFelLQryda0cn9qH6xtZnp1fSJkcV6UtimVPrRyf2ofr
+kn06FpxOeHBkUTSqhiPq6OkYF8Zl+Ie6plqYdVXlVxaMC39k9/tPdr9Ldr+e3ZuNBYoTm+Pqy7ml
+aeVYGUn4tR+jODFfk6evZe9f/5m8OL1l5SWnUhdkAt5McpzmtyyYr0XhAbGqshh01gOl1EnwwPP8
+hDgpX87uaJtbvsL9EfRQ1QuLEao/vhYyBCM5W71z6oV1zzsWl+684XlPeJECMjrky7ARmNpmXUYe
+/bZcSnVA5KuWl8Mw5aBDY5YwjvLMR4VxrrofHMqGVDe9cL0fopFpBHxalYBRzLm5Mmi0VhkVx2NH
+dz4SfC+YmkBGh3wZVgd3N0BLkDzFTtlNpm0SrhihMZtfMRkdzOnXiv/14n+t+F8v/ncWr2EN43Ff
+WbBNTmHlNWJbWSPOPpp57LPXaLMaNNj1ddhd+Thz2a6O9yTuNeA2FMGcyNNDSQQhLgKMeBQtmkz9
+AkUIbH8VG7VIdK8UVCGahLo9gNgvNem3IliqK/BP28M+nbAojCo5APAuXP5QsPs/9PZhyNeiysqr
+32c2nok90H63h9LvNb1egTIdpFrwUNlkpGlbwiWccbdyXINjL7xfcLfVO2sMU1tfNK1IWdusfLRZ
+ka20q3XZPzVE4rqsIpOj7GmbFPLKefFpjjKx9QXdjFStz6p2qbX4FpqGxPqJr/sZahxNIsptC1m1
+keepNmDKgaKqPEKORanqztWHYr7C4ys8wvi/jaCN4KzDgNepHhLiCHcNRvSuhj3EcDNKh/wHlhGW
+S4PhXNMeRiFnTTV9hfEXx3/Q4PjCo4H43iHer0h4LhRP3Kf4feYh9LhKcZjuVXoXh2F6ysjpmcb2
+peGfNb8sas94pw1yuGOJM2Uco5BqH8H+xS8GpF1Ew4pQI5Fyx/QTMl9Gx2K1AzaNNkvHn1HQ8ThH
+7kfTB2DT0XW8Scf5FdIopN7CnaJBHhGyiUlESKSAqlfSkDTUAylq2p4OiKtnezhInDijsZXjeq91
+B0SfJuC+Vb2MJOi+NpbJP2WN+SkeJz8mNbdYk0CWfQKjysvkS6Ijqxpx9sajPSMMWRwQURFCYahV
+l0u171DFJAxYyiDApNMASBh0tR4Shhp2caBJsAjjKHFRuAXUNMV9nPEUzoNC7TgYIcVJoxK2L3Gb
+kvbKypMIQYh3ClXZlevSgetHB65Z+AGhVDP2T07f3aREI2Y8kM6HmKwOHgUo29OkGnYcwColGjHr
+ncdoZXfa7RNAA1F1dq293BJ6AO8zEBrpMglDqd2kGhBY/2zeQa20hjf5BpQfkzDwgBvSmtNYqOmQ
+V3m3goCol0h0gKlXnYkamTE9ZZIbXw5q6tbvFKw6PaGH/uBSfMBFAdWOVj9Qf0rtD/YwcutTsYyn
+9udTlRmtJY1gmNojjFB3VMPubjhcG3d53x4Ib3oLaZDG9+2BjvtfqCP4+xWeX+EZxn8tKpoGD/Aj
+bUP2UMPPsNxGsudjD728h54eqZch95uKYwQ9IBw5JXV7hogwhcNhROsZ5tke4QpfIX+FPES4hd3l
+4dBIyW3JjbjwMVLj6E85bJVXTMBrQIxJc9glajF7QXgvNRI1gTod6oLH63PQQmraVOwIJUJqyxPy
+RWy7QXdoIBUIUMH2DBSHSdi7nufybfwHDTZd6yU0pSMFk4cDoyguFR0vCBohub74rgKq5i6pq6gJ
+kZVqDyYPgsUXNaS7HI3VSLh0IDRQoYDKRFXUXGV7aDkaee4I5TBJczpM6iockADxv1Pq/07dkHMc
+OnkLvYbfAdJ6Gk6Y0jqEbCge22RSnDjjsdvBfZI7/MqkOHHGzwy0kumW8DWgvKUjljpgF+2GW0H4
+KNlk2iZhNm1ac2z7gm4GlI4su1ZdP5GrIlKq16mNvV06lmSdf+Yot1BJ+NADoSkhoiIM2FuRL9kr
+mMchFwDpbBQlwguLSHnMZoNK7b/aY2MZBzwrNq7rIPWB/wGaXjvIMAifEcyyaTCGCO3q8ihnm6D+
+epGowNFF/gtHrMFplC87DwvZ9aobUtMfXf5TlzEnyfJ6qQJnPIQEmS9SNdkIjb7oIVK7ueLMYSRk
+FRqcbBE5O0IcjAB4wPtH7w8MiV09XmTN8jdgcdeoGdbglFY8nHUb/0pSQR891L7Hf9BgkRqTRhW+
+y9EK5C9kHS30XcVEoCyQbRugHeIeBOGmc4R81wP5Hqp257vcajCZtkkhr5wv11pfsBkDjnRTWRGm
+YPJwUMdkdVGASXT0vie06J2L0wQ14evyZK1eOf53MnNic55WGmvqj31S2la2TYQ+EjzCjqZ5YHxJ
+41+3M+HHM1RSRydMt4QQ0bfkZnXT9R98xGuEvfwcStpMyd5iNdIkeognOfx2MhRkJEe4G/QJ0akZ
+sR5ii/eO9LA0IiYtokJ6Btj9APoU+qj6XfTMn+5japdAnQKl2NVJ5pVJTvcDIeNZ6B3z+gHmz6fO
+jRir4ikIefAn8xwy1MtQohHz9HxsqSlzpQkXGjmV/hji9cDTYTn97yzdpPo33X06Kcx4/zOn8YAQ
+XiKcDGd6c9aii8jZF9XzfNstL0vr/DkaFar+Bgzez6BFPNE7h5BGOMTA5nhULqKZmjhEJ49EMz35
+VjshOpnfr/sVqxG3eNxkplBVoArVKyKEkr9C1n/d3BweWA7KOezhiGFSUzw0vBAKHPFgQ8ZVWM6b
+Xgvm7PdMHBtYKKLJfJHo8JAjLcxYPqPj2Jw7CdWQMaJsk0JeOV+rxj4AXSa2Na/WF3Qf5lMDpfRe
+FqRfTZQWx4gsFFRZ4Xym4ICo0fhwRlMeWBNRouSwlqXeZULaHBvJTUtL1LtteL3bpiqZEW78cR6j
+OLE5WqP5D/NMXpzeM5tSF4QLSruo/ITLQU2PkLFmk7P3h9kbUjh+VcKOWuoFb+ZxmtPdik13LzUx
+RJ2TvZLhGBduk7m9ab6SsSir31s+FLR82XhWGdRXnhCdmhEL9YeQHafH2zModZLHn3USjOMsnzZD
+I6wJGsHTvqBFxIa8Qn84pG1i6ys3V5gMz5MaNUfL4qzp0FlHLjjMSgunvtftyFQoh2+CGjGqlpc4
+MWuLsxbG3UEFa983LT8Iff/8zFtJ1C4SuyOI0cz/xwa58mP5oxOXvxhSC2I5pBYbUgt6PQJBZHth
+PpNbZ1c5o4lI2SCZi94FUhEpraGIjUzpyWzlIJ+Nfe1s1tP4+XOCu/l5NX989Dzn852CyexgUZbp
+Wz/MQ1CTZmztceEQ3YMGenWlTxd1hSNsJhsAX3bKe9Bz8I6ZEOo3lT+mqU956PlvL4eJBinVJAw1
+vBNcULxam124kWDEJeTecP14JL1UOFIOu8oexgmziAYjRflOGo58i85yit7Twa/VjZAbUbRZynFv
+D6zADMREn9ctpXwj1IYs5dtSglDcObbjNf7DFihGxAuXhy+twuuBH+IipG3BkD+UfE3VHtb71Nti
+kJXjqtQP5SPNqqBzUPv9p7zff8v7lZXjqtQP5SPNqqzl0f4tvbxq6ZJY9HHuUtP2oYT8j5qvDwPz
+qqwTVNmwSx5SQ/9VKgfbjNeoCjosvtDfX1iXHxJjMEBNmqCG34Le9xrGKiFeKhuvc/m8aa46MLWg
+I87YdeFwaKRyOKipaDC6U8MC3BDvlHUAbb0W2XmhOkroCiEh3CCqij1VREKtrySbLNjAEL9pP/U0
+hKG8C1/gXV6YzOu5uUvlqRbkHtvqIpGymmQE+kCXengmdiVIzNfk5rim8yKPtUh7omvyktMcX12f
+SqkL0qziIdqO+rTigMSPnhk1Q/TYbp+/zD29ctDl0Z46TjMATYFhTae9vdz5ZkPuvAzBvsdMuNcT
+y34AN3WHtC3IIwOqOo/gHP9Y89GTxFhhDSYbIXlUaSnzEetP3VzpyTyOAMcjQZzNJBNoiG8g5XfQ
+oAZd/XmHKr+QqkrByjwgX47NqAspUWppbx08ONW9tKvzhHfhIvZq7dxNDqffh+DO9v4V9jD+o4ys
+dq3YHvI97Ca1UMe4cJus3dOVcnwoq9/bM4M1BQj5sgvHPRynHE2aY1Ks4egPB5hw8USAKeUM06nh
+hVm6EXJtDLE1+IiSStq5Na1hvmOksV1qaCforCZTeyyYr0XBcfehymLYF3QzIGmol4x7GMcY3qTf
+w1kLG+Ws+MnGfdwC2/VXDUcYKVARe4jSqAEhuK32wNYefrH3foseHAopOFjciLqF1oIu7TumkBdt
+dcK90w91RuNF4UXRyt10v9yk3mtqkyzuP703Nkgv/3b8LNV+4wUeAaYof7vYPbRFv1zDC8aLkhEn
+lpl4ZmhMlTDcz5nz/bRc76dleT9nfsrsE7fU5O8Z8oL0ariaMYIzSuXhBWhG50L5muwl9fXTofst
+vUP2TSMvEX1BZJcFYYSoFA0SAn3phifDVabgkCfly1kTntl2GUhPEK7IoizuvMOyqNOxO1iCLg7R
+ySNZcf9Ig6N7cJ3HFN2As20hyqQ4sU5sjlZKe1BYiof5P9igJ57HUUCq4RnDYzSiYNCUnArWGxzj
+wm0ycjClHB/K6qeDs7airWNjo5dV7d0WtQGwRIQegaYQ3H0ZkjU4IgciEvKOEEzDJI/S5aVkaWdl
+rCKl1kcRbQtshiatQc8iUgPu3e5SN6GIlFYNYiNTskS8QwR5OEyTX6J86m0xyMpxVVavpTzcZZ68
+uP3+U9rvv6X9yspxVVavtTS3G+g0yIjGI5q09gR6tAKCDI/+uFp30IsL8nD7rsJp/f7AUwj76Pgj
+QRr/a9dLs+cl9q80V1LA1QBZsFum7SOfbclos5y2NavNEkYNBKFGRZ69ADRpYep1Dwh1CpLuAaIg
+VEVbAFdXY0qJ8CbHb9HNx/QhoR6ar1VbK4yBbg+1jJSchO82Bd93tfI0rXJzCNmwGWCc2PnRdILF
+6v7fC+6IEBCn4WlfenIc5kMw0KvcHDzKwKIsputSK8lsl0l01CPpe2y4mzi1RO2goIrJUNlGixS5
+BQqhbJAptGS4QWJ7y5hQ6OLICHUPl6OnnDEvdtbENWQK21vgi5Qn7Eo4SRefXJT56ZLJzOj1wEhA
+MmM5F8rXZM/m9fjMaK6kLIpHiUN08kjsgIG/PGDAS3a/H9n9uo9n9+vZceBB251Hp4Cn/aSOEjwq
+QlX+pi3lnLTUv5fzOCRfevG060Oh+0tquItDA9FgagtHnKTGB37OQ1ZGRLql4HBM8thyrpivReE+
+Gao+mS6rRsdXiQ6M7g8JOMKnskZV+VAQVWowqdGzwfCM7P7SHfjy4e6FcfPFUfOVl5/GWLVmqkSn
+OmlGG10mrVyuABjBT73KmcWkmjWg0WxnzsEkIrB+TYhOzYgFn903h0gPEZPDUFNvYWxRwxusX2Ef
+DdJrGIPBOf71OuPM0ilGsvOITadK2lHPmkbIT9jsZ2MYKXR7T3s5zqgBKwVVCGyE/54OiPuOa+i7
+3uba9f8eQw4OYoRh7coahCYmm0J5CT6CP3hEHl/hCOP/Fl54A8OxKUtWkXD9qaRD7qE/9O7Q9knq
+tz0qX0SavEYwk6lh+DzClrROW07mUo4YHJBHlXI4mB9ZPWIteMvVXoeFNMNm0kqVnQml6ltLBDel
+bZKnaf2B8+Dktig+BHxaWv+/ln+dZv7cuM9vj0y9LYoN+/8YPlzkQ4kfWv3UPtN9aNpVp9o/lNXz
+998q/f6fKv3KhxI/tPqpfab70File75eDwf1wMdzDn9rbPtQrEHVINHR8sNXdo4w1s+GfCaB0K3S
+gyPL1oNBA7xYDohGiLv1GdA0bpNgRM1yuVPgxoCjR7OTDWoyKU6c8dyuwb8yKU5039/py0MJ5OX6
+GW/VPL3JUxzMhAzm7aEj5IsjKfCIk9rEcze2bpWvZonE0kh1sLRiYJt0cTjLV+smaehi0gxWxe47
+4Fp6jiqcELo2Y2j9nZv36zIaVAdzt9xsJ2BeuDkWbhMVbM3B7vQK0rpD2hac8SBznH6Lm3s9ApqZ
+0w5A2iZZY7wetcSEi4JVYzZXDpuVM5S/qPcrh7bbRtSN26kQ8oL5MkXcte2TprFPmplxa6r+TtgR
+WvhNKnvgEdl6uvsQ0XrZcY40jAu3ydxDVGYE8+kP2YNDc7LR2JX4oX04sgiqv/KhxA9tTWedpxf0
+vV5yCg7HJCQqPDF2e93RMV+L4s7i1GRSnDjjvSI79w3IHX5lUpw442cGWpVzBH/1XeHhs/FFimOT
+1suERqohT8qXMQYvQ3ElTVTfB7J8FFxsGDWiRIPmpu6QNiI2QanLpOY4PfFus/IeDiMxeknR2T2V
+qvd+FsWyNMmVU2PL552s8LduJta5IaU6NTdmd+O47/xnVcSjADkHa1LlkctoshjyiSOPdCjuJkt5
+IvLoYnLkEgUe2u54zFuFHIRC6AR10BM3FiYP/YkAkxpbag0mDwdE6RM+R6yCMFA0yARBrXfqPxSw
+p3CMy550JF1BQxbzqz5TaTs1+/VQUy7HbilkS2FSdjyZx10OB0uDh/yUrkMmTWNauJwfnC/XSCma
+pKPPcMF02/GblIoSwyQaXwG/XGps5vIG+LsSmA7Eka1ssfSQJ2W3ikNTeuiiG7+Cc3D+v0z/Zbdf
+93JszpUeh5hk3PitxnIuijrqISRsmeUS4f9cAvzfK4B/LgCW+f863V9n3R+T7n/n3OsE+2N+/TGD
+/pxAy1mvtjuE7Ki+o1Pfgx4p94CHNo67XiLeJQcIzfUu+VvXOI3MmuiVNpNuCHlSvpwt4VYIh6U4
+hPC/06gzjr7WlANPSGZ3QlaE2ix3Pqxx4Octj7voffbjriPHneXiEYLjnvDKQzIFVxmkaXw9pH4o
+a1TaPhTbbBrIo/05l7inMqZKnGuaRj/9ltOC6JqmkbscDRv11HPaXU8j94wGyPIqOJffszC62n4k
+oujMDcmmNgkGF+Cn1H0S3LD3M+6vEDDkOTdXjOaF7aLNSJkUJ8547lPlfZI7/H7k/vuZ+69MihNn
+vOc+JSILwh3vNw4cQ4Qs2CZPF6w+EBfzIQu2yWmxo+H9C6jHveCYLDkcJjUpJvE2ebqX1k9Y8Fq5
+Sh1f7nixfEie8Ul/FDUKjq3Xsjs0kIozZVTjZEc6+YsUpGbEJsTvoEDaZRWVyzAbdXGITpaizxxt
+F8+7vMddj4+HYD3ueFyx6j0gUDfCYUTSclN4bo/wHHTTMStxKFI5vJNO+NPO73iX41NrU+0LSlwU
+VN00psgU/K0YY1nQPOdBs2gzUibFiTPei+fSnlK/jH4/Mv/9zPxXJsWJM35mzk41kLKLyeiQL8NJ
+M2W3nDFGAtxkRc36LnXFpQLATBUPYkxGBKpljcHxI/FqRiVT8WScxrUgey8YVT8bBWJQKSvSi2MN
+sZvwPDckzelpSZhPwzdSCDD5c+yTeYYxfXVUu54+nzjBPx8aDJendrFnFryVb8SoCEGtU0BF+4+N
+1LOnLSir1EyxhnaELHDCV4sIDQSxa8gfjh10VuSp2QjykYD6KFSHRmJ1hHlKTtFkMEAMmvOQvwit
+/xiyllNri0pmBqyPfit3SNZBB+4jPRIHMcO4cJtsqaCU40NZ/d5LZqWuDK/lZw1cG/v+2Id1QNFg
+e6rorF3pVrfSrWale71K91qVPutUuteodKsPCPHnsYlDdMJdk0WZ3nXSNDpZdS6EwbK/gntdLOdi
+VpdldHli3oIhcqg59B2L4zjR0+zHao3ixObIHO2XZ43iRPf9nb4o+2QnPRN+mszIjNyFePrsOE7U
+CieGw0+SSo3QxSEaca8tJ8YDN74gaUgQ2dLZkXRm21UgulOckHrkjp1jFQDGhdtkyxlKOT6Uxa/U
+lUdE0dN3wfV+iTr0FXy4EjIa0IH7p+BrlJDRgD6/5oPLZZUalY5OIdGgEpgmHXzTyNFSqtz5UiiI
+Z5DBmHOQ8jWZXXFosDKJHrjaVGgi/Dq3ijkRgCaT4sS6YJs8kVs0mD0PfBn9fhTzK5PiRE/3O/Pj
+xoKy5ae214OXk6Qx6yx6m1aFxlT+ZjkobZMYrW/zE9y0OcB0D21PYSLi+1hZ1z5b8Mz6Uc6UH0O+
++dShUTO0VmCxKGrENsk7RRnyEYZfSxqogybk8l37kUrBOwcDJRpUB/NGiw6yywVnc2lfqHMPW4om
+1Rj0afCj+8coHePCdeXVf2FUg8q+4OLTF0zbh8LRzwwz0a8sGBeuK6/+C1ul8hVUHuEINb2LKnWz
+SijBAwt6/ZZqNKkGrQUf1yDAfpOsbxscXTaJeNJ2cptKXzBfi0KfB5+DnuwRL508YbzpEkMXh2lq
+RtxoiUlfoTs+FHiZS9ZXHwmIOgIOKxJrimmcSjGg/4ELERKMr1A7G+Ulx5mx7TWV6iDNMV+ONJZ7
+DVFWNh99N6KyhrWU3cGSvkpbioLVL7O6fiJFhetsDHz28OABP0T5xWqes8VDppxNwjBv/JObrBxX
+ZfWyCsw1QufF7VdWjquyeq2ZXaCDci5c9kf5LcHhmITYGjY5xNGNGBQNzfwXkhcdSmWmL69lvRu6
+rUsvmvU0taS4YHOet8I/9Hx9WOQzQev/qB/RM6m93PShtg99cfa7sh/6R11ga/KvwXbQYtr/j6H9
+Y/n9N5vf/5MN70GuFuzrpEG4lcpz1FTYOquOJPOTj0Pxz6kM5fibRE//Pd3C1vkDUKvWqGaf3PTk
+TxIpLlaesHu6lZjEkQWcN5wELcHG3+tT7A/M/JyRehsjcmZXTduj5GQ/Vfmp0/1RrRmANKdX6LJg
+XHhxsXaBUo4PZfV7y4eSr6mWSuVppTw922wvhi4Ke4zrLCan3W6zL5p1Slj+Liie7pBJZizb84fd
+N20l3G3is2htVefGF31muO2fWvtQ+6fmkS/pabZVOd5nbrNcU+lOcW1473nyGsH2iLLPm2hD1V9E
+tayn2qi/xzmRs/ieYvJnVlTBCwmZapPWwy3zl3L5Ier/vxhrmP9nJEtPZ52llS4O0akZWU+RV/Ln
+jxYNftIDSv0ObbPWJ8PjWyRaa5AtonozfEtdf2RsWPRONWPLma0231XkVxZkZucRg25t9lqcx50t
+iRuXKtM2ya48XL1MoTWX27ry6ZbfxWI95F7D23M4g0l6nZDjhO/Ash+p2bPDqzYjyzxQH9dLqt5z
+YU9+cmGFhER5DLtOJzELK5M5m0p4Ekxl4BezVs2d0vR3wIbuYQuthfqhNGo5+BBuikV1zneJNKet
+lpsnMc0ic+qhWid3tf+jmndrKcvKjOCkJaEpxqVuWNHyh9aMmaa8JU9i7LllYQuU7WGSp8jyN3nv
+JyPiFWrIWbIrLVnXH5Nc/JD7olgqlz05lUkW7Xd6oSSZEdgWPNhxWAWktleqVtOpMtmjHA4hL5iv
+RZnOpBJDnuRGcQjHgjPeKOfTD7TXeGyCx9ernnU2FxUmatf28HmAa4y85vBHRsT/zjm4kq0nTPXD
+dVFk8nPSNF5GXSbFie7pQ65ysepYKk/jKab/HMr+d9ooVENkv6wS5aX3D5hplVYOLmaa1os591re
+iddCi4r8a33gDfbJiGBo3+FXLvV2WoO7RkfZfReC2eBNDs4Omrx4QaOYHGe61zoRacuJqUk990k0
+5vAW7o9WNhtqgJbJK7Sdi32Lxgy6n+hbf4iN0m3c8HjIyow4b3uoNra3a59XA6ah1B4O9B18sH9B
+axbTcIT2h1jTDEyOVj1gyB8KN7E/Lj5P0lPnihLomMQc69n6JI7a/dzttNLP5iejfr0mNCOvxZlt
+iv2Wg5dxA9MxqRmWfRKN3iWX3vhO9TR6p2Y/7vipWxN+mPr/w4ScfnDUZDGBA4+3fXr5DvfQup7h
+C3dtSXaHB8+wdM4K8EqdygP1LEcqlGa4KHaTxWOw6TUg1K2vSf3YuzW+4S77kNispln+YEAbi3Zf
+4Thv2knO4wjPNADPpZ18Ku1EoLtNf2v50DcsIwEjzTtps48LtSRGl8pizzUbIrqcLvNlxFXnv+sL
+5doKl1+rXbxUu/T76KNNqgyJdr1eOegYc3Xe5QdoSnye/MAn3FSo+YcPMVy8+Pgd/2FDODx+R1VL
+/gpjKTgNoZ2k1B4QNhVjI0sXfCW8dCkKr6+Az7G/8GSaijZkDtuDP6wyzuUIH5IiCFNgowZEAxn9
+GWiH9iusB7Of23liH5eAXUzC7t8ue92+wmtkMRzGnn0JPqb5EkwJX3hv/oU35l9yHJiHvqRqkDAs
+GrEK1FKn0tO3AxLzp2RA4hCd4KbpFTtvWbzG7dIXbnWOZ8ZTMGmGzaQa8DiYynI4aJS290NXfexL
+b/qkedsdmlGfsbOllwfRJzdTtNHT+A8aHBpibqDQugNNI1OMjX4j/cWL7hd+9JyfHcL7d6+klvZA
+CHf7OIjR6CpZDt0Vuew3iuFxFBlVK+N/C/l6NVmwOWPjnVmxstVLv8ZllBdcPfgIxlh9EwpVc6gq
+Es9KjkicczpC0s5Rci4U+FyIIjIpdx4Fhp4eqnml4OAmcWoyKU6sCy6+E7EffPXvNRf+Xr6S+prr
+qIZ1wcV34swX23IemtGeNm7snvTFhld5PfpD7vuCNF8ZC1Ov8SC3JXuNb05LM9TM/Vthjojnz10p
+MXmTPXygHiul9dCY68CL0MXB4jpbmhgXXly49ar8yoJx4en/6/74AsCgKXF/ZXIzZYK+iD+ZEfw2
+kqOb2QA2w3Nsk30zqcQP7cPRK6/qr3wo8UNb09lG94dsNqpQuYj33cFizeKG0mRiWpBlm7LGsORf
+5PaLzEYLvvQw5gkuLHis3KDQVScUCsiWlC8yvUtpYRKNEPgO9usVaugmt0d6rtxMORcSsmSTrEsV
+r0yVvFhXsyU+ukO+HDnmvULlJZajmgUtJSHj1quiRINKYHEg5C7cbAl0qrhoc7RYy61abriRq+T5
+mqWJyWjAaYize1YHNxlYvhkLuEbNsIsTG8V4uuilw+R8rRrcjkjBPQ+yONnszaGpXqZYSqkO5pg2
+mZh6+lSWODwBA4UbfMT018FdIWsP6ZgkcbIlVwXefE3WyI3m2v1nGFXp4mCu3oJ8vdXI4n9nVr+e
+VQoPCK0p6pvDGU3KpDqpGTLLgWZr4gATdLldkPjU1iBGt3Bu9MHziIM6BXoLnhZ6veyDy0Y0rl9n
+WfTLtSwT3Xr0SZ7Q+qfxEvGWlWc+ulriyATcjsRWS+IRlLqYQ4hO1p5c2nm9WMzFCDwaBCnRSSY1
+xzrJjUs23J75JNFkdwfsofei3dzu7xlJnGxZq+I5utamCkQ/KRx5eD56FevwfDyGkC9HG2F9Ecsx
+5A9lprFNBWrqGlILeZIbxSE6zSRSJ02jE+tORAWqbMl6IHnauywYF26TLVPxp9YWZfV7y4eyFuPf
+7DRDqSt/RDDZg8MtSFauk71yDxv3FFvyLVatHB/K6viWDyVfH+qs9WKw1tE7sQvGhVcXTvRWdYm2
+TbB977ve9+zcset+LWF7OCwjDvUsrkicWBdskydaUeVmvWOQtSCZ7q86u5YqW5Ilarsmd1k5rkr+
+cPMWX3T5sKyK1xZaOT61D9f3WoVSPxQ6vkN2MJOYjAbVwX0MvD7vZUf9peBLAJPjquRr1dqieKZ/
+/XH/VftwfcunhlzV5axdDjuBubJG9ZVHxNjSmrbSTaoxZYSqaG0qx2K8c/rSR5S+Ai7ssfcwycfv
+hb5GdjhB4B3cl31alzBcMau1Ca2/ffs6x38WXbP83xk08N/5nYzY7yrtoZTP4+6ASFsPd4T5CGd3
+gIm/vGgUJ874xPwrHv83gkNNweThYFHn7uCmCZYDfzb6f2dAqNfDkDjXgC3y6A4wvVM3qQbBrxcT
+YCqnS+SYvqWa1KiUAwRbDu8MDllU6EwT1EP+CvUr1DD+bxpI03mHUVNMgeIwSfsGYVOr6oPcoEPP
+4IPaTtlNwjkLBVSEmnqTnFQ+jhQdglMjFY/1l9Zq+A5azr0cFLh5VMP9DJd6PE5sc9YAS3I15FM0
+xR42CKTat6KihEMTHZKiyQbQ3VLDcWqlX+FRTEozUqioWpeqJZ4bf3rQUb1+wpNC425SR5j6lA2A
+bwIYxYl1wcV3wbOuPCP0KAFnA0zWJrs3XtEzxkiwarPA6n5dJsWJ7tmXGmAonbw4vWc2pS5oLkiI
+qFIlZAdUuVTRx0ONGnD5OfNFlQ8N/fhDt9QWQ/2depAFYUbTFyxrK8DOG1RG8K4l9MeAs5/NpEZt
+4z9EoYiUDTIFkwdBm3VDJ950uFSRLwJ33xb0li0+YFq3hwZBgsnDoZHsobt/1PypI9NHkBJatx8k
+h8EfqvxQ80e8tFVr/VNbIz3ZOINdf6GlzeTMOG22yQ/M6wnRqU6afk5s0YG7g8d2B5rwtrpR2ibD
+4Zbao7wWrGn71FY/cgkV1Y0StnIsWK9FgXdMZZMWjkWRlel12r6I55h4tpWnzyMtzLLOpzgg0voL
+G1X4Q9cD77jjacj+IQd/SpSMnLknpHpUNUkHqmx26dcrZEeZ1By9y30nvZIxgsd3KYhNt8AaJ6F4
+pbaVF+fSH5brU8+famcGu0RLyS6UXpLDvawsbdWodNn6JNybWjXv3G5p/6h/pr5EccRbtDUfDIBT
+sYQ1vGVBmt+WlUW+ufPTW6qDOXsqb4q3HTtKFl1ynwQjq+gH8TH7p97InATji6+WO8KsN38h1cCK
+4H5n1ZP/tgxBPsSo1hIG0j4OZD1L4o6KCvXo9vK942JOYeHWp6IldNyMrTHcUh5S5ydRDu1nUQ6d
+3la82FHtpY4as/SHHndRz3/4OH6NOPFEnm9iLQ1jgfb4iA/IQ+bLiI3rrAWOjdETAk6rEtQYfpKe
+jOT401UEhBunR3IECjvRGSLTo7SSHdRUcqAQykpp0Z0RJmj3E8pyuljPFX6ikP+d6aWFqLk/Bt9D
+RDAYjTtEOoKTTArH5OaYFjPndXdOnfQ4x+z0XnAxQ0DsgdwxauPQvxfcRxtwqUAipDg5lVbQ6BOq
+Hnj3UyoFvJERngir95OzO4BNuRatrepbPrV8fersL/9YZNpADGHXLC5swZVpy1lFxaGxfeEKraYQ
+tXV0WZqTDDR1wszGHsfj9VtN2lV0xp6iXacMulRmCFY76RmaPjwh4keGaiqtj/2GiUJqeihyisBJ
+gc0IVMLQx8BykvGB75osoz6/tOTaNbkvmLYPhcOzGSyD1wMXvKqY7JLZQK54lLZ50vll6o9dWA4x
+rJw/lHwtKrOfWlvUhZusHFdl9WIjQvuVleOqLGl+mQYt/atBOYLJw6GRkttY3f9OdR/XXc9wYFwE
+NCNALRiUnpy6aQqN+rGukrVvZNGOmvGwV8X1+BB3vWdRcz+P+8hpH/8YbIbIQmiQ+I0ZIxjvEmM5
+FqT5AXGETYFHNoAHkCvn7hpSH5ECxR1JBU/+gOjUjNDy+43Jb/hMVt23R0jVASbNGJkI5n4qDwd1
+00Nqx2C1f5+ZQtUHRnqVakjWYIk6qmPjLwADwu4D8aAT0LBCbKTGjMFgz9IpoJYQTdKwPU2qoaQq
+JmE41EHPwnth+5UqpmMb+Us2CmIWbEcxj0vFWSmY02lZnZ7XWZNHWm6+l3jqH1IzfKVOoeaK6ZFK
+NSDotSD5SHPovb+qnfmQ2wj16YF6FA10rnyc+XuoRU+nZWeIV6iMsFeoNCBFf0gNLzndHwZsz1Rb
+/0f9iL6oeB4+cEFJfTLHfyhKxwh0xCqndu2Cz8RBNoBQMF5oRmvrtOIVDu1FL7nfU3EYjnX8hxt2
+RdWbnrWGdD9MSjRChoo8pp2bKYDjLiY5KVCOBp6c7Kmqg5sMrHRLiVlmDYhv9IPtYlEXM7wssc6t
+qwQuWVU8YlCr1KPD0DqFVVwaVogU3CLNqDqYiYWBUEt+lJNgjiofwmGgPq7+2FNYcDFvC4b8oaCI
+FHQcr7zyUdkAQhEpKyWjWd9xobOLUV9I/cod9wSM3NgdUJFyx3wYEJ0sgbVPsV+9doQHLEdMrXlH
+WVQ4/SBk9j/3GhojHhLiJBqtyJ9aSp/EWAr8OHSt5SoUI0IPLxxcTTjyA6YJfkfIJs3QxYGmtJk0
+Q6RPeV346lZtdP5JumbAq7vGmT0P7KYN1fhkOKGBVIxfdudrWnV49PBXqj79UbWULt9h6w7N6dTt
+6LKnZjKFSTDybAHIl6PFigEvl4AyyePblqWW9n8MyQ3jmfqTGkrCp1Jrx4l5CFTh++TjnI5/FkYR
+jxryHRv04Es5Rs0xysJpMolfO3Q0s32OEEqJk8JEcx6rMbLyGpG2RUGOZ6XgxqftUZ4yqX35e1iQ
+0cBi0HO6PiN0M78zY8IPrE5dFowL52tRrLkXVVbDwmvpPPKnsvq9l5JLXbmtCqoBX4Ebhu+erGcn
+79npG1MsQJ00Y53YtYl2uTb1a9Hyqnhj5OyHALj1lWfENWkPx6IIFM9xadgSw9avvDIiGnPg9UK3
+Uht3ZbPd0djrl8fFVfE647vPCmrgZFRlvoyOxerHminICQF3T7P9o6sH/axliJ9SnybVfUSetzDC
++5lV7hqM2POIenn8Hmkv3eDL5tYXtns0TfsKLexhhAdDNb00eHyFdhv/gktUgkxMikWDgVuoatok
+56DyiBQ4LRpqC5pyOfZJebGar1hmMhNJdTA3PYKUaMEjK0Y0sixmzuf7jHDcmyZxsmWuym7ZuLbk
+BtQ+BIkuBNZd4eiuCg9sU8L9IILGpIRQlVJvyLt0hGqt6WaVr+nmMhqYE7ejpldn6xCb8xhg7K28
+9ZU88PDjj1RCcgQw5TLMTiP2Huqoy+hbj6AXmJDq9cDnJ9rjduKj3O2xBRNNZdVfMW8P0UCTi3po
+o+m9hfawn5FrD/z8dHvcwy10QNeIx1mbGhIS4qBrj/QM96CgDfR4yhF+0674CLegueVwUFYKMZ3J
+8DW19sgiELp5e8Hb2vx19fbQ86MK9e7hQOEdGfdQS9emYmt0nbtDaoqz3sKR9O5Fe4yoFDS4FxUH
+wqaiy/YApM2kxkRBqMqesFVpL+8hDp0bQeaLZPsaPKzP8c+DXD9L0Z7asE8cl0/5QYjRsOGDFW0M
+ZW3UHc8atywyxp491PAV7FVOyOGpH3pvw+MV9P63GJ5tkpB72GWSGjdNL1unkEhAdV6406ISPfsl
+GeJAiNiq12Dt5b8cC7wAHG5IGvsIuZuU6FSNWPwDv0ytX+ZNm0PIC+o+eD2KDvAEpLn0W2KE7HT0
+BafnpC4LxoUXF6sglHJ8KOqH+qJHvVJM7SlGvYYF4VBZLwUzdXGITh7JOqTqNSCG/KHka1E9damT
+aGRpVpbQw0pMh8m7gcZkLIgBxkm3vYZ9PKYzjq/BTceJFlKm4JisePRJjSjmj8IGIWpHKA3JW0uH
+A9rLOa5KWxTLk1o5PrXpCjpzNynRqRpZbmfmKcIwX4vC41xVAGIvZnkxQ3xWc4DlqwNbk/2Ww10W
+dLMbJTrVSdPPKS143A0vB51UGstk/iDG1BjHsFOwHgcbSQ5rI04IGu21UzBB7R5h1a6etNqOkup9
+3DlfU/OEb5k0HeaumJqYDsnQhPVVwQ+hAyzSqme/ge5oDqU6wAQnxr+FR7lhXHhxsWKglONDUT8s
+MkCqId34jVzHOJlZpGifVCBfJL1yNsqL1RovfdxWbU3X/lRYcw30cu7LocXlQYA4eFx1MBO33pcQ
+Hc2hVAczQe5zqEn7bI99aQ/wdLe2plKOD0Wvjj719mFYNfTA1BJEp+C0wLA5T4e+GPGE96pZJLeg
+8bhJzeveZuHM6lcoIiXNTKFbWTaE88eEqWUx7AvFiW1BVndqM5KFFX/af3LIn1q+Vh3XB4va/1Hb
+qi/KW1ZeM317z3d9xpa6IJwO220gN3YHM7GNMLwVG96KDW/FB7ViL8Y7NrJKjTg56p94ShJgqYyb
+KQYs4NyXPXnutiPP3USkrJRIv3tyrkUTGWuXSIbTjNY+qzUVCPGVFpZZeY2jZKOK8R9TzFpNmoHS
+Klq9oldNweTh0Eh8dqJ1vIXdOi707UKjY5Wz9YDnJAgjNXZel5ra00GiI2oCpv8BoQ/6EkJ2lOBI
+fxUPzqYUNoU9UDTK7hHzIpRa+1p/js1xMbd9ZURoTZNuoO7ZPo6ph4PEic2RW2ycL9fYPaeCRMgf
++6fj1hSkOFmeQGZ5YuWi1xCjduBewz1kh+TUnE7iuNcNoj9bq7ozVFz09dGPxNZjTLsm9wXzaod/
+uaX5AM+it/6P+uF+Ucmyy4L02R6yzwxVa/1DqdeHaunK/sLsu9eSw00mmbHgus1wmmd5ywOtpi7J
+vSYfWzU3yb4DZEwzHhwyStvKIX9q+Vr1NhXDsktdcJrTtmDIH4rnyrdq52O9eBW99PkrRP9a2r+m
+tP1r8XyuNouG5k3malv1T+UPtH7W70k8RriiT2hGAIxiJ5bdFTjEGooplVKrdR4bhvaTK4l6GOkX
+dtqVM16baJd+sVnFiJ6fe3IM+UPJ11RlJoH11e1O96J4mlcvXSY1x5lgcT/m7yebgpnsVFbH6yv0
+r9DD+N/CrvJxhvxSyuFAVOVnQQfpmgLALJfKNMJ+IqzPId9aEchG6CbTZqRRzBcLEd1yx4fW+2AZ
+/3jnFnJEyP7Cz+try/RHuIW2BSN9BcMRDkdoeuO4PwS1U2mGbjJtk9C40OB4BAo5kcUhECkcDnSF
+qPNnv0xjEXjEgtDLaR7LDb5VT5+6VRTLo/2RKrYhSxeTjZA2B9uk7GvP5Ok7jr3+KKNtHz+hjlxT
++AlDZFXao5Sv0J+6TKBCq2C9ZkgJZpFGSJsBLAwRL/IV+qiMXmb33X+TyjEu3CanbWVunevqOIKS
+M0JV9i/77dLl50oVOTMmm5UW+07oZIsQk9GgOriPgY5iSqxH6yatSuhuZf5IwmSLEJPRoDq4j4EV
+qbpecKhgDZT6JPPyxji4jN/t8gSfP+t4PXEIzHuMkIhpsU0Hq6AjT3lpgJhxt7YPQxU9BKqOB7hf
+3nE3u1e719rxCJwKdS4bPkFmlK/JGPhXTUzvaSJS4GQGkOjUSOaExamBOk0m0KsMOf67vkSsAlce
+ii6jQXWwVGKAcgaxbQ3p8KSY+T8t36fl+/R8n57dE5Z0UCxvAi765VqWBdmiVM0pSp1k8axMOjxy
+xlll7L6ZozlAIiFSyf7qJiU61UnuxtwHwnZEClycOTbnPsmMYolEHDyuOpjJCtUJ+5A8bkkhr8z2
+NTUvmqd+y6Tp8PZdMDWrFr4/ahQnznivpX1/dPJ0KnVBmFunsP4grc8cyObKopvtnebFNsu7LTmr
+1GMPTy93nox6ytCqUERKxFbmynecCc0osc2N8zU1DNhTiR9aW7WlkITfFFmU+KGt6Tji8sTWtZ1K
+PeRyaCQ7AmrYXForK89o652DJRqxJMVyLOi+7EvEfC2K9aZFFTeUOsmNgHQ7Zy2HslCcWCfOdFZh
+5XKsHPKnlq9VX7J4y8qr29tKmnmvOXs+J0fC8xCKSGl75MS43k8br0Yp76/QfxI+b91/ShmJ/n4F
+fzONMGp0foXzJhU3J43awDr6wXk760ExrNsTN5MJUhfUeGwQpEQnqQt+2LXarnkei3nYRooYeBo1
+ytdk9JFVE9N7mogUfxHCLKO+MWkwTzOmXMAsJhmHsvmVYQKiCsJbKc9JjMITZkY0+gW9KbJEtL7y
+jEDx9gPPjs1ZJ53G9MaW2ltjjkxE0cNLJsWJMx47J6byTu0MeWU4odKdqbv5d6uC9w7gGs8M9OAZ
+UKrJFLKjNMdzX9DNDth0tFwNGmrHjudNKzNKvktQ1KbESsupL1yMUJXUR3XuOOogeWpZlLZob/lQ
+PEqiU53k0WiJO6cZ573dzqqWsyJEej6Ar3AwnvqlonWKYPIgSDVwHxz7oBSOlRuVbNnyODMUU0zq
+8Tk6vnZ5ba2jJ6GADeHIUXeIXiHbi6FnDcf9V25Kw7Hic6oqC+WJCL2lBhkNLAbNUqXs15bGJuF4
+qHiUErIBzhwcqtOM7GJY/PH/s6Zvwa/onjXdkcH9CHhj6awFW1ZqEMpMqSnO5znc2vb4Sf1X6cwd
+JRnGhdtkbJgp5fhQFj/0/PGIg5hsBI6ihvlaFO7fVUW6loRC1S4HBQ4NkLZx61LFZL6MLOfB1aB1
+kg27YEuU2Tq6BK4CPmhOlXiGaPLxobi7OE33xXl1TUd0nMYuC7pZbx4QX6k/ZMPB9I+l/WM6abC6
+eE1mPcrBB6LP0UT6tl/aJoXsLM3wAjzN8elxT42SbTnZUEOEDi/9IVgBMeIhaVqXDyV+aG3V0Emn
+Wo5/1A/vUj+1JdK6hauoD1PgvSdAa5PEGKcMJdaoVF8hWTVP0GRSnFgXXHwnLvnbT+Ssmi5i/GNo
+n5ZF5elj0fL1qR9ze9Pny7C0pYU97e/Hlv9+bvmvTIoT64KL78S55WbDLWZHNbMBbLO5jERQU9Hg
+Fh6Hg0yEyy3Ndf8P9TM6bf+oIf8fQ74+TfKZBWK3ECl7yFdLbdX6h7JGcdvKJuMZOYy2veCTHAvW
+lc2nll0WpDmHt0yKE2c890PZSs72K+Cq7i8sty5K/NDaqrGDlK0c3+l+1vB/LPKpxU+1faizUsd3
+qvvK/2SM1zen9k9Ky7ZW2frKEldtplJ1ScXz+KLFT/XT9yOjvNbVdSawF61Pf80a1B3StqD3vA2v
+VyulXSa58TBjabSdsETp1gpAiYtiVadWjkVDPpHjokKWuHBdeXpnzxTPtirFsPUF07Yo5tO2gh87
+OXtZTjjlO+VM6CabgW3PQCv4O5+lStt4xdXLd9nONkniZE9TRjdbsC3shUCzNPew2wF7Dzsq6zdH
+yLapdznokGpxoB9+YNDIja/HJImT07bwcZ/KkpKFXVbYZYU9Qn0BrtdRrKqqWF3T/UjeGdL4YTqZ
+6OcLU1FSOvSHWWbvzyVEdpFc7tZOc2ScQ98e7ktf38NT6qRG7CaDgThEJ/f2rPtH1n4C2edhu4wu
++3Kw7vwavGNceHFZs2n7gtPnVxaMCy8uSzazMl6XcsNluGFceHHxTPzXNBfF/GzGYDfWjZqjOJZz
+RTYj7q6f44ZHLn0SXF8tSXQy22XSqlhDTEsfn6rEfwxp+8fQ/tGRd5UN39ycbBH3KvzNH1VfEvqC
+XiPVmLm0VzmiZ/eWyr5Q0/Zsj/DzoTBR6dYrgXGy9TBTLMXpCU7rvQztLN78JN78HN7mKbw9bI2l
+l1Z2DtbNZgbN5wV6DeWvFn4Y0E7tvN+leU150Q9AFvzpJdIxidE1HG0MkVL/1ReHFx4T/1Bn+0yb
+xH8MzMMGI65wkuqk6edkDYSPcBEQ+4NLOIDUBWe8JR9Ma6k2SgHNfDK38ct5vlF/U7ShBBgXbpOt
+nKH8yoJx4elvY8a5HxQhO+BYPXfsm5OX3m+pd22/saz5N2X7xRfypXQgVHPRm4ST0ja5fYX3V3iH
+8Z8ywuXu21QvU7JMwmUJNXpIhNQNe+NNgXfI4dB7jUZx4oxnkjya/K097B1q2sRkI2BBwzFfi2JV
+qqduU+sI1dZDpVD1RAlYoXpvj1Gn+PrSg/Yt+JzCuNDKKu5CESmryUagxFbIARWrbW85ThNq18T4
+ggFkNMiXETdF2YCZ80dMCYg8TsjKvKr54kc/36yoDoIqWFKbBeHdIIKZ0BwVxfEGi/0EOSQz6snj
+rGhWTeMVWx+7ZUTewzCk8R8OhGpgb36jK7/xjLOKCIm87ZFlAlLoRRAkDdZtFP86wLbhl+DfWApM
+C547md+zm5xa/0dtU5eJH37mpVtg345689tRb3w76m3fjnqn73AewUGLvx/Y2Kx2PMAA2Rz+KGkL
+qFF/0hXP7LiCmHHIly0gjMVkA7BhSSGvnK+pqfs9xKoZ6MaVFNlBQBdJJ/FGDShMINUkI7CD8SDu
+u6Qaz1dJH/xHFfZWBvh8z7sc+HjEuxx4dfBdXvopvkHn9qCQaNAILPVHA2z0j177vPGJChXYoB9U
++ceSDKfz4HqyUb6cu3yFn6/wE8b/LTxH7j9h7LmfkDpCiZCa5wCNfQrCg0KiQSXQH8Q0ByUEPfaz
+n0O+ziMNWSNCMSkmG6GapIEZ1Qgd/hw8jJC4PUZ4acD44a1FSbqPWHUUzHEg82VkSYz/rAoTjiMb
+7TL43J5f4Ue/JDFCva4FtE66IJn38jWJH9wew978Gfk8h/XJJnp6W4BaN8yXETN11mxHXfKAUUns
+Oe6tIVxXjxEU3dkFG1VG6pq0XH5s8meMh3+/wl8Z/3EE6OqQbUAegT6u9he/p6cjHV64/MsvHfxN
+quQcKISyIlITjHr+TZhx/F3uWU7Ol2mAHSEu24zczS7biI0FLNdNQ1EHBPMx1r9ZNGga9iriMEwl
+l55NquHQQGtbDtkdxCS8RjjG+Ss8NGgjzGGEveQcHpNG1Pa8Fb05fskX3wu8Rk5Xloa5woUXg1WM
+iDr+2yNVcRjms74lBoOxhdd13lL8Cr9f4TdkCRRtyEO1I5k4hvyV/BV+RadwMqk5jlx/pd5C+i8c
+C6aV1b3CNE5EvymW/rj2MQKPXNMz/ajEnZPftJ/9YTLkSfkyHlmmsRll/Ov2/pb3V/itQavcx79s
+CDXTLttw6lm1M8t/xeSw6zn1V9cZb1+3PzFcX7fwdQv6L3GEo/fcQsgatBFq0B8IU+tft3C7hbuo
+TB16FopIWU02AiXyv21lew6pySQjVBdhmPT0egu3hDwTskxCIyqSjhiGLOWg0GgNzvZ4Bgc1XRp8
+61O1CyLuXotJGB6lRAeYWP0rtYfJfBnpwDU4l8NkCpMW4+GOgBY6ZHLZDLrUBacZdRmjgooa05Bb
+yOGsErp8ag1qDd0Bpkc4Qp6UwsrHh7IkIKYN8oQ+mmJ7aCC5VAe25FSaa0ocMEHJ5LhSJuvEC3hO
+gO2pwcjOAXZ8FmvQTUJ3aKRje0ySuvLiIs7RgabUTYpDneRe4sRdNrDLASyhxkl0LYc40FQFs4+h
+bKF1B8RvDz52SUV/GH5h92LCcT9+EmPLfnOQ6OjZ6g+Rgs7aJ9FYx3kaVFqbJHHhxW75RvZWhejU
+jNyvoE89Yy0vh5fEye5aywvpGcs9Y7sFHzx3MmPODhIdLdPB5lnFITp5pKfI6eXA2MxOeQ/7Xo4F
+EX/Hlw0nyodSP5Q1iZUJDTGPcEQHWTFfU6kLznSW32Bai5iEwVx05jUgh/ZwkDixTpyeljwLe0tO
+351wfywkcbInSjxGcjqeDpZP6w5moqWE6CAT6VXuJu8eeb97mcW2s1huzH4P1boYkObCipRqzfyy
+Ees1O+drdtDX0klfSxO/9MFuJYkhT5I42Z2H8hHzGcWMcjhkkhu7TKKx1O5g2fJdX2N4VmndgaZS
+dgeYGG6h24hJlrhqlj1VS4XfCLU4G9qbD+3tYd1IL1oNGOk9S4nGrL/quPIS4UV5725L925Lp25r
+r245RQeZVBdskyd6Bvp75E5xYnOcvulwYHQPt5vUlZcIT0jFo+5ilOokj95fk9x4OZgp9e2xoMRV
+WZ1mPcrL4eX+y5A+FEuJpxiNrfgq0hdszrMyyp60lqcsGBeuK6/+C1vNeshPB8T3tD0daKq2O5Xi
+xBnvOVYbJZQWZ6sZeSZ9fqSF/XxRUIX4sRkHr7sNDxuGlytvU43aw0HixBlttfgJdmgr0SEd0UEm
+eqJkp5ofzhgVpqnt4VgUzqEu26DLaorzajlQBZt8bz713ni27VKD7Jjz4sdyDPKkfBlvKc6IjUe1
+sWXIi/pPLeQP/R/n1v9RP6K9+PEl+bssWrmlsKp1aq0HXEC52j+UtP2jrtupppnYntycPxlkNFus
+pDgJSS2ysvXPHCD4k0oLCzXNemQUMU7GiPNJjEkoGqR2xBhHpXQ3RO0LUZsn4twd78jjzvPKAPS0
+eEePjHdkdzdzOu6haitGHU9jOtDigOjUjJgyY6KlUuO0MSO6a0RHjXs6egGVsz8cJDoyO2XNhwfl
+PB7jOQJdMrwFiYIBATDi2Yvlgv336xa+v3Vjvr+zUERKvfMxsZGrSTNQonYA3cCh3kOXnGXScL7r
+1ccd1x33sZYIoeodbaoSLQQUR7hpNe/Yi3fusvs9CUWlNLP2lPs9oWb3xKYjmZtuwv0On+unpuOJ
+yj+i9rz7K73EZHVoRgoanNK7OMCUyhAcJ+8/uIJVOeKH8RH+JIhT18UG7oGCA9XABukGjlwP3buP
+GnSXah/R+QymMumpA01CELAuk7C/UxZqWteE3ZyyXBSwpu9vkzRoi6WcfnBOIyGSHSjhaj/lcnaT
+cGCI3TxAd3LSATvV9EwqYTsahd5+uAWdsidMOHltbdfQqWesGw7U8Hek+C983YKe03HOfMqjnA16
+xnU6jpencAbBo2YIuyZ8is4onozXn/8ygIlHH0+5dvnzzOGt5beHSHtMQkanBofut7F/ctiYgNQU
+n27MY3dOQvSxicnowJ6uSnVwk8EDl0wDUfMcjtQTojEy5VsJFGrewlHKoaYtn/0BKgdW5UCNNBpN
+GzDj3J4xhg8RcUAYIgnH9hy/OR3KHN4zJwQZo2j27ZujYI41bSZhQPiTDpMwaA662RKYBoWJHBTq
+KBikVOLQILbufBlZhWTe+rgFS4WGh+m7nGq8lxE+QtWupWtcOd3Oo6MrpEO0l/JgG0LnbjqvzM9w
+UNjz1x9ac1UmxYkznrkP/v3I6Pczo1+ZFCfO+JkRbPR6cDc/MTgPidZ72mCcn5YUmraGNoFODnIO
+kUJMSp3UDA24wjqQB7TiRaDhgMDi5wAW0rpJcQvrl7mylvOtXCZh4OI1ACYmZ1WlpiNMogsrL50C
+fTELi/F1qJzvYRcHmlLNDjSVn8MBJjZ7tmbPVg/bqFSQCx4hd+Lcj1pb8I/yznyxzK5SolOd1Ayt
+wIIPmk10DwLjbAeUbtl1Dlag5sh8LaNuCV6hPh0Q+dJzxII066/xO8HIVQoATAxZMx5HuVkjN29l
+O65yQysyfJTiINHRnAcjy/EhykAqh56Ps1ZmZ1m7FbX7cbTPSU3e9RUpA5gQalaHLNcgprX+obSp
+WZo5689WqXJglpBrODjUV10xyy0g3CnEdGx4C3vaDA6mQB0bjo/+CL9BN5Y9s6e96Fk299Q6DuHx
+ZEFzMCPCxo6g0EiV4wgxLqwuv2GUtYd7UPEcO2y/JQ1LoWiUv1kmxYkzHps26nPgPghurfiXNogX
+IEMegWJ8VXbBRoY8mzbLcasJFzmH3ie9hWObn6VzTerKmgM+p33DdQpumx0RE3GV0aAaNAK3KrKm
+0eoUw+EWNyH1rfw1yWwknCYRxQJZ3Dcu6I6YtlL6JCROdE70ZltGHha2gKCSMbT36r4dzRWTZdp7
+UsgSrBpAxO+BXcrQzF0cYCpZpE5yYy2TaDxEh9Aj6vfLDJgPQ8yVD1vfOOLo6XES3bBgdsST2/6j
+P63tBK+fFPtjEoxoB97M1FH24OznmLOfY5n9HD77wUh86DUCkt9DxnGq9KOA3cWuxAu3w/rR/Vme
+KvU8/lgwrYw0WSigFuQI5VR/BMgncVVKoRo0Asu3j/hNpEO3JLav/wt1D00nlMd/BSEGTgCcsGv/
+Q/vplj154XU8Pw7Np77WbqCpnhvmIgCYsAW4TDl4eXJw2nTYtcbxPEoXB5hqydlBoqOlrQUnteNZ
+z1d32JJM63Y5Wg7nq3sO5wtbzeq3jknIgfnAcXA54jhcWASXXgaxg3O+cBwW47tg2QcHJlsq9XsK
+ZERq+L9TukmJTnWSu7GkgW7rMkmNCB4iJtXQw05hqj591T8URKFou52hYBZWAIQjyupnlesnQjVe
+aKqLtfoNiWKo5RZuFKr2MpKOUeH1KLt0Bz11vF5NxXB9dVwsqIwG1cF9DFC3l77RQRnypHwZW4Ju
+Uhw4FFOxFJ22XzEZDaqD+xigPsOhhttNTz5cMq8hYuZYbxrgt9adGrEQaqCUnA09gb19uiptao6b
+A5tClSaT4kRPxUYF75PcgXMGsGf7K5PiRPf8ndmW1mybytkmeR3tGW9osMp2ikN0qk7NyAoyr+h5
+SfzBHXJDmjOFS7NTspBqOVYvolZrDKlW0WoGLtEY0twpTO2oUNrC4YC4xBiWYIUmXJ0POGvosjI8
+cXVfb+xFMJ4R2pnFJCKsTTZd4SDWkhdqjrRKxvKL/ii9SXjlcJnkaUQZkSWKyWjAmDKEGhkXsRRS
+IzoyZ1M1lsybOIbNudQFpxl7JsJgJwdstPBRHQAKZmjrP6R8TbYeJd+YFgLo7Q81VH1ok5iFK8d6
+SKMCzNJz82unytM/Rmj2U0x66rcGyOqbbfKd2Um+kaWukdY7VmNVSnSqk9yN+QykDX1HJ+KVFb/j
+nFNtebza4ni9y4v95U6XxCTJdBage1kv5upDT12VB8nD7q8q0d2OGjzlD0RMaj2YhL3j6SQATHrf
+Rq+/K3ftU+RlUuqkZkhgFO0s84lF6fpEI+vJvtKW5dpKPRa0WEidgtZdV9brHlI02QCacr/Z2WKX
+aDahwA7htbfKRkC/2HGHofrFeNXrbNTpCDwbHXjcsB5hy+IQnehUadHpY2XvYbMeGMIO6ZQXBWK/
+mYaNhnY4mpkbG+xoPbxYhdZxR4IkceG6cpuKZ995F6Me2N3HZbW3+661YOjjp5WcmiFPlWX5eu5Q
+D4S4GgUgiTVwmQdsYasMyZkr+DKiCfdwq61QV1wSVaxT11LuqOr/TjHJ2vzv9IFiMKDXcO5IceJR
+Q4DGMg9z/t+ZWtql6yGozVDDLUBuT5MSnaoR29ru4A8oZw8LmpknGRCNdzEZDSzGM97PI6YF6XD8
+70ycAFUb3auP7TV0m0BUG+QxqnMIrzizV8mu84rekDWRPEbqBc1sFZQ8s1iMdDso2GJjP3VSZq0O
+23dEK/gw+YOHmQxpxkFfzbt3k+KAqLRhTYkUJ854q3faHpIzukFiIlbdRpXqvriwU8C9GMWTxEwK
+fdjjFGjixRMJRuaDtc1qS5t8LrbqL73eeE+i1osLbkjbeinZwUyaTtum44lYlTKpTmqG3MTOR2dr
+N69qCeu0WLLqqWh5IBRqJcdklOw2lmplF1Qzczd2joRdL78rW/fUFbr6A+3a5OhVJmKkuhjLweNq
+jyM9y1v5zHe90Tpimg42jSNaC7lTpI2gXqIBb3kAGklbs4k9D0xiLE54uIxogsR8ahKQL8fD7Zhj
+AmjC049N6I9TX+PsotnzAM2fBmji2fnB1MQeEsQlY5N6HscHwo0hcrQH1nVU4DoNV2k4OrQHTl0q
+82VkFXis99psNUclDW03CUMZIdrfzzUN30FSUC89LFv6xjRKAfbvi4IFYVenHCpk1J7RUpatO4Ts
+iFxy2oLD4WSR7elAU2nhxMMxDTfPsf7UOLFsiad9AB3uFK3jgbaGyWVLL7EqKbEJNE5LxkmoPaVT
+WNckNjLLfErfeQPduU2FFXmKrboqXgQz6M+bToTZkqqmX1Z0SGHi5qiOGAUbx8CmYzg6C3tJw4Y1
+6YIT1kCLQVQ6ZAKisHqhEgY7kpofSa0gLIdJSzRvcykTIPhUG0BNmqbzkB+SDafoN3tXdYmWBePC
+iwvbVZXfz+x+/8nuVxaMCy8uS3b5MvJ4WJANc0Bf4RjeOvNKuLq1m63N65V4Y7HNqpU72v7shQ9Z
+9fEf9Gjq4a3dAw942oOm3V8/6Xyzwx769AdOtZaoZOcoqFKdkAQJOI3pPofR81DXGSCf3OLbRPYm
+UTc/rrv1hz2DQkIs62Hjl/7GjUkYigmmqOUmDmYqu4NER8QyfMhN2+jRz5tJRJ21oUx7WnO8sSaf
+2Jxr2iZfwJfkcDd728LrISszMb/1v7BGpCdCKGgPXm7ayqFKjceFXee+wgkLZ/KOroJZa69he2AB
+D83I2ykq9eNqykiuG99DRvcjaSp2jU4/OVxnPbuYY6Uwjzot1VySOB33SWY0qY3aEzPg9Wbvdp1p
+p1CVMGShCH8XsrjbuZBbt3pysXfRLDKaxFzQ0KOrVSWLPS/l7BG1HH1li/B3W6ZiUZx5GEpcFPOx
+KntlXXK/Et3MB+h7z9ZwiNJ2Pkfww4gfa1H8DiRAd/6Jezkn32E8owaSAxbzzqjrUmcsp56oz1iT
+PWFy6tZh6fR8BN0Zpz7Sc/KRmSErZJrQOon7/bSnZk48NnPiuRm9Cj+bDn3nXzzdpnL4vAOeA33b
+cvbbl6zHcfQTNUChPzzr/NgN1Z+IJY6faFUg0Q2hemQNdGN+sId+2Ng/eo7+iwNk+F4B7ztx2eCa
+i7qXLepemhEe2rhEJ70XPPBBzwF6/5izsgvPO14Y7S+uDaiUaERXWxq4yqFnlEvvlly4rr2q4Ilc
+XVu5+OLI9cMzy28IlWLE/6bMMYOkxlKewWT7ut2+bvq/fd1u+et2+9/X7TbM2ojb120LX7dtyPh1
+2+5ft20M5bGO/wHt6xb/5q+bfN0kjP/tgVBfzqsrNygSKRkptPNwHPgIbzmIeh1uRNdkadERlNAD
+gHcAn3IeiJYa9COBiS8V5aBgvcqBc6kj68eHhYQP5UNybGEdI7KIrF602kWm5H1/CTELBVSGekgr
+YOsj5qAEZqq1viODe0aJ9yzVZCNQsmjN5glv5vSk71NDZLhrALcdbjvddua0q6b1O27hbhJWpDyQ
+8mDKQ88YEo5vCa1PQmQSCqoo5SgaYhWZAAeGr6rP8DrSjHdcjNSodam41w3Q2tSIUFxaD6rRegBI
+aGXVaszumNMTuUYsSgjmmSr0al6wZK8CEZaL9YVqZfElbZDXhfxnVVghL/wIOsmWwCLGdYqDmqwy
+aP7W2dqtWy1az+y1SuaX/bAytoSZ+amq3t2auFsTd32+BlLqpOboxFbp6Pid3uaavtPmgOtDUzAn
+XzVPItHJbJbwsvIWSZ9+RpkEYxZ95p0M25GeJmGoaRPCX8rfL77JLTibSzg12B54LU3CKEKqtt/5
+XQj/lRwSone9k2YKB8izyp3990RMa9x7OCbOLuVsk/I12Xbo1P5ATVsKx4L0YuOdvhPOz4Y//2n5
+czY9sC64eDieeZJVNH1P4C7iIX6yHd8lIWNt6zdKeWN6q8Bkbxb6xg+U3eRWXgiHedMnGFVIJCDh
+hocTZQu7qNBrI9keXUZ9t6cGSPSUQ+9dCdbIh+gUkBbLrJ97OA6FYoIZ2UlmkJb+RNjpOLZ9y+WM
+JsWJCQdqmoJ663cEVdKh8BdlDTX2f6dUyZPU2MOX3jOXiJeNJIbzRkH7iZvQSk3lrz5+SIhOmDcv
+yvR2Yla304TGjGMFEwlDmPVL/YqRA09Ea+CdLBWIZr529tXDO2IPRtuH0fdOZKNHeadskj7vZJUc
+uFhXs22qsWem8C1ZLxGNYCw1msTHY24SOfOJNu+JeIxHYtoRossOYOlph9+vHCbp8ssLQqB6/RfY
+TWMOO4VGjHFe02MVViLX0ggN1MWkGnBZAUlD1TN7fIWDQu1ck5X40nfOITWmhjumNaQ4ccZzK2qK
+EZWsZXuahJs+XQZJQ9lNMlMsPQiatT3wEq5EThIjX8Me0ClMxZmJBCMr0KwCDTMBlTC8KnclyIzc
+FhCM3Ry7+/UafhzU1NMuJtVwjmDcJHp83WREyU0DIAsHDH/RKbpsDzkoKyVjj7M70IQlQRDL+dZA
+71wq3c56l7qgJv3ewlMcYLITvOhTCyI8SQypfUK+bdpBEuO7SmitY1pLgtHqqecPeaS3mNR4u81v
+5EZmjRVUyGjQCObzKzcdB9F8R9avm930QYKRafy6eSlWhOWvJx1E1Vt57Arc+mpbX/fgEv4MsUIm
+mE6jWj6Dk/YT/pqUaMRy2w9BM+6Pgusr0YUZFZGyUjZIS1bgXvXxFgJcNETzfuuqvAo5CMjgG4v0
+8i3w0OPqG4cVPwYOoDtIz1Lf5Y7wDif7zsUgTY7D7bvgQRvFM1OI6ZbkRDVqkiM6yET6KavnGXGx
+BohOHOWnMr2dkJn+Bw0ODbuGP9B0j9zRjHfh+AJwU6MPW+d+D5VCIgHzKSX38cm7KhfBDNCZARPp
+GH5PuEtGaCR3Odjj7hjp7/qYjQqqKZiEoSPE3P5eMXqpjAaNwELqqS8PEKJTdWpGSwrrMajdmbKY
+jAbca87uWR3cZMASQCjgxDChEm47QihjOx8hf0Pg2NDH4mQsbFBEykoJpzdKe+CrngYhL5gvV/Re
+onNfubnidNZJIa+85AO1/6O2VZ8VOKvEyR+54Pbm1PqHkrZ/1E/fRVPEGcwenlNgO/GU9sAyzEN2
+/V4UATEoWpirftpBxSM4akxBFiVjZqFQHcyF5ZbMROUtJqOBxZgvXcyDDjxSU5Sq1wqDtden/xhj
+4XBPVU/K/8mPZJMSjeCquFhX88jjKW3supE+Bw1upZqUaIRUio1wVgf3OxfHk556S1g4xcu4cwWZ
+LyMOSlmvhfXh0BHS87I8L/XRmUrmmmHmDCXbEl+WcNdlayOJkz9ceNxT00xkR3ikLpM06hvrJJnT
+8vzN4TV/66iZNeM7rpZVNsAGoYNBvuOlLoWjELQn5KRHX05QUOkk3xQNskJQe9sXuU3LMtGsZrIc
+35YJL4KVDNg+IGah71tJTrhhQ4hO1oppueNBrTq5zQr41ax0i9Hr9H3YY8UG3i9IrqDkfEs1OsBJ
+X3MVe811QNXXVfBtB+v+OYvQCC/pHIMM07Yo9Hknz+R9AsZn0KQuCFd9RhUShrSVJpPyNdn6u2p8
+WN41+rHSHneXKvo6zqJ8RP3jeS1KXzl/xDDNcTcpBymZhAtWonPe6VB+KFjR8mOZ4AoWs7ucfwR3
+mR1pxqw8szYXvoJoFCfWic3Rirrw9JESHj9ylPqhtEWbqUuwTnTdzo4prTMjvnN5TXpJXBTPaWjm
+f2YHmujGxbq8F1wB56KT3lwQXfD6mUJyUzru1l2gGULWs7UUFjxWhg+P/iFh0F3XHuFXX1yVrLXo
+AQdgD4fuCY7UnGFmNg46TG9+YPSfcJnUuPMeKKDq23GS3xKlagp1Z5ugV+03vQElO9Y5dnyaUGWD
+pHeBe+FthQEzRl2xJrfrLwNR5ovEwcrQ/CmR0cj/CJvUGr4VMULpJzflYNc82C8PdsrDeuSxPbCs
+fmyPXR8rN5I42X13PCE+kPd1ju283ZJWQhPYQhavSI4oOy4Wj+hCc+AdJnuzdMC9QLL72JXMEXUo
+xRRHX6mUQ/ThEDkkpq07YErr3KZSjpVnRKnI7jtMp++wOH3zN58XnhGlLjjNVdqnYkmwug6ITsdi
+5RhJpS+YL1fcAydhw+mRQj68DkPlyd+06YgG5sWsSq8YLuNIlrovKWDLunp9CDLgkHTIu2TfMHKb
+Sl8wX4vi1Z7qWNo/7tjSe8iJUt3uj/CrBvt0pVG+Jlue6X5QBFQAvzUlzZmJDqaG+yF6hB5J15KP
+1LWX/jduJg44sEB+HHLhWzpy4H6gXmSyOQ7NEKtMR4NNU3Usqx9dv+8rBx5FGq0il0kYmKhwrofR
+4Og8LQHUdO43CjTIya/KKqnHrwYSK4+XX5myEUrSxL/mYofTb9mC2UpM4Vde/1elJzbpt2hDlZ9T
+ryb/d/LYBUh0RC3BDdS6yWHAi2EqIiXNSIq32lSk7UFSD8GsVWU04Ojq7J4GzFW+4Xbg8K3SOKmu
+ggim7NpBqqa5h71oJKYnQ9h9dq4wDhE2BZxTqp4g6x3do95br7roW/VsWdPtVg4HbEUa/w6anHV+
+aiDoRQMwo6/Por/kO6gh5FI9SY3ai2vG1uV0UEh1aCTK8guJnLWgPehL41K5cXolXXd9lwLycPAo
+g+SRnBJU1fV0X48Q8a6AIjpfPcLxJNRoMh3FkHAhM3w6WyrT4gCvx8PSDtCNt12OI7oemGTWo5w5
+g1oXNFDVymF31MShoFZMiysH4FoTVtBqTTsSXAi53+rFO+K1cScOKdVIM9GqobN10Q9+aZZ6VFX9
+cNQQKNgOKwCSPyCwTT3ljI7VSz63TTeg17BT6mm94oNkUq+M0PrTpeNw/cWvXd90IxtX51VKdKqT
+3G0mQDUN87Uo9NkpLMvdk+zw6AipcFDgDRG7H9KS3MVkNKgO7mPAXHY8hKFSotESqf57qtvDQeLE
+Gc00emw33s5pdmuz4a5O08GjYX26FS4uDzC3EzfS2otfbjCKE5sjE734DKWRxMkfLkiJWxMqLZb3
+KNpr3FndrLTyFJPHAHVpkiHwDE5rOhS1pr1Ox/XGrWKdOmYzKu20TOUyzEZ1xlfaUMWemFnl5g2a
+cUhUfihCdrAYprKrw0GIqvJjkj5V3KnKz2FAd7u9pygOHutJC2MR0wRfBXGcZktx3jDHJo3K9PEf
+NNj00O08S/fwNHFQNkjk1sOu91z0dTfpoddyOKhp0wEFjwx0yXfBFUvHepwOOv0RdIajz2qPMFPA
+Q5qO+mMEeppk6Q98SFz6I8tDj/D+sL7dHyc2IUUTkbJBMhMuvvf/eHXW2RFVSjSi90B1x5pLLwUN
+V8NFEbJDI0k0qA4Wx3wrzi69YvlWZciTPJJ5FRuaFTUWgR4oXZc5cZ7j4c+D14/cedgO4mzGsJEh
+k1BQZSo2Vy/UeZHecWHTeVGDx356vzSTH9wzVMmZtTIg/R2VOPVrm/LW7N/Wp97ep97aQd5c/X3b
+2u/blr7edgy8/Qh4e/8n0QiBFzIJNOHjTYMwCxqgEq3wdqnGEfyElCkkElDmDz6vya9RqoiUNNPv
+kRrO1iS4Jd6k+klcjwcsNl6xmMKGhcqsjRdH7vOpzEQgfaQWUqKTx1nOfZ5zVfF8ySPBX7Si/us2
+XEWnBtrpf3WH/mJ3/OKVSfktoVK0r9v31218yug7fd0e4T8Na+gU4Qj9Cjs1jXwfQe8RPsor5K/b
+4+zhoJAmSr183dLXLYXxn6+mLx2mcGxqOI4zZIexcXozL+HdvoSX5hLf6UqhmdnsFjGGpi6TGrEc
+DmrSX6ui1NJu4/+mufIj8OkmuXSTI9UtC0Io+HIcAZnktOmqrxEd/QfmP7SPyLR9aiH/q+fr0/KZ
+XD5Uc+WHKiab1x50DddYFlxcUljZamivvCyKpRlvoMnKa0TaFsUKxwO3jubvH9lLNzWd+ov3N81i
+PCGn9xON9D7goqhjqDeKcuil6GSLVylHl4NTFNcuZ7wuvyhweyHkL/Erq3jIUVRW/PL0xDhYhxsV
+rk9LbSR0v+1pFtatZL0tRkAG5dj0annQgR9tV37L3wGazn5gciIb7GyvFB3U99qymIwG1cF9DNJm
+cNyNWp80/EY+9swc51kJz8ypUBe8aZH47BwkDEw19khU1HoxF2SCpNIRqpLCvRy6zTHtcrTkGvJP
+FT9jH/Knpv1Sm0KyfEseZclx6AUioYHQLwD5crTYc3dQU9Vg02x0k7/1HRwVVJHjGHfTtz6olL7x
+BBI+OZ2+tSW/C8YGlWrW+8jp+zzmFn2fdUP3I8WJ+ZrcHFkQuBwrD6cReQ879tKA1h1owoGgv29/
+S3fcB0h3OQSDxV0uGeJbdEfd8eEanmyHOLBP8V3zpD8xrTIJRYPUit7v+hR74sN66f4ICKHo/XtI
+M+AHWhxx0v5QmVGph0kYOkImZ0vho2bpzi/WE9RkWXM9NN1n5tjkwrwK1z4HMdfCV4SVGKWqXuOr
+UFWDH+yWH6iPUHf9zdZb+k+4QG7UDN8ySYv+r5wIu1Rt6f/KWCNO/Fafrm+kp7gWNawImwoNUf9n
+OhIFPHGDLz3L1y3lcEMXBzSlzqGclK/JbEd+rBZSDbeg2flKDVBT3swAj0JN40QDNdwFYYSADZuQ
+H6H+6okPP3Q95CGhAu6YppAwjk6lTS1fk2Eup0tG+sPxk/+sCuqV6nlLx6CnBqg09kHmTuCLcEnf
+QElZq8jP3CsUxGbexjSShdPqglwYjZI4+uNDzSosultSjL2ZnzghCG0YpQAwPfSajgBTsuolr0QS
+Cqr5PAKIpfJMAbhj1ONraJB4I3FyM8XrxCNEoT8mwVh+KLjB5SdJdWrdkPXRJQiVupfzeN7Y2g4h
+7Jd++ICgEcXH3lwk61bqzxelXbvzfivDbdcvFqRtwcXc9pU1YuwZn+GYdinr0bqXqJ16L1nw+8IL
+a2XHreUHdwEY1voajTVqeATcZ05eEMvg3OsIfm46wlkX0KyOqEHQUCoFM+IcYUj4pgRx3E0yE7+b
+DQboQXDEog2r9yXTEX9EooOaNC98dSwdOj7ghnnCQwtDwE9vAkF25Hm31HrVlY57Qaj+etmejh2Z
+8KtXhAbSe9wJ3wq1Bx/TUWxfkPI1WRNytx1lTwvky1G9NOis2bllDGWjhiVs27njp6M/tPiptg8V
+tVv0cvyrM0E5+aDdUHjqAekiYSohPqSZnQz38Q7S8nXuD0vrnwZk1VqYgEze+lMffIrJdW3+gk/S
+Qqr3/7mWKv9cThX/+o1h2xfuK2v67SG7Vd84X6s23VpfkP3e1WtRGJFeJmHA7y8piEnG1E0/n+0I
+M77xT0ibI2MTfmJ5cBH8vLtzIu+v0NNsXjNkMe3Etadzmwo70VaOXksmvqU29qYYelAp37gxPfBe
+Q8T+ZAOtpms15DWF9dBFXVIvnhIXXlxY2ZikSQZpVb0aVFGF68A33R3VR7Js1nuMQ54afmR/qIfU
+u7DxXZlRF+ieDpG6ssRVY51NRfqx8ovWlv7w8geyAxo3V5S+RSK+UjkZESnvJmHI4zfx9Xb0oiDq
+lGxSDVpBfYwnlXmZYOjmlhy7exh8HGSmtv6p4QC6y7IK41raPrWQ/9XzR2oogxxEFmyT64LTnLYF
+Q/5Q8sw9LTnBqvqDX8UzciP3t/5YQJyk8eNkuOEzIIvCqPFjwnasT00jddKO/sJfbkvrkDx/on0q
+zdlJnFqfZEYkPvWTkZsd6lPXFHu4j32P4dZ+UNSoAZtLIdmv1U22iIfMbdwF4fh6rG3Y1PK16m1R
+sC27xJkopi0dzI6uXUr1rfRlM1LIk/Ue+qK0qa34xxgZYo4mK8ObEzNA2hw1lgfPsoG2bYcVMaYP
+m4PGvcblZZi0ydltGz4t7V/TYpjIDmsfshp0tbRNCnnlfE2Nje9Km9qKuiUvZmlRqYYNWeXQdgd6
+zZPhK2M68Sr52qUuiHxqeltGtegdVtsgqqV+akjWcFmsQMtFwfRVgiUmNvCdpzFQyCvz2Jhq/0dd
+8iCO/uqnxvq4+mOflLbJ6o8gfAtOcm1LdqwSm3Nn6a4gSraz8hTZ5GilToJDuh+hnxWZjsuUUBeE
+T+EaWWkl87Rin1AymkZshP6IY+v/KCF/qDz8XEcmvYaelrH8w/CvS9r+jyHk/4cpX/8Yoev7i8Dr
+6A8765nyK3Gq3BjMkLo+vgF5rMPzNEj71Fv/V/90uKjZ3uhSa7Hprin9Q0EGumafZFGQFeubDgo4
+JFx29BqOxvMOemM/620q10tMarqfUL8DKvYj4VWOSSP+FWpPLRwL4itIH6p7dpmUL2cUrk9jJ/6u
+NCRaAai9+YXLx9cDn1RM/PFoSI0p6Mkqbc5IZWRQbeFXH0axJ1FUHpQNEiNE5exfT/M14sNrhAbC
+jyAaSZwMh01vwBJgopNUClj5gTYjGJNQRErWi0v4tpJUY07fQnga7BOiUzOaKRdALEOR6GCmC3m2
+h66u1tgKU9tj5KnGHnjbrkZ+u8hI6sqLi9UBD22myh+GSVW+qy4KbLJqWjYeK4TUrO567aU/kaDy
+Ijw1kIMr1QNb1xe7Ex7CS3X3tYZaRlDTEc94DsQu748YLocGQvt0bla3Teq2OZ1Pjhsx9pVxO8KQ
+ZjzEaWRGrokb0mwCn0F3pNl+FES51IXUge0yuG3hotCM2lZ610heyTa7jFVQX/WTrVNIJGCjmy+H
+t7kc3uwk3fz83PB50tRs6tjk77mAHb2mXc55ojo8nvJUWV4UeMZQEbV7lBdr9xjTiW1SI7rkomYb
+32Z5EiBx76Fh2/RGvooGmeDdwug0jZ/LGYDsun4yoRnqJvRaKNR+5Rxw03U0bb9lEyMWhxGfVlBJ
+K5ZHu30tSylfBC7QGXoabYuuH8lKfSvpMKkOokFFCIuGTGZLgH0PLwo0sxKddv2Nl9QP0UpxEqUS
++7JXvWuKjWsorfUqehDideLUWYsudavcFD7jA7BYbro99qOUP9ESo+qdj98quZxFzNYaisNpxeBV
+eUWLbD+42eacr1VbMlcdCvdet53XfZf1UvG6V+porxOrmiobIB24z9F/cFS+A18tAHWHBnqLyWiA
+mJrQTd/lDJtJ3PwAoi2VR4ofkSeXqBVHBS/hsunv+NcXCdIvPtEOmS8jbqPzH1NwV8xQfbRNf/UD
+sP9JfeoY95++YfP8uuWvWw43DW4SKavJadHqA3kPcVGaaQRzVnV7IuSPgSvmTNhLqQsywc0d7Fc5
+jZkzFPPGx8qAfCp6Km0q14Izo+SJObFwrpM/3C3LMsvlXShwnKWWy8FM5/ac5MZ6GPU+idFbeDmY
+6axVe8iiMMq2QQ6T02LbxCcWgZbO4hqzxT1/JT0wlfRmmZFtJ39PBchNxh3zQffTG0pRVsXroVq+
+FoU5YtJhNI2dFfEcMEYpWSX/8+7339Iy/62p/gu+G7KXlPkuPfjucF+Md88iixWMi1kjiQvXyTMh
+LkYUueV7SHmSxIXrym0qnt2ORwmB3Pv48N4gjBlGtr/0M4EEml4l50luLDTS0B6p9gXNvKf+WNAr
+p5o5HfrosKOZMRkb2PFgg+Nqprf+ciLhJXGyF9mLHS78MrKi2NZhqpx9YIqRJyhgM8AEED9mnK1f
+RFTa+q8c4RxSFesm3kXQPe6nnFqQts8DPtZAaJqEDU0VoV6/KXRxUEc0345JUg47w6lHiMydvvvv
+CxgfHzE8iayqMLpSUKUYjWLTo0/9z7+GjySoATd638sQx4YwQDwoOGoc+jY0pBuqQTPAz1QpM/dj
+28NxlwXhjEy404/I8e6IHDUUJE5sjpZx9F+fXBS6oY2PaE1zxNkGxxMhcx9jr14eK1dKVucpLt29
+u1Pn/hhotcLX+bP9zOQAVsaP/eO5VgZCbwsPeWGksDBCqJOd/+0h4czfMYKk6yF2Wq34daMcmuY1
+Frj2heLEumCbPDGtfNyddd42OV+rZpurOmI6QpbNhmzWjD4mj8vaq/UFGV+i1J34/e1gsfu14V4j
+b0sOcebgoFuv2fbAzxMAL4LF9ewW24rO7j6kRCe3seq8es4B5XfbpP47wnfo7alZ/7VD4O8rl9S5
+dpXH9avG/wrCCFEpGiQEs/717vCLPH4LJxu/hXON32JTjV+9ks1xKPgOcrbvHQOqQzMyQBb61lDm
+F3pV6p6SgH4uwfo5icmfEEhTWR4+KwZgLjUxm5o8n5qWjKpVgxWscEfqzow78+3m3DkvwadOcUoX
+C6WabACmUqAJ4oWQCV9M+LKEL0uopzzZMZiplOjkcfTGYGvv8gMawVwQEcVkNQnH6H7QH5LGcTKe
+Nq74PR+egwSPwUPmy+hYYmdbT8nsFdTUUee3Xmtn+fm6Zf3CXMaSTbanHwHVoZGQIZeCctrlooD6
+Qoj2S/od2py0ERIbIbERkjVCspNIOiKe0jKShVkuFCZzmx1IQMaahU2UllMJHg1SkaJMihNnvJXC
+MLH292S1vqOghBAJnwiZ45Np+Cw4yHJ8WjM83QIds9B09F8KManx2tScuyWbtyW0+OsttTuoqTGE
+vzVEw0/lEfLlaK3myp8PjanZQ1q3DWvdN6zNH/iF4knUoq+kqcimW5lEMaWaZM79oe9nGuqGopE6
+Bt5kbdR/LcnvsyZZUePf0ilU/cUddcIwFR2fCsenwvFJJaNRQMHwVELHm0i53DTAskC54alwQOsk
+pLzdLhW8OARo9ctNw00DjdPrsTKWA8QhOtVJzdGJxW1PvSNAYKxdjQ88uVed21Q8DyqMsjpYOk/D
+q0+SLGwOuOvgSHOynKy1NvshCCBrwRbzK8cyLwrxwVQVmCSSaMRHUgZp429qlv27HHhtPBe8EJCL
+FnW/+5NHULKAsEl4jh2yAZJZbAvscqbc78jFb2fmcsd3ZQnIQUO9UClP4YYqWDvgwq88dQQu1hY7
+PnSQyz5+gVFL1uG3HOgyR5RMWSmbSXonOjJHH0HLMoIWjKC4BiyFt6aMGLWddntnaBJN5svIci7j
+ptMRF6Q5WSq2py0PATrJMkwzvznzUIV14CYVfmXfKF+Tj4+YNZfMFz2NPVd82NXIvE+r3l7wQPpk
+umAV14hGEw+Jk6LURfGCh+b+lld7lbpic55WN/aQjgUlLor7cNghTrNtta2bTzaf08YE4yVibsXp
+q0+lxP5ItXXf3aa2/qHn60OdOw0Gxv54//yZLftT6u7EXOl/eZ2uXecrni9S+MGFrwOorCbpyK+J
+DLTcBuCeEBVcNOJD0SpueksmF12UKbhtpLKahOfLssQ41lrZTUo0ok9rzH+A5q4biwlg4a0cQCOw
+1f1uDhDZ4XZNZtfpPCHaEdHtPOGHhb4Emm14x1e0sg/xZ2NZZ7Oi+N2sAVrQjwZ4FpGgSX+Q8IcF
+/th582eeN39sSXUQMtMPp0IiggskAJpKFgeYWMuf5Doq+8O6/uSwvxzgg4KwNKgS5ld6iQNMDK0Q
+b4oftkAvdXtMYqrzRQEVrr+Wya9n8jtiBmoFzvHP2xOn354AuQ1bddrdCIWRy8kLzNOuL/ENXRWR
+shwOi405KlvCatIMlPTVjPlT6QN4h2JQzg6NZLcpwBcomWOypLzzcMqtHIf0Bc1cWFzBByodGX9q
+Lzuxaf5bX4oHZXs4MNXdKnJPVuN7DW0hczx3kzBYZvYlfUdE/4cfq8mn8Ps/GT/dnO13mwl/nFjQ
+UZo4wPQq6eiTaKxuHCRxsu2mV3VvVADM7qzQkIULn7xNhYlQd/j1Ry1hRfj0ctzZOXrRbzkYsXb0
+06x0lenEu5Uqq8lGQKX42uWAzITWgCAhq6CvVZs7aU7KsBc5t1J5O1tbWVyT6FQneTSLOua3BVxx
+HwB+CSSfB0Nm3Jlt55b6jjs6683lstMXy0isI5pTM6k3hMi68tLlrDe4cFsqv5YwiJ2g2gLRICul
+LutCqtwdLDkEHJCcm1W5WdW2BhMcHp2cNZ2Nw1Wz4cqOSFu7AaA0Xu+cfhULmjZm4Ze253ppqwrW
+U/1q6bSr3PfI7tJL3f3rtkts29dtz02DgT/jf6h/v27Hd/66Hb9ft/J1K2H8x7SJQv3iFZq9yaey
+mmyAO24w+Bt+BF5jrdr0IuF3LIxopPjRn70yglFDBB0haoW2LLY2PEAXGAj5ckRnWJQ/H5owkhti
+V5sFC8Q6PJTQt3zeoKJ4lm6Fd9/0PjfbsvQcO36LhACTrr0U/sYmRqAR/uh02QjGiztGYZhu418P
+lXK7JYRC0SAPijslze0BOY7ncrtloYiUcMuW7kLY8ANYjuq1hU6hasGaFEFNCJj5kHHFuqB7slsp
+m1FXD40kLlxXXtxnJun+UHvX27aQEo3o13Ffttx+HqmLwzBtYQRb2LZUlETDZEJ9nhpotjz74CJm
+rALgx1/LiIthw2u7JeJLrSVGm6qXKBqon9wDhTprITobKpgMFcxwCic4BfObwulNiY8U+Xz94BZ6
+fyunTSjUPSGvRA0XrLzW5RVtic90UGhklqT9VndOVEf7mrGR1JXbVJjj5Ud+vG4nP4szeYmwBHxA
+wQgeFtkekvMkRDZ9to9gpvrtIHXBGW952uOFA3UZhyB1weY8CM+tFik9BZPHgKqBuvRH0t3/rRM6
+FVDL162M1Lrf75rurrOvcg+1q+gahZ8uhNRouRDqrrzzBt8ADC8DhKD+usqUA7EmBSTGgpKvJ9ly
+UsHPGqmIlMgqezzPVFh4Knc9Ou7oV3fczSp37eX3giT8dni5F2yXemrb3K3mrPi4rRhMuuHQO++T
+mytKVQP9akZJ93OEWQPdAjyqoYK/DTW5UeHvPE22CEhsuUoYNIRRR0GuzBdbcy8cExMCzdpP6475
+WhSevlZVa/GffeGvPOUxwqc2lO7UHDYNR2yOGuj2Zay9lRzx0BAhOjUj1DbrLKtkLk8Q4KXJpRYK
+2BBeWhEMTnk8dWdSmhEhBZOHg0ZlDXC8q4Q1Hcw1p78UEg3o8pd19wVcx2ZM0DIzFCzWEGC6sKpT
+crkfwSR22kAh8Te8jZqhPn5bcnvIOz0nNce2T+wLLukwB8ntocdSbo+SVLJEm0iS3MbNtrlR1twx
+c8lM2h/chf1h+43zFXw2vWQU3X8R4hOjJWtHzZzijMaqo2n2mwYhQ8F+UylOGFcMm/NxN8SzGpPd
+pxyT3Eho3aTlzxYk4TlqaExCHV82LvhKcsHP86mAm1WMHWe/vWop30rQ7ZRDohFTB8Awadc5Qr4V
+lcdvMInY8W6IOKFTGHfltxx4a6Yct3JQaGI9hvGilC6+lyPqPRdIWAV2qRSw2u/COMKcEB53kzBj
+LD4ixmJdMx+hmZvZ+XveINi0NqgDfwGHsNg0Oz4DVQ7ZJWSFihBWhtIhHxyyDRHNKfLxnaqY1Bjd
+IJzh+GWFctwLQjhoqK9GlONRBBfyA/ckDuqEjnzw/Hjwo0vl4GUngb4oKun5DV8OV6GRTw1QKdsE
+Dlk4Teghd7AhDmw6f21bwRrUfnfblpmG5GsqBd8iV1EpUe/jQnjjdxLLoZUrNxOaUQuJAuoj/Ayp
+w8DBYQA56Z3QMhKXmwZazcJrkcLjqWD9CxIRCEceJWahiJQNkolhxjmgcNo7pzlznsPJxxCRkjnc
+k5gzK/ZAnR+W+6PwFD5IPahqtfkJowGPknWgKU98a4QgdUGWQW3ly9myJ8NpC00cYEL1nnK4nBbL
+A893DKgUUNEcT6uOuXtxuCJUmS8j9ucxtZCXg9QFZ7xlSUYEZ33lmaWbZAyuQsqTK/elPG0WRmqG
+ljPQzAdlfTpInFgdZ/Lq1djxktIgYUa8d+aIaJsAkmBkyE+UOk6zFTnOC99Ob0uuL/AQaOoh50lm
+LFUm0Yi9w+sRhd1BXbAVGZcIKmmu6Og53XV81XWYsuuvW0OiiB3NuGPbdqnhuE/yyGDjKBWmRSvs
+iak52JTdBkfeNeLaj94vKnpSK0fQLnjwSrQckTO1Qbz6MIwL15VX/4VZ5oE8rAANNXll/6/e/+vS
+/5efL58K8qil1BtaupafoBIRutmKaAEuXBXOU1TibF9snlZsFQo/Jqs3bUvpNuJ0vqZhBJdbuUzC
+gGK6CAWtEA+EUDAodDaHld3x1h4BUaxAlrtJdgRgXbA5e35yN2MjpO3pIHXBGe+pS4hSF4SLDxZ9
+DhbdbhSUYmGv4TXpJXFRrATV4K9N+SsII0SlaJAQ7N+/1r9/mdm4FaZHN8t6Ybh8cai0QrU4nTnj
+u8WlhsMEorpQREqY/2qoj3GWGvHD4QNyLg4NRJdKIdHALKhK9aV+RY7Yhm7GXNnQzCbxVHfhTF1H
+rYpZ3BA6dagSICLCspuEmdcjAJgqQigaaoXvZL14rHe9F1IqtkBPyPjaugoYsaG2PFH9i17FtxxV
+HJ2y5nCqu24JtvrAYFoP0VY+HiVrmaqV2xlPrWqJSd3QCKWlnDbuyPFFc5M+4VJNC64lF1y611p+
+KHjcD5RoUB2Y8IdbBzKjyrY9ukkYesgm1XCO4LckimFr26ionpJ1TMQNjaJPJZd2pEBxmNRY3Zt8
+OGBIHB/NTwD2tEDBswIFTwqUpk3V8PmQITXu9Sj1BBkgQO4NeWMEbo1F4eMXkB2ge7s1O/WQzA3V
+aRiDVSJCE+EaeIgUFmpEladG4EUVHap7wHZ0++yLUb4mN0MDMWhigLopdgdz+zW3X3fT7HW/8WkL
+ldVkI1Ay2Tdqpalw3dOZ5qE/qFMOMjK0pyKVmsmya20e1goPzROXKp0XI11nGL1gqQam9hP0wrj3
+tEnOCYga4mwzxK3q7bbJ7hApD/0FfMcPO6tLzVLivrRiNWlxLrcqPyszwnIsOwXL42RpwHTh2c4w
+X4tyzLgd84OBMKnfmdNh8tydGqntDsPEpybKGUtyqRHf3/rOAkFN95AOuzicSvjU4PlASEXzSTmH
+W0ityafWqJbDQU15vGivgFORyq3sNzFFohHa7rTlCHx5aIg3LutJNP6q0PHo1PW4AcdmIlJWk41A
+mSyG48WgfBG4fxTvDhYNgQIiP00JZAwL9+e6lA/KapIRVgIfFTTK1+TjI8YHMTy9WM6jSzkXytdk
+T+oak/bEWvbkFtxBJeVr8swlrbdNz0N76/k/faACUu31Fp4JUA6TiLlLSQslWRkRT9gOE3yVuZy1
+CmwsvvVaXg/BrOPUKnT89AChOWk9O5ZBZGW4aPhmn/gt5zM5jKg3du0bs6Ahsukarb9Rqyfun4hP
+GJSfmKWmZgPpqrapy4Jx4cUF/QPK72d2v/9k9ysLxoUXF2aHaMkITZg1m5eern607/7I8W16RYin
+nowYxTyqZVFpv1SoghtfP7zx9aN9AE++2XTmB7P7H60Yq4Wrjx+/+PjhhcVPpi+LyujGKmHAbvux
+meuPbR6isZbwg5WAn6on7p8abIzUwf8Hk44hoOpTsOWnvWqagKhumw6SOPkjwrxPGH/Qf35s3/x4
+W/ywDhfqgC9W60n1r910/buFSqFxmsVf3SLcs+ftelzj/8UjZJC0aJv9xRHwl2PiXxsIeU/lL2v9
+Fx/fKX/5NMdfe5bjL97lLX9560SlGsbe138doC+eFVRKdKpGKE9xJL/UJfIyA+1wfdeEtTCSOj5Q
+sEo1YEsvu7C/5nX9tVzWX+tV/aX7XS+nr7ZhYLmaHP4MoGsjx9+iQfu6vb5ur/h100/RDZHy1+2V
+vm6voRX5uo22qV+3Gsb/TSddVS+2K+7CDZGlOzRQpSgmzU4J8UiBsrxKXHA1m2tp4aynaScq8rjw
+6xNp+1Avav71rcr7g5DZLchO70NCmqGb5Eba27BAeumOImAPLcqfD41b/Dx05KphQwFdLzkBHa2K
+zLXUGH6ySVjxMHQNeCBlSNYqRn2ag59vrSHi5x7ts601xCxIiY3HDZIa0LwRi+QDehVsfITftYUa
+sZ+0frIH3bnYIM36zjDEog+bTG5UanegCbXEQgykRTT6st4KqDP21kOr8dgDxf18dePDpBtCM0oO
+bV/Q4w1eZ6+WnDYVKSIUkxWS9UzRulOKFBhRasCoDImoA6GOiASLwSq6ErsKyKIfKccFqzAvVgv3
+TABoyXQkNF+y2z2O4go24cgeb/M54+Mjxvt3Ol44t1Y886Wiy25U6ooSV6UuitfAtLao5C6hSV15
+ifAMehW2309oj0kSF64Lt8meCRWPesskM+rayyC0EvdaYznWtlaxpyCMEOyqT9mFoO5Pett+0Mbb
+g27GfstiMho0Ave6UuuGyGy/YUfuL10iqChaX9Ct9u0DQCOk4CBO2ei4T7IUOLsqvq27H8iXB8wR
+JTXCYfJ21ju5mnT3aiVF7mm92atCTEYDc7AkHJr9kwuGzdFdCwVrRY8OcVG49/WTDhT/hCePvMOO
+ouNgTohu1JDfX6zDDeLO0Ll/xUiDOlv/tNq2B3cp5gND2tG4nlsaviCLR+hHGGp0oElowdecjCyS
+8tuu+RaFUcnMuCQx8tgJncQaNx96liWtar9XNkD9NFVPLYe3bgrOFr1zxORHuQAogisrCsxL1yNq
+6HyC0UizOjWfd8BOx2H9Rgb4IZghrapvmTV9o+C3t9dbKt2poqw3K/FGAYV5FzfD+6zBpBpwnv/h
+tuDyAJLRTM95wM+BkM3x44P9zxzjf9Yx/ueg0DojQFkXsrAOfqnfryCMEAeF63T+lQNt9ms/q+go
+plBWCqoQrP5vMnvKFCkckxjHMtGCv792tVPxyYdqn3wAVIdmZICMJMA3hnwLB/KJt9Aw9XNuVHDR
+4iir4hna86Hgvw4sOm5Vf+fckWbLDG/4K1V9DNSRjg+vNJ9BGeil45rRiLEmut6/cqS5P0zS8JPw
+7MbkJcJKQkZ8rMCoEe/iYCb9XQ8jGm8S+iQaY9DFMMe4cF158fdKRb3SMqKHJfMk36H1SRIXrisv
+7l7CN57HUqzl6AvS7K5Y5B2k12IEevGlIkeJi+JZ2AtHFd8ZUfGSwFqOl390tXNR6NX167KDzpdJ
+RvFrVY4w7whZjd1qsCOW7dU6Ho1ytFgma/17oQ+0DKFZOvzskaOZp7P+5o+RRfPphskW4bJXfTV8
+Ueqn9unp2rnv7snJnSF8eLD0h7/vTo2HQR8Xnu2D46rUD6Wt2qL8yspxVeqH8pFmVawF9dUaSMvG
+s5jJPWnySJ5MFD0vTvZEf1C0rZivRTk+4/xUIMHGmP7o80AxxfNQuW0pNIMuk3Q7xggOqbUcV+28
+PYm5I37JvdoPuQMagQUzAtMclXCIHm/byw4rvwFCKCIlY4WDHIhGNqn8WovKLyZEA6yliOIKKvCr
+fuNp/h1nJP26KzLERYOwv+6t9Roe+UTKIwYKdToOk/DGyHN0rg8IvtI1JC9XpQkeKqzSMHll5RE8
+cMUpTIY7i1Vfs6v6a8xDnnf9Oe0qOsMSzJHkjSzeXFQZwK1980T75s9GgyzSfjkdeAG6m3Ak/mgQ
+ak168Y/fOx+iUtD/h7say7v2a+hVfl7nzSQcGHLE/IsT8oXrBPl9HPL+ulVMVBJWHFI4KEeSdJsD
+RbpxmEg3hhGiUqB2CY/yqkQymhNSoa662JX0TImzetqet9AdGghF4GmVqj+fapIOltuTPy3o2JxX
+Fx4RaXvuAR3DkGYKDp4kGvGYqxGMJWO2QFJjDJnC1LckmAThHSu4jvATEzsWagxpxg0DGyaGsP2B
+F5NUcnpl2MiHGY+iXxxXxockFCul+ZsdS79At3Ur1E7FymwxIjNi28c7FgtSvM8KsO6Zdc8sPHMY
+ItFoGVmdNa18I+R6E6kBka18o4aCF4IHMCsF+uqAloSvBhv9mWiZQiDHylToavDQnO8hislo0AhM
+DXLjYQkLOuUdKw8q2cGIzdigY8psCDM2DN+cHxJPe/Jr7yrkcJA4sS7YnK1+g93qrs3ynG5omvuj
+W4sSxZRixZvB+9Idff+e2M+wKej6OSdOrw3b5LQql2OfxJaCkhcXqyU0YhlC22/X2zQ18eGCmnZM
+NNPOiWbCEbzzoylGajxiuEnOAZxaAmQxGQ3gDjutaBLL6Y6QRq432/2tat2ZV9TpYIPYhfVcOyX9
+mWiezOiJEMvt6Xju9ssbi4IUONAPL0sTaMsV/TIppMaN29RdJqmx8cI1tSdCbHjjiN+erDuvaZNd
+z6ZmQ397Yk+2p21de85Naqhg61lM4vbpZHdwq1nYkEqshq3QDEKxvWSTmk79uNqTejiOFJy2STBa
+2/S0YUmfRGN7mJQ6ySPzZWSb3g/sMh5fnNng4jthBO89HCa51zoeZqr8MP6QWUxGA5amD/a0tvKf
+VRGPqgZuaayBNWK3+mJmkrigOBriGJ1I/7E0UmxppNjSSPGlkWJLI8WXRvSV7xHewmGEQYQE97jh
+2pAkdeXFxTPd/FV10wyzPtTlSLMcJiU61Unu5iUoutnAklhtOfUtIWYJ305viQszMb5vZOQenHcU
+3k4rIe78iONg7mOSZWXigRtshmbGBIcUJ85438z241ugSBc7DSheDhaLKVC5jXyOcF8Zs9iy4eSr
+Mho0AkvftpKTAyIfHJxINOoLYASYnght4a5IlIrXwCpeHlahvvo1ExWq6hSQr8FWvgdb7bXWau+1
+VrzPWstT72ZiHaDg7m7Br8zgWxmrhh34lMPBdh/xD7hSYEpBol+1THmisWUGvrZSS74HFfj20gAt
+bA92dOzWKXf2g912/Z6ijn36QoJKnFrK3u61vGH6STjQjw3hI5kM2Qg/wGQsC7bJ+LAqtJ62BRfz
+ip64xGQ/g7vopf6jujt/vhLK65BzLxju1dC28pIPpa3a5cortJ3KSZdCYZ9rXBTzoIRorDP68NH1
+SYMF28Lnp8Ja/dXwVygiZTXZCJTc54de45eCXWfnelyF86OAKqtJRiez8Mxqn/mr8zN/jn+M7w7m
+uocqk5iIAi5PhEIRKeHzfIwbJEDL/skH8Ku9P1X9BSqli/7UbcwrT5y7xjtBwmJ2hEJBozx4IuJj
+q3YBOASXuA1h/hHRS3LscZ3I81zTsUShshGqSYlGj1I+2F1Z/sDsrT41mfplRJOKkxcYADN1k2iP
+8xEewQFOWe9vFx3gfnBVXX64nA6QY2JdsDmz7j+29o4r1fKDAeaH59sfPqwyoFKiw/0c/JTfQJ48
+xk0ok9WgOTCdlewn6p8DM7XywwH4x8ffn3knaXBxxJo6gCYT+O0IRV5PGJqHn31+Dv2hhwFc+tKH
+1kY4F25UoX8zQV9uZrOWtfrxpmb5i2dBFXv5UfjFxfxIed5wk+DUi7Jz0xdhdGw9aX+i3U+5a1c9
+hctqp06QT6yKn3j04OSjB0NWkxZPiXoBYOoImbKbg7bqWBDPkNx/Jx4aOO2hgdMeGjj9uYDzkEQR
+Dgf4ILfRqaThDHXqY/cq6IKJBACmR8oRNraGzm7Po/3geNdve1V+26vap7wqvtFVT52c6zP5I8SX
+84waEAl5ncBH9ofEK9lGNCbzYjHNnn0420O/30+gu9+OcGYEvusziJ2P1AzrcxKN2BQ8MaAyu4XH
+jPOfVRGPqgbYn61h7NPj6ewhU/hDwKvWXJVJceKMZ9N0/k4kycqwpVvwZfT7UeTvZ5G/MilOnPGz
+SLQJuhH7BIbBkz2q6y9K1tM6Ci8VT78qPJerQuc/q8KMdDJw/sVGXtrfLyya6DOj9dKOqye964g/
+VSV68TV/o5Tc+oLN+FI4EKLdLn17vV7jB367TELcbwmHwzD9jnsK7Zm+bu3r1sLXrW1ftxbH/zDI
+1609r9vXrY1e2LevWy9n/br18+vWf75u59ftvI1/HQVVREr9MKNRI1LqYQJonXTcAdcQiC8aIBFe
+qdLV93MLPR1pgDbREPihdyOJk5ExFU29pQNTT0c167Ohp+IjHBQGuM1xbg85fkLW7B8GTw2Cvo5M
+0Fye+KwRgSY8KmAEI6r7xBbocsxpD5cq6CKZEZN0bhdWbVT+PETyyoy4xCQM+I0jBX3rwwixlq/9
+sKbjOHsiJ7xTOYC6mFpNNoLJC9Iy3/VBwDreypAqB7I5mLyy2Iq7ziBLWXU6hN8wG2H4MUnD9oDU
+B9oJjNKPfhEOic6I7iIdabu3ED+XacQ0Pw+dUBkh+YWfjzOisZ83maRGfGUBEgMSeESO/GN4obNF
+1XSmf8b4CP8fW+e6Javqa/Hv60X2c1FCV7EbxT9g9bKe/gwzZ0LsfcboJr+EcBUV8VIUHVKOKwQz
+DQOYMhPLseHg88CQMJSUke+JkA5J1mfxrdoDN0Eu0fIzNIcdTDEo5C6ckKaTQyxBnVnSE+M3ygcu
+RFBF9LVLycDUh1QOe0TliD8J05mDD9Me/EQ15OUi03v5ZOufh3zZ9HpvrFSVKRp1JRSM758e+GqK
+CNOnpXWSpVHLoNBEY8aYL1e7BCE1qvBm0yGfULvCuqnkzUBVTkU5TQEZDYFQ3GQN4biKeAb5sAlk
+F5Bfjz2eWDSGlIhHCk2lGGQp5sAz2gce0RZhOlrBh7ZFniIzXVF//R7bwWe5D/nI2iGfRTjwRYQD
+H0MQ0SFVQDKhKB98/w0/53rIY9BXaHN1KJI7v80H2f/Mb/IRaKI4dpUwDIRyKPn3WB9Hu4r9fgWT
+EvVdf+S0enxDL+EVUwkvwZ9wVoN/+nLxQwJpd8Gt3KM85DL5KA95He4oD4RHoRD1K8vE+yjPsKEo
+7KSXyCqDwTZJkssuXrA5C/blwm1VdBsVbqOij9sfRbZWyWu4bn4Hzx3Kg8ZskhF5USmGbwlQPE6B
+hb9+QUgTkYCV+9bK6S9cKCVlqfI3NKmu9JsMk1KCtLvE+lTJPhBk4os74ZMMolGbNP2MNBcmSBsE
+EmHTFh4kLsibgbM9jTx3Ej/iNTlFr80komoqyC/ctVRKzbNz0UxUcVGKHIIgdsBXq08DmHTDlrlh
+ieV0im5Pp/7zS0/TgSTLRRfg5A1AXEbIAjl+sNoKiV7QdsqVBiQ9+ksl62nXAJP/8QqzllVtSBpk
+vy87h8KeWELTspv1L0NM3wA0vfIwmCYdR+Dm2TlpOZwflfLzCvxQiSh5N6BJblcKounno06AD6cN
+BZfSkKjswHf2jvIT2rfKFJWY7gefL5IrhvURnhRiwjH7Eo/kSOOiyrqSmkp1oURZgHIaIraGoZIG
+8fiSAB9FP1Z80gNSvDA/xaOBImiV8cRb2Me6SwDXHa47z5cXqBdT7y8JMx05ONddD3KkpIzEmNqK
+RMReRUAZ+ECvUjknW66mMWfxufDKeMOrZVjiOfDTmLrQo+s8l0StN/0ZDFv5uQDpK0ImrBorsxC5
+qpC7+u0J2jDz2WJocnrdYuqPsH0TR4DfvwHPtx24+3/w5r/IRokCOArk1Y1j0wPgZscyxD6D/IoG
+AfbQrk8+SDfjHdhLJAq4pC3WBhOHCgCxWSXLoANryrnOppOd7anpiiXQgbs9cTPkwOjFWX/L21nh
+iePXxsMXegs7HX5Q7dDfUzvs59SE4M1HWpRgVKc1jGSAKISy7/JphUO+xXeg9C6Huk26GYcofGBf
+hHjwmLTpqWXDljjkYnaTlZNDdsd66sMoQLUNlQUWKaXyoIRj1FVg48hpfNP1aDi2NB5GGg8ejceM
+pseMJp9DEQG7hLIrtJiw6+EDRSKQmd55Fuoq5Z6FYTmdwj2x4V1NSDGkcFAsLbD9qp2Th8O83BRe
+ZppBF/B+W/rNlO5avKt3XzZV9c9/Cvj8t4BPumvxrt597wVonMq/f/CymHx4YVOZohJTPxOrgMuW
+xmuU9kzdJMp9vhDS76WOL4gSmkpuD8F6TP4kh9Gxc9FqldB0HjeVFG/qb1/LxuAEsFxNruVp+rry
+cYzJ8KjnhqRQjy3iYkFxmpOhFJlDoRA7pll4mO/Q7pER/R0QwpIQ4kHIo32/AgWi8wZdNCmghJ0C
+NpTDD+lf0CCwBq4UJ5ZzsvNtffJE9heWrhonjU0ni3ZX73B39ISfYU2KA2MR/YLSV2nVmrB3SfTG
+61JAJ52QT8TgV7Bww+gKI0SjQCJ5kvTATyQfDc9pH03uvF1ie6ospxISYn4rMkWjNsnckpFmxw7a
+au3JAF7cHXBHDhIGhpixtw2xg7Ua2rGytTFvapzoNGz6/wWEokg1ZXbQWvgJ8MPprTUclNsXW9i+
+rA08713yX6zhtpYZM6pKGKCqcvAoILdZD/2cGaAZqIsWg3lEgx1BQJggGgX3mB7UCzt2x6MVBPoM
+hCiWD1PxdcYDv+YmvwJztJH4qdujcVAe8sLs0eZ+7nZzrqehZJ5Ir4j+CI0CKirbMV3uvCDoMnix
+EovzBurbn7kVlWIQjxfX0AGwI5Xc0BRhOtbCSfTlse2i6eiNHOgdN/N5M0+vUfRW3nXXTtYbeRtP
+pPRw11+cV0rKT5UwcGG842MDIrlxFOG3Yv34AvjhXvp1P+8Vlu9JKTpunvtUtBqiwC79jwNxZ2O4
+pbRJ8kzI0a1hrl2CuG9Jhc0Bp8l7qntJTm3ytMxNnVnya9pT05yk+tJqNpht1WZihnUJRmsSXOx0
+NBo/RgMpBpbGYsTUE74Gf6ATBm7GiJR4TFB1m+n2GuzCkaeBGbAmA70wOPzwZOahz7QS0OMDMcI/
+QYUYRTn1t0uAsDGbU7cWf7+DcJmu6gws3g6u3Y6w1WtUyfebDn5BDzJFI7OhUOD2VCynksWqRQxS
+IO7g4MFUEci/WE68qJG2y0lqICH6m8sVl+BNFMWufKjDglcSwcc+SV0tR8uTZV/3m8KYZEY8rELe
+ni6LL30WnFp/OUxm58qS8rSfk9gRI3FiBOqTtGbTcc3lm7gdY5ImMrlUbuKR2g/eAhFm8fDTwjR/
+XGWIZO319yWA8K4bBQ/WikmjNe3GVtWNl76K0bF36ZOt1A2vfRqqz3RQC54EA9bqER4t9QkpTpxW
+zRQW6atTxt6Jr9VeINdRf2Vp6W9dZXv+rSv3wr8jvQ6ZwuDp6uOKPvEQCeTlJS9PHXhv6uAwODEj
+Ojkhkr3/85EARySRsCboqVHQCoHsPp/vXIoBovAUMAGm88/j75/H3/7nOj+fj1IvStd/qe3oPYeL
+m1iv4CnPSIm41BJ+EIqSt0QRKRslo6Vu5ybTYRGXXWz18edx7qF3ihQV1IK0u7xVK0IiSjgpRG01
+HsuYdBmvNp8tSSC61BIPrYsQo4z9s9UNYV4o+2pwhqvoy7v3jHDDA1hn74cKKaMPfqJE6TJeI+gc
+aalxAuxXKAf+E8f9E1vq5HY6P/o+jZIZj/XP8mcJf5Yg/9eufMHj+g8FYaDokNf+R2h1ksZe/SwE
+wxYooLY0VIrhEZ5JZVTQGGalVXjg8R6lMSkvk5GYmWXmpTmdVxhbfqc2SVxgSG1DBWnNG0Jklxl5
+SXxc2yvdaSxvahbZcpp8KplJTsKOGWH5iV4SwiX0MYk+UOgXIZ4trJMYB8FUdXnVzUCi8FMTCjQV
++ZkVwW4ScVKVhkHTwioXUBcmyrxlyFrSmCSJEVxD3eAyLdd/qAjFsOQgn/xfwvIKMm6XF7TEflle
+acGAkLVkEWjk8sIPqAjtdRjQ1CCoMXpoHsMyUf/rJuXCl1iWII+uQETKprITKC3Btf2Xa8ssdX/h
+cSvjPjzPiHpMlvQjSwjzOLA5lmPNm2yLqzIx9IEtEwOGvkhkpVhOp2zoKFEF5MptCXGRkRRjXr4h
+E0Wk7JD9pbKcSpppnHe0Lg3xJ3olpm1JKqNCJ5jPgPgElfCAeVAZktGTYYRoFB0SgvnmUsJiIFH5
+c4WrHChDlJEXG1JJcCwpU0pvXg5JBmZapMfTkkfohAyQzFJcZZdLW22v8Iga2/ZXLtJpqYdGkcI2
+qROzGWFBJVM/WlB5RXwlCYAjtRwm0ZhTS5PE+CVBylvALiCcDOAj4QgbxaVKd6O30dnsa3T1M50I
+ORbksQARMt6fWRr+zBZt44Tb6Lkhh3qUpPKKftVvader1kFxmfP1H/aXNDQvchDJz0POSnkNG4WY
+Rdnqp8rRA8favOeWt1dwKOa2IZR25gZfOR3mjkJ6r5tKMQwd/3nkry+VMBQULiPo31oSRaRcMeAV
+1aupxF4naHASaEDv/XtsCC/rd5IgSgiD/LysQoqGSA0WTxjK9R8eob8MJKNi54cyzxAlZIg1tGxA
+E4YWIBpZJIsLK57lJdbDYzmdwq4WFfbxSsFA4hYJQvkOhcUvoSVmSiynUzTT5cUM8pceRC/OiwHu
+Vdw080rRSG2nSrZzydohoDixG5rv5krTVJbGUkz/kVQySg4bZanrLvIo+oiTaiU5tF44ShhpUnRY
+TqfcE0zUCpFdGm0O2SV5zwJrc6gumfLoBlriGMFAso8Bh0IB9EFMjSKo7ARUCW81LqGkh4RRbpte
+tMXQDFI0ZCuFOyk1A/NMzhO7cfmSQNaqFMT+DLJ3l+sR20tKo/MDrcqYyorsgMbtBIoTZzzLBtfN
+83SqzaGaNWdmtlQK6l8Ia2M/ETfPfSrYDPl5FHjvbMyuPZV366i8awv3F4U2VEjjIPt4pS0vniXi
+WwJkroc1OcZhO6Metg+U8Hyh8iUYVAgkkot1BZiQeUnIAS3iobqUDGt+trC/Ursp3Wl58Uq6RfVx
+13zkCd6eKhlZ92RA067F71a067V7t03tH1XRDXWngFvtdsgWRnV40LxkikZm09raAbi4o2+ZD5WI
+9kPBytefxO6Q18e6w38cW2pIhIcKVsrqZFWS4bnagQgkPivSrKjGykqsms4OcCuF1m2dtZErs1Lr
+goFVW15UdkJSqYYv8/niTXlo+MlVx30qM9+VF6GljhPjoR5bxMFvpEV2q2NNQ2UHbJk7GjE6di5s
+O5W63RTnV5tni5hZcbgcq1zxlDePf9gGbxT/Tm1Aiu8bB8036/DOG9YOSN1wTMrLZHE4915VwvAX
+oZRxZbeG5VVFtnAdwpNnccLh+BIpElCni0o6SfDNoUAeq0pE1JiDwTYJsU1tzZkWlWrAlRAJr14s
+YV3wC1hG4h2T5CcOqSDkgW1N5RVyA5UcDDqo7qHFULKqQyUNEFeTxyQYK0L0U6rsplQR2wJFosT0
+XSgp8X4RFE2Hu0TGaXJDi5omqQ5yuHE3RQhL/EtY5QbkEtb8vfHn2S4F541LSm3zJm3DPrDmXksy
+kAQ4eqw1Ife6ZnhcLxRjTNTjq+DiSjE6bp67UxyzP3cJQn5uBilObA67saa9mNYtsZF72K6T96pc
+0iT1tbfPnHqP7OOu+ciTfFiBB063xIESH5ibAbp8fUo1eSTkUthUNjO1aBFs7Rf3CwGYnu06KjqE
++VWbShgyc+XF7LrjavZ6pJK11evaddcOxeDeGeaRDGA6VFCXDOXwhyNDf4WvYSAmJJF7GDiTbP8M
+hP/IEXMLsIawoTxSF4wIA0QOmwEOoGD4FoRpyLl/m+9xeqWrlgyikUWiP7b5XqZX1OuTDKKRRVoW
+RWOLmeC0JwqoLVJQzWo/RfQRVML+TlhE2x4Ji5sb+3dhhyypUGA5lKRxKSo0A41jTZdkc03w8Bgn
+s4tM0XxmIbMUV4wrhyY68TqWVE4ybHKZscWwSGViKuHRgsM++ViV1ShDI2amzZEiqFzY7cKn0kiT
+LJqLz8YaoUY0JuaYQ88aWTRP7nuKmjqxRtTR97Ek/SmTqZ3kSMlfCPeKuhd5DNhxal6bbuq0HY40
+eues1lgj1GgGkwMLs8Ya8TPtP9PM0RArMqyHSTigxedXqey6U1Oc4/gakyRWei7hxweMECNdhmqz
+0ojQ0+iGlYuN6xU832y8XNlyZwdm/p6/ovp1znA33JXh08gA+nzbGOdZepNRvYVH3mqOnvtUiqLZ
+OHDYpi2VUrcMbBRZXZrKExJqxq01gDtker07Q/IcveK92Exqq2fvhlkutc+vsj+/y/4kz9Er3suX
+TTs7g+fCbcO5cNv0FEjSbDQD3dBuk24VQ3djTesWYpoUJ3ZDza9uD1zIK6bolLuXpjaXlNokjZ1p
+uFG1v3DE5xzlkn7LHvfteswteczteLiteNw2znHfNIdtmGNulmNulMNtEqlkfciVyVYZRgj61ITZ
+F6CDIORu8FbRJlSm6gasc/tVrBGIDGWS64KboXtLuinxpt0ctbpQP7+z/vwn60+6KfGm3RxvWZdz
+snrdGnJvhDVgVn5W3FW6DwONvbXhXn+r+6z3rPOsrx5rdDhqBrx5C4hGFqkZ7Dz/XYBITDm3up8U
+6jonBtcjqFglQGloGYLwwAUAqRN5iao4zU+LKPXYJyWH9HaHcFPKeVN1bN4Mmn4ElTCg5SOlTSXt
+jYIPm5tyEjWhnlr0SDXyUpIBTSW9U3OYolM0A2hMQVtFuFFgvxp6fLoAI2/YQWnUjbeKFDuZslFQ
+hWi4bFLn4woPzL/e6Kl36Lz6V1RzigpNQaNYp3foapne072z1YJGTHui8ac18ERLrmGy4yYFts8+
+71js4VHCtgRiUsmRIdwU+iAh5cKVJpK3WnLTkuoj4Ja18YwY084lQ1HydPFW+UUYKrVNMmfEtowl
+DlKKk9FNVCSZ5A6X9C1hQVgCJZ7LwBWxLrPbIjsvi/fURkJWUHlTAgDTQIjdeU+84pal+T2XICsg
+ey5Fr7PI45fmldMY1EfTfEagKCrnIdqrferJYXTcPHt/x+yNPHRupeh8hsO83BSeU9QwE33udf78
+qvMnOYyOm2fv79jVeZau8VqmK94VXYtKzMsFGYcJDqAZjeQwOp6phlVo3uObivnJFeSOZ4R2PiC0
+y0ZHiooaMUVFiOJ3+RXA4HDzLK7cGWQPWBYZ+lyM2Xf5ek91KCnkRLe3jHBJKjtBDxrEcjpFDxtO
+tXS1bZPEiCDj+RAATZGC6iepjAoaw7Z0buLOxzJ2fJ5OAaajhKTQDGYkt2THsxYizaBOWt6he1i3
+yS3RPLvz/aRJcaL5ajtkFAw8nCGSywXgUylFJU3HBzj2sbyCShg48i6ZolFT0gx0TA7dvmNu3eG3
+7bhv2ZHf3BtAceKMt0Le8943tbp5nklqc6hmLceytuOpMLJlSk2FRRSRUaEZmI8Cq3rwyHvIz6Eo
+iNPBnfWQKcuVlewyLTzyxieLlLOw3le9qIv8ShRQ10ctYRKNqaicx3qqyWF03CejHVA+9+Sf5DA6
+nsk/LjmsO5bDAGr6SxoIobzD85krcVPJ59OM1ZcrC8TmcHpoZd7YuQF6YlXtBLPX3lrvn3CqFMMj
+rBivJBixqCaShi2phKG+ztg0pSqMKnkh1BOwUYSFT9iIMtKkOHHGs6HgunlWpxiK5qKlbvPBHNG6
+T+wmBlDTpDixOXS+E2fV3HB63K7joKZJcWJz6HwnzvzVdhZAO7Yop4gmvxpNWQyCNunvaRuCbtzk
+CI9tKWlSnGjxPDG0x9HSqB67sbdrxMI6LLwguMDktNQNo2p5pRWPjLbrtPtMm8OEtVxV83JT9IDn
+LedN97HGa5gEY0VLpRfidQvOoCttBjDh03tGMKIfY8I9H95c1TurLdqisSLMOSwq1ZAgVdAsjw62
+mJ9hM2AUDbhSBFjvUDuNy0R1eEJWCj41rtgn7y/Pqd21m+NNYZFYbb1g3hVULXWn9HFTXJTmtKZn
+KKacdZef9IK6H2WtvJtz6S31PcuzS2oZr9rCktnz9R26DfhYubWrbuz6Qce0usnY4ebur9D2Sal5
+di5APj6GcyJGCpYfLmE6NwUXJBrWIVtKlqKZxVwbrktAY5LmAPnVEor+OorKktrEPoxPJU50jLGN
+0pOvUZBgLKlPKKfh5u0uE2TAxAxVsLVcLWgzkS4QkFhM75gPkWhFewZf1yAhG/bNDybiTZ7FaF/c
+OM9USn7KJn9mbDRqWBttr7pTiJofCB94VJvEqHqopAFi0TtaitGxc2FrqcyzmRrGTfEJ55mJe1Yu
+pW4GMGE88ya1FcZHE1rWSnd8ol2wS5J/sSgkMkWjNqkrMleimQUkD2agjoiRbsdMsCScKkrCklgr
+WUWifEJ2nNTkxmOTV3gp1aPi2f1WKsVACfIoWSv9wSSdBWmBJ0Jkv4YmH/a9EFOsNaGmayoGFRJu
+eXkFurLS+kQvvspASUOhsEP4PIDb4XuTH+sW2CHaG5Wd8yU3W9rSE6eTLRVcG5MYi2GOt5waX0tq
+eOD+Euy/7UteCL2IuWENuW1a0TzYvi2/QzHAZzRUo+u7tmCUJs3oety4nF7T44rXmRY/q2BE46DA
+ow9tQ27atvpAKM6yCtLwrE7j4/iNz903fdi+1cIdSMBMSeFpwC6/+I5MZCVYEbOMmRENJzJYETLl
+ql44W9fBnUbATDz/EmlW2zRAHj0UAzUllVGhGJhPMzCTAqspY3YPy4I5x46SdpZzncvZT0SYUSgW
+Mi9B6zb4PLdwapNSnKwlJ120F9Sjo/AJYgbqNCge4TnpmaJX2k3pTrNiL00jLPGM1WPjzp1vf9ls
+Z88I6Wvnvb3WopI5Vht3FyIjPA0lEgaE2Lek4i08ORdpAW9WtRbGi9m3pAIpMo9YAtPEq0tlRizf
+Kr9Se7LMzPSodMMdY5Ear5IHYDlnAfAhHMEp4V5xFGtVj9tNxaDQkurg3ekLmRgH2MYwlGIAh3Op
+w4Am9nWTd7ABnBmBxA0Be+KSeuRS/kcU1FWyGezhoR2MIxaHzcD2GNweHPA6qEdqbP1IPQdHnCZC
+gS/ngkM+f3LBS0t+hacdq0yhT209TYKRPTty0RxysdpneQaRR3Md80NP0MNOy8NfVozbVQW1vHgl
++agTvAYsDlovcK2v2c2yi/SsyxtkbVT1nu7OX+/hKloawv7KxTyouCjUR5/DJSEeIb62bpSi4+bZ
+uWvlRKG98eGjdnTZXTArxnPS7Y1dnAP0rQ+rNB2nbzys0t40s4C3Hpkk8gw8XwjAXveejHDykN2/
+h0egoKr353hC6YE3k3vY6nuCxD1kftMXPPIhMip0AqrYuewoUqIkRXpg0gqAXXJIL7lKd3izM1Nq
+SJc3CqgcugCYePu123t66JOe+KwxIDWHMx50dXCXRr9407W/tA0vawPebeb7dv2VfiigYj8USb+s
+pz5BetHAa7b+WrFYC4AJvx1vJEam44jouGXSebuky7m8S3Uxp5efg4QwnVl84zTXv1UXbZdL8I5T
+cN9x/Ok9yONofQ4XUAeOAfkOKhGBvaL3xIkcKU6c8axF59IsANE5UFBlXTL8Mp537z3XPanrAROe
+XhLJUiuue3q/fk8pp8mSp7RjhC3FoGQm9NbgGi4ApoTQHhMl4+w4FTo3ClWHutEg0/s+8jOMNClO
+7IbsM3DdPE+n2hzSzCsQkhrlBN9nrhxjA9tucMONkuTb88A1YLp9cUvh+SIzpeZVv75UIoa10ho1
+bWyztjZrapstbbOhzbWzhYG66yYdDboohwShUJSTwFmAYlJFJS/+BJmGF+wkxOK0IzIvk0LxzELz
+rKKwWS/Tlc0IuoIP6qvhSA678WeauW49wvJqqac+OTsezH4pZ18nhTLZe1jtqTOnuj4MYIqtrphh
+jPAVCq43FWEuYVNJQ9p7TpNPJa0kkA7o/BFKfapM0ahN6oraIaU+zXakSXFic+h8J8688D7lCGU3
+SbfjKxjQdPZkEI2akblZ/qdurnL2YaAm65lzuE1Tzk8yiEaayEbGGlrYJiF+L/rdDuG/yHI/yjBI
+0VCzuhjpW1iGAU3tpRKGa5fcX8mzj8jLTbF20dDXmzbuGjIatl2EpnGZBOOPzIqG/MAxpUTId8Mp
+xcA3UYe9STrmC51D310cywshH3m6qBQDuqSmUg2U+MYY8QRk9eZF0kUWx8PW0K+fAGjSh98Vzbyx
+apwlkRi9p23LySksa381TOWM6XPsKmn4SbPgH5weSHSQHNPV7NwV9Sg5lXI6Vf1wm5AUJ854dkRa
+dKSApsOYZMZPmhQnzviZay0G+r0Ep2mKA4uNijA/+SopKRTP5TQtTXdbZPaqi04Oo+Pm2fs71jY9
+q1ug9ur0/SSH0XHz7P0du3LQRt5wFtBSN30yTxEZIP+21LYps/xmOsttWk7TXSA1PLXmmREUP+Eo
+k1KcbJldinh/6ai2X85QZnR/qUTln8egEPUVeH0ulJV4sAXRKN30ShHvOZEQh1a/rkYm0FDTSDpA
+nJ48R690p7A10D6/8vgkz9ErLo+PzwP2gnWs8aqRDakxqewENr/GWg7ZgxZ+emi8dPbzsh/UUoW7
+tnKfCidqqs0kLjUc2onKiT3rUZEtz3qu0N0AD1oOPmY5ClaQhnxMdQljxeXZwA2AS2B9VIg9s+HJ
+srHlnULUKoHkLnr/xn0uAExHHirFINoImaKoRCRKHrqmMAbWEgbXhYb2ka67jcEzyrATyphvhQwd
+65j8D5npb0rYwujng6/uj/cV8CM2Qz9WcyyBS2AkNWId/KIDJtkkx0KNy9sCakGNDgyrS0j9D76v
+dmBHPUrEmiNA0pb8pd8oMEbEN0KUVb7p8m3RLLLofbODrcK6/CWeOZB0FIJPUlFgFOpqe+wxD+5H
+16MhqZyT4YBI1Lbr7V6u9l8iJwONoaTAtStAs/kJXEQFw1MtOFAd7hpGeLZ16A4nKLbbIclpMzJN
+ihNnPEu/HZecZo6fNClOnPGakWaR9ZmEw55APOwJRFJXdIl188/SeWf2mA8mHv7BxKn8c9Okbu9Q
+uKZGohF7KiBFQ9QDXE5DlgSFxA1/0akS+y0wASWU8lMYFJIOpXJXf3NHf6cN1/XvtPHjcljBfKe2
+UTBhY6ddhA4iod4Jz9pe8igWr+Ilj7xgrL8Tv+EFgEm/JSCEquXQKJLKToAsCFG/jLVckYxmfbN+
+nUV+MToqNAONM3+XAIIRah0YaO/5nLc9H8qHQ9+4LXQJlFh1Zf7NG0VvvBR6rS/+iMsP4mWl8S/O
+H1dOZ8KzXCKvuBMft+C2P/mVNdm3z+MZKKD2RNH/LI8/y2P5szwuHH+W5c+yxD/Lch3bl+PPxXH5
+s8Q1/Fli/7PE8WdJf5YUOsMIgff4lToQhyulck7ebjHcabyqWUBIHyQ5ql2VSg8EYat/lrQECcoV
+ppJFywVhglDjVYOl9lFX2Sz4NGCKoSHsEHiX/6LLPyYJxJIaQnGUHCK+PQEJg9Q1trxc4tiuWKlO
+Ljm+VHbA9lSZotIVVcIW85Ym0Zik2+VzWFfYwjYmdcWRJkWH7HWqE1ENct08T6faHHrzSeZYJ9MK
+Q8thISS1DIdwbiyaOfYRVHZCosyhTCrnZHGUrV3yg++6A0/CSAZl2uAm26pI1nI5ISJFBbWwu3CV
+wW8A6Lv+IjNkHyrht7JwAZgqwv0VtuSQkRQj7GlSnDjjtez9Vbc0CQ4ID23SoS/7kMtE9cwjTZrG
+rChTOSUz9jRJjFiUT0X6YeSFArYrxMqEiECZokIzUFe0UmgkksYdqwFMOi8GXm283ssdyaArScW2
+qxtfBojExynT1lOjEHuvjSJFAkak0PZUkvOdYTmdwl3Rq//80pM6mHzl3aGaf+RMmLZ+NCxHCZqM
+Cs2gKylonVlfTDgBaqHLkECe9IHsCgfpXVXCkDZ+OnWyi0iTT9LGviQWb6d3o+ATwZM1Pqn1qxaP
+Gq+tSW3PaUme1YWy5zUUh6xOfjaZzxl243USjSVrlYHT3JJDmtek0gyWfE0z+ZosuaAzY9SOvKeY
+JsGhBYqiMpvFJvE3tU89OYyOm2fv71j7vPGlPsXhcLqP7NKO0/Bzr+HnVw0/yWF03Dx7f8euhsVK
+ZDzzTXPIkTVejZwJCergA6on1iAM1bxW7Yo0ByVZfZpKNaj8C8kN3/LX8QzFczm91p0yklescy/V
+xaTo2CW3PvOg8Vsl7GmE4tmqoyPBxsEcBTjLANSEe+aGzqxNr/ITfRPpcqwqYUB47CUZRCOL1EYd
+dqQ6mvaQkBqv5lyNlYPe/golLwZqqsurthhG+KWn37ol2Gt0GDxvTjF/ecL+r2gpI2mS43ILcoMP
+El2taNEKFsu4R8KxvaVQKKA+dJoEPFaH9GhBZTEop6F5jTQpTpzx2BDNTVGbn6JeCr+KJlx7jslz
+N2XHGxvQ5jsIomsTmjYAdeE0pdmE49pZeZpSLKdTeHZXdXKaWI8bu/RzenDTZ1q4bt/1LSD2rytg
+L+E43Xj4xdd2U1vToBCr9KFY8L4nZGpG3SLLqaT1uphHaDA88lfmFETZ7Au3sXL0SncK2zDch529
+Zq4zh9QmzWglrcFpGdcWWgaOI6ZJSCLhUVLYDGCSBYjU3mFjjd+4uSNgFk6T2ztfO2KXsaWflCdc
+8fh8fOp2xu3zZItXMFLvGmP2LUDIUy6p9z0PlXDA46mEy3TFj7CkoHIzkGh788fQzFfPDRmlp7zF
+IAJXoJ+wbenP8vVn+Vqu//5n+bqq8lWv//5nef5ZnvnP8vqzvP7JG8Lnn+UV/shN09wvueCFtFeI
+EsgsEVKi5TtZIqAixKe1XiGN+tzCeHmW3L8SwgjRICTx1xdCxmnkF/Z00rS1rsjKf+F30o00V4um
+Lj9udBEjnnJ3+BWeLW8qU1RSp5bRbvioh8XnDXchXsH8evgxUO/OJSMyPBtC+mhzcivylJdQ/hqT
+mGoNmwGuPpyiPok++jHQyfSg4Foekal6ooDTCNsZQOiF7xYoxKEgh7LIp8BTn8oJrCWPV16o7GfL
+Q0ooMQWVmwHSp0EBNS9JZVTQmDpeqaWbgqhvhJwdGjKSGX3n1BT6IOGQaphMeQIwW3zh7sol0NKS
+tmeaFCc2h33yRM2aXJhh7irpuzL9Klc6L35c92Vf1yVNG90ftr9cWDielc1nDTl6tgj5sRhDMxNa
+OA1gSiXVbRKM3LdX27fXuRtfCK+aTaZoZDb15wdilRC/IwzPLU1iFL73+uIXUF/6wdOXfopUoG4G
+KU7s3dj7TqvuiaYwynp6r6VY7hIpvGFgbxzX+H7mS79Zyb2J2mlcm8PumdVw6i36jc/xwqAxmpkr
+zsqiIauT7iTbYjsJ0VzRP9vSkkrG4OJBiUYUFFPRIoCIvV7tbS9g0fhisehOPAsL+Tz2YUpP36+A
+ROzVhNJQwefyOpABdtrtmdwHlZx+mlaSQ2v/VF3CoqkiZZnuuvIORTsMaD71OUmNeKnCUM1qM4PJ
+NCbRaMV1nJo37ZwNz9uD1GtzX5q4qX3qyWF03Dx7f8e+nM+9nM+vcj7JYXTcPHt/x64c18oy0xaX
+1Ld+bpNN9xg9BGw9tTeA1dbz64ZuHyxpsJihZYx09DRJjadKGvJSUmqeNaJw+AytyrBqDtuNB/di
+bHmbVBqaWdwqVNamjryoDCxNjix7aHLhqNSBI6tUg1nUhCf4CTClcKg0w19AodDWXHgSaGh1MwjP
+5Hl6THOKE2c8O1AYVj1kCyxafOHHdog0pqGSBk2aN77q+Qo7p1j7Hv53ZP5u2ItPqVwyJ5WdwDrt
+KA9W/30vVZPD6Nil0Lz8p7ZUTQ6j45n8Y8lTU6muduredVzLE6p7koEj7i08wrZM6sAlbwZqYgGg
+FCfLq1mqsEQq3R2fp6mkm65Nvhm6t9z8fdF6DJvaPd1N+1U17j9O1d3ImXwGn/825vOrMZ/fjfn8
+pzGfm/+tMZ9bYz73xnxujfncGqNnLmjEmFTSsD2rAU0QOjtsj1ZzmZTiZC1PFCRbaigGKRqq68X0
+lKdMkmdEIFGs2xZOh4yEwHGjzbN7u5/d2zPhHSRDNUdKTXVNOhyqW1OpBkptx7PlUiYxW86Gms63
+WubgsWlXc7OulutQqRnUkVJzeLNr4dAsD2emra8qg8FwmBfPNsSpWyaEYZ0MnG0Z9ykUDMxLJ2RN
+944Sopr0YrmVMMI2SSuuSp9ay8lrLjnNadXrB35yQUAj+6ibQzXbzBq/aHJJDsli5dUx0qTuklw6
+Phf50q8tvEJDE/huz0Xchis3rF4MtpUH1FU34Jp1Wgjsw5i9utpezb6p+HW6i5CZjhgbLK1SMJ67
+OUIUMDewjkM9rs+j+tDrU1BX1GLsGGPD0SY3beh2HZzKCDCuJQNOr4hqrnWdRCMFvkrzCnNFZC6I
+cIicj4gOQt48U/AUoecGFtZzZE31vNA5eGlF8d35dWaOIdLxKITApjJFo94dJlO0sJE2tTVK9Fcf
+PN8JRCMspzulm9YmTaORlWi76YWo+sHBICBxUsZ4VJOwch54Sfk2clTNov8Ciu4LRMTLI2uQMOj6
+zCjmg3CEV63LC8fsMcJW5fveLz65K7KoZJLUVGq9gX3yX4fJ7JZjm1mwD8cc72MkPWSM4aJ5EOCT
+wy97ZPilzwy/5kPDL//U8EsfG37h4eBXGB88EfUKB6/2j6+vdDhKcTLTQpH8jm1BBY8Rjv8dyEl/
+T8qwTx4O83JT9BRBgyR6p88lzvTVZSXt8Wd5petfLjlS2NOmMkUlVFOwExqllJ/CnvsLIEWmsLO3
+knxN9RL9O4zkELFIPljWYL7cOIm3Q154WvOVllfaVNKABdMk04a0fPOgmJZvXgORuuJfg0QbysaX
+hCB1GXMq6tqmM2u2fLOu/OrQBbirr8TYlc0HxYkz3jKQl0cJjJavPRBo4tvShjTz2EuiUcWxGqwp
+Trai+6FJjl2lGKI8JwgphvSN8IErPxKjkHfikq8A+iR9Y4+6QMdIcmu/ojwBfA7AkPEUyGVHyPJ2
+TYs6s3BuNR4ckh4akl7xAEpqjvuYymloNW7u62SXqu1s1s6m7WyznS3f0rCuLVeVjCkpxNQ8uwhL
+VixbW+Uib7cYPl3lVa0CBZL2RGHjX7mrcnCWPRWNwi/8Gpq51PHyrBGa/1q3mSUUddmDdZEqGqUS
+z6peqBug2wbos9+77/dumaLZI7xUwmFwM2AFMMmuyJtmSW92JeyX36/6Von7d6lwPy+6lxfbsfUV
+eSXJouQlNU1DRsTa8dq5YEmnNrb0JMe8MtKG83Eqp/xev+yRYlhxb5ZQTsMOur+FdLcMGHpSyTRd
+I3TdC3wqwbTl8RGoCMMYLSwjbOO3Dv+6JHw0eDIj9pbeadMuW2tLV/Qvtba7xrRdng08qZzb4GuY
+lzoC3v0F/zXzK7Wwp2PGTcPNJae72scv9RaNOowWcpkEj6M9Na+jydIgTm9YPE8YNNszHiJ5CQOQ
+VNsW8iUrjnZYBLuEnvuIGhspORz/d6Sm0nZ5UzRRs2TNpdNYSsa0jDBB9JfKFI2aUVfS1Fvaviuo
+Ps5CWk98bfSVWv1OyQDJax0qYWjhccBbR2nDOGhwpNvxoMiLge4ol7IaqD+mYBfB8s5FJQy8sEkN
+Ve9hTzwo8vcvXzwe9W7dTaQ5UeK66AIMCIywzgMKrzRE8ietnNJVw6w29cGTnABjs+aQLe/tmEAv
+CukTPEIGKXZ9fUcJRqyOiIShVazcXrSpTFEJTlLCjwQ6gft5hGWRwxxu7acfFPbDkn5Y+I+24McO
+9z/uaM+HAeRTNa/0wz75kQL5EgnhcrhcZZUqX/+4e5XDNrLKLtBqX45WPUvEI0iIxzxkXOUlPKvK
+sE3qigWXYMaM2AJlUhkVOKzBp5Ka0N6LqkpESXflBb2V8X36V+ZYAWYK080ZDZdJdl6+Q0xpEqMC
+nsYg0Zg2laz/d9q+0uRX0uULUbXy32nb61+HyfKb8MrDMyP2FAxoovixci+CsSQKVZlNQb9yRAHo
+U63/cX7JsjybIzLHGm3mJE0k8pJpRE5fsa4GHYTuTl/s7fSlKRiPB9SUnJG3oJ0mkV9fdVOJxWNB
+blaieQrgc4KQMGx1UymG1xFeR5gkxvLgHRwSjBHhI+n8bSpdNfXJbbwcMn4JcrjM/GK1AOULkyAS
+jTjTAMppqJvPFM2kpO5o+4/2z121ZHgAiTSTrXmLk1Lz7FysM/ZW65fDFJ1y92Ly5vuyYa/C2MWD
+epBwZm0zwtCW1yRGBa4VZC6YYlk8lxKeFbkW1qmwNUVbwiujXFgATwIC3UBdrI9Kdh1ZtCnFdg+7
++ZtLmYl8Ggp4j9Q2A8Tg0J7XkFpQ4CQ4r4/awkcOiGtCGCEaRVDZCXkxYB7sgRUVku/rbs/g2SKO
+JjOhzMvqvGJGJ5Ilb7j1nOU5mrzuYfuk5JCREMcf/A7GK+O8sAX7vVBVTiBmDhckAn245J238MOd
+a5PZ18YlOq926lzKUoQZzvTBNSNbuX0jRAu3bzPDV3e6jecCvbmfcabaMGcVCT+cuDaGo4V9Eozj
+Q5FU52gUwijcxgd98xOeKsVbctt1Jpb39HOi8/YV3+9Wgvd6bN8GMNWCGzQkGLEUcUn+WAQYF2oX
+NpXq/xMMaMpJpRq2pwFN0ij0w46HT0SK4X9HRsVaWCBkCLe8SFc39y1L004wv2V54V6juVzch+cZ
+oe5Nr6um0p1W203RqJFa2DwjYkeIzmw7t2vbdbM2/N61gB4wmvZT26nDA0kb89IDaWvHrlKjjt1F
+Sh4IUqFIUaEZqAuTdn3uBdQmqaP31A2KTwe+cn/VIiOl502W6jHLGVzRB3QjTp6GW4rms6OXkIfs
+hSBrR1czgxGelUlGalYVKVXerV+MUlesh8dyOkW3g1NnOjpCTwihZLThrYWYYVpOCraQOtUS1jPm
+MClNLBOvrIqc3krFDiGyEwZjIubcpbYcJ9Arb+aX+TMyxs6F9aJSt5tifpqR5Yi9p9RWv8pRW9Xf
+svmPqatNXvkwTNEpVgfRNMX+Okvx7CJG8mwRJfThOS9eU7drDUDTd0vcsxKSXbWtiwR83KcucriR
+J/qvMDyKcYqUDRJNwgctX3Wp/HSOIc1cgSM542kIQtQIPyrFgAegL1FOAgd25VVU5SPRVS+naoYZ
+P31DgAeOOjW3Z25lEiPZIn3UXIDXY8Z0xQm9Zur9DCrFIDPT+q0/HX6hWwNRjTERolHQA4JV+tap
+WS1pCWWRHzkq1M8+0qKfEbhZzqnjTZBLk4fSQ1e1UQSVas+LAZdiKo+atchlai2eEYFhJ7I9mUPe
+xAW3j6tMsSqfRb5kU9kBbDJmW3XFnbG6xZaX13idaOQWW11a2OTFFarYZYzh9/wf85P5DGczterj
+UrV2FU0lPRJl1hie7C7SIVjdWrYoKErWsqs0dH/hm/lK4rnLOaFydlI5DRE5LcxM5yZ152lXADXZ
+edq9QKtETKYwF/hhM6Nrd/5ivJIYcSy+RFKpdhTFgzTWwq8DdPkzXyUEJAN6JvqyMlhhqw1+KVDQ
+CN+kPzY0+R6T2l3rXs3LXbPKJz/Zmur29OotTtPZU6RUaIdv3gyQE04ztWU9foDghLvetd0acm/H
+rRm+IniUvOqD5LUNbih8HxfQDMxHQSt4dAqm0qHZDh3FGLUdB7pDgmsRqMrx+QcX1iIlHdYFK1f4
+qhy8WxpZkjc52PChKn0squVeFXpOgEERKJfXgUvmS9G8RtqwcGPcTUnREFUxnj6OLMusMmyTwkTc
+gJlKvGnda7PcS+XTIaaeTvmkmxJvmk/1ueXpYlwDLK9Z7U1tXCu5cA19UnI8MMNy2i1yTXdNI+cP
+ed3UcjqDMQ50wNcks0qm8gNtIr7TOSnFyewOKJpoJIW8TNKqQOMQpDJcDI+f1CbGSdO4TTIjfzTN
+Kbeo/XXX8nLXWRW2QRubmId6541vzugPvghoy3E2BDBhjefGF6pF5a/oCWvH1J0HY+PuFHPXuaex
+evW6hjI5OTSXne+qmWLZHvDRam1uk21cEybFic1hnzxRO2fTpm/1MX9Z7q7fHVi022bbbYtt8yRh
+iqZx+8LUio9N3Sl93BQXpUn4uY3JfSotLzcllF9qcbk0ZXy5/N4gZ1O3ebu0nT0UDn5BR0l5G9dt
+0/WuMZJb6+zysSvgkMrKbb/j8cDERqAPEvdHxUTlFCGKzF0OXpgcy/erljSJLpkmjIdj+VafosnM
+tUwfnW4fmBUdsaT8fF0kzwIdfADhEH/8FNAlmYGcao5v3MI5vr8DhZhXCUKX3PjYz/XIT2oK3WCQ
+UJd1tU4hmivKXVf47QiZ9a6xO7wl5MK4yBSNpq2ZG5Pr8vlB9RshE/PRIgFUe+OjRRdotTf3aNGh
+S5JcwDu2b7gP5jSsZmNWgnvAsYclyAcdDGHeA8ULUm6AcgpxiRQVOuFZU3M4zSxSWSPsJC6KpdVm
+N12/J2E+IYrlZyvxU/lHNc15tXRrUvrhr5Y4RXPRp3fJ5qXZnVhXVUQyVLPgfg2gnIZWx+Lv1xy4
+Wj4aStjYoRs7YtMe3LS5rCKiuUVby88Dl5yHrgAevEV+yMnswGrOgUuIg2s4x8DSvUhEfLipBcT0
+xr51ynnolFNQ/rPkcP0/whgTrphQJbhwCTFQQB0tL2nSNMpKFljfs6GWJsWJlhJzQ/Bfhc8ti0+a
+FCdaFh9msWT++hyXRvKSWqXYkkFXyn+WfOUnO37GzpyvnJ6hIUxjpEkdiJ/lUKJRhARHGLIdcwnb
+aNXgiizS5yWHkVRGhXIqwfM6LOY1PI7LdV0TvtKldPlsywthipS0yj6YN90FhaSLtuVVt2AA97xt
+YWRB/AAnIRp1JeaCDtMDJUBuxRh28kwAy9VG2RRbWgNFV7nUXaMGnxZyirqNvCjphMRrqf3W+93w
+O7lOC2629CvRLxXuO+5xKnXDjKa1gDY+j2JyJJJs3m0Lj9AMOkh+WplAkwxySfq/I33Ju1OZbd1f
+3Az7S/tbW73L8Nuvtdn6F6/a5StJWwJCfNMjN9mn21JURMpG2SmHShpQ3AW7SsQceagMRYnZHrwA
+JDKLIw+Z9xqW0yk48t7UmUVz2TVLZSZarr1QqRng+GQcvdKdYtUs9haSaefkT/IcveJSfHxuZSaf
+rcTHHkEjTYoTZ7zLjYdNY+f0ntnU5pDFr24AqNJN68lhdNw8e3/HVj9R6nZT1O8rtbQt6a7dIvFZ
+0buunbd+lfTXYYpe6U6ZlSl1OWZ/mdq9PrunHJsOm3ULb/4Y1E2Nv/R+12fJaqjbfwyh/D+mcv7H
++Ctv3aLXtyWsmn1p+ZFuSrxp3Wuzepe6u9qZbv57WoZn50v15mr112teKCNsy12JN617zVVvBD8W
+VLX90Czlt0EzfIcydyvV4k2dxVGv22/dckvbcIhldFNnvvqF1KnV7a75HMkq0Bk4JzaeDVt71T4p
+K+EMSYIxSyi5yf9Sr/CZeConSV17v7qt4wkWSEkyJPXVUm3iCDEVlRKp/TSsg6SX0QnYIUZ+hO1b
+ANZM85fqz1aPXUh6a+RP2lSWU6lpuovNaiY8ejZZInATNetPmmWsF4g4Cuc+Azc+M6ejo1WU3qoK
+TnUUO5kSvdRMJIV+EkQi6EvY0yRxPX9k1TG/5Wz7xrn2LRV9Y3Ne4h+RBSHO4oBOsln4VCwqGUSj
+Nmn6GWFTvO2TKMCT8PFlfW5lfZJBNGqTpp+RlSUbmzfZ/41/lu8/S/mzFHkFu/BH1ov+sHrRn1W/
+YA3bFjY+1VzwonYJ4h4jvQRgTwih1Gfa8Cy+KrI/iSLLmUrwkDA/atskl7wixC4itA0DOK+oOJ7u
+gmQEq5aRd0Z0C0fMODdODXk22mqBfNczmCOV7rThuZxeEzepvNZ4fcjT4QTEP1JTmaLRtDVzY1PW
+h9rYaq7rAPogoS62nGOYTEFuK/xqo2Ce1YrjD6QrlXOyZV1ZI/mWP6Tmc2hjhMzNcj9o2xFGeWhN
+iVHMa2dOuybeEc/wlUqZJEbJaltCGycIIibet5hcbjHaMJSD7wyJbCoZndWjNpXsLSLzfbJnBDS3
+2Q3CM+Gh2UN8I2Qy3Sc3vV9/IRy33F8G5TS05mxulQW/T1j0O3cXrOwwvIRwAZd8iLCKsz5Fp5Qc
+s4JQpIw9nXK0L/wwi8imcmalSleNoDlCHcwKzwcSJErO5iJEbUHChHCt2Aub7vkt4StXFzFmoHaN
+vyArhKwy07ivdHqtO1WmuNBSNGpG6qtep5aVtzQMcr9xN2XSzcc5aeXw2gXIyrC69o7u4QlIL7xK
+aN8I0eDvzuy++UmFwgf4i35EoAQ0GIMPr/6IbJQdks59R0g3DoS+WzTcGdKr04tDt3d5xeWCvKgM
+ZRJ7xpTutHFOpRjLXTvDPnk4NLOCVkuPvL3bJ2GpzNHi1O51jhdRU3Q4vVJz6MyGloMOpt6ztU33
+/97dKaD36WlNt0ZYU0vqjmZO6zxwXEr7nmQeI02iEa8qK9GIH6++CDVGJThJYE3GqzE/EIwjNZUp
+GpmNLSSWUxVLLJYD05UjJr6OIzwGrTlQbmqolDhga4jhd/REsVEyOv3Ia5IXWsLRgsJ1G/SRf6v9
+ri+qHtKJb/bIm6G0+5354IxhJ5dkoCas3FyYTErcjwTo0x/E/bA3f8ThL4/C0oMnHM8EjZmdulPo
+mDx5fjqveYaBZHci1EwHd5ETj67jlYcrtO84QSmMTJGyqewEqUHiW1IExuFFBpL66X2TyUk1ljrj
+fayKpDIqNAPzUbBKHbtKRCFN49STxKjQcwGxjMYimjaivRJ+x77oSyQEPifmtW4qic1s1szmmskm
+tB98uAF4GmislonqDFZlmBl+b/muHIEmGpjizULe2ndv9WNWMYbjkl8SSNzXQIgsvrTQLxT6FGWV
+AN/TIyDjlXMDQDgU84w+CWoYKjUdp5aK5lc0JWqCpeiSVpxfk9x7EJGiQiewDVWCPeCwka7tzXfT
+VJEi+I7xBdTxI1mgpKRzOzLteaEIxQDnB+NuSjkN1Qj5jRAtaZyypvbNBN/04hmEBCOnaQlD4Z0w
+RUvvhPcmS+KgeKc2oTMJR+872fAlokPeWQX0HwlQSx20cnjL1/9DbkCWvLwSRaQUF7lozvoBkose
+IQ8QPeWBW0gmSXRAYXn5/jc4hU59tBRWzxKBsXaJVNKkbojtlDnCRKKD89cXQv2i6eQ+FVbjC98g
+uICRo+4GMOE4naWbVnzWbvHcVVHzSCoZNWNsIF3KfLrlpt7jU/daH3fNRzLZX4oUFej0l21eHwh1
+W64PXojm9cFEuFDPevmd14el5cEzy6/El7wiC5SB3VrvKQKagbqYL/MEodVyNFE7t55tue35lcuq
+1F+TmOUzawqe4y7izpFtm7MB27OPuiWHNKMi2GSbbp9tbpktL7JkptSJkN8I2QHcKTZ+Wwek9dA9
+RS9z8S5ZyfaUkuE0c+xh79g4NKsEM5bIWk6tO1VYhrpd3uZ5FcsXvQrf6IJkBCsrAJOEuB7N+BCD
+SDWfkNw4/zt04/DDCgL9ZVBOQ912piBPST3waLVAjvOBfW84pzpjsuEERksoB+P8TlHOEvmdA4XE
+XrtXDUugSCK/EUrLa+DmrIEfK1LqQBEP7GIiU1Riwgf3u4qjceU+I2+OFL45UvjmCCSjmV6AJr7t
+ZzjN5kzTj/6svSi5JxIuWEjiK8VHzLqqLole0F8G3HQ16uP4hjBLKOnwuNclmRGqnjt+QEKJRuwY
+gBQNmVRYPTE+5N2RIq9JFL4mUfStiIK3IgrunF3ik3Y5G9VtOeSFkoJjBJcX8NOyIpAuUcAz88JJ
+lyCqHA4qDwdVd/SKHV3ONHxpoeirBQWnncrFmNqqjDi+X4Hf4bvCCPGV+1CWHaf2tNZjvNSo26Gn
+egwDmhoFVRX0U6FZcXpZe9L0x64SBnXQ4dc5IvCTdZBRoROYSEobL4SPesgEqA7sAdqewT3hko92
+9Jcqr9p6uinmx7OKcfSK99KKvBJXB4HJcM8emRSHNYCaNCPth/GqENAQsl+HdutgJw3tmyMitLeh
+VTmJR+vDIT0Sk7FiF6gblkmqXN/37jCZ8gQUi9evbylvLobZUuf9KEOakbbjCoOD8GDjD208h6Qc
+e+sbXY7J7yVKCl8O++R3UoUSQg7tmNXUH27yn42TBlKiD2vws1msnoSA2uCf24oyV+yr+GFNoPLa
+XyQykKvE+gk7xuDxkOAxr+xFKYjD7OvgqeHQs4A8sfsU+ErS5cfjq9YJTKYXvCQk5YXE8eC0FIA4
+np0AZkK+osr5hg8EF33mt+AZ3iKne5zsD/4mQcE5n6d1eW628LnZS2JACvRBUs8OIV2ydvXs5skV
+vgu4PRQTFYnGDn0gVzn48pFakU0lnL6zWrQYPmNb5jO2RR+rLXistuCx2nJsEvJ0dNjZ6JgnI8Wu
+LDCWF0WKChrBdPJTR+Xggt9hC37HXOY7dGXvnSW4wp/zcvqbJUD4lvA6gfwVx7/wPGUR8IwJN9Mv
+6nibv5wjrVvCYt36Z1ljkyD9Wdbnn2Wt/c+y9j/LFsKfZcsxtDxhm3S5XGr/s9Q/Sw3LC2GKlI2y
+Q0qrLig1xEmMlaOxQCLgEyNKdPuRC0ChWuMkjZaJTA3x32PUNkmir6frZD8EbmOSxY80KU6c8WzF
+0x6lmjydanMo5vJHv0x+iQbRl2QQjTZnxSqZKsPQjKxO+QpLMkAsP6ylpMb+MqAjDyr6ufQqP95Z
+t0lySeqUPjXgKoc+Qps2I81YDA2lNcxmBDaVKRr17jCZwqwuVFujRDXbyIvbOk4VfzjhZQpI1KJz
+g3f75Hnli8yQTPw85ELKkGZLgIOxEmML+wdEowipDEtmYVoWR93IT+waWsbA8aYGfqOaABPCEXIx
+oIm9c4xXbQYpGjJ3YUnyVwJ4/EXV/rJqf1G1vzkUlejEv8zlL8+O9XH9h7yJKIMiL4R6dKGG8Fkv
+KWnkRMlLKL1YqjxL1gd240tEyqayE0xiBWBy9Aq9mK1cDlZmvh2DQpxqQShKCwhXCLFJcDTJ6Cc9
+KNiOi3JqE/sw3jTJ4yRcWS1BAowckbBKuGSEcjOHsIEQf0Ccz0S4tub1y33XsxqCeaNIoUzqQNlD
+l1dJQaUZmgJca6CA2lCNbwnkuRnIqNABa1gzI/daDRg71DSmCXesDWF+YA9avrG2WZdv/clAQzgu
+rf4YwMQ60Tm1VAzoMJA3XqOsy/dXfr7GJGw4KkiSmRu374K5xiV5PFvm2zx6LV/xfpWIR47xVD4a
+KVJ2lXg0ZDIjNDu5VIWUJVQgnPY8VMLQeOYmsRCGS10fk2jEw+8XHdZ9QDU3tIeHIUCKhlpPPTot
+31Jf2eYYTxxO1/5WDWDCbikShrpRoITKC3MheEjYEk7ugGhkkUwlWDfF2iaJK74/X68MYpDgMmOl
+RBdKaowlUUTKRglv9oHsINccrU/ooFq/DWhqKXxPSs2zc9GsF4SIkWokHE1EwoxJfY1pC2sygPOe
+tsjZiCk5da+eThme4YUasm4IR2qr1oKMiB9ZpSfAdB2zImcs0SYs8Yl8n8yYB4OYl1xUwjAfVnCK
+RqWo0AwsjnBSsEcpdNshY+bIL30TxITgJxRZBZKvTNXLRUpOMQ8DZHhRbQadBInwsAYZh3LXyul1
+Sfb1lRfcbZksEUV+KD+M4JV0U+B3tNrD0TxLhNRkq4880qRumJcL/3eEorKcSuLVlkQRKZtKjafM
+OCtfhM5qCztCQL3eyaCchjz4iiLX5DWNsCXMPq5hyF4EIeEbpjcfsBGyGEn3F/NikSkaYY8x7lMx
+bzbhL+fS6e9IW2fdlaWsL351jHA5f32lRIEvlhtqrM7njTWC68STNWKvY5IaKZmgxbBO0rgUCWjR
+15flKbX/yhsFHfVK4CI44rpPbs3VK+ZpB5knjy9PdNyTt5y5lqxLyfWZB8YsIBp1JeeHIaM4Pd6W
+QW2TJH4LCwXVkVSWU0mjmP82s99yx1MPxAEyz2z7sHE5p9YV32nSdHin6YBTvfLwTCf+RBhwWzya
+ByuI++cERFa5BE2O88US+fNKqRhcpld45KEybB4tWvtrKqH8Ust5M1jSFJWwdQURKzmm3CjEmGBs
+iSJSohNI3TA7PpWGQVFbo6Q3q5I6myXQSe9kUE5DHpmmgrwkeZUAhtoGBVSGr0scW/iz1Pwlgbxk
+f0lUJn99YTYGiEZdSf3EUCSAG09zOJle95JHRU6bBPKwC6Q4bEuOySAaoYuNp+82HIZyU8rpVKdY
+atZtY0GpUcBBY6H1gw8eTUbEaJjLyVjJrIOVPo5OIe5yqMVFwzcNlxtnW1fSEiRYJMR7M4QuhFB/
+s95QzI8kG7jIJWCxb1pfmPFCbC1RgkepnM4VFhFxsVGirvjwc8K1RGlJiRxlABQoEwo5GpR0XROi
+0SU9GiaeZd5tJSNe9qSScNguyb5+OblPBSfYkhovY0tqOT5BiOnyaB4BpjHgwLTsfvn+0xWK8iWr
+EwWv2NWSF4RyHYHP6NWS8W3BWmTAltzTsRrAY2STKEQ2YwkPfk1xcvRKdwo3v2p1u2s4EN8N/W65
+qe9018p5171zbTeFUc+kMipsCupild57MohGXSnrPQxVi7lbFqxEw4/oGDKPFlWqQctpaQuPlk1J
+U5l58/Kk4G3Xypfyqr6OB5B7wIbyQpFp5XSKZaFgBc0N57bI3BrW1dbNKTyPNClOnPGae1qGyqA9
+SO6qpDipnJN1z7207BJnn9gVpO0ATo93cjgLsBElbK+E/TLcXNJNiTft5uhrxcnJVG6e46b4qJFv
+eQ5XcXun7JfB+3/STYk37eboKlvbJB1uaZlbftEacr9KScvkrpd0R0vPHMok15qnvo5KZZs0PUZy
+qNsMfYVTrcio0AnalEwX9UhNpVaDV7wCJ4GGVUsXihNnvJWzzg1xMfsv07Kl0CZpo/P25A2ci7tK
+vpJxKd3y7DoxNC7n1JC2LlphoThxxmuF6zIrXBc3aKru09V26Wp7dJ07dM1RZSiTWCt8ZfCC/1Ec
+OUwqDnWvMK07tZxOmRFp4rE6NDOTjXNPkxDLiUc5dNjo9KMcNmwO6/hjdu/R9QjCtZBSJXhuSWVU
+kBR1feRgsE3SWKRHxJaKSk69waeSmijZiXWbXajcnTI8z4hyGurRVRSlhdJKm0dFUywqGUSjNmn6
+GWn/bbOCs3YfX9TnVtQnGUSjNmn6GVlR+BENpTiRrpVfVpnsI/JNY+/RBG1/1c0AMahZCzFsk7pi
+NbDobRiom/VFC+NowSE9rhs30aFtz5aWwUHCCcclGfcV+pg008grWIRyGk6HzGc2J7uIlhenMLlu
+gaYbu9nGbn5jN928zTZvsy3Z5pa0CVG1X8xTntWkkBcZCDD1HooB03adFoPUMVMe3YC16fOyQJjZ
+4B0eJUQfjUKnPfWw7XzMDX24LX24OXE93AY+fm3hS8d9JuMUvaZddjSz6wjAO7wENdmoOO7D4rBx
+cbiBcdxGhmn/3NWk0bMyvHkr2H27b0eWo+mhRShOnPEuT6s5f0J1cpgVtpF33IaeaTMyTYoTZ/ws
+ucwecOPxuA/Io/FqsR6Nv/gprLE6UA8bqfs8IO3zoCNRkph74eBl7uDDXCAtd6CBowWcpND6A/c/
+RSZCDgab0pYmaUKecI/1cdBE9y00Bc49jnUz4KYEOWM3ZIcKj0nm8EmT4sQZPzMQ28+5/VnqVdk1
+SJAkxOqvrfyuYZOHjwkwIRxV6iw3/66VH4qwOerEFAmohNA7TSrnZG4bpyXVVc78LENaUHs+CrA+
+eGMIwGwy36EDcowbh3LXyul1ywEzWMUcyl1zqfChV6f4PDxrEq0aW8SFK4D6W1OeKtWgpooQ5noy
+V6hHHypZyNEHruNz6nfDOdWSPE+/ujmc5re5s68OjNZ1wVF0xc07ebSsruKcHvJZDoI4Jz61AFDT
+QonftjPUaAV58pNAk7Qn8UpHAJVJ+hKkITPBpqQ7NgBjZp8k1yUJ2Y2ADZrGCwKR1yc0sc5oLBG8
+xbh+aX2+sgrEY3FXZPBbahrOqZbkWdvldZ+4WMoUjdokc+XYIs5kxRUAaOlUCYOo2Gx4lQbSmmEv
+qVf7KVeQVp77DaugAz5v+MJQXWdOyEUObDiyrXjcSiSPTsSunMwhpebwZmfZ1GbamWAana911aXg
+5cnJlqAamIkAkVZdaTCOXvFeWji1ut21m+s73bVy3nXvXNtNYRSHs0A0soaqMr2bkdmsxpvmEHUU
+k0/j2SAqfWo8il6K1SVagdEVo4m6zTyndjptpJvyK05z0aaOwIUTKqfhSA6j4+k9t9s2Zmdvw/e1
+S2vN0l101W+DAWlrunmabZ5mPd8WnrqIc7r5y9JvpnTX4l29+1qNqK937eb8+U/pn/+W/kl3Ld7V
+u++99HI6RY8sVCczSU4UiHFzhdXPBlZ39r6OSyVNihO7oeW/Hbo1c0820si3iO4USw6tbnft5vrW
+LHtok3STUxk3pTstOcXhqTgLvxAfkrqp3ekpeqXdFO8329ddc67drUxODunCZgzbG4Eaq9LOjsJl
+Ujknm7NWeoyUJuFSRZXklJkw8VVzpzrHNsmSaMPxwJfITvBPKVFP0fH0OyfN/DLusSmW0ym2F9Ro
+p1BhdaotTWI067G5Ezi10/gWYfbUDDSWE561blqxbVZq20tYkuffcaqYTFtPjnW6sdbtJ91wvG4K
+M5jdPHenyh2pvrXb9cRY39rN0A9eQAjowQ48POfFazOt9uBhlx7rsSWVUUET5OU2GXSW0+klecXF
+bMOzy2Ikz9Er3kvbTq1udy2U33o575ZbZu901+7O88KQltpuikXNOs36uJLk/WRDM/c0KU6c8S5f
+26x2/jq2uX391nXbNg++G0PNbbK5sT5pUpxonnY+O+Zx7LDJxTHnFsecWhxuZnEMt5mG3wTDbYBx
+637RrI5zZnK4iclhNbDyUzMwHwVWp0qARwPW2jDz3SUIy1CZolGb1A27eTJbwbopllNJB5ByMq22
+SWYkbFFlmtQmmZsVz9nlHrZsSXIyW90m6Vac2qwkLefUZm52noTiUuDzF15zkVhGm4pGqfW0XP8H
+0W7HF9NP00pyOOveZsXnRET5HuMT2RWHKLU5VLPmpH3RdFs0S2mNaLnXzaE62CRBOZS75ubB/zH2
+39b0H0P8j+U/iWYd1fT5/4r8/L9FftJ/DPE/lv8k+lXkPcmvSCbuJtV77jV9tsGmgkQekKYW72o5
+7/ocAmq5q/eCGHdfsTL9NK0kh7OIuVQD1gQt1+EQHqw5VwV2WxLY53rArosBeyoUxfYL/kouyBLY
+T4KLwsQbXtQBccamXE6vWVsu/VgdqrnPCmw9DI/R8XS3LoZSt5vi/d4us9o8s47cQ2dP6Y7pekxr
+ZNXRR6IV++TsldNwTCpmVc9ZUJ6NGdm1ZeTZlJHdUYna7OOpz+yt5SPboSnPrZ7L7MNcXBfmMjsq
+mzM7IZfUDNQ/KWiTVMpceEmOtaOgnZOHw6L2vBmEbXjupqQ4sTmcHrM2wi736TMzn1nrA89T8VHD
+s+aZbCpQbHWJaNeqUy/nXbftaZbxnzTjnuam2faEfqtJajfF52r9M9fA9pJs8nbxhGhktXfHRlXS
+VJvRtHXtGFe2q/wci4XfWzWc5rfW6a9Kq9tfK/SvFfp3Fvp3Fvp3FvrXLgZMKadTZ+6udn/9Fvo7
+N87fmXT2TZ49mufQym5gZRtW2Q0qu9YhRs83p+gKs+sgsLXZXQWZ4vxq82wReUzKyZm1eKuV9n22
+QTb95y6Rb8M3++Ga/XDNt+Gab8M1u+HKjGxuKIS4inDTsoXaOZlubahkTebdhAut7tXPPvRuwl5V
+JJXRoJyGtoHwRRuCmRSs3J5HmhQnWp7Z7X6iTfS56CYnh3LXXHY2Hi6lNodqHir9RFvUopWddR3W
+zjEb6qpm/ccVMxCN2nhr1bFFg1ncYdc9gs3Qijn0eqelFxfwickr6g/Npej3iZy3nTdLSb9U2zhm
+uiWo2127+b7TXbsnff8n79sUwGyqdjsXCw9Fi+8pOrTCXI79V5u7b/GcbAvXzXMod83l7lrdXZv7
+rcW91+aQCfTCptkFTbMLmTYvYFpdzbOu01e4ee5OcXzLaM4h4DHY0tFq0ekRGQ5Hsd4ATvM7OSyn
+U2zbTjV5g2PdHkfp1WYZUIqLIW5z5YHMBD01rc5tz57rOECzznzcW09Ou3uylKE5cSPoKoxAmLHX
+GDX217pO787gnV3Wth2h6IYU7fMr08/vTD/Jc/SK9/KZ6ueiqZqbAn0bnmQUaff3oeiGF+1UMpOu
+nShLhHTbdv2Hb9nOWxBtCSPxStc4eqU7BVUzrW53ja7vpLKcSqyycFNQix41wUiDRW6RHFvCKRq1
+SV1RKwgslhVPzcaWgBCTymigqaOWFK2gmBSswDxUpjipnJOtC0z7564mi3a5qu099zeqJTk2uxmt
+GtrhKb+thLeVsPGXgCZHr3Sn3NPo1jft5vr2WdZ2U6ZjXhzyaLAtaV8s832odCY9kEylnE7txlqL
+fZjtmCkPv0L2y9JvpnTX4l29+1ovQR+n1z7/Ke7z3+I+6a7Fu3r3vRen/dA2lSlOmrGWqmnRbai0
+FG78ttv4bQM/izvZIrLBFhzOPPMWZuGXNpM68yc5jI6n98f5r/r9IqeZZzUwE0GnIIq89+tV7xnK
+TSmnV5vXUnPKzEKH4xjomxdCNvCVk8pOYAtfeA1jW16IyKk90yQaSw4GzWgkh9FxOZ0ys7DNAKVu
+N8X7vV3OtXnuXmExWAoE2EjSPSt3q05PPtYdFjkPE+iDZPWbHV30/FPsDMO3QQDRqE2afkbaD/aa
+iGI3fieH5XSKtWGq//zSWVJdhsoUjZqS1qIuti3qMrdEXWw71IXDuq76GrpxOb1GtxZVcqnBuE9l
+ONRcWgzTPHOzLDgO+XGTbalH62kSjG1+AE6VQR7JIBqV09A6t/m1JKjNyGzahS1ZF7Y0u/B46Bve
+xjNimtW4Z1dv026RaMfRKOxMzR+4Jlkerbkc2nwpX7Vz8nBYvN38tbEHh/TRTWrZXXekw3ru6Hba
+OuZB8XCHr8MOXzG8IPhxmy2mdVOpbRXW7jalT43HhUtJ0ahNMl9W7ELa7L4AeHicLrN0t/wvmvlr
+yZsefgR1eInSJk2jUXb1sHr2BcsHiskrdy+bo1Lvk7tVXzVE5ifMueD9+y3qmhmJbtb8uZhjzElJ
+9Cs7prn0KTpunp2TtkgVFwWsCB98PX2LlZ1WOZu/gHsDsSsraCF1pchbPm5MT5VzJFY3EOumNi1Z
+2zUbxZfvLrBS6dOWNEh0gThY+WNhtoc24Vg0j2PR/edw3+m5tKFSD8PK5mC53m4WOsM51WJZzJKt
+/cc8dQi/k8Myc3nfy3i7EmqbZB4t9RvPtIQ8VMJwltDGy6Gak0o14L0OkjMOZ+bHFbFx0Vnpq9Qf
+bFtklfqoT0mDh7m3r/CgwFsXQuyzLx09X+GhzvrJVOPoFe81M5kf53QaXPGqvEj1AKbolXZTuFFM
+v2un07QO1Pq4az6hMSGmFpacvHZOLhNH8hy94hJbd1Cr212jKxvqnsyAxu2QWpqgSfSoQYyOnYuV
+3/RASVQfX4o1sPErCoKWdbN6Nut9O+l+mambDNtwaOYUJ5XT2OItRzsbf/lrkK/7NYip3WnGtU2i
+ceA67SvrDA+UHPbJihrNytsXbxTNz/LUHyp3ih1ZnOW86eWu6pHya34hR7gZWLSC9l6eJ1jwzOt5
+3EYa9JKmYgPVtFDu+sxpJktxYnPofCdaNbekMirYUCV3VZqCWiyXtqp0lafSp8aZ16WkOKk46+ym
+trrsaeuL7vnA6Ni5WMK+uPr0xden6+1E41sEqlTC020O0zrVkQyikUVqLYrPwSXPyzDQ9HmZycDl
+nFrdJr+T8cyPK0xf5bD9A9iNx6RyToZDXUIxYHTlxq1ttWEKtvhQJjmrVoHcTbGWqBLKL7WcN4Ml
+TdGoTbJo7bsL++pwevThcJrt0CCKlU4bbuwBkketp93NAyPZdTZq28xadaRpqSUDOtRtGMxeMi2U
+33o575aZT4oTm8PpoRW+GNYDv3SkpF2oSvfamIrZD5JdJH0d3WQ0KKeh7e4Hr3cEzKSgVT363CNs
+beRrLo18Ha7bDt8lB/fVQzvm0G45dJfV2x8MAkK4P3Xl8ImJ4JP3CJ52X+A5bwE83ar/06/6P3XV
+/5l4NBXIiyFnk8JKOZRJNppUPadSjMdMMqadJTbL4aJ/iDT1oVKb1a1bkr6qqmhpeDS6ELaSBj1L
+XW2+Zkq8ad1rWpiq92ysUNVZcEVYcFtuu47TXcFmz6bEm3Zz1OJVrdsv9ebN88SzpSdnM8RuPJLD
+6Ni5WKHp6YqkEsovlbvl3dL/Yxr/tfx2KufNoNF9gtVYZ4jPeSed7Gp4mxmKjjd4yGnynupeklNb
+r9svdZanbwKZZjlx8zc73xH75OyV03BM0h2szZPisx1zT7tYrbqDChVn1SMZdma+M7PNW0vuVVxh
+AgQKyrwUuSTLyTqxZ5f/K0Faxm0OqYaSnFY8F+fnnFJ03Bz3yVpyzZvKFI3apK7oUphtGLAqxzMs
+RsVAW3Tx9GTyQ3cnUJw4XecaLbSJWq1j7mTKzunNArZlGGhJ2zLyOrFunkPxWp/KO3hOXile8Um8
+lzVus0/JqcJvK3rVxU/SjU5mRHP90Hw/2H3Tfy2hDhUbJ3Zp8a/LkF38LQFS4HMYG7+Gwd8Awu+2
+1Q3fm9y4d3O3RsAtvXGxYpvf9RNOcVI5J+vGn9o/qmaXPC+HZcsabXM5ZLstXWz3pQuqxRWkPbfZ
+RwAVZ9V0y223b+6Zan61TTIPAttcNNc1tABiosaIv9aQv1Y33OLTnXPLdlubSK+3TtuJalYbK6G3
+9gXMR0ELqbn3dDTP/abgcZFtq9ombXSdEzllF/FOnjXpUCvryAfzLtAKHQ8e7EFIWSPn3QKS5H9H
+aip12F2cohJzvLC2SZZaoQ+D1BzO+NocTjMp9xFibTcl3eNEayFCZGmf2HrYnkfWT7B7tZzOYIPR
+dMQuWc+qRB3zVCcPvcc6tXLe9c2nNYvlMVfN++Iy8xndM/mVQcv7mJTiZG4oKnW7KS41MC06WSVG
+x85Fc4VSt5vi/d4us9o8n1QO53TM8ayatVmrsnVe5hPVfetHn6SV3YZKS8+B2O3hZUFr0TaXHbpe
+ufT0P5tiKbuI4TCHctc4X/WG8R9D/2WxIelM591Qfumzo/43J3JUfsWR23WEy8lrp/HcqlSsHaaP
+33q/Gbx2S8vpOrV3uinlvKmz5jQ4rfKhkan5yOY536NuihVomelQaa44Gx+w6DKogO27qnSvnVMZ
+yfM9xlqa/Wr91GZkihNnJbSC5OlOem4qNf1zS2mSuaU2yYyW+3PTa3tl86ltkhkJmnxvt4uvrqNI
+f94CpLua+3kLVfJNOycPhzNzK7i7UZC7bXYbtdUedrzQur+6y15RNGE1Z9a78rlIAfVPCjnqxidH
+r3gvrTG1ut0171rbTbGomYMdyeq6svhNZzjEbjwmhTK5nJPp3IZKbUcbORhp+W3wVjHRUgP2tOSv
+vHjWoxxV8xuHR9Z5z4tekCqX02u2Szn9n98Gy2zee4NyGs7rG1PT1GrznEP5pZbTG6wAyyFFI4vU
+LrRJfx/zuDLkhmbLd/Wkolt3uIP7sEO7Pp8kwNsfZF2S6PO5pW4PLfU57yTSnIrbW0zTyKYXMsrR
+K97LWgytbneNrnm3/PI+sxN2LpZZ3l1eVNRvuOURVU+nDM/lFjPTjOQwOnYuszbjcLWBEsovVc+V
+3jL+a+m/TSOn/9rOX5by23BP4rV3uimasuHFT1KKnr2PNbrxXU7icKhm3goCpei4TZ4ZzntBpric
+rOKXUptn56V4zB2sHVaNY3EvWZjBO1ot70+CqMG7zoofvuLH4je/qeW8GWzbT73fDF57p5tyy8pN
+pbwh3Uw29lQdv1TnXZtnF2FotZmdNYvTXjkeOPyE8kvVHjWL9d3NggyPVCqA2eA3IwBdaaRJajTJ
+a3GimodKdzSFdk52TmO6zPqW4ata3KH0KDqSLpq2WVLiPQrhNqk7NAfr1KL1sJuWRDWrTUu3+9AX
+NgMdEOTulOF5RhgpWJ3WORs5VpuhHutq22Vd7ZAOLqdTnP/Mc3XdvK6+m9fVdfO6O7fde+13p/cs
+c7eznDR1/CM1G2EZKrVvh35lBjRtTG6flhHUZYSh340hmZHwZI1B03h4LKdTNsv5eV8gGDxoX3JW
+Wg/fQ1/PJ7lXM26G7i3ppsSbdnO0lov6+Z315z9Zf9JNiTft5njL2mKcVS9VlM2HsOq3C4xdxEie
+o1e8lxXnvvDntJvr22dZ202BI4rhc73DntEd83HcoY/jjnR94mAkz9Er3msm3ud8bWo313e6a+W8
+6zbMzGJqbWFLNhVxlvOm6xh2evmPhy+mNpvvmnpLYR9ouBvuSSzBMHDnbuoleWXGWE7jqMddKedN
+9TUZt+uOm0XrtkWVaVKbZG62DfmEhV70DlvdApVz8qzL5m8ITNVqMYfINsfH5gbHXOYct0XO8WuJ
+c/xngXNekl+0umrPnXJCW/NWj7uiqftQaYexZNc0xK6sfaJLz2OuPI95X3/oYvNIf4dKTfrXkv6d
+Sf/OpH/n0Tz9ndlwhZLoj6c3S7+Z0l2Ld/Xu6ypwf7ntbrkl+qS7Fu/q3fdeALs/P+dtYShm1w0G
+LN5u42KqGIN504UeYjcek0LxXE6vuQQ+Qs3zdz5VOycPh8Xb1f8IBjrWLi6TLNkRZkOO4FpyhG04
+nGbbXqr4qHe6KRZl5hSNmpG56bbLm99c29xc29xcm99c231zbb8314HVnMH1n2HrP2Ou+gjWzSGn
+ilPrTp3MRuvq0MAve4jUQuwO6+AvO2wDdwBHC4+wRYdaqGndqZO7x+SVyja04F6BnVr36jvdNYsc
+BjaE2pwrXpi9PXvenpPr5nlmX5vDaZ6kw5XMDS2/k7Mkz5ok5mU4TNEps0ox+0rFfKtWlGvtXM67
+buPqZvnnZvJJpnMfebuXZxZ1+gqL2wxft5Zf7yM41CS5GJhpi/o9H6/GX/ov99ktaqjbfwy/01hd
+548LijbqJE3jvhEz1Xf6pZq3d/VF74cdTFWxbcQviyryso9aN04Ox12bOblM3Ta/zccu9UfPeDYx
+w08uiRwG1vvddXSf9+igvZNnFzNzVNs1f3UYHTfHffIs9+3mRqbRM1n10zXNI+fHMZLD6Ni5WBGi
+1O2meL93uinlnGptnrPP3u2OUG9xGmMVHTPfeV/KFMvYVdRX8z2PLe4Ziksxq3XDO7VJ08/I+qUW
+lcWtzFEvySszJsWJzWE3dvmr8apIDuWu2enEGX4lKKfXk491MS4bGwyizBMB1O3pNGR2rHaHAlyP
+u1JOU5VSmSlScSl0E/MR5aGPKNvtlWHPHQ971njMZ42PLeLXuEgwtocd/pQl4h1K6ktyGB1vtxgd
+MaqOm+KjHLOqbz4v9bZnpN7zEaf3fMSJGMpNKadTu/E7KdY2yeIJtrCiBxg7utih5W3PNdsR5jq8
+WPvJLmI41Npt1pxN62N+Iy8lee6mWKLZB5vvAyo8Kns9/jb0X4Z73nYLw+njt/4rCz2ZOMt51z/p
+tx5/G37l8PldreKzTKndNZ/amMCPPghEI9ts4Omrm0O/EHGRVabpm2lErVWbV4mmhPJLtZ70lv7L
+9CvHGf3RG5eXNiEazZTaO822duuuCa6it2raW5RU7kk0J22D7aL8vSCSud3euZ2Gc6oledZTlepT
+sWpoZ/2lcNn/tZz/aj+omLv2GbbFY3TcPHenOLaanJZ0mnR3Pu3oghdm35yUv21G/p7T8feci7/d
+RPw9b4W9dTb9zlr5bFXPVvE8q52t0nlWGVhOp1iH5zdPmaDZq1TPqTCD+QO5xqF4Denxk/gio4LG
+aMVq4RwLFCeWc3I3tGLL4Ysthyu2vJNBNLJIK5oF6Nas54yB81F6MohGFqn+9h06xenxTg7L6RTt
+f3yWfbuCev3jZ+yqlFdTQkgFBdZnuAqr1L4leHjoQglhhGgU2LqVTzVfUor+flVMJ0iIzImCKkus
+x1CJCIYDP7pWvw1+8NF2gHgVCcIjvAAU+GGRWsKWTsIz4JM/Fw+VyCP8hO1kUjQQs0R9fqcWLDfW
+wtyeoUU0pmQtOscnLSqQlK0svM9VC35IkA/61rKGvyK5Bev8KU/MRCt+XaSWo7B5qzRDGriFR2jh
+gR8+uAbwfpJWHKjqFn5Ca0jREX7nzUBMuwRo+Y6W76nhgyykrrgNpZEmxYnmycMSuW6T38lzOb2m
+3aD6VGpzqGYrOZvnLFYtNpJ2Ds9dfcSjhQe2WQvbA2+91Rb1vo9idFxOp/TJ2Sdmk6m8XV61eUZ6
+LVdWKGqrKEHiJJBuH2G95tiyNQfqzONrlaF8bcg9LNfSVgCmkRehE6F4JQmihAWrZXvaXuGZNsG9
+RpXwbwi3vITN8ECchPiw8I7PCu/8qDB/ynnPpQ6VMGxPCqj1MFlOJQ6E/XYDYS8Bv34KwOlk1zZg
+99xrOXHnmiQJ2V62Zec1+q5D6IKvnIryKwWP6suaXx1QH5M0Wu/CGWuEyTWPl2eNGJiP7Ryh+56X
+pDIqdILWQmUtsrUbQ3FrddHvDhgzouD6fm91f4UnOx9BQhghYGMpmJjtnJXtHFcHij4KStVHK3Z7
+vXC3Zyz2+VrhPl8p3N3rhOB3cmhmLE7sCDgL20+epwDiey5hqDQDqyLIGpyvWqJBag6RTP0w0RaJ
+iJK+hgEzbilMSM1hN9YMW36+xiRri2gpOm6e+1RuOcH+07L6A6cZ3v870uBBUvAkjGQQjdTLpeWv
+HyvKfnqVJ4OuBVxsiuwAfC+vhe8soiB8pMZaUDmBBU8gtlAajnUkeop4pEKBs7QQaqiofpRDcsLR
+vT3ktNceUqKkjzjFiZQUuO/aYsJ3/Bo2ZYvPhvsDLWJy26Kb2lI5FYsRD2HChFJSCw7NXAFoTcQe
+KhIeRbOqDySvGwWrrFdYF9HzHVRuBohCeOAsA0jRUDO6GJ4/Vc4ITSoFT2yLVPLTbuw4rUMtQSUM
+Ug/0KUYoZnEt9V0+4DVuCqKkil8SHFf4zAglNoeFjxC0rD+mRIJRzoONgytvWiU2MW8aMV4qsf6t
+iFxqCduhiJK/JeAAwrS4YVrcOBlu35k6vxzcOONt34juS0s/k1KcbI6XAm/dBgIwSdeUhFNHWyXA
+zx43+TGsMUkSyNJck+Ztj1CKAewPHsVIMC71oZKGFr7TJBhToCiUNCO7dI11EOO5f11w0JXVQZdt
+PC1fwL7YEn/YXSgpdZU8gxprBO8utC0NCv6Ut6J6DpXqN7JCVYCLThRINBbtFyE14uZl2+xbWIpw
+wGleZFTQGG04h9Gmw2jDEn7b9K4KSRNqMrZyNnHV7bPy+wttWzP7HRNPkaxJqzoML4Q7wxHK9yQz
+tuXlkIUeS91zcAjzj46/CzJtOgikeZX76iX1nCFssTDJ7yVLuoq9vW4hVoLaWYQ+6HyB5blplltQ
+w0gKmKUoavw7t+PGmlVqKtUwVKaoxG6tOuYu0Gi4H3winwSvH2ndLgHPu7ueWQXKadiVRpo043la
+2eevOhu7iP4rsv/HIXmOXvFebC+11fHw7JK8XcafX7X4+Dhf6McX+pmFJuui5M7aqp5TKY5119oZ
+JgqqPBTt/JhD2w/9fqJiNx6TyjnZSjBN06jgpApkRj7R3BCgbdfGT1GJTddh0HArRCRnm8IpGjWj
+bq6ai90rUQzlppTTqVZgHvjgL5S3R5fArvdVNZ5O3mP2UtObMCQzEp7PXEG8JCHFid3QmmqXJcbO
+6Z08l9Nr3o1jSrTaHNJJ5jcO003hKHJqv+njrvlIa4Zq5XQ6PHOszYCmREEVC4IXpC2GicdKzjqI
+8jPTFUerVh92yFGOXulO0dpSq9tdu7m+010rp9Nruyn9ptGRY7FqZ/MnkS+w1lTrQCHGd6tZn7Xq
+VqPuatP9eK7dhoV+roMUJ854LXp+lMOYTvtQqTnsuuMeu+24x367WeIM51RLMtZi91nqfitUW3rs
+ruOPfUyyJpNnwYATa1utc7bc9XKrh9xUwoCzZdeTZbdzZeepsr+CjMKel6BSqt1lb++jtv3F0Tiw
+CtZGeiaVHaBC4a8IfqwGgPYNnY+NvNQ+UuNlrVMZ3WtR2NIkxHKFqh26Fx2dv5ksNNKkOLEbsheO
++R0/Y3F62ySKY+7NK3sATDivSLPOLT3CMlLL4a5K7S6/LtfJ6CCZSHZ8I5+Tyc4P43dMTjvnpny5
++BLzZWvVTnKKCs1A/TRXvC7cMdPt2KCdM6Gu894+p719TUs6dAs6jZFYrgJw20LB5Z7xdFeqpT5z
+uml93DUfiZxZmlXHFVoRPvVrR+Q+PM8IJnq2sL9SuyndaXm5KaF4NXlPZnjV3NyoFBeX+uQ+PM8I
+um/hGJMYvfNCmTjCdlMwdbzp8Zdh/a3fs8M88Kbfc/Du3lVbpgL6HqRz9xJOlSkatUldkUP1Qtjq
+hvVWUoqT1VcU8e64UBcJQxoUSGjHvs5j3yVnnEZKohEQNgqWfZG64eW3PhYZf8xnJCw89ZHaql/G
+mwpSaA48JeozdvP5uu7mcV0fg++DPzIowGrazyYpJirIhgcEtHbg1TSRUaEZmI9Ctsg8Y7Xux4rX
+rPuxp/bO/cbRK90pTK1a3ZxW202RZFcrxvUf9LFy0hUrY3Cklk3COl9yuL/jgHE6cikomAQjvsoo
+Ugx1S/rzK6Nu9RC5h7/5AvHoP1jvHgNnYZFmaAZdyeAkoDcGRtnggOKIHCx7cN1mDM0p9VJRXt6e
+OHmPgQYNLl5eoJnXba3HeHnuVEymFB2qeeA5XkU1/0zvH+fN1TRFNUtbz5KwvEq6Ig9Z2jiWF87a
+B38x5uAyxyVxwiXRiGYdz9AoxP58IUQWTxzXj6d5v/CV/uMpbT9kux0l/iP73VHi9s8wUGNSgSQZ
+oq4PlTBgOx1lvIKsgR/bkovKUpvDroxrXWAynL7O1b5f6DSLNNDPGKrCTHsqKlNUYp8Qu7Lz8C53
+n9ocmnlanREwEM7nSkUpyYi2Bw7exzYi7iyTmAvrNpJ+YcP4FtG80p2iDRrmwjcIFFN0ivO2NwSc
+1r36Tk6bMTxaTWVGteex+ljq02EM6wzVUrzrvoZisNSPUFwXqRp/6b/cXXaPgifEpzJ98bA/GLcu
+jKNXLMW1cz/TXYt39e7rKrK8QrulbTfntHzflBlVQl5vSoo31Zdx6S5lXb65uA3DUfRzl07/Fe0q
+Zb9m49UZfS6FE0TRY1rxtQY/wm5GSzpbkL6+8IYQtL7nyt/5geFvWPfyW7V85ssbXp3R+L0/4xRv
+Wrtrt2SuU7/4+35Q3Jj5qrl4nk61zRHrcspbP9p80exuO6flmnRlfWYUttb056Juer8bfM+vwWP0
+nG6aq+DKF56otG/PPlX7vqVqc7iuKXQ3pqi66FkrXLMpuwzr15f9au7d0L2l3RSL2sOWPLuINjzn
+e9RNsa3hftYfOh5VMe5ecSNMVNemnV92nUqKN/W3b3daTzcl3rSbo8+l1fnzMWop+Svd99C91b32
+uRPtB37ybiop3tX2S+13/a66CrVkX6On7h6x/GXRey//MZ//NU6/OrBQMjVffL3vIJasL6HtN2VG
+vYLbWy7NZdhf4WvclJkOqxzGwR9nzXBzd8XYKoepznP79nzz2+Zu2HfXf323XutXD/nTtRmm92j5
+Ozmteu2cR+N+PP5Nc9bRj1m1wfcNptKn1lptdw2X8jfD+I9h5vBqKQynHc6bP8irytbnL9CI7R1y
+8Zyi11x3voM7or9DOSyLn5Sfr5uS4k11uYjeb9q4a7/Sjt+Jrd19gm5a28aWqJjbRTobnTtTy19Z
+S2z5S+NNutjTziDttMN2O3uOybNmfP64iDl/h6LJ6cziLInsfTsQ5cuTdABJw0nAzu214/FbkRqv
+cqikgX2z28x/xzFg55EDezsfZhWJi23FcjqFqxNeTWYAPFCD9tTrPRAisQYhMkUl1rBxWYI/xHgJ
+Pr2mqLGRsqnUCJV8DFoQT6Ic+k7GgZMgX6i4pDZ3uNaOW2PHr7Zy7qQvYFzQwzbJjNkK4WtugqeB
+VUmfBlekr3bXsK4ZlqRYpC4fCc5KuwWkS2OpeN77aKPVuk6ilwp84YlE48kLYpAY+1KPCSiqY+D2
+vKHIjmeojz5SOC7AQyKHnhLsTDBseAwbC+/A28MAyfEtb9ouyWE3HpPMONKkacSp8I1TqkwS8PrC
+W3bWd+LnbgChGKY4sRlis4Brc9iNSRTMW49NgqgJUuuXUt/6nPnbHjO/qBSDFA0ZqxbNSiunHzt9
+p4bjBMBMLL9kpmdEfyX1VlHoqre2FP9xrHVl/giRgXai9qB1n72s/E78vTyAs1mJw++Ub20GHGXB
+ma+e/Fz/GLuXwANFpE60/WYqM+4k0JAl/QPT7J8HHskRKQ6P3KJKGOpJIeoSBieRimKWHvnhF2n4
+nA+eh/rhBv3RDQmgI1NLyOe2fuyhrZ9nbkWlGF64FhIJQ2pRJQw4KYmMChrDClBI1hh/Pxg9PyUv
+3yo7gL4cez+oB86PPzgz/uiTgT/2bODPHgYFVMTvmIyJhL3+paBuU/QfNz3/aUFCbDrucj8IMHsR
+CUPJu8rLcOX/d8E6oUgxSiKU+Rct/NvDghd4/vYffEQMkKKhev7wu1pXl+EuCu6gnDiQ87xwyiY+
+OTJPG5QnlspProCf+4FQlCv4SK9+EpcSAIyQ0j76ZuwHx6MPDrwfdMyH55MPbi5+dN/4XK9CX/L8
+s+zhz7LHP8ue/yx7+bPs9c+ytz/L1WVXfaQ27c/SwkOCR6KIKsuphOyFm0oaslpQJSFNmGc622Gb
+/jzmBfDjo8lK9Coyx2/yy5ktPLBM1GSh7gpx8L0I65dKHYhWLN+pqfzXkoLVsRHwuoQSjaypAE1a
+bNEiStKYrKUV7YwLt+ck9Twht1QMGLXXYUCTCtkjQYl07CrFKcaMRkbUMrKSsaBmkXWNWqsvNv9r
+IIT714D718gTOl24db+GbV1iMgVZD+pabxAmLl4zt6Ru+otCVLgCLxrn7cqaRKokxT6frPPzqXV+
+Pq2qz6er6vOJZFC0mk+tSH4iDO9KPafH0YRXCVDQuqLX1qmjU9eVXbHuCOm302+36LqpREL0ySrh
+FpaXSHvvgHyC6tG2BGwyGW9hizL7aIERKHdLukttzCX1Ry7FIcx8Nvii2gA5FMqRDODNRmy5foXF
+/ObNedP6uCl9aidx1FUbKArtx6oScWjj9xJ6msQoNpX7/fbNIbB9Z7XkacJI3r5teBCTKU9Cxyb4
+3vNmQCcVL+5ERJpRQsEFeQsbx8i2ZdZ00xZvJ4WW2geGhQyePSEU350jaedI2neOuH3PSWVTsBi0
+e9dht+9SIsba3l+1crdXlgi0HOdgkU0loxMlcxVAx/bXT23jZb1LHXGwdGbXWTmsCFzSEvW5RTD2
+eJBiZblYL8AqaNU5QvWA9A6DolMyp7e+UtXCOyGEHccBfvpifj1HCPV8p00rSkyqUDYIaJpczwQ/
+EsQQVcKqu93P10EoCBO11FR2wgmZNYab/cfORD96yP8pjOl7WJJDmuX16wsgxCp4fnE3EEhxYjdk
+GWctQSUiMZTPyq4862au6KlTwk9CGCFgY6M+2XQ06cMd+GMj7ON23w8LuOZCbfmztBS+EUruKfCQ
+INAHqZwEZqmYTHkCqMN9RciMV+a78gkJoAGTaOmrlb5q6essffWlr1r6Sh2rKBchYU8UkbJRwr9r
+et4RvkhFpITnME/5/X+CrIJO7k4ZnmeE0jsZlNPQWuk/4E3ViI+BqsLUtalk3NGSQZn9J+ppKMbl
+lSigSrS0n48uEzoJ3/M2TNEp7Kfofh7HNE3/CQYwuadbTTvJJSnQMiiCd7MNSNT2QlUn1jTr1oyZ
+/SagCVTeDtZmQF4VIWIOV/nD6nSUevRJxVktT9OQWlqASqaIPpZLvJbSN0IqWNJtKcGHmyBp72Py
+nNKOMDWVNGe1sNZJT4YXoaJpt5YTzZVl7NThLsq1+I3dY8Xl4gUjqYwKGsOsVtur1rmTrPiBjYnJ
+mY/V84yQuqwoEP64orgEK7LpKwOK3ZDV2eyVgcnq5HLx2fh8bhndcvJZHZbTMTM6nPvhvCvGNn5x
+piV41F5HMohGXYm1kJlSwgwpYcJkG3GXjT7fQGmpL2mLdVKKk5kISlccBjAxTJQ5BgBWiC6SrYTx
+1lnzPr5CKWnzXE6v6Wjs2i59LLElHmD7eOf0I/SDy7409F1SQ2Q6wkaBOOmikTcKHrZAnaj5cGCP
+um3JQEzH+HylEqVh79B7MohGXYmteOclqZQ4acBP+qOvj16iUHRK3CEwhJkZYp3qkuhNmbDkhwQP
+WV0hwI7ljcyFjKwLGVkXJfID79cSRlKUXTDLYkReuNuj1zNXGy45VE5LapO6Igu7kDaziI7kSMpk
+aMC3zPSy7PN5TYFiU9kBEYIapwl5zVsoBvZu3F3vzpA8R694L1adGp47V5UnCtXOyZ9fpX9+l/5J
+nqNXvJcvvcz81UUj6zNteVFlXp2amrrX+rhrPhKF7AhZMZx0sl7TZ1zC57WziZ0zXyH14bpG3jBM
+8ayWyEbJaCbYsPgkMip0AkeL0LT1oWhZSPW3mqNKeFd8v0BJjLWUqlIMOJ7mHcszIqNCMzAfAx2B
++64VAaEyfZFSOKp6htgR8pVuQ0Ym2lBs39nSzpO9gJl4MFVMpjwBrMY+/bwbBXyg9KXVG6boFR+j
+hYy8BUB9BDzP37KeJTLfYwFgzAwM16EDVYC7kL0CIsgdFRQnNod98kTWTdlluE4y90+aFCc2h853
+oivl40uhx/+OZBCNLFJT80A6eBi9Kljliq7yiu6STWUHZLVwMFS9xKt6KVdxKVdxKVcDvEYOKjcD
++MmhoMpZrC6vNFSmaNQmdUWWRjSzgZQu55PK80nl6rVI7NOC9EwDRX9ztaDqOaTiHFKXGnNJk2A8
+VEBPHQZZu6pc+BVJM/PEglbNHR98VILx7xXK0mJllVeOawA7EUFC2CFODDW+SXRJeH1jB6kbqieL
+Y3VTb1nm5KfcIFMz6kOxnErcsfXrbgI0sIH1e0vL9yTGUmhz0AYeyyvelYeE46a5YbDsEuDNOQLs
+OHxVLuBVXcDTDyK1Kged61M160Oex6c2VKaoxAQXapox0iQxyqtwja+6QcLOkJeTF2HP6v0RmgEu
+j8ksUBQmf2AJhKRG3coX/hhgvkZOk9V1ltXSDWe5eplYe+dzyYYpOsVSiKYp9KFhp1iUmZ1pWDaa
+OTuxWycmk8zgK7c0ibGvMJaXwxS94p2sRA90wKDufZ25rT631ee23nLTvcCtqdbe5YNY5zOH7beu
+Du/UJtG4z+J3X+Ke05IcqhlPlwrisc+bQq9WQ5xEI77UIYQ7fRfxErz2/mPRP/iQB/BUylucZMbO
+Ov7w4AmSaLbLutQaN/RwP3AUZ28OO44PO5CP5VU0mkvleP+m6Xs3AI3gJpLbeXzE7JLcXQW6EsbY
+sVsaxkjBB65MRIpddryfR8iD0FRyR/tx+/SP7tI/82YTWDL/QYKYTGof/MSvY4uTkmPNWxR6m416
+r0dbkufoFPWm8SthM/x81ToBOakrVsQ5Xn54vP7h8fpHD9MCSMjekvCM6ESuPIu8Iq7CpJyDN7pE
+lpMkR91j4RoegN5fqdVDOQfzwNOMpDbJXC2D/NfA2TBVNNaI2tZJajxVovGHFByxqY4YWX6MHGEX
+0TFKUlmCOyKWU4+oa46kfyYmmpGfaswUL68KZCPkKJpc/B8JlxOXRDq+LgVoChbHmiZujFS0cqnM
+GmFB4UhFS04FJSdkg00rM6GDt8KPLzWKY77u7cn9xwNHkIMT7oOz7UOn2gfn2YdOsg+td+EF0rE+
+ECKDlU9SCKBdK9ckjpXj5ZJRgXkUS8brPtJQ1J5Q/scrM4WVxD3iwhOSKrRVy1u1uNVKW2dhq89+
+RdIdYRoqEbuzcbs2btfG6cUiHvhv+qg/oBl0JYVskVq1C7VuytPdCpF6clJ26KTsaOEKBXuISWVU
+aAbmo8CsMVZYotxEO/pLjwK8l3bw3uqh91YP3lk79LbaMe+tHv21t1q/BCuyGQjDksKkzWEnF0iW
+ymc8BLoBM8sszc52iubKmg3q4o4z6KFn0EPPoIdNRg45mvFI9pEgxKrysl4ZnY/wQKUuEguSnxyk
+Z334Jw1M7eOueV/JxlaDSN3hP+BSH4e8xOoUuGnKzS7ChRG5t4Tf4seh2QzppkTR+gioqLxhxfOt
+asj3mj0EObWfsnh97iNsoZxYJDHtFjV8FFYRnIae2s0+G7GPSvHI1Qq4FHOuS10sB1Gc49LOa3Rx
+HUksMr1e2LBp0OKeYZ1kZTxxCSGom+niNr2bc9HPSnjtFpmXu+baOz8uMVWNk3HlORSn9XFT+tQ0
+/colHWP12c51EqYFVBTb8srRM657TVW/vteWYw539VDffvaR7oqm/FRr26f+at6n3ltoer8Z2M7+
+h9v9z6/tr9yd4livOU3VPcbr3t87p+iVdlNuabzCYwe0kTw7t8+9Xp9f9fr8rtfHO/t6fXy9Prd6
+fW4F+nr54Xwz9F+WvPwynKr3P8v1F/4sPV3/x5+l1+370vc/S7/ixp+lX+b3n6Wf/c8y/iwjYUn4
+kvurtnBhz/vravx4HeV1/FnGxbX8WUb7sxx/luNx/QcJOAk7HmFD2C8hs5uDd1eOx+P6PnGaJD6i
+wy81hLBLmBeEoagsJ6gklXSEQEmyx4iAtQ8KqNAGM1XjIVL6QQSisUR+PK5geR0l5KuFy3ctUWUy
+QvGCOKCTTyWaKoWmrJawisexojMAl+lyjLEkClU7pJzFAX2QcM4GPgFXNeIzFQrk8kzqAOrEGe3i
+vYPIK7gqJc74RQZZvj2+viQQ+9cXIr6+irwgSIDbFSI648kwSMnk2m3ltVihpPKKuhpRHqkNucly
+lMfR2jUWyxJyE0vG+5hHEVc0B7ddOR2/xBCB8kupkdFSgvRX0R9QVooTuyEzmD+cPFmc6hhJpRj2
+gHA+DGLaSS7JgNtSFEZDb3moFINoIz/TZgA79giRahgqaXhrkRc2A4sdPn44ihOnM3sEXDfP06k2
+hzD3P/M7AAQ0GQ/5QEYF9WZpb2zWd2rckG+8qEfoJJVD5WVY/2Bvo0iRgKwvwlKu7ZIi65ocltMp
+3GheTTDI1AByA8hE4Filrisquq6pPY4tOoSZFVq5K67qLwEftSBEI4tkanv6wnB6vJNDNOkoGaJu
+eX14PphO5BbaVeQWU4vSli1xPUJJvLawHnKj/dg2eav16J4Tte2pMjWjPhTlYzzAciqx0zeu7cgn
+UfBm57W8duyPiioCxLzId48hxZDayNt1iN6/jkIhEfqlfSUxyvliz9G+fCQK92lB2kSXZyNFpEhA
+O3c8LXnscmTYdyrw2unT5Hy4c7jsEhwlJEgVl/mqZfNHlzYPLnx3DZId1nhkaWEJFVLTLbC3RAHr
+SBSRkmbUVF/iP/Tjl8f87iWR/rWplFU1oMXxFYGjPSRAYTzLi0QkwxwTAN/SVpJYSYlTNT9TfzSc
+TxvPp43n06ZnTIwPtBsesvHx6XcefFriB6mU1KgmJuRxv+mHz472lX4oJEIOCS3LttSuyFCQksly
+RdgpbPsKn6Cjq0zNqA/FYm669f2PlBwtj/xAdeSo2KQy7CScOVthJxS0orAVJWM6AIhGFslWcMbS
+dMLSbL7S5tqUMNyhSbXXIz5T3Rze7OLZrgPMdwBuFEMlXX7q9pzU//jrVbtS1ftFkMUsWlveQDpa
+45T0AvlREaVmeKwOmYhJ0FWNvaKbvKG4E6+3KaU4mf0DhWloE60nhJECDei6ds+XsvXt60u+k0r6
+2qO6YEBF2CiYP3+cFWSJanNbVL8lKKQWSSOdjI+gQaZo1CZ1RWZz4cpNpGw+CptKy3ObyTFkBgvh
+eBy5cLcGwYcZZWhoqw2FMVs5ejpVssiedMNcSK+skVnTMZn6vvWZPsNyOkWLdmqigdMlEowwJPx0
+woGVqKNxm745+N66S76vPk6heeYRRlVWRit7aZ1PF1zrlalRSob9hVOCyBSVkFiwK0hGL7lZfPSX
+aLsEOO3gAaCj71lFKITaDDoJUkIESKx7Q597A27uHuxE3oA9+sCUQaREYKz0UfFzKqRtUncor7x5
+zSKT0gm5UvDrMsrlnKwVFU3tWhu8ZyDEDxZM1ojc5zxA9ZKmwtO202bK6ZbixObQ+U7UXqxr/vwq
+/OML/9wK/9wL/0y3WfhnFv5xhX9m4Z9Z+GW7huEIG3cpkvTvCD/BpETiG3mQYkgSwFsPifN3pA8M
+ikHGwxYAGf3DBDJgKN48dQxdzRh24hgZETRvcwtNxaLM6eOdZkeOErrJZIQ3eyYzBvUvXJkWUnlC
+Dgox168vCqjoa/wkogZL2pKBmNhpg3mPFx7CUoIR23DoxwdAiEK/jZIedfOosdo2IuxyJTAwfb7e
+c1hVXgZ+eBpSDNiSn6X+Wd5/lnf4s7z7n+U9/iw/f5afFT9e+FP/LD/jz3Jeyhm2sOYIuHI7g6xk
+XyImlVGhE6SdZ9hqWNpZNKFpcKvzu9deK1Dlwv+C/XWO5JCJeyYM1udSH+nqxlOufc+HfuPQM7za
+41oqSU45HaL2j9SW8JUmdcU+JjlfWX4QfNUtol1OW36p6oynoA2dGY85e80i5dExQzXr0sL5uH3i
+71K59UCyS4GPMYnZbPhEt6GcD6bWh9N8kv4PFLkzSmD8/qqP5NCZc3C8eFan2lj+Lh++UmJ0TzLN
+P1X7S3jVfZKcZU2xbXZpmk3hW/ZO8VEzDV43NFSna3Hb7HMI9f8dAccGr3WvTt/rEaHUbkp32nQ8
+2hfIfdbLq+OXylzGS34wD4hve02mz09oBl9Bm3T9cmmu7abMBEq1FennLMO2tieFxLfQOJJAMMqx
+ZQkML9tScONZQEbEUsKaNgPGdTolhBGiQTBKzoQEmJgh1uEJ5TSkk3T0gl/2hmTEJ28or4YY+b1C
+r8GtvtLfsCXHFaXVHFUGszDNiiduDWnWbLakBjah7iHFHDx3p6hTCpvS9PbOmu9OOV7nmoNj5jRa
+3SZdzlHqi9Wj87mlQQH1uETJ+Ck4gpoa+99YNsH6CIUCPU/qRMiEG3Pn+uA9QqUreks/R7n2DfTS
+xnLsFvdF2Fs2fSj8xD3vc5PmbRVf1SRIxHhJf+yvVCAaRYoEDJj9hSOTw04WibesIBMIe8Tech0q
+ESG918JWLyH7U8sFoxdx8j8kSIt+cUCVE4iO6jic95Hlcu/s8wYxbrKfoy6vtOYuH4YSrclq8Onu
+GE8up2mpG/bh0MxwXdMzlMpf6jrHdWNu2Hj36j1a1gi9jtz2Evo6KS+TkR43WAg0faeSRihOkc09
+6qh/rxw+f5ZPaBKkd5YnPz4BP1oI2QF9VamGoRIGhnKt9EmST1petZf6Dt83JYdf6vZb7zCIaNv5
+J/6J/4SWPp8qMK7wczQR5yVSH7IIG/9JIwwRLf2J/+R3zQI1He8Lwp8YHtf/I0WIRtEh+xApmYXH
+oySKSNlUdgIlE1zaslylLEkCMbwCQiqHiP46tjhJ4lrdKEQdZ6HIi4HGXPJKL22K4dpCkENlXpTE
+N+YEeV5huoIqAcxVwitIMRSKQ/S1bhR5UfikSXFiN0SPXHzZvuQBSUip4XVHMfU+KYFrzMUApqu+
+X5JmII/BLK71m0toRsPyuYp+pu26wRDD85kaRYoE1O4icX9murB2+s2ZKF+ZieFZJUDe0lNPCY7U
+WjKo49x/afGu9pvKSsgvAcTwKhLkQHH5vuqarvJzwcbLReuVy+y1LP2QVwzPvI08oRsMEpy3YTkR
+1bWlBBC//x25ZQNxacy1nQjRjHxd48iIzu1cQ3bUFTdCIuBzQIqwijMr01lSv0r6N7QW/sTwHa7G
+fdcRKDbKy7WEq7LlcSWQnRd7aEkdJV2QAOMlQjyfSVpbJHGGZ0GGBd9CNKIxRcqmUiMot+eoyOdE
+iG4qq7ydpLBNknRbujZTYVL5ZcrtuPDKZL3+wzNRhEdRjJRNZSdQomwAsgp9ORDZvymYS/9W7/4t
+HlIIckKF15wohmyENUt11xU5rEy/5kEhacVl00pvcrtKYDA5CL7IaUsxNQUZwOtGA8vYrEFIWBds
+cByy1l0CZLaj0nvaTE/NoJOY7542WuiiDqjFnmlXd9Rh5y4FQEV2CRGkQiGrGhOTRn+V7PgkwYIW
+dfTQxVuQIF3h45C9ESN1W3RsbIu0dVvw8SihRrHkngw/ih0SAq0TWU4liYqpYKuRaNzq9pxEY6NA
+VaIefC+CR6YL2i5yAL5yikaM/DpVaj44pG0RTY6FaQpTFPVr1zMtCoiThJIslfAjUiIkAfarDbvV
+pqePC/CNLXBWZ7mpa1TOyRwWG3fM7alpUOMna/wsLKmoH5vwLJbANgV6N8upestJBXLMGDQisZkw
+4DYdbNu3BCjvm/39jZy/tbrfNoq3Lb1zwwjaMkIokqKiC3s6+gRJN0TH4Ww7HgkCh5HtnVndT75a
+t4creEleO3a8S0zog1ROAmsqLzRDREqa0W9XDnLuaHJiaIuEstHhKSdxbN6WYnrnMomnO9XOyTAX
++G4SNoSIYRgkiQ6Z9nWI0zNo8xsGWJNJRssbwidFOQlsa+Mu2DLcZcy1f/k5lRjatwTSww3H9Pad
+qG2mp2bQSZoeh772rS7S7e07JwrEqjcGVvtmR37zlNj4+rqQNEGHVZvD6kK4yMeSFRAlYZGtVpIM
+iqZNLK47ivbHPOU2BGirnkOanUPwU98iGYEu0A7YMClo6AYZla2lQkEpRXBjXa9pXUKm602KGHhM
+xUiyGqgRZrFtsNghbwBfIB05WIux1toIB6PE/5Doo/dQZEbQ3mj0D+r0k1XkYNTXSd1wTBKj1F82
+Z39p/UldMMGSGgWtEPk7q6Rhe6qUEYDbcJQ2kXXaP3c1zWhLfpmuasu8Y4RH6MkgGnUlVGHwbDjC
+40DUgpqMsNR17UqAr0xneWJdAXGrTlZG6GmoRBwn/yO8Uy6Fp+KBQL6zriDuqG1C0wZvYF5UDPKW
+DKKRRbJtsjE4lgZiGZPfiUKs9Qr6IbU/VoRXxPGQQIo4cEF88IL44IXwIRe28jthMk8+rt8VaAY6
+VqAtXinTXbJYcXQ4toEQpW6sMgBp+FsDRt663WJsrMhjYjEc1++vqrwM73C1XXrjnba9tjGpA4cc
+1985IuwUsq3fcuR748D3zgwZNRCK8criB7OQH85CLtlUdgIl2vwD9RtV/dkkQFpu4h/EoMSrjFN2
+7DNs+dqMJzbdJbrIKnvIyTF36pA7H/INPQUxLTjziRRDbCmsBilObA6nK2oIFmtJncezs8iPUSik
+aWMiYSTCtPFcWZ1Vq7Nft7EoxYCA1m7WIL8Gd1GOksPIq0lxwZnhEuGS8k0iytQmXb4yF8aqxoc7
+6Md2UCxxcHXj8ymJIlI2lZ1AaQl0gAP7n/j4Ex/xT3ykP/GR/8RH+RMf6/V/xf38icufuDz+xGW9
+/p9/4vKuf2L8E2O6Ask31v4nxvYnxkuO67//ielPTOH6X+pGkaJC75OSsmQmBAufE54YHTsXTWfP
+CntF/CRhfKQwDGB/1DIMYEobRYoKzUBdWCSonGTG0r0j53QmlYh/aX0uSBM1z4vhickMgLlxVCup
+35YMotGM7B6nXUu8fknNQDPgJ0GUmQhNtRz3sKnUki/UhHtgn2CRiSCmLwnQ2V/a2V/Ws1+uZ7/Y
+s1/ojS/2xRdjtTJfs3FFArgXbrWSsD48EWZcDBOYPD83lSkqsWYXygNjjplKHWTHSqGUuujZXzWP
+0bFLoNlAqdtNcX61eUbEKp+xMTKj5gmD1F3y3WQP3WKTem0MIwQuPYSkp7UHNiRlWiaCp/bwZv0r
+tWmP2iRRQ+0aN+El4cWiGoto2LZNt22b2xaNkumqCKji3js3nECKhsxVWPyRerweKRowQn430YjG
+krqjck7eZgz3VqE+FL8TiYmabm1WfbxGC/skGn/kExkTmWa0/DhG8hydwuEic670uP7DUpJKMcoW
+eciFmggdY8b0woHgoddaQozi8CfR2NS/mT9ieqKIlJYcqF5NpRooNTvdkx5hqIiUTWUnUGrSY3mp
+THFSOSdzO0JLk6axTcrePDMxI8t9ZMnoIR5pk8dEjMRZLpKSPNTGVhGj4z6ZGVOp200xvzyTSNF5
+IGSulgu6vm4hN4NyGmqXmPLPTUMhtQUK6eJ6LCZTNOpKLLoeWV6eEtyWZBCNuhKToJyWhqRqOSaV
+UUEHVsvpS6XGJpw9SF1RMweamSBCAskXh9wHj7YPdN/xRPhMUaEZ0OPJYg6UcN2thmAaLIUR1EXT
+bNjtjt5l0xwDIVa3lODCLXzoFj6kisv1H2Ki2BZH3TA7PpWGQZk29YP8Qqgi4XU3r8Sb1r2Gel4q
+zRDPuqmEoSBc8le2kyL1FB03x934nKQFlvo8UMsCvxU3bUkZfbbuFCyEK+5Cq/YOkLnskIzatPHb
+SM1AXTQvTbzrQUAxOnYumm6fB4GpOL/aPDOiRpUwXGexkRyauW6TaDyQI/rzZP1ONs369hTvJSCU
+XBLOAiKjQicwHSN01CUbaikPiq+jTCrnZB6qnJZUp3wnlVGhGZiPglZJiAWV1Lh5iNFxn6xpi/4U
+qVecX22eESEvECrAtGFys8xdVHfQtM04LMUrFWdlkkGhUaPNj7j+NnRvSTcl3rSbo7Yb6ud31p//
+ZP1JNyXetJujZr1bnvvMbB+6cXe+ImZsw8I05tvG7UjideeQouNpPyexYq9Smx33TIk3rXvtVzpW
+Iz9SUSnuOQY7i4tSEkhFVFlOJW2z8j9UmkqNpWRVcjzkBpJSOSdblliTJKyoRFEBl5J5qAOZsaVJ
+MK6hqHQD0al96slhdOxctB3rbfA5dfp+ksPo2Lm47NgVK/elvHJDC0Qji5xJXS1cDZL2kJAZW5o0
+jTgTZd3T8/5KzcAGxI7n4gybw26sFbtYT1+mTDdQ17pfEIrDcpqSoqFm3q2hnftl7rZb5u72SlPQ
+3G8JlhdmoyTEoBhMkhZOkZZv+bE7BZrkvRUjGFmzb07w5Fe4IKBKKL9DT5miUZtkbszvQtrmllae
+ERUbrgTG20a7cBuTuuLMq/m8+Cqs43J6bbrV5tCb1V9z0YY2bWezZjbLz5rbu0pN13tKSrcD6s3Q
+nSVFr7iY06GV+EOhqX5mDJKmTYcpEWZ3TFUOxWvTTTsFS2iLrqAJNAP1TwpaEd7duuitF17gekyW
+IrYkG7tKsAwKlFYXPWrUhZeJS/UH/2oH/xpVRMqmshMoNUf+4gIwyevGjmfENJvRjnXK0Sveaxbm
+jnu11C33/0/tU08Oo2PnYpnXzR3bnTp9P8lhdOxcNLt1zV3Hjykp3tX2S+03/XdWjN3DYrVURaPS
+1q0jqU3XXGw7i3KP8tlk52a55ZKaQ+c70aq81+5HGHTLtXZHcWJz2CdP9PlnV39q6thS95iiV5pX
+vNvMXLS63TW4ymceeGw1hVF9tGMZnlP0mhagat1+qdzfb5bxX0v/Zbrr7+RVxo2w5s2qDW2kuxbv
+6i3lrLvqdfuta4JWy6SSolNmLq0W+r9rOWZ2qoVi+js5jI5nBnpRhbNT1YupapdS1S6k6ryMqvN8
+WN3psM6zYfUnQyo6xah2NqzzZFhbPSZoOjfPNeWfm6Z1a/h9HMfRK97LGtGS25e1GiMvlhE4esV7
+WUbDnWVrO1aVcD52PboLxYkzXrOSX51XiEZtUjc0col1OlXRgVwOqFwOqHoWr1gOaLoE0GwNoNki
+QJurAERslZaYRBMk89KEOo8FhTI5xcmWzGa6Le15GBwxeaYHf9/HMSOsnMFrgpYGNkXjDKdxLapl
+diPXjH//fsp/LHendNfiXb37ahvvP5HyH8st0SfdtXhX775aADbNaaoOBPnKJCVzuj4RqW528d7k
+w38xLRLw02kx4TVrtlJuLctd8xTTd97EprtLtH2FVE7jroRy49xdopuOgt+WWW2T+kTJ9lgSRaRs
+lB0y46R5EcrECSbqueWCbCdaaCU57MqWnDWep45oZw1S0byu2MsuRaVIgaxwUyv9G04KxP6ra3UX
+iccqAWJ5fZNWxHRcrQLEtIUr3CVIGwXS6iPMQh2yQchJMu2425rkkeWU+MgyIQEllFRNGtbwG88X
+veqxxUkwXo+MG9DElzoduwhWsS+BB3LF6LhPvvlj03xd/2FJFJGSRyPFTm4q1UCJjL9C4sD+CuVO
+cWI31FTFhrbxdKrNIczrdF+dt12jfoU1UUTKppKurMWqVUBj2c6h9R1WxaMMlSkatUld0aUQG54a
++Uqh41k2w06eexS0kibSOihSNLCo1AyYo1bhor5Osug+JqkRUjdasm2WbJOlucXS3GDJba+0DApN
+OxNMf++OIwGpnJO5Ozntn7uaXLRibQZqglwzL88U42St4aV8ksPp81Ef3YC8vydQtKbkcnptc7Fr
+2AyToUZbGcw5YgJNolfUcmNqBho3c6Al9dF0g6oSb9rN0dJTrdsvld7syU27ceuuE/qtE/qvTtDN
+tt1G/TZH/TZH/WabZus6TrY+x8nW/ThBdzTbRG32beOPlzpmFrp4+DVXDr9S04UtwWKgsWx1a1pm
+002gTwx8meGd+pIcRsesKavOh4G+sh4lsh0jMkfCJVHx/Gi58JA/leg1bj1Va7tryHjJdjOGymk4
+JhVnVV+NpoGFoy2cKn5pzuhNXt1fUrudqF5NpRoo2at50w2T+drTRTQw742ZbJrJxkw2yySPZKCZ
+5GEjyRRLaH0JDOWmzCx0DxF+J4fO530ryB/bqEtcCc+5BxZ7YuRLt7lt7qInAD3Tf9lp/qv4HK66
+tnMq8OUR+5JWhB2+yzx+l3kAL+4IXuYhvNjxt8wDcNEjcEn9pVIz/0uoy3JYu1S5RWnJ1fVJ/UnN
+QN1/9NYAWIqWH/uiTNHIbExQ+UEsQ3MwD6lhBfMpCYFm0Ema4glD0T0YZMaRJsWJM17zKdkarjyd
+anMIMx9LA8ykpk2vFCc2h9ND66CPuMlnTCF0yFw4C1nnMrXTyul1zUiLX7Xw1YpereBV16PBmpFY
+WkD4pOBmacE2QgtPPdwQra7UU7sp3WkzD1qPqDJNapPMzVIekbaTIhQDjdG8Tkt1IipzOtDwYKvI
+ZBb15lOtX632oVK9dLuCzM2ScqNKOroOjh4e94cesMY8Kh7bMlTCgEmB7mK6g9nuJTlp805dF7m0
+Z+gIEyWyeAbd8UiIPbrJFI3MxhRpS/xuqymn4UgOo+Pifdhor/7jdBfny+TImor3e1vBSF7OJbWq
+qxXPFtx9EmglKWrGyn0qb3UyGQ3KaagNanywG2AmBbYGpMk3PUAra224Fva0tTBQm9QNjbSIxKSa
+UD06p3uvYw3urotX+9SdZ4qOm2fv7xg1gfK5l/P5Vc4nOYyOm2fv79jKydHfXbwburOk6JV2U25+
+TjkdssAzcriT4sRu6H1ZL+XpVJtDNddFfr1gJCzCi+mZtlkktXhX+02dxVOXKuQlIYwQjaJDQuQI
+J2SRl5GXS8YmZtfRtz7W7tXuQ89Zp/Hn7C9gCG99+JpPXue1itg61lABjqxsp84UmAsrN8/OiSVC
+mXbQSK0keR3LKfGm3RyZmd6pzHafEtQmdUOjmfgzD1JUi2b3mRCN2qRuaMR8G7dZXxFKvJyF+AEW
+SPQ0Tlk6KUBV/g1XgOnsvzqDBZTTkMfAqfxDDdX412a5/85J7r+cw/6bukwkv0N5iLjGffoT01Wn
+EvALsKDjI7AyPmzphKwifkJLBmFz2I1BSIbpQeHziSKnBTUXZBqziP5IbVxSZvi64dx8X5Fdo6pj
+S8C+MYbP0zJ6hjEhGqkbOxlYN4fwoAnKrN5QESmbyoy7X8bl9Jo1yOn/0MAIrZBVZ1bmnRpuQClG
+x85Fs/jLwVlkJlW+ZMiUr6HbGiTGZ4gyDl75ChEEhBuF+OWHTlYUo+NyOkXb6tR/funJOzhmA6jU
+7aZ4v3e6Kb78eVFLQ22eNQJy0YmZ4EkYyaBMm+W63Fq13Nu0hOGpp+2mMEpHC6ick2cpt3EyVc19
+YGPhxTV7G1OpnJMty+eLz/oqTq/5tK9X/1FdNwxfjiu5hJdKGFY89kMeKudmXN2j1KKlaNQmWbQW
+iU/NpJK3pBtmSzb+BGe8JtrSLJjsnDh6tv8dtuKk2jl5OCzeTn/GW02s8Oun0pfkMDrehlP6ZE3s
+Br4dU7I7ouTb8STfjyZTTRp9rAYwMez6qYpUMkt5czu87RFIY3PggqCxRaRo1CZZdJ6e2tK3iz4N
+1rBNxs4jI7bIUUlmG2UNjeItVlywF/mWmRzNagtYiyuVx7mK105KRYgrRJFRQWNYvx2HRPlZL8gt
+KbBXiV0Z/Yh30VMZAaEYR81RpRhwb7zw0r3wwr3wNnnRa3YANv2Bc8DB4//BY/+hx/1jezYp8+Aj
+PIBQHJbTlE56JwOLncfQ469EvxlGiEbRISFQjfX6D88tjewehJqWkrzKwX7TuzN45xS90rzik7Aa
+0D7/qcLnXoXPryp8flfh4519FT6+Ch9fhc+sgp/4U0/RcfPcnTL5nGT5yu8gGnGzinakSXFisxxF
+c+7T7HJH/BYpkkEjqO9mw3Pl48JraDyDkuLEGa/pm+udtri+vz/6urrnXFf3mOtqT7mu/iHXNeDz
+236AqSXdtXhX+039ldvnP7l90l2Ld/WWm42KN9bRVky3V303eLV3gS+qTeFQktz0ERxAOQ25w07l
+H2o5GMChPZPKqNAMzEdB69TyUKnp9CwtWPV8JRpBrsXX1LekEhGDJQ587CitWYJnjSoj26OcTAP8
+W1+bAUwlj9DcNr8Zbi7ppsSbdnNk+6h+fmf9+U/Wn3RT4k27OWrWa90iT1dT6abBvqXbm0V3Q3eW
+z28XX0WW2PXhmlV7QvugpzX/7wg4wHu1U1f59wjFIY+w1OhkxXWtUR8UWtzQgoYVMSwZxhBGXR6h
+9zSJURSanz4isVYJHgjxOvoFGvvI2nW/1D715DA6di4+u889u8+v7D7JYXTsXCw72BZbNQafSsMg
+Lw71LADVNc/p3RmS5+gV76WVEu3zK9PP70w/yXP0ivfymaqdz6uteBJ/rVGXeRWj4z5Z84pzKXgq
+zq82z4hgnvoDr477VPLiOZS7Vk6v+2TJK31MBSkyQta+4AkuQIoT28RuOFNZi0ue7YVFTwj1PtOq
+vyZadc6zqptmVTexqn5eVe+zpvpr0lTnnKm6KVN1k6Tq50h1y2ExCMVhOU1huqyePtY3zRoymzEb
+4Zpwa4BVd1Z2VtVVtJYw2qmMiZfTUndKHzfFRTEDWvpoboZKvaSbMjekM/zjLS7xSJ6jV1wmttds
+7ukap91c3+mu3Yqblwq/LP/815R+uXm1tpuCqPs5r/4641V7k1YxOm6evb9j7YX7ibP+Om1We61W
+MTpunr2/YyvniSlfRRx+ekdovBJP+mCOdG5N3ZC6DfXAP/SIY9vT2jBrbx98UoyOncvM4j274tiG
+ShuXh05Bq37kDSh57V3Wl9ejcK2D1IEj7yX99ZziTWt37ZYs3TSWjOkx3u66BEbn0WxYEpMq6o9d
+9LAnrASLQVfa1I81bZZF0/rps1brAcPZb29C33TnkKLjaT8nMdtzvHAgmTneLP1mSnct3tW7768C
+Pv8p4PPfAj7prsW7evdFAVeWW5CgZBFyINWXCGfDfln6zZTuWryr7Zf6K+1dZbVU//yn/M9/y/+k
+uxbvavul/kp7V638o7miMYoB0agrMdEjP18q+ysjTWx5TOhKeZnEc+Ym90rb7Rx2s4lTeiCssvCG
+grG4sHHRAF/iT1ueJ7Atc18SoKVQSBqM+Q0LHxvXPDaM/i0/ef4ixYkznp2Q5zNpxs7pPbPBY9hb
+vhZaM/xR7xX+DJFgNL/jUk/RcTc+J2mlPmlTyUQffXZfUBJjiXNb8bUofuJNP++2sUKb5F7tbTXF
+6LhPZuLq3lCbSii/1HLeDC6f2jwjotnHAoyjV7yX1qPZFwGMo1dcGt0Xqp5hLrI2DN+Cca//cLUf
+ttHrUBEp2//RdbbbrYM6t/7fG1nX5QY1YZeYbMDpcq/+DDSnhLz2e8Zo0SMhBMbYxp+hpKfX53V4
+lMOeBjWkGVe9d/+YEmj57rd/vNuiZXRiC15b39RRM9DDHQewvdvxa+8+nSIKzSiK49je7Rb0pGoX
+WJYSs/D5A9dOKio1GJ4imAKN4W3+fdgn5SYhB92BH0s06CQ7g3NOQWHnL42lvhoetzCkuVBQZdmd
+nujPkZnbh0lvb+5cUHvPYB95tWHVj5Q7Yt/7Hrcidt1oKemiXRzZIqq/clHSRYvlbFs4/CErQ3gl
+oUiUzaTlU1qcp9/L2ee420bEFLgvtrJQ6n5Roh+2N1wswv7KdoT+UF2d/6lyFkXqxHXYD9rKlEVp
+YQsYfBeiCcq/l/i/1/i/sigtbAGD70LG17D7CAdR0/q4KH1p2iN/z5Y/q65x3Q5fG+4lvrZmQhIB
+dU3ivsZwKxdl9eT/2Po/RvlXT/8a/i1xbUScLf2P7Z+iv/Kvnv41/Fvi38rKGVRzNonNlARjNyMu
+sk+xhc/3Lf10rUhA7nWj+mfpXmgfi8xfrEK+TWHYF+eonI5jUQlW82WfyLrZ8uJ7NS9p/TqB/sdy
+dZKrlq7q1dcqpf77PxX8/m8Fv3LV0lW9+sYKft073waF69IILJHtwShSJ0LaA9YkbzZUBtVrgFwZ
+ULRXCz7WqjIZdAJr50NAL3sK6GXP8Lz8IZ6XP8XzWo/xKL5RtNpO2dBGXVD/LP0kWgnWW3Ei+rKb
+3BOsAbX5UCnVxKr0tBCnFzit34iMqA9uvWqJN1aiGrIlYArcIkf/wGyGKr/Xen7/qedXAqbALXL0
+D+z15JFv3mQq6aL1qF3L/cpFSRctlvP6dnauAjxeNj0yTIGDi4V4rVnTUuCHA0dta7QbWz6DtyHi
+4HnNwUxW5zru1LAHrtwDV/af9Zytal/N1onefT0PkzaVpHIaWqw8QiFbqr5eelpKOYMaitt3ZOI3
+UpRrWxQaUVtAjwkbmg3lNZO2+VWyyWIyOZTT0TZvV/6Y1gwsn00GeQg/fCnDau/HktLC7mjRyGWV
+tKaHV2ahcNGb3OxkHjwipsB98aouX+vLscJ8qdEnkkuJxbw5KYRIMULiWathNLMse19654Gb6GZb
+IJ5cKWVfenuVw7CcQVn+dQ+4zG8JGIq+V1W1LXLjEfeEZpCLki7apaS1OIdhGj7ePxUxmQwsxwpX
+fpqQ9JQUldaDdimiGdrR+knwl3/q+xU+9f3Cp7pfBy/6AJIRG3341RxDL1TbIhjX/u8Iu7+D4S04
+h+xh25R92HdCF4fkZF59+f2KQ3Iyv1/3m8v530MOMZkM4KLYCTC17WbXfAyTci4UrluBbF+NmVjk
+aAHNzP24oZkh97uYTAZegOyeBqz9E72ov8I1U7si2cIb0M4pKu2i9KhFhfXI3Y6XhilwX3zxx3BY
+SvDD3qHJt6YFE0+AlSprgglFKevrb/xWtn0qu/FjoM0+Btr4MdBmHwMF6NBv2fYuzb4I2fwTj80+
+8djWJx5b/MTjUv5cNFbXuWD5vVkdvAHU/JZP2C0YbiUoy+ctAcsZFG/N+3LPz3TmsTj7yM3skuf2
+2TbrAXy6VF/KHNZ5UCK6vd7LaffcVV9n4q7KJbOPqxYzT+OnLHKH56lzCNdfj3PULv/oKz9kmVX6
+yNoJTxp8UXRy0SrfWAAkp27ELqv+xoLh8nh7gNpyOZeiaAN3SklGDDuxA9IGiTdGAMyas63jScZT
+YyQ4aD1vTfBAg0qU/sEOZXLfiu3jDFPg4ILWmVL3i+J+60BJVQKmwKGERx4U5rjqHHAfdoQyTIGD
+i5UL93SWon63rYjJZNAc3MeAEfnl8+6fPu/rQ+fdvnTeb1tjc2649qHSiq5LFcriDrIcpC1yT6uG
+VxzsEXaVHmUsL9bRtudnkYApcIsc/QNbxJY/N4+UPxckp7aoOzqtQF7EM+3rWc4h4y2Ryxk17vsu
++p9/DXJxiQpeLVvauGrLtbaANLMhPHPv+tTENkluNk5BaWFb2B0ZAFz3yHBiBEkLmgNc7s3etHJO
+UYleVh+1ul81uPIrEABJjlZ4ffzBeRUE7bqrXjUEPTpI5BSV6GX1qvb7T9Dff4P+SuQUlehlQdug
+oGuzNWXn4v2fb6qr7oWDzVrR2AftLSaTQzkdbQC78se05uAOBl6zn5uSQzTORLs+5NH5eEfnsxvd
+ntro+WY7NVA3HLIoLVz5IYB1gHFweq8wtQWES5K2jW2hpMV6eONPHk1hO5+JI1Ba2B2tcfd1tDLe
+ylUrZ9RDiPeqpLaA5uI12wqd2BzMbTXFVglfFu4Zj5xtgXkq5OppSpFFtpbz5RplUCVkL2QsBmLr
+8b79lM0kS1hJaz8/KwqwYOsVqKWgMdiX2iPG3Z8n7rZf/dYkl2JSkhE9J8IV6agvk2p4VhxeK89H
+AMtUTkdrpCt/LpqszObkNjaHuKJyYNV1RtNr8yPZC196VynJyW2MSiynKR2A38cCSFq4si2ANLw7
+SqKHvSZKKudi64zX9T1Q6Nb68LJnf+WbjUAggnHX/bLd9ovXmAHdyMDam9mumotJSU5tUTe0ktVP
+n53dh7A+irMUZu3JJM+GnXtQ8kU7F4+A7IBKG57uAdgOZSr9uYhR2eAmW3KwxWv8acRuByQ/jCi8
+at0vCt4hvehXb2j2Ii0pObKf8Ap4/z/1HgwSOUWlXZRLmahwOaH9/lPf77/1/UrkFJV2US5lomL1
+5fszPjN/NaBAOKsZ8aSGTzaoTAaWkxdZTXr3vY88cK2LZBvc0v646rWC4YcP+6i00K2eJm1bnCzJ
+qS3qhqE0bfh6a/g2ODDHo0+wnEEvspT9vrjukUOEt0QuZ9S8T4L+519DaE5tAZdZ6RB+4pgEY/GT
+b7JVubQ/rsLtJe1hX88MmpY6d30wj9vB0tJVbf+o/5S9quxG03/lqqWr2v5R+1W/qgg9ttuDYk0o
+oBUhSnIopyM7ayl/TGsO7mCwKuV+2hAOuVCwStuzD14AHVveKcxjF3EwF2kOZvI4u1ec91CxjsqB
+U5px+erFWF+9GOvTFsNOeoaf8gw/4RnrdGf4t+7G+urosK/eDf/o3eBJ0RD8rp1KM1jUfUVFaU7G
+hrQ7lwpEY7avzDunqEQvWwRqdb9qF1cujn0Gc1LeY4dBL3JRbLzAcAueMYq33Z+ndC5n1K7hhkTF
+mx+fm3T1LVftEnfteGBZvpIChjJrSwjqn6W3gMFtoXf9035NxTk48YM2QeNEYtjHRRUwcTHszmPR
+KuWd3bwNMPRBEddqX5tBH5c1utQ/S/dCvjrCZwSHfURw2CcEh39AcPjnAwc/HjjmHksckpONQFeW
+txND5V3v7YzqI7PaAKt2lgjqRixZ14iqYTjVNZaqnQGSkH80Csa2x8InweMvUub/Zc1/43WEqK5s
+SQvdejpYPTyHH9qQtt2GSUlG5uE/2GLYnd8SsJym1LbInQEokD/tSSbnFJUeFGuGar8SOUUllPn1
+MjYKQFuJXM6o9aUQ8S7jaDVriNkfot1w+Dg5dhOJskOiAfg+Pj+PP4VWyc/j2zfxjyFt2+srb1Tw
+YYpJdXeoz9NZS4+uR+f3/N/KYYPCuVMRk8nAclD9e9u3+9ayYufPbJLSwu5oxcjlXFrdI4ci7xW0
+toDqInmnQIVS6sskO1p55Upb1A3Zrolc685buWrljLpHALTPrTioSfvGPqP89s8oK+WFJ2GYLG6h
+zxCTycBy2Ho/A32v0893ZsVWbUHKIMWK8vVTQDkduS9eyp+LZkE93gpo/bhOl9+5NFvk0lgH1bFy
+BrOwJLj48/bv37zD12/el4/dvLFTeHN/8Oac+G2T4Xd2P2ukPb7xzv5krGEKHFyspD/capgCL3/u
+TN7feVBoXuVaqfykoILlsEjNyPFfCDKEHz7I4r8L5D8KtH4R6M1Se94WwGXnE2Pvutvd+ncdJpLJ
+chpZh1c8PqbSDJRWqbfWr3kaljMo3Rl0NApWz/f0AOZidRxmGRTmyynGJNZ2DG/7MXwAk9H2mfzM
+/60hVcN26v7551Max6bijPrD39D7sV/M+0mtvkyqQZfiR9+E/HkcTVv1g5eefvgz9j92Xvjjp4Qg
+ofGuYqhv2V4UmqnJDP5XUsNsEof5v+iKKeysGnySsD2RSrCyMUFjabwTqdLCNK7Mv6PhEUAA/eux
+wN1CBTH+10weH2meJc70e5tpe6o4ynbPbdo/5//0hFoH02mqP5pM1P+ZHK1lMdk/Uv5IecNv5uft
+E8/rKuSbA0y15JvDVgKWMyhYmIv6x/T+nKSnw/geJJV8C2jxqFo8qH0EX7hxVpm3G1/qM9pK5HJG
+rS9FMcmTQhJBh5mSekiTLk5Z6f7IRd5Ku71YtLhT4ZedwAuSkzuySkU3cpmItiBUdQ8WtVgMjH0N
+IXx4KliKBPXCKSo9KNZUPu/+b9Dfa9DfC6eohKC/MWhZ0eCiz9tBPj26KtZDpmzelmUpyyBpYQvo
+1diq5/N6efvOu6187UrciYVcFqtkcr4t2krk6NMN1wM6Qe/jH/Xi7mFo1YUqbDmfesv8gK3KZpIZ
+5lpN5JsDW4JvZhFQDOnZxWQysByLe3IMl5MrWVcfvwN2A+9DDPK+KdpWtDYhey3PaCuR0Z+4cACJ
+QnVPJj+323dkdxB3YaMnai4ag5j7jhQm2F5br7tDBx1dDBjvJcn2FS8s1ksaan3xc39K8Hhsez0i
+ljMotiMM6h/obbs/F9nmAE2LcFe/NY6pZoOptYdshBqpLOyOiNu0UWrtr9o2BzeJAQr0wcOLArxG
+LeLgJviPh7QnW6x8krrb2KejPilYsj5p5yFl4LEYgub9yktH4uf837r6fWJ38olncVQ2yg6JVfVZ
+ZLblszYMjXngzbft1XKdIJokTXeO2ZtG0jlsvmlp/Ah7UudHnW2Xvthu+lz16CCRU1SiFxpM7fef
+oL//Bv2VyCkq0SsGxUh1LmfUOFahI6dVG5nGOhu4ZXQQpn6c4+vTeHwUb4q9m8TxDr/MrcJcmhXE
+xqSy0vekQMZDtuQAE9vwbY34tmB5tyBwNO38zC0t0sw6kFI5i6S6R2aPmapKq+wTLTw2pK9H3SVg
+Bw8xmQwshw0evqLHWsW8PEDAIcy5nFGz9Rb1P25YWf2RXxcFWVZ3rrvtc27+izb+wHC+6ZMkOc3/
+jcdKANsDZbqmVIQiUTaTlk+JLkgJEVI5+3+PbSjrh0hzkqbrKN2l5VRrQM3e/2iu2iuitsxVnnRg
+pPOpl5GyzP80ky/ZPzcMR/lS5fbI21WboeTO3ZfcufeSO4+Dcp9VymNryaTmz4M7diKGatb6nzJb
+Ja+XtsSmpj4zhdalUKCtXdhJk9RjaGLrSvCyUcbre5lHYjsOy7D2DjspIP1RfJxam09KZU1I+fbf
+lMMzh2e2PC+6ZRlQfz9S/vrSRNuBn95S4TouuztGc1+MWqGMRXmL7FPXZSkS1YtSLkq5lCs9akOu
+WrqqV99LS+1y1VW/FqjtHzVkx1Zxi1bNi7jzqhaWfNMfUDTKEszlXExMvhpyWl2fk/V2Tqs1VhWu
+ihMkOcZ8W/p1ZZyKn2RFdWWDkGIypjIZsCmHfUFisbjWHNxkkG1g2PxOqe6LthLZq/Nl4DeFjZbD
+O7bIf9nftek3K7xvz20KadvICn1QbJSSDBohPEgQ9NO1IoZfDu67343qvsiz37KonIu5NNQMVd7R
+MDwYn/n7T5BqwM965Hu+bU+TmpGfzHht3yoH0q2YzH2RbUNRDdkSMAVukaN/YLY4xxf+L+ry/ZWA
+KXCLHP0Dh3rKaWT5wyKv+nP/X6WbJg7JqS1afk5e/WUhr4voC7gWby1cWLS1YGGx1GLffSaZ7TRJ
+791fiyXTYSybWuYtuClxtUA/KmlS/Zp0l5Kc2qKV7cQ2+KuMjsvjLQHLGZQ91Lieqsk62B+S9CIi
+YZr+892HnlT9R2v6Fk2Spk3T6cUZ6vfYBsW0lu2VE0aGYQocXFDclLpfFPgN3HxRcpcRHYQimSyn
+ERe6eK1e4wq1AqH3+CVSyLwi1Oag8fFTKZCakypSVeT53EzCMAavMxgGs97cWcrzoqhf1uTOIx2p
+Gw6Hcjpa0/N4YLpYiiaY4JWCIWtXYFRKM9JPh+VS0EsFnifStM3CKSp9KcGJW02p963l8dCI9fWo
+fTuanoWUg20/0DMH1+KBpz4UbDHWA3LKjZJ5rOmw9Xr4ej24Xmftz+1+z3XCZ61lm6BZsnOLIm0l
+MrZpV0vQJC1GE6jgdVrTgA3pPFOvqACX4jOv+unrbPlpc1jAvZz1L5uZdwwUACeIUCQt7I5skjEz
+Dvy8v6OZ2XeGy/yWgOUMCldOVP8EHXncOT7zaBlSQyCQXUTlBVFeDLULoc8nphZ2MfT5ZA9YxT5r
+etaGLwGT+tOQffxCNS+chUxJM067FVDXi5vE84Umqt9PHhSmYqzu83/T5KFpQzq9dtFE3bQSPArE
+i4O7jCEm1XDX5HMbJmFFgDs+WDzhVIF273czs/n7XZ9tnJKdRDI3VlOEAuaK1DQIpIcJZmp0hvnW
+BC35zi67SW3Rt+5+9m8tyP5gh+w8m5rAkNZJu7SBgW9oZvxMkiPMLK3LUedP4gp/8/iiqq/ugBzU
+pIk2lquVexS9M57rTfq2O5hJTMJQhKeVNQlStd/x4VhsXLiiUneKk5eSJmk313ldFQ8X5/oazaVm
+Nk0w+1ap1r/8Pf9c/+bkEll5p5jqa/7r4r0e4yEtbwFL4L4YJ9tQaraydb8vUm/7GGx+lZrwK7oT
+czJJw3MziccryKeRJCPPHA5edohTvgXk3tnUFQKXnJ1XBumFvsD14BevBr/YWbho/uIV8tcrU+f4
+fuHxFEh4aCMUe0X63Pa8BbxF7kEhQwxcgwSoyW4R5xdOUF7jvD1MTvtsWtPwOngabhI1PrcKaAbd
+8rAEzR5lNdpKZOy4XS0XrS+FB7/mj8MC37JoGVdQj7ev8r7janx2loAR4Lw8sjeZn44hB/qzEBcQ
+l7K8rC0tNoCfOyT/XeRWl3rbWxFu+k4GJJeTwhcWC4c1chekCUIduOeel6mKqFe+87SZpG7fmiju
+qVCo2rb9c9tTnqzlx6f+BiVBfQYmdlM2SrOjZQNHlzbs6EKiG1J4cPo3TT28xBZv/tpdX5XJAI8m
+Le6mNAc3GbCu7RNXXADJqRuZX3pv+9juclHSVeMSLks9/kcv57+W/yl10az+ry9smCRJkcsZNQ+o
++iUANtalBD/DzJ8OD0q6aBfHFT37G1MX1b3bc9HFEb/TbRwC6o9wK/InxxfHAP5L40tbQVRFmLu/
+2hyUdNEujhbkHl5vnmoTcfDhaIr3fTTI1XTRTleSk9fcZI1tU1ZpUCn1x0GSowWZDM/nZ74f1gGm
+pIt2cbQIptb9HxXebN/rJVtbhCcko9aXKmmx1aKK+dS8j4CSLko5g7rKU7vkevctNdZh3OrnWsCl
+ruz3ViKHDAmYArfI0T/wajsUNp2bSntu3nxyp8Ka8Mv2BMuzoO0ZIzKXnwZyTIFXZa7GUoG9Cv3d
+ecKKdcZ8FltfD45auqi2AnqXp21Y5BSVdlF61KJirYB2kuvN92+mpIvWo7Zi1FuOLTSV3sMaNbxB
+fHDOkS14c3N915+tLOqGkpyaG60Zb27L87hsUpzoNdHWpbGXIjSK5deCW2sWtDUPitZ8SsnytWgZ
+3xIwBW6Ro39gq8UUdNan7PKVR0AUqQcX/zBhvXAk74WDy3q0JDupb2WRZXdxSE7eMaYs7+bkNtZ5
+0wQXzFTKoraoG1ox3judwMcC+03ablKSUzPKn7lIVDhBghqzyrLv94DLbstq3E0BPDbML0l2zIOW
+FraAoeRCqz+/ijgkp74I8+yleFa+Oe1bw8lwVHvUz6VIwBS4BQ5l7wuXchRsNrey5aeDd8dUJC1s
+AbuzhZtMKx6vBCSntmj5OXmY2o8mAeHxZMdUpAy7fhcrKD1oWaKG7sMpuUpsPLdqlddSm4PvHJfW
+XbUG2Adtye5wtEWXQMc/kY4QarLNt0wPSqhIlZBFfH7WTz8oBTX9o//j7oHdYO19cmcF8jhfOZSx
+nRjQVp4pnlXbCLhC2bdco1bOoBfvEVWN8TNsjilwcFmhp8LjF7VhtbxswAJT4L54hXqtMWoK/fab
+tBFQUlA8gGnlDLpF2HlaRJR0Ucp5UddoCQa5mFpQVgv2de60tFjO2L4hEpRyRnVFGbbXBUq6KOUM
+6mrI1J7XgmuPDd0574fNwoN6yY7NMT06xMKSohK9QvOmNvI1d5xB8zFlWvknd60jHkZrS2sxwH0p
+I6CF8uNuXYfYaofY2l52OWgpdKfHeMzPd0ZmxmHV6a9nrWPu+jUtKpIW7qv1rv6b3a+6N/rYfUQq
+Lr/oYtbG6xPEFHiNGVdjqRY42FclUKyDbc4ESgtbwL54YYg4xMcClXJe1DUSgkGCqeeLxtJva4X+
+1HlYSf4z6FQkLWwBu3OWwNbyd/RA6CYpj0Vei2nBS9JiC6lKJ8pwkLawL7OHk+FdZIqY+rL3EV07
+F4+AHm2IQ7CFGoZcavB9B3BrZ9DolP0Nf9f2cVGi45CLki7axdF7ztVyXgx1/0e9Fn9faqrtqvWr
+isgwziH8loDlDIp31mFCDFx6dxzdrp0Q3UWSU3PyXFv2wx7rMFSPtOXdpCQjXE02LGdQrM1B/WM6
+q0r47kXugkfiVSYDy6Gz3OpuT4dT0erk+am/j2XkKymofemSFltkVZZP2/pVkXRRY7Gp2z7wYrAI
+lY/GGgd7KEdtZZ6LVnX1R0rAFffHHmU17Zr3T2asl2oPOvk1LxQElBQUD6jaCuhqDALebw8OS6Kk
+oFhA18oZdK9gqTGo8fH8lBZZUtRWHaqy1Ffb9tsjd/lHTf/oqw0X079R/tG9zjuPoaC0cMW9xwMo
+1IUeaGy8Z+6corLCLb1HQ4tKzLEa/nvkkj/xhYCLCmf9uAaefliKpKt6dbXIpnsbo8GKiDwXeVyx
+NxCpqPfX9q7NQZIjXZXd82iLlu/BY7RxcA9ReGr3JZudWX3l+4XSQlu8pfWlLrTwcfxdR9//jL3/
+HXnXcffPqLtXXmBRSE4WTpXm1I2seNtu4pCcuNM3LmfUbLcfdVmW5uQ2r+3YR5GAKbA3eak96IEt
+3MEuVUiLyuncFnVHJw/Uh0lJiyxOH9YfwGUPAXyHNhWtQbcf/eZVkYDI2xp/w9r5klGPf7RyXnVb
+CbAg81bqeCxCvmxtyB5QUlC4AEsrZ9AZgQWovaU4WNbbj07K0X7JsHXsyooJwr3gKbFKbMeiLwWN
+RdZbqpRzsWFtDjZtMmW5SHJc9XB/Muloi2KU4xLmCHFsX2McQoUauK95vLbdJAxtu5uEYdiEAAQj
+H0qa0HlNjtidrbafjecCuCaXS7En+J0lRY0FTbU1FvUeDVD2m+w2N3bFskqYVwc1ZEvAFDi4WLv2
+y2l4UNNVd3+7+w3a9qvSlyZp8aW0Nz3e+obm2MeiLZboIVaJOWUL5z6uW8AabnUHldl38cshrhQG
+0kepAto1hKD2oEsKijdVNboNua/uppIu2sXRY1Ct+z/q1ft9CVzbVXNftzfvarCkq1bOq247yqtF
+LrbQZL/pr8pTUg6Lbnr6xxCW0WfKrqxoLRRssU66vL3o26/qTe5jUbgsYYYiS8sX7RkC9hEa885D
+FiVHb9S7lnG0bSr/qXk3KcmoGwyHlemrgYqtg6DKMrBS5VDsUgh07LfhwE43Xh4cUsbljNpyW8RZ
+np5hfvNRWYD64Lyx4M0EldbnhS8qKJgXF6fUG0cOKC3sjtGXy2NMp3QXh+RkK9aV5e3ksc+tOJTT
+0Y6hUNT+hOfT/J7+gmx/bjZ1A6WF1pilhRIL2Z7nxtCnxThXjk9Anrz7/xQ74QNJWmzFVPFGuBbK
+A3N3uZVFbpTk1BatbCerONtrMIZsu13sfa7ruc9w4fXpF153OSkK78rXT0mZr14spQdtREaF9VNc
+SnJqRqx3IiOVfJdFaeHKt0KJZ4nVbkQrSApYzqDYgFHVgkwu+ZJV1rN8NKwoNN63fb3hddGjg0RO
+UYle3pKp/f4T9PffoL8SOUUlenlQXy9K3O5dSRetR+0SIbTK1eAd41zKwednp6Dfj02Z6g+ivDa7
+yg9KC23rWVoo0QIG80LWY1xO04YsilYbCEv7s1TL8ZtByiztFy6JXoVp5bzqqyr9mn1eX7M3bAGX
+qyy0xcNE/OWnN691cvPi1Pwlu1+lVbb7AFBOx7FcbKW7sgpwOjTZ/a3unXspkJdZ1XnT7NEVUHfk
+KpdmwZsHb34WrezDQ+ITLaq1RcvolL0vWmyPL3Hra3n98jhx1R8uj78yJ7cKkgKW0xWrihxyEKTw
+qodCcvLFNGV5t0XL6GT1le006aOg2IFOqS2y0mcoTZtdLAelgOVcynL2CLLZ/XJjOPGRgJc/D/Ba
+DwO87Fb/q8bHpV91jeS6nn9+VRtN1QcTb+r7Hf2XPYPy8kdQ/A7/ur2/fsx18VauWjmjbgG69IiS
+ohJzVk1d1p7DNbi2jccQUFq48i0QfjMhh99MINO11i8HM2lX1/2qbeVfvZz/WIb8jyH9j+X/KNX/
+tXg7g+nf9vwb+n/KWNR3HCKqFmvne0Fy8nIWEddUXoefuABPw30s6obe4CMcFakcV6WcF9V3HMew
+2tg6vtkJcB8DNve/x1bCx5L/MfRgkRSVkHMG9Kj4EglA0sLmGF19H6ZawO50JIlsGWsTc2UrF1U9
+2yYlA4aYTAZ0sSY1ufM5RZAE5oVSKBd/BBG7r9AEP05jxOwwLlyxVWiG05XlxzZKf/FqD1FSULxx
+ppUz6N5a1RCvforJZGA55n+8hklJTs0ouHFNELvzWwKWMyi+6MeLcwGQGxX6NuY9DD8ljnpw4BA1
+JeScAdnezn7s3ol99VFfF8V6uCTW7cUpkhsJDPP8LHZNypXu2rIHf2kBg+9Cb9l6GsA4RaUH5VrG
+F+hy37/7C9uGZh4mrQK7SgVyN69lt35oI9TWRqys4SvNjjS/LbI/e6Looe3Bku5fqDFc5rGIxmfm
+Y6lEn3G7fgatRGW5Zdv1LM0y/QFJ4xSV6GULQq3uVy261nZRmGXlX17ja9X38k81mGLbFdQWMJgX
+xvDeuFds2suvZOrj/Z9l8YiYAvfFVkF8U+D6ngC1t1wULlQth9fIDxyQlsM6FVqaBH2h178+bTAV
+q7q8bSmKDUul5efki+UPshG781jEth5bEofk1Jy6kYU/Nt9Egd0ZjdZKxvb1ZbIDCgVq9ivlw5+z
+W1fLx3q2zuYow9s8yXrX+A+UPsSBxx/jckZtlV+6LAtInnhBmCRpsTVOFXrvyaQ4uR9f6Biy9/AS
+ANQiznUPaCXGMvsQGblQuLTtQHlE7IstlCkx6+1lJDm1RXkFkhbwNLSl5YdFJlDyUrKCtIV9mb1l
+4QozlLdELmfUfEUGXZYlOF88L273g9Mk49WTrp9Rl3TRLnGsD3gSOKWH8xNCpbaoG4bS1h/hjGDY
+ySN//IPg8f2bZ4blDIp3wFRzLGO1kkMpa0T4VBoUo/XYoCrWqGxw3B8RLbQ3xc8o1wvZQNt81weS
+nC1I/jzCsqvmY5Rq8AxukgIHf69HlbpflK1c1FjsLRclti8OUTNcNNsTqFpb5H5R+KpN1M3BxkL2
+9VIpqPKRCwVb+PWghXE5o7aavR69UIb1aJ8mfU9OdgdJTm2RZ1tQIGs/mn72th/l9cg4Nh0714VC
+Jw1xSE6emQPud0Otgi+S22vk9uynv0J+zl1pvjnqAMP1TLuYaVcy7dKlX7cctD94uFBwS+uk5ayW
+tu09cxgNfTx6cAHGqEgRbfCVnjEqctX3VxP1P+wTnUfj5zQBMGEMqyyn0cx6z/9NE755DUhO3Qir
+4r0VzHEByNWT2veG/B22xh+jzO9NDYqIK097HdMQHrDgSSuVyQDjzLA7j0XlNLZs9Njbo3cxab7+
+vUZnK9zj1YiLHhwkBW6Bl8+5aDUnRLUnE97rGq3iCEEGHbiww6rFO7mRW1DyyD2otV/U42nKU/ZR
+TbEmDj1B8/511UJAMh0UbFf3MPpTl00iWxGPjb3KOydubu+cTCTKfQF9pZk0AyVrXueZ73CS+V4P
+i7/xLMybt9qnLGZvJllGXA4Aq7jT7gZfaZk7nHfu8RuZrnoel9gGRPYvlRmW0xWL8BYHz/VDzVL+
+XDSrvzaTaqjtJiaTgVvEfWxtGXuGAfugwgv5R1k7DWPLEJPJgFv4RHNi0De3szd78X0iZfbfj5R/
+sAea+DcL0lvbBog3fUkzxl9t1Yzz+4voUzbKbnIACh3ZxyS6IY+NAUz//3yk/2zfWxvbR/qPzIPJ
+gv6R/pM/6zHyR/pP/0hzGy5l/k+lfaTnbftIz/SR+IG15+9H2rf53+Uj7TIvF8to9d2/P9KeRU9W
+9sxvs+59/ne5faT6keo2/3Wk1c/5r8fm+vmZd4o7ZZ9Smr6YSgi0cpXapums4FMNvU7nWedNB4uK
+mXPLRSjKCeAGAVTbtyZ627Ti8+wV32JX0SEHhWVjP6MED9OxbdbbN9Y8ASamOaGqn9qsHiDyz60l
+BzXV51zWm7LO1lRsxWDIInNCQwefelSqPwT4tLxvuUnklVEWldPYfeF4JHw8zAjGJ1eBwlZy/0db
+XnxDO2qXTLlofVy1mHkuxlYfteUoaWELuDzYWcfTBtDR6/ZyUNPfj1RnoIREGoWYjiAJRwiVp0od
+i0lum34YNXIJSt2p7NK1wJ1pgnhSdMhGQRUi04z9iD0oVNMdW0q6a4OqJroGU9WCyJ71aQBEFSTf
+eTcJg35avqKGedml4tPmlZ/FrnoJpk6637Y2THYAJ7OOak51Lp0u8J0fgqlYpvtX1nM6AmoAM7s/
+TLJArcmkudeDQdCbU5STgD2so1BpUhxoGgiBTp6ym2QGaruzSfeKdBeTzaA7DJKV1VY9qp41ENQ5
+C1JVitwpuDyTGKHIHT76TFu962q+YyO131MhcBOH4vldFqWFK58V2a9eGC2HsciNv7IoLVz5jLpv
+L4qXJKe2qBuuAmrizwhVLH23t7WApwNL4fx2ytrEAf4Y8PdeJJnsBEEh/NT0BFuXo+rj+fU+Wh0m
+uwF2QEA24AfPrBAQ5Icjd8ppmPmPufoyvo1bdVaAk7LK481/qiYTy+fMLanpuz5V9zpYhoLLk5Dl
+NOLId/4TFUFWywNxVEXVRWPpzF+FZrGmYmOXhLwsFImSZoarLwrm85c6leCJ9ETKTEwaCmcH5Sk7
+har1yZYruEmHf9FjaeEaLxWf5CGglno0Cs15PfJuch6wAh/Pi+LuCkMT7BBUltPIen+EH9msRQfN
+jPjc8k6hJtEkaQpDT9sJwFIBuFFTGRI5RaUHBT3qWt2v2nK1WZRpqypYdRf5zJx/AZJTN8IPL5FR
+d+Z9BKNuOBzK6Wi5QxySk2eu0LZM68ysrld+q7/ua+T5/FSjKX+BIs3B6hU7/juXM2pc3dBJdL9X
+ykN+QflmcnPYF3XDb4It3Fq0rJ8qmMTglcuBj8tqARzI9z9jbvj7pslDi+1bSRSYGux6/GB/W29P
+WUol0QFL5T2++tt7e7/xSgyOjLvcdR60y5inFhXl75vOlPAtsspvi1W8O6lCQ+oucD65OUaepD+P
+pAI7ByUxUmdVsLBcKLRiR0W7lGLyBGhL9wwvtgOf0Iak39qk9x2W87PV+h1QM3Wc4U2kutc32qnK
+ocO6pi1RUH1QQC1C8XncF1leomwmLYMS7a8P/pSUkeYW7jtqQVbJY6C2pyZbugtUVPPETEhlJ+jB
+vz5xpNLR3pmFip/QOp6XM6IROzQATcPchnnZkZMkLTJcNMou/fPQ3Qx+DbpWJJ9YwwD1xzZc22Pb
+raO4Ldf2n+356YCsb0wDANICMn+vnw5u4qmlMzIwTKccJpkhyNgx1QAg64X7bUY01j4cYGI65OXw
+krTYFnEq5ltfDjD9bKdJGHiWCpim16bJE6eJ+IxEfemm9VKL1omOYh+9BOkMjc8gV34Fub5sxLz8
+gofNBl4vKfcNPy8QFPV4YcTrPqNtqU5x0za0ObvCKGqincqzz6Y7WZ5ttox06EyqfWuCljV+gLzq
+RwZq+56tbk9N7GsawJMwTCIHUfC5r9qeWY9y7ZmHV66M0pw9Nd1OWh36k4aAw0zq2bIeNlrfkBYK
+ROzclBse5Kyty4CANX8V+btIUuC+GB1viu7QGz5f1LZyUVhbfcs+PI8K83Si1Nj88Tx2rXRqs8aO
+CzEdT59X7QWcgGAQ6P62Z/5mklE3RBy0V8dPZ+EO905fBus2TSXBmOmbzZnxhiZV3l1Xfh/1NJ5Z
++ACwiq6yJQpVb/VJoaq6atuwJFwKbMh6EjEeOkB5KLV7pDifGDge85RiDJ6nAtQB31xWqQ0f+Nby
+lNy07CREb+jUYyubClVw0e7AE1qQnvEQPKsRlE6NkQ88zgVpBpcv2b4jW8YwuRXLH4+8O9d9kZch
+YEmOz1LNq7rT0bgsWABUw3u4k7iIg00e1mTcqSRY4XW+tBQu2rBG+Ko6PvnBNaNoXWFU3B5CkShp
+ZlQkD6Sf9XToIGmDNqx4BV3zx/2xHwu6E5ccbNU8mD88zFhxrAqIsnWTCurfhAJlGha52dK21WGt
+fiRufrgtWvFTZbYh8t6oPnRc33Krw6QbpBsBMB17C6966G09PKFc38LTdYAkR6tl8ND6RpSf+Y93
+YyBn3k9Ct/zwsoLKAdAl/Um2eZHopnm6jfKbL/65F//Sy/rIS8V74Ph2S+VnW6p+scUkvNAmNe6f
+sg0H2G9bF4fk5JnDgaajvc3vGCYtz8bIz57a9jUWdUNeXpuc6cBwWJDdGqyHn5/9S78YT5DdEU5f
+WQpKf1XCvW1JFgVjd7RG4gMHKXI5o2brKeqM88ilOEgLuPJJuMDys3Nc7DYedmsHbwQAUKhk6ygl
+aZGDi4ew65wT928HSY7Ldf+mZ92Sgz/S73oRV2T5SQu44nj0unERnlvDXZGf/Vm5xK/8Ege44X0Z
+giRHC/jCGzGTsP8AwMTPUSuNBYjSvA+VyrnYV2zDjX28HTfTDT/c45gCBxdrXb9tRRalhd3RfR82
+5JUkLb64sGRO4mCmX3FITp7pUUp9ySLmv+oxFtHIGQnJjbk5Dstvsj0N6zetP17nD68z/uz4FR0C
+TbYLGk32tIi5rabEjXscbYEkR3i+6xCH5OSZ1pofzKwA5XT09f7DOdck7Mv3sG81pkdmkzUOp+I/
+Oo/8wd6Tu04cm344g/zhroLHqL/6c/bSDWfxv+ft5Jcv6qkdcMpuGi4Yn7hKeeoomxd9dAhg9f9q
+kV94/Mquc0L9HcKK3yCsGBy4e1x507jinnG1W8aV42beLH59pNcjl4/0yh/p1T/Sa3yk9pHa9qmJ
+PjClckbiB1Da9olIBAFqens8N5NigJyKdNeTLNBNQR8bwfFjppIoxWQnNJM0ZLNkNu8LM1oFayI3
+NgJNJp7bvgiX/qGJ2X/q8vmpbtdaNOz97ntSKEVAWA78EOiUeQFayzszhMIAEBgkBG3tfZdhEoa6
+U8hwsBzc/XIUt5cc+CTRYgHxZhjImljNiynXxr3b6sg7Ut3BEJjxWXXzdKRZaJNmkhmsM/PwYcRc
+Fd+CdO+f0u5gzXh+8B70TJ/sRRDyN4qRbw5bCVhOV5Zr/2No7+5Gzf26LEoLW8Dgu5BLbDfJjNzh
+91Lp77XSX1mUFraAwXfhqvRoGHbPzyMjmHrNeUXbdt1wsSJeqOCF4C8+i61k8oSEyio6O7uHDtbF
+xBIObvPDNlXMGiF98LjSTeMWtU5IyOV0tL2AK+IqX9wMSqjdvWwXYWw7iagHZ1nOL/42P1XfkZi2
+XLW575y4Z/zRxHYkP5/b7duhk5pJGlpOdwkI841dp0BTNVM1E84XCDBh3/vDXe8P+vmHK/mH/fRj
++9OfghRr8afQvZhf8d3bT0EB1Afuo5kjUM3qfiLiyfxz5siWkIrJBqnn5oRyOnIELEWWSkJ42VLB
+IzkTdz4ZsJgZEE+kn3Ubi5jFhj3ZMFzfm5DNwtE6iS216+GO4srdwXxx/mBExyI9kLv6ZQXV9CR4
+Eg1sBWZwk370EieppIAsgbhYjmYL1HyBmi1QWwvU4gLp/kF0RMvtG0cpuX2rFUNY8GSLymayEyjZ
+K0h4ZBU/sgqOoqIjSbj/lf32oJBkYBmIu/PSSpOedOvkEnRcgiTQhCCdwXkZsfmC8pohAK3q3fuE
+6K773YGm5/YtLeAyuzOYGbrAc88hf0W3g/Pr6CYn5E/sUFQmg+bgPgaoZ9KpssgwqS4IgggsLkzV
+8Ysp/Dh9yzZ9s5MgAur4GpjLAOhGgWcgjNRYClLUULg0xRam2CKUYo94L6YHhFauR7u8f3PHS+pA
+aSZpYOjdVgFOspseHPjDjs1+yrH5bzk2+zFHAoq8tH49jOirZFO+rRlvpoVCkkFz6CSGto8xktoi
+cwyeNO0UHKE4TVZB1cQDE3VDmgcngIY0600iAkxWsS38728Rk8nActh7Sn0Y7vdFWHW/vyrr/RCK
+GaHmhFQVHSyV3VH9PTLgSdBKarGDBsn8KNT5ue2bSkmbRVJGbs0Qat8FaYJoEMi6VwrXIbGU1b7O
+2WotSBGlcjkqV2rlIzgToL+Q0p0DsdrtNCVbynBLDcrdgXW/mKWaRn591r8qv/VhA4K6vbCnUAmD
+XomAhOHF0V25pVQ8ygVJFzbDtxM24tWFRTon7pOSONAru5dZtPG9zhNeXXVYeFvuMWZfP2p5To0H
+fz/w10OHt155bvjWRcPFZBUdEoIN10kOr3c2XvBsdhWQ0EEQXSgSJc1cdT92IJmE9fLjRxRDL8NK
+dKGPT00+JVE2kx0AbwBMmuJ4fPB4fPB4rBKdqngSaGCs5Oe0h+o8H55yQGYx2Qy6g/nsLJzdgrOi
+A4f4A82991GbLFKjbv1HTvlmciuk/nSgqwq1PnHVnMAGPHkFvR3PdMxHW9vxfGAmejxL3k3CBTPA
+A781DskMC/esO1xg5+/wGqlRD0fH/o0pPIAZspsspxHHgbOY1ijRgfu3FdF8Pas7cLZ2YE3ybTBV
+Tl3CE5PeE3OiUyOedMICnZjHnRr7ZFNOb8ZZX3k85KYTkhNJ0uuo7ewDu8ezH3mYVJcfvRUAKcmI
+Ff7gnkDfPlK/PY7Pzwll/jdNZt74SOP1kY6PdMw1f2xFVzxkJwyT+bYI56hTw1NtB3+b+PAMNe4y
+05b3+/aR5kZ2fG75I3FL44Zm29mBlxxsa1MJc53pTb6neMyQn1lY76RTZT26yeIW9PRilCmop9X3
+njWoWg997uvQjeC4bQPpzLo9tudLJcLdHhbqhl/4Om7z9t4xh+hx+/7UeRehg3hafuBtiQOPtBy3
+7+yymxyA/W4SOcUsxU0vdNDt+6Vby6EbxnH7/tG7wQTW9yMB4KZN1toG8kYuQmnrFQxPNoD3Z48b
++mbgjOiYEZJoApy56S51N6kGBEn6nNShDZllpGiiJTFxOzhtOzhpg6ShD5MwlA2C5S2AR/AQK4YH
+WVE05YiRfd8oVNVEC8AZV2SmROi5yzvwNP8UBaJRdJXmCW0u/9TveuxXMTO0eY+PdOT5P5/DuivI
+jut4x7dokurTZFeYabnJoCgnAXZ9tXFK3eUSNOt72zMWVkdtKbo/hVQH9Co7FV9qPzgTPKxnbUJ4
+YMBjJ1COMRek/OSbymnTq4vH81OTrQ0dHc9PPFlGgIfWis+cTqHVPT+/8EuVRhK5LUarqCCemYok
+PRQqnodZtXG8bE8QYEUK5fXQvbERjPitcQJNo2F38Pz82fKQFlA90vGkUPULE4Z5BMQyPU0/kbL5
+ddd+qG1kPmB+6K7p+UKxF2p6sRbMalWajo60ua2RZaOWV4mALKZWZ+cyvbot0Ut3E885BT7mWt3n
+YUmVTRu960U5FaPOy4K6FnZ9PPfYb1C0HXsSgWiYQzhqruZJyrtJtMkQPqlWlap9SXvihgHW937f
+9l2H3n7fmoiDet+1K3fuulQuS2YA7s/2O+57E2Aynwqhxsc21/ecsxy4CT1FodR+4S5//9afkMcE
+5NjLNlfvvHfc5ja6a5P2rWmPYonYuh1h8GbMseOnE47dWrxXpLdvk8jWNYSTtgOL1D9rw3Mzx25f
+0lbSun9qTdN3hqtJblk/j+zYnZdDpXHfTBaDdpJ0c/A7gkqHENTnVQuzJvWxKN8Wm4MWUQXTJ8hp
+eIkmSdOGFHZN0VuvrOte73wfOF07eLY25V8KSQbNwDwYZ5JuF6+Sb34fjBqj82UDo7SwO1o4f8lg
+cXB6rzC1BTQXO6qD67FYG4WdyyzI33mD9/rRN/u9B0juQZz/mGJWlLhh3950+PBnzKccFFC5VM0X
+qX2igHZtY1W2p2q6CpruXppOLhs2sCnUAR3Wvh+S1b5/VT2utXpD+kDgpgek1rJQqKrDS893DpyD
+HF00saNwf2z7p5q+NdG11jmd6zaNmy9EoeUkMdYY39DmQvQuJdU2ZxEoia2tj8/ssgMO7nr7wDuC
+BOTe9Ho7ASY0zcpgsPfxEN2x9MEmD2sy7g5NuVNwCUZYAo7HbnPBPp5sr961Ofp4bS7hwLtJ+C7J
+TB8iYxGMs2Z9Rn2mCDlBCLxFRIQVP3p4+O8dHoMfGjs4fEfWGx+Q9PDbHovhO7PnBzK0U97awDca
+NwccNu+/GEpv/e8f6Z337SO9a9u+P9K7zf/+kX629oVUEqT2mUJ/GDwdWATNmgSh10x+tnOXj/Qj
+8ol02nSz+uFs98dmu/7Qxo/PdknHCwjL+Eg/ef7rdZyfvKciJpNBJyDg6yOdH0lPec8t5ZuK/pHO
+z8/jmyI/CdMu8x8r45RZSjSQCs1vmigiGTqRJkyTdh4mP+e3aDLNZds/0rlvz3wzuZVF5TTuhP50
+MNMQh+TUFi0/J7Rj356VAjk4BAHETNoEraImEzOvb208Wt4mfh4FIPuQlm8LpTvPQP3Lf9th8Vau
+mu6io6FcdI2IX6XeFmqdunPKW0B1LnLDkpFo/Js3BzfdHKxeVTT/WZtVClRnLMxLXqwZ1ImsGATj
+Y7vT81FbIDiWzeIUWyH9tVeBrW1s9avVri8enr3J3b+rePbxOJ95c9Lyo+404VmcCS+zvPRutFF3
+dEKBVvWHv38/0q+030fedYf/qxPOvH3Ih/yRfSbtQ7YP2W6PD9FHaDV12QegnJC6n3AS5SJIE8Rj
++/kGMXdQQJ2DWTZ9N122Mg+fstH4rPuHbLPq+XySmJyO7XPrD5Pwbp9HolAPvVBIqQZtUJt7YQg1
+PrY229PUWFJ9mtRcXZRWZKc8VaCRTXfPkN0kYmX6Z3YPiW71U0zCgBSxz8/ctJ3zMU2T4hYuKrEb
+A+Zei1IN2ns7Cu9o6y4NWWzqlGwtf6yLZC03FtcAj60lRGCTdmuPrsj20kRPUw1gz3ITB5jKcTep
+hsaQzUIieVStsL9KxvUOmfswpJ96Qq4oJneT+pNcgTsUfYbZiUau0/GwlTkevhaJ4gqbULxQWaXw
+pDBxRSihFJduPNDtQ2cYAL1nYehF9oMR/3ts3xKQcUw8tm8vMzcPuvaSX4vMeWysSR/OdGK2XlZz
+WsbnIhp1GX62vyryneIuyYhN+slY7J8cBpZFtHj6L5okTQtS2J+yDweYmqbKWBs9m6ZrRG/FTMFV
+0deq7GgY3IaNJKWmXYePdOoo1AksBDd7xZOwm3SvfZXD49Zgs42cUMO8tjsqylt79PkRBcn3h9Kc
+eE5JT4ThigPQVKuatGHnvTIkyLpgaX8+ZJtNw2k25fQZOiKx0JwQKFDM8Gzu4HBG07Rlb9EE2PWO
+pNNLUlTaRQklXohOrX/I54d8bqcm0iimedo/Jc1Ui6h4bIfmzeSW8u1DPmXXRORXi8tu0MaHfNay
+zXTPtw4pkLPCtj3yc8r6IZ/zmqPsNwnYnceicpL1AC+3D7ndZB8tB9pK5HIuDee+QQ15ateSBT5F
+et6KUx/BrFORqGzlH7WcF0Puz4vuofTiSCiueh//qBf3GTmdbNyEDqkHh9v9Q24PKXU3KclIVyRw
+FnnkfbvJBqpJ2jPgNraLVo8edOOcHGii29E2k7uDZVkxJTVWTW5bS7ne2/b8R+1X/fX4V8+3fy2n
+GrDsVRoFAgkFfLy+VdOqg4HZc1VPjBXsV8yigiyk79yOQKjyuGmvzFnih9z0HqfoTSO5lS2b6CrH
+TDFVN+CogoIR5dxdUcqvLiaTgeVgaUp+MbiCZurlOcppeG791vJrtmWuonrLSUx2hZKf25CAav6S
+Z97RQuMRGU5Ntm8HNRVNNBx+Ds4AuXNTcdhKwHKaslz7WGRGdds1qU/hfsM5Y4y6flX+UPMy1gZi
+OV3pi7yUuLHb0AnaypRFaWELGHwXYqWCxyJ3+L1U+nut9FcWpYUtYPBduCrVZe+vhzQN1c8+5LlI
+iwxprTYOC1PGRYGfngUDxCRzjmbS4tTDowDdk/Czm6Thb74MoWXo46LPZWrHh9z64PxH6YTExgPg
+yocy/YbtTwFa0ah6nrwIjq+tbT0PuSiXLK0I554qy9YRejykBcq3xX3hbJl2ylyUY0u1mdz2Rd1Q
+llWCOS8zN5PjKTv70LCcrmD1OPegjMiesfyD9/Kl50x+5blRmIrjU/qQNHfmab76ICqndXs9at+O
+dlwVmWraZpIFaYLQjLzfdfKV0qmJjvU0HaX8SO7TSRuS0I4kuyZpK+q0P+oLMNdLkh0+Q4+sAHGY
+efetIU11yk/OS3U3mO6iP/OvlDTFsSjdpSGlYmWg4lxNYdBRn0lU0H3NhFo1ZIYHpvjprlP8dOcU
+nyDEO4Wq0299ZNUY1iIUDGMopqi8DaSq+Fd/A1uGmKRBEmUzaRmQJ1I0GKCLlfd5E/4xqaMmbQFn
+7CkPBB4PpO48GMna520btVHoxMwIfbyUdNHaVesX9aJZtVTHRYmev3JR0kVrV+1a7qJd6ivnYvNy
+Od8MWQjzTPVhLwiqGJr6oQuIblI/TCNp3z4kzWgzQ09S0/iQdOhEKR3cMo6bJlsYcKad5CIOzB5i
+MjmU05GDUxUDdMHhY5G0lchYFVHtUfcqct0vrldHx7c1szYHZCKDrWcbrYXWwLFl/Ih54OmJM1oV
+uhs3srxKqXrekW5TnB8itw+R+4fI94dImf/3tvU+QZPpUuf/9Hu1+vwQaVmQNoi55KJPnE+BzibI
+xLM2+ZB5ve3ra7sJBdajYqLE0jl2cqOkrv3ypT+GDoHy/FEn0lsWlXMxWhc1CbphbQ5mMnlsZZFH
+Pvj7wqYNCZgCB5fV2oODUB9Gmulznrxl6Us5HYcELNEO9z1lkzA09qqCLFs5HWl8S79JwBR4v+Ts
+N4ll9nFRyhnU4GdLzEXw5o+1WuxHVBcjQL55hyjW48LljJpFi/ofM9Azy74CTmXZx6ISrPDVpue7
+Fb3DAQtWarMeJO7/aj2qWlE53OtYHkfIHTS982ZwPEF/KTh8ahsUhV1ALGdQ9mve6uTaUFWrdkPL
+FS2vun1KfOEya+1HF4pECYfOXjo6W6tgeW9xKKejNfVYH12Pmkaew31uk/q2hnw1men4kPs2/9NM
+SuZnmAPrlPSi9qirMgvgYpfNve73+f/Jy3xG0/l+q203CcPxolBVkqZa7KsdeUa968NSlBIICw5N
+QTvvft+rCbXWmbzKtg8HtSN5SCkO0zTz8y6L1Jhx7L7Pg/iHaMzq190csbdzlQfnoMdczUEPV/Zs
+1SOkinxz2EpAVICD570+tz1vDrdFW1m8PJWQHO3LpCSntsjd0KuKahts87BGD7Z6eLNHaPe4NHyw
+5U3u2D8ZlXMxV2zQBHo3IcnALGhlk4F0ms/XQIpRDWDGOv11rY+LEhzPD3l8yKPxMRl5tJJvjw/J
+nx8yFzjrQx37h+Q0/6Uh1fdrnToQQvspp/6uZRbRR2sl32X/avLfw3a70XBStRvmF42++IrHQprf
+WzkkoJl5EF+sGY+BVGR3+EIzoXSn8QhI83JlpmVkcRiPRTS+sL/M+tqNipOCAUvShS5o1r513sPI
+e3rUN4x9SF6Qt8DH03nGb7OuLvtDP6ykaIX7DsuQlL5qCrgdQcm3oMyQ4zHL/Uc/azFkUVrYHXW0
+Gtc98nKq7fyQ/+gcbqYbhAa0iZxC3R066S2A2kwyp3/It8z/NBPVxvY49u1Dvu8fgtt832O7PVp9
+yoeUDynbJ35ZwCktLOdibLZR+3NVJWQv1CqN6x45OL1X/bowZdOddNmeM9FSe0Kquftc9LIhxKsL
+RaKkmXX3scEDO7ASbmGQudBdrzVLwGjuiz2wHwVM8Zh6ouqYAi/v3xDHrDtk9UeBoD6lLcq3xcth
+BJexMvTJkslYx1xUnh6WdXJYNgbg2rM1Z2vN1tjnNhPRpM3An/UHqUb+rD8o+Fl/Wq3PRR2oQq9V
+Fb0xUnSPOtNPwaOMrpzA29YE9FWwRZdkN1RBXIKEe6sFO+KSRBdcdy2F3wmVkpr+3JYU4SgSXOqE
+7Aq/m2bcBlI/+YZSBKTLa9tqsW2VINIiS4paLCForG/hxbbwss7dSjx1K36mVrgPgNzKIitkm7ah
+FyK0jdIj4+iqlG8OWwlYzqCsNqm6WHfQi0MGtxjTVjS3ritkVz04SArcIkenxeci7/1WTcZbc/9a
++v+YXo//w7Qqi9b/y3Yu24ETC6oPeYaudr1cPfr4R23/5q9Kiz73RKVu/uiYm/b3UboPt1Zvx7hw
+ikoPSujE23GJecQ1VZMswo0C04JLz7fLCre93z9q7Iqwf7zof8wg+01ej61c1urF2P8v6+vxf1vz
+7f9jl/9PmP+P2RfgLrv07F42R43ayjyeefez6X9tq9Tx1/HsEjlFpQclrMdyxvacQyLHjHy7KFv5
+R11r6YkPXF20fPtX/98SehLyj6FfLUuV22PbYzPMElzSNX9IC0oLTZKwOT3rCJvG87wMJar9qr8e
+/+r59r+WywKf/wwM3wh2uW+xAdTj/nPZVvFV4eyUyKHW2Dt73GzWlru/axkXxbNej1zkolyyVgte
++jMrclVzv+jjH/d/BsS0HaHEGR8q+T+M5fxfcx//l+1/g66iZfvvIUEbVyVdtB61sEG9yuYb5KuW
+rQUl98dVk3TVY5zKH/Ck3uQmr7i7hKF6/H6rL7koPWqrw/tjvloVFUkXNbRC9RVnbGHF+Z3hi+qD
+qh/tHg50U43rb8jtEYYt1f/J7xf9sr7GQ9r2kiM0KZi2cjWG8eCGfrVcIofD1jhfEjlFpV2UHrWo
+hD790UdElA/uWeh5bM0382ND38mz9nOnct94MFDopGESm8FdLxtBbsUIzgyPTtTKnzyWTLmVRdwb
+Qynn4u6Imx+u2KwQqueobdd90n57ZEps3a8HW6+QNx6mTMtB28VZrW9NuI2+bfN825b59o3y7ZOM
+t9/rMjbX2hyWSZdAr0OowFULxW5SjMbDYZq+5r+e1n1l9f7Cpn/fMKz0tni5q0ee/9t0yrc8KKzN
+zpYviYClm1Sbg3mp1FmY/typCthY7B5ubbt2kos4WLau6PyfbS5EtvsxRmlhd2RF4LpHDk7vFaa2
+gOqCU7VctVP6hvRTrzXhHvRMq4oHcllRt0rQSr+OkdlATjWyzTAyrndCquFvbhSq/m5IrXLQtkee
+jnO7/sb7AuX7gceECZo9kzL/Zzzt5qLjq0jrJUPvT2lK0O2RjcKOmAISl0BUdkI20McRjbay2B1s
+PrSUmKWD356mI2wlYDmD0p0xiArb0dnwziVQa/+p+kx+KXhGo8xV8dxmog16PvStRinPrGeqzzqT
+rom+zyblyZL7poH2qo7rnISEnRAVrOGl9KUpJtkoVNXneEvFMKv7fRsLklM3wmgH1j2geujho+LA
+UV+2yyXRo0FAY7j/8iaZUTccDroe6kyaprqZdFz+0LZ3+cEDbKWLOuiw6nPjUhhNphzbrnujoW+u
+FKww3NFVMas+bjnRAkoLVz7aTq575OCkG/6RmCYIzecu6igz4Wmq3yAr4f5YudwQKziJ5emrnava
+iHhvmigK0oa0q9A0q5N2w9s3uzdG4TvDRXfj81toYsN34tm1E8+OCyCnvrhF2T/k+SHP7Za17wjJ
+qRtpi4l1DwgPTXNBivL4eQMFzd1Zw24V7BZ/9/C7R99XcNsV64/dvLYRMQXuiy0clLpflOBXW+RL
+xqnKIbpM3a6yO6bAfTEr7utKe1Cm3+dWnhSSDJqDuSDOJFj2bwoWwmcOQNhiHa0AZLttSVoF311K
+cupGFrF9U9gSONPVGtG+VxFm4UEVI0mRyxm04OVBqEQ/XzbXULDj8VAluI9RxCE5dSOr5fSQp4eD
+vyT8OAHQbVg7eD5xEctBFJw8GUkK3AIHbwumijVpafBEWQwK0S8YGiQnL2vK8m6LltGJLchjSHOQ
+5BjzrRJXumtKZfutu4MkRwZRtiCurKIgeVLgRM5xKxelnEvtg6wRKp4oBTQDy7L6iTSfFGxpLUl2
+B0mOMR9FS6bovqFUnJkQzO035B8w9gq3TpWbyoTm4HkG1obj9jiaBITDD8v+2Hq0H2whqlfbbmLS
+hnvDA1+AZmDujAAqp/GbRfvRAqFQtq2x+Wsbi+FSM7cskKTA0d6Ce5ao7PelBPtJLCY9dFlFig0G
+YwtQAGel8IuipuXb4pPI9yQWb+WqlegpPSh9XJSQZUVWjTPzJoI0QWCRbqJBZU9IuWInsfcN6SZ0
+1K6Wnfs20TOBp7StJJMw3IUiUerUz6g75sCn0XCwTErW2qxUHptJIWTKAx4dC6MrGdfpVMzMr/k/
+YRbJ96Yn74ROGuKQnDwTzQHWPSA9WBgFiiZqKnmDqCpm2/KuO7O8s6MAnTRMwrdpwsZ5i3RM5955
+2QV4Ati83q1xvftlWFPUVWvC4o+xsS2DS889v+/G7bXk53PThLOf5/bORZf0iWTf7nhvaXGHMrZC
+eJAGUhWnJtN1dlT9T0aqBnvv0AjG42nGwzZSzMx0W6jaYxVLU9FtdeRb3R1o0qWqXKvVLrQY5S4X
+JV20HrUQiVehgnLxHBclZo0zKL9yUdJFi6V+L1UXj4GnCsGEt5hUt9e2SzGJvSEQEZW7wnhQcNMy
+3MpFYdTxcCcMPKUui9LClW+1jgcWnpQWuu/v8tUqbU/xklabSTU8NlbxwIU5gixKC1vA4LuQ1U7+
+lUVpYQvYFy/0EOzJR5i7zKv78twW9YV4r+b5yk0oqOabya0sKqcxBqNhXzwCulmhYCqgMhlwi3Om
+5y4mk4HlcFH1RF+FP51ArUjATmaceoo4eF5zMNOqxBtYz9W+qruVV215ozyeDsjC7Ojls6PXmh0p
+WlxjL6WA5xEg0U51xr7nNbLLRtlNwg+HhRe/A+VkbmgGd1Av25Zf3JZfa1vGKesLXx+egBR51ksn
+3ikjTI/5PxtyzH+eyvp5rJ/ErjPYdfoazl0V37KonOTaHOCIUXyUHp6cuKgrW9LCFjB4OJ4O1sy+
+QjMoknz7kP1Ddow+FYmymewESg25b7eBFP58mGYCBohRB6p4SqGQZNAczIWBQGZcJVaRUCYUiqVM
+4m0zR5hro2Bo/JY0iB5HM2k+x3I61Gv2322TPW8Kd6FIlB0SpW7b80VBB3wEE8SOM+xkyFfndbvF
+KSrRy0JSqzs1jujFKSo9KCuCj/CowbUNLHMXikTpC9JlLUhnBf1B4bs/aoVhHhbnseI8Qhxr24Gn
+AveblC0dmqmPa+RF5kAlSY/qUvh8QNSuma/Hx/8+VfLv4yGm7wv9oB8N6vrY8m5SkhGX7eHvSy3u
+phAsdx+SFpXTuS3yIh5/5698UFmV7SNUtvP5ssXiGiCXLW2L1JhfD2kOkhYuKxuibJWrggjtVsQh
+ObmnKcvbiZGLJttbTKpDqV1MJgPLsZIVp+pGml1TGK412WityUSibCZZzIozeOVFsf1Wn3rsJdD0
+2nogSYGD3WKhldyB3ao11Tq3HjYKQJIWX1xQ7Gjce4HSQuv2pYUSbeGyengwBlqr+rkxgqSFbWF3
+ZAxla4ErcDv6MOl7KVfchTXxO5JE5Oq7CJD0Ol/udb485vlaEc8XCuNz0QaSHFn+4DVJJXyJNLBm
+aLect4LJqmMn19cmKW9X7Zp5c+3ieXX8168/L5p2wekCS3J6b53ddw/ETp5yOif82L+BLBtCgBlk
+Kd01UBvS9BsEWf4xzH5KsjUKVCCbhQcV87KKiJ1M+a7NwQK96wr1rsvzaIuW74EdmXNwD1EO2lFM
+n4mExNkIOA/HTtCVk1gDYty3p5hUQ952Fbc6dElZLXctSSefKlTFqS9kOY32YLUp9p7w7ao97XWI
+Sc2on3gc3QjLg6djt7L4koFjbFqvZE1ueZdF3dEv+kW9j3/1q4MuDr9pQWBrUt0He7Xe53TNEWXu
+srtNsdBeau9sazmfeuRPeNhwK4trz31pB6rH9yb7VYGbfhyDYad9XwSH1sVkMrCRbOyezcFNBhwL
+9iiZUXfMt4A2GKFGrz4irwy6f0uRsZWg1P2isMTLVk0/n5+57uOi9KD1HDUbR+MhxTpemV3NT4wY
+LY/2XCXbsy9Ew+dHVPZFyP9Byo7/sS788f7/Wb3/o+K1vaQ5wFTzPhzUpMkhSBMEbKzCv/cuOz5i
+LU4nCccNEgo3WqycmS3o2hsq2pZ+tEqhKr63safziaPcDCrb5+c25ZNpV4GBIdqF0u647GXEIUSt
+A1mi3f2uT1A8SxySU1u0/JywiBN/Y9zfS9xfcUhObdHyc/K4WBQ8k0ZITt3I3N+rEe/QhDcvAOxf
+Ip9lQXLiyFpKd80JleBH9qcsWxKHZepG9K9wr4VOtYQcda7tJibXtJZq8azkUDzf2m3s8ZqDmwzy
+ismGzLGMS6CLU1Ssmqj3aGgX5ZIVlUt9vxI5ReVa3++/9f3G+n4v9f3G+jiM9B+T6Ttn0vc1jb6H
+OfSds+a7TZnva758x1ut+10KUrzMOkmL2CwBIMmRkXz+cMcB984TzSmlOQQbCypbwXYS1FC2G3bG
+d7zXByltEdz46Z79jjv+kNKcutFz2x3FEV9eXwpyaruLyWRgnWrcTTHgQjU+cKO0Gz0peCt9tzP/
+e8OZo8pk0Bzcx8BreXMSdm+1u5S0qJzOzck9LU71Rzyg2GIaa4GjfFGggqN8sfxRvqwE0QpM+eBB
+RSW3eOVkwMLOXqo5uMkAVT+29sS9WEceIPKMnmeL8/25UeRC6JRYD6StREaUHxn1+0P2/2zPTzbT
+cMb4T807BcblJEkEtHESfE+kayf4H7sQb8SDJzR6MNxp0U5rh19qnzjFd07Hh+y4g7DzBsJeNoxk
+laxO2TMZ0Lmb0hzcZIDW6PeIZV8kafHFxepYWl8qsA8KBulDxMFc0KZs54uTPPQ6X+Tdvb3kN1v3
+9ra9V8veoV3v2Co8sb0/pT8oJBlYBoJMYgxDdeB93wlzPe73iou2u84794r1oDIZMI6zezYDs6Du
+/chIp7nq1Y7avObaWHdtPB8BlGXjcFsKCmpy3Gc83mTa7SbTbjeZdr/JtL8apqIqZ9Z/DznEZDKA
+s6I5mSk3MZkMmkM3MsiLPIDtvyZPyctLdm3JLhj51aK2vQamtqS0sDvSVz/0oEKSQTPohOXL9WhI
+h6pC3xfaW/1EpFqQipjsANqLublHcJHmYCY2opZijSCaA+XKDZkQ+wcGf79V7LgByckzUVnXZ70c
+NPfB025AcmK1S1neToz6aPVIDrIw5muh/FX15Kzn+04Be8EYACQnz2QkXrdVmQyspcbdlObgJgPG
+23H/R2UysHjG3RQDFtbh0bHZdGwp/bA8xOVg7bwy1O2qkF0+7jZu9SV0Vjxw8Bs2MVFoDp1kBe1Z
+LEdzgNzvRRySk5cxZXm3RcvoxGrnn0nNQzFOGwev6atkdr5RMkDzGy/kvDiQedgHihdbzqvhjMGw
+RY4+gb0RVHSHNNx94CYMSVLgFjm4e0Qq5VyqdfbSQkHFx1Z4lUVRyz7aVkwukOTIKpWtClW6UbR6
+tVMh1F0cktMqVdemD83J6j66mEwGnZC3/gwYzCNgvl0UTufM4IVQX74JRaK0xuY1IZ3cTJqB0uP4
+KlJEbhMK5jQx+8gLkeeWUcRkMvBGjbBx5VG8iDUDwerzkwIhKn4nEmSx6prLTqZcz0u6JjGrj4sS
+slCz3dwZ607NaH+225BFMNoepXHb8NMlUDNiy1sY9y2O+mZjfp5eSVlUJAXFwzx5v37YJS9Aclq1
+hJOS4ZfFQPpWB9gj72sQ4GlHSBZ6UazwrxAcP2AA8oAvZMo2TNJJNhsuzX4wwZENwG8mAGi5Pbax
+iLm3OmQRjbSY+hTz3m8PB0kLV7Y3ZvfZ0FLo9qrDwUxNdjnaRcEVgIuOa94XUzkvlms8fJx1GnpF
+MOs3Xp8GeBS7Nq1UdxLycBNw+J2+se70KfrSHutsZNgtQH1Jbqb8DRtHZtp4Onm1dPxgGqUyGVgO
+aj1uRXD9kJQc0fbjKXatV3EESgu7I+OC6x45OL1XmNoCqst+y1blzreYjNJCd7Uqd3+TafFyqi2g
+mpt0MdDD+1tKfZkUh+TUFrm/OKEZilyPzu4D2OvTJKPv9WnF96qHpbf1AYAnW6qcCiiZ0ajMFmHo
+vfFhPEi6rxO2d/wk3v7OmLSrZEw/mCrawhhv5aphg7oa+r+W8T+Gf1zKGXXPBHQcEQDJyTOttXh0
+jGCOeS1N55B469WUNy58vJV5heRtX7fef7eZVN7d+T2f24XY4t/zKRQdkgeyepNdPqTO/0d+vY7u
+MD1ryduuYjwoZvTZfG1i7XnX71wEzLeg9A95fchrm/9HkWGyGwxZpMa/ujN83er0FdFkZjwk4YTL
+CMa5RTqUReVcvDydMIEmg/CWIoGmQ2Xb8vNDdDfqu9BXRs+pHNIWdUO0ByiLkI90yDhAB10OPEFp
+5JkGdD9vRRzclG+LthKZTT5vRd+BdlxeeAv6ldNmlfAeO8Fi2K11kL2BErWt/KuX82KRa/E+/lEv
+2SyKG5Igq7A9c1/EvepFDdmy+Pfq+nt1ZQelqkMzz/uExUG9vrZj3xw086sc4SO/r3zf+mh5W3jo
+CLoLv/rzyvdSx8g9IFqIa+iQ3JiDYn3Jy+oKr4eDNMd8C7iVi+Ix+NEm4z4ir4xFWhAPPUImg07Q
+HRQIfcyndAlwk1fP4ngSuLAg5OJ1D0Jy8kzW9pTxyBi1+9xgsaNwRgY/xPbKr61sa2f2yi/9RJKT
+dMMToEfAfbsoJWpY0H6rL36Y2pTTsSxiK1xZ/lwxykMCwqUmMWmGfHPw0sO220lxk4x6OaOFShGT
+iD9q4UtApqjj4JAbNjSG7bVJhW5/IfWBg0XF8XgG7ORhMt+MkFWfYtIMdKlx81+ae3VZlBa2gMF3
+IUeX8jD6vdT0e63pVxalhS1g8F3oNb3EpGb+Vizib81DHJiFg/5Lr4rPdCsmdQXUm66dup+4Nm6E
+zL85ickOkEQJHW6YcfBcDR/9f/Vc6m5yOneYYcT3ZV/Hnj/kdRas+yln9n8/5L9H+EEHU05gEZOY
+BiojryCVBKntUbBVH5Rumjgkp7Zo+TmFuLi8oeyN9aaut2uDYj6/4pCc2qLl5+T1+hKuRbQegPOe
+n6s9qpyGup7/e9jPEf4Xn6pQkSjpYLF9EVbzdXcNmbcS0LMV7BOlStoC0WPeHlAn3VHrQV0s+y4X
+RbOyJtt+P8qmLcop97HtIzK6Ro9AbSuREaPkz8ZOME5RiV7sE9Pq7lreFh7PyCiP5mdr+F5vg11n
+bBl/TdL1hVTPHAjM2NrI3gieqxnRpXKAg9LClW/L9KpDdm8ULsIouEOrr9rsDcV/DBxiF1v5HxO7
++YXa+ws3Z/97ZB+OecRNW7WT5Is61lD0YW71v5FuBfOsxSkqPSj5qu33oJ2LR8AS7Sxdb1tZVM7F
+vjCuydKHBEyBg4u3yd9YD0rwqy2yZhxFjv4h7UPaNv/nLEB7GjgCpYXdUas2rnvk4PReYWoLqC5I
+fB+ujBZ0pgmiUaAAGtHZgK4vT9kb/ZBqGBVpHw/Rjat96vanbvKppeQ2kEqibJC5CGG/E+ruwKLl
+hMRqNBJybSZpUFEqAj71mAypGT8PrWBm3qsmOkGDRP/c7XcFjTCaTOsB/yi/HvUzb4p9SKslILwH
+UlVm8tja7AmtJN82TR+afiOF6VsP1y3fOiFtu3ZolpmoL1y/zUMTdUEP5dFkM7k7zKxZvGzaTyUd
+e5puRfannLp65jp8TttT950qEuUsvm+afOKjYPzV7fWj20p1Sm3srrk6PW54c6Rhjtwqf5es8X2R
+5k8pt/rc9vsMXlXDEtVu/rhIA7mVReU0pv9bTHIMYVqmYjPJYvZrnkbmDrnm6q3N/427L6UTMlHQ
+PCgKs0czgMPYKKjmm0k2qW3eJkX6HU+TatAuxQ3IZo8hNIzFVndBh5LKuZg90nQ7arUV11XMpG/j
+l0KXVXcb/RurWddrHz/4yGo7dFM/bna0cOxgbeZhG/yBzfVI2ExVlpPE8iB1ew2kCPKyIC9zfQVP
+rPTDX48lMneKt24s+hNb7ez5JWXrAdX1HA95bg7FaVS98uSaKa3eziGRPeN53mZd/UP6Nv+PD+m3
++b99yu0hSqnhcwr9tuHJV4WxIDl1I+0FYt0Dukdti2jUC9QEC2qXnsn01PMdAkwvHD+U0gJm0sCg
+LzHd8zFPcjRzo6RuLXnpyCdYXh8ONPllqqCErFMRe2ICclst4kDTy1vYXt7CxxY2/qX2cdWir1b6
+wII9dBefN/APBTrpIT9c2of8aIC6p5yqUhsU8K3N1jd+/anfWh41NwkIc/2hQMFWrZJWUcmBGo5U
+oY7bQ+oeUFJQps+s5FFnqe/5n5+VYmaWD+n4LMxcyPp6bHfZimE2OKZv5TMThG2R7vL6a/6vjcGw
+K9/3WhRKluYgyRHLCUaRvZZNoY1qUnMEj/YSULfwZixoVKNfDUH/jPoy2pT3yty663mCkeaWbcd2
+QlLjPhPtS/1gMuDoW3HoJDGZDJqD+xhw2Zv0Xh00s+VBoaouKq4c9P/Ofzz+Bjk9ZiV9O5FKomwm
+OwABJvThkG+OdFMhO9KbmKSZOs59jLDduzIuSl9aORfjYBa1P0tFzn+P/FmV/s40a9320UStZZ7y
+6BPcRpICt8B9MfqBCvcfQYPnEApV5XPTKkWeFKhI5MloIk946ugdUlxqILRF2mP7PUHwRvqW3ynZ
+4vzkTpvEngraH6pDHJKT+XLnA6x7wOXx9gC1LdJ8PH0KuTsg66VXYBVEoelb1lPud3FITtbLrnTX
+mpPb2HCdXQIO5LmAr33KGrQL6fyQ8SFjm//p2HeFUvcp+oeM2/yXIW1zUPNDE50FDjymMHBIUAEX
+gdAWQk7DdJWmE1vIchph5S3+ExXxLAJP0YHnB7//O1OB0AuYCrOuhzDVXSrGz0x1Z04opyPbspQ/
+0BBTmm1ayl0cklM3QgdM/BWH5GR+v+63y3BgrgrUjUM1JBfcDjAga3rG68cTdD9DoAki6UnteOT6
+ypvDvkjddtS8r3p2/wkZsllhqUj9XdGl9OdVo7/fQwpKzMq3i+LN8A82TH7kPvgj4he1X/TbVdtK
+1Bmp5P1+sNOW5ktnBlNxJ9JxKxelnEuVUKSPyCuD7k8Zj5quEYOtj//D1P+1Wayj2+/R/49hK/+a
++vhfS//HxMBdf7RUhzU/vEFQ/1hBWPxQSYzuYStUbkJnQfop+69g2z6Lw3TJlacQw+8Kj3BHeKx7
+v8Nu7I75qPgYsmjm6u5Pt+p2NN0e2tFvOp5mds8fMnRfOXBJYxxJkM7sM9zyCko5lxr9+oi8Ms4P
+OT7k+NxuQ7QRjmXx3GqPzyoz+7YV/fYBITsdPaBAGVvXH9uQqT22ps0wyrfIW1maFsbaqrvzqdSE
+IlHCGfPVQ3/mbrPQYI1QtO0lJwrZpjnVNu28cHPcdUVPkanmm0l0LRiLYdiNF/whWbQ6Zf3Ryh6S
+mi7s99bOOmRRd9Rqtcl6On0UaTOtmmDFKnRxSE5t0fJzQj/ZkCDkm6M5/opDcmqLlp+Th54d9ZQd
+d9yOPd9mrP24PSim9fXgJwuMlnEsyrfIXAfUUaDiRjaoHhHpXPFrkUbmwcymVx2NmNncFkw3B29H
+2zo9eY/lmD1kVpW7g2bL90ah6uMcD1wOPVr+rFo6v9hxrWqiBSo+UT2h7skBbje9Eno0HudugbUz
+W70V2RqoPp8HblgFjV5133k1GNpb2sCFwqm3bTiw4iYpm1HkBTpaszsSSz1VSbWUrQWED94JPeb2
+2r4FAZ9Zb6XC4bVRdOpCse0OfoP0qvdgkMgpKtEL45ja7z9Bf/8N+iuRU1SiVwxKO672KdVPEoZq
+q69a2yChW1Go3/j8yOKtRM3WbB/s56FXm+k02tYfSvZ43tHqb9XdXjv1u1+LtMYzYfNta6T2sd2E
+8oERPvGEROvwow/HkBv0CfrE0TGk6dqbc2VuKIadjD4dD9up6ox1c9gXaa525e1D3h/y3ub/7dBz
+BYUhDsmpG+laIdY94PJ4M4C41Dx+yem9JaYJolHAib6s5s6fTiGxRmN1LYfFLdb24m0v3vay2l5W
+24lbuSjlDOoq/fbgtS3SfA22pw0NWT9Dt7gvZTjCeJeSb4u2EllH50XtUS9n1FbWvi0Uxx4xBV4F
+OzsJyjNg8BkBQ9P1CEP+lYApcHCxqmDCBjCBH/d9b6/ats+FI1Ba2B0Z8OU/pLs4OL0lcjldqy2g
+FuiM0i1CZ+nuJdf9ncV/oqLtm4WknDOYINElkF2Hv+A21lvf7k6SAmolfGkXUsPt31kFs9kA2a12
+2VfVO9O63x1gQp3j6ygO5XT0oKYw3MhJHMzE2IP6sRUHnom6djoXxyGL0kIvZhVobzXZ0glAtzUZ
+LjVQu+M+hlEHNpF9EYz4+aG3NPy08D1yOaNm/WE6lfz2UnB/bqjkWRuasNrLm4hKFV6onEvdvAXD
+slELip/4dYBJaVvgDTPlDzRryKmHqwn8wO5b7w1ZVSd/8eaNbkIX6kHjnW8DqTYv8waaQt0dOkhF
+4o4PkJw8kxESRp9KPXVyLKcpi7S0OpVUpTl0EoOCYKQXRkgupi+DBrdBntcAz7obzVwMWwhbBF+A
+fuPrJI4pcF988We3uaJ+Y/uQd+UTGutpD6Nu+JZF5VzMZQjaHAD1W5AmCHXB76O8K37+B7ITWClo
+K5HLGbSWr2pQcMRwZVyUUI8jFqm4SJRwwJb1s+0f8iPzv2nSZzoTZP98zIvqf7dPvQUK2SfcpH1u
+QwKmwMFF47hS94sCv4GUEYY0ys4MC2G7DSJWTVD+UKu7gzngcoUjzRZsRbKR+hcf+/273e8cio4p
+cjmjZnGoB+YiUKn7RYl+b7koMX5tkbVQGUg9ZhkrYmFbi7ezsG8LbixMYMMKlvapSeZ3lIEW+rlm
+hqqYgyQDt4j7NAc3GbDm54tVvQojvQp9XsV8VO8vXxXEFLmcQQsFGMOV6MdFc2UW+8yfeZikAaMf
+ME23reTPoynhI1uE5NSNUD+w7gHdo7ZFatR3bRQQUCQtaATGBZWTjPIFaWGpwkf3HPviHJXTcSwq
+ZrUaC0v0XNsiGF+DglW/bPntF2ONOMRMK5F1V7fUckaNG9lF/7MMlgfZ2J7mDZrUjKxtzfy7CXpz
+b3ATftMI9JZF5VxsTXvg/hbBlvTBm12ktmgVcmJtD33Sd5Hm5/XoHxTEz91EoqQ3Y/Ecm4C8EZ4K
+X+ppSpFFtnBL++OqFbBVnNduSFnvLkxkPLbQttpJT445IotKM2kGSlsm36JveVjdGJU6osqWnyYl
+ObVF3ZARJ9L29OUxXhlcIvxuIGQyaAadYKEPWwOgrZ1L6YZvWVTOxdb/198aNH0hNx7yiBycbJVT
+03rqPQ/uzIgpcF/M5aGCRcK1u5uVdy1d1X5RLZDr/8SyflmG9zV8bf+o/R8dC9aytatlb5Nid7S2
+tLzaQVan5kO0+S8UG9PBLk0sDhksOjZKMZkMmgFLWZOaWINAnv22GLVlL1RbPhbr8rfjZh1ATIH7
+YqvQlBKKswlHeTEUKC3sjowDDuWs8aqwcUezrQLEMetKX5rjWxaVc7FtI0v7c1XFso8FtPl+FOSh
++mV3pypq7PRmF3RpJlnQAqM35KYJr7oYsZqg/XH1JA1xSE5Walh0n84YLo83AsBwDG8B0MxmYyUH
+BiTAfQys1sMr9VObxeVcmgRskftSHN+yyIy1OZip4Usp5L+T7nhzXyETsGT83ithK47qNX/bpjnk
+ci62VbS0P0sdy3E5mS38HMpV78sgKXCLHJ0Wr9gn14H+OM1f+3mav/4LM3/9N2aMGEU3KWmXeQTV
+IsYLklNb5CHEiZU2vif9V9pAygjWqmaNat6mhiZpwmF36A7zS1+GD9QNhyxKC1c+aiPXffF7lawt
+4Cz68GduHWkWikRJM2p5bEcfJiUtKqdzM8J1KvIqbrtbKHUP2J3fEjC4+64vqn/+0cUdFHieo1KS
+EVukWPeA0i5KDxqmHEG7Zo6rFjMXv8WwtkWeDygb94aGKXBwsYUwpZxBrftFicXQCL3ZpcK9jTsV
+SQaNwCrxDO/fx+GTR0OahSJRNkpmI0zmTy4SulFeeBKGyeIW+NiLXEBkHnpP+m/WAYgPtk0BG6vW
+1cWPZapkS0DdcDjkrUTGYIhqv+rjHzXf/jFc/LFYtqVYrsZgEy1Lc55Vh8+zHuPxIX/rNp61v/j0
+2t/6GR6OcU16UPq4KCFrNmTd1J6orvwlgL9Vf71HBQLa5/r/8mv9f/01mL/hW/1/16f6/87tCuOb
+lBZ2Rywuue6Rg5MO5fp6jMdWnnkLSu1BQXP045tjEYy1ZB4ea1tHito+M39ccnFfygiIBbTDSfXD
+SfUjR11HDsWng+cOBzP9ikNy8kxGW9/2/xs+7b9Y/XVoyKJlxArxL/E7ckTisRgVm8mVw+UAdSL3
+0IZ/yLDhe/6EaXrNf30SQcXqf2hFiGIe0hw6CT3xwptLU3YxmQwsJ3vI7sU4MSdhXkMFS7iUHrVx
+UUKW41sWlXMx+yhobCBCDh73SWlhd7TWDz/uO9OprSgthGkhTouBWozULqFUyG1Q2HqZaHtrKqfj
+WFSC1X2tLuOtXLVyRt2KSXJwB78i/bIXMo3cpbbVIOW+lCGRU1Si1wrs94ajZq6QiQ9NOgZzjsrp
+OBaVYDXfIQ7JqS1afk7WYnslzZFDnNryr8eFyxk17+Ogsy6suVJ8XPCSrIJbzLlIczCTNbXAgP2B
+rD0A0XLF8hnK9wGT8F3ixVaIsovJZGA5HsS2XAlbrly2XPlny5W21jgw7MrcUqJDCtwix4CBrXUt
+Y8pwUYpV9LSRBNzKReH+LOjjX71fDJe4a9gHPfpLikq7KNFvLcrTh3hjSzzI8hq5yyJzt6YNXydt
+xBWCuNl63faGviv0/eDaCa49YNj9ZbuG88prw89hq88teAhFoqRHdmBN8C9b3k1aQwu/4Ulqi7oh
+g5SNB9Gy+Q0s55VRra59mIzewyrjmih2yY0Eo73+DxyB0kJ3tQ4t68qAc3B6S+RyulZbQDPn4VCW
+zda+K2yFvrINmQyag/sYWIMra6l+YwDsS1HDVX/T3u5nddnOGeS+oZZQiNltVdhCfS1Wt4Zaqc2c
+rdJmdTavstkCNq+6e+S+4nZvztrjlRr3eEv7c1UlZCtWTXbuiOq+dlfG5YxaD8qQi5Iu2sWRy+Nq
+3f9RlzcIO5jawt0faEUMPUo4D37ZefDLzoMBlmft4P6mMhWKRNlMWj6lFe95mLQyftgmWgluHaBg
+xBL1wV+OXZyi0oPilQ//5dio0ZXHUwWYjj2ZlEVtkbtZDQePv026S0lOzclz81pLUIsEXvb97lj3
+gDzULa1f1HHV8u2qR+ci/V+1nP8Y9qvP4rcEDMXWhhXUtfTL82eLCIf68sm9cYpK9LIOolb3qxZd
+a7sommW/Rm0EI278qkwGzcBcWPPR7mwrKC3sjtHX6iNPp/nNH0yBSeVczI4MmgR94lzZPCUZ+gPq
+Gz8scVHL+Y+Bgf81/fk/bLIcZdEy+oPyVz06SOQUlXZRLmWigj6k9vtPfb//1vcrkVNU2kW5lImK
+1bcnirVjHWvuPmy+Diino3evKX9Maw7mkC+h8wqdeVow/CRgcK4/ZO+XYn0V69Y3u32DZXE5l+Zx
+3rJoOfhGHLQ/V9Ua1+uT1/yX4lluNtOgoIqnW43SwpXvy36sFU+GU8u1OcThGPQeDBI5LeX3n5K/
+Enk5ltORYZ98FH9xikr0sqVp4dGsoEXX2i4Ks2ydtj18xe+q92CQyCkq7aJcykTFG7z708qm/f5T
++++/tf9K5BSVdlEuZaISa7d+x3vUk+pNXt5NVDQr77dhUpIRgynWPaCX4dYwkbb7kfsjoG37VCVF
+pV2UGEGiYu1ovBNPSgu7Y/S1Rrd1J54Kml0LUlyjUGgEhqnoOcwYh83/hs3/hs/6lOq+CFdWRvho
+XVBKyJMWuS8FM5OlhCzHt8VVS9uQ3qQUfM4waqi0bbfa5hEJm0XD06gqbVU1ezZVicvX/EFTw+78
+loBeDQcayJ0NjtbyLTTSdTqkHNrDi16ktHA5ezP9GpezOVXCfSsbXoya2n+OlPEJlajZUhS5byWg
+2/09cejPreVhnk8ZfNtctaMt7MvuE4/LpOOfCUfjfQoATbWlvG+4wuAqLywEvZxXfcWMlj8XE4u8
+Ks8cnFNUelC846HV/apdXG2gvM62PfFd8KnSp+txjy3o1cdGP/uoRS4KiwxpDR/6LP9jCC7ZvjL6
+j8H3z/9Y2Yb3dud9n6X0oI3IJRb63S6KF+req+AUFO+699bDlvGWR76o9vgQFLRBnpvJQhCTbJg8
+fYpi/Ccq4lnNwC3dasnd4bloGZffcKP3sTytZ+Vp718GBU5+dUlxBEoL3dVH37q65EynvefbIt+C
++e0Lo05kZsM8oNXY3aZ018YiSYutUarAG49NqkwGzcBcrNzhVR6rPntS8vi0rzs5duexSJfzSOjC
+KRGTpEUSsrRFbIY14tAn7Y/CcyUAQq6n74/w9P3Bp+8Pe8QeYEXUpX0i3XaT2K8b0ilvJmmY4u+s
+8bx9f8gp8x+j5JTPrRSTkoy0CcCu0PL9MUD1x6RmaRmegZySWn29pAVUs77VpkLVe9n6AlF81CIm
+1cA2FH3nFxIZhXUVGRRoROEDkErwyImC6i4mm4Nm1ZfQBnIjI742/ZDPKa8sN3FQNy2GEkgeW0In
+9UfVJnbrPQU46ektJAxjK98A/AaVUn7JIvUbIkNbMmoF/GzdJXx+7GKuo9rLtqfZDK0Z1XbZ55j4
+le8s5UN+2zaTvH98fXxtH1/b/P+c/5+SVMz++No+pSG9y5R52yn6lEWQJogGgSyWb/lGsQ1ZlBZ2
+x1UEG07g5VRbQDOr1ARX8ggMchQrdujtGwMWqMeCcjpqx0blz8fXdtuSUMzMmyqy3R6Az1q/HSQt
+bAG7Mxb4Jrcy17ATHFC6oBWA3ZX8NRxgenHBSTCiWjAKDMYdeRMCG4GbmU7lXOz1jhz7YqlWBcRP
+bVzivBUKhNPZxhd+lmGKIhTM5mOvinjkfGEKHFzYfCp1vyjR7x2C1Ra5R+U0Ja9aYGNF+13tPT/Z
+fFBaSE8o6qx9b63jrXun3J8XpS+tnMZmPBZ45lpBIy8XZlekOZlELnpg6J0QANcVyJyyOO33RW70
+B0WXXmQpvsiurZLLbTWhr+p6qPD3n1p+Yy2/l1p+r7X8LrdVy++q5XfVYjbWMo6nA7KQHuwmvlVN
+LE62Ko6yBp0xvOYBX8xnWmbDUsosmVLuD5PR5hva1IZJGDSMaJKQFpjqoZ66OSbse7DrSljyVDVR
+y0zkpssn2GtIy0IBtUKdsb++NNH6vr4Q7etrun3teVY3Dfdt1Pus4n5Xx/sdjvd7HRS017FyZpB7
+nuuUbp7JvJnMPnrMso8m+0PydMQTBZTTKRdNtI7MY5LKchrBTcdTLnMmqgDr0cSkGnZN4LKLroS8
+M0Ud+2DuQGdPML+HbM3d2JYd22neB1c1ifXp4mpv5jn3+4b6eTRtSbs9ctF4aCc25tzQhMaK29f2
+FzILve9VmgNi3pveo1oIM1Z85uafbePPDYM0tyJbcuikTshoFhaxPSv6qtmitrWkTe82ToD6zvKj
+8LOxR9u8rUMJH6g621nErLFhjfatUGyfaKgeafN4CLU5ZTUop6O10RVZKol9Mh7FfXmny3m/5MQw
+U/wnj41iqt+iSdK0IYVdU+3M70xF6/7WofGNTivblxSV/THDFk65yq3uFNIcOolrWPEkqCHhZzu+
+5mx1JrlBsrsByGdcrbzY0V2pHhHLGRR2zVRh/txuWkORHaJRwC1/rqOyaSe5iIOHNcULsHb0XCn1
+68skPHCmq3QMk8z6oZBE8Cg/XmH9CdXVH0hN8dDx11b0oFe6PGpNizoQ7eu+BD00v6MzOjbp0mWM
+6gCPLBRUv/I6alItYuwz7KB5OUlObdHKNrLIJzuj++rpVu3AYaJgGy74YW2CWxCav6ntVM7F5qDy
+GHk3qf4/okPvKZokTafnM5e8mSwGzWFNUC56DwaJnKLSLsqlTFTQxKCVc+nsLtNCzu8/Lfv9t2W/
+EjlFpV2US5moXFr2e21ZQI5GKEYWCG67UMDaHxRslp6sAlgn9gpP2yM8197gGc4v5uLv28g3k1tZ
+VM7FLLvbjycsdLPCZz0pVL3ZFrHfMOSnbCbpIi4HAIcLQDkdrQ2u/LloFsZKLPeQizxuTfvtnOdR
+i9Rn3jBO9TeD93t1QHbVpf/amphU+1fVAz8CYya233MrJtVJt7K96CUMAzXtKLBnoYCV7dy12dhl
+6gjFL/9T0tDz5uAmSU5u67IoLWwBg+9CNkZ5LHKHX1mUFraAwXfhisuBCNpK5HIuzYqayVbnLzYT
+ldPnv/N/wmxC06lJ25JOcdqW0F5AMkKkhmlW2zAfarhE03Te2zDrbTe2sN28eToisPdt8tieKrUk
+igj3ogBJjqxVWT2/8HlrDuGozjHfdEy2vG8UN8HmPplSAxbRZmlDsNduTzToiXXRng9MNQA06Vfy
+nWBkxCePFApDd5/tWSxG8RhMB2auJBj90oUhzOfWkoOa9s/a6nF/KNsm3HTR9Ryp1QMXhVrb7nln
+F7TtXk2KWwx0E2pt+37ovq81rpGG3ZJK+NYfCvRbs/lIa5h3tC68CtF6Rnp/4NTD0Faf1sks/QI4
+5bP2QTZgRAWUNcvU9P+WNwqqtyIOycky7WqdcwoKDoBBY6ndy+yrxM6rQkC0y7mcS6t7ZBbpT5Nm
+GCbzzUizHtt+qGSgh32xJDC79qL/iYaYexpLcmqL3JWLpNjHFtl9FOYAQ/39M+8488fiDM6wAZ1U
+igNMaMiQncJ1aQ70tFaBzEhJb9adE08GDMsZFOuyoAoNrME9Qt5MZ/ShFwOG7vJ44jdFh8TaHX5t
+TWk45FtA7iyhmjOnacOu+IzlxVZZz+rARAcOXoIZghmDymWxghInCBjC44Edk0oJZGUe3F1xc53C
+3BrPLAzNDb04iftDQ3MoHruVvP+riKsnAYb6pOAmMJFNqU+rsz49eMUKyAmdnu+HmEwGncDiINSb
+nxtEhjc8ikn1sbavZiOpSFXhGsIQHzbEhw3x4UN8+BAfa4gPDvExzBujaWTqHC4je4Y1amTbFxDN
+k3XoEhw+4A6OtwNbjsriFgt6hDmzKwjwsyGdyoFbAAcv0x/zRP+z5aR3dg47iQfgRPgoA6l2z1HG
+l+1PnPtSsAx6cbMZdAbAvHcCG20orrBw8Xy/lELeLzm2wEG1WBRaVBdDpyIHrrkfmIQcu235xw59
+FGl6fnCg0X2wE/rIewXoWjje2KGoNMMwqYa/H1+bXvl9a5veGVdg3nrR/c23FoxOkPbx266zTxji
+0I1Q9RtXq9/1aBQe8WjhCGRq4JMoySHYQjlJTs3BTNbIw0/Uye4wZNEyWvPJljHlD65y/WDF/eya
+aCt/MDJ/uE3+cIv82a3uH12Fs9P/aoG/Gukv3KbDKZo0TXVdnTKGmHzz8u+pc2Q9OdTpN6beOBvA
+5Pszf3zdbh9f6eMrbR9fuhtL7eNLPr5k42X9CecUTZMZQ7amBwFIvbBl2IfzaYS1sBhR0emycVdN
+KKfjHu2hHNP6FIdyOnopU/5MrYerba6d5CIOVtoVK40asHSYUgjnBJAoNbhMut3Ipt44qkHisLq4
+uyIpsayqDW+AUQ3UFnnhvKyZK8CPkRKOhmJHOoKeUoB/BKfJUXNHXXSqegcBMhl4dNxWAOh4dDQz
+2vQ5/xt+Ukbp67gLqJi0PP6oEXHa9FQQN3Tk9s3KAeV03KPd1uRNbwmo8IuJ0IYsSgu7I1pOrntk
+c7LxBZ6hZyS97mzSL9Fd1L50CZgCBxe0hMozYPAZAZf591r57z+V/0rAFDi4hMrLaWT5QxySk2eu
+oqEVsQW4veho8fOtKvydaW0bhZaaySwjn8UEV7yEy+WqNErtG8EGrc2UxM0S0El3kzCgPKYI/Cgl
+JQylDpMwMB2Vsfs49Mo8z2RFiiaIWxi33HW+K1LMiQcFkC1bsWoLUnVQnI3I9x2pJMpmsgMQPN+1
+6/N/6kaRDNReuJR5H0gRj/f6BHfzJHfMkADau7nr6C/otJKe266yv7bmMMsW0RNsKfkmEKbyaQnH
+FLgvRktMqftFcb+8itQjYjmDws6N6h/T4bcLRTEdBV6w/0XaR/1ZpFlFkw0phmop24Mi74AOMZg9
+cnWwrKr9V9AdGDu8oyR28OUVLpVZpW8GuPGjgqtkkhiZS3/kV0CYNUUH48dfAPUIVM7F1ptL+3NV
+LQSFFtX2c2UPvagnxdaxDrZpeeoZsQpWYiimqMw7VhignI5WypU/rrndBg0VK+y776WYVxeH5OSZ
+WAjFp4PnDgcz/caKfi8V/YpDcvJMVqSTo+cT9ooj0HPUG8bic9Qut7qnyOp6NKRTmdXvumHqtwpU
+vPQy2qRG0SEhUPvOp4pEK9jTVjDV0NfAVDQKOLGUtmCXPvwIsRTN0jMYFejFHQ/uyZ71M/lfsuNq
+gOy7FArN1+S4N5G5L95/su6TZyPQM7jorCLr5qcPxWuodj86hTapzc3rIWPkqTy3fbs/QEOF7C5t
+KZbSTZPk1IzQC4rwQw0WI9c934zocMxeaJo/myu/RlXhM7fk0EFNb8tL2zGva/sj65Bo+0PveUjb
+sTufssOAoqMJSmq5ypvhRiVYuYEF7c9VFcseeSEC/J1p21o22Qm6SE2yTgB4LXnKQSHJoDl0Eru2
+CXq2oSNbZhk2ouXndt9l5NtF06HWrCEcHzwPVDD/oTvyxpbWm+yyqBPrjm+iqiZFbsNa4xq7YhlY
+Fu2JnG8XjS3lemmHXaifXMRkMrAc6x3Uen7WbSyC0+k+OHBM0Kt6IDUNTN5VltPIxoLxHyprVxq0
+7qosSgtbwOC7kM0c62QPbM1Z+9WguduvLEoLW8Dgu5C1so+ti62HvYPf/PQP8SQMk+i0d04Urlsf
+6gWXKQ6X095vh1CoikQbwU2ts2N0e+56YVmF1sDT2M6HGRTMHQvcR35vxcFMYpJh7GXYwH+iIpZl
+6waMwrXuJtmKypsEQPjPdGyaFE3VcHsglUTZTDJbKBEPoI3WDRG7DmzPQ3t/eJ9z1zH0KQAVdOsP
+bG6kZRyL8m2xOhScDKhUA7p+WNcP7/rBrh+jCEWipNn8jtsN8xzDTl5WTAT1cp/gZyK/5EiabIUC
+S0PqjliGydpf8y0SfaRUDhzLDx68Dz2+6NOGM9W8ty3MhP5wKKcje3kpfy6aMHOKOXP90fg/GMd8
+Ukn8MSWZcfWymOCymJw7dlfzuPiLbfpXN9yvj6/H9vGVP77yFJtOc1S47ASV/VYpXFc5NJn4Of/1
+ZYMpGoXm4N2DTKu0z6q3Xyd+6cF30l3n/0ZEFXXfbrc8qQlSD0DsYJosJoh5HtQu10wycasvCQhz
+LhQ4I3FMgftiLiOVul8U86Nk5q4/aA1ETv0seo3NkeacTNLwPPiqnGqdDak9Ew6X5TTabbnDJCR/
+anKUjUIoZ+TbNOpd74xdSL49BrQDqdq+i1CwBkMxpVHqovG5+ezPzRv5EfEfQ48WuSjpol0csT5M
+/f039O//hP6Vi5Iu2sXxErqcxvThE/uO5QyK9VBQ/0B/i0kU0BgpFaHANRrFRNlMmqvLkW/fkZmR
+rQhmtkr73YFes/okBWmulDhkAdXjLoMCDbrzWKHUKdVTQxx4eYRQTsPGuMrTKvP/SxN9/AmyK2ha
+ElK9sj/h1ravARTmSTNJHzwwY0Qj2islUe+6twSJ0ag7S+L5LSNpkftSFPeEVPf7hHI6chws5Q80
+NbabUKBEu9mWZSimNEpdnbwSnu0y+ARGyB4gh/Ka18dGMY1fU/kSTZKmDalmajm8G5C/5mr9GiK7
+STyVBu4G47EIRhjQG19mzGJyPBxggt/J6LPqu54N5TvO7yCnL15KyPc7+uF+Zz/wLYV8v89YeqF/
+ptykSB0ozSQNLKpAkwqc6UKqQZNDWt0U9MxaJXc9ht35LQHLGRSurqj+gc4iWNCjCR4ucGQpaSbN
+QJl3cXDT3WGa/pM12XaKaSsbFxeALRUsyRE7PjCo5Q3HpPIpbZhUw+2BFOV5gCmco+qpFN7zyEV2
+fRaYoG66fNzzFZo0PHYyxXYx89ebML93lBSVS07rQWM7qCFHXiruTcQhOVmJyfCnAf1a8kvvPBPU
+pI3lJdFcuLxcOls8LlnhjrYU29FOgge2nmIVeo0lvyhYNL/c5QUPXDniRImd9tQEe9/yRNEn3jEh
+qPMz3yCYwa2tPG1vQxJjrfn5xNsQRsis97a9HlmihrbA0mWY5OokerZ1ylPfuV0EB42lnV/11nIu
+9VVT3hYVx0OXm9UMabZ6fPD4wFmDZg0Ya5Pux8p4ZJZ5sJuGLaXCIKHjx8O7jmiuFPQ6rS7ccyIk
+p24U/LATKut6Yp76vt2LmEwGzaEbGSAiJm77xrKWHeZw1/mbz93WvG3N2cJ8befNO4Ll8vCw84mt
+fJnSXadzPpVb07g1hQvTN5u67Xo+n3eeAqlMBpaTt7KonIvdQdoiL59tUOz+DDpwGbGh7roz3LkT
+1LkDvx5mj+hkXi22B3MgYdBUXVEzDiV2nZ+AyAjF9/8yroHySLBLfx07oIvJZEAXW08YufsdHXNH
+3Ps6t3PuVMSs7srluXsn3e3dEEWepikuhxI85s+2BzTzvuUS0MyvlvcRWVLUVhWqWimTI78WqZFj
+wgYBntCe8puDQakPQ1sbxmKargJWn2G0zP6gkGTQHMzFfT3GwzopD6HAkLBPBS78Q1ab9ua3INUa
+vxkTnYu7C3nXR73zroNl/6z3LdUxsraMQ3CX+4ZsdsZuXWFLaguHjXbXgIr989BRWfdtpjqaakus
+u2JEzZh63Gqij5xDao6dKQHMVIqDNEe0BWyeHWE/+eLExPr8dJDk6KXB3RWjvyaFloaFILlxOJhJ
+tu9FZsy370U0HneTMNzadvuWFhkZqDdtz5cDM+rdJAxfvuBrQuwcXGzxfUo8sT8cuNBfJTudkOyY
++4F9BQlOfCHACEbuC5qslVJC20psW8l0qLdvB2Th9K4JTu6avOiKX3R26sTj7kBT/WGNr1br1yJJ
+i61bVEE5lGH7mN6ayB6QZr31TKApJ3GgieekSu25CNmjbS8HMx2370Uw/thW8bNx6gm0JfjxrYIv
+7U4Sc7Tt70ef2FBo3w5ajoFs2/jOusDa3qedUJDUAVNNvDYyRYcDwzwxfJ7cgwHQI1jw5w9O8gFq
+QjKQfta2L2LWg5tkHwiPSUfT2VvDvEv/sXvvfKJcAXbsknXcz6/+8W6Lsh6/++Oz/hQHLfS46V1I
+Aky67N2i2SF6EoatkhhZHqoy1xNBbcPqj4d+wIQAp8wauEPunHt2n3r2MPO0g4ofUvrjG2ct/VEy
+l+Ep6JvHs3ImYIgSuwyTMPDbJkY06u14Akx48YwgydHaxBfScn/84JrFhDfj6ULl2wMPnuSu/YAW
+91xMcmV1TCVV2nrr6+0OKJKM2IDOOWfvOC9XmQwsh87aB5h09GH7WFIHHsUkDPmGR6b4q0hfnBHr
+16lNmgHn3jOm3g6a6efRZiHGHXwmVYGreqyXG5213dgYBrfRYVvosHLLURMsFs/M7KyMD9JnOzMb
+9uoOyEKtH/Wmotbfu7RNL/iMX/0xFt19jV99pjuP356f6Pvx+5NLydvz4wvXU97CK0V6Mybj7glH
+xVvw2Gx+Yx86bX/nP9f5322YSJQdMn8Z7HdC3R0s7y0OakIUPJI9JZf5r7bsL1qGycFfxuXy/7VT
+nr8YoH/x4CnktP/Kdkzxq4nW86t3ajNu8OTf30yVG/ovT3x+f4tQJEr4F8s/P77+o/Ou/2C2VT6+
+5ngr26cm8xaN3qVYLClqGueiljMaOhRtH8CiZXPkvshRqCBblZsmt5xMsstMOQ0R8/aNFE29fbOR
+eiwsm/IduwrCPgJ25+Y0JGAKvJytK6jU/aK437zgUPhJlWLfVCGU0xH+doPBsZxBsX7DSXzZ7nrw
+mFIgqwm4vWoRB5jwlT2j02g4sEJ4Y8ZCoAlfCTAyo85ZjNSYC1IsN/frBTv1suWGVBVde9+CNEE0
+Cjgg5DdH1bcNqm8fSrbKsca/dSGe211jPj9NIPjzU+j9/ETg52c9rWOMY0a+aOfiEbBEO/wRkLVy
+oquUv5m1U9yqA33qMRxo4sTUUVJQbJF8bjq5UVClGI+GyW5QkGVBfGxOqg7iXtUJ8Fw/QR7U05Qi
+i+ChWbr0+3YnJDZjT3ipqWw71uDOrWe/o/RuW9H+jZTZHDa7jZv92/2g71JMSnIaQyK7qxXe+VQq
+MZjhqqluKK//cF+kAPvLFv/1QhD9FiMlXbJZbGS/Xqzl9dKewtbXBGmCoO14mYSBJZuvxP5A+snG
+gTrxKJ+LaLzpHNPIjMenLKJR6CfNpGW4HID7sTswL1up7F7ZmuybdpiyQrk70MQTR0ea8X0oIxpf
+Ne8jIM2oFL3zLXBADymPT0kmYcWDZASamt6uMaLx0OaO29ZMIgPPs0/I/eEgzKx1OEhyRO7D6nl4
+NbnV3QEmXFQhSHNkLmvHbYaycfc1njz0KSDHVsTgTK6sFzaLLTuCWVOH7CaXRdqibsi1OfCapoK5
+NS/ags2LtiwL93tALskIvmbpbKcXsL4f9WUShY4iPCAQl3ksYlUHM61Pfriljh/bSsePnssT1HTg
+rlnZjv1mo/rYaWFPHjv749jHcvFlPDh+jx272mPkPhw0653fuR0R0SB93K7Yy4wKeipR/L1GI3O3
+wxdeaISsOMThob3iLyuSls1KHKuSY9VyhGqOUM+xillNP5qgjh8G+LESP/aaFHGPdh+8P/GVu7L9
+aPpXEwzbv8j4+xSsxb+dEweNfJKxdCcX7WRbTmhsrmya6Ps2kB2QhzjApJerIGFA+lN1jIjuuIUz
+XMFVwClRrWBvLnz8BgcrSdKem6560ReHVSRKHsUm0sMN5UKaq6XlZiJRNpOdQJkth/v1SVwZhu6K
+ukRnVbzNWoTl4YMIg7UO5g0LPayMbxdArzCchAbNgluJ5R5zVdiFoCKcIIlNfARfUCpiKRrJw6Fw
+fy52pBM/0sk6wIkd08SPadL5mTolL1tWYbR6HsOGA/01R68TFHtelKAOB44UKtXwo4niX02wDNgK
+5G94udS1k1zEgBZe05iIRfrLAfk3j42TOmc6joxt5a8DHq9QmAZ92HDk+5TjX30G0esHM5VE2Uwu
+CxpkWE5TugEt7qcZKI949BWm2pT7wwQ856VzaU7uZCvbWZZ2J5Xl8trGg2gmbd7zq2xPB5xZkBlH
+FS3z7GzJs7PNz24tenbMsyZYy4jmqtl671KFJINm0Amsefcnj4vd0CyWdWepuxuQO5AyuHXd7j23
+r46zA2DevaN2PnNsRGP9+gJQ74+McbQPPz3NOGLq6W1+fZW6ALN0MutURcv5dH6SnRmCT6PhUGhj
+SEz8s038J3Q6eyCr8YWiatVjQLZf8FvYFx8XLmfUrAuj/udfg9AFTW3YzeZmvd4G8zWytgcWTvp4
+gRByWVjaJmN2vbDU+a8z6Lr5YlVea6m4IwHZASy28SJi4ay7btqgersdvKpj2J2HBEyBgwvD39bH
+jKBwEkhG/G+kDPVtRb+tZbfvJ1+ft0FZU9KpRdVYqFcL8bLQlM0kcrmlVL84VJHWhFRMNsg7FxNE
+V8aoyXZUhpKCcvXqxvTHj7guZD7Fj60qIsyw2PMCjszkF9QdaWabGuM1a5ZNDmvFzRIFIbzMQo/+
+2rDCavvZyre0yPD5zeye3/ODG3598c6kkTpws63cbKudplfbWqufr08qJ4Gbm6G7YnmwYWNvUtuG
+tFAgBt46L3gPvNRmg7Dpi3REZvXbygWHjAE0gZx8O8o4rE5qEhWcRESVJRPFZnIn5EjmzIWx100c
+2cL8JXiWfXKRe94WHs/I9GEV7IBs9Vhj8TqHkfelau4K6A/JqEF90Do8EKOSehaTzcBzUCsfmZmg
+kdaubO2g68i49AyAqW9Pk2o4uBIPPKEEsBzWf9inLYGo+MBxh79QamAxku2BwQgIN/NpFr15cC+D
+N1sI7s/ZLbE7xlI2E+A5XD3Q0wcq4P7n4L7jsB3HYfsiXPKsP5rcHlsbi+gCBW4Ih0uaU3y6gddr
+Jrotu58t8Y8/RL1YlnZfZMayvDEFJ5nRpC71j4XwXvnRMfIDL7t6Xs/ZwhnsmP+fmmBRDr5nUw69
+QnfcxoGjndKQRWmhe7LLyHWPrE5a3l7gUzohB0UxHfmafn0hRZVf+AAxoA8SO9VQXLkDqGt03bsc
+OeUuDsvUFnVHJwZc94kOu0s04VccklNb1B2dPGBhEFuSbB/PnKgWvZR/YDpw4E7FwTsVh92pODhL
+OPTOxKHnOQem7wcuyx+cwB/PZ/1rUpJTN6Ib02+kcOT172P/ZifY9e8D17+PHdXj6LAoLdyjnbug
+IxxNnENGW7isrFiPEkMCpsB98cWfY9QV9/NAXnQPIXdxsGyv2iurFLetfdb9opjH7dFw/WYplvXk
+9QfnkKHvOgeFhyAzGL9qOZ8e5L9H3mup1vTK99UWp6j0oORbVPZ70FDVX+Fs4eDb4IAOOClYUnf0
+hz3YMgkluLM/uKc/7IL+Yfv7w154MdJtg/Nffyi/rMfugVoAa4crcNhtsYPrysaETfnt6qZf3Dx4
+znH4OcfhV4uPdbX4WCcixxjBpl2AvcQ763zo0E2P2x03ur+cWh+26fGahkqW4hh9f+hjcOVkL574
+7m85eefl/MQxTeWuwI/6lfMTjnoB8bxtduXGUM2wmKKdcT628jUlGneyr84i2xelPq9Q8HJ7wQvt
+5XzWmXLNnbvaeMPktNsl52vD1BdAUx8m1dAfuEIHgOmF65UASY5sozI8f+yKv6Ga8ZAbpBrYjfg1
+iXLaF72MYHyIFIf+8fX8+Hr2j6/9uX181T+3P/1PEZLCVjSZ7asbLlVUnXnX7akJcnRbrdszu+wD
+gN4zEmON84R2fnzVz/mvx/HKw3idR/F6m08wbAoF6Rw89faYSZ7J0ZFiOE2SRNko6YGwt6O7h2cx
+L+n8UIUkwsqZvrMKLYQX0KuMfNNdbsWb4yrKSeDC483raq9e169Zcka9f+I3Suo9QZcTqc4pJ0z3
+u2bpWXDlq13VXu2aoNXNc2DURhJjlFafR227Sc3O8NJEPU596aXe2YLHdMc1TBXTMRdNtDl8ZKFm
+HRK666l8yKvaQ1sVe6L6rUfeOkvjekMtSRO/D65ckIO9AqErYQdR8ZJjxTuOKpCNylTCoDNpSBg0
+1S4ud5bK9jnviXcxmQyY0yCGmEwGdOCiKtV9EbJvmlakLFutRFUfXbJve7K1lu9sAtlYH+W75C8h
+fBNqW9CN8s2pj4AXO7yZYmApsIRd93T8E1g84y4t4DJzAb/nMjmgqV1Xwje+bUxAQa72b1vrOlwK
+PrtN6CS8pV359lfFx61U2CjiaQ/ALe4tzcFMbDPIjCaPlwNM2tSuW0yxhXtu5bHpyMPDgzV8CWhx
+pyLJoDlYHtryxESy6n5iTxvSfZhEnhbfE9bcnopQJMpGCW9utTv2VLvtp3a/9FD3dAiFqhp2bDpX
+InwW2Q5o+0YpxaFIwO4Mkr0OA22CLkad/5+6UmvS5N62vC9Sp5SFAirTcXx9LYJx6Ayi6j6nojNq
+4V1xpVPlY2sJo9+4j6WU4MSOCposHX7o2lqwwaqU5sTAfBka5EHDRy9qLXySqdYCvd+2l4OaOl7r
+qNqXdWD/pVKzh2fzN1IdW8DlypYr04qLk0Y04gtSRmbEHM0RZlhYNR4DVbBFHmyKNePLWvzlbbgL
+BsTAd6oIyHvgEAKACYNj2LJwK66jCAUrtuu4ivvdgaaKG2SOZq4dcXSiqLJ9O8BJJ4+1jt0Wf/ce
+mNSNrNbXlkx2wng40MQjJolGvNBlBGPTa7sEmjjqFGBiys7q3CBG10vuhLukwC3y8r7bUnRcfJ80
+j8HSIltGY31DXg7MG9xKQTD+2KrHmxUE5OlI+sVa/eXgxpO8U6JVsxJcoJ6Cm/2kUyWdXthSVZbT
+iCPU+U9UEGgms31t0+QuFImymewEStTb8GTjlHnfDPC9gcWXjK1ctP2q9ajW46JCOZGygSdrOq09
+esBtn5psOqdtn1gDKvHw5+JuirgLI33iI80TIHKiSLIbmS+wnEFh30f1T9AtDxKDasodALNeTK3t
+U1M0/IYFv3E/1G7Cj2k4dvJRHNzV3hkIilB9yjZAL1oaBDTOnwEeJHxgGhoqYrdoq2w23DDjbUm/
+iKk/2A8njaQeeAsQUpIR/QSvBtYmtj7FV5F81iQOyckzLQawnEGxZQnqn6Aj76YnwwRJC1vA5WrV
+TS4ScLmQCvbFpLRw5XuwUvGGymI61aONRTAm/Vr7BD1FJzCv1qeDJEeraTI9j10cYLJfuHCkWQRt
+sBeJHZmPA3yTr1b3QMi9r6B3/1i4K9aue6jvXimsWBXLcPfq6+9euRz2g5qOEhUvqRqLHsMkDHjX
+jCALmStbcqCpcg3k+25S2qJuyHGZ77sPSvKfqHBB/3NgstLke5cfQl1gCzOZD4ZFrbu6O8FW5G6S
+hvz8dKDJlslukBOtUt4Qr3xdU2UfDswSZlVm4RMhhOQE773W3YGmnm8OvKoCZeWz7+aOKO8BJQXF
+mg0NhV9bGw5mYhe99NVegpnqi9v6vIRW94D0KNvpANN/j63ZToSMjHbsNkaAMDPdcnEwE1Z8FzG5
+rkhQLSGruN0WvQvXRhdpDt2IgB/XXygpKB5KNSthOwa7bKhow0fJjU0WLeOQPbKkqK06VWUpXDU0
+khS5nFHzLS3of/41SHAhfufdgSbWODD9J0kK3CL3pfhCDJ4xKPJ029kzuAC+XMMDr6grZLH9FdAc
+OJNUtIAwjK0PWZQWrnwLP6QUB2kBu3P0pfVRD1tDZGbgXGjCyyRy3gz+lva0cfKW5qvvZ2u7g6SF
+zdHaQV7lSMLjko/jn+VVbTf2U22v9cMJjoK6fUkeJiU5tUXuxiomuo0HdyLNezIpTl6Ya3MdPePB
+83LsDIfOu/4KTm13pnBmeTvBaHbgvuNEo93NPCg4vwSVc7GtkqV5UZPDdkZkK23dcg8SZfLbi+S3
+l8hvM0nIlt3BmhK+3QqtObjJwOt++1QRHKLFiSJmIjYRuVcLyQmJz0dsDnLHROheB3dsfiDXXSKP
+pjhzb9/s5m+8TlybHWG/S/4aDpIcYz5KIB08aCioqeBCukppTn0YltPIFt1YF1znkHrPpT3Dwea5
+CUShSPI4k1yUTi3vBt13d0tzry6L0sIWMPguZF88+WFYo+UwFuXbYncYeUWzpVsfLYqau/3KorSw
+BQy+C1dbi1VkvU78Q6ZtHyZpoKB5iEmze5vtm53G7vCWReVcvBoSvmNnquWYHLbYY0hb5Nm2oHSj
+D37FTgGe/sp5bU+um3zbTMIn+8kl0Ru61D9LRwUWtJivvUxC3KN9RYgvk9T2rH0ziV6F+SibSTFg
+64/C365enKLSg2JtxKcBAEMWpYUrPxTydU1eTrUFVPOeb4wNSgtXPmPv/hvli5dTbQHVbLOiNUnp
+uO6qcjfoBNZynbx2/fqFSr2k2LrNvj0mr7EBLMiPXR3oP5wwTECdJyOd45G3RequDR5bkV/NQdew
+L1BsPG5+sjwezSabxHIGxQZTUC3ST2Y07mP41UwCTV+hw5fmmZKc2qKVbXSatFbn2jKg9/ysAVlk
+HEkWwbj7kiqVHO3lXAwzL+6NZn3Q+C0yw2W1ZsFy+H53Yj0iljMo3rtLXeXouHNwg0qwrtKm/Vmq
+5VhZttsug0xYU96lXTJtqQ6berJN/ApqbccTqZb6wW0KlbKoLXI3blU/m19Fm1hOI1syY3ENgJmu
++nTdj3XdL/WeC8W6/KqaH52DtjJlUVq48tFG5d9LoN9roF9ZlBau/BVIbdj87ckjgFnoygPPqDgu
+Hjf8fqadfujkau5yk8muINA12oFPXlf+AkLlbPcoz3qMBz3Z42G+i/naUX6yTuEPS60DDLdyUcoZ
+1A4WFpVmclnYpp3Dwy9iHX6DlMRczu8nsSaoAymukQAkOfKq1lK6a1bJ4IUTvKpaD21ruz3w0n09
+Ggc9n0OuR9vlbOiK9sLziKD9BNkKNkRYpjfs00k06sN8BJrmVRUY8ZFjgjdm4KtdpPEISDNNuK1y
+4GtS9S0bUoEoELqoP0UT7b4fjKEf7hp+uE70C1L17/xXN30Crf7F5/Dq33up7wWa9ahFTNKAIQWA
+CatRJepR1Dx0+l/eFf6LG8J/7V7wX38y4u+6IfyXMXjm8BdPQPwdrQ6T3QDTBiCLzQpwewYPsr3q
+x9dryuPjq318tU1ncSoE8I1UEmUzyWwN3PBFg7bdxlZM0qBbFmErAcu5lCGRU1AsPrUQLLhFJ6/2
+CLTqsgNU1LioONknJCfPZC2yj1/RBVH1nouYLKdRM+iDxOMnGY54kIOw4ZviS7OG3v3D0MbWD0tb
+jpIc2WBlONRdd6vNv0Vj1A2HAxujObkgxXL5YmWuxFysrdnOigyFZgrrhFzQBd/j0JBPQYpzh4ZL
+zc2/29LwIKAKqhS4g2sEI5d7Sr2xBdQ87bb9hlQousnb5lRBDz2SGqWFwSp9cQsYzAvZNuXaArrL
+GBmYd1aSu8nbtmgPuBzYbn2JZ8I3BM3VQlVfrvp65HLh7krdJXLMyFtU6AaHe3hreamnKazsXjY2
+Bk3kLmb/xra1f8veh+SdSqPEwNu/cSuTZLYjkrTIrIot/c77TfZ+VcyFawhPxk0o1gqM9v3bRjvI
+CpoYle4GO4rp5QxI2/tNXuRhf2f60uQlFImyQ7KVL1U57ro0PJjUNu2HIY27XJBecDGlnMY+k7yo
+fekSMAVukaN/YLZTFRsSUFb9v9f6f/+p/1cCpsAtcvQPbPW3fMtpK4slIPw1PTRJSDsFxxKIRns9
+dXHIGAHLGRRbxYfo8mLK27ajcKSr74mlPNn2U0twcfHxubbhY3OQyaATUHCONj0rn6k6Cb/iBeiD
+VE4CWyc861foDwf3s6dYgiJUKdQTP2ADmQw6gQ1R6sMQ1d6wpaFQ2jRNuulKSsgRfRCVsDl9L+oB
+676UERRjC+YRYqnlpy3gl4wJyakbcSHkM4tJ5uGpN6U6HGTZpAXszh4RT1gpNbTos6M0U70loiCE
++jSJkrhVTpCI6HPBs28EaQG7M8iaxYOrSOFBlZQWtoDBd6HFKta8UrcFErAF7M5evm5sHRfHeuS5
+9Rqo2UqBdi6mmf2Hn8JsIjbi7aJEE7EDpMgLxyBh4/CyFMAahsI9byZpGBTbvlyHxVUyx7PIorSw
+BQy+Cy3ueGQeSZ37Ui5evkp5QBHBZ5UUTpNw4bsfRpICt8UWXxWWzAUFfznvE/k10Ux2AqWF+eWd
+IKLmZuwrcEkMUpJTW9QNGY0XyPTJiIGWPKvuxUW/wqECLdhvDw6pSWLEu5HGtOOHNAk85kJBrPFL
+x98syYFuvxyi+y91HhAE45gj9b+a+Auk5NNoOPBeAjVJC1vAvrivsqz4v/Y6KtG2CVf+uKZ2TEen
+kGRgGQyJ37trgsNMf8huUpJTW9QNrfxDdrOZmzthCfpDXDIHy9BtTXZbkevAFo9q/cEvLTjCvGPY
+912XUusYvo/Hzdjmt2JbuBHbLrdhG5s19IERSBi4lob1P59XJaiPTlXkwEcACJjngdVJqz+3j6+W
+5z83trzuziz+o0pDip+BMoIfd5x505tDU1JV7bZ1nBuR0kIsA5UO5Lwv32xwkpD9ran7+BWE+Atx
+VHiauJSQpxlpOymg3oVCVbQTU40pdkh8TNyInk/LbVl3DVlHAX6YTIUYcIlF34/oAfegYh5EDNY+
+nMvpeCnpA0hVczJD/2OgH5J1RD7SX6FIlDSz5UhwdUalZt/vGwRK8VFtAjweg4IujyG7Q7BhSwJb
+FHI5XTMfbFEkz19bVdDE9BUVlpwottyEaCcm4JNUDDz6+oJGy1hQPXzx56Wm5MostiqLr0jeeWh4
+xaDxykneMQ539uXO8bhbn/rj5UAt0z+xhWHvmjs2fZXq2L+RImTHET/377wAbeqc+k+whezfYVh1
+Tgqyfo5zCrhjJqVS7SOX4jsGKOo4tIG459z840Qkt7EO3pBueczT3/yufg0BfJJwYY9UgtUWYWl/
+riqC/f4i/X90nWuW6yivhv9nIj0uYhSbLgxuwMl2Rn8WeiWBa39nrSr0SIirMcbXoCrfr1qle/Sz
+3kW/613kw96QXkFSRUsWR7o4El6QXeT+/5TXdgo/bVfkSc2ij2kWPHpXsmdvtsiOpu9ECLAXPkLN
+sizbIPIT18GaAQ53WTKpBz+YjF9DLTnU6ng5ixcMWUgtmcqgqigZRyxsWPKF/8KnH3nnkGfInDyJ
+EJVTJIQNoVvJQCJcVBkvJYl6yiJNkcpNmb00Nym7UXKLkCQKE9RB0tGi0KToym+oFotOSS3UI9BC
+N0XyjtQMxGTZWUb65OngOhQrRWz4WZNOuHupJNFTda2qHMUrsMxDONf2pKgUBtCgaigVYG6SoXRw
+1e6s8lU5JmzAqp+XMyRTVgMxieCEbUOIIuTn3wAown7szfCfickiVoDonPfZ/w04Rntx3MQdjLyw
+83xwYOuyQEoJHzuc5Q+G+UcmGwbU+fOVbvl8rfKCUgjmED5iZ5w2dBHFmjSRYG/xuejzCIpV8TJA
+Jc+l5UoG7EebO18dVhdF6IwMvoQiILxzoUHVEB158jg67VuUhliuqiausvQSEiOaEvToISSRWB0A
+IpWJaxvKZSgddt5+n6Pwcw5FQVOG4Rxurui8IGtUgMRFqhPFa3C6xcz5iWDf/UAo1dkPrc6OF8s6
+VAj2Tzw0zio/XajkJ4zXUMrAaijtUZ78pYHKkuTcVbKhjQ+a8QLgYuCicL9RvnhSH6/qHq/6erwq
+H2Pa49W6OPo/ezZc4W69iefjdS5bRRicSjb8cGCPJjFHYiAPUUTAH7fNBWDikiBhQPgJrcv8x6U+
+WzxeZ0+IX45moWqFRDZ8ZnHiY78nzi9O+b4vX1A+aePRC8mGyAEnkMskp3z0nmVRCWfzYDXx5ju7
+hXfTE69UnvxhhnMNOK8SiMOGMTgp/5jG9pMQegjYULLUX6vff9X2/De0erL8uOIYLrd3+DnzD6QY
+oks9RXzKecIZF37oBrLnGF8hijBJ3qgooTqMuJMK1iwmm9wBZYbk20dnXDcUzfcJTxQX8T0IASmE
+mZNKVaQeEWtaBnVFv3TZcnrYFHRajfEO9xllDhIgoIR4yv6M8SNVZYCpb8a4h+RSU2o0yA+shlI0
+OKeZ2QnrNch4KWkFlf+ZFeSONvbu213JkQx67I6dsQsvsqjUeJGoICBehhzLeSAHuAdkgynx3MPq
+0oAq1MjAG1mklMeY04TmkcsgGCWJVpCNnXry5F5nCx3kkoWAixPysW7S2l0Ly12vs4pj76RfkxZn
+ZXIrt0RleJE3RLPSdCFFFKFcVLKBE3r8XHWcWRo3qfWut1/qLTpes4YoVJKv+bOAVW9MGA6zNMUH
+hKphGCVfXKg0qEw4OQzUDGA5Ibie9CwBracyZBVAP1MhjSJt38uFopK9MWt3ATUgxH4DkAi5gSlI
+gyQej/+faeXzOEiJy8ELoA2rCTjoN0+U2PjDATYBLh6wxFbUKwZKpMwd9gONW5woikBW+tsup/20
+yzl+2YWxQhYIlJekAknGcwoWgY5NVqE0VSghDt/fYqAOPavyJP6wiwB5Q9QEXJkwX7Mkb1QGmRsZ
+SS69P1WSAqdESwqeVIKkohQvJWlWiRygeOmsEvOQcOpPURqQN7QE57rBk0PujOQWUgmrFJmk3cna
+nazdabQ7WbvTaLegmLEoVGIjzyolw57NyALH1ILHEiGrAG/1ok9HKZHyqhIGbOCim7fYxi2yaXlP
+ReZNWtYlbmQONgfyRmXQiK5NUQpqm74dpzxcNJlYsFQrtlIr00LNWKrycQm/RHaWMy1nzDVwBKf7
+EsJuqQvGF897Fb+dC4loblAlxIopIuSrMAKIQMh3ACHZsPHxr06P/ahyAXkuqzpB1YCLZgJwj9F5
+GuQHjnj0aFWRkwgcd0BtUBVkieDZV+RtQphRHPY+6WH58PCpnxvuwBul6serlNQdtar8RVpIRHCi
+xqe8kBWA7Jtm3yT7Ztm3KXvuye7XQiQR8RKQBIr/gHVTNN0QWKg1dEg7k2NRSASsBZ0JMFMzCMuE
+I15JyzyLlHmWHOVzcKbUdlOmKE7y5YC3ScMYbrgSe363fHbx5QBfIRKoIPn25YnLuyeu7p5ycffU
+a7sduPe+erFFSd1RXL/k8vGP1yd8Hq/P9Xj9ebyux+sKj/Wxusfqnv0fX2waVIEZtid5Fr0GkLUB
+uGQjUl5ZRhLhRRaRcIvqd/WQrAY0avCSgrpkA7KUHCVDyU+zywkhVfbN2gi+ySXSKSC/nn4p55/H
+6nrG3j3z2Qx6rPdcJH8DEQJWlOnxqx4MvTG8g0IQlUFVsAQyVH81sJ5jSOHcO7K9euqtol5vbgXF
+sLCoHDa3bL3E14sDQughiogKCYGKv15d68RDYXUl9HJW4oDjVs6Gz8ghYEX6dY0kwotEtGyMlbVP
+CY3KoG4MjoOEkC3U0C0BnRAiB4w7HBFwUSFREVEhVbwDtmpIrzOqjJeSjFi8YCASBvGBUl3wApdK
+KoPU69Jsaj+QMPQh11cwqwuNA6TDnSMAe/LRk3+Gtyvd1KvLYzO6pbk1ryfdlCpaWAzcIDSyK7ki
+3YbQeZXIIGlJ+DUAI8Qebs0ghI1QRgsmE2L+BEnyhwt+lt6mTpwnkpGDkhDCxqnCM7BYglOZDOAR
+ySUDNZHI3CW3I7p14z7GD7OKJK+ETcQYLyXZB8FmhelJxaI7I/oZdf83houURNJvkXJSKYYSnIGZ
+NJkVRVIQqYAZW7v3S90HSdzuzpdlAA2ptdE40Wc6cgygUzJ6a38xkR9syd/WK+hwaVtOmCU6SfQh
+QrLJBxUDddFs8yGWj7sEYJCwYdIWgvHkAnJgLecmAmp/mpgblwsnzHUTgdQIPrn0mySri+d+bCrJ
+K0n1OnKCt0tB5aIgjxrfNPOuNMgPHPFSBPM+yBy+t9y/99y/NMgPHPEjd/nS+KzUSSvSHn48j4ln
+14jtsTsOGJ/BiUgqK4Cz35+hiYA5kggvsqjUeJGo6/7MUYSkyFPMsE1GpM+Zp8qdi6Ol34tWYAek
+JH5vqsMPEUakYjWWrCmiicQ7jFw4VECt6XBepRgiukmyllwl3F0y4EdYZkV9SHyaCCoGcGkkQlTe
+dDu1vPaahTq0OeYSPLar0cTwWXke3WUbyoJuH+8FQyGvUAGXCOmxEF1xaH9AmkQiqsoBaltFiMoC
+I253UFCBPeNY30FS7Vncucz86SEv1Xas09ievIsvllREkBdAPknm7uS3IMIlgeRWx8mobr1SSLDi
+oTimUzdNwuIoYXGUsGJKsjZKKz8HrICoGJxI9UFnA3jTyHydVvkRqIHI4uBfLTaCsVAiA29kkVpc
+oZRPWCVsTqUaqBhgigIjumWUfaI4TBcsOf5fzi1RE8HGFqmphEGOWHLkTY2jr518zzX3/8gBNyVj
+Cskyg+j0gHmg71IHceA5LAg5jguRvfvQA+EhnmrvPj1RwQGtOGzUIgtg+Sbl6sqTA/HqEnt3ebr0
+zQacBidM5akpI4nwIotIeEf1QzZsxLKoLDmJYKt3CAMLQphEkFcoBlUoOAMzoVBPknXQdCKJF3MF
+34FkqJtKVJyvrfYtVfpGPN0grhJODoqcHBQ5OSg6+Muar0gG3oi9wtNFHwbBiBrgPQ6AzDBlvMOx
+uiJ7HksykmJ1byxYWRV5NEhIncLycwmwM9+JE0leSbx3wiKpSNclmXE6iItuC+wbRfaNLqFL0+Qy
+pRINmoyaJSvV0GiK12ors09ujVR6BY2RxAX1LFLPIvXkR1pZ1pwMyBtq8s5ImRup9ArFwHwUNAOt
+5KglUxlUDY008SmXjpQvIUx+QnGy6jAamqRmH0ZZKRWpg57PlWbbWOuEHafJYHjzeh4Zfd1j5R2w
+Pt0qgtUlh5Wn8LpkPnTXJV8wECpdSatcSarIzOHGAden4lxIxkPdcGjXQVHlxpMRO83H/aFoFHmF
+YmBxApcIKeWHmgiOx88HKpA3FHdm8WQ/TK2yNqp5y1Ele+WIhUa1VW4dS9x6cMCTesVkX3FcqId6
+SLl2UKj89kuXHI+1e61IVSWV9HWVfqiyatKrqaBVJQycOQZOlUV3l41vaylPpNa8JNpDmJXTonLU
+jDKlRrfszHLT+TLl4uJfttr+h+mvpJcYAr9SEZJllPJOE9bBYZnZxbsWr0mfk6n9yH6QG6iux/kc
+pEb89tHEc0RYJkWLqW0uv2sa00ZzzugMyEh3F7zGoPDPY3U8bzVyp4gq8g/LpWB3FSI/cZm5TsrE
+GGJN0uF3J43gZ6c6bTrVaXTk2gxgkpAfKOqE9RxLjpIqbTLXMZilqItUCaRGlhITQ5ILXS0OE1xz
+Qeh4OcM36FfXKl8V5mNOw+7TTg4/OACdy0Yi4iUge6EiqVJUBhqkkdwl57JlEWz32MW7VB1+Hhck
+T6+7vJC4cdxKCOVq9Yn15ImThBOrylMWlfxAUpeRAywCTlzAPHmuO/n4jd/xh6xiiDSAvKFkbKvt
+U656nfKukxEqUty7C0SosVacD50NlwLOJh3CFyBOXHw4ceHh/GqcdtLXOuc7dY5cmzi/vTJv4iAi
+xOLvTXqS/8Z1bVySeMtC4K3rgLce8d92wH8XHiIfXCL+dK/PDwcE5hp+foLJqrIBuIs+P1pzIXXj
+Qj4/0NgTS/jPwQEKkYPKhw8h3QkXjC+UgaXuFTOfRV7J89XZS8q7rDTe73iZ8HUpmGQrcfDMImDz
+HGLIsIS5IISCEP3I0itQGWReCmjQN7jU6GCKcr4qxI7idTjsRACO6c9/QqDA/tgnnPm5z9V9e0Of
+j/XZtWV5rEslDupj9Y/Vu1R/Hqs/His9VnKFg377V2T3I8dzE7mCWUwAMVwcJAwSbuHVBsGId0CN
+YLweKy0/GSEXt/z0xnGR3oeeC/X/rmNhTvTDgc87S0TxD8R3yWOQZW0AjAEmJOdpgOgHVnyXrhNn
+EDO8OLJy2XoVj+jLAUrA1qfXi3rURim5LkvoycPKToGvcVBoOeXV7bzSNK0XF/s/ZjLqx7yk0gxU
+lfI5Y7wmRZrIKsPzHag3Mi6ub93oOdCC+KSN8CiUSE7FWzUGjgxrCo0GcVREQt47KcbwLHwmqDML
+tmrfLHtIQYRLAhzHPx21Ev82fhe6dXZcuKJ9t3XT4GrKxcThWHPRnGZOYilYk6veAt2U+r/joOQu
+vCs7GbALr+ZJL3KRXeRiiqh8x/9OojKzRrDkTMmNKg8lXkOlOri2mUcE3IsTEVWGqo84T4ZIN22O
+wwLrptahT+nIT1xmnv0nlv6Bwt8pvGmTZ5twmFuYcpva8/3dwO+tgd9bA7/3Bn5/NfA7pZsa+J0a
++J0b+J2q9J0aOEqUgcw8gK9KD9aIRgbeyCJH/lMb5EHRWYnXpI7Ub5pw+OQySJzDonJkFhbb+ox+
+4jrY6igfpREWyHXail2RzDGHAeKwjRymqwOUNFQZIDFqmlS+TZVvo/JNbugptgmr8deczVb/AcHw
+xn72DoReXTdUImBThGm4BhutQSsUxrDgpyax2YOEsAcOm+TXXAxOSTJpMqCCbloGMfHPgeNAmgJ6
+N5zSY/xzDyulhI2YZJtlh5B9Mp4ZFSIDRGI2zLuqYVEJQ8t/xNSugww4shBCVjjgbdCwDGCJYzSj
+bKyO8VLSzlNGXg3HlCS3yjtEUqkG2yzN5owmXjiKdck/fjiwCu8uDYrBagFd89pJvSaHqZY4Snap
+urQ2yofpjTUj2T5Nisg8ccrVfIZxHemmjmjyA816GWgBGC4Y4ucr0tIGkR8s/qLkdFOm1LnMPEUA
+sZxhGS8l7TRl1JdHcO7/z9xckqOlKLXNXE3peebF8TmrgDcqg6qhEVqYF+6gZULZ+1TF8BRNeKNd
+66ccL9OqbjhTJa7kNK1dJkNtv/V6MyB9Ljm5Qcijz/Wo/bVEkHeN3XifztihuwiLgZks8oJs6tTU
+6Vx+VMJwJWetp7SGRLowyq8XwkKcHdvW4g7ZFoLVOCwTunhT4jVUGknYygvVHNL4TvpKOeYF0yzI
+rGtYDFycMF6qUFWqbZAa2Y03/O5WPfwYaz6i1/2mVGjJEqWmtdidGbWz5aIr2TVXskcKlFycOV5D
+w9woykhLhvDN5dhGr0yqi3dDbb/1ejNwdocrPDyO7aqahXK8hia5mVKHNuM/zPx2/zKhizclXpNa
+Z+b0xQceBmVVUUXyB4sEm0QGpzIZiL9mE7Db1SVQWmjCaszf+p602m4KHA/XsHrI8gsxOHpnvGkp
+W0QURFwJc8BZVcUF+sEc0eTZtU6rCJxJM8ne2dwq3n8CgJYthWVCFydl+Njm5ofUXZwwDrvkVPIx
+Ee8Ppf+76EWgCZ1wdl0cHsoUqEzFi8hdPpHHM0QR7MTZbOK6udK6DE72GCFs2KHUoc34DzNPdiW0
+zOVwnXYXEqQKnBl2IpFhUTglDYrp+zvGXMeLJUl2QddfQBqEpLgbIBAvxUaD/EBLI4fuYi/zTTw5
+vWlmyZ7jc8KJW8mp9ePLcqkypglTqc5abXdtjuRcSkgrLrOUEti5ZMcC0dfoXWXysyaNU5ULqNsH
+XYpvgq5UWjnRn2fyIqTB/L7WSuXtatdrzQi5HbW52FSK4cgqxdBIpVfQGFRO9u1BLs4cr6Eh4ThB
+MPazMntpEaLldNdurm+6a1LyWaRCZyGVXqEKSDn9zTniDcX//PvoIrtn2yrJXsD9JT8U1IGKCPIC
+cEDGjdflrV3ynC6180DYvT6r+4hg9Szfxt/p5UyvaoK8AHK9cGVic8kh5F1/c7XFQMWoNkWsRAeT
+aleX7NbY9E3uHfwgGPuTgd1I1IPU/an8hKSye1FrWQR5BY3gaoMqDfIDzbNOvl8a5Aea73f4dlt4
+8mW6nijEGEz2OL5z1UN5O2JiieYS5MMbDNKXefQl4w8pSa/mW69m6VXcIeuCn1mfsMw8+0xslfgU
+fE9ElIyczoiQr/MKxMtQK2YKidrFf491O8vP1ofO97GGxxrc0tdNTTB51yGlkNiSGkLOVIAE+bDX
+4QcvpTNz3PnfSY81PPs/AioiyAtwK0F1G0RVWGQToemapWvwyEmEqLga0+msKqNZ0Dvh+eSQK4mc
+UUGUHvipVEgx8FOQAjChGvIIQHhG1DRKtYqLzZVBvJNOyuTEWLkZ3vMpLssisqpsAC7O6y1EJXVL
+KwBOOCPmw27wxEVQXM9eBCXXe/3V//lbACvf1MDPdkHES0CKetkvEa7yk14rbn+EV+OPY66hm1aH
+7mNZAeg+AEz9TI4Gwfji58AFYNqotC9Tyi1Xd5YTETjbEMARRxSp7mpDdHVH+ENxEKJ5XQaZaJBF
+At45ojUf1wZw3MpdJUvPsK5oEJ5YY1lUVgGRQWNks+orLwJoiwpOkzmIKtjWg96o6HrwpNJUZgUM
+q7hs/ArbGqLngBPwbZKA53lYcH64axL0rknguyYhkmNngtIQssJPG2+g5AtnzbWKvGTFlytWnSFi
+pHoEAvHpSYgxuF1kghSzJMQ+EGPfooC0qtw0aU4q4bxnFMfajoS7K3wPKkR+H5XlyW7cIG7P/nSc
+Zl/4tx8U5Ka/aZcxW9Elu9xLZ0Ald+mmfSeEUMLyo1IzZr6UzMSWgwNkfWDwyNdwGFAS7zD9Uzgr
+tzG5HqxURDwLtqFxFYXUinw66eENyiWghprcMaGa4YYNwhIRqMwa3k4lCeAxI0OCwh+VXEP6WbMI
+RPysPOrTjuGFq74hJR4scvE3pMS14JS54I4kL1FCqk8qK+AUoGQSLudjDVkPgrmsIavkCSLzMifk
+t0t8dDxwaSkctZda8O4cZGXgHYC/pg6R+1M8AVbsfgW7X5Hdr8juV3wkEV5kEQm3qH6shthfaeA6
+lsiBfI9zIPvFLWdvAFMgEaLWTWW8lGQ0lHlJEUpEyF6ZAzY0DlDvtiFUTWrdNniqyjtv4R2l4ufm
+11DxVALqU/eMkF3aQ867+DjDkwgOK207n110PmNwvJXOSsdBDLz53vSw3+Zk4PW+QGV6YtcHwJQQ
+QikIoSDklr7RoLfO4W+ZRL+uB9yGL9rw/WJwsKyP9eexRvfEDaToFkJIHpKz7YDY4KLKeAk1MvBG
+6t40gyCnU4biQUWlGMZpsGm13ZTJUerAFi4cL7yyTCqHRSrj8ew4QxGJMrwPJFJduQgPK5rlA+75
+KUmnQBGPHJ2BmYIC+hofoBWQ8tGrsu4RkFIl5FtHAsVIksuteCUkuVgEnIBEx8OQBdt3DnZ0kjwt
+EN2eCx/zmOym9qxVU2mQH1gmnHwHjpLsnvKsmeOXBvmBZcLJd+DIX8Y2CD2Mwy+k5iqn5UCk4DBh
+p+Cv6kOKWdwZ4mWIWPh6qRzDP0JiEXFGVwZJPhxXCKGHgE0KLVZm0d/iXaOTbq9r/rBMGQNDYusz
+5g+VmacIybnKZtSBVyu/kCwgJnl+01DMQTMPw6I1CKiuPXZsaK5afrT43f1QmXCYzfnAyzMdP9Jj
+IPHl73YbDaPUCnVquT/oCf4QLlYbcppzybszUBMG1p/cQ4xTGaMyPnVsyrj8Sv98dbrTQfqVYUO8
+OxLeAuwS8QBxgEgIxS9RUSnRmjBZwgTBC/NIxAG2LMt4KcnmMSZoHHLa9Cw5/zB5JyKSwP7MoPwJ
+sFHkqiR5IydSWi9xx6NvkVLsBzImlJCQb97OHc/idCXAN5dlu4T6/T80KJX8cUqIRo3fgfrOEJ4c
+8Ok2Sx6XgR9tieEpjQZwW/nktYceooiQOAh0c5Bzlhh2DjAzsySvJK6CVRlwVFLpFYqB+ShIXqkh
+RBp5racD++XKB8ZQq0s+GOVBiG6UVJJXkqw6qldRaV5leKGObdlEkFfQCPFt2Hj4NLVI14KipBt5
+C8ZrUqqyAWK/CCWPrxQtex0DNnr7aub9qd6YdT/Ltp9l1xCSF1kg1bFpnTJfw4z5yYGLIsYxExoN
+qob7oGFsg8z4vWX2pUF+4PCWSj5llZZxZhnzEwklkSSgpi8oTcocpbmpxH6Yn1kaBV85jAH4Sphw
+HCg7WVdIpRpCMmDTcuvD5dYJyxa8Sjj/UOpn9/GmcNRORavGGIxOxHOYd4TomowXjAHYIHnH8auD
+NiOP94OhrADR4X4gZIX5AJfgIFAkTsZjHg+f3DWLVPeXVBE0opUulVItfOxMIV6G2poCk/rDWKvT
+mnW8AKiDtlwP+1kP+9kO+1kP+3kc9vN82M962M+15ohntAaLB2fQmosqp6HQ+FQeEjmei7yMbli3
+UHi8vSX0ELLOyG94v6UbsTDOb6nYhwOkweKpC9PVSxJ/pC8+2gMfdbAJ4wPHTy67AUwc/Sek4Ax6
+xLm4lVt7LnnJpYUFu+Cswi9XeZed2aRG4pAmNIxh6chR3KxTTvtObsqJlp1IQL2OJxp5ShtPcd85
+4EaecgzsEj67bH4AMuNmn2gahvjJvs3tOK3sFBaFJKa24bJIPBu5qBLJKUAkERj0pxzGzkaih5Rn
+itdgrWhDiEPAqfPgifd6IDHeGfM5o+TXtFWN346M14IfqDKqiskALTf2szLch89kVMrRQA4RUBCv
+ifNKyQBRkhAv6jEMEo+jZNwHnhSJqto0G19X8vTMZ0GJvLa7jg1hfaz7Y91diD1sj3XP/b+b22NN
+rkSE5CF5zAGqOPAW6VAhri7qhhDpcF8n6QtoyXHSxrYPB/D7IPePeH2wDkh8izRRqCo4t7wsW+hy
+J4S4P5fwPKc8ztkF1yXvOYkQO4+KlPmTbiJ5MZD6Enalch6PNfX/7nb2/2PtYX2s+bFm1/89BwTB
+tc58jSC7yEE/+DPAJW6c84TwDSQCqr6CZzjMUkbErJkdfvB7EIx4WU8AJgmXXKhMOMyac5O3AgzZ
+hbNrRCK8SEnUCDVvGxVvABN3P9ZnPeRbxwJiwjfKB8J8Pdb87P+8Usq4F8iiskTR+FAcC6+SVj4x
+gnL9pVTRiko1iJSMQw8iCo1SKK+PWLCKYMfPQ3ds+cCGU+wePcrz5b7s3YdP9rJfNr4ZI1Co5+t9
+v/TThNTCpwPZaw6efzOTysxciqdqkpviiUt6yYuYSuQHo6micJpXLtX9oDp4xDF7nB9CshMuI0Oy
+ga/uZy+D0sv3/oXSZE1WOcwkWa+6ZY+9F9KShDtJUs56z9ouEEdlzupwhdJEHIWAklcJQ04ioB4Y
+2wA2tc25Pg79mfK7S34QJ/v+FltvNT2fxHsXV0b7FPXh+lLbsPtR2wgitA4vToGP6/FjpbmnxH3E
+LPcRs9xHzHofMct9xKw3DfPa9681x8eaA976wzMXGbNnxmMHLKAWEuFFipkPnjm63rwot0Eyj9Io
+iHsoHfAUmRL5icvMdSiolCiwUxLhcx8fN0U9JD9KGCdMFw1Sv3gJyJDpWLIfpMZKPDnjzmcXkq9c
+GVGqgmnZBpFa62YgtoA6hZi1ddrgHT3qsXYSgIuEO3/7TkmMOMB30i+P5ejxQ3xMF8dS403z4gDb
+AeMqysCKL6nHi7U1cJjbxumCQ8ly7IkxfPJqABO+ypYjejhekl+84LrTt4e5FNez2mXa28/404tI
+PHkk57nXE6aQ5HN0KtWAGUEIxo9LDpdxM/cdRkOS6JUDrpx8OCljyKegjxkL8sOSg10cWm9U+uGA
+kyauV17yIm6MwYh3kpQj/aiEoZSN3KComIc1i/l88mBJ8rXJnL74riHgK5BZ9pD/+ZoZi+7JAzt7
+LC+yf16kErFw9uq8kcM9uZwxUDMeLemSh7JNyGNGnqZfmXOzDOXszW6zcvYJ3+nI2ee8OgbczJWj
+RJ85YzRQEzJE9hc5rgMfd/KLA1lCATgRhnl+SYVfWt+XVfc1jhZZhn9+QeOcV3krtZOKyeJFVshg
+gJxWdIYUr6X/PHRxkaOTwZkjWhLX/AkMOVvUh/to188tZj4S5eTDJ/CVwpxTuJwiZ3xwIAUfWvJh
+7T64tCrPlypxVCWE9nlKVS5BvFXd0UO8cm6DJG4trgqq4Ne7lQ7ykyINgwZ/mHoKvDHBokcVHlrl
+2V9g6cfZ4gMsPvBZbC4+JxG15VP57Xi7FQSew4RwZcGZ83YoK881ZSWEHgLHPUC8DKVTh0KiFgho
+aE9ZUbc1p+AmqoIqv5j2Cj8mkQu2ZJENWWRFwbICuDq6fcsY1rLM6M/yxcDAnnsfL5Um9BOXmeuk
+TCz5svKlCf3EZeY6KRNrRrIoLCn0PaRkXuWVgwOOQHWlEr0VFQ+k8Vc4c934awyQlYE9q+w1LNlw
+UBQBle8uZS68VnxjUWCYuLgaDhHkFYpBFZLiKr/myhKJr4OXXr2stmxORIXkfBo9c+mJ+VDbsO0b
+vxjEAheIGbHthPzAaohqgDUfbCghP9BSfUcq2DjMPeDlAx4zzSdeMoPsLiev+07PzTl5t8EXSTK+
+SMJC4iA2DlHcGfkyACTi4/MsXBKvtM7Eh7GzSEgiKiROlQBigtixIjin4a68G4srNZUw8Euj+WQr
+PkzCssLAPnzq2aegN+/Kb+KgJBFyoGBMy4xVmLxCrYMsGr3TCR9YM3TxpqA1Q4/XpGpmuRgME17M
+nhSNYtmL5Cst/NYGfrdvlZ/rW/kn+tZ8ucd6PNaji/hYj/xYj17Awf89OPt/fazlsRb35IDP1FkW
+lRUQ1IKeLvqhdwHxulhEYsG2ZdlOACH0ELJWZzyjQaQycW1DuQyxUSaFRMU6k8lc9ExWOd1i5tQQ
+gUREB0AjF1z2F4iXoeUXpmNMf6wwkko2cMW8fSGAGS7eNZNeoQpI8V4u1CshGskkETrOywbzkoO8
+CwZqBhIpmev287boLs5L5RBxuqiSx7RhHdwmDMukmE+8lLTXlMk0heQN1NTIwBtZpDbmHF11Tn3F
+RdPOWbxeCIOrAi0oZIaGEOW8pEteTTr3pYW9Gkp6oY18XGBBItVc8X5VcSEi5IgAU0IxeHGkyzAA
+vRrkp4EGkikrQHRuZuy39DpwdfaQ9Ccsu7Y7EREfmGcuKnntY6gpioJKedtxsN1jnE30S/U3vV03
+9ft3Bl/6pd4yiLf02j/7LolI1KAg7hfUfGw50YSTGaN3P7QzDuuK4zQpCSRceACxe3LeQRYpG29Q
+FhnTybtdZRU4UxskRv46GRVVQvQTFkJZ3jtv4LU8Vi5DM+qFx1mTSCKVahA/GYLJ01kMxEevTA4m
+P2syOlWN16xJHiHm/06dpSb1Ht3umuYUcqVBs1XHA2vysQXTxE/9d6eyGlg/gTUijwaD4zVr4pbo
+2Ogzs0XUibROKdBCE4o5yyY+nMpiHWGXRaEIqNCUVWa/5Guz0cdIN6VMim0z1iT5mZZIE8K8kgi5
+hVHk3ghLuIRGKtWAvSvpVki6aTB3pexDLpos4ygsrUDb27xjyBSdmozfpuN3OMhGaTI7J528tfva
++Dz7rEmkXOEpLuHxB4Exouw5DeFo2GiQH2jJrCL2kuTgyemt2ZjUyLxcjWaeI6Sbz5h3N6gObJO5
+ydEhWXMPCfGrxwMrWAWhZQfVLYvbOyQaBMcNoThvchxmqEphGaSzOzRxUQc99owvMIB15zq2KUn9
+Rwh9fWwytBjUq2nW9rawKbXdlClKyoUlUVQJwyHdeERp9CFL2w7qIpWqB0JdEeKztSwLpDnK0w+C
+2uKK0SqKHGjlw7UMIje5uzVYIoJadcUhz5N00FU1SBLsVFDV+p+MD5BEl5wVubboc9kNmq7427Ti
+b/OKv40Vf5tX/O2+4m+atIgKERbZV9r0ENCsVVXJG5VBI1rpUimdIig1DJK9xk0Rq+SgRYpop6dB
+MJ42pXTM54zxmhTrjKGOdOx47liJvJ2P7g9op2TA3phW3uiCN/mwytytLF5RhDrqoNUPd5bx5U7g
+JaDpRU8ipPZvGhV/a8FapgpKUgAeiVDS6KZSVnKCEn3x/VYlGIMXIbUKdkR+S+e/g50CCQ5zwTTx
+1saG5qpCo0F+4IifEumIVHbxrsVr1qcs3lqIVbZZTsMRqXXLs4bgSdIlIPa/UNsLTb6kU3WgX3xv
+oeiHSou7JESxl57WYKUuC/Qv8vpK131RV1zBKuQqifAiDxdSm7mqUvgWAnNRqZEig8bIzNUpXgJS
+a0VLozWBH5K1Jc9EfjCSNSmpaUHN7sl0RQptVmabikQWT0LICr74AckGrAFxQCXy0jDy2jDy0jDy
+1jDyU8PIi4DXD0LY+DZGId4LiRJemeqEN6WUqiDFNsiMZRXKrUWaWV3OaGAmXDnsLEmkhUkamOTV
+TKBUS9usT7sD+U6uoSaSn98DSvrVrTRIYteSFyoz3yIk6ZZLGlQVz0oTijmQSjUk6SPsOADbWkmf
+CxosEXKQFaIy8+QSlonrNiltZhoKDgVQ1GzViaTJrN5yMZ5ROgTPlimRn7jMXIdi2e1yraMjOZ8/
+qmSc8pLe7VfSjsw6LHFJutNBTnaRVPhJYyVJouKZSxaM/HVcUQ7tin596kcxJ5pQfT+YKRXFjDtV
+TLJOJkof3WXTJ+j4/PDtXSWkRl/hXclC0vMyfcghh/R4Q/L6sYCYWLB1dfIcsmG8JkW7dFLRshWp
+s1znEaqKWYDzCuy7x5BUsp9c3uiSVGqE5AoyI5VBZkw0CEZPPMrSiyIgYzk8dfbBAVbHenOy8H3J
+QnLPqVDFiR7x+Q31s0WuVEP2LcH8/eFG9pN0TI12dCQcEgmHRJJDIl1bPpMfxIXKcZLkOElXdNCx
+OS49MSY9gNI1NsYl4SKjSo+mAY/EQXYfXrUEvJPZZSSVi/u5cVXFPNWCigbNI5ScDOCDr3EKkDfU
+lBGPxZTATQ/0QghP4kdWICVa0tELOpQ3brgoIeotFsnqLVm9Nau3+mmW+n5uR+wGgMkm/T0U5NF/
++lSlGngL9Y9oJJUcw6NQRl6QtW6Q05UQpWd15QsQEwQfL8LOASYull6hGFQlBclzx28gMfyg3jvy
+kVwkQZC8ZKUQ9NUKJXWTXNFLO2q2S9pd01rSKWUOBeXy7zKUwNdSUSEckgPvGUGOXEGPWkEvJwHi
+ZSixKmQWVoR5zSKgYt3QJcpImnUaWbMnX4gIOAHlqzqBL2bg5nDBrWEWcEBpMpmEQ3zVWb3NfTha
+sTwBBQROR0yl/Drxu4ol1CjbqEbdSDXaVqpx2kwyiMWpSWayxgr6YSMh8xp5NU2/ywgGIfKTeTHF
+qwWcrAc8mM+yqJSIoBYtqDUrR5BMWQFcOL+EWML3G0mlV6gCkjnTsFVNKBl+v9yczAeWjBc1WRaV
+FQD/jMMOVvKycpc1XxcoSRd+uubjJzdL5vTrinox1CaEOqyrtl6RROFo3tJZ7uZkvTWTMSjzDweY
+V/OPTKr8tFHJO9/jhiSvJC47bnyXvH9wYATAdP3kxyqLIXntimVRWQGSF5ZKXWBZyESghpBYvFV4
+kUj3ls7Jb+2c/JbOyW/rnPzW4vjkM/MehUcQC34WlkWFhJAUtk8xSRRP8Lk++XcQBGDSe2gZBcuV
+r4xfbmKpdtRWr2pluSecq3aE3RjO003hXKtc9M31TSmDvj1sVPkTWUrxGqw5DY0mHSiNwmIw666W
+bVfLY1fDR0tYkFfQCJFc+rlsIsgrVAHJn6k2xajprCzmVQixyYt4utAmVL/kn4Xwgvms1Vn95avb
+tutnqZrrEvkBm8HqRFoFK2Tkv+V1kBpHeVNRkeqM8ZqUNOJ2Z5jONkiNJjcs4o0tws7yJs0iZcAx
+kqHu28rqLk8Lg/kV/JLPA6H0ihZlhRyBVKohrQZiUkHNQEx4/b/ICW8XXmRRWQVEavbISqawUytu
+AwUFYGrBdcWs1xUZioE4ykEg26VGUBmkjpOnmMRJPVh8OJCr7HiAhgUiJYuPTUGfiBBFf6Tgjxb7
+sYMu42qgqRGVEJ6HSpgRYrh/ZKRfiUSw+uU7YueTAzyZc8qTOac+mXPqkzmnPZnTKV4CUkNFMmUF
+wA/W2nA2IeQHjnhJ6FcS4UUWkRXS/LQ/gBzLSXn7n7qJAfEyhGMc0XP85ACRPo7XNZhdT8LXXQTi
+Zai9YcrkX4zQi7woOF84v+myiNRoqdoL15zO10tzf71G1i8++znxu9iQXqEYmI+C5A2K12A4IC/x
+xoTCP7bUQxkO+nkxJsnhsOEgKDkcItgreQjSPsVt3lNu6Z5JVkgMKCGtSILL2izhilhMCKfckj31
+Bqw86cAHwvPC/SSW9bH2P/dY6/5YexH9zz3WRo/1fKynW9yeIw2qHb2L7l9XnPDikovC/53hHYoo
+58EpPcUsGKNroYSOOwcOzwArcfZp/W6ZS08hkUpEZYRQmktuZ0txKaisAo1okB/IfaKKOYfhEoY1
+l0FmvAw0KyT1ZDJeShgHzCSyqJQIueJpKOYgwqlMBnUQ302fFI3SmuFBKCWJxTpWSYwqdi0IR83T
+lRQI26y53UWuT6M7pQk5o7dDyMrl/ju5Gk8qyb5AdfKrIqdfKSeV3IUU+23skxIeATlJnvYWqEyp
+0mUAU4FPIRElAyz1SF4lxCGqE0rGghZSXEgkupRqbQEvew/2s1InxdJgRaEEl4bwye9DdJJ8mg4I
+RXEfxc6lzoXeyhwgm1tIXFm8sRf3Gfh8vdxHBHkBZNGpsuzhulLaKPQc++YLLvUsgjxl2SEtAyoT
+dJKmCkmUh8AXiZUkDi/nKomxiBAVAjUNvm/yED1CKiorAFcuO20uxkESDUEI+da5QLwMZYcOkeKN
+4jX47qPzf9fhFB0fWTrRntugKlh2tCPmJt0i6Ceug6X1EVnhHAdQ1ST105MdQ8mmWWva1Jp2a41p
+/0AVK7smciKSyiog8k28Y4ak+SEghKw0V0SE2gZpHMvLoa1f7rd+GDIZOhTX1yi9MtGtCCuLsuqX
+H8+4bAi5WLxbyYLVFweMeAHp5KESo+NUvK7ooomokJcIqOFpvzxk2iXMI0w+2spSnKQsfDXxxKjE
+0NM3Cc94cICUB/xwOnLGQ1261nfPHe+DQbLxSdAzwhBVIpavTUCKgYUv+VDJBiqOX9848Rm+c8eF
+UZa84ezn/JhWlr0d+4E78gLsgGDD3UclMWZS6RUku47s1AqRym7ouacnX5eCZOOS8RWMM3m3ugAo
+OTNQqRLX65heeDlPgMqE1ViokEo2rKyunM0PB+iYJL9CefKLk2f6YQ8caRMOs2mn5qIBu7E98U6B
+i7IseBimRFEEXEm8cOmNSSUXltSODuSHAHtY+ba0ErscOaQG+GAOFeLYYoWVUUoZGQuyGQHWKLY8
+SXiCDRIG7fFqXVujfJHXcJilIHkVWADRLS8/BmySDShLiT43kEqOvxzCnlN+EWd94IT+5C8fnUfr
+LsV/XBnQo8saSYQXKWbUEF+PP/XFs7NgkigJK8aSRMWcUU4OOvL/xgHnjF+sZoEogghilsElnwyB
+5HmjbhxWaiKQXZVrnkycVQ0Sg4bzw2Q9FDdO3twL+0ltrvGty05vEVkkKi8FyZGo6oGoynGo2mFI
+lyn8wdkTc33lmbL7N30Y4mxbCb2ze46Fvp3kiDUfo9rteNsQoA5NnlvpoN7cSn6tS76ac+La/Kkf
+djvtu26nXK2HTHyjYzAipCBtrFyrP5v8xIZAvAy1HqyAemTv0jdfBGXRI9496HEXlluXS8SHTr6F
+evId1B6yG4q9eLTy0Lpweeb8YsbB3YJTbhawLCqrgEjO6vNYP/jSVRe9Xh+X1n/Px/qhxIHPWL4B
+LxjbY/1cLnR3tlyJvIfs++31JA48h7WHXNq19/8f/GqHQI/dk6vBKZz7II1tKsMyCB2uWp3wH2UY
+cz34F34M+X6nacx8we8C1xgOld2QHC36Q1qT4uIv9eZLN622uzZHXsxl2TQNPip5pbnUe6G3XO+Z
+TnmOn7y7UqYlOAXu4t5E3KK7cIfukht0l9yfu7jx8n3vLhuxbFRUIgLZVWwu/g74hcdVL3le9ZIH
+Sy99xPPSnz297IdNL/n5UshAZjLgfF8uLjkN4tjMwZL3g687X2X6/bar5CPE3EB4gkcJvhIufJNF
+aRjDMqGMuX4B9uI7OxdfF71wu+bbx81Xbnx+9T7nt4+i7bH9sz8299jcP8c/ubSNifrRenNu7UHl
+oD0293Q/P+dPB2LrkwpC7+p250B1Vm9Kz/oZnhzGwO9ZDmTPAEtztqid1EuVSIN4ZoOWmoGa1MJb
+d6CLk2K+AuQhe2cxiP3UVHjtTXFUQBQaqlGjQX7giLfCzqmu51y/U7PgVEug5J0Bm344kO5Zflzb
+choEF/3SgPLFxHXir8RAVJWHW5AZtcYS9WQJp1A3llJolKyi5BWlrKgJE10qxTXhkRtBuEvoPirF
+t+JKgSC8WniHug9sE8KDr4kodFPPzLvdFUCvKr911UVeflSyK7nEgpXItffpn16ILwFxJatgp3oQ
+61wT6v87B30rKzQaVAXzUkJTXvGBPtEw786Ki7NKN8/a7toceSnvTugsAZh9cPGqVGctqIZf37wp
+sh8Mg/jik6gzS0Tkd1gYjy3E4Ga+RSx11mZldjOvNipayuZWmtnPyuwVlllJq2k5+JldnDXJoTZn
+ZfKvqiGzbnmFbw8TF/bin+bb+guoG94/3eRt041fMt14sl15EK4U8YWhjgjZ/MJexpIzXVcXIIpX
+GS8lmZKMJUlQYTpnukYS4UUWlVVAJCq9BvwgqpuYyk2pkxZo1i7wGMJrGEPWuJrC/tjreMJZeRrc
+Egc+lxcDephldwo8MwZMi3wdb3Phxbafs4eRA25vQHODtDbEgJCbGiJseJjJyIxlH8TGY8tIyHkW
+viyiUIWSNxBTIefbNrNGnHUbhHLL4goB+N66kcSeTaUZZLSF4rMIRPmCy9ATTxGWSBSNuizFpf7o
+ycLPZikgJogzn6kwyOAUkjZFqgPMg78+B0gDEJeoqYThCLTQIDUmlTBIOHd5vfV5PeQD3LMyR2m3
+1KO4a5C6lCneOq22K9KgYTTnzrXNDCcuoQWELqnskf/2fwSc47//BoRd+XEcNOKx8oOAIxATz6uX
+Fvu/htghonz7iKmniH39ppINy5WTSjZ4x9snInA/LjomTqZLNOxoaH3EcMCOHV8cPLGCAVRQIeTx
+0vcyJo7XrGkhrz2jci+zHJQWUkqYj8DXoI+sgeOrhZ0M4Cu7PwCmj0PiT2gqq4JWFBivSbFKfXLx
+BmwKz7OphMGTCKgv94clNg6WEFHWDzG0XAfxGOC5J0aK579umxBx6PpeGj+/zsw5xt2VHwPyhlIw
+M/LICMUnq0MW+2QQfxEfEZrwQ5QGqZOl/sAiYXO8Fo3xXPBJ4In9rNRJ0bxEy+mu3Vzfc5a5XKbl
+ldJNCcsvtd50aDJS4geTBDpN+iyjkppxl9oKy0B7EXVGFyLAJqyNeOka8cTR5iJ3j2SDqSzKRMBS
+7ShbrjuBEi0/E6oDpLrhmp8QolA3DFt03xvFvCXxG4W9JY8L6xaWPWLv/zwP7TzJ9QcnkOnOvwwm
+ksqgqsjA2e/bydnvIS68nuLnZVlIZgzB3ZhTYi20Byzj+LfjIDg28mGEv5rx82+OnhUSUUSYPiyo
+5E5lwyZSVDN6Q0iNcixkxMmIoppN8nVpYVk3dm6ZBokz7zO4fQJZxSBV0JKsmJxQ47O4Z2Dq23w/
+PA/V/UBGh7b7sNYcUsEDH5dnkiG6H7Id2KFibLIUg+YBuinqIZu4nj0j/l9Qd65v8rD5p1tV4gwA
+iCyZq0CMBmoqJX8mVHMNknfQNMHS5PxjIKaC464QjBiXLM3QDMQU3WFwaI27YvH1GCTGgjMMIfKD
+LYciJxzJL/1OvIL6ygNQyvCUSDmMJW+HMEVpGKFHqIiAtd/aFgmDLBEBYjqTShg2it5ATFlNWU1h
+cYfBQX7iMvPwtq7sipqt28LUPUGzwF6S+DVNltZ4y0suLggh8of48unrpiAqkgj3NHy6MkjdvEiN
+sgiTCqha1BrJQhIAn915EgjegNKE1RiUz6ZSDG8qBjAdYfkxIG+oNTnCIh1ScM4FgElC91EpBmoq
+xbDpbsRUBo54LVBYIvJOBvEytE2oCg21GEkf1iP80CDx1FW+opibzkFMMNrwGKPjk9/S9fglaqNq
+qNkLj4gmKdGca5fZ7tphx1jgtKsOMFxa6AKbaHVa+1VG2+rzqhIG8UR/yME2rQGhJNZ6rVKJVeqw
+JtnYDHCR0bTqaFp1NK02miQzTBjreYiAlZ/T21zirpRB8COHHga1SOV+7Iia5HJm+gkkAipC7i/Z
+7skh3FiEJ69HUuZWa327xPoBe0zFQoYlDJgIK/qi5l0EIvnol5p7h8IX99LJp2Pp/Plxm0GP6e0/
+NvdF/kLxGizDeNJo0hlljjjkORuhNFlHsohrXizNgDS5XQcNQixH4UJnF+QFsAGEqqDJ5nAqNhRE
+YcI9DplwjyNIATrhCqm7FMI+bKqyTCyOAz5alD6AyGCYilFVQo4Fl1aKq1WEpJLLJACLU9DEtco9
+DUV2eG7uXx4x5RlUYM8SglcuIlDkM8tY7iQfr5oVTSTyLCpx8ZNRczoLlUGWwvI/dc3JbMA/D7e5
+suST1yg8EouX83RAFeIFUfFPnaGKf2ZLkH2gCaviJXAmxC4Z3e8X2d2EkIAWl84GBylDxh1DMRB3
+GYpFDm7Fq0tFYzZnEvEbOU9+wnhNigzE4selCON4zZp5BilRmxy06LDl7AdJrOSCQWJLDSHNQtqE
+8xX+zWEqg+AkGVkGU/oDI1GKrEtxTxwmiq8byVbk52gUxFOObWUc24pvOhJaPlQi5iM7kv/IxcHi
+PzkPEK+sFxUKxgKnoafUg6GC5JuKmxzECsVwqDyGCd47QigcyidomEgxLLmphAF16TvlJYDzFT6E
+y7WQ8oPB9yPDSluAXSGifyPqEOm/M6RBcEHmEfe6SkQlYm5yY47xEhAD74ecJU47Cw5ZZccCm2W8
+lHTDK/8zKyhDzvvkS9AK8TJMs31Kh/7AD/YouEFaC1aq4UT/KFqGIZ8zjhz0ne+7OtJVvZIzaSOS
+BvmBZcLJd6B2TJa7CkLm8L0V+r0X+qVBfmCZcPIdOApF4zlG255Ij0NJr9eX0RHJLtUXnCQV3jFk
+pzgoyWSJ06IiZ0TlkP6XYylLMdSmEgZcUGVJ3qgMMjfNK0uXHBKGZcPlTmMtZKg3VyjcF0VmgxJw
+ag+oINRH7jKw1AiVTaUBPj3Byqo5plWl7PAlSAa2sCihWlROZpW5rOQVlyLl0kEXUjl+Kw2g5eCS
+Qukv6GwG5A3VrzM80RVyo7xg8q8bJZXklcwFpdZNDjr82/EsdVyB0FPsgga0l2yrFunIOQpekLgT
+UxoW/aXxmQOuXJS3HPHfUuQbrxoolUFVUWrbUXJkJEPx5NLl+mbhj7J0KYeUL6fsnvVZchcbB1y2
+dITcPqvSt3UL4hQsprlVJRuCD7tIfr6g/qB8/ErW5ioeA+BrMRX7W5XdCVdl6khaI4nwIotIOMrZ
+e624lsWSY7gE3jDSlxULrNpkozMUAcml4dhUcUqJhyMhJT+sNfThSCN1l1xkSqj85I1eXe4ZNLmL
+2fQmZnvmPyI4j8YbqC2bLBaFJIrURsPkRRaVQTPCc0Md1NBUWiqpMqN4mUVa0ZYtmi0OIy7PA9hE
+HHDOhMOrfrtwmz5dOPE/s4JK0p5lja8IMyyicIiPYW2ucYdtTqojNZWjc8N9zCa3MRsWQV2wimCj
+g8+ym67Vmi3V5I6U3NfswokZVWmBILjU80nlR2WPP3F74lw3GUmg2hTjpSR9Y0yqsUfkAGcoLDkW
++8SJXeKUK11nlKJiMANqx0vCMy2bCPIKGiGOfCqGdxUhi0o4NvPTOxpAiWVxyLQEYCruJ3RZHYf9
+IYQXE/JvxOdib5eciArZWBS+mcv3SN4UEWKfx0WhN2FWeWMXxnnQWy9syMlQF275GQQjh+KYF4RY
+tL6zXpV7Z16MvbPM5P1g9XEhiHDJoLt9yHHoOeSTazmIfX44IIQeoojgpD/qiEH7kUfFWMowMSZo
+csP/8wO1ym2TD44+fND8oJs/snz7tC2XZEBGPbInuuTuAEs2Yj10eQ655hexB2d8cVUu3S0BbEJz
+rphfTSXbd73+KFfjrj1/RHA87hhfBRdLWYrBk0o2IMAdb5YwyFkWgE0fx9PB9cHx9vrgPgtLOODA
+aDe15SrSF6+OAlDnTlh+C8VrsGwlvZ7Ei1usa788dL8U8Xv3jOlsBnCPEAUhFA6xa39lCvli/vjq
+5PEdM4csjL+yV35xX+4bAy/nvrtTwbHXY3vmx+Yfm+f/+tj8Hh6b/68X7mssCLvS3o+NHhv943sQ
+4wNjjZx3y0YGFbTCgsOeQFXiid5wmLnuzBckrogoiWMu/Dk85rM1A/KGltHZGlItblephkoGYtqI
+1Mif5jNCPD/XM0hSSbG4pjFd+DBUvyIC6iuUihJWcsUAkXhz2QjGTau0yRStWCYcrtoHm9U/iCdG
+UQepcrAah6nCmoHM9SBERbcfBmpK3kBMVAdYEZHfKDJSYyIDb1QGDT8jrSAOBwISmyWOZxiWVTsK
+GK9JqTPztZyukiQNtdmWV0VqkXIjA5jwmwpGYsSzVkpqTCph+O90RWspTH7WtMWiIhVuFQmISbdw
+wQlBp5x3A5gkXFx5Gb2lbpWaSjFot8glLqYScG9hUiRK+71qL9bmYhxUFZMfpEbdnvIUoZJGF924
+jRwWwIPh83FB+xGo5hgN1DQcJ79LpRhC8gZiysUbwMSJuICIzRaRdZR8I1U5lSZ5KIkcqhHbJgLP
+KjLyUlopXoN1VxoaqS67OFNtipY4TEnDLeHhFiG1cBre5Adac0iVsfW5HHRBQaFFmqmjtOh0gQuA
+kOSV1AuXBXXqK9VdKsVAIkTlVhUJ9dADgkd7kmuD1FjI/UxI5abMXloz0+I16eaZtVmNfw7MaCQ/
+izRNpmu98j1f9R6s1ddcrbPa6Cyg5aHZvyhKizcR5VwHiddmUvcrZYkI2ilhWLS/gxYaRr3DXG09
+AIDUSHWieA1Ot5g5o0LJW3tF0ZTiUsPyM2hkZQ++dKVtJT8nFPNHr+Wywus+UNlBXBS3Wt6cISfb
+zzafzBk6YaDX27bI0aBtlFT6vE9YlUPdJozXUPYJzV2hqDSDpPwJqPGmmwEkbnyJrQN0aX7Tvmr4
+yPime0vvx/xDE8L84dMwATZBf6N73mj1m1KUkQSszVg31Zu/rAxQw0emE0ExSz5FVAjNPpguZcgo
+fY+CpjH6lna/Q+UzCeofhudw2kfflxz63teHdKmi3LN59n/igGehJ2f+JP49g41vvCwi627APoU+
+IqDiPIYWx8NmcS3vPXce23hBiIUXWVRWAZFo1vKD821amsM9TSaT7MxP67HAjQdGxGT+rSwjMcbQ
+Ck0oZvw+wMBhLjShmMUTi5kOUmN+OXCj5Xy6x0ae68yf02Kx5dWgKh0Gx/CT+9uiiG8RkT8GGiMS
+QpPqLKMrWZ9lZwRUpWYQFsMey/UhLwJHb5bxUpJRSTK/kS6Rydv0SPNqeSgElUO+BsECZeklBib2
+4KIxcAjjhmTYUHzxjUABmCR11NrwooS61wtr8H4CT3hGjl5ymHjpweElx4aXHRpe05FBnqYjPven
+F8+uK1dwpRhcYpC7Y0JUFdk5FCei27fHRsHTig0cPEV+Db3ji69AUPChhy84vNDu8KL27XLdEDYR
+lAzIG6LO4CrEco98KZ1wvhISYREQUqQA4IsDkPFSko4xJmhFM+OqcX3lTCPYSUYoEUt3AEwcVnkF
+vyPW2xidARe+u5R2YIBy70Sub6TkIAiiitAFalw5Hu8cQXJrYsDZSwxLTiIPzAWK5CcFFVANKVXy
+r/TD2d50nhUrMtuvFw1NYo4cA3LLKo6NCoFbyceMdXBYJub9PIbDeZXw5F/fVYDphOufB27+U4xP
+4vPyGBdnkn0jPXOhQWJMpFINYVHQG7SzZl6VBvmBI156KOoyQmg4tEFTmc0cvrfivzTIDxzeVl6B
+qEeWrthyJAMk0IytRVgCsYyXkuwoxpIYw5vfnyB5f4L0/QnCaxJ49IFiPN88dOOFanFH7EixUxNh
+umSx4zw37luOPFHEXWNglz0MICfDcZfT27ifnHGWbcTvihI/qNBDHI1jbrJZ8LgC4WkFipwVDmf8
+4SAIROEwwjJeStpJx3z6F+UgGOXGNEV5nE0gXoZptk8ZyFUYAEw71iYAmDisIf2EDrLv80IQLy10
+gVevQDwf9nbz+wr2Mu/8mu79Ld3ba7mTUofW27JzJrj97ZqbFYm5ljhRFYwh+aIlBU97qLSH/05Z
+bP42IdlOzT1zDMusYarvAwVXDPZgk4+in7gODsvMqIr0/q6dv8t1rV0va+ENCsIbFCxgxXYfbxFP
+LwvP7woP5mT23q+hn3hyCcvEUpS+8msI/9pcM2DTwQHvJP02PU+ZuGS4I2iBb30qkZ948kDBPZv+
+JEHv7LQQwheu2QvKWQTf7eohdtsOSC2flOqEGwwCdZBF90k+cRclvqFCiY+aKTmuZkpOqpUcJ+dd
+6VJiOLCaZ0leSZIdJOWWgJAaj+NUAidCO9mjLhtFBqmJ1JLXU72TD1dCUlkZMMezVEOTUw7wRGrN
+S59BoyuqXo0mVK/dGQxTMyOtLl6iHC4theAmF0qOzVFtvPPg5YMueGc/jkifDfPD0dyak4GLhhWU
+xKttMacJe3TPtDgOZNfvEFBocT9qi14EKYRFIa1CuLUOvATY8OTALYSZoDyxQ7EUQxSBqR/UBqmX
+yBKcwin+Bd40JDe2PMOCF+0FaRAywzK9PGWZXp7hjeWIUDXM541505UnbMzLGV0i1Gg5I7mkxE7c
+ddKDuM9R5D5H0V5Ef+l14aLXhVEfck9+3l2pAl84tgiJEW6oFvnQHJ4gnpRqGh48nxTZCKbPrvGa
+FDlCimoxMg46woYnfwTqILzIRQUnBV2M1vCVlkLYilQyi6pZV2Tc3Jl4CV4IuzFLF5Xg3LKIl2xZ
+wvfhQRJ7HlLUJ/A8WQIPIqbxoRgokYTWARWUOVvZfnKpgaU88NWVYyvZY++dtHpTw3JX637TMRR3
+SnSqq/Atov4DTYzmap0kGC9T4BoSdrE9NBHS1n00dtcmyCvkTIjJ1YkMWI2W5JKnLxMnkvxTQD1S
+cFVkIwNvZJGyozDmNCF7cFHZI8zSSxmpMxqfR3uztDZj+GWsLFiqXWTdVcIAf8QepELevhisMdLv
+Rx+C7kzupkTV9IMrQ6vtrs2R3AIebEWmcDlQy2OHkE9cwjb2s1InZUpjZplCWdcja6kUX5Bcg6rm
+DXq77dh8jlDaN6+Q9cB7loRH76h8ac3vgB75ckbw7WXW7c3DqgbeojXIWx5K1TBMfCk1g6i2Rgbe
+qBhpJjLIFEdyjDhFdj8I27wi4AIqdgeWbJZC0J8Nw7SLvuAOiyg+/xmEFolyKV6JBF+R/oRnVHV1
+O45OomiKlRLpfDz0OXZKBi3+ipU5vlt2KsM95bLLS+03fWSfrn2QNTNdU1WPLT9p5jkiuFnR9Eej
+4tJNqZM28jZJf7D/mtKGwh/fmpS5s9Ry3fR4V805L8HFmzKlPPJcsVbOpZ1FG/69VolsodtO/WXG
+jc6i1yCEpHzReiI8H0bv7vTp/zy0+dlg+mDn/VDrTZbLn58A7YdnvU+USFbkHIEvj/bR+MctW8x4
+ooj+OE9LwOmpcbxmjdO5VzxHGixO/9jaVEjSyTL1j5PL6gCY9Oq5EIwJucp7ZUrITD6HosQJuDf+
+IB9Y0At/sDP2hJd3KrrDRcW7rVdVn9aiq25cO3kAir7PHKPbOtFP6PB6bK+l/9fHtj62tT229f3Y
+1s/12Lb62MJjCy5Sdw2Oj0ssiOHj2uYeW3i6ZQsqe8zzyadpAV+dp0GTsRpyw5Rzmnk45TKhmoMz
+4GShLmc14CKWBY8TCZA3RLFgeJ6HCPHCr8qA2OOHA7pEwMZrprD8ZMTi44ggOHatZ+g9dU9PHODJ
+VgF2IXnzK/BaO3jMcZDxUsK8NphUayphKAihIOQ80CSP42Wg/t9LI9ip8JculwldvCnxGipVY7E2
+SZrXeB3bhDe7ZTrUeN0MddKE07Vzf3Chq6tbyJW/6xPWlRc8gb/Z1fPhDb7y2A/rhrfxBCoolyRw
+qZQYvqMkICa+IhHWbcGHrJQQS0WEqLWxfLl4toBCZHdUgiPejwjrpl8VMIzXpMjmXvF8fljlDrAA
+lQmrMYakMA0WD9wfUSI/cZl5csfoEEXsqKZV0AbjuuE71p1Kdt4AkQgR3VyIBuQNtbiGZ57CisfS
+IbV1HcmQ3XhyDP/yE6IsyCsUgzroH0GUJ6TxXf4QB57DghB2DpHu5yx4GzxEV0JWedZB8RosPTZp
+pHoLAyUFC9wIYtk7LnrHIT0LRkN8hZWrxyM9arL4DPjKvTKsCx4gCzES3PGbVZ0CJu6IW/xIDdeg
+1Y5hVFk2VIx7xoWLftq0/KiEj4T8m8VGmJRjrN5FFI279gKSRvPkFyohEfNx8YeKoVYD7bsQw/XG
+8JaP9ch3b/gXFLaw938X3eUMkhE77JFz2lnBCURIjr8EvQV+sF6e950UYu2Hr+MF3jj8wROeCpIn
+fKKmk7wzFWQv7oK8glpQbd27kw8c9iuwDPZI4WD4FZxyKMEooex9QjCeCHnBKSARKppLg8KEcPho
+TT+yByUUysaVEHqIAoF4dWGNn4oISXtPBwdPJngqNiRpb6MiU6FiFYaUfLHB+bJR6GPoeLq2qWQj
+TjUh2bCdK54iCHKLJRxpknA6+kHvBHK9cKkzyKXOcByBRBSRVaXEcwWPIyNc3H6EgZJ1XoqslYT5
+OHoc2Ze804Tiwr/uO6GZcTRWFHO8mgMdubn9rDdF84ToG4R3PnxhWmQ3FLdij+ClcCjEgedQJggA
+O3PaIim5CBnkRT3y89wDwxZaZqhbULlzSXXZWPAL8JCyjYw5b/4/HN+DFTBTjj0rToQXSgPeJg2a
+FndcIdnQOHB7SDSoGqKQFrzG54XvSc3s4qRVvlPYVZxGKCFLvX1m6OJQaPKpbeYRIXnjOqlSVVwM
+XJwwXpMifQoVk4NyG9ysNqxZBsM6vop61385hOW37uLflnj9sv0uR+MhS8i6ZYCWA9Q6c18Q9JY1
+OaXhu1c9xGszeg+LZVFZBUTKqkHIDywTTr4DMd70flgHrHWaFLbhym5oOC51gW3LVHelRoOqoEje
+njxGmlwQls97soBdSm+ST5Nc3v2fOPAcshHOl3ts+2Pb+3/u/93ax/Fe82Prf24NJKJ2WTjwCEll
+UQkfnK0IiAniVfgYqARjkPSYY7Nd6VeSkiuJiJdAIm9U64SWooiUjDlh+4akkv3+cIC8/iDJH2nK
+H+SEs/j87P987yfzz16yqCyCSjjyJ+BZwBxJhBdZVGq8SE0P9UK4ZTReUSJ5bxZgk9wJyM8195wG
+cSw+aARJXkkKTFiy52dCK5O1MI1WpYx6ZQ74Iav8zGxCkLul73Hyxl7GaXeW0259fS/LB9Sx1uzh
+lg8DNp097Dn4lRAev7A+tiH46lLmQdZ9aHE/JtnoOcifpJKtqA1vDGwJdu6H4vx6OQ7Ro6/anOcd
+LvcUKxtXnpbziie884q3hCE5gl3xceuM7/dlPCKX5RN+WT5fDcnjeuUX0DLvNqu8OijAphZIhBcJ
+8yUZfvBAdoe6qYTPh8ir7IaNUswbgWpz54u36Ebpm2OkwsqWe3W2/OP2x5ZD/+dWyrfSsn4oLeOS
+ScaglKfdWBZI8cJQ5cMNr7ozssFmwmj5CRw4vteWfzA9/MjsIKX9hBZ+OPrHBZ875H6P+ux07hxW
+1zOKT77b3yUPEn3ZL8eFn+zN0XOAabVDjCorg2zX6Ll7Iqa7KLOd3JKGhIEv6kCK4U3FACYJ5RJo
+jv48RHAM14S0StxDmCJ4iZ1j8M4keaOiJHXquOO1ikmpqgEklkTjXtbzxjxOG3PUTOsO0USExQAH
+6Sw30XPEWh/ShUrKVAaZI0CicFrFIOV2QmdFKiNWUnGxMZAIWIOKJrnmjwh0n3y8F6Ae0gP66V6m
+qO7aLYKkikp8MihLN8QL96I7ycQoBH+5wpsj9yimmrgj050fNsgxL+6sbVAFUuJyZAkqUJWObRD5
+wWGZmCZ3aSqUyc7NkE+nCXAsgoZQdiwGiaCikrySlCEnIDlyai7gknkuXhkTd7yySHktRskPrIaS
+8RcduLuVRBSR3XV/FhIBlc/jIdnAsaTf9gJeAiYlRn4tc+IpAtWB0oQu877Ud8m7egJh9hziB7o7
+yS4EgAvWgwLptyK1lx2boTahHxIYqcKcBu3EhzYZfqgMqopa78wfzOiw53JsuPzQ1YNP15XCMrPM
+EarTlCTUNpTJLv76YxXCTfNt/KxChPJJVAbB+5AhwQATfIp0d5GsirYMb2YKSAoRfG0C5+49XLas
+U+NOeAdKwPpYFU1ynMlATPi0sBLNXGae3K3MJmuDjoX43GewlNl0ODVZ++yEh/IExMSf+RSgMuGI
+12I/hRbzAcMJG+uy1l9T07H/6ENSSvFSJgM4In8dwCGSbHMQfGS234NkowVLRUMKXqXYs1qymThp
+5sBGdR7jOM8DOd9Gbp5Ga56Ga8Z4pTopYVKaZcbDd2YXZ+3uJ3mXrOlx/z3v8tX1Cauy3pQ3zRLg
+ZtXgeIvRnsyrPfc+VJrQT1xmnpLTxLJp8jo93n5Th++XJvQTl5ln/4mtHHwfWakqHtsgGO2KjTCZ
+WXsq5vWkQWZEz/E8OCgsM+tIt9nyptSh5XNSkIQfb4CUTPXRBqAB3I8tRBpUBfOTBg1jcBNO5kUx
+0SCLl53myNEVpUvnyVxWXL/Le4ah0h+Vp4sTyqw/tDqrNnSnByRMi7MibvJohKGmaHi/BASTPgth
+iPzOtJyR7an/y0EwLVsWwVHeccg2fxaXDKpSfUjXJZ0rsYJJwgUOEjYRpLIoWFQw33gJQEr4JDKA
+5yVnOULkB2PvEEW8PX0MxCRv1hqqWXLC75UPlGjLPPaTedT/wq+aKJGfuMxch2L57PgcX8cjNwMx
+qTitn4WniEgzS0Qr7gDhy2BMma/xoOTgCm9oqcgPB6i6FKWl/MjW+VFXaLxpcjzjyVAQyrmdkMy0
+0C6hIvkxTtZzn9A8JB41y4VIpXhQUSmG8ArLIDWi6lmcziLCqnve6wt14jArl6BU6tRanVatU+t1
+WsVOq8QJC4Ktd1/mxQ2fM2VPKqQ22QcSISrnk308d5WIyFmEZCG/8cIED4SfkH4MyA8shpqwMxL1
+RmeSkE0vDpD+hbR4AzLnl6R/4edqBBDFIRt/3CYCNkLoIYoIxEmGyOPnPERAxQv1AjBxRSM+IimA
+I5NxNYWJ/YCc+YF6HKjHIeUf0YmAJ4fFXSJYrbTmjwFMWPKxZEPjAEU0Sk5++kiVC1hEIEaqwHOD
+XHvpApnoV8iyXozJfEmc28JnuWjPga/mQcZLSYbaMX8oDxogUh0QL8M026eEhUNwy3xecJDMREfg
+gCt78NLxwFfIIOWtUHnpOh+SGd5S09evc395w4tkO4Il4zxBiPzEk4fkgQu7PA8WtxBEyCwrh3gm
+myGL5ANo4WOD3CooODro/YFCGx6dUuJIDMQSXOMml/DNSaUZmosTxmtS2Gcnjt/xFKAA3HYckVnC
+9/w+8Pm1XNKT3G5QQfx4hoCYIiVPE6pZLiOVhF9SEZBYLh6F49jXhQyMkiQhNRGwBjHLKVBJwRJI
+J6aoBjm9L+kIxwDEIcSDOwIwnZILV443XV/MUlUKyyAXjSeH2iY0M/LD7/8aIfazUTodMDTu/EIJ
+H3AzxMYqeOpYQJtuCg3VSNJ5EaaPxDjuAXRUdIW8kXpqnCxnFCWNXMcrJRcRrFZCKNeNO5FLg8QJ
+Z5kAEpsXwRcfSqVXpLopBvV6ofxKeLJNSWLxrGKn6Ph5HUNx0AFT9YKQ3lMrlXatqL1QOymSaifx
+OaKTZIcO/ErF4bPug7XaKjZtZt1CU8o0yA+UfociGegoBqmx6BWRUuljNf/okx6m3Jwk8Sdbuz/Z
+moe2bZgQ5MDUJQZ81YygsXOTX1rLBRcBSgvLGfF79jdNdpqboc4Wuim3qF9pLSWmyVaokis8uRfs
+UfjQMC9sKn4fBJK80qaANjGyO3HAKeBNMrcA4MMhuqeLYAaVF0tkfXCw5RgOh+yPsJisALnHrXgp
+RoNxOeKm18lAM/tZmb20WqzJ+ZVqo9xxleKmT85fmtnPyuw1F8f2xgGuT1Z52SnL3bPaKIownYqB
+eMrFx07DbfhNjpOnbqWOl4AYqknNTSZaJs0ClxdYxkupClhHtqDd2DSh1szqJcm70juj4Ry1CzY9
+oy718XnmrJ9nzk3u8ja9y9uW7ZB1DXqu6VXwpl+46IR9vQMJcMQLi02W5JVQbUb2wnTQ5IZF049N
+KsVrsBxxJu2fu0oWDchnJQNvZJFSF9wlbXJPXT5mDqmGH5WSJMrZSZOZB53etIajMkfENUQATHgs
+uIMKtvObb8JVtl01gzhX9ZYubZRaboMkrogQFXXEre4mY6nppuDxdPqQQpd8gDtlcYrV5Ikns7O8
+dtklksoS80wVNwL57PYsa3S1DiJwQAhFjr0nf6FSzkuxsc5+/HZtUFWUylS5fQTQWHz4zpDKTZm9
+pOpDmyONcU6omITtNtVQ6qRZ1gveHjYkPyl3r1EBVpWtC5bRBZLJS76RxqwdqYuYs8p5FkDi5Ka8
+EJWZJxfFs+KTtpMiUT/8xSQlKjNPLtZEfVC+M35lTsmMSUEu0BmbR6NBatRNMr5bOmvmlkdXgSXi
+cPIE7aRYVOGXrQdrRHSpTajmkk9pl+Qn97pOqwY/ty0gpg8+Via4W4eJolHhRYPsLqPql/Lb8sIN
+JyEqM08uQC3UytONgaXPeW1pS70l7/5PHESElUVBKL+WaJcE3nhye1wZkAsD781JA/pk8enfWM6f
+f3hp9vmH85dbaJ8n8UL2490mglPxpaAPO+KM/oPv4fRnDDhElT64adjPhagYsAcnwlH9gwPnJ2oU
+6vrBV3XzBxkX1IC5Zi2Ab2L1yuCz4SzICyAfproJ9fT8dlu+2mM7Htvxfmz/Pbby2Mr22EpuG/8g
+X69K/3suj622x9b/9vjgfai1g4P62E7+Nb/TFXwaVKBHPPs/3js75Sm6E4/Lnc+nxJ5PWK8e8u9l
+smAjFYStC56tWSCfHvSu5eu9T5IfVDHtYsZuLEDekLtFue7GPYfu5ftcJO8InZ5/EZOFqkVkhUR2
+nq+knN2XHeVc7KTSXBfMvV79GTT5UdP+g6ZURNYGiHCSPUyJlFeWvH/gN1DPVz+XPbu9LzXPlffG
+c5VzagECFg65KDy2dq5ixGNrp7y6xQff/noefp2nE6yBi8g9YMNJKTeDbuoJ+8g5Q/9v3xAzL91i
+1/uDXWdEwMVHnJicfDHxjD8cIOZHYn44JnLgno5/vdsQcUiALSPL4lPWxCd/N+CUr1id+hWrE1+x
+OnmvPfsYQJfu/GIoi3gJSEcqkipFJHfn7saN1EmpqpGBNyqDhp8R6rnLRVsBi7Uy+W6xoMbqtzgm
+ZWRi+t3btGBVaNIDdo40KerzJQNvVAYNPyNr2A+/j9NJ+3p09dzTfFdfQEwsMEd0gXeVGJvKzBBJ
+hBepRUwXmlgpKtVgEjnKcAJIHNf6maOHy7mKWKU02606chov79sowVjOXSUbuM7E1xYgYS0B4mQV
+nRI8X+zS7TOp1XQanlQmnDwMLwOpefAB35libMO1iWsUmYtKeER8gkPJDxzxWoZwvIaW08yaxMqP
+Uv7uZBPvKIGvD5/4aBcLdt+loC6fofiZ4cDP8EFKRvpM86k/Og5AqdgE8lLOueeE283njl7Isu1w
+145l/e+kCcVZSshF9uhcbI/ORffoLL9/qRSvwclirAJyjVXw5jBGvGwlPlNiQd5AUuMOnoB6a4XP
+UadzrtR5r9VQSQ0sDw5wHVWAvCFcxLAhVE0qcGyFLgMk2CDUAxqGycEhW04JUaE39a3Sxxp/w6yH
+Uish8oMrUE0kOgpMHn3I8pWjcVVo2yAz6mePZ40jOc9VZ3KhKnixpCICBa9U8FQqo9RKJhn9SeMz
+rSUMqE0oXgKy6RRJFI7mbvlB6h8t9ceK+pGifqQA3DaH5HLQgbwLoSX14OUz/9SG/MyG/MTGKSdC
+LOWGmP7MBqTPaUL5VIz9QhKgiQ8/RXziCy5nkvM0AdSxSYhlQAcS0MJzUirehBdZVFYBkagvgE2R
+A6STZJoKlSyaZiRByJHcnsLnAiyQUXEyBjtVyEsE1LBgYSMkRkkdZJdnku0vP1p46tIai2r9iT8B
+pOJtWxAgTeMzf8h4KWnObbrDehbZpMXm/9LQu016qUlnNu0Y3lTlTLzCrA+5LqCSBhUlJGSMlxK2
+NJAMpblg82XTxgHyx74vv9Z31h8OEIX9o8rOUGVnqLIzVN0Z6g/2ufqju5yQuknOoeLMov7Azmmq
+CwmyiODISmImHme1Spk18JGYP5J46u/mnRUdXaWj5QLuqRdwGURKZbA79drztdhT7i+fcm/51PvK
+ALOgAR2FOIazwS7fmrwkpwQHyUtKPR/b+Qf7yLXyiez5/bpNBHkBJOpUH1v/bMrnio/tcktIbTOo
+nfju7uUoORGs4qrr5XoFryffb2Rhy/tZq6bSoJGCRjzXS3gfZA7tUvreyvney/nSID9wxI9yus0T
+B4nD4p6up/HFIYz8W1bgtJIbVAWbSjGIaKTSK2gMyvdFGyEk0Sd/p0oJ92hVExerUvAmEZURPoPc
+hu7K4sozp5nFd6FjczHclGXWznrX6KZr9uO1YKgRb+oORSqZl+xzurHmlyM+MjQpFlVaqCOZ3iDs
+ir+S28NyU1y8qfWu/QOVIi3NKqmadbVZZC19N4kPvry0TMqto2eLZPHKIQ4yo1xQZKW4pZ3FdP4s
+vUBVSgY6xoT9rNRJmfJLY8yZNlyzbnB793lS5qiw3BQXf6nxmgzCun1t08oDFoYu3pR4DZWmJLXN
+PCLU/ao0yA+shtYfnc38pUF+4Ii3ZLtbEx7GZ42WTV7Sv6n/qN6oTGhuYwCC5wiaFGnXQSX/sV2J
+v5u8DLYtd+DHlYB4uHlwnZRlZhvV8rizoaaILtGEfuLJxXrowPvrhmNrHnl01ZGnfhKbfq96sEbI
+WwODbxGaTduouMM6EOo1lN3Fm6L10v4bXfxnTKp/LnfIR8KhRgNXh9G1mW0/+3NFy+co8qudUGUi
+x+FVjq5r4NfbINUQFgOpMxSLr20QG8fIW6eRt+JhJM4OW+uH+K4uPlGErxP1kH83TKCCWHB0QmSS
+qCRP6zJdLPEImACieNDsaMkuQ2VP8sAqE5JOk48qEmF7v3E1BS5dz3W55HsX/ObexWfJF98A66Fb
+dCnBCr6rODjeYuqk6NFg6OpKe3ADF8XaNqrBzWrT2Cd/ykOpGtKgYZwSyTQ5FK3vM8sh1HhylEn3
+oKJf07bKL7kUWtpNwdDtegltlKiaFrmcTxokBdCfRsnPTDcNG97UOVW1cl+6+Yp+bnOwlo53f5ji
+tUzbQNVlqCMypJftm11V+KHiWsbhoKsh0U2xDYcHDJTID7aWyQMHHfsDzdRm1gjyWqU+yefDtDTG
+zH7Kw0CqjIoPKRkerjicxMzaPTIsd7XuQ2/bVWwATYbhtLlVK3aEdac010ePEIrV2FpT6L7bFHJx
+kJUDpd2UsEyqppciKqUaxl5sKk4Z/zLRL+O0Vw+TlpD3FNzgHPGRf+hJa1UPt9CEmp0eyg4qt9Et
+Sp20N90Ui/rTBqmxH7iCm5VFlWkT/t58Q9d8eLGwzAr5mzY7XjPbSH9TakGOe7Pqf+n1rv+V3rpm
+MiANh/w7CyykqM0WvILVUPLexlLXeDjlMqGaLb+RBUeJKps7ZRlCSWeLdFt0DLW2X+ot+hJFc51m
+VlbUGafNQn7giNf6Zd2hQcOhDTLjlwb5gSNecs0cjMNkdv3u4SorkkNf0O90P7LkZcvJl+BuynLX
+XLzr9aaKVkINNPhSajTIou1wle9HK/4AtOwcyuLobRcSRGbolry62rQV4HOHMh91hlZv6nLXRuQt
+4c1x+OkmOLarylyZESOf372O3NwiQyg39wcDMk+zTZ6ml85nnVHcNxfdHmZebop1IgynJtPltHBw
+M09Olh2NIvXwKkgz+1kpN+WWZla0s1j70sx+VspNqbM2K1N2Y0ipYr1h822+T7f512yb/4yR8meQ
+yd4/pX+L6ao1HyJ6Sn4uY2l5D3RTL1XQix2DMzDTYuDihPEypbueybu+9T8UH9v3ER7hH/8I/RfV
+wz/7I/THfYJ7BLcseelyzY/gQnoEtz85CAgXEZUlwlMFdehp0vcR3EGNYxpfd+gpn8736KcE9RGe
+3CaIpJLtfzjoOT6D54D2LthQYf86hD1l5Jjukh/hWdwWOlfqcedR8mum+ujVWdyWepg4LL2yS89h
+ee4duwf1f/7MqcjKkF1TKYYzecAfEYQIefhxIJsXd4hglZO+XIwq2YonMMOCL2SIpDKoKnJjaHeJ
+BbFIoYdHv4nXZRiSk/VgS3TuuXtvbbv0Isyk1XbX5shLua8Z81ln1eJKChZR3VlmvkVw+rBEEsEq
+tzUQ1yLEninuEYiEU1oRduUHSX4kzU9P0rcnt5HXcl3kJbq6T1gHtwln9yY+emVsUmiOusDRtaIo
+l71UgZnTVLeRL27COJjrjG8jYrgv7ZSQtfMRlusR/CP4fzjgnvCu/28ZoUsqK4BEdkH933EQEeql
+/VmpqpGBNyqDhp8RKsS4G1hsMwjLhDxxqarO37le31u9vmTgjcqg4Wdk9dKSdAt5vg7SBcJGIrxI
+MWsGWilu3c7H24Umvga3CTmX1LTMNLUajEHO2ivob+UMPdKsjJjFKjSpIyPyA8uEk4ehZXpJW1ML
+P6ENUs82EqGO+F6TQlU6tkFhGQyHaQtMV4ZVo+FU24RmPmmQGTl910JegsLFItLSDBCzI3QYjEJS
+C9Fkq4S8Q8j3UjrWKy3FVS1G1GtS2s1zzhs10fhbDHLjb1MpdBNv/8ibU57jUpDoghCKhL2YiI3J
+2edew55Rjhy4RsWgChWqM5IpYWA+DTnfaRbJ8ySSZQ7JOoVknUGyTSBZ549s00ee9v487/xZ9v2s
+u37WPT/bjp91v8+222few/v/FSPCsKiUrr+izE8XH4npEWh58P5DnTmo7UqPQJer5yO8HgHPsLLo
+SfXJ1TAeXA2vV6Srix7UR1hjzgi7ktwSMssWTjZQhpS3xZhUeJFFpXiSSG4sQ04GHJclr6wPAYIv
+lntIgaphPm88nABF65ULnzAYkTLPh0pmbAbxMpRY8UcD+aMOkBKNduVuPV1yIipk9jkNgrH27RMe
+gf/L8gj/1kpxp57xz9PFR/ih/u8e4WdLrvUc4iNE1//5rv1P6Lg/QuxOkYL8nmHHmVgm6uHZvfuN
+vrw/uyE4DhYOPcLuHtjxp//z0OgP+YY+LGOk1ZmUWVaVSzEaSWx4htk56KEDSiQjtbWwu2X4szrH
+Nbop/qbFu+ecCcZfjH1B7+Igq5yq11CQXVhCUzksGKiDJZNdd43B5plyGODShDD/dwZuTt95rA+g
+XIaNJoyz3cqByqy2UUO++ynSxUHWD6peQ4nG7MHBuZMIDxmSNpw5LTeuQ2mGjSb0Ew/vFiZM602J
+16TmdFPmHN5WzAArbZRlmc9Y94FtQqSSUAvv4MplrEmEh7fSmwziZagb7ay5hFkRl6ajQNBPXAdr
+M6DkdFNmvzfdlDgVk8vMlgjT8OB4zdqo8tBRse62Ew4asb9zEOLFz2o9+m7eg5UQeogEUUTwEGVS
+eUGKWolfbJwYETo2DVFFVS/FqGAlMc/exZzRp4PrUN40c7xmbcpMvaS1IUndMSoZVpE3QmRGKK6Z
+xc4B4/EI+9NF7IhKfmB3etISIhmYKSxGmBZU6S15hieJ8CKLyiogErXuIJ31LHZOMJidS15jyINg
+PCWHkxB6CI6UOIrePcL+eoR9yxw0Oh46+QKaSa9QBZBJaForIYt+06B4DZbNOGmWZS4G3bS75Yyo
+gmK8JkVymlWCIeHsTWkYw8SXUjOQ7NNJkYGvzs2IDalanBWrDT/wpKAuZ6HZQUeHKOxErsq3q2ZF
+U6kBrj44bQuzWRsNipPVsjFNUu9ZmygoqVIllcNiuSRe7gNqE7JGMSNRkWz4eyECRaEKBG00PlQh
+lNMg8+RRtYcVS0+lqthokB844iV/cE4zw8mGThhDJ+ggCWOQMGp/hLpoG/IzDAh1kJ38D8PsaNWZ
+1Cn15El+4jLz7D+xtDmPFaUqozZf97dhqt73Xr3vr+p9J8+pet+pet+5et+pEl+tnifdfoLxmhTt
+aqhaGS+jj0Gb5vHJQUWQTiQM3qgqaS3iyDxOrSwuqgx1H9gMrfhi00Quujvl0jQD+d7HxH5W6qRo
+nUq75d+mAuRzHhP7WZlSfOfcplrB5e1uo+M9D4e3DYX3PCNBGyjOpN5nUlEhp0FuikWRgTeySKn6
+mayGHS+B75zt95btlwy8kUVattnV6q6ZxSc/o3zMAeqS94OaThGToU16yftoRvb0CksYx57ZdP0y
+aC4r4QmWm+biXdfc1pifZwzpl6rRv34LCEY5mxns4l2LsyfVSantpkxRmkQeHNEuqrk8R9PqeRyF
+anX/y1J/mcj/Mth2HpY3/W3RfPTWvWh8564zDtD8hhgkEhTd0O22N6h6qRJpkO4NrHWPVHk1xf8+
+nHuXfYt1Gd0j7Ef/d0sTQV6gv2ECQjWYchpUFd8klIuBRkKGIkJyD3z1hUEzD2XHVlHUlJCoqotO
+VBLhRVpioHoVkaJrYfGQXhO8GBNFlZJvIkuS8IkgxcmMvEsT4ayXinZmaVjTCOmWNPUaSjSWAoq0
+pVarcq3Tlhb1ElQP0rjpws2kX6ZFmnDKM4w8tT1M5AdrHWu14aB7gNDI433LHNo/d3XU8X2r8dvq
+27JKRLYgc5/iMA9qg1AdcsumcuxKokZSJm9UBlVDI+mGjjoMleGzLPMOPPTLtEgTDiep73LKBdPB
+9xjt2lmXbNAIL6ePQhIlFvWRZnqNDtYaoJtYXaTpEaHsIRRlz2OoQuYLQ/IiSEEdkhcPauXeccNy
+TXqkm2KdIQZxDXqcNK5DaRPOCaSdaIxeYzGM16RYsVCNcxkk00BXSliGTwl/gAuFtzWoLHS02/47
+m67ZcE8R5zit8UsELW3Q3JlskH3dlDpplhMt2knMo+Wq0aQLWtODLlmN66S0mcNy13R6VMtIONnV
+qMXpmCzBRmAJcbLOZhvxwpaZQj4nslLnXbD82gFLqGNaUWXyhuGmSbbjwsFQ6qS1mS0R3XcZM0zx
+kWYedWFd/cI4OvDjqi5OaNlV+TqsKY1m9rNSJ8X6HFpOd212zeWmSFQLU+e0MHdOC6NzWpg7B5q6
+neX5q6+G6ZoNkW6axL3HYHjPo+F9Gw4trNlATGeuo1Bol/JZB93MceKR+3kfbM2Si2XDZguk5QVC
+prIBQlpJpVfQvUC5qqKgiaW2o/Vz0+/7QaibCPGsmznWbfhFdz9Ymn6ZFmlCzQSqOKWm8pY2tZE4
+jYWaKVU18kZFSdoc5bzgiO68L66G4RpqpIlht+4FzTUUQ6RJs1oOtQ6d/MRl5slJqz42ZgyLdhKT
+GRsN8gNHvOY1rv8aT05vmjles2ZbDDfkBMwntJvDNDKC1ojfpgchJntS6RU0RivMN4AhvYL4lOEj
+t6M667oXVBXfNAhG+F+SSY6hkUG8DLVZppCpYRk0UuiDIfuRE79bbANF9Eg35Zd3pJuGhDhNycVG
+Wce0zFiN2yBLbltdWd3JKxQDjdOukZOZXNqpRwrleM2aNUX0oYxEVuCZqEw4+Q4cNRhXbVRDyXZ+
+lasKL7KorAIiNdNqo4Yn9pwmFNf7VJFv52F5HGnz/TxM1IklQROhVdROr9bp1Tq9yhGUQSNV4tqD
+EIy2Ts5jlcwYJr6UmkEcNvidyaskI63VyR+FGJ6ANy+BJiQ/K2VW5hSaKzSdYCeVnfnFu/lwooZI
+d0373yyzd5zZNukw3HzvKS1f0pkW5AeOeGkTOKeZ1QnXKYXiNXgqSDQa+sgs1JHZmm7H3WG4hhpp
+5lHImqyPOjea0E883Efr1jQ1b01z+6orIU+oZpOaeZV9tEwN/bXLDcM1VG1PrVadOrqH0f2VSb73
+i1gi/VLTVM+Qk5zzDO0e2e5aWO767Dz4TRPGa1Juhb9vA6BW3T2UNUKNYgh8N3BCMUvioIsPpjLI
+3LRPgxYRak4GmrjadSRmq5wqIyko3xftpl+mRZrQeiLb0r3k/WjnIImX03wGdTyojAyOMpbRoonb
+exT6vhf6VrM0N79t+adsnkVBLcHrVGzKHNVm1hq/tTPze9zgmDQXf+vxulvqpPKzKqLRID+wTDhS
+jnrWqT5fGuQHlgnr4IGaxTn645x647S+OEdPcNRIMPmb++R9rknENJjOMeWdq4zXDsWgCkkFz9WG
+r6A6QMZKKr2CxmgWUecfkEW/aVC8ButQO+PtqupQpYSx6rEljxz79bGAYzwUcJxygf7UaWE8I3Do
+EwKH3Xs4zrEsPKdV4dkkVporB4BTZoazPkJ6hOQeQbqdSxibILlluc/jN8vsEWlWJObUx+9UGfZG
+E4o/mpDs7gbTW12BfuLJhRtkSk43xfwso1HiezSsyYXu5Lw71NvTf6c1Aspl2GjCONuxHW8qzQbw
+HrQrw1+Wa9LRuf7NLxgvd6VOWptZ6vOeHjVQVfMLFWdyycVA9yEwDFN8pIlhb3oeNFjbvufimhvE
+xkQIkTyZM+/VXYhWuS1pddvJWSR5ZFUpXoMtD6t9kmof+Kp4FIWcNf7g0+HRQNE1stAS/tKuSdPx
+Mal/xWu9jqNku7cB9SjBtV9avO76nHxY0MCjIZQ0zXzb8CnrqUUC2Rc/vmIR0JY5qtFNifc4Lak0
+WSYZ4kiWXN3PZevQ+jCUnVG5DuVNM8dr1rSUWUerTn/bY1S9VIlkBNvKP2FjVA0bTegnnlxkXhEl
+p5sCv3qEBRfiJiVeN1Wbc7aNkvSaMur+zK54lZWhJBZ8Fpf47cMkLx+mZyHXNhrkDVHjZ1HTkBbD
+WJUZzhDbI6RescVxEHmUaNeqHummSLvMANfkqS40oZ843WJkAjO13ZRbfnwwN21itAvX01joljKu
+pvB6ilkr1TT5Mc9/pl6qRBpkzT7kKo+gOi/yqOSk+JtWZ21UYAlT3VkNsyuyL4s8GDXYz0qdFM1a
+tJzumrgm50Oimf2szF6WYbIqpFGBNIpPU+FpKjpNBSM8m+w9ivGaFO1qFEHJB3mmWJULWEmlVxCn
+KvWwKUeoGoZKM/uhfGlmRBxNhOlawKHNBFXFNw1C4+jga0IMcCsttNPTzIiQR2OUJH0Vk6SucuPC
+MF6TYn1Yb7crEs/SuM+ecD9dPvXbZZYNzOCNqtLkJ63eRPAPdSqwv55HKZmxGbg4YbwmZThPZhiT
+7QmCfuLJReoqSk43ZfLLZWaJQNIjWBOAdXCYlcuwDYpqVZs0oCyYVmQE6uALsn2Cjl25ZpTsghHT
+mwziZahbO8wnIF2V1lVt2ZhrwjRNBulQ7cwm58uG6lVUqkGkVvk9zaZQuI68nflOiPYblMuwDUKz
+YhgziHIVhVR6haKgLlKhGJJ8U21W4CR7XpdeQWM0+anbAFQV3zQoXoN1OwwNWWYq8nRwWvKaQsuD
+4LBR0cGmPEdcg9uEKDvvz7M2y980ZLCLlYpKtYuUxjKIaafEj5rbFhymSL/1X2niPUGjX6rFZ0+D
+/MBqaDXrLJOdKfG6qdr1mZ/rXKaKsuqzam1EHe7eRtZvkdFi5odCbpbZI9JNmV0tJ312dFLqrIW7
+OidrM98ybJomUqMJ4zUpo4tMpckgQz3vR6GNUv3d4Nl6/bJF+sswlWbGX8l+Fyh7WP515XiyWDnT
+Zkx4gGeu7GS6ZsM9xVRHtaj32MnzfT/Pv3f1/D/29uQp1VEafzBWK7KW07Y/FN2SXZuawNqI0eEv
+HG8xozJD13wq/dcLCS7+1qfCZuP12xT/sozyxGYuwW6S3tQpQbBbo0NtdNd+x/5ObmM11VDnnUb1
+a9baTbG8c5wqmuOvauY4VTLLO5msHGEZW0O1eN31KafJYrVsbq5z167BbULNt1Ftv8b4MN18It00
+jdNHdyalTlqb2RLxFfIxr00G8XhTCiNf1fxdrTdVJ3XR200Z+Zbb9Jpu9ShHLu6mtBv7WamTomWL
+ltNdG67k4sxSLXx202hUocjTecq29UsJ630eHZZr0iPdlF/p4y2tFXQe7Xfe59HumZ/H1G2q3bxv
+2UkflHHVjHmANypGlkS6VzBekyI+tws5Q79MizShdgNUdTpvGZxT8jPKfqmsaXSFCXJx5lD3u9p+
+qXXW5xxtBBV7LEjZesJWzyXoW9uD/azMXpo87NMyeGizq1zdKGed6qMKO3JwPqXs86kFM1mkFHk+
+LRfB4fG2DHIZVAdewABxVpVSSly0DnGxSsTFahGXUY24jHrEZapItOsyHdtEfqC5Wn77cxwloFyG
+bRDik3ue0Q08eYScaLTNPOftyDathccq+Cy6bD1LkQqWonWSriu6EAKJ8a1tK29rW3mPTinjPkeP
+9PRsNEBqNRSCulgvgC+lZkDFMEp0ODY7qnt7msGwGr9pwnhNilXo1zmqpyWPB4FYxTdoDOM1KZaN
+tPXlWlgtrWnmphbk8SKna0hTNGaZJs6up9kzTZ76PvzgURr0WbFEOMdWvNnn9NNjamYAR6tPtMrE
+sDjbnqwMe6MJ42y38vY0fWfqrk8O5Cce9msQBjQrbXKRvtxzqm0MH1MRKyNvzKbGVRQp30amzq7e
+7jT4cZ9BLxR1yfdalfzAEa8Z8SsRWMYMpU5amzleswa3upTwtDaaZl1tlruKnGor53I7lt9td69I
+v9RRiJqQoFHZp9E6VEtgplsCG1Gq32Ib3bX4K/Z35raNoONwOzTcGfH0B6HV9s9I+GcaDn9kQ/6R
+wfAHlzM8vi3ZpWzT4BAmEaoOQ6pK/clPVUYKWBaEYpU7Dl4f4GVqZOCNLFLrM/azeRezJ3cN4zUp
+5pPLIDFqpFRIl6MdtR6yy4Rl1KLJWkhRM4FEBpLz62UXpYYyR7WZpd6rpViHu/xQycDhPE3qYdUs
+11t+TWPtIpco0p1r0q3CpAnH0AvjETdWbC9jFvcsAhEF40UW/ADyhtqd+un9iasp8TK0JpbpbR1R
+QXWhkqx5dQmHXOkcihetELUJ4zUpVlBXGw3UWqpiXmNdOqvx+mWYM54XqXfD72RW6sFXRKYJbjZd
+syHSXRsFq+WuauJcaZAfWA2twuOR4VlTx7NNlZjf8B0GKbNme2KAtXjOzWH9FjlnBIO4tpCWNuHN
+PtKYSsOwnvau02/LLaFakbNsbx3SOl3ZNuUVrw/v4E8XJ7TH235b6s1Ed83f1fJL/ZX2rmqNJj1e
+N8t+1+7J210Ly29dTukm2y0DG66q35y/f/XG9+/e+NJd83e1/FJ/pb2rv3rj+7s3bsqUtNHMflZm
+r1v296bNzbpdGp4M11AjzWwjUfRZ4US534R0RoQRlJdWbHIyxd+0OmtSf1Nz+qXevHO5a4iMegAD
+mbEZSJXtK0mDtZ2iww+/DmKUJoSz1EMrgFUrDkDFfdi/BD0anM9R7Pm8F3s+R7EnGnHialyXeuAX
+rMJFpRpE6jY85YqdPxdJLi+vGEqCpiU1IgONG3nIRjnHwfLU5/SE4jXYmrbwjfp94jazOuVioCbI
+uJJKr6DNiGOpwtwGSV3iKv0UV/PTJsnVR48Fzlkb3rs1nObMu6XeTHTX/F29+2rRqu937e7c7tot
+8vtX1b5/V+1Ld83f1bvvvWrxmpThOKXJ542nBNPK8PfX8GBh1w/FqFLK7lgFuPHdkZ76+J2QH1gN
+kYNwTjOzk16hJH/qLUZ6veaFjamXKsPL9lRo6rHQcNJ3vgfHa9ZGBkOnYcHR2nhKe9pdG3q9xmUD
+VfL5S4vXXb8ntsjxLISpc1ybOd5iOI1+mF1JqxxplUlGsRq3QeJ8v8Y+9BEbacLhJOkz38OaUOyn
+nVQps52fBqLDnt5RrGB10h6bns/sDwKPev5nCwr677RZXdns5j18304OSFSct5vdprBbme5td0X8
+JQxOZTSQdC24ClCDtqRMLSnYgAjG03LKnLJWu/FojAQt7JZAWAsRnTN4h9tNr6GP2EgTah7v0YN/
+3NJU2gYzpao2nDUPZfFZTj03MTZP6Mhhc/z0hVVSDZHumiVWC7zzvNX+2G0uRUuVi5V4kNd7cEOp
+sxbu6pyszWwZ4tE6UHjfePKZpqNZt8LKXC0oc+OGLd6d/E0rd80ybwZWpTFS/xxhZDue8h7KcIxj
+l/mj70cb3uy3NHici/78elTBDJHu2kislrs6J37TTfkV9yunW6e3cd2a/vw6O6Q/rYTlt8J5fx8h
+vfq/i3GeSod+mRZpIKy7Ou/5HKCREBh1L8xCXYQleJq5qhLVXIIZy2WwuzSYlOFYlqYSR29FiwY0
+OSMS8gNHPJYCwjnNzE58+8C6ZtxZmO4r4LsvLMgrFAHJ3y4gvcblI+A5Y7wmRUbArJIZ3iSUtSRE
+xWDPFouSz7sSr6ECkYNOQuNyoFAZWA21XcXmfmMpoIRcDEadoA2XyVsckmaXRl4pqxSnIl2t99le
+pMl1aDFN5baxFWsTYducplupXZHcq25IefubSQvEIvsVPEWVVhyUSxE2il4llUEWCVg3KRRkRilW
+EOYYRaC++sUdJvGQT2Eb+oknF0tnn8KelNnvPWWWy8zwkltg0w2w2+2vca9LKOwu3rV43XVzDiNd
+XxsNpgnNBYsAxWHelVBM2UW4QsVQi+qsfqMC+qBXJ6ntH4TSvX/EUW5TvKLbSaVXKAZVSWEk2+eV
+0WS4hhpp5uGnm9GUKQp38V/RtZG+Tcmb2rS6TavbzMUqOQpq+nl3VeArE2S0GTKOmTGOqVHQxZsS
+r0m1/GSjR534otwz6nA7tpl+mRZpoFlzGoSEQWodrNZ6q4VJ6x9G/cNUxzDqGHSWjkFrm8t4Y2bS
+bpEoPX9EaHn5A6/Tkp+6nBIsE06+AzWr8cSgsXTISenrBknSPyKQFU/J49eBBiM24nHfV95dCm7Q
+MiE8+VvVusuJdgmXRSqoDKfkzmYAE3bkXGQ+YbBRINplHA1TG2S+LSwT5zSzi3ctXrM+JXvTzLOb
+Le9u+qiq7p+5yHzRoRion2xHeRnjhWNjbi7Iy9KTIlHEb4a88sdxbsUhXKKrdUJSRdtexqu7r+Jy
+kQOYoJjlmFwcfoIXyDer5UW9SadfKrKg/2xUC4dbzDW4TYiuLfjiloA30r4wpZpWBg2jkXTvqZeU
+hPzAajj76uV60dCDZyURXmRRWQVE6gR1zmcUXZMiquUoW6SbV9c2wgbgTlhJPmypFK/Bkuekkegn
+qVSD7kJgyeS0rM8p6/OW9XnPutTMq5UVq69VF1nT/d7VFlfjvq9QVXyrI1v6L+T33qbUf9beqAJF
+fHLmgnD3f41ZL0cqxmtStAFZapIbQjYWF5JK8oPipSypOkqKHaKRSrG3MGw6rJQnF8uwTSNL1Zxu
+iiWTxY8xIuxSgKKYVZgcFi0hoHZ6FOqURLbNgJOd+JWQ1D8A21SORYuokYzTMvPwSW3CaiytZoW8
+EurFCNeo6aOljiPtWJ0w5zJIjCTCiywqNV6kFlz2rHvcUOJ1U2Vs3Q3IiAqJ8CJTM0A2VNRVyqQS
+mkrtUWUtisroYWWL0LKs+zrmMsg8C9Ubjzzka6uq/Jlw2BmCjIdgGy6MDWc/gGJoabDPM+YyqA68
+FNmWoxcheYMQh09066Q29GvSZCvmeox+NUU7Vg3XUHSEn7h4wZIMosVZHl2xqkAxp+Eu3mHHF7IE
+OH78hku6/5aWquw0Li8z5nPG4TIGaNCr0EGWhyzJK6FfQxs3uX6pdeg0oZ+4zDz7T3wrZ59w+Hzv
+xX9/Ff+lCf3EZebZf+Kp+KnxcaSdOyJaf2kn6nXwDskbqKmRgTeyyJHz1L65bbJbKJo5l0GTsdfu
+X5yH/TtealCswuQFUPy/dm727zj9+tfOvv7Vy08AjWR5evt2gnG8Zk266aajImeyYgWHGQWfCaKo
+8CKLyiogMhigYfbItlC8BlvF0GlnbWGhQT2urzV+nlQkX8aLIeamkmO4Zvxj0j3ExIAflE4/Gz4E
+gR9ATvIDyOlHfkxLgAR7tX/+JfaQcceSoxE0DDMAm+S26Y/eK+0/rpyi46M5fqA6RQebPN4b+Rcx
+UuSCInEjIioY8buzSZ5R4C6Tb3Mm+YTLLkN4z9yU1P/dHtLy4xSF8CkMeerDHkWbHkFL81dR8O5v
+oqIZgyRKRY40SI2HbPJE+rC9kB9YDWdfDEFjOHEoXtL7KdSXC5GJK/dDdFAZxE5ZT/eFzNgMqAys
+wxwvQ0kky2+heA3Wzsv3l9FStsf/Un7P4I2qkrQujy4QdHFShvubJozXpFht3jIvCU1Gdme9PsvZ
+f+88pZOSzwbkB1ZDqSQzrPv0HVdVI82s1RF9+MlZpijD3mjC7pHtKzpCfuCIR92Ec5p5cuJuy6+X
+3XwzjtesSa1vOgpLZw8Pa2geH9IfrMkP+8jr4HjNmnoWr2NFMV6TYm6rw1m+kBwC7ZchlLrzwVdr
+jlMVdFwH9NV/JwY8y8oQSE5iFMVcSKVXKAbmoxAGpXVQvIRF2oOixi7etXjzrEN508yzm/X9Tadh
+yWXC4OJdm7LK0jB9BbKnK6EH2XkR3aOcdRNBqqPdXasunk897lUsTasuTOtYllb5ZFfVj3VVl0LD
+Jbk6FqxVl6vVtTBfpR76iI00oZXT9Ntb/KIEGXijMqgaGknblhL0Ay3GdShvyfj2Yz+z4RpqpJm1
+orM+J0bdye0iOAqLrC70mqbwuc88Ii6hsATv4qTQzOqPu1tM71xuXCdFtrIsxyotp/rKRwmB1htQ
+YLdjhaKfuA6W7qfpGfChwC9VzYkpXoO1c399g6Devj5Qx53uOt7PYtQNq3yLueVmn/+rlPR3bQbX
+oWDD2fciNIvbByREjTSztUX0WUGi0kRY0qL9iu96C1Qh7dtiHVtGrxbZqMhTFU2zybCQARRwrZZl
+FRCpc0EYn9oQ1gYNTdKsW1PJP2NlKKnXrYkjLvoDzPQKi1yNmNV7dLtrknFaqNCgyWobSjSJkZv/
+Qn5gNZQOU44jpXS68pTkPTLNZUK4HMGrHNVi5VKMRtbJmGVD1WpW/XiIYjVugySjas2oUyNwGK35
+aV/WVYXj88tx5bFkrvY4dB2PQ1d9HLqOx6GBGivynHeW+e2I8WrE/b0I0QaKs366o6Nd+hflMmyD
+4mSF7y6jjmEZNGKF8CHDms8l6IBUrkNpE6KwQ6b3Q8+WmWQLHHa+rFiVcxkkA9OUyWnGf5RhDHZj
+3tjFuxavWddkpNIrFAV10fqPxRFzM7C0oU2+qBl73Y+t84G1ObkIXvFKfhcmdYszp2aoLTWlqkbe
+qAyyaKldR7nvY2w+CpPDHC+SbwICZYA0+X1ooapkZWp2qTm9ijhp8brrujd0yzMUzbhpu5JeJDL2
+s1InZapBmPouTdeMxndGmObCBaRY7Ust2oq174UZm6eC1oMc0tAhAg5h1VyZ/MARr1mEdTREeHJ6
+j2xymVBcogiTU163ARVsQAUbUGEMqDAGVNBxE8a4CWrCe10Aq1saD3ZDG86n7AmhnY0G+YFlwjp4
+oNWrnaNtYBfv2rhG+7ex/rbSXwb/l+WvRL8qo5drb4b2y/D9X5X6/s9Kfekvg//L8leivyoVr1mf
+/N8jfx1C6P9yLs2AvKFmXexNF2MX71q8Zr0O5U0zz2524gg9lwmHWclGI9DFm6IbYujtt17vBpvD
+h+W66fGu3pyn3tjnN/zVQH5SRi/aZHI+p8sPk+bvarzu+j2t5Dv0nH7rkkDmyHjXJPfXeCbSFI2Z
+X4kY+i223RRJGV1RaR0NxeKHp8Y2MvBGFqmtHXPcdK2JOZdBYgxJpRiaCC1BZqAzjvzH6vKMsrUO
+up8qncfUZcfUY8f4YaRZ03F+jlRFj7YgiRaL1K7g28ZVLvhXveBf5YJ/PYu+9qU4mcOsXIZtkMRr
+y8uezzRqb6qkHW+HD0XO6k2X3W7W680Arf76Xcq76ZoNqIzcj2dJZVBVRHwvvLlFhXS74j/Ma9Ql
+BnM2mGySGzNy+CERUNPtHZ2hX6ZFmnA4RXgUvETZCK1hWQHTOTi0SIrRIFp0tGTJDzJjo0F+4IiX
+poJzmhkbc1brrE/Ke5SSy4TqYh3FLHXXGV3RxVkpsxfSIj7KY5ZCdeDp4k3BQf6m+9+G+sug3TEs
++2/9d5L2Ww/Lb8uvJO2661/6rfvfhl85fH/XM96z1JFvBtXsixZDmaPazPGaNR2xQx0JbzvDZLkm
+PdJNmWpo7641itWhCvyrtlpTVerQGs0cr1mzrCf9n98GyUx2muRdaoM0jrxBvAwt/64YFQM16TbS
+3THJeExVK5zqqGyqt3pVzbCSjLBU5+8b3fQ6DOQnLjPPToOvQVbfOhUhKz1jq/nY3qnqltZbPG26
+wdNut3far5s7ogtKQ0cT21T+vOIeartmNU4a+cGav8WP6oxDg7BVBhJ9WOSowuDSYPKG2n/jeVJj
+F2dN22Bqm9U6lDfNHK9ZsxaU6YdfoKldfvJCW9DyLlNTcX+CVunp0o+QtuVJ3huXiW5ozbUfYWhU
+Fhd16mZuNLOflSnF6LplPL1m2tvyqIMsY/IT+YFlwjp44CiTYhzdtFCq2jfyrXyhkfsxVdg+mj+U
+OmlWef1ivvHkZVjrlFetc1615jLzLUJ7fHN4LNZ4XkyYKdJvfQwms93S+FkpszInG50imkaGGpSs
+LvIodsescstF+zvHSGsY4yfHnGzMLjmee3Jl1sI8bvJugC9mN/rL4P+y1N+W0aBhyul/mO4pf7lY
+k/NufinpKkYV8r/UaZvANNUGek6/9V8J3lPZbBnxLaSx5poNcaR4U5nGY85x0KhtjlRmtiJKoWn/
+V9Xf9Sl7M1gOdapfvVVNr1+ycpZKM2tEycegg/ykjK4s+Rj+dUaalZHgjPqY/6TZjL6czaAajU3n
+SZ5RmQ4Kd5s20k+bx9PhStv/H8tIMX3576bXX4bwl+VXFu2XakWEpQ0iP3i0MEwj04d5WPowHdP0
+M22MaxgtC/tt3VHsk2F2YNEVUdGH6xXjcLD60LhdZNrYtUgX5+XlFhrkB5aBw9Wyf7nQNsU9aDkv
+3MUT8gNt60yvmopWJpzMA0ehVKY2vajY70LMar3pJSx3fUqdJtRUIdIgP3DEj+qEPwbD9c9w/WOu
+q4tu0TOkstL0gyY33cW/LdreNbrFDgVrflNJ866xFvoo5fMwHMZPGti2maXGYVepBvmmI1A/19L0
+5d82XvFt9opvpxqdnRqUf0XYzP/vNO3/O0/w/86z+790W0L+e3thcBjq0ASjjeg4RnQcwzhOwzi6
+tJ5uNf/iB9HEU4LiLZ/LgMqEk/NlwzGSe9OEfuIy8+w/8ciUzp+QZlav8Cw2V8hTnEKuzFyH4gcO
+63q6maMpow4hjWVODOln0OhMfTxWeE78o0nzc6xAY15+BpGfePIY2eRlZLOctsoU5R6lvZIPGuQH
+lgnr4IGj2NPTID+wGprv7koJ1iGq1UklPytTzDXhyI+81+mJ36OccEpub1iq0uim+JsW757jGCGG
+mzZXZV55mjp5n7vy/iwuWR1SG2Tu+MFyMN/oXUwreFmTle83DMwTqod9KvevD+XePpMLZZ08V5vt
+d3u7TJXR6jCdqYhijq2NpkEZRf0xGKX8oTLQchnHjD37c6wkVfN3td7UUTfTZwfLKtJ0gmyqVlfy
+sKn3do/xptfJQDP7WSk35ZZmVqzht9uHN31y/9LMflbKTbmlmZW5PGu6ra/va1XV6K7+6pa/br3e
+rH+lJf+X5a9EUyXvF4Jmw1+p2l+G3y7t+mX4/q+WfP9nS770l8H/Zfkr0V8tib+qoClosWOXHaAS
+mcVypnW6SKKaRTaDYfrk8qPKOJnpnNPM9xi6KXXWVBltG30/dfqY89I06aXsaZAaTW7hmFDN5xLt
+aJ7niyv5dnUlT/t37jcppaR80O1GS5meL1et3RV/0+qsWbNF1V48KFEbGZnqf+n1rlt2ZhgZljo2
+i2o6gI7NrpAd0SWyuyhdncjKt9dhRNF66Jn+ka3zOrbRQaze2M9KnZRRQp5nZtVurm/Lsk7kB5aB
+lrBOJdgT7pOmjoXaoNvGhyHSpE3ZqFpv+pt+qXHKjPzEZeYpk1HtQvf8fuWWy8xTDoZ5oXpXNM1/
+p9NPBTUqZTpzstPSQus0w6umFSi0pnOfuaoyhsDt2o4o8bqpY/UBw6xYT9yvCf26IGSqzQizZTiF
+VR74bfbZpE55HdXN61TdvE7VyetcnbzO1cnrrTp5nndVi9ddn51zuSnhV+Rd03zOow0iP3GZuQ5l
+vvc2/2aSammdlJxuypzNe0okdhXLlu1jl123U9o6ndLW+ZS2zqe09X5Ka6od04elXTdLHZrhOp/9
+mxot4bRUrbeL6lXP6SvZwrPSn9PFmUcVDjuhZvQTa+qDlnBTXmG5a1PN+vxNM/tZqZMyuvGYr8mq
+Zq5h9OkRku0FtTlv+31tdiamjz8qmjtFm/j1tVBF89GXpocSr5uqnudzvEt70+P1yzK6+ixvEm6u
+ymKl0R+7ya+KJRFd82zykknHj62jGP3EdbD18VmeLk0oPm/N721Ne1OiQX5gGThcrYA3pTw8xm7x
+vt3wVNV2iqHXX4b2W787mFYaPW259w70GUR+Ypq5TkqZeYoYTQv0UXtenJ2+ijK2z0fH12dcD/pM
+l3o+86WeDz0FbOHaifzg4ZuLXg75ZMs5vwmdXJuzPbTzpdRokEbb7gSMlyk0iJ3Dy3nHsFv+HS+B
+KLKRgTeKl6EeJVkpRmaTZgb7XJOieARvRQQ/CmGeXCwbUeI1qTndlClZLjNzmsxBvK2moUaaWRsm
++vCTC+LKwym1CSWBPDHJIPXM8wlqvp9T5fEUZJPzF30uIMtOmuXF6qanNFn30/xH39JRrMaNJvQz
+x2vSpgRzplb1P/Ptw+IQzrejh4Z8i/6Kfhsfs+pod04774dL6lOc3jU1xXK6vcswGab4SDPrNhRd
+/H7fnOqWst/ZfLNkMO6VFPf7qv/dol63X/CAbtfhi9PLvMXt1Eo+cgwNk3hxt8s8k6o573oKLDjs
+dU4mmsYmVzWVnGQzSPuSyfHE1dBuke2mxOumDk89gJoSr5s6Nk769RbuMKk2Tp6Ly1MbxxlycfNb
+1q24vo1xGlDcdL5R3HHGXR8EbcXNJxND07rWpWQbJhUvBpn2x7btvHIqzibn4tpGi4sza84t1HqK
+19mo2Hh4u3krqqbJ3vpbTsZ008SP8MIbYOwyZK+8gSdv2xzj6/TgS6nRoDhZR0r5PHynkwy8URlU
+DY1kxhHUElINyyDrP9aQNCOU39wQ8gPLwGqoheXxoxhDqZP2HpnqIaTk6RtcreTQVEpCWSUXmTyy
+vp2mSH5SrCbTa2qTNmdhlVEtXnfdNkW2F9Two8Gjsu9pBc5aG0R+sFXrLa+1tiI3UIrePulQDMxH
+QXM4rfBzlKy/8C0Ur8HWCNP+uas0RTPqz6s2+3nVZj+v2sbPq7bx86rtxEYbH/7vKEmD+YfJfT7h
+g/pLaTelDs3wTYPiNVhbfP6aB0+MKNRVlZ8W2skG+xzPmWQVBfBGVQkNAo4kUjc8xi9P8cuj+vqU
+/dt5EuFFFpVVQKQmWPU9EUU/GIUPBUlj8Co1ZbTVoHIdrCXFsfwbivmN1JM/tpmi+eoUKcqlGI0k
+9pThoiibTtWJkRKdVW4fYhj6ZVqkCYdTNA8ckxXNI7VBk3ODQ9XOqdYxst8BtPY6J71tknlPE8z7
+NrmIBgzrppK8UVGSju+o/pEMvJFcZ4dSBo1ERpqlJEhacb3a87YXmMap6nRayfimQfEabC1Mt/2w
+67kYBPITD4dB16BRlTIyL3POkLxQ1tzK4sqKtyS7Ei/sg1RIbdF6vt8oF7Lql6nqOBaxlKaWEaXd
+U+z5JWHyg7UJdtyQg0YXTnPnE/V2V9RXM2tUDDROLvWNI44ebt79lBsVrk3EtNOMH9t/60NVDGaR
+0usooY4fKxal3djPSp0Urdj88uuk3Vzfc5a53JR607QZU+7y/V1jTWC7R7UfDe1s2Y+sIdv4dpIo
+l2GjCTEi7IMT7+mDE+/bByfevz448Q5rkKvpin7iyUWaJ0pOQ8llZkmib+Er+oknF8tVlHhNak43
+BcnSMr/0MfTLtEgTWrtZhVOOt1nc9BEbaaBZGw2arVZEXXD8u/+y3+13/cav+t1/02/6Rb/xe37v
+8TVWRRdvys0NVwdVEyYRXqTqpPFFpOi6QcbGyPpjJ520OnlcP3hnnLqx9AoaI/nlH8vkR4UXWVRW
+AZGaNJ6puRLiNWvab7NOs0W9GxlotDUinqMR8U0qvYGW+bYjm7IlKgpqkWqfMd1f3pwt16RHuina
+MDV01w8ei+vCdPH7yONwn5JP/nZMfoS8tPgIGWuw7INXUVkmqFWFF4lYXPLPnh8yYOFFIh6PEeTo
+OEht626p/7uHDNCcqC69Him4JKKyXBBC0Uc3DMVMIrzIolLjRaIiKXytT6FEErLsv1P2X4mV7L+S
+/Vez/0r20s6kT7gqcWyuB74obDiZ0cpuOHrQ/5vjoOP5CPnjOEgIK4se/nErppnjEQ7X/z0H9REO
+WtwioqtbWCmF7rPlRCJ6RO7/noOuHjkh7Ep58O3Ko3an+gl9wfHfI/znIp+a/PfsW++/+sDnausj
+lEcorv8nDgJC1bjqxf3HQUDIFgTzRD3US5VIRmxrhFA1DPD5tbmCS9Nd1PYIZen/+UDY43sOfbsW
+4m8nFcI3OAsljuHxx4KdexC8Cfl+5OApok0YL1ECHPgxuxJ8jnkNtc1cTeE0fUAVpEJ7Qt0QcrNC
+3XaXBEjgk832yWJtnMdP/+fWYqbkuw/lp+adVMZLSTrTmIv/OVu/w1X2volz4mCJzhtUJoKBighY
+wyLCRYN4GUqBOWHzy+qz5BQ1Ynf49R9DMWe86WM4m5G/5S0hT3ACYsJVdyUYP66QgZj40/UCaio/
+BlQMtf5ym6fkxJX578zYH4SqYofivJzwAdtEfqC54uirnNPMk9Mb2eh6wBA713z92hSk/jjv2UpL
+dPrbgV3LaQnzouiX7bpZfiXSQ9bN9EvXDN56wJu1KflbD3ld058KLIU80T5XBfotUssUTf1S4ysF
+xg18TrORqJEmhp1/rga9Ta/pa4aqxilKkqz9GK0NWnH9V2l07mrfpFAlTgyfSG8dO8pzRLhp1+A2
+oeTKT6akCbUf7JGVwXMSPW0ohfj5Ym2wKr9jkZQfyzXX/J67jdU5StLYN/8GW+b2mb/O0X7nddaG
+r1jEe/yI4KRpXHbLNtdNLZF+qSN3NVmCXwNJLZIFfzJuioZ+i5xyF4O4ytLV8GYfaUylyaAbu44V
+LrTR+eM69KRoGX25EG6zwWS6+US6a6Niarmrc+I33RRL2kqgUVHVkPKtbzINrkNpEwYXJ20kv7UC
+eqRZkRg97RlsFYTOfnolgqkN8EZVSeZ2u/RgaB65DIKx3ebkMM9Aweaf+XcJRFOP1Aw0spGBH2Qp
+mhVlFW52Zi5KThMOd2xPfmzMnhkbD36Np77KeORrPO+lD3txwxsWRu+QEJIIvDJecIpVPj26PkJ1
+/d8tCGsvoronRRHRKVAHnwsbgtseoT678+JKyK3D5mgPzmhhMpNYKPUbvcC2BXk2QJjNL7e5nvFG
+BSuMuoX9wes7rtbWXHmEiioEX/KDz2ZwX12JmBG8cum59LTR7Qjd03lQWETWXaEZVFA+tvykiYOb
+FckBzsk/xgtkAhoDefWQcyP25bpGaghZCWltvCl6naTduwssukP6p3v3luenKyLCItA98rLJo+CG
+8TKFD1uDJQa342teLiebhZFXiFXOfWumSEuTNWyVKztVHyau+iBxtYeIqz1ArIRmDaXOWrspYbmp
+k6diLgYwobY7FRFhUaj7oCoosplbJ+0re33H8B9lxOdybAZhmdCyYLXuM3OGB5Uwyh0aNtkRqW0G
+SIAzsQ75uNiL7XUhDKFcD96pM3/skLNtG5XdwMUJ42UKJ266RRu/ZQYpMYfFjIa1fEAWiy63+AKH
+o/9T7EUfPbuC0dxFUKl2vuKmpMYu+Z8nqdrXEIXhv5OngNrbXOWWVIe0DKhCjSXsPE4r34hnAR9x
+xZBtLj3PXs227XyG0uGsKtn3/J6P0B6h/eN70FvYXDi60TFHTgnJewmwAhYRdiVn1qqpNMgPHPFc
+X/D3ltH3ntGXBvmBI35kBNvVw+TW3tnNJT5Rb64u7hFav/TRFr7Z1UVlEaiIrA3AeSybXG5UUncu
+b9m6D+0cjK4wrqKQSq9QDMxHAVnTPnrEWFy+pNIrFAPzUdD8WMe1lqYvlzR9r6TZKyXN3iZRsug3
+DYqXcC4G3XFzixPRswhJnqpTitfgqoh7cMLdIT/4TnmrFF+P0Hr6lv/7L5eWc9kDD9w+VE8+wW/v
+Rzgf4fT9vz7C+xHerv8nDrZMD9ztfL8e4c33/t5c4jsXHuWQYpCI6xHena5H+IRHf4kQP2R6ufII
+FyUeWl/nfnqY3Bog9y43etdu30P3yf4RvnxDyD3+ffzrnv3fpR4+yUMUEaYPS6lCffMYqRFSonJD
+GFL9uYAc34PFFZe8G8RG6tVY+JnKf93yw4GLIipkcgcJVpNieebcDMgbIta7j0oYxIOKCLE2ERrd
+0J5O8HiVM8Bpc/tOZULyk6LpoCFtIAiJkr5bfn5SeNEgP3DEa6KfxAOoY7y4y34OafihjS19O7QJ
+YZYwJ5XBGVrcO8SIutTmLoHiPoPgylFSg/xESE7UIMJ0lY0AVQRJhFQqPznbfPAY6C/veIMKIl5c
+CaL4/nsiXZ79teB/nScOPMJ4QfLcaURg1IclDByiq2nljft6OYQ9qtvXlbwIKgq1CcVLQMpTJFGQ
+IpisKpEBil5XziVQjNwvPEzW0xURXeVvDHVDiBzwt6UVKqiQ+xlEZebJBUhILl7qsOWz0iAYUcvA
+e2XYOTpx4DgMdWfJ0cfZM/xxpfXIH+Kgx/zwqInuoJQNekTMB/ol9oVPF2cNZNBjev6749lhd2Fx
+KpMBe4lDr8D+5MBFd7lB7IWZb39SyRkAM1q5P3MhMkAUh2wkLgJmwijeqbb8YQocEEKJ5e217ygU
+E4jOEPseRJcBse9Sib13xN59eh7cz8kt1Ldu8i6K4HjiIJTMMkYRMFfdvRPPdIka7wFpjSq8yKKy
+CohEhfhM/F+XwsLpAkKUHSrCioOpMvz7QVpkcFGRvf51H0LxaJ/kymMiYaboInDPpNNyPyXvk7U3
+uvDo/9xZR282GVRQIi+ABh0YMgc7Ig6uEs18ll6xnn3xaGyXgcqM3ROHgtLv/0CwceUBUGJ9UumZ
+luI2FvAu1BpLFFnkIzdCnEPJ7Mn85n6uOyrCkg1cSD2oiOjG5n56zfnQ2rhDz+Sx7QDeyCJRizM1
+hOLS0L0M1UB8QrwEZPZTNFfNU/Tu/nYcyEZ68z75dvhVn44UQ1LJcahBPyB9njmRSq9QBVDYZ8x9
+n2nq+8BffCKq+5FjC0s29OCPKzg89NpeOgouV88SLmC//02JZlY3mZcvnZavhUgEq0iF4i4EvAWv
+j4s/KskblUFVEa1QNHOHLxHX4/vlgDP6frm07xc9+f3KFvx+dbt9v8jy+915v/t+r8e/S338u7TH
+v/7xL/FcrDKQYbyUkBOYjYkDxyGmHcLEQTJzkEsmNF4dzKPn2SvgAwddpYMDxsIBe2DhSrISI/1m
+lBC8OWxb7oJXVURfDnphrxcHVOj6P7bOdUtWlmfX/+tEnuOiJK3MQvBlUz2to1+D3Emg5rfG6CZX
+QtiIiIiWCvCeUQpOFUSrW6G7AoZ+5Hq463CN+T0OePrTuRn+9EQi4i0grfanp34+/tDrjvHxhyTg
+zYoxmKyQvH0xZoQwIrwRYmKmJFE/oR6TJK9bM7tjeBGo5BgnDb/zRB2GhH4jRGreujF7He2fXnzY
+UoI1wcinHxaICyRCVEmbZE+lNApIOfJ2ID8+I1DCPsnXGH+pGlZa0C+8uKC2UD60oF94+n8W/1Gd
+y5UwtuI62sH7tbg4UhY6g5tQlQ4DMY1MSuBg4z5YQvRUuC3KiwOuSuGjnsormKwqG4D3dHmFJALd
+SEi9065SDZAIf12T7Dmv0+GQK/mZ3WnAvhnbmU92KO7sae8Xc0DIUzEBTsJnLiqalI+NUu5nD7EB
+NcltSaQ7lcIHVKl0i2C1VxeJK8ZnQirvzJLPedw3uA0q94RaeZY4JNR8iYAP154bkx//ZFFEsAdO
+XfY2vUFs54tBFrziOBFO/Jj9kCMcnu0oY0+3GHAkADj2yqWpZAMCd4pgFbVrOPvK18BY4jgAqE+V
+k6AwUox6tfEw7B/6Hf8UEZIXWVRWALL4xSj0q+MQQzGoRreQJcM8jUnioFYViMZwNIT03l+4D/zQ
+k6LK+vjz83r82R9/gkv73/D4E57jn+fxQVYgwpM4YNMoIKh9sPfu8SeMAT788Pugh0R4P/6E4YfL
+vIBLNhamI6NBVSQn2tUeSYQXCbcYJKuI45ZJk3AO7FfdrwikrzJLYRoeh/MIeSYsIBFD8HwpjL0V
+Ii+d/uFjLETuO0MUFsg1ctJz/D9zHzEnpXEWDCdfuQQZ7IMM9oEH+6CDfThrTpi3h8RlJe+ePKsJ
+yfPcAy/YgvAii8gKGdQgLaPXGXKwhbRnhHxpKVCVmkHYDDn2xQFKfUmOL47hmqYxJoeUAoewc3El
+vEI93IIc+ZcDZPcXdf+LXFuiW8Qwt9pHiJYe4tn3SehZppQvpS6a5A1NI4IlkMZixDHDrHJUaFTj
+7caQE97Egedw+PANzD/58Sf/9+xloPN/3BCJAwlhGTs0u//10bfGhXvG4ZaxYJhl/Y/lzRKZP48c
+PZVJ7ITpNmT6h4k1BEQvKpNgPFwhA5h+c2nHpGHcXG2j5hsWifL24oBuEeQFUM9BnOwlYSvumsTG
+XElEvAWk8ookCu6VC7IvP4Ij0mKhWDxy7cmLmH7Jq1viBabsj6tzc/jAwajYmJPkARRHwBpv09g+
+9LmM3paln2XpYZAwRBLhRRaREi0JbEUnc7qDu8zhkgonkqqB9LFDruXzgcC1wxllEG/PkRZZAbeI
+jReSDBEroeQxlzKH0tTKj+QJjTWaHBDw9uKczAJFyrmZ5c0S288zpxxSQyiJ8QSDkHSNwcizadKm
+ThLf0Y15HMv4POQfGcHyn/wcTclby+NYxhEhRwM2/UX1DO2YJMYmrqM4zB2zTBkzZox4uh0C+ggi
+X8nmGB2HPPqzLCKrygaw7zJ8aeLF+cYoA5SS5tEstwY/CVGviJ0ZuRy0C85eLDihtCqfxdiZx/QR
+ssEhlD5mXSx5V26YuHxZGMqcV9o5HEcWetz4PnNUyW6o1xWDinq6idy9i3cqwkpVUFIAkWInhHKA
+MHFBPPBU+kHoIHi1NVe6DhE8X2eqKiX1dXQ2sefB7chP+0IgiiDQnPXonDlftGSeM2fefbVFEuFF
+ilkS9hE99hfvEExXM06EWU5OWWatkMPQOdOOvHraSIQXKWak6mkbO6WX5KJK8kaVFqzKkpRxeuDS
+0LisXBdl4a+MzgUXn7Zg2BZl+nxoQb9wWXn1X3ipw7Ll6zaKM90q1Y1uKpPMzZIymhldFEiGEp1F
+sIptlrGry8jT5eppgJSANni7lN9hG31rzErym5eCWeiIAL6FosCoAy5E8i8CjEq/Ohr98qb/sj//
+c1+6N14AyWiGm5dlWeD0BqpN8RaQIUuR6yVNdcsUhmW8ldJinUlwVrl5xYwFEtgimSHcL8e1LMF7
+SkKkshhUJQWpGQjGjISsjL74MqiPP9fjz0X74881/tPjT3n86Y8/fbRU54W6zl+EH7KMC4L+HHup
+P5+j+v0ZokvNwGyNDLxRVeI6Cua0oHgQqRwG7yizODgMmwiuh3d8AELCW0Kk9RxQEUGqowoeLdhH
+A3VPYyv9Tgg9BP/YysjFleM9tQosEKLxW/qMYJSSd8t4yfcrW8t1nErxHIApt2IvtKB4BBcN4q04
+c+A3QoO4OyrFxYpOuWqSuvFu4D2SX04ER2UO+XTTxzx0XO30nY8zFiMaU9AhSnK9LYhYtA5moF1m
+oF1moJDYil1uSgFuATFIJiFDshVzb0gxhMefzrc/Ou59DFFEVEiIIGYMLwO4pcIm8xkldediwzhB
+dbkKhBzRfHT96U8SMWwveua/KtkymizShZANMAeHMIlQNRGDhKZi6t1jUNFENDhkDrleMQa+z955
+0+IYLfr5jCTCixQzNvF8ZoRsvTiAJ994YoGoILq04HmhBc9LW1CIlDn7CxqW1wRgGolHG/ATVaND
+pi0jrCxkXAGICYcKgE0YUbitErnO4klFehiYCyK4sHXn4KfAf8euwNoGix8+J3VZ1ugp5CKCT2RM
+iEAbsWTDiwNuv/RC3EujqInQ6EZUJqkT2i3hMZWeXoFEFJFqrshP/WG+EaIbADiCWzZT/V/n7Dgv
+PiOkxgf+FfhM34urlUfncfIx6eIk3umFryWGSBCVRdWPpqyKi/+o8f4ySNKrdHzz5Uv7imxfSnDx
+X11zFscmW8BzlV54LxbsutLHxnPXkNXpXjBLGhJbdePHckJsqzUgHLMdnivJFGkIUTEcsawC+KkL
+84+94sPUNSqaXa/EVq0uKk4grJE3KpPMWavxowlkg2sLkn+SGuACp9cm1ZADGyDZjHBsKA8/mBA0
+fsb7D8Z/XCnIt4L+9DehA/APMjbZwao0cIhkUJWCM+LG+tscv03ZyE+shihUWBpw0erjz/vxeryc
+e7rHyz2fLpo8BEI9jdqksE2uA/OTw8ze/RVEcFyPCIdx++SSfys7eB/qoZKGqw9p1IDy4+V+fohe
+0Y2HSVeF6qLWr8iqsS+HEEf4y/00l0SM+H1cEJ7D53C/btTkCC/XiC2xsxj+R09ORIXce+qPFycJ
+7sosfyjtQ77yQZAFDq/cy0gfLidybBZfkEGMTENzgSMbp2kxJJb5NTLjJw8fL8cb9HK+s0gdNs4v
+usOVwHC6T4bpHOcCpnqk8MrvwU8OXIE3cRB8rlu+vpW6amH71nhcGRZ4vdFn4p5ziYHz4exHx+CI
+M0DcryFycdzw8cq/brRD7Oezl7FdbB8PMHHNz+1wjTf7pIP/GUuHLbzchwwqU3SF98gZIiml0R4n
+Bxdqel4/HPbt4B0+mpt3c9oOSuM8x4ofzcqARz0H5cqClVF42l3J2YBjw5uT/xnFp+R4W7gSKQN/
+UdjVOXdOU7m/pIq6VLOyaBwEl1TiiMxHDZ07XkaXGSJsCpUm+YnVMFja0Mz3s5CfaKk+M1WWzLho
+rvBFMZ4U+AzwclfggHv1Fbn2V35xWNl2OYScVLrr1cfW8sFS3HYElrtDZMFRUNw4wE6lLsRjTOFD
+4A0ieKfdHY47eHH5ZbIyoLhGIl7OQOJZEHEhlBBijOKjqHDTF7U09IvCdeHeVmL+Gf2tcO8uJ/I9
+eUeV5EaDlMwBp8lZtjUj+2sEY8MbH0yljCU/Bu53pTZUirejYe8UVLrzEF76yWN76ekPH53lHgnQ
+4yqNrlyPMxSVI2m9XOFGrFfJv2H7PF78pBVvZHOna+gyTZ4yG1RGrrDynYnXqGgJ3BztoIUkMjgc
+/+0IiIlEiDld4nGuHZZKE43qNviPATswoFoYXNvJG9Zyxma3UX/2l33Uai8mx8a2Rjt3g8aZ3z54
+lRw9Nr5zpv3n5G4sZwPpsp13VC+c+697ugYY/wzV8bnul4obWzVKuXm/3+7FB9ntXmjF271Gxveo
+7829584cwC2LF48zH/c6RKhacZL7aNg+DudfnBQ+PEA9H6/nNeTdCGF9vLbHa3Px8fL0eJEb/4mJ
+V9pehOWNIcfRTnxQ0NM9EbISh//2evAWkd+pEBkMD9pHtYgiB8/smgFHcwYUOTlFKiwO16MBeUNU
+B4zUamGNC0ooLyFZQo6JKpebxD+NUxkR3nmggPomDi8OkJivaoaUxBcvRw2Q0L3IgE2/o+UeL/p5
+vGgk4aWCIZADpw77EbmVQ4y5sISo40Cn0I7Hi+Kzl5FRfI0AzTMEX9slKDeLgJBY5H1nyVEXB1yB
+KJsSZVMib0GsyKPyXo7voGJEnlTyOw+4OAhsvJDkvNAz+N+7KAKSUr8YMofFQQTuLfw8YR8QIu52
+viiduRDLDoldmbArh7ghowi1Sy8YNG2LsUI2EQckMlOvIGa2co3zM7DA/uDGSFV+f/mixLkp9+0V
+3IpVmfO7HcIkokLy2E7p5kzyPiqQX/31eNE4iRLa+sJevy7e7GsUW3hCQ+MUxwKdjWUVaJy2PDkA
+1sCHedmOQD8G5A3ZjVJ1Irnu5af//ESaxF6BA4XTedoYAlePa4O9VrC3iuyFIu1d+AazAUfl7jiT
+wgFnXLgG9XC/jxfVipaojd7c42orFFWOLPiKgeRSYch+qWRDTiRC1HE2ppYrNxYeO9iZIol4UoyC
+nqcchlX4p6vDsKBmOITe0Y36vnORzjG24tfVF+fz63qiUcwvivgNfE1DvxcfxGPL72fAZ+cnVubs
+ijegBcuC01WzYK5t5enEtEUcngA2IXvkLJkemSCiSbZLORGD6h1xe97IjOKH3TAEmudOuZFKr1AM
+qpKC5HM5L6JCRhFQe9oOA/ITy4LTlSZK/tIrbo6o/OGFSTBK1wKIqfCkU8lP5PjfXLzK+nj9bI/X
+/njtz8frcM8xDauvgeNiqBdun4PnEwfO7YeLwQ/BEWnLT6Ex/LqDkd0KXwMerrQ8ut3h7tux/Ljy
+eB3n2JYjh/HPwNciRz4pJDYUnv0dpddjO2hk1X/6CCPbf10Zv8V7vI77yVl9Hq/gxv/JIcb94NJe
+w+MVnuOfJw0svMgKye0cns/ePiolqrdPOAeFZ2gqK4AnNAIwIXloH/IKxUBdzFdKzfUQwTHjMAjb
+uGrJDE+3vQyqUIwGYsp/kR3/Ho9FEQGHnxguA5iCuPBSJoPkwBcQkPCUsLnkJ8F4P16jPwSPgEQU
+EWdItZlWWQYSIWraVcYbxC3ifUbIbji2g+cZcfDJXSIu8kZlUlWU7AXVDEm3CFbxYq8XT8ODr6/A
+JVU+1QZ+Pn3IyMHTVWdQlcKAwjWk8qLd8XAZ+MwfRh8Oo2F3XrAIL+JgJH71uz9eIbpOI9f4DCVx
+K8Un37J8hbjlUTVeqRhhIUge8EP0vbyGDKdLf/j6LkSZVIT44l/NvgJ3mog3PjFgth1i9ETFoDJx
+q2LixwJW/pqzgjeySDQ2P7tSj0niYLGyp5kQ9SffKmHg8HQlZe7+EVO0EHlDEspOkmFiZ97o/HSV
+DKpQ94DQVEpMR+ZZjmUA4jYXc8FOyNu9xYXgsLtehXh1QOCkBWc86KDCY0TMB1LgkQ0jeMWAQ0bI
+jIUmwYiXGRqZsdCkaQwbIwzo1ixheOfYDGD6da0ZiIlPHyEW7DCObtgdUhV2HFvHF8Qj5I53Pqnw
+NQMIwNt+PnNEbc6csoiRyTAlR7UdxMu/i4I1wcXA/ls/+WQQeJziy2V+dlbE7gqvXizKP3Fowqmv
+2rfvdOXBM/mDRgq/crxXDZd8IflIIrzIIlKigxqC5i0PfU00Vz4AkpZkXotPkWIKeRg45CrQ6aRd
+QXXB/5hhqSrVpYYxVga+hJ9qbd/a6nsLw6alLjvS9iHN0lu+oqttZXHRJeTJHPGT40slG7h1dofO
+mnb+CdkAn0+V8EMj7T94eeNExGpGkS+4B1CDlH21277a577CIJf2072oTELcFZJKGCR89oJUdYvh
+50eQ56eDfrJsAT8WoSBpI87Pg0gywXHF8AviNxi9QuKGwmQhyVQg6VQg8VONLHVjXku/04ZFG/MU
+AJe1IclwzYdxKhlpEVCMDsCeVXdWPXAuTbpJtkUVJ3s81jckbggKsS3XF8KRIP86hEMZWV1ygMgk
+QWYIQ5huDrxfdLrA5ZfnqGjBhH3IAxOjsh1JTH08nj1oPwJWwxg/KnlGWgIHT14+DCWk2+1sf3EQ
+ziefZspL9l95Xa5huSIU3rDy+sUgWl6y+3i5NBS+cmPBe6bU/OacsUobqku7S6PUeoTEE5AaIU5q
+IkbCikCmBpVX8IcoIhBNEGgiluIg3bdWNBBLMci5UWgYR7nNve5ejz7w6UZ24+I5qaRi1BqtXFUx
++MVcyJgj2MIbgZOSnBsl/cEBI7aF2wC39oLe02MYHaBJRmjSIUyX1IPqMSnek+X4aXIywe+NWEra
+8IvFu4AzbGsj8a9LPFT+Bg74Uij8Brwywwge9fF6vR6v+HjxTY/I7wERwhEUXa8j/KUR/s1JxDAS
+cZDor0picxhOdLW8yTvrp3ZPbguGbVHU/3QpuJW3L+XLTZXLFVd5H36p/0SH7R+9no9XDOeoEp/f
+xrHPm5qT58m2wMiHH1GD8CKLSo1XeUPy/ood6SSZOLUPQtUCFYXahNAPBnJ+o7X5qjwZ1MfrfLxO
+vrWSHq/E130Jb1YRSV6JawOsCjeAu2LCxeK46uGwSm5X1Yg3ISy8c5luSKiSzVv6cyLnEZLKorIC
+UCfi1ZBExMHmLpUXeUNxHQxnRGFhJxFpfEQo0ZGKSqSK5gedwxgRIlFUnwifOFqXaiWWv49X2n9d
+KXSPgnnMTXLxDPnM/1erqgrovlJkM7259uGHEHoIXBgpcY3DD138EwhGWFDpsB9NBNLvRztyXrEK
+zwQ6AVkUMvUWYANqorXgkhq/CGAAbFIuP3cuUiKkQAaYfsmxE3pQfnLw5IMBUBuIa5GfTwh25vbL
+28s9c29AzzMWJfEiL7KolAhUByAmPhkJqEkzz72JScRvL7UtyGbuSlm6UtaulNGV8sXZ15pZ48RN
+ejAAdoRoyDzG/6KAAlvTHZa1VTOfBVL+5QBn5ZR/kfJXfH7l/CtUm2K8lTTfX22U30h+t/yGspA+
+GLUYIn1rlqFaFm+NgEwIoXD4lwP8lOiV+vaa0hvgca4vrap6YAgypi/N3BTQTN1XSpCZZYkIkbqo
+W+F92tuBEIokbZw0P175Pz8CTpL5XsgI6xCJg4FPd7t6hEE0gpFuzCzyeNXw45W9Gy+5PInxxeFo
+xuwDaxRPrHpnSmEXgRedvPJYPhh1ylQbj4b5ZxR0hJTz0I7IAYGLewYDGnU7+vh/vHL49HPE/eEy
+/2S+ZZZfw8Sbha2Kmc/eOeacWN7nEOfZU/gwckukPXOotUxl7KicXxy4Zy/FLViZ+TjI8jsqBm6F
+rJccSgRGyB7RNXcwWM7JFykuhecTpnFWzplvgeVc3C8eZMi5eIiAcOyHi+8ks6gsIfjOV+b1vBGG
+DXLEFXIIkwg2cpOUJM3AXyAcYvwzjC0unYNT6z7Ourl87mfl1q9b6PWVlT5AvgRhQV4AnVCuUXPl
+e6W5Vsch9+Nc7z2M+rX+GoX2H/d3iJKH9ubZV/51EQX/unTz2nn+PRwbxl4fsuVfEeQFUPig+nhd
+j9d1PF7l8SrORYR1COJF/uJ+2ghfrmXHMPIrTiJryt4p3a7U1+BeiUVDOLKjJ4dnDEmlLTtM9R5K
+3UfqQDw+DlmvQKNmIUo4ssLPqV8lsFKP5FSevbTASoON5/5DijWQSDVL2sYzzwH5OlB4HSNqydsI
+UJ/RqoUPqMIn2xHijDYIIouoLDprV268Jla6bFifovClY+k7T/hLH9O+gka8ca+83LXx3vmMnnw8
+XvXxqodr/OqdV3uMLtJ5TOvP6MIQuAbtz57aiMQVWperL/6tAof/DfHpCIdN7273Y/iH5135Bk7n
+Z7O4L/boRvk97lRGibxPe2yuFJZHP8/Hq5+uIBw+5/+6aypHYi4inSgq9YQDquczpMZ3qPuFRfuO
+tyS+evEc8MSHJV+yd74R1ssOJVd+kGbkxr9jHDTqXRoH3AaFb3P3IrdwO6/q9tKTH558ISlnst46
+Px/Wf11oIoLKkfSTqJl8PV7vx+uNG8osyCtUAd7e38fr171Cb3FA4sDTkyIx7n86y48TYbIKsPz0
+OPx4yvx7PF6/IfGl5v143e7JV+63S1vn5wVuvUV1j0LuCAyEcCjnuMa/xkXznYg73Z35ELtzy4/X
+Xfb7gya/+/j3+SHLAPER/3Nn7uUR/6P041J7xP9yITcicn9T4Z8eRPeI7r879/SI7jn+HRNFhOQh
+RxMxRCdSYyIVgyo0vXmaBOwngPXgEEYR8RZAbCQW2eNXSIORPBeE+tsjU24gapULFZXiI1XKhX9c
+ZBTvyXyW/NK0ABX48P3gXkSQN4i3oWXVtSbdqtLLzARzS2N1MGkFFudz9pFWrqoUA3LJODTzRoal
+142bnbVeUj8N2HSXkNoxKSSayvDYxj8XseGnwwqhnsbsSBx4DgtC18jAG1UlbOim79WdOD3ekkGT
+okcfRizCX83jV9pyI/5YzYDRyuOQD2w/InI4TpHjpxJxUlXMlcsM2AieWQ2Jcjjnl/Mu8Aebv7V4
+f+vSJbaX+3UpOUa0wwuVwwMaAC2AbqRRPWIMVOQDeHuxT0Z1+EkYkVqJ/3UqKskbTVupilLQ//pi
+4/VAZWnPkmslA3bloMlebrqTm+7jZru42R4GubhyvKeGhCQO2LAmu2RIju9ozJ4ciWTzyGbUwD/x
+oEZ0fC+dRRFhOmrmPbaab6hDiCrR0q1wP12k7FfPKZAhJQ7ZLFlIDpoBH8geY6gcyV5GTZ93xwKd
+xHMcbwze16cA+7PvKmH4wTXsRJhjeBEgvycgju8SKAwTtYIRGMCmdy656UBL7x6xG37czQvK0f0E
+yFEf1EAK33dXvMp4K0m7MQMIXmgGlmxPYax0TWJjzkkEVA6LSzspYIxAFp0Kd+EdfeXIrAXPQeTD
+L/CJLYw0oXDgEVYWBA11C7xdoVb6DNmwj8eFfnSBR6Q/XCFu8hf9BIoeVHJqk+Bz8DpJdPK86AC+
+bTEgHeR4REYPflENHpnyXYvoXpomNxXsWA/nf4ku8Bke0cXMwdi4MVifjgOM3CwrQ0HIo8npYKsk
+J1vGm+Ez6nE+OXDeqayA2sjAG1kk2vF8SjpNJq6EjzcJ3gJNZRRLESER3E54Ne6Qr5BUIqs87h+l
+wDxOhZkJcVW9q7nX35wjU+O+dvIG0bOgkwixL6otcyOWpFAUmprCs4yfqXxrwf2r/+tfVf3J5RTG
+IchfsRtCjigACTYRvPI0cfo2XnMSlIHZWH1kC5PuOELnPbEPqOQMCaOEXHRITgSJLCIbGXijqiQF
+6afUJ4oHYnkYPmUHnFR2wpkafAuLN4698+LgKedvIc71Qj4Xkl3UBCT9FXn12IjKynUqQAxRLMkb
+lUm3ouYvo9l5Xbk2A5gK3SphkPDAmUdIjA3jBx/RoxK8D5ILCNnqyqsegcfBtLkVsV1pg85VT5sN
+YmmjSM2gCoUkkCVO8ijiIEIiJdlBVhiagGudvOsi2A0n1YSzdpJTdPI/LkYDMQU1Bbk+AUpsLmgQ
+f+ToDagsOOO1QsKIUOM0QNh5nvEG4AjD8yUGYsoy9xtYJkhsfz6pLAjz6crLgLyhVmqwetYJUuSp
+tTitFtw/07gVQGVSXRATA9a0FCCcrugSTYKxYPIPUFPAfiloGlira5DPzJ/mhbK5iyb5iWXBOnmi
+1lBOkcnXGLzBNGmSGGx/1hguA4nGlcEAEmgUtKV+XfEGSPBLB8dxQSiDM+cZiczJhjhwhZT2H9nz
+u/b7HTORtJ89wLu7nQyqUcOZKe09eJXxVpKh3VgTYrAEkJ9YJk5PaRllzT0XlbiKVpzxbOV++nII
+kwjEoLgXzg54TAZSDVp9W6sejPxfFrdEcUziMMswNCRHVu7HTLJBjUpSyS7twMevBPmSON2ye2/d
+u58j90d0efxvGNgzH/s8z8tyQcpyGEZnulyRZ6omh+1LkYsdM1BdNI1p+ZwRLZ9sx88GFDjW510E
+qxQRsnKU7AJHB+9kKsfImXGjXWiyi7fqkiv4S7rmZePvNYfgi7cSx+h1UROBKDT3xQeTBITQQxQI
+RIk3nr2PbjyCIILj5br+0sv64jg4+SqtaFsUa4uyUQpkVNskNeaudLM8ECIR54bTTsFmF9nm4oPo
+0mGLjCHFJ4mAG+dKfnSYwsdw2UmfZ2SF93zZ6cydJ65l1zmZ0H8TScwFQrRqkgzUxFXbccSXHbXI
+iOQwuCYC9Qk64RoED94TfMaRs03BsTsEe8iJByed8qpXLwZsyl2qVsLpuCbc3xBZeVQtlY+l8nYI
+CSJCSOXutGMJQCjek6uiQmjBr4riOHTU/vcR+YKjuj05lWoYVaib6+y0hbesFyrGe1Fkj60ql8Gb
+ja6Onl6p+FC3SfAqEBweHCANBmUZkHUwZskG0ZkrbwBaU0uvgUSI2rqnSTBmhCiwZpRYs+bNvYX7
+UW04C8mVnF686eumhbQpmlaySRwrH774HLk1hwvWxoccluMhKyRyb9uBZTEAxxEHW8ZVhxBiuKxm
+141NLhubHlNtHkcN10RNLySbXMs0ybW4qJK8klRqoCQq6HsDEsvaRMD8FyGK5E09+LK7HYTQQxQR
+pptFyjxkGw9cMDRpKG2no/BYifVZvKFlyITQiUDbyfSlhZRFiDNEPUQglXSaZn2GKWC5zjjei1Zw
+HlzVNSE2gG8gDwkhIw1Ldi75yQZWUHHZN42fcmdAZNhIpVewGFmqNNYIabgWpB+9gxORVA7X7jmQ
+62aGG4BJAgCeui7WcdLoOGkM4Z0kR6ndIwFy4Bie+Pb90IL2Y7k+h3aDkPeOPsNSXCTv3WZqwBlb
+G1aHF00iRTTNUy5kOy7/hqhY/uhYs+hJjliGYlCVFKROIDVezk+CUZoq6WosaNqKuck6o7FFaFHe
+nAvVhcjYclgzKMEon64tqOZbJa4JgGSIZ6inghiepPTiagAQDkJAnYRrrKlIVBSBQYhJtrNgUteL
+lEOyrwoGNpboy70EhCRCdmXhNyPxaa1Xl/j4e49/x8FOIirLiDBQoUmIQjjGQAA+nKQoTnJs8LLz
+m7fmTbLbAYsNmwjm1JiuD4HkasWWYAX7zScKuVP2xjD21ouct17ivPUCB9CEZqp4C8ixp4hEOVRM
+/d5ZGmyYf8c/3wqP82s+E6sxijHmiJ8eRcRbQIpW5KJ/9/CmYqAmyRG3X39lteFX3vkplBbrzA+X
+mb+nVva0qp5W0XNWE537F936F8HJj84ZsR+Pmr/aHQF4Nk003ML9RXf8ZW9M/375XXoi2Y7d+4sF
+vCHIC0j9bjkV/N4ofZxu/+otwr/67U1FaQcoTJzxX2HuDH91Hf8vFvL/Ir0mteYb1hsPVSqw2Z1c
+5/GRJXbhErTmVnGprt40uXUckjt+d3QjRJPLGHOPpy4h2FNK1nIzOgdLNlyUL270+yKZXwtxNILD
+/TQDNqHJ734hZJuObDaqfXj19uMKLqoAFZQheFjhmwMf3vQPb98nQMEx9AlqRDaB2/qj1/6feen/
+Qbt8AufeYxiCnW98T8voP0Z2fz7i888jPusjPn8fcXvEzT/idj6i3x6RHpHc+N8OhORFVkjOgxxb
+/ZP46MTDkSySiMPFKKgeeAkJUGQRES2mmOSh3xBmLRxHH/FpkpzHZEaATT8c8GANCSvuEQrAhIr/
+HJl/rTMwmKwNoBX/kfJ/tL4/kZpKySjilY6C8JKwufiaBCO3zd5JhBdZVFYBkZI17xvO6yXb+NJt
+fCGPF7J4SQ58uTBGVzHrtr1s2+aKkg7I/IHJEXJS3rFJdllCIUk1ZJYkWdLdI7s+IW1yECSiVgMU
+i16ROC/e9xeKkR1zybZcPyXvBjv5yVIBVuCrFmicNWdW/mDPFVliIFcSQvIq462klVX+T5SiUmKl
+tGItUJIIFMYZsqUSQv65vZFEeQisxCtRWblORVCiNQbiQCg5HmqVelaVDSFi229A7XABBompOtnV
+mBAP1JOrKpLxwGRA22tlczbg5nkTQg+RRJguWb9lEHlTwnt1mIsIiYLQFLJTnvhkIUMe2T8p1eL6
+yVhxMD6jS9sjEg+DMgpiTs+Ch2im3BeK92TpM4tmaVSKN1TUcgvj4tWAo7AsCwlDyMlpHaBwVnho
+DdIrFIOqNAGDrLFGoC4jC+9GwC9iYhOPNvzzJYgKyWIEIxqNpq2mW2ibKI4vDhj5PEqcp/QufC0H
+knunfinHiJR3ldz4qBGaFi64RCMS0X8pHRml40YFZAXcIoY6JgH0w1d/Q1SoaPYfrAiwPFVqRFMJ
+w5nFgtmIAOIQSkUAMI2KjE3b8XM4Bra5iFCMEX0ZICb9jPyqWBQZeCOLRHMyngYW2wzCtiDWOURV
+5xYs16axn7Van69qfcjAG1mkVUsLQkwjEV6k6qTxIjW9FT+LblkEq5S8CD2+hONtrFRIQS3wLwg9
+nS6klTka5yt8SRqyqmwA6edCGp12lTBwmUcuSSWbA/+mJtCi3MBIIqGjHZZxZLdhZFByodDK6gJZ
+o7Q9yE+c8VJjcE4rL05vWjneqzbdcllwmnuhlRHREIbTDhwot2GjBf3CcfWZSW1ToOS0KJW+FP+l
+rVnU70w+9KX4L21N91nSLcc1VFrQL1wWrpPXrD7fWS1HI3Ra0C+8uEh2MhneMYrvfC1EOx9WBUdk
+yflUya4I8IGuSHs/SYSoAbegaPxW8qQyCUZM93ed7R9hP4ZABcL4dyN4pvAZcmNtk+cplOqCeMCD
+wna44tsgXMmEnxFw7uPRFW6vEDm76xPG1gfOpqInsvQKUY/kRaOp30pm+sXcNLQzt/AzqRq+J8FY
+wvmIxDXC3UiKvO2nC42rdL44PB3CygIm7D6WbOatO3NyngwkYi+uCorAE3aDxnacnSKLghB14Dtd
+3I7JccAnuYSAigj2kSs7DKFpb4cISgbkJxZDScesaYPFy6DKGG8l2S3GpJpKOf8z4iAbyOkDVnAE
+qlKYqG5NZTQLfBLCvfBFdMJn9wWQSIZFACfCRDWl/JcF55g5CLytuTgITsdJMCIkDAZJLgySbKwM
+BSzZwAU3NHcL+OwdI56oGxRFwD8jhDLCYcjcE7O+hSsSVzvz7s+y/1lmBU6ZNvd2McJICCOLnX9F
+Nyh4EQ5JgjjmIoLdLiljSOxlRTJlAj/XSPnCdRbLrCCLc8RbmJvk2yQ9LknlivQKPl9Y552cvpTV
+DUPbFTwkH6BXoe1wPS3IkbzSLglKrq7wMuFkjQikdEMiSeMq8Q0vfu0B9zty8UuFZxafJGq9wsiX
+Nc5MLndLHiWU64EJaB0l1GdwSSV6s2JVFtjdbQBTRsgKzkhDkBdAR61ycqqROH0MNERuByfnqEok
+QtQkAnlVHSwGicclsrBAnhV1qFqHanWoUoeaiwhWGwfIoxWMnsPGjd+2Qy9PG//CXORIia7dDhdF
+YLI2KCqIX9nDNmnGF5k/MsLGxYeNh+AWGiT7oI5DPPP5XLAa/51EaiWvUAw07sBKoKKa0WBCasTp
+mYmULtyMmmylmkRbNs3SMuwbqWRDvxAOpW95uxtNmkZux75lPyoEVKkPEwmP5uwvRyc3YX+tsBmw
+/8uKe83ieDx4j3+sab2JEFYWESF5kUWlRB+6qmos5623/OBsgCW39DMD6ZpvvTnGdEPOrOwE+KYI
+8b+OeiLDggVGgDeqSpp10UgzwKVKdoEDfNDRiF0CUuKsPYRYIeCdkEfCwfkOiMPvaQS8kUVKVYI+
+um0oHmHrSpqoQXAzIUAmv+Pfc8D1/PVo3V+sEv7qzvm1pwrpFyG+rjAAauVHHegvnSSisiwIoXCI
+n01Bcil/w4afPFL51uqqhu1bc/Ff/TuzQF/JNU7fuPalxSVWMalENvwGJgWY8C7pQaxzo4/UN7/1
+O9KN2fYtU9i7ykiJ2y70Gfc09kfc3SPuz/aI+xUfkV/jxWF9xCO3So8YHlG+t8MbJXwzRRKBPRVc
+qCTCixQz7/EBOankmPOB73dxyJZECFkpHAx8jn/pcwJs5p9wBDy/zAJWlDfeTxLDkyJCuGJcgKwA
+8ZZjP+iPTAXEafHiqYBSlDLMCFGciI0Eokq75vxS69RpQb9wWXn1X1hrWWTByXD6yJ4U5Tb8fFfr
+80+1PrSgX7isvPovvFQrWonSbYxnAoVGBt7IImemS8UHYtHMVONcJokxOJXJQKIyRHMzEmwN29zi
+Ppu18eCqpMZbZZTNDvJwk1K8laUvCX7ZkyXOIqC2znXCFqLzFUcQWv8yqyvIi9XQSO03JFTKPz8B
+XbhQa8GgtgWrcjYQk8z5wxPT7PBk++0QJhHDyIXg81YiYU1VBpqBJr2CWcydioJa0E8G5WIgkQ0/
+fTI0c+4rxntRZB+sqqQbjbIdqBRfabNAbQ+S11sII39cZIftkB+8hO1olPjZ/6GEhhDlvzhAdi9s
+54sKL5vLy59FcpYvDnN0LIq0MMOIGbngJ6pDxMgSeXg+KuQnJehp6Gd+BCMbesrsM9BzfAbwDHEb
+taCv6i8alX91zspzgMgfDoR5LhBoJ4TszFlyc0HvHDSElJwsGph2T16cGlz+PmL4IQ6eFJtBBUVe
+jVESY3bNgGfcwhLd820Ak/wGKPzQ3vl6V4kWRpuLAm9peUC8DdNqt373Q/i5rcB0wjgEEFOWwgpC
+WCvu8ymZUWtW9U7f4MslAzGNMW0SjE3ueQ8MJxkgFguOAmzCz1PCTx4BNzPGfNwtYsF+Mur/NH5M
+BRIRI9zlN4gCMCUvAmojEV6kmJGx3tIIdktjEK9GCngji5SkR0MIl9HXVU4LlUlV0dKje8sDkpBq
+qJL5D6YUQpKvXtoIl/aPEu8vVbrIMOReaUExW4Vkv+PNcyxnWuuAgy1Fwst4jcXhKjn/AMVS3ZPK
+gtNcaEE1byU81Y7zlpA4/NLcAWCNQF8TorIyuyTCYLyn0EglDHzS2rH8CpkMhkd4xPCac+KXnKte
+y6yYj8wXrZPoFy1JSNPQVyJVJIHnMPIlHaA2oQNDlWIVtpwiikkIJSeLXcqQffhCJ3xJUWApDYu3
+QQ5tPdXwKSa6DSEsaacUBnHTRTSczvZl5JeBPIZ2sOj55aJDMm6TGAlCkuPkE2O4ejOwODDnyNsc
+Mwdp/+VUfAbjkT7i8IjSzaP+tAAkTYNTAcw3HtYIp+PgzT+6DOeTAzxTHfBz8oAfirMwHcUMkrxP
+6e7nM2wsotozQkmaNWVGLMLOT7QPwI90MLye5LxJdkQmxO/cH6AjlJDkTXZMnnQFxBfMNQCIknCT
+hiD58ZQSon/5+RgB8hNntJZ6I+QI9OpT+vQpLqhLaPNYYSWSkdqKggzd51yUEH5LIvIqNbENhswK
+RaUYrErS48/QItWF4j05fcXYAXYGtDLXEMfhKYfciaryzs+Y7ZxZRiMGdBP0gtxrSAY0iWMvDpD7
+he24pF0vUgHP4EXouAS+haKCbs81f+AZ5Ffq4bzEzZzkaS4hcc4XbxuP1edIMDaAh4LkdhIxIsez
++iEZwJRjUmkGucMY+OSePGfl8UyNwMGER2EGqOAsfFDx40pCRv/ruKxJvrobkkS2kk8m1tmd3G6y
+AqKICC+diwiJUxERqsHs4apHmop0amjTTn7izAp7hVl2Ktgy/6z5SUWfTi4nFJEOBfyQgDQkYeab
+NIQvUktCCTVB1RT9EsEOXFEMYEOQVzALstupBDIwk6XXwVtYHSAzQkmGsDsRUSVphOy6QWKSvb1r
+32AI24IzEas4HS1aXdQv/s8UNgftLsF6i2yk1vzFgfaOl9tNIvrpeMxMmC4MgUNJdq7uWDl7p5fl
+K3vqJTvqBSt210t2F8ckRx0aNRGscjPniB+dMCW0UY7UTwP4tvtir+qk/CpdrOJJtoBmwGCdGkUR
+iMLJImnvbnLWkHeFhNTQu1Ev9HtUmS/5Uv+LdLjdjkMBN0dDljEs28CV0w+urgFimxPsnHBEsjRD
+MahKCqhjTvMwNBaXD6n0CkVBXTSXoY99dDmuBQb0C1fpV/h88DhxuORUdclkdIzG8a5uGw9nBP//
+M1XYSpYreWNE1K3HSy6KrlzzOUEc+ibbZ8wRODFdV0CI6l2yC+Vn3OG6Tsfd7brG7rsKOa+SipL4
+Ftwkw2XAVbcjtI9QV+J7c+GS9zIIwL9hpgMgbyi5M7MnutX/xn+nH6ebN5UqGqa2oDJpRivdKlHW
+/zr1ohI+wYvAYSW0GKuh5sGc08rTKZcF1TzPMIs2I2mSn1gWXHwnzvroeQh8K32+Sv18l/qhSX5i
+WXDxnThLjVqQHNMDIaUR8O5GBqyMCamTZpVlfe1/eNlE4LVV7vh1UH3yRlTcdwk1EsLhWs8Mx4sD
+Lq9iTlbxoEOo0uUrd99anacI+INr0lrzKYJUyiYNVmwcoAQsOwwx5xXQUDldjqi6GlFtMUIIl9fV
+FiaqrEvgnjyL4BSSZCoZaAo5t1SdJ9c5Ta7rLLl+T5LxbLGcEutnbBf/O7xhYcDIYvxwWwRbiYOC
+UB5TBN4CUSW6dJt3SATbgmFblGrcLDP0UPuBNki2qH3dz2h6P2OAPnK9KOZUNB/dGHl8UnBJ2CxN
+CwY5KWJYVhRXCA4PQojMD8v5mGsB+J3CENLvGn7CGNqx51+WWM9vB25xLkh+VcqXUhctbF+KNuSx
+3EKdWtoXbY2RNHZrVRQx11EorVwXRWvQSrjqPblR+VLqoq1pMJ5OBX7dpeAmpQXZIexYjgaoqZGB
+N7JI2f65ONmW5UnmXCaJUW5hCMV7su7xqaGos6uAnqUKaEJpPGkddMT+xRrPb4lUUiN5hWKgcbJ9
+2tYtkgj8wkWw1gVJlSJSdfTTFvHzhNBMyBjAwM69WKfp8yEgKGQetU1S43B7z9H1LUPrG8G61Pde
+lvreZH7LUh8riPYcyiLf2xb53nOR770s8r3nIt97eRg2vHWVHyAmlJAQSkmWWsZ5/AIIP/8N8gBK
+4A/MGEi8+svJ8U1FznVvKlfOUah7WbmbimQgYjzJNUmNeENl4A/aQJyaC36kioc4R9hE8AsHlBCH
+98gGft9CePNc6i0Tg7fNC+Qex1svIvVGx1u/vTaRM8s4uN/Dd1xP/B35y0E5Zpsfx0HxIuT6QZGq
+KCw/gVjcj/inP+Mfl9wjxkfE2zIj3ocZXaIYA0NGOKzPR4z+ESP9Uox3esSYb/+I6RHHBuVHzM5z
+gD6XcX8gYwbNorLk7YeEgcMfDpDiByl+JMWPpPhh7eQgwEVW8LI7EXePMHGgtUjIMyFBkjyT5Jmg
+1UNf97MoiOKvVyqwqR0ISYQXWVSKF4mUfBnEhL4wCKc7pXhP/vbRgy3jJ3H5Of7d+MwxP5qUn65w
+iFN4xnMqGc+psOBEzyDmQKJz3fi5lRGayoOQAHsSB5wYSeWhx/y0Z+4EKy3oF15cpBwozfBDC/qF
+Z9LPkpQrzVGYJ0KSVxJXmSrmJ56IhuQBEhiFSMTw5VE6Y+2ZhRh1qUwYx+GimJv5kDcqk6afEWq7
+ySRRwGKbgZow3gNvgc9Su89au89X7T7mY7X7WO0+s3YfK+FjtYtSFmKaFdhUeJFFpDhqBlYpfTO2
+YbxN0TRvMhBTLiphePNZQIBNBwchvXln84tl8xZG8OJAa/ySkMcLXIpnrEqyqJBNBNTDFRgOEn/Z
+LB0vttcf96sShtSRJE+JCAlPvhmlpPmxIh6NLgOY+iUCKpaW8pY5wCZkySmfWZtcsBq/acF4L4r5
+5DIJDoj6lQZgYFM/EUKRsImAqhXpWg1PHABrc8VATXwWVGLjTgg9BNYwlcTjylG8igiJgEDTsGTD
+5xEzPUfwSxwGDkfcT+OAS8OTAfmnBZMV0XzXbkgZtIVIeWcJbXgOfeeOt7v0pIJ5jirsMYao45wU
+tpXlaBGd0z5zfqlkA3HA+BMiqWQDmm9H+wyBp8ANxalABidC1LSrZAOiUUHMbyF1iBLlfixPoIKk
+uZgFgksGalrSYHDceWE74xeYWX54mfeApJIuQ8PFtYCLC8bbFC11rqCLstB/A/+4UwTHZA5+fkTA
+hsdnBWBKCKEgxKK0AEy9iWC1yOfjJrKZg1Hzg9JeeJMl2GJux6TKyCGPkYERfTjoiTLI9ZGAuqBc
+uY7Pr/CIOboRbK6JGBExNCeC1cgBT0bxDTwWhQX6gm5S5CVeA0TlZwl78A7KJUKyyZemVHeEN8Ld
+7QY7+cmaaigjxfnkAJVkGRRGVjwdS+Pfm/ByUA6mYjBy4+EpySUUoDYh6d1puVYaCudEFol0kplM
+BwHxNjRvVf770mhGMu0cyItDQc2gCv2qFEMJGFvT7jMyIKSmIBmirrts4W5P3QtzdfcfilHyxiNW
+Oe2HC8WgCiVvIKZckoGaeqVJMGp15BIKJFH1gMTL/ZSqIZ6AXRTJATdZGWozQLoTx48+qJHTXvBy
+nazFYgFTQE250CS+njSFRNFntwY3ff82K1nya4UI9am/2pp4vC2nvVMPUakYoAL4BeSAIE14kyvP
+G2WnwLaK50xyHv8/7hAxEvLZJL+cnBnyy+FujhJ8CHFURMCK7cgvjNZDNpa5m4Qjwn6JYJWTnMj4
+lJzOgCgOEweBRBSRVSWXkaCOvcttKeNIvuB/HZgiALxRVZKCL8n/0nwvjYAn58/IjZwx388VB2+u
+lESoHQc8oCrVhUhZCsJP3YaUhKLVprLwc6U5yyVE5gj0q4zhPstAnpu6DG00C5+D0DRoGZyLLhpz
+ItnWS+LFAXcSstxNy3o3LV/qzrE14PofEG9D2dqp/PelcfH/627DaqJhvBdF8ljVma6FhUeq4jjA
+Ec7HEJ6HzfIobC7y5PEAbBRDbUJpxlpR+NSLrAkULyMAgE1cGEUKLBNCB4G41D4qMf8olMTyySyR
+UeOTbNnxaXMjjuTc+VAoAQapHueH8lCcNQBqJolk5onzWym3rOIIjSj+l4umiqAg5BhOLj2vyjhc
+ESAKrxVkyQ1aqzR2raNMtvH/uBGcDSoT2qUFDnISwXEccMIm64RMN0veOP55QMZvA3LrErJnH//b
+QSJkB3fPASURpDo2D689HYIr3f3hQqSyIDLhjtG9vJZKifxk+Fmp1qm6r5e8+GvyEiH1oBjdkPuB
+0D4wkPHG09x5+3sAVwchNjwSqZQWrMZhsQeLkB9X5J52FV5kUVkFREqNeZjpGFv4TNWLFwVZyIwT
+Jy+c8zvGUt1jlbB2m7v0qK49qldcf+GZtDqJlDlr7m58ApL9gvf/QMZbSbNQ/o8VNr1RrixUM8Be
+SIQXOcyySA8pub7Xp2xZG+Xydbgu1mddn89vwsvuBDgFbUeIsG38mdRB0P9kJMK77RkSYuSAe9vT
+/YLJ7GhRBrPUJqQpwuI/t4FihMACC0BiMAwBZmJkp+/iNEQs6lGgSBgk59pKF2yoHLLiic5bctNZ
+4duerM2yxs6z0t8nKvX7zLcIjnoWiS6YEP/iB4yZVwR+uYl/sam/lJ7FdaaCcHMVbkUcSxDBdfql
+ghdIZbxLdAguHG38K17ojr+y2371mwRKVREQJE521C9+XQIpHvz0rYCYpEV+lz34y831qzHTPoK/
+459vS3Bz3S4ipCKSC7911fK2VctbVy1vW7W8ZbZ9uyjn6EGwcAvcY7tvXGl+CIMMS69QH/F6xGt/
+xCuN/6HXRyyPWB+xuvaI1T9ie8Q2RHnE/ogd76/tLqURsqXXR+xP9x439MemyBdpWMRbgH2e3K37
+kzjwHHIE77TOP33j797yTValqthokp844zWboEujkxen98wmlwXVBZMlw3irgoYWVqt59PPJHx6P
+fXMV4U+I5yDiIDUR7L/hNcV9Cw5hEpFZeoRa5iaP3wyIItHJlP6bKCkIOfIdoL6FiNKiSOzHLaC4
+RiKQlBuA9xUWc/uGV2WyrCobAPXRV2cqqRvvEv4wPAxpDA2dR7W+vTipfq5TKS5WzXRqyLjAX5UW
+nALqPjpTo0l+4oyXyoFzWpmdRj2856NxyEYqq4BEySP33R+YD3QfNoR484hSvCfLhi0acuWRtfsz
+xMg5ZQ7eyPA3jApjytLHSln/4ft8/Qc39jovm/UffpkA+7Rf9/Mz6j1idwbekXz49313O6mEgbPb
+xQlzkC4LP33HhR4kR+QahmCuLkSVbBjHR+4rxntRpAVWFZn+5nKqHIawj0ICt2xgA2/bizgYb8Iy
+iLehZD8VzpxbLkYOHG7hCVWhWyW2GUtvXdbaeozwY6/OwTCcT7dnlWIoIqBSEUFewSzmgjIG/eEX
+oRqqAy99gEhJ4/jMwoRPEhmyA9yJO/aJ97P3M8iL7ZSqYNrUiKsB4A2gKr/0XZS6aA2cqw5eokhy
+9IjTfh0tKLtrKmNAO8/8VwRyujigTe+TqnIDPUSC4Pa9cBNfQNIEiZNBbPweQoq/5uAlv5IYUnKQ
+uS3IUkjYccPLEOYOE6eqfDXUuR8nec5twM2iIIStkYiISF53hxQHjFf8oYUR8rtqBJAv3jfKUl0p
+y2XvolTTlIpI0dEI/BkG7pJpO/ACOyXxw0qaEhu5fPldR7efaHQ+71lNOG4HosYYfDBD6WmXHQhQ
+W48qYZBa7tBkBEk2hDBx2aHmiLZ+YTVOYHhdxEHZXOTzCf+YZYQkokKy6BKykT8KNULyIotKiVY3
+VJQPOgwFGAdw7BUae1GeYMKjSvj5Yi88Iyhy9i929i/LKV+Gj/LiAJmjTxbpj/JgRuef7XYeRat7
+sdBpT52TnrqsDy3Kf18al8w7CN9O6LKPKh467HLI2PFiF4J9fjGhV35G1E0a7Y7kOHXjoQQWMpOo
+DX2AZbyVtBTcV2Qp+QRxCtNlli9TgdqKiyIbGXglHHT4ljYLiSGrVJH8imUozdpsAgC0WiAcEWPL
+azMYEVwJVAAP8/dG6kTTCWHjxzo7Hp9h4ZIBpirG1RQm1BZnkLGS1t/DPDrjXzTEX2mGvzpr+qut
+8Zej6XzmXvYFqXwpddGwf/9Smmk4evR1nFkUp7lNirdyo0l+oqXSuoJzWlmdzDv3hayMuasWTdMM
+p9Fan5H1O4z/EQwlvx/x7yP+DeOfg2EIwzQc/7Ln7WJEyCeKeyPisJ8i6pAHrAcRVDxwKsCmfGUf
+kKi4Efayj625fXAIk4hhHImJC+bmueUq/44jgomnGfc59PM6EDpPKV+OX/zIun4yjRU+QSmJUd4C
+Zqjm4AVOZyBxQ4yi+bw2wid3kFvOZrecnyCrgEhsDEBMPIALsMk7DuUz2nfyGQXx/clbzkl3YiXh
+fs6dkoQS+ZcDLpVTcVe65Z2IN7d/IQ6w5CRQmTjktiq4gw4ZbyV0tsF8xAqYqRmICYLtldIrD8kn
+rLsGHrNGfWqOHNZ8OiMyqEwf7HDsSbupf7dGkQeG257Lvf8+zsf5X3J4TcLpHqf7z52P07mY/z5O
+96Q4wkiP023jn18LIDLeTN6dKoObVA0rLegXXlzGPlflQwv6haf/Z/GHVX4XMTBz+L9OKuFRcgoG
+m9ESq5STgZja0ZF3Rxgdtv0XIXvxx8iH5F9znW7b3JOoKnVQpIqXzJxu88GVMwBzclEpUYyMBQVw
+bmgJ8jmpDG7SYkSd0Rb4IYOCN6pK0o7644WJ8OBwL7Rnzm6/38iXN/SgRirZ8QjuTTGGlVGzI9jP
+YFSLpNgW8hOroVQSnNPK6qRZ0JKxZYY3swiXYDlT0eqYaRY1DkUjia5nNoDpJOl1B2Jglt4vmYXU
+cj0mkUS2G8fSiwP0qBelT4CVCkUDTvKSmJDcpvBrIC4poYVes9jXWu5L7licbouEvRMpwJEXK9iE
+PLA1/JsRGP7XXUElijshM8JnyC1sK9cv5T9oG12Hi2Fbtdzrqt6iZE9qx2dylc2hnpMk2ge35+Si
+aFkr6e/kTi2Wtpzy/9HqP6rUmN45du12i+qK1OMn1xqkwJ+edt2a3Z1ygAjjNMq6fef5S5OE6w+V
+YeCnuYyl3DNHwguNV5W+NUl19ubmNqiQyNS3SJrNdVi1GMVHBb5nvDCfXKCHs8ellHpl9G9OO6LI
+wCuJ91hfefDL7E834rzbXd1cWrBOLownQoLg6M1dIlj1XI73lESYjiNTKN7CkqiIEJXHAbyUa8jM
+x6v3fH70ntNyHSgUp5KTUiQMTJ6iwc11oTOHSjgMp8KpuPU9nvFR4Ijj5uwDDkWPWp9ooxObyHMO
+kUiUU3Iq2VBMOJVqIIXqjkkw8lnKl7C7aMAxCFB8RwP04sKQ+JAYgB3GBlHEaxyMRgb8vIbIrJIj
+GpUYEjf5z/j/4SJ+goSVxcZhrkHkyGLsrJ0/KmbgjaoSduruXaTkjMgIpy/eJTvJ2AjgQndKzank
+TJH1zmPkvucmIlAxqmqsIseO3Mcl3A9FjjsKPYcMHGwIXVTJe16eClWooBc2Un46zYSYiNQJNZIf
+j8aVJdcx1dww2ogy7Y0WZA+eMe6nEwVngD25xG9pobpot3GuX1qvK8evGOnQu0wSdp0X7IlqcAbJ
+yCLFn2roJ6iJCJuBbjUrVanOqYrqkaYiY9aizZTTjfzEGS97hfmcZA6NJpnx8099Pmt9Pl/1+XzX
+5zPdZn0+S86zPlmlnCnVJx+3t9Oni/8/q7nqZGgqFnW5bo2TzSq/DIVWbDMQH3709t+XNiMpbfSl
+rFFt5XiblidNf/JGZdKMVtJsbm23MB6pmvVQra6q1KTlPZf6EqV7mgT3HIMzgEm6bpcO0jH075kL
+z7wpF4YamSDtJTT0IdaquyvPK/ce0M442G5XRAx1DPaHex6OLzIHF/fHHZOqYAoGaqpHK5Im1CNM
+gkM7nUoY3kHcb5cMMNU+fEDI9p/cP0MGzAJYchaRd9GRdyfXbow3QyLI3kSM+JErn76CdxxSEsHf
+gADyw7gTqzI5v6CZc/YLqlmeJBDlFoBBQir81vSFJaJlPu2gA4a98HDF08oQ5XgP8el2lRWQXTNQ
+0/k0IG+ILgtWz78qCRZxRx0ijoAQNSUvxwyIOYpsKhGB832ION2HCGs9cjNgE7e6OJ8o9EShpxR6
+SqFnTgEHSOCDIHCahKe4jRDzLLhUDmlMXhmgIuskWacfuXYRgjG65A2oLDjjhTTbKHMKUJk0/Yxk
+c6RXpNPVZgCnXDpLaFX2PYOYDkKnSPUqmh9QHJq7DdRUyJ0Lkl+V8qWsSSx/1hDTtPUHzHOS6pFM
+IT+xLDjz0fybbTHeFksrS4Tsy5Z5Vh8KHbwMEyoPfaHKzbCJ7NZ0j/JSPiTPoHFWlNPgn/FPFZMC
+gEwKWIEP1QZL50H0T45hcwbswusjf3IRe/H5zJPEhbxIVFdIItEiA+Rd16uC2cLUNY3WMBfsiz+5
+RJFs7zydfsnOeeEZ0tPxasiL+BdQCrBnLPAAYIIuioRH+GmTYOyXCKjyJtCJbMZWvqTPRsfBk09H
+0T0zi22DeTuCykaTkNC7qxmQNwxvuPqgjn96bZPITzbfoZw4Uy+apD95BcgWyKLzJYc2Kd6TZfxf
+NK0FnHZXBTo1N6kaLlY1Y1rJEoaze5GNDBCVPNJfGAEB6D3G1ZQMROuX4FRGBYkPmKRHV150G8DU
+Dm0XJhh/gwiXDBCD5LcTEd0plFSKn4rgDNIkjn3SOGrjluMJmZrsREH28vSmmC/dv37szPDs0hNM
+la3w0ik8/+ATICWDYBTnnJznTstpyIdN81Fmd3tV1cIzIpcFxRw2lTK7NK5T4e0h7XM0uxvxfCiK
+8s7RMhFeI8KqtQXRsX/IVRmYjetUOMGYSNnxYUpVDS3905M1gvJ3DAYHUzk9dyTZAdr64dlZoFJB
+6iMP/BnFe7K2UVhvdw99TyKcNQSU27BNiotVfckrFAONkw0a1GbuGKoHWX6IkoMX72NUID9xsZZq
+rKWk3az5t7645fhIjm7LGQNLlDE3uqunmyf5+G2iyCpwctPG5S0qi3qrEmmSNnEkMWqaJgJqPnHR
+EWMuyCf/ioCZw5PGu7w4/jRKuY9LC/KLokf1onIGqbcStLeZwlF522KvEmUKonwu0ocwHddK5sat
+eVHh3i2HwxXH3SUu/8q44o9XGYf3RitzhsX1Md2O7I6dIUNQIzR7Iwz07ehVpfR8QZhxjRB1KSnK
+/RG+ucdCssnSyVsh1wzIG2q6wdqQpsykIITcGm8qXBK/2+V05/h3HAwnLOWeT1EyQi5W3qXMgNjC
+J4ST+CnAAW2EgRl5ni6KCM4gTaqC/IvklWfELSSWKzqd2qkmHsWsBRbV8fNlo2nkN2gtCq1RyCOj
+svmr2LwUm9uhEpFsxuL5mXv79AEjauSUHAdbJJVeoQqgjZPbeZ6csH45xHo0T/02LdKCckAn/j4W
+pHQSRY0uKmeKokMsK5hRKGI6MrW2aFErUywx/4oMLDbbvo62eIWkEh4n7EkFrNcYI5hqpYOhEamE
+z69r3GbbQXI/OW2HWDpmSEJpwSoMGVKAvNCeW0/q3vX+bPIYiFma4QacvRqQnzit0gLelZAMJLqR
+Sq+gMZasZenqYJRLUiOKz5KbIJackg/SORiqkNx94t/oZoYcQzKAWy7ItuCyA4Ao3OFkaSAxQS0h
+mgk598KnrcRTah6lcQ5OhH6OvHkgSdRxPQNYurvokUwhbyjNpFxNYfoR1/HYGgQ6rT60drq0O4Sv
+kDIoYWxPu2supPyCA0zITg6jHQuiei5P0ul3aljVEoIxSBo5E6Q9WA2CTXLSjq3cZaTYo6SKWkRU
+P9lw7Ms9izlHpMupBwDsJb/JACZuiMMlH2mSn1gNpajDtebSJDhkSZ41BdbbbLEtHT01lWzgiuHw
+DG4T4aIBdlHAzcgU3JVbBsGAJNNxO3BvPAVJseHaH+CNLFK2h7G2BeHxI3uIQafKiza9yBtqnoPj
+baj7mJU8aRqFZMcx0ILF0ErI0WsJOfpZQo5o8CBjbNAxNmCwCDJ6haj6NKC90l6w8JpC3kTAQ4bH
+cPW4HJlQl6j2ra3oF66TdauueYd5Kqvfm76UeH+p1gpsyGXlrwgkqzwqhZafTqDLYBha/sW2/wkn
+L5OkV0hc+RenjQQhx3PU41meW5kLyykuxzWqyyXgTCcnOhkOE/2vhzRJ4ooIdSpyOgZVwyY0y05U
+pEgZONJBAWmlxbUREh6wF7L09uPRRcGmJMxZE+54pEz9Xb4mK4vlXvRIpizkJ5YFZ0ZLnlJzZUTo
+jaw072Il7P+scxnpAhf/3lGBTXjwDM1dcWnFEgbE2W2AJA1gD4UluTJJelGSahzfNpEKmYIocbVX
+cZ8ON6TldnRqtKNrNJxpG8Ur0EYri2M0eVD8WfitHhAYKlvYMEEVkjgvQlQZFJqNCi2o0BS1OgOY
+sE+bnDeanAKanjGanJZwtZNw+2mIS2UVYNmxu7qO7B2XAamjQbpMevpYfelrDzNLpEX9Yr8q5Uup
+q7YqshVTQ+RBJbxw/uovFTzv7Pwb1gFnqNo5lKsoTaUZZHBk1DKl9lpxrbNVV2ta6BdVsNrWrYSr
+LciO+iBZGhe2vOSUx3/goJ4iKmQTwWoJCIcy9htOBRf29aWrzpctPF+X7Ad+7QaLIgLRqChLGCK1
+I5eL07CFrxUufr+BSJj5DDou4xsZjJiRP/et4p65q6wKzQCmzYkIzyyECOIQXLcDM0JFmJFXO5zI
+IDInAyoLVmNQ9yLIoBioC1poEFvQnEN4kbgvIrQYq6Fk8cQEssiFQHlGi5DmL/y09+nKxoGTxzHK
+Rtyny0ZRhDTHRhgqGMyC1t5ILAdC1I2fHxdZBURKbbZDviWxsLgEhE6E1CGgjlDwsHDBI5Bl6yrg
+M/p6wY3k4gNCjvH9NQQzEc8EcFSV3eGe7gDcQRTS2NDcpGnMIDTgLiFPOQoeMyp7SCJcNAhuoj2z
+8qXXxUAr+1VZvaRhobV78uefAj7/FvChlf2qrF5rAXHmby5rrpbLTIQoNJhciBY8g1V2bfzCn5If
+1KlIt+QdiAYLctcJUEFJhFOpduxG7UABT8uXsOGkgidlSxCr3NspQS6oAMjqT9fcmWDEs4IlYEGN
+JSJglgQJjx8CvFFVkuYJSUYzBo1V2cjAG1nkzEJ387ovpDPLrd6i93mta8shHiTU9yGaUtvK1ZQb
+lETIAK0IPwmxYTUj16p6EwEvuX09IKpEJwstnGSArWgfZNIvyRUjwJ+MhUCAmrCj/+BKoPAI8HJP
+ERhoX/zqDAVOiIf1hkA1cNIrcsorL/TYF7V1Jjz12zQpgZpm2IjKJE02bWaSPQtS4xXxHIWxRkAe
+aICXprWULycCMr9FhPZhglfFMiZA1vamoj4YWl/9EsF23hE4tOJ4vY/BeM/PYGy/PMFZcFVVZCCQ
+xzZY1iu8vpUq2i4SOkpAF8R9isIvPxmSPU4X5fADiRFH2inDy5kLocuduVIzgHMWA1TUEV+dG5C3
+4/aYacvvUoYgr1AEpObyi5WC+VT5H096Cq5IhjBZBUw6owkW20pY+FZqmt0HMlSVZDBN5g7WCAyK
+Y01wO5Sq0K8DYHsLql+C2yd8HRuL5V70ON3FTTIMuPNSiqVHM5aQG++Wgp1exitxEi1YhSFRmDwV
+iIOrYn23VJklVApRb+VPpZqmCHEgdCKiSvJKWtaBe3yAGS1TNMFqvCbTA3coE+SIlJ+LlHoEzUpW
+JQbpvKoe8pSNkBjttp6xRHBDVVy6ldqv4OIk9uES0CdkOMOl6xDRtaw4AcnovGRNQ1i2FL9rG6Kq
+lGMLyJezRebeTerT9E7EoKQSGQTRk6RIywDUAtadAeqPw0PH2iHxvICi+gWVcrwO1DiTaDppQSkC
+Nb2LCJ/PSeKhTXNbu9zoFRghOh5tL2/W3mjxN0mH4ndb7UIaNePWSLlCEkQbvmkxr1bY0KB/OZDW
+/ourR5ZVoKlkA2dxY/WLJbfYveyLe1QR0dpHb3u2TxF5RdKj+4641LsRYT+VKp9wuWQwYvnfecci
+cFjuceqvGwdu5x1aN5wNWJJXQpMxckZbbiKgdjldCcV7shx7ok3UUVA1TkK6rlR5uys+V8RQIcb2
+8ngjd9YrLqyqXFfJOFCPwyFWan7I6FGPfolg9U/wIljlBsFEp2J+U2V+U2UCUXUCUXmCUDP/vG7S
+NLZJYVtZDlbRkSAhRMnyg8+q58mqJ9iak4z9g5AJ9i6OwSGKSpzpFf3Cq0upi7KwlDwUtbJE4BCO
+e4jUmti2QgbeqBiZm+RdnfjvJr1CUZBEu6WpeJqtVnHGL2GGJLEjCfWiUg2VDMTETVjDjwhYtSis
+t7OMt5J2Z2XJKYqZq9DsRTGm3EAPUURsWG9ljNK2yppaxkf5LAYA9/KE4j1Za9eoyE2o2vQnpPo5
+DSM1xpnIVs9Z0YxPrP4r0qrMHIemSXXV1lgjTOK8zdhKftGXol44bwzi77FPVAfUUB57r/pFe0Wt
+mz4Lrwdiw0vdjPzEaqiJ5686jNVJ5M+PSjFoSiwf15Z9TgZiCl7ldJNBCMdT6+WptQT6hRcXLQ5K
+Tl/K4pfLyqPl2vh3PheVlWGXBw+aQ/gK459x/DOkHYbi0gsW/JquuV+k4tlx2w6ZzrbxhqD8mlQV
+/xqQ2MiLLCo1QiS2GCAm6cNC8RbGrx+FxEGeJxYqC04HK2E+/Nu248IjD2078AnQieLwi2NOiI1c
+FLaIMocFIeZugLnk9aXXxUAr+1UpX8pXmlWRTVoeaTFe3drKYfvW5MxlliVhWyI+/2zN59+t+dDK
+flXKl/KVZlXWrVnqJKMXFCWShk5ugqaxaQhYrDAghIlnWI379EGna3ii3jh+KfFeVMwRF60u6hf/
+B6VA/IqoKtGiBx4BaEegDgk1y/F3sBqid5BclZCIVCIezaddOyQVXIeAwz9U9LrIa0NNDyacyFsJ
+ssYt5OLksLoseAM3jd6C12Qb3kIhpPF6B93Yr8rqJVukWk7fmrjiq1ATZY8X/uGOiyvHJUZLOnMS
+K49aRUrJSZy1nFwxKLJW+4mnN1qVcbX2emAhodV+u5OJDzxsrGwmriSbjBh6/m98bi9JULZbO600
+SsNvTpr2I+tI1n9aQFGaXrtCk73cdMBs8pgcQLKXzthfYfwzyu9tAd6oKklB3X6ZoOjilxLvRZ2p
+35K5CimB1F0nZnpvU29ksmwAq4Id9F1+iNI+GeEhokKKaCJGLN+9wu8hu8diHUuuSN+x3NjDCCIH
+XNWOFY4uqxtdLkI7D1JdLvNYklcSF73w62l3eKRpUHEvxeYuPIXFSi/obf1yVR54x9DQCxHXtuCR
+o17w3O+QIQOSGJo8naSYVq6iTPNq7CBkmAM0/F6i42TVKz7wbsQ+vIM77t6/uaJvLIK88ZpTBTZx
+tqNn/3Jz/aKpfl94oINlvJWkZxhzEXw4jKP4L+eAycffwAFu7rEkBZlEg2+mE6ETEVXiZC14TmwL
+VuN2K0YjiQ3y6PBAmyFMRb20OJkYgMqk6WeE9hr4WfP9fOX7IQNvVCZNPyPLVyz9VKkb1yUqIXPx
+ZxtmqH9/sWjDEts/kt6Og4SwsuDwSQhZ8fD1+D3OzbW9EfWDn8zePzGIIcrDMEJi5AwOvs1/H3SK
+qJCjy8m66Z3+ayxwDXdnDlKSRVNFTsgbdufiokoZgMA3CN0UoLEqcLl0Z5wh7itHk+zCRzg2mvvZ
+3dyo5Acnl4/chfu4hpWzj2czceA5LByOHD5YQv3IAuoHkwjZt5/MB8enl5dJTsQ+n5DC43w++B7g
+0z1dogfuAz4RE9rjfF71cW6PU97FxWJY3A/REFgIG1JekqXvqOIXU7H4+Sl0M43t2oaviH4yJJhL
+O24DPhiZE6kVskcYomQX5eUZ2xZDwhlx/PCiEGR9wV1ffbVtuZwBXpljCr8aCcSGLuvaTFjz27xL
+PDfdPJWTV/DnK7bsDVvzBVubz313QjhINkolj0r9yGtjth9XsHy47dyYeJXGtvPL5c5tz/gxxLbn
+X97AvfCsdsjfx2nvztr28YbnmyHwZh8uDhHGv7ypyd4JhbdBbS8u8UUpucdpr4baXlR5ujBe8hHh
+GPAI4YBkoD4VloTfY27RFd4yfpKMz7fyIqgtUh4bGvvBKv+ifkh5K9Qmk4ktOdzi2tLIgtcUtkRh
+tAueAJyvjNrGzaYhixtVf49fgj5O/zh9pcfp2+Okx0nOcwDMvyKeLr0EsQsEy2vBKmzyl6gxs2V3
+iQUVEfEWwEmM3F5IhETpzIdc5CAgLUY5yAYwP3sok/hUQy7ijrIATPwu2wE3wvF9bgV43PpNlJNQ
+bUxjIMWs0xjDqgyQBFLHFEgEYjVpwJt3J8Z7UXS7FvW/f/SZX6T6xfFetfRP7HdagKQw18UHITZF
+mzT9HiGCOGFxI2TPGkmk7LRqe0vqVfWJPkMtFqo6qQd5gxkXZxL5ScxkiygKapG2Z+/m/nIJ7cnj
+jwB7tgOhHBS61kauyTY13aRmHbAtHbBp7Rp/QVrgLZGXk1cDTUYEwrGJz8AnFcgK4BeXCQzTtjmE
+rBx4gF7AxQXjvSjT2Wi+a8f0SFPBVGrVZsrpRn5iWXDxnYitB5+TpkObFLaV1635/FPvz1rvz1e9
+P9/1/ky3We/PrPdn1vUz65q3g06rwkjHF/ojRI29zoIHRfWIqIeQxlqKJQkEO/tIIrzIorIKmMyn
++KKqPIR7chxGPrmQh7fciCLPH0A8yQeHkES8XZyEfWNK+1Lq1OI9WcySi0WpPYlQexK7CtzSNYyT
++VV399S71K2RSq+gmTcdV5gVpJGC9g8hjc7FAKYNoQveQCKketYh9PAaIEOSopoLGaipkYE3skit
+7Taruy31lU+wMAYR2mybPsGnXBXJQExZhKgQ1hnWvrB2ha+esHQE2YV5k63Nm65NqAK/ptvebNub
+bXub297mtrdl29vc9mY/HTHFdn2jUlwil741REov6qcONIM5iudyLKDyjMhX98Ml9hidynIrsWev
+TgSJZPOvG268mcQdiF4cuHa4wqdP4nX7Ibh96cXbQy/ZEgC3DqXAF41E5eWaSo4pdTtcPxdkMzs1
+5C/tylJecbgoVTUA6oC827jLTCPt7jjAOYplBYzv3CiICactAEzbEUq+GhX3j5r+1dWfPwkwUfPR
+XsV4A+4t0iTEejmjC8H4E/NlANNBpX2Y/uSuiWNoh0HYDC220CQxZvkh3OTtS3HxS/1KtSqa6sox
+1JVp1ZpL35pGVtdL/1YkIU9ymZD0cqUSSruOnGiSn1gNw3RFZ9rdFf5SnCS+2ZMBTPJGUiUxNlcM
++NawsEZbmrakKV1+DbJqiOTrIUgY3jmir/66NgFxvzgZAth0oFkyB8cpgqN4jOSDY7QfpoNhP2gk
+CH/CCPGb/yEDJIaWwK64+Ies8pYJ+sOd5ZW5LeP4dyeGaUBlStuB99NN3lZl9VojtjVizesG4jWO
+Sgm4k0pJEMQQkkqNsCli/JohRpfy6QxgalkyeHLM+GZBMuAScIt0yJAVPuR5uOTJC35HSfLDSYq+
+0C9LLoEIIZTkaRzQ8loPiiFjRV/JT6yGki84p5UXpzeyqU5E8mKAZfxiCO+xYI79H473qskY/6X/
+968BVYyoUcx6oOIxPxbkFYpCbUKyVfisHsBKzb+zwPy7lsXX9DFjGsRSTvPMmBUwqh8p5L6QJVm2
+dWqaprjTTVqMLWxfyqyDGMQXxoSQbVc+Ca1UMp/peBiIDU+CC7CndAYcnlE7QZN3E+O1/UOw85vr
+KU+mDeC49z0Ef54KgryAXA+eTyomefXTEObiEhl4I4tEo57sQ6lllVSVAAjRbKdcF5zsnUMRAacc
+UKOM3925heXsauqtSqRJsjehqQeONlA/FxRXXCAAcBFp7FelLopuhN1rn+xXZUnzWdNo5eRihEmq
+Zts5h7KpWBQZeKMyafoZzeI/a76fr3xtU+Z2fCzfz8x3btncLNmkW7sX9v7Ft8/prO4IT4NhGjVI
+biMRXmSFRLYAbi28tYRFCQtVQUhZIEv88y8DjuOPHw75QtFcoJdPaE+M96JIh2JVeiAYTqi0pyiC
+6K30xnR+ME5jg2rNh3ARgSwlZz4/pDGuycunVLkN2ySJT7tKMVws8hZSBuUiNcgfx5IdKbouEF4s
+WfvJ8aWSDTvxIZb2I6TA8GHB340ZgANI7+gzcMKQPJ8Ax7SMxEdO4QOCxgWcmRXrws0iMHwI+plJ
+C4p5y9vm4qqFVeni+JdFlcgq9peLIaGZXo53zgtXzSlyKyZsa5IHRZU4bT7x+Ad/RqdXFw2nUTyL
+O1SKoRyZeCtza1wlPmWnuh0ikHK+HJaSZDammS4aNVrQLzyd5USiSk5fCvxsPTR9r4eyaml6WZL8
+ElqsoTZNr9BBbVLYJldFPa6a3KplQk9qR44qdaPA/VRGWqnXkFp9fZ2cUJlkSaQ5bEuw4DKEJJS3
+cDKph5wrU+fg4LNtQif6YFN4CiQTIJ3rYPJyUQkek+kxMLZscB2Emy6rXr9UjCPFjcOzbM5nyNSw
++i2Ipi+brB6VTdbbykby0Udj2bPQbpCkkVOMkJ9YDbFdzB+a5Cea72f6wna45G1pV9Uv9qtSvpQ1
+C1oVLcU0i2wGc/ag+qxDO12aTJORT3AQUh1tyoAbJ4B4G1rrhvhF8Z787WPTz7JhH3eZnAjFe7Kl
+7DqJYNwUSE15OwoWZYeGHDhkE3msKMjNLtylwGwMT/VQoaafTl6VeH+pWp0vA7LZXZI9CIKREHqI
+IkLiIGR3BlmqBVQhPNVEJZzPHnnHhLR3MoBfyiKg1kangUzkoSC+IUStQtMKtLzpNxBMuydrPlDU
+qWiTKcd71bTBVl0LgHg79EwN2YoV9BIzVzX2xJuO95lDVgC7SR/OZy54Lx0V/sy7NOjY+wDMPgse
+AxoSe6TInaWCt2+xvCFPtxlIGllmFqpA3c4y70iVEpo4/nL10SvLLU8LG1Zj2RPKHIGAbhE18DVv
+aUfIeKZx8M354XIJvyziR9q5Hnyire4ZWLAF84chQjKgSdVQiaV3kuPXp1uoUmqEY1JwMedkyBU6
+OOCuh5/YkPy0huqBZ/OpYjSoOhRUPEdF9aQiImwG6nTqYC7kJ5YF6+SJUgHmc5I5fGiSn1gWXHwn
+Sr7ZUzkNsHGZ18+CW7jDx9Y8Kzr0pNXKhaBxcVav+aKIz6YMzs2dwa2cvhQk0Q9RGMLc+DQ8MZ/f
+yuIlleZPcV63cC5uC9tU/jJ+Mmzjx69kwHkhwKWP/n4HoDHSlLJfK4U94Qc5Q5ElPSHyky1ZkmF3
+IDat8mr9GXyDhtjgKAkcKjUGa7C1BilNho0BWhUMHLXihsyQunFzYKja3yoe6xdAlOTDubTwySqH
+eRTWHAdPWW8UkoMAmk44RFvRL1xWrouyMCoqyrng9Gm04DR/virxWdEvXFZeUq85aSU2Vy4XF6zG
+waiLkRJuEiguZmm4LRT5jNiiYM7UnHfS0HhXPDW3UyJtG+YwuUmWqNz8yNmiwDnKxkd5zEFIIu37
+Z/+oEm2JddcPcEbqNRdBm/7wWcmMtuayaDOSJvmJM37J8/OV0ec7ow9N8hNn/MxovlP2S7V+rSbT
+gp+kGfayh21lF7+1eC86rclq+1KWKEkilnpiIqkoZjlzCVFZeXGx7RVliQLOH/QsikUtPxL5MmBs
+/8fybyrR7TyiXM8vZXHLlb4U/6V9OeqGqSqRfQuo63U4yew6cjEI24IufinxnmptxlXormFb0MUv
+Jd6Lqs1j2pqHcHS4IQPUQ7Dm031zpS/Fr9q5KB/6UsRvuSyDJmNLbU4bdGBYkVb2q7J66Q5g7UMr
++1VZ0nyWNLaRd2puU625Ul1csBoHIxlp2mGj48CwoFZBlDVGq7BMGZqzKUNzb1eCtdInu2TAubAd
+udN51XuzjmkqKkLodKRdjtY+NpRGk6rhdNYDiHKRoQdkRn69z5fi4j9qvFcDfSWt7VtbIzUZbNiQ
+IlstE5wmwwwylSeN2uE8blcJVeB5HdT0Zmc7HC4xB6ghLye4oYZNCZ4hYbw6eHEJqbIXETYDFxeM
+96LItGhVyQz1nDSNbZIa14Ze1HivBvry/k6sjmJrhf5iSjZ+B8ePDQ/6e6MEeHWeILbxi/XIc8R2
+vMUkfreu4g0kaZ8bRw8u1VrYepSTtGC8F0WbZ1GxGZh6tiD7W/a+DgY53Sdng6mPzHqkz9sQu3T9
+og9DCfmJ1VAKKfOBKGN1kiF20Icm+Ynm+ZnZwZYRLrtyPSS+Doh5OJScgh+/ISDR8rlQNZRNt6c5
+FGlRmktfyjKpKevjHfg25xANdzIb/9aTV7BbQ02aTNLw+k5IqVrHdn/wWCEWzX/HP6eQ9dPfyAFM
+UW0jq18E/3HImY8h8u9osyCQVFbAaFn+6coIn6Q0Yj8fJ0euEIwZoSgtpDaJ/MJl5cUdNRalPs4f
+9zh/9sf5U8b/MPSTHuf+OPfzce7lcR6fxxkeZ3DjH3SGOsQV3RZ8Z66nExFFDvPzwTuYZ8FhcweH
+lcNfviMcNuIAKxW8q8J2OIoqXdopZtWQqyKZMiEnRnLIgVS4PSvNHCmSyCDJolnyU0CzDLub4Kms
+XE3hExUvX42Pnmpi/hkJC/bk72XjF25he3HU6TYRbCwZoaPxueMQ6R+1futo5JLdeRlQ0UTJxbtS
+XbWwas2SF/6QlTxB9K9FkxT+pYuhmJ+kUg3BRSX5+a8p6hv0tLxqLv6rx/vLQvVLre0f9Stakxb6
+lYWvqUrc5q7aI30p4jc/Cx6+Pwse1s+Cs3K4lCh+Kep30Blqs+L4xo0SvzfWFD48VdPk49I3tC+l
+3F+qesaAFYtF+YoK27c2m3bLnib5idVw1i2fV29UvjVzTOM0EGd0PSdZBfBldEZe/Fe+Ky6FtTJ3
+W6gaaj6eUg32gVe25GYg/vOz6qv2T2T9T/RIWyvWGqbWL339TcFiTmH7V59NPG31/xi08NSoXFgn
+Dd/feA//5xvvw/LjivOKvArPGJ5UFqzGhRY0Mz+jAIzylPaqmV88J5FfuEy2XjIUTRnzddmx9xNz
+0Yrmck7SePlu/WSN6GkPE/UwHHPhYt1eNGuzPQY3SbLFV3OUTlq5TmWijusFqxwLhu1LqYu28m38
+Ds2UzF+qmCwpDvcMzbUvRaO+v288TCH9oa2tTH7VbI+Iqi0TEh4Q/f8ZJGt+5BcY6a2jW8QP9gzF
+OWaXJolRP/Mz2a/K7GxT/0q5KrYhyzXhl1q/9bBNvSw43QotqOaQgptnQFVtSe7L+FmN2VNccFY3
+e4pfqrXqmaMrhtNolcENBsPvM6SZ7kW3lHzjnbG3pZoqVpNb9rk8+mqYVlYflb2VYLtQNYnMZZff
+Mq2aRF7unvteFI06nM0JBtv5Vh7uNFzM1rjXkdt6jE49bP9a6r+GWw26YjU19Q27gZkuvEJ0UTQK
+D50ylpDagrPCc+p0LbOna0wv5EbEoufyjzrd55gmig3Rqq+ZLcPb+OCm25fucDVZoGbl1m5ZyHkq
+K4tL0fOO6NW10d8b/aNq9JazHZCizKiLFlzMYVvZxW8t3ov+lay2L2WJ0iTSSnXMBL5Yfck6MWPY
+FkV9wmlvo/nWzcGaY2mJ/KO1y6dtktzym6ze+JWAoZrbNMsD/y5+6/9EW8pedsrpS1mj5nQZ6j2V
+uQcabUf6HpbMJAla1jNs688ev1gKePPbIXUoxA8TlMTl172x0ijKQt92P3Emtb6PHzYoscMIhs27
+UFiw4VkcXxV6vifF2+b5rRMs2MVTEgEV7YXfKbJ48nt6DTUWH44M8ktGlqdLRvzDHjCZFTsZWJtg
+EakZV3vPqKhfGv0VajkZ/Srio4lA+UTyokgJaEZAvA0Ryzq52lRS4czlF5YCVBbkhLvjXeJ5nuBx
+zvc7NRGsHoR3xQQfOGAjfn0PSUUJUfxxkiGTSPQGH3M7cFLz2pBDclzSzU62yZd0gitj/AZwVMEP
+QoIv4SkCdn5PICQb6ubQI6pNvxQRjwtCltIPGMkQbrx0F3y9xKchLOTOSXBFDWs/MTcXYmOjcqqE
+QfpAw1P/AT/LYwGVf5YHGW8liapNpexsQSpfCrzHCgj8Ze8M6RVkuAHfSmqSnvcri1tK8Z4srrKj
+b3JF5TBwaxKfqnC88l76QQV+JH/ssUHoCfvR/uORZj+wtAuoEsdPEzBgQdiQROFXxwhYRhLHSVNO
+ImQSochOxaH7AsSUmkoxNFLpFTQGW7XbBZGQRb81qTzwL8xVY7XzlPblfBYxrC/iwHPIhsPxFRtu
+oIZX5p9iskm/eB7wrfEQ3Y6JQoSLfIFwwM7i1w3BQyCPGhGjrAwc0aPxo6dfESReWKFlqiK5QH5B
+QtCPxwmgSr5IYmi9cNlcHXK7SY4lu46IdGHkACAWEQWKhA3zVSE2/nCQQ1QJK05KkXtfDNRFqPqX
+ZdPH8YVvpWYQp003lxWlMtPjGTpBu7gRlRb0C8/EMjUV5Vxw8WkLhm1Rps/nu+SP9U/RaUG/8OKy
+1ES3qJGBN9IkzRIEpxLjLpAMOcWLA+TzQtwLu/sl+/sVRJeRIb50PBAiZS73heckBaoQMpbCX7DW
+g19hqiTGfKmE4ZfIG8DE7cB9LDoHgTlSjNKv8XHtIeGLLCIl+bDMZNm7U23/qHXVF4UmSxkpBTcp
+LjgLSXiiQrifC4q5iBBVqiPV2F1pPHbEgNVwAKbUxlWU4g3E9HTFQEz4ov0gT3shWllcxCbXYEIS
+p4soimJ+SSuPnkZlwWpcaEEx2yAkOM2FFlRzjjRJjEkzSGQ7KSTLKpHsLumuQ4bzqduI2Z68wQPS
+hUIr1y/lH23G4jugYDO2Y5IYL/I0SYx2uWYsEXLxILQ0l6mLoyB+AK0E48lvRWC8tKTLiin8izEB
+mCTUQT/OQT/aJE0RZv0SXIjx18nh+qt98rfIHESR0yDvzIFt21zWinIiyZXeGHAvVwK/uTnE8WmU
+jOm5MScqFCAz73hm3LlCU8ogKqVpUS042Rs6oDZJI+vgUWaW0sy/rvOLw8I5cjuZCJgR6rqNIvlF
+QRmqVebCIRj3tOQHnpCyLnAGDjaEGMkZikEVkkLCJgfGIGSxQUV8rk7EsKbx7zjAC2BDwtfXhqSm
+kl2RQOdYiTtR4l8dDoHXMClVYBEhKgQqkvRniSCO4qy8S44r4p953+1qDT8VZGG6nKnkCkrvZibP
+PyeBFF/7Mclk1EZuDfGHlKtBvA3Tal/S5YJKQqvUVMLAGXALodr60Qgl9kK1CVfoLOdsZlXr1GlB
+v/DiIlvEyuc7u88/2X1oQb/w4rJkZyslqk0/vZk5lSXqVhSbiDd3PMmjHu6nTRJjKznRgmL+Jbqo
+rLxEaIX1cUlD9uG4nRLPwfUz3wEf9GbhRcI7aooOnTclPHQVnSb5idVQUv+zDP+lL86VVvarsnp9
+Z9pWXtw+/5T2+be0D63sV2X10tIwu0g6uUjhiYspgJgKudckMXaT4ra5p0o1nCrVUFSKgfgkk8az
+Ehi1Av8qK+MRmKGGskXBeZd3KsjHh7oZwPRjDx+xIi3xI507LHcAU/i5RWiroN6nExFVYlKoWCe3
+BcO2KNOn3YrRSGP/Qq596fzqSKf2otO60Ikn8pWmn5Ftzdprzq8uc2p/Oa2znHjIXmn6GVm+Yumn
+ShgkWoZ/LViLlHDrslurzvlSqK9Q2iQx6i7X75AIBhdXjvfU0m6secgqheI0W8OoYlFWKzzckMLb
+JZWIeaNmfGzgqMHBEfFqm5AQkJOHyQbHGAD6XpnJ4lFzc5PSgnAIBHHBK/+K4Fjc8kxyvzPl8YIl
+OWXliHxzQYi2zNpcWd//BbwB0BE2hK4jg7/BB94FHFtfCDmd7jPbA7UVigbTVAMtCqflUhouCllW
+AE8ZU3M4Q2MGKEtvutaWZEks6Yboypje3pIdj43rlLzKCmgioAaoUKzDgsQospEILxK9sTdZRmci
+JZ18CFoeRaTqqHrHogBLKUY2hkeNv3C/ecU2b8T7mq/D/9fxmpUw8i2Ogye5zsDLWcVhbGVZAfKU
+qWG8F0VqXrB3itw8L7KTit4RL+50KDR5lJvCEM+ch9hGwBkgORL/8DkGv7gM+KFlkN9ZBumjvOtL
+wYuDQim5iEA+RfvyIPbga7fSDkwdAfE21M0x5b8vjSQyUl0o3pNnBizeI+DoD29Fdd7L9aYi+VVZ
+Y1D16vybksxBpgLHGPZkQN5Qkw6Wm9hTmUmFYnCY5htrRMazvZP9qqxeViK0jGrhuT6/MNIkLzMa
+RTPfgHYU3Gc1rosSti/FxX/UuOYSvpPW9q2tkUh2XTEs2zDVajr5iWWiOVhGt7bLdRU6ML5Mhb7V
+f33rqtVZH9P/cXiT6vkqwbV/Vf+PXr/1pXgx5PR/DJzmSXtujYev+qQDdzqF/MQysRpKKeBcFu7f
+CpIU3EAD0ETNpsjtNJ7ujdtY+p2WRfFfWl01ycbUnP5R1TuqtNziTBvFSz+wOnlGkJ9o1tvAMqtN
+5WKSBI12eaXRqvlv9dtXM6Gd0tw+0+DMr5gntzCG/EWL97cuA51YAi0KPA8dU0CIPwL9GJjpnfsX
+x3vVrKRFl+zCfN+VabdwROGRP6a3HM9qIb9o1vx4qrLqE6ZVny+t9nRp3fJ59hR0ZFxU/49ev3Wt
+QD6/Dv8tp43k2FX+iqiLYnVIG10zR9XU1fetLUh+UWYWQ9MUP2G3t9ZMA30pmsv6UN6XXhcDrbym
+pNXLKnPNXdBTM9CUPbU1HlkUvOBcyYyNJvmJM16zYs5p5cVJdklX4UXCRavu8SA/y8VUFRqAvEqJ
+wYvqlWRDTFmccll5jejlS5GoupXwpJX9qixe1oF8cHvKlVb2q1IXRTdSNI2KKqMltSHSB22QIHEF
+z4MK0WK1JMUeRZhKnVouKy8RgrWV8OyWQ0YoW5K1lCwXpRU/cxuyP5sBeUP2Irm+AJA3lPxILzyq
+uMkropR0LJsaLfrEG4RbQnY9XOdHMSdbjKQRFRqU2vShe2O/KNJEU0Oqvxc+QWJIflF0g6HNFPMk
+s6gc/UOu6jlBWSN0eisIs6ykVvx+HtIM4qI97CdIBnIJwSA+xXyaCIloTX2bdrufXFpPtCD77q7v
+ZOCNLFKS7+H9RfGeDF/56IkS+cmakhWdtUND2h68NqCyRZiV/KR4T9Z+w1oxMpuUfriEh8OE/MRq
+aL6XCEThcRaWXkFd5RGXemAnHLITjtn0Bz6mLIDSgqfUvk7faiH/pa3e98qSeUjyIL/QzI9V8pO/
+UiBf/OaB2peyZLFavlOQ/1bLP+q3txUtOsf+6XjsDOCNtIeYMr2NJMOBq7t5v0LjgTG6J0UD8oaS
+AfNq/4qooCDRtwhzueFAzqukMski1R8Y70UxHwHOyB7cr/Ox/fr10H79fmS/zgf26/K4fpVPxAqQ
+n1gNxTW5k1R6hSqgPhm3/QAcmXd3BppY24JmvgF3Mtc7meutX9UwxXxyN+QsLio2o1T2q1IXRSrN
+mk0IpgbXiHvJAG+kzWzK9DbS/KVfDGk53EskEuQa9G2EoV74KqDANFUlTS5PrgPM0aaMzJpIr6MF
+xZxT7mmjL8V/aV+OlnFOQ7Oh4MvAKf7XXbThSpUl5l5Qcv1fz3NwMaWqRgbeyCIliyLHmj0Qz7RE
+6jFV9JgqpK9Ankx+1Sz1lUubRH7yl4tkexWq0k1Msa37tnynIP+tli91FgUdaTMWXgHeCJFYrmXp
+FTRGsmO1Yi5bZc4qU2GZTI2zHRl4ozQp3pP1rDu1/77VJVcjrYzMe4bUGSDzrSQmdc9JpZv++lM8
+RiqTLF6gVtd3pzx+6bmyS9+aJHqTShhyj06gBAOXFoTjhTuIAN2CS24mDtq+TvtikAa/cJcQsNhm
+PvYUEBSjJpC8wUwkK0mDxI8/Bi+AesiDewBvpCOhKdW0YmQ2LbLRpRJxXYX48o7jjMftDrmUaO5l
+MhnEW7EoVAEprrlacVO7StWlcjoPkZslVW+SVHl6uLZwkkqvIEn0rGcPGNfl+WJbxGpS9UgtLwQf
+/hg7326LKqtAqrqaZ4r/0r4ctTBVc/pHFW88wwLQ/GhJTq7pDjVlJlWqE7QaQbcg2Cb02gzITyyG
+uBdhHG/TrEJdzvYSwuO+SKVXkHgdQkBsxLd7myynTo3Kv/o/7pLVl+Efl1XNGQVgNaHL6Nnlglh7
+dtdR67cEWUlu45/nYU3eisCSgTgoCDlHu+aWzoueIO+tCe0oDiJ46SQt7OhJAG9kkZJfsB+aKZoH
+HolqeTty8iW4LyWuGtLnnUyGTYmzk0WVJisoDV8bkQcqm2wjLmGbbmntz1CPMJAbqzmEe0a8tGOT
+IvDRJM6owcC1/4THGcadwr8yoPBXh8Nfbt6/aE3cQ8QnccPfRhsLXKT/1Sv0D1/ufT6URJxYvFas
+wvVxvh7nuFs7BvfX+3HGxxlH0efjPIcY2Z3X4zzL4zwHc6850UfOOix/H2d6nIlOPLkngFs9otRJ
+/wHrnehx5seZ3fg/OUwcAMlDFBGVJReLG+bZNQ7g2CSmcczv4dI+Mn+Of15mG6KwgOdzc5eIkeQZ
+IkISIcZg0t7upaq62pMgi2KpzIe8UZk0/YykerZ8nr/K/bh/VavGZ63G56saH/OxanysGp9ZjY8V
++JFqsI4xEZINvfXHOd5UX0MyGDHb4RBCCR/+7jt/GjdvcprMeIoy4xlKFtjQDU+IsbxZohIsn6H4
+lasqEa5DHw7eRYTafF6e5xkQRbKd12OGKCJgRZmeOIgI4UmRisr6mD/TFhCTOZv3dJ/+SwKIEyEU
+/CxHwBvF2xBn9EWhqRppaXr3wNA8cpm0GPGjslWTSMkkyaYVfJKVaR4Gi1ZNpUl+Yllw8Z1om4Bf
+EihNh1k8D9PK5qC9gPlW+nzV9fNd1w9N8hPLgovvxFlX3VFp7qa07iQpS8ImQkqobZqC+UiWtWWR
+rAcOtn6qZPfwE+YwAS3SglqrZR6/KEsUn1VZIa9QDNSRBMT3lnYIewwuoYb1EIHNsM/tG/63MPLj
+AD/rhVw62KLWqdOCfuHFRarGijauvRlW8PNdzOefYj60oF94cVmKiZaxxjcy8EYWOZMutVhrIMcs
+SIySo+YSIDJEh3WEw49GptQeZ/4Zq+uPM+9utHwftAcSUVmOyvOJdO8R4bAf/KKpfLhQREDlS5Ah
+T6dAE1xaEEfzotVFBb9JBNTflwhW8aG9fGQOSn4HfsFkPkaNwvgn2b+BeN/y+R23KTJ+cZflFgV+
+R5cDT0jwNHQOxXFICNlUVdz0ZmgIKakkb1QmVUUprWIWmOUij6Xmxb0lVPn9nZIUjFmegJnQ7xX9
+wmXl1X9hq5M8jmzoFy4r10VZGBn9wV77I3st8skYx/ByyNkBJ0dFdLKN0XPg3sEZJCU+bqJ/Zr6H
+oYRkBAsVEaajWlHO7tEHccF19ABpavn6HSQM+e1UJgNEIeRN4FqRvIBAaTHaRtN8B7Uq0dgSiO8R
+YjSAScJXSAZiaiJ0yDDWePIKxUDjZLMJ58xI9Tfzs3g5BoejPgbCBgWem+MZ1Yz6oeIxqKiQy4ll
+0SySvJHabpVSn5hhQXyvm0pnkJTU56USBmRwbxkvVZjsV6WsSl0Urch98ipIjgl7OG/cOvnlAku4
+5ZbHqBB5X0iDY2iI0ura5triaO+O743neD89JenkzHxS5/+nq04kgFIToar8IldZOvWi0dTVzxLw
+XQql1bpkszzek2XRhWU/DdjEV3Ln6aBg/54n5zkwjX/HAXZgcltm4RHyKJ546Tsnhw+H5iQvtBVA
+HL/pAjJsk1w0no443Sgu5rbgkgsOBmaDm6Gi0KpfwhCWSM2jNqvI/Mi6KFoX4WoKCG9gEZBkH/SL
+9Oxx9MTkHXfoxK8UYcFpPXFTeizr5kS7q//rtCC7EZ/cEs8BElnXAdZzwTq5LWhmSTZnZlOpqpGB
+N7JIHAoDP2sWn68sPmTgjSxSs0Bd7qfbDapQ/msg6W7J6Y7yHbvJVZUqJF90MZxmKxosESzGIwpD
+7kgqH67I9tUKJvbccxQRnEGapE5hU6jnJI3NwU9So8hcyQCmQlElDBwGHluTdOsgWx10k4N0JSlf
+C5euod0i6G4MtgsDpqwsyStpMfp9MqDZeHdyZ5fVgPQiFcjkRXKuSC9p9CEPGSiUEfGDKxCA2DDH
+Sy+NgrUevEyqxEb5uLNAPTAvTHywZRua8hyb8tNV7K+Mt/gIoOJjJbIsVAWLvpBeNVpQnCKp9Aoa
+E5KmjfiwlKF4ZNmBectNpUa1O5LP6VvDeWExiKofkhK+lZqB1mR9JefQZZYkFO/JVVFmufJzGMnn
+bmQATzlRyAdyGWozEBPyJ/nyFyNMetNfsLYFzZz7ivFeFDkxJnk2YECURsXP+AXmDrWXqilL32Wl
+Kl3HpOk8XuQ1eWYov2tWBblE3NgC6cDNrNsz+Ba6zfeWwzzzp0lcXFkOeVXjvWqaqh0GYVtw8W9a
+R7y4Ny/v/hKutKBfeCbUkwaUtuD0+dCCfuHFZcmm0yQ/0Zy7+spn6JW2BV1clDpZUNpZPm8F0ijd
+YWfQTII263ptkeaHoozrORXdv2l8eD+imqlvkVyZrOcA1QI0/Qg9Yzv0lDBeYBipWcwtu3I8P0iT
+xDdHMjBTWLDSgn7hsvLqv7DuA1bagmFblJlgzigWdYmmBf3CZeXVf+GlLmiQ4kI0gOOYR/pJuqtY
+gYeEeL0wCLnp10MG3uP1K2FblK8Ybel2kJYAXMxtwbAtivng816DcyKDqhS2SXY4sxbaVKZ/7itO
+/3XknOpMJ46FTpoksWX88FhOA7jBCpC69VRd67penvL48mDChv8Nngw4t5IJAgsN6BXVpcandP3+
+2MrwCGoNe8oLhYlwzDmpdFEJUXKw6yV44pvgGy2o5lx17UC1W7nXSXGxauvyhuOHmZBeoQpID25O
+Br/mxoaCklOZDJBuc/EERCz+JeyRRon2EtLkDCyYtDUqRuPLcNSFqzReozcVrxjkSqbRp5+ojE6o
+256jrEIPPmVyiJNPO3S4aVhpTnLGafzzdeR5utIwxcDubvlGERdFzXj86BLXR60Q9mArMo9ulYrM
+dbBDulyPA1xcMCweeuB0vXxOn1HuqEPeODgQkhdZVFYBkdh3PE3NsqKVdUUre1zNZVvZEkJirIRg
+Xov5UUZxnKNkPN6jJr0gR0qOzx9j+WGIxMGT3GnAmWH1Uy7EcgoEgRyTXE7lJO9/ViK/cFl5cbdM
+At5kw4hMZPkTB1dOdeNnwJTESPDVawOhsmCdrJibgZhkbBRSY+l8dzNjLSanXxdfBuQnFkPdnsGc
+DUfx5cj46j+VgtYrEnImBV+VY0gqkVryK3INmgsuaXLBOy4FEMUhAs6dt1J2g+wBbW/e7mv8Ewce
+IVvZE2/9wC9A88U7/kJ3vKQ3XuiMl/ZFPP2SL1QQD3HlS7K+qIlgV/VlZeTCDSRvNYSsDBEhiRAj
+OgbAG5VJ088IJTI2g7AtKAcxVE0no3TR431978uiqM+HDLxRmTT9jKxiWjpikH96ugOAStcqQtK3
+jM+PGsKMc1/RNfbCL0/M/H2OjE9z5PIMXoRtJPMtFBVk/yrCdeNfsOfi9d2DwFugqSSvJNvp5W2E
+ICSkzQUGHA98bBTa3PkEScibQmMuDAN6GhY8CskBIesehfgavlB+o/3p4k/94f3II3xyJfDOW34J
+uQiO3lEYTqBFrhdKcAXNE549eYOwGLFpfLYsASfAEirKCBXDVQk8nughXCIfXgUrqMWWYcpciSky
+kSnsgXFYfroCWQ0wIWKWTUNKbo3suAnGUx3JoArBA/YURGwqtX9KRTDEDCHmqreeFdFkF/Hpu1wS
+kqsCXuRJBhqFSbOQGrtAcCrVkMjATLuBmuppIKb5tTTTWtimZhytCLu8/Xrn/qrFe9GpLkptX8oS
+pUn0m1+LsmQIQ100jeG33lujmSquIti5hFTfAbd2SsGmW/9kUBPe0ZYL1rgK3lKdC3Z7xTFX5Vjj
+DWvOU+RL04KJUmnL+GkTpqKTpdJkqGuuiND7f8YaT2qVHduwqFQanSJE5Sq3HbNjgFxUQfFG00bV
+0EjL2d2eyyTEh41UqkHGWZAYlxtli2aR5CfF27hMmq5K6nZr/cKpCSqp9AqSSk99LfIjzLnIuNC6
+PLAO5Lw7z5fLL8X4OHkuUV3YRGDKw4QsB7LPxp2kEkayis9lZZySUY8qMVjgrbq+W7G8W/fAPYoH
+9HoQQg/BDnJI18h7vEpBWPXUux/8XepsABuCJ38FXkBMVHZA52EVPznIMnDJx6JZqs4HsDz5n2s9
+HZJxTTgKJ7bKd1aHobnmrj6AOIgIkXPDwYNFz8YnhobpEY+Y7Xi6GA3IG6J8MNyR30F2ABlr/Fb4
+BXDMpB6WDF1t0E+XR/emskZp0VRkKV1RnaaDnNwU1UEuFhhD+lchU28BNUiPbIfsBlz8tfCDEEok
+EaLq6NP0gSk5HIdwUYC8QhGQjQh2aaMY70VJ33Gz+nKQt/DGmgbAG1mklvN2Vq+3W+qmSrwXdaZ+
+W+a5TJJ4idNiybbkreVL6TiShmC7ds6o7Zy3goNICMlR4pgTvmiSn1gNpZw8OmSbJA6b8zTJT5zx
+lgG/t05Ao2/cNlH0Cy8uM4uh1PaliJ+mPXBzRkgiLYN5P3Qq6lPJwBtZ5JJFM9DYz5rr5yvXDxl4
+I4u0XDEaDSAFubWlSH5RJIPMJ2ztNrlUJw9QtVz4df8CiOYZQcPsFlXnLWnYBU2av0kOTXZB0+bn
+sbnJwNw6vbjsXvKQfTswdvWxPMMHTkfARk4ja/U9upO4Ej16hDrw9eXRoS7PDnV5eKjb00N9Pj7U
+9fmhrg8QdXuCqM9HiBTJlN1ATCJuFkjepLQmGTctqml6nIo7HhjBNUVPUu2kpWD07HxR1ZOuHw8K
+yYCorEx+1TSjqdZVn0ruXxzvVdO2YH0hXHJMZUa1fK0sEU+HH00MpqdLrwXFRauvG2V1DKRSDbpp
+c6NY8IpCLyHyJwcyzvG9JBLhRRaVSJX4d0ECkcrCtU3lNtRGMYVElYrZ5VnHhRguXvELnSHk+lRI
+PMSiqohW3DXpIr8oUho08edKyjym6/ylV+vTNejjuYKkZuRV+WV/AoiSymvtNcVMksvOLx/MvTbH
+3w9U8hOrYcgLLi4hw4dzanhGDaPZGMgeczzrMvvp8nY5gQoKGqcb3w7bekEyZQfoWkBvx+VKmySO
+V6CNFhSziF88RSREZm12ELYDG8VH/nv8zwe65UtGkFLRt94IeuMVJfxpwPymJZVe47xpTUeWkCyl
+51AWtQHwKAihcMhH2jvQnqlMkiiItItA7tLRx6UAHofgr5yNkM17DEMgFZ9K+JJyPMDzNzx7lTPg
+Xy4YN69vLujmjbv5iL5lNnJXNODHnU98QdmwKuPq4IOdiHPmJ+ASdUhcswlx5McVuYRVhDmQiPo4
+r8d50f44r/F/jP8QH+c1Uo3j7no/zvI4S3icJbnHOTa9VPc46+Osrg3YHmf16XFy16v7+C+Psw5L
+e5z193G2x9n28U+Ps9XH2d6Psz/O7n7D7R5nf7oyFnI6lnH6dnCA/d63gE85CJhJBve+GXAWPGuT
+Wf+Y4ZusDTB2V99ehS8eBbxRmVQNjTQxDPcI89abvuqsb7k6ESQyQiIP8dKQjaNsj7NG954vo1kW
+kVVlA8QbUprHzxN5x8OjLLzIsRL/j2IJo5SBH/0wiMR28peuRijqT3SXyirQVLKBlyG63zvPXgQ4
+JrrCreYvvnKFZEPJ+VTJBgSRz8ICakq7AZUFZ/zqguO3e7kbIjBM8ounTnKPU4CjagxDfD4hqRz2
+8RWljq8o9fEVJRG7SLhEEuFFFpUaL1ITspo5sxYQDtOI3N1z5LbzaYAFx/CazxBFBKxBdOkn+679
+Q4iUd5VqgIwkAurY8fDA3SVINvz281I5DPobCgGXFgz1/NbqoirzBoXCwajFH3fQ6NWKSsETGcIY
+no+zv0J6dhhesb9EjNyj4zk6JJmFAbeuDMcG88eYRkheZIVEq8WtIZToZmZ240l5jz7z7uT2RD9A
+m+Ip+ERlZUQ0omLApt0hLCylHL49wCLeArKP4/JrpB4jB+4QARtqEikklWIXvUA0ERL5y+VHfBJe
+oCo195sCfak3lJe6p12lK0Lin5NKqZmuUwDhlfmSrccItZ/PcD7ZUnDztseGovjgiS24nacChlX4
+b+CBOLbwDFZ6w9vmAbeBmPASTSaKcRL5yXjaadV4BgODfE3RFFo4bKLo19RZyaOBaM0kR1zIq9LL
+yt8x1ZR+JuXzysmaZL7qftUsndVQLfyEo4vfGo7pb0P919K+DVG2x4cT75x18f8YbKMX20wnr+6b
+6eoWrhjwWpJh+XEbNW2QH7e1XGyf/7jQDsUzREtS8LTlovwTpwfYl4HUJC+3ZM5Ry85FGuinp61Z
+X1PNxX/1eH9bdCv2WzKyk3hsgZ+ZdPFLsV1ievtXr1+GeH+pFnlTWZD8lzLbQr6RbUh+UaamD2cu
+yqyp6fXLINpJPjjFHe+9h2Ldl1Hd14P662NN/xjUH7+9HqivGpz8HTMbS3XJQ5+BHHwtx+zlinUq
+XHAphUZTMW/tmdfhqsZH12Y3uvinln1q9yRtjCtaUt3GS0Zz0DT+nUR+4bLy4r7k91dGaCi5rGxe
+8gteUTZeNl+Uumht9Zu7wdQvX14D/lLj/Y9h8Zel8qlO37nVYW50WLZ5SWmp7rnp+EIq0Mb/K6+D
+Px4vZcIHrL+UmdX82PWXOt3xhPainOS/9TUzNWj6Xq6sfaq4zbqrfNF1YN3wIAIzpZp1PK0k74Ji
+5aJtns3qRVqLigf3gDOtPQgKrTaSrWj8qKOmbq6+Jq1WKivXqdjmirJEKR54b9lk+tJmDhlXmgML
+rkoULUV/UtF3gf9j0OK6p0nTqFelixbvb30OqF33kj4KKty1Im8X3+Yib2VnhUpdsU62zvgOzZ0h
+fSnql2M/Jf1vSD7/rqyF/9pIim/N8lB0Pl1gEUmEF1lUVgGV41HYHRzSBETnEeLFO116+Xkir1N+
+v8ak8map9uXm9KpZJHkjtd0qpS6nSo7gS5vzGquJnU9RI+fE/SVth+uVxEBeZFFZBUSuzkGNKCtt
+B/9EVgBxYbRn8i6ZjLeS9BhmgSJZ+TIW0KJj7uMNMozSovDhywoc+gnXkXIdPYRLQSKwBVhqSXvw
+ATIZ7CrhkTlkDmihsIXLxUk6Gpt6TyUuDJ+fsBE/Zjx5iWgLStpTrbL+1/WnXQLkDaXyQXtHyp8R
+loyQC61IKksDrMAZj/3JlLi4GETUZlBBENW94MLx24EHU3rZeG5TcMVY5C4OwCylKtWFSBnbMQjD
+jFC8J6eZEpKf2mU5xrqyozI4K5RAKviALiHGzJL7YeEUGJbLC2sMRRbXii6ulRem1kU/C6uEWr8Q
+ssfp+Ms+vZy9iCCvYBbU++y6tSA1yoafuPLBE0G94Gv2kBUg9lseFOqlEOZGAHgRvAJCdGo8LNRL
+GaOdfHQHWpClrlJ66TgwsIZQWuZ92y9cvJffwBeufDk+SuIFwrqN45ZPkHVzTQRFgyoEGUnEE/fU
+mFWiVkykJMmwfxhqE+KWrBvvqLplvKtAqQoiUq5uAaFOlKO5zrcECEdD/DRuMvuzBRVHzcTcGqmE
+oZ8iWN0Lv6qh8/pHPVwp7ocJWWHorTLy4iZFr4ds/KHbrndKlNQNDXLIMiSAvOEajxS8jThqKnYh
+npqDJIOqZO0F5VaMRhIbXDLQSKzlK/HLjhZFouaje6bRGlXbl7JESSmwYFPl/gcD218c0C4iJwFE
+UoxyTjGWiCYCy1tCGiendMZbAIZAIopINWMrbHCprxNfolKCMePIrK/8VwQOixeGYZbw5GJjOEVU
+yCSC1f91h17HmvTdigO1Unz2sh/C4gEhla9aaW3bmmNI4XEudxY7P3HV4YeP59u9xnmrcd5pnDca
+1/uMfd5mHJh2lWIoXqUZUCoqKi9E7Padu67r4fI+z67v8+zyPs9u7+rsy7s6e23pP3hBY8/xsNOz
+8KspenPzfXGq3MBIKiXjhvt8fVxkJJV4whUctkmaDd6N2PG5eBZQG4nwIsWMzdUXkgi4uGC8TdE0
+b8ksF5Ucw1YUgIcg5Ztt9nW25dtsshAn9UDbtoNq4NME1mYMvJFFSr1DtJoLTo+3ZZDLJMTjAQsB
+MSHDFKQ4Wd1teinT5lVMC0mFJKv9CLzY3nhDmnuOcy4jCtIuxmAWFG29TkiNkNi6ltN28DMXzJAc
+YpGp2fJSmwtLwDYpbCvrvu02d2PU3tl1uG598RxR/exdxFA/fPvy88GRMW4ZYW9/PpFEeJFFpERj
+sz+f+3G+r8f5+zjvx3m7uAcncmMZR5hOVx7nvVFypNKlQTbk33PAv5fh/tbB/t7Gz2Dr6RasCzdE
+tPw3pEnDg2JouBUHZCgj5GnCjR/G37xld8zDlBwHB8LKYoR5cwUfexFEbuB+MkY8rA/qHG3bmK/g
+RIi6icBuYuSYyltRhnfhJb+7BOdFVMgrewN2PIPPyYC9eEsKrDl73qRSJGSPFkkELrkYRwz/U/wZ
+IpfhVC9+Ef4tb6C+eWfZW1pAua8Y70VB3/pSLR38wibCRYOoUdRoUjUMQnwIKWm0yHmRvWoWSd6o
+GGnsrZJ7vKFWi59tEBhJ2sFB2ES4aMBJ2jHfNrAoddHEzaZGixKXOKqTK63sV2X1wiao1lZe3D60
+sl+V1WvNDNUasX8zeeKj9G82UUU2NciLf4V7faRH+s//cSclx0Sg8ee2zOE1wsRBfST3HP9P8ixG
+XZJ7sueTygiD21hUGPMTISfNr/wecih8a0wlG46MkJU4gkIIuaYDhvCjcvipBcvTpcQ0iveBrSER
+C5jKSDYMBPHzwwEn+Pmh2ljyYZLcTxs5jM3axzM0xMAX5snxzXQWRURliWZgGe9B+eZMrs5+7PTr
+eGv2EX+M/+5aVFkZzkdyY7ThcJjCDweMkYNnGVc1RoQorlWIXCu8+S/xT/WTC5frnJKjSn6OzQj1
+DI1zeRNCrnV4k7RBeCOrN4nGTRTe1MSfT3igkfLlxqIFw+1OBq7Siyc1LCVnIU796v+5mH+Ge8x/
+M++y2AoJjtY4HdfzfGZuyfPZt80xjGxPdsPDX4nf0MhhyZfBhcY48fy3QrwNk9lhu3gaZYS4ghCK
+hO5FBmLiYdqIjeEZnMpkgCjULIdH4ig+6JJ7cZj2PCTnl7bDsW/aRh2T5yAgRCpqXOn0B5kmM6Sg
+wousLPvF4ka4u2CA+Fsy4lrkp9saleBWhXtrys/cVHLKzLeQa2j0rSHyJzwLTYKR71kMOB2XEr8U
++JzUCk2CMXNbZb5VtkX6UuBRrH5l1rDyVTeIpwKMtOXkFxRf/shgL/St+W8Vvo22w2C+/uXbQvUf
+Q23/x/CPyw21P8kAHrzOrsCmStyTpLnbeKyNs2+/geuWz/BIbuz7y8VTBHkB7PNBI/lFHDAezcUA
+OJzKSIlWrqowBD5qrldIItgaSQXLHCkng7AtyCddU+u5cDPmTC534mflCQ8FsyginrcAXKVWF/YD
+ywbAtl/sXngNILmLnfiaNzke4Irbci7cucszJ26AsnEQag0G9VywTm4Lhm1RzKcvROAc6aq3oSYU
+Fh9zrkHA/KZTC5Um+YkzHg3BLD/KUI0m+YmW8qMp2ULnk7gNy4sDNLws/SZ+uG+EvFUnzukl8QhT
+cuZTSCnuWQJgd6lSW1mU5KmIcyOVXqEKSM2KbU/Ri3Zjc33TpHhPng6hqXk5xk2t7Vuri2rpigGi
+8x5Qr3xi6sIryRBPjPgD+RGjibTaqXwpddF08/kOnYB6k+U4vWSz86+cHBVJFZa/h4sqYQjUdN+N
+HIaxOg4iQv3x0KpU1cjAG1kkKlf1WlvwFvis2X6+sv2QgTeySMs2SlaI4XBzhQXhLAyoQm1I7/43
+xMFB5y09cM8guZp3V/m5rcHX4cqddnJxUf8Orq6z4B2CtTPIqrIBUENZQzMSt14aTsiKMI8kIzlP
+aRsXPgZy0QKJrE0ACVzlMb7xGaNtB32GZOdDUx+a/HBJRKAoVFlCDWd/PZLuHzvqALM/fOl1MdDK
+flXKl/KVZlWwi1U7V17d2sph+9akf5hlSSh7SrXF7fPPtn3+3bYPrexXpXwpX2lWZd22pYbqwr9R
+nahmlb/BowZvFZKHXQfouMeDXpjIbrwbMwccgRFIlu0AUj8Zjpr07qa9ukmvbtar29KrG4rhu6ci
+l/6yqHXqtKBfeHGROvVi3UFw8WkLhm1Rps/nuyaff2ryoQX9wovLUpNom6oNoTwTCEjGmqlcYeks
+lF98fio0A4nLFy6oFMP2pcyKsGFNBDtb5JzRd3fc3IP6fjQR2MOgOsmi460kG2ps7irZs/QRVnIi
+qshGBt7IItGyiiizyioCYLFpRUxBPk1O7H0e/72F2KsB+70dL1u83R8VYv6TISNCzuDtSiGR7M4K
+D5Rv4h2M4+0dROwI5y2QRb1ViTRJNuaNz04peCOLRBMBc1rQxS8l3otqqXOZxMbiWoj57QZr7d+c
+dAT8XzngRxAVhunjCq4jAGribvrhi/1P4KAiPEXUR3o+0tM90nN7pGd9pO2RNuceaRuwjebcaKj5
+kfwjeRr/9ZFsuYseif6j8kh8Wia+KCSeUbbDxZXrUC4OcgzNpQURh7DxiEqO8+RLD3J8pA4BIx8L
+8oXiISOcsN8ECCjhkQtN8hNnvGZWtfwq7x9bON6rpuVxZRolEUgi33MAwQ21b1L7sXPINWTZLC+r
++3P8Fw5cfTmDNIndxm+efaLxYzNWoxNBItUM74j3BAJ7NYjTJnWZCtdno1rxrg/jeBsrhcbHzGS/
+KnVR0Dqm5fStTdewJMt9Mhf54gDTewH2eKHgFxUR2xHoZ2W4SS1efP9rQHQbGXgji9QEQDFTUymG
+kMgAJoQtkEoYftHRt5KfAVedwnzOHKwLHsI36HRpo7JynUqgRZkJLMvxc0OaGNzK25dSF22Nqeeq
+NFXy0zLLMdQFtUpXDROlbpVUegVxsRaRuje0VnOyns0YyCjRJDO+c5nOk1SOSoxyR1KAV1FYjB+D
+iYy3khwkzIDDnSyDJMMpe4D52kmaeWcZSQRerwL2IvHc88JVlKJSDSYnxNswrfalEpF+R8+YTJPL
+a0EqiyJpdQvGwwdDwsyFouiLg2HWX30YxXuyVG7RuHY/VFpo4ZFoFINzMcmZmOQ8THoWJj0Hk51l
+md5k4I3ibShlT4WmajRLeKPjK04POaB3m9uTnOFJz++003uEkbamkrxRUUJvU4z3omhlF5XMIJUc
+CFvYiQzMlDZacJrbJCkz7EHnSKJFmsjW3MIyrVr0GRtJMTWlRpP8RMtCtwWc08rTKZcF2VyomkRu
+BY+KD8oIxZ6DVymGIY7xT2dwDKU/EoX9QPjMxYC8Ia4PF6Wahq0ARx0ERL0NzdjLpFlA/yqhfxfR
+1zL6P4WoTqvlnixmLcpyYnvkgMN2cOeM3o0wchAIYqfEMLKNFUrNKkZOeMM9y7zdtS0o0dk7BTLg
+uGfn9RY6aTvoxYCtOQknhrxFV2vYFnRxUXC0Llr9Utu3hsgccwourqzZTLV+6+0fVaKTCFUrlbdr
+lpvqb/pXlwSl0V8l2zJmePj75BUryjslzkRerp8IC6c0yU+shtjn4HPSdGiTzPihSX7ijJdcz3sL
+3AIIsHYmEG9BUlkFlnKTLfjyzYzcQsJhu2hIls/g0iTZGCjwQLgsNqvGuV9ud8lAkjNzOpskzRkO
+aAPdjQyQILoqbtHV08AimyQtst8uuWyjfLUc6eRTZS5kfQ/YFmSPRiVsBjAVPJMzeZgvNlwuEneK
+i29FseBYSu0gAzYd9CsCagmNDMwUNiO/WIWW5latti9lcbwFk0qNG09DbSvj/KJqWpnTZDnGAGZq
+BmEz5NjWE4nEASU0IgvfN6biOcDkrujkrtjkrvBHLEWaDeGobSHuuuVnBHkEJZxuyM7jX+FRoGCa
+UuRda0bxnpy+YmyEL28RUsW3VvFtVXzLu3oMNQW28I0ZGkspcM7OjCVNL6QShpGgbu6HHpKiVooF
+YzpXRD5akPC9ORaFBYqvTSKjxEaJxty2tmh+cuFVMQWo/NJiGEY4ymr83sMh+QY3JEf+xDDGVDmp
+DfETKHrhw51Xy0m0iI+sr0pdNGV+cyPwN5foV2af8XxVa7QgzB9KKtmAgF9RPuCKoRlUI7SeMNqk
+sZ9EoFlbk8riknN6SuM1NHeT5m7SzCzzKVGaJnPI8XJdwJK8kjgKmiPDxx3dxS3fucVHoj6e3x4S
+zxwR1qZZ7MFNqoabEPfJXlxtB6XgvpS6atuXgigZqgBm8mQIyC65lk8ZtKZe2z/qlzsqlp90uHfI
+tkXjDFGV8jZmlrWV2yxzdJx6bf+oddUlLWdlKSXjL0VLsbcPfGmSp6edEukN22Ghjc+aWusfIs9v
+PYUWniXElaMr96pLsoPfNe3iqtGXIo7hxFtyWdG78pPFi3+lrqP2l64OeQ/bgi5+KfGeKi1Jalt5
+Roj76VRWhaYm/aEOa0mEFqvbapuZROLGAS33DYxpcfneCLPU9n8M/yS6p7rEqJV0aXUq6nXc9Z/e
+OE21/V/Lv+m0iHpvR3C4Y2uGfzI2U23/11L/MUnGdQty4TqVumjNMjNVo81PD/Day045fSlrVAn0
+pUoVrPe1tUs1vcYAS0KdsPSv+Ypqy3bp9KUvs5fBxaV6hmbH7WIwl3xZD+Kv3GgD/z+2zjU5YpRX
+w/97I7MuYpRuJhj8Ae7EWf0ppFdCzpyqBD0S4mKMMcaXfofVN7lRkAvuKQqYRfLT24xkg8PAGDb8
+HUNVyWF03Dx3pzjWcvTWoaHzGQ6XGYsMUC7D33vt7C6i6uQwOm6evb9jV9tsJWJ+wwxIpRrApFWy
+utRDz1rgTfmVssObfaZ9z//AQVRRVHYBuf5580a9qe1yv+NNbbx0KjmVJObEQc6icOR8LarQm+dR
+3/OfHzqi749EEBzzUVtRCcPJM57vj5biU3zPdqk8ahuTt4D53fdWd2oGnAdlCSHExj4yc/z+DOUp
+85TvT34bUyGHPwq1P2q/6ay9wpt4I2TG/m0RWLb/1lV7fohnht8UDThC1ky/99D44UD6RgJ0DXvS
+h76LLAt/a0NI03BUD1h3A4nxo14qxSAPLAGoOVzxQpFCpuZQzFKR/imr2wIw5dBfi+D4TC0bwNTq
+eSwS40t3hZAYEyqA/tJxFfLd0QsnDN2MbNPU744m7bIE/d2PcFBbRHGxHJVQNJ0oPnFfqKvcS1VH
+Cl8CLZWxSNI2CuhRQDVTNoCp1t1ATH2gcwqJ8ZvoyyBrI7Eiu33VdFWSf3FbGxZKH07TbufUf/7o
+5BykJA45F7nimSIFpS7yu6gMHx8kSWbUD48aPzP5D48ZP3Iv//NRPrdH+cyP8tkfcz89w4u+w5SJ
+gzrDnGhn23G+2NxaKvFfaqKc4Tu8EjsMtpztfFV6lOdMXcuzjvCa0XWWcV5UHuX1KPyj6OU1//uj
+vM58lo/zepT0KClsqUD0Kfk7byXxZWIK+9y09DH/+bWMhNcy0gdfsrKIkE1lB0AiAeXTARWH/Bkc
+VTlVaucUk7dAgUULz9NRcch+cwIxJXEwu03aqBBE7wb/GJEYG4d9sJDT6QTO4UUSRhEcJWvCUzbe
+jjkngwgdQJBZDSarJGl8NZnmRYT8+gOLDJHNgPabWCPV4rlDgWwQmrZZ0iYequdwikGq+SX7avuS
+1x4A0agZmZvmpFv8hZDam4Hbrn4ETlqjrNzNOV6AUFXtXG7dKUoZtSfxHGmWFkmVBV0pbY+S6ON8
+zcKI9z/xPDhh7T1h8T3R+O2yR4lXhdJ8Wyd9fs7afsrzRSy7yiGQxWGGT95FT3Z9ynsLIotBB3HR
+z2eQsEWVejsC2qUEE8QvCylHXgZK/GVMERGyqdR4SNk/Avky5NgXB5L0Jdv4fCHcwmHQQbnK2qco
+58ciGRNMaTfFpcCgAQ0RsVH3qEVoNpbDpwxGIBifPAQrwfgK31+LYEykUg1zoCXPiPg3NAOYstUx
+p09SsuZiVIeqGykN/hp7aLSoG/L8arG6w6G/QiSHZk5tOFRzMuejjkUaLSdbQzPLwKMI80g7MvsO
+Y3vtoXiNVCNup7kN+sgDoCsNg7QZzth/U+EZdfoK5wxncV9phCm+KLHgsOYgchY6dz/vMdlVOiby
+tUfKRx1UZoncN3KXY33/SBKKkgkCx54iqdIgue4738hN+8fZISgqiIWj05hH6b6nET54XNhbjRDT
+rcztKvyeUj0cchxxwN9qnHDIlfykVFR2g3+YRB9ExcAymMqKHy+HMCcyGK9FMF4QluGb2OnfICG7
+/XuOPncc3vZKpXCyQSVWhpeEM65+EId86yDN7ZdXaJK8QsOis0zQMeIeh46QIFJ+quTdcxyZIDrk
+N19OpOOoBYK6+HCC6dao8M8TldTeoQSVM6pzh+/cD7soMzfunjIujRQ5LHzGH3vK5zyOxpE2kxSN
+2qKuKFuhaGaDWdEhr5yIjAodgBz0lRMlRP/SEXZGyQHJ+EAcTYYLlrDbi9Q3zSIpGqntUqkVSahs
+0jpWCd3rfKxS+ZUZ2WhVPlJb+18VGWyvkL8EZbIowLdimPO1UUvlriEzdfqZgvlbduS3qOf0O2cl
+3xQeJX3PYDr/8Nb+cCV+ZOt+anmUfx/lq517eD1Kbh+Psj9KfZQawgxeM2iZP2lW6sf8/+AezbIP
+hkwQEbKp7ABILrXycDLD1IdBB832rOv5kqqPllSUIt9hEiCAHFBK/ywkmJsIaL0jJynenocCIlcu
+k39CV4D1yfNgqHIkVPT4yl28buNsJeRF+VpsDmQwTTOXyGki/CM/ilajPC1W+VvzIiJkF6nxnIh9
+JKb+zpBRFgWnJIhpnsz7QF4lrzzJrrSnGU58PlOBmOpr+r7q96PU1DggCTsLPuXXxDs7cdvKGuEU
+MsdQytficouxvZU67fzzAAthhhiDDMSU4I3+mHpCOcnKSC5/abYkd7xEmt9MN3fvVwqPwhP6mrlr
+yKJTzbMl9hAlTJvImZYz26ls8hEYY7kxtDS6KZwycSAdh2W+lDpgkEE0skjZIsFaHC6PNznMl1PM
+p7ZFMBKpnIaZcwkcfLTQJc8SPnptH1TGTWH/sIXIPy/JvNEx5M3sqaUoUn/kTBVaJHnEmJY9vsjK
+jf+GTYuN/556q3lqeyqpD73JVUt4qihBLmec0qFB8pNkCRv7hMxbfdWMLcnpWSga7lqJnKk94XMc
+FJptrWlSRrtY9CPxID+xp2ex+o9XpoGMxqASLaNzVPxK29TeIWV79q6Wj9Al0RxHZV14cYeS05Ma
+auxUiU4Fg075aBS+tEIfZ7PKzRshjmuTIrdZRTk5VVQWWztPdlp/ZRcxHObLKTgmp9rwY5xTy9Tk
+vsdiccupWETNmTbrD1vdIT7CWBTKXemqnX2klXSnxh/0vWnqu6cxQr4p2Ii688c2XR0OwoGqiu7H
+qeZkbTS1y3k2epHtl41XGqcs8dxWhqzVdlPUcX7hejmy1vebNu7aLSHOw0vH9pVO27k2QX713Okj
+PJP2h1pGKqfTWvo4XeVFr+0ynX9TyJq98H0Ma8p6kM4Pp9paXc3TWtURYmsUk2xaSzrAGncow3pN
+u/rgn8mU1Gd2Y9N28m9aaM5nHzWiejEkqXgk3iWoZqSc3rizaBr89rq1gCM80hqvkSEdoY2dyjC9
+0aaPyE69by0dw3O+vIYib7sk0gjbK1jt3pTroWPX7TP/0HPtcrpirSWt5B8L9lpsYbdNaqnIKVxR
+KtS0W3Ous7XcVtPnJ1llSZ+Xm0jbaHdGiv2QzkizZ242RlAZ1muZxel/Z8rpQ2bsouIj0DdNUiHs
+MzkK6+f2MoCD3Wirhd6a1dty+eEPkKx6/Wz51M5JP7P6QqkPwtEPBRFHrssfCmf9GTb+Ghujdv5P
+Gd0+w9CYoW8k1fJJTYfnz7TpfgTCP4d918p+5vqNRdapnLO8YaruyE9+TrmWz0b0q7b1+wulluc8
+ku0cuTTEtlAwR+XLxVpe8y22iJryj86mgU75qvu8j65xp0zaS4pU7CBOXPlUXEuneQBRiahCKvHs
+o2G3psK9DnkmtIF2atZ5W5aOR/hZme1Du2U8iM87Z8h/9a6Gdu8SbPqkpruf9dmBsH9S4ccHrOVT
+edvEK7Vm71TVkno/2fzvGZ+rgf89Y9LTV6angryoV4v8DIrQoIbNzHj9oZY9PItORXaebUi9d575
+4Ay60/5BbVEXRA0YMHViluj0bNbMe+I3Asdisa+vcSzuouhAseawxb99UEs5t4xNK+e+Jg314981
+zNSPLO9iXqLxw9jYFapxreq2nYcbrKbebK/Vz09r5OrnmHaiCtmpUvYRWsiZsucOhVJepMbBn+4u
+4496j9buwIYeimd4XuZyadcFigO1T/5V2I2cqmloXu1pGx/Uei2LJP18QAiNrSxnpyOfXSUZyaUi
+cFm1wJp6LTg8+c1Ry7vmbHOIo+rgdrSwzaaAvZE/Ao6GEyQOr6PVuM6QR6ufMvcP+a/e1ZDGImsH
+1tRlLqav6clxfqDzH2dxbYefiWCKyXrFVOq6EGqhRJm9NnJW2vg3LEK+a/2mats02uoTH2qH+qZm
+9aBNvsMtSiTaV9RnWpP9Rp/1zDbaNfo8S1yROdl0r1Gh7xUzG53KcMdBo56i5SOc7lHdlHNFdLt+
+VE2zGC251hnuemVpkuXrGi9ckrb0lKvQHnJoSfphn/dDz6xKmlX7FP++VW2oTqHr1nR541COtU6b
+ns86PZu+uza10smgK2lvVsZkRdV80/SSiHXd3M5TBhAaq39hw3I6WO4V8zGhrqibU7Uz8sMRaj1o
+01ddvNa9+uudtaHmha2eBk2RZCMknZLwTRu+d8jcBjWHcKdWU0ROcmNnQpXN4pVjXHLPPmA1xwfG
+kO7c+O1htBfmcf2UQ7mfhzynY6iZnO1pu+e8Vfza+QHOX6nwCO1JA+SP8RF+DGl72fXpoCKve9Uy
+XhTWjHa8UtFt4B9RWfM3PF9Yy5hDxDqWRgulf67jmfV54pBjY7T0TuqpL4nUchb5+SxBGRjOTk0l
+N/6bSt1xTL+pfUjSt51i36lmPQUKD4fSdd+pnRahI9a7+on/u+YwErKs6AQMHYQm+H6lQQYcac+h
+13IFDhNlnQ//UquPUnnVWpZuq6ylVqylVqylVl1Lrf8Sx39xkITZY965qNxOtcRwqeS48uQDvpav
+UD54xVxOhbXIw7cANe1kwKYeJMTDebV2hFGEOKGC42t6H9Njltn+ITwgVlvgIErYqIScyk3hRFy/
+9kFtqKwMVRam25Y4H1nbnKKz7KPxeagRcaZ0JHnvR1J91jzbrCXi+wTcs9ouGDKEvKMueAFSXwtY
+qmdaCs5aTuumLjeKC5tD57tQWpJ5rBq5Kvz+qdKvr9LvrUq/9yr9LrdVpd9VpV9Xpd9V9O+qUtZS
+EYmSSlQZtColwlWLL0PrhVMDKF+LcQZpO6qnBddBpWsq9jiwNtda6hDyZFht0vAdN2ImEOBbLgfb
+4GltGy8Jw67yQB2V+0vuJbTxkp/cYNxy+vyEeasDRKGPRdQ8Z+xI0y+vFa84P4ffch5ymkZqOb6M
+lb/l3cryLKuUVuRNIShmBuA9pNrkd8QZcKuhjRfadbxaPQAnAOnPeb21Epz7R5Pjd7zWFrmtkYdr
+lah5XtsH/fJa8Yrzc+hKJN+Gssu/OQgyTnzzENq+6SnzJhD7yyc362TuaZ0+wlNlB/AWd/rIRI4k
+dpMFJgExiU+UHi6wTF1JdkGnZ7hUSpz8dHvBYNyJo3mWf07i6r4kR+kwU0hzduzsKS+RoqIwuXnV
+4YxbWLiDZTew3P0rDF+9bvKziKX2gbeolLrhtghDCzRxaSGG/QSnrBKGc1fJhpl61s39roQpl2Am
+laj30Hn3CHJDlSfKGYCYtsZUVsggGpmnbD0WCOS7MywipDgOdbN8bbrhfmtCFU3DnYO/3jdDyRJL
+fWOTZ3EF+gBJKduL8+LUJC8fAjipZESZhkoxHyGqVMNBbZEYJfSP/N7Uf/7o3p9rpZWcUtMrk2kC
+tYezLWJj2ghC96/gBfyoctQbayo1Qkc10meyfNbDGU7p0JD+E83OYHGAC0LzhkCOusPd7tb86/aq
+LS4SY0uk3Zr5AskUGJSdVduTT2GyV7USU8p5T1FPdeM7jRdf+sBV3q4UOfM4w9f2qmNMfAYVHMPP
+5rFgdacwReGAN/bk7TtxS54lRaNmZF5SB0Zc2RuzDwdvCqfKHwGZqr7nf+CA1fDFYRs8Z3jzSPTm
+ucCbMu7cgxAlsziBaGSRUr23PioikDZDdbQRZCkWRQbRyCKtgBxUIo53tHxdbgr0jEkSgTXPty15
+vqkECIKEmdVn5efn6luOqDcOjXfCx1GU2Fg52EIqBmQkHl/nb6HRucVrTx+pMc/T7HfApYmcZr+h
+vIg74Le0+TdfMHyPR6k/6OI/1sF/XPf++SWeyV9c74sryL+3IqI/yvEoR3yUoz3K/AuP0rZHaXU+
+gdwfpYdH6duj9M9HGY8yPvujjNejjGk8H+WUVR0WEbI/yvkx/+Vpo1OfNppQINiHR6Lz44ND4l1w
+fiSE7MmXlvxk7rnxsreuDLPsBBgLolFX4n4CrMUhPBLEJrLmpHDCQ1PJ57qVLFIB3+la7CMsJT4x
+IdzqoFTumqaSR2gNzVz3kBeTQ3UZK+VYKbE1EoeA90DEOf+MvL8kQZR9xu+IzTCKaCLYQ94FPiPf
+kmbRRQ4IUeXwmzAL0zX7U16mOp9PUjG9UkePEpimL+IgcsgGKXf9lsL5dSrlzIGcqCY8UwHNC2Um
+d/byWldV3s4TpmjUFpkrKV0qpW5Zp1lMEjm1/YODMCDExoXIoMTCdLNIriAkanL/khE/4XBiQJuy
+SxmaEg+nnvII8bl/FE2t04lz/0D4Jc0IEuOZv/nA23W9iknWBQQvQFapMYMMopFFooK27m5oHrUt
+gjFtKkNelK/F5ghYrzWbRj6qj5viopApj7OAvGzajPIDYAqSdeq4r27ozf2fpfThmb1sL6ydcLav
+8HqUs8z/8D5norK9wtdp0JlShWCVV87OQmFw+xb6neEzNB55yleaYcFVD9PcPG7/Qxb3RU4Df+S+
+PZkuek+Zah1HmMQDcuPZwBR4s+Pk9YYUF8HYIKTcJos+LC+VvE4BJKA4SbdhKYasFitWHtkTGRWa
+gfkoSAZTmw0rVygnrlBOXKHor+EJcHuOTc5ELNll49/Hbg7Z/JLviwPE9TU7iwGbdgpDJQxPCBmG
+mVCJnXhb8aC3SDa0sJF+0NdriOzfxOPuaClASEySYVkApt1s+zLq1jdb0V6cr6VJ316KS77w9OhS
+2/HlVTLDmxZJKo7qL8rZgE0jiAU7c8jeGdgz8o7VqV/pPu3r3Kf7Ovc5dM/zgD5kxew8Z7vLpIpF
+hGwqOwCS83g/yruFXcL+KN+P8j0e5XqUax7hV+jhUS55PJtFf5Rre5Rr6tSoTZlrkXBG7sdLwpBF
+UiiAoRJ+FQI/scG8h5KC5+2mdK+pAjlFeXJp8zkdEX0W2mct+9yU30f5jfN/m8Hno/zubD5m8L/w
+qI/6z0dLVB71n3kYXVPmun1NWUuZ+1Ootkf9J4oeLSZ+XzQjOPgMrYn5FVoQQVMkeSq6/vNF85Gl
+R/0nU2izrEIpQ0zZiF/iqf+0erav6dhfNHPq83z+DrOao9ZMjxo+53/qL4h8AbgDLSRWZvA6HzV8
+zX+KHBYOZ7ovXg+cUtS9ljHlTPZ17hKKMjcsfHEfrGEPbWZ6PGpo858z5vGshibNKHKmbXUGTPwW
+N0sSKY/Tg9g2/aTyPc1w1mds4YtUspFdh/CLA0Z5Vb+GOZZllWyYwU/YwqN+POpHiCm8ptyDhBvv
+sY9Zlw886LGoP+pHPDH5AF6AQQZ52WRfOIWWOmkmoigfLTHqisOAsyTMUY264jAQx5z6l0p2ajkV
+llXEbKsPSd85Q3w/WYjzkICiiMaCd+wHXdPxU34Ne1Fc2BYuV0kMrsXzcqrzqPhI838a0+BA8JT1
+P+D0+/chj+ZAUASsifYfvS+Dd6ZlvxZJhf/FN1NBK88qzwvcNOzvZbmWZhFvWrQc3rfE79VboC9M
+fXc8PKfNay7JSD6DoaXWZqDRLM/2lH0LigtXPFpFuBbP0ylLDlmPiLyOh4x8sibMK1VCyTlpwUIW
+KcUK1uIw5JuSL6da6sqfIzcFiAK1OGqQSGZFysWMom4N/0AmZFSA3/9O8m62V1lT0Pz/d+qOEpYs
+BjVtkoGLmcV9seYy9JLGK97vTTcFNX1rkre5v3mCZLQcMT26aVKVKpWoq3X4Kc0p/3di2+r/zpmT
+LiIZZWdFaqdx2kocMErfr+jo/L9RIZX5UmoKfYC0mYXF8WyhDDm2ltK9Nm6KRaGtJ5PKqJA1c+0G
+zE3BLJa57EUmq+TZUEnSNmSqp8d8OUULc6qlE7/21vswqmVy2Bebh5x8FM2jjEXOeZiDNpByyHct
+X15fyWpL5DV101pQVGgG6m8J0Z5CKAltw88RAtSi7vI+j1HIni0bq648KmS0HGxYdxo5nVFWfhTY
+VDP1jRbFhcXbdUdAG57ztbTlhC2smQYZRCNLZKO1KEZIP8KWyYBjB/FD90YhL8YGmuISePyHORWd
+YwlfSoMWZWfVmo5Gh6yYeCVfN/U/zmuf8DNgBhQNdaOZa/GMIcOp/a6PP+ot2ilv8pwvr606n/fZ
+gVmWUpvDZVZCJxGaxhFSgcAgMJGiQjNQb2mNSbvkNpEj8Rh6/RjtjKQyKjSFDkBO7eyWTA8qxjct
+ytditIfTJMtTlu2MxNgJQrI4u6U/u0/cpYJnl+F4nJ170hxWSGW+lDgqSZ9kGRU0RraOqZZFEl1P
+k/lSQr1uJ9UttFSCymnYws6hsDQdIGSH+TKFoiHXavHKhEmeslUI2WG+nCLOR1IXJlrIN+dMk6SZ
++4TIqNABqFY+dXvyaZXCmxlGbDzzgED7MlNUaABkPKnvi7rhWKRGqe+Z5ZVCQZEHfjhF+VIaBhpp
+tbLXALySL6ciSULtj0TNwOIASHfpdolna0iLdwwWduMBspQzikKR8LPxVb8hIp9V3CmUFCA3kfYz
+7zete/XuKttg6gWFF7sZEc23oJhOtuQ6IKb6mv9c6qvOGr0aSTgjvzjiS6pRS93rd511mFZpImw+
+O8xsB7+yCxnyonwps2t4UWwhGyKRGLK4FIKAKvnzLwl1Rcm1jyuLM14pY4QFzu8UVBZAFamC/aiU
+JNWgISGbk+RfP6ipZPt63cQrEsVvLU04alQpMcdpUmpXW+UW4GfXRXSWckCx7I+ZTZyjAV8jKU37
+jIj8/W1INs66Rr4HWGNMxKHMgyPfyJsfMeBQzjwiOVaOVJHiP4OZA6/JSc4cQb2HKTgdjTF9Ph81
+zr4a0ycmEDGVGYAxSsc1SEc/RsfEwblLOJW6c0A8EAHYXkbdaJBDZ04TOQF31ljbqlCVx5omZKlC
+tRitX10znFilvLNJKPmdlpYPf26k2ZBX73R2wKWyPyo9Km1xBjN6ZkKR9gDRIUeV2HSErDJfQhyz
+z/8J9tvcin0oXgxyO9uIHQ/KOT0qtc4DPPHvuvJvvCono7M75OT82FqqWXB2+UVpWwxf2ZQ5QzLJ
+2ZzvRhD9UT8f9XObO/mTcgqP+eRC/fwMWcIu4prig8JQyfbtFDk35fOTykfYXkIbqRSHAo9IBs1A
+XXg8Y4Klk0o18NlTKV+Lpat4zacRlBIbqtBMRwWalt9kNjEJQi/hwFzuK5Sokhzla7HWammSS5L2
+SRu9akZTmKIeexjkcJl/QJoMW5Q224SJ3eCVDs8aAZlCNuCDffHyoE7GPPtYvJzyZagbzopRGYvM
+OGhRXLjibZt0/rl4OdXmUM1V5dkXWS1tgPEaykWR6Ad8d+LzM6fCdc01RJVkhDQTOZF8YVWBoiEc
+mdlTAhoQqqpjf9U6DDqokUE0skhNyvMPkRJ3WNQITzLgyDHvtT7qp4xNn7PMQZnDIiE1yC6RBQ3I
+10s75zIAz/Coz4+61/6qj/qMM4vn81GfKb+vKd70qM8sQeSwSdhZSDhetR3sw9V+NuKAd8iTt3eG
+KkSXyDN9TN+zzAXIR3096it8UWBxFpqypXOKGcwavFKh/WyJ6TnDykEoEP1RX/v8T9sMcUoEcOQM
+ZqI6gf/Do6ZHTV/zvz9qyvP/I7Woko1bKBCi5jpeBmwiTkBNQjbJA/QKbErikHj/JHyQUoGAzyl2
+KZHvUaXMMZ0oqhTDVyoqxTBqoQnXo6byxQGnkTe3ppx+ZcikBjBNnR7130f9N318B4j+qF+P+hX2
+OuqUR5Jw2kOjrzDlxQHf1voKfBfnS17inXJ6soloe9UPoomfVKbhUb9edfRZQirhO6gsDDN9DhzM
+e7IGZdHMvAUOSmQxLfOfZ6Cf3MQzjxw+Z/Dv+V3LhPejzt2SI38XvGbu+TPkHwLBcJ9lapgjdmLm
+n1OsmaeKM+wsZk/OkeuVcTLJdhrJsX5LDCt9r/y830T+zPOEQU0lO53vkB6VvSg85ZmbiXI+Bohp
+sFPkgC2faRZGEtDMnyoHe2hP+THKm8aJKoczmN9twGkUPFvnOVswPef1hEHaHIa8FOrGiy6mKkvm
+k9wzfH90SVSPmpNnud8EDdnpDw4shSMKB5Er/SYJm4guYozEkMQnIVpaJ/Pu3Wnmu8swK9PF3Lld
+O3ehaz9SUBkNupL5FDMpbCphmLt3f9Q9PF8zfAUJpzV8hfMhfX4PJUnIEQc1mrJxwAffHq5rVmX/
+mP9nlBvfQjQpnk1M9AwSzqwo04BQdZABmzhNociCj8ydCsfUGRwt/HJ/3dPWalE5HdB7ReZLSQ6T
+xVxMwjo4gE2DA0HeFTt+YKju3BwlfZxdJQnJ54tkfuvUwVpMjfTzH1M/6vIE96UMh/lyCnwa9ZUa
+io8anpGB/nCI476U4dAnQIl4h3ohX6fuJb1rI4OuVE+P+XIKdoFXOR3/fz1qedQivzsn3//qj1rk
+ZYrKtS88ty2b/M6fQmeSH79RcsY+HJr5YuCX/RRm3MyVr7oKxcZHMj7ZWgt34ELPNGtJL/78Yy2U
+ogpOL5tY7EqjcI2phVdiyTlKGxVbvC9u3b7cluwLAsqfLBNBsJlfnK3lSe08HlVXskQiN+YJ3M2L
+9HEWfBJXmkaZxpZc+cV4hQ6SrLm2df7vYdSjUgo3pXttc8oQrcTwDAbTu539BUGqS1lT64FvVpe+
+NaJZ305DQo6V6S6W00rP/GP/i9gn1+8pZFpbZiw+TaDQQXVKCZ5UpIkF+1C8Hv7XEx3nFUPdEAnR
+33iny5BRvqVXf/PbQbXM3/k5XrWFclM46oeDmarG8MAb2rXGEmIYY1pe85/iDLnpeI45dxlxYTVH
+mm78pZBHrfORtlrro9ajc8BOLYx0HG06tvGodbwm/hIHkcPp9stnrinmxtVfKfD3etTjUY+w4dET
+UFvUDZUulZzHxGGRgyMzB3ZTcHFfynCYJRXH8oIciwgpsfbEgiGp0iBV70MA9fsOj3psNRLEdJtM
+2wxmKTQrTIWDj86Wwr9bMiHW7ZQJ8CEntkMunFh0kXB9Sj66hGCIilN5UWgDUVI7wr2Og/ggPfQa
+DCCVLbrGcFA5ngdLieg1h5bAXYrnt7AUOKoFCfXO+oGH1hliElmiSjMgcRkq86WEKIhBKqOCxqTN
+QA43KBZfnkq1LArZ80pXiyV806LloGtHqinWZiAm4oMQwKYZvGTV7HilxuG5vc4u1GriA/J4jVfI
+e3K4OezGenLzKv2J7uOvfneY2yWLKgdWVA4spxy6lnLwCeCQe6OHzNQPjTFZi0rNRjlfXkPrJZxz
+jlRThGD13CWcSi21pCnbqFP0fu4qOX6kL44fleXsuXwUpJ2PoKMWObAEOlMbfD2vlC9jnmEs7k4Z
+ntN219B/zOISYuRSTdw6Do6jdhURskFKInksg2GQQb4M0ZiiGMm+UAz5pqzU2AFHo26SopHZUImm
+D4cYLo83OcyXU4rL3K20iqG2RWKsH/ISqeN8mXbuDqdTC68Hn62OuYOk38nuGXImPtbuGXgaA9CF
+VPzDcg8QWaXsM0bksuu3NrzSVSODaNQWLT8jaV7G3cBih0HaHPraDHX+9fX6vdXrlwyiUVu0/Iys
+XueuUuJgR0noYALaWtbRBDsIZeNe/GEVrPK1Sn7fb6o7nqhRpL64D88rgkub6vmaO/HMmBsIdNBQ
+ybU7Cz9YzZe9uMHXHrWF+c+P+bDoLOV+H98IlZ+7q/zKlczNWiicqDwlJIgQHXWgHBKMsmmCF0AN
+8qw5I6J4fWfCOUJZJAn+pT4CJxgkIRSOlr2mJ0E70eG8xUIOGMN8qULqmKqBmlRO54/5z6V+JG2u
+Dyn3I3Uul59snmHIKqkr5UsJJuQ1qKlEhGY6WK+dM528zf/AgTxbBuC4wCElNr94oi2S+5RhVxbg
+u2iLssN8LcU5D/IcveK9ZCNMq+WueVfeR9srRQjaRt0Tda9eUGC8uywP6UPbtM/cYkgFglSnBpAa
+RnveybCDWfJ1MQtRm4SSndw2mxKZ6d2ySXJHlimRUaF+Y4u5RLKaSsgq1cDHu5IZo1JL1BY6ax/G
++TLUapiykqjTIINopF7Yv4K1ODSP2hax0bZhbUJ947TV4tkIYsZMJvzqgtCsFj05CKWq5NhnLRAp
+OOI41tmd1S6bQN/hUdun9JKZ6ZMTPmWge4YSExl00CWScqZFiEybShw4zBpp37YyVbOws61TQv6j
+5utmsFwtD4pGbdHyM5K99sRyE2CVt9tm8DlaaBio6fe+Ob9uc3795vzeN+f37+aood900yxP27xf
+27zftXm/tnm/tnl1e+Uqi/A3FZ7VvkUr6vGq/XhJj7/p8If4DSrFgDbsOypo7dd31w/6rtXSRpXZ
+DkD9kpnT3S6VODNPHNu8pGmJX6RpibKEopQBgf2R8KgfQPakcTclX4ZmHLQoLlzxsk3gWjyrkyUk
+aou8dWVCsj8Z1XnFVo1jKRfzItnAbs+wJ5ViTQUiZAPp92C5fDHtMs4LzWHQorhwxaO6zzUkGjun
+N3leBclwqagJWHJWpW51yiq7Vm4jNZTTTbDxCjP8DR9TfhWeYMm7gCxmPlkey2uZU2ZJyLXZaz4h
+pq0EnB0FQnYojbm0cde6U/PlFBeBlnLaiqRoKG0rLA6DIJCzXi0acvsUwnmRQSpIdvYrMi0tabz4
+Ml3I1jmXSt1rfdw1Hznzk0eRWFBPHZg2BVKTSkmkD1Y2e4yy1eMV2lXkkail9aX8OBT7OEtUqT0c
+Cmd5hkdtxysUCF4hVupAioBXrYqyD5j6DhJ/OkVy7Y+XRuMkMhdTBu/lNv+5OzduloZTU6ut1of0
+eunKg+87tPGqEsZaRgqON8/dKX3cFIv6MaBlS47zZQiiQ4uhw/KlA7Fr7y5lLM2qCAWHwDTglKi4
+pgB/LP1morsW7+rdV3aV6cOXvE7Rfyy3LH7prsW7eve9F2fbaR16KSH/Ue/O1J2GmL1m0uut8ar4
+kCjzESimzXN3Sh83RaNcEp/CJ/D+7VX3D00xHHSlPhaxMf0+antzJeVTrrXx75uLkCnqb33U/qid
+j74uR14PX2GG+wz5w6y180+ui5iOW2gSTveNap6uW8ryWqZSXNgNeScp1+J5OdXm0JsvcO1bPeim
+sNupNTitAqeVf67iz1X6uQpnt2eaAY83/RU4+GaRZsTrq/bXQz6TyOFMkuSW1pRTzfVR5V5833k1
+re8123lelQvYvL2pvcPEh3bfZZeLlN7aj0Yqpkuneppkh06DICSChy6RxUCjNpFjCveKs1M6NIoK
+sFwQ0raD0vYar4tjB5XOP0BjeAnqKXdxd8p5V26J9F5tH1RDG68mN9a8GulPPNSPHPpwmDanwGdb
+Ppv32ZxP5KkAqdL2RSEvVu9aRv3Suyhsubo9yuJ12UycvycdYbwWpdCdsjkmF+FQc6kN/UkVbZAe
+2lb3cFPuUUMjD2q8RukUeI6606JlTI65JpkaBEe0sPHpRCkuXPHoU8z7InP4pUVx4Yq3DORpKv4y
+/0sl/OqzjteitC1mh++QZ97vkGN91A71mzoviI5HHeFZZ/g6X+eUX2mGLcxQ5TnCjPt41LE96nhR
+zpVlk1B6tdI/grDwJ/KEasv6o9bOMKuRKjulGSF9fvAXN0Z9zaDVV+DHJoYOngJpWyRDy5hbNHjU
+YzFzGlxNWRwco3KwUQHxYCdyxs/P/NUzPEOs57Oejzp/c0KeMlLqjzq/e1/lt4vkS5Icln8mpH+D
+hDOOl2tOWas52/zn/Bur8nQGyzc79JmyzxR9cEBRRINgJzkRnHxIn9NPHlFgwSZeZWYhanueZBCN
+LFLyGx/8tK5IakpdQH53bmF07Fw0qxQh4rKImzyWA1BTHwYUDTVpDdhW+a61Akw/EKhG40cVAWqK
+kA408zPlvyypztaHAZs21HNbddu0anOMXUBx4bJqGh50REpkSwr1UHlQNNSEYPNV6AsslZU80TK4
+IGCIAe0d153Xc0TZe1F/JMTxLaI7RTOsEiKumrlINrU2ld2AKxVbKF+Ab5WavIXvoiDpGsEpaSp9
+aBgMt/Qmg2JkkZaiqt8p+cmWymFAba+y5+UIHNT4t83rOT7DRiqjQgcgc/5dekiJkmd0AdQcrniQ
+DC4Cml+SR5QmIdJU1JupLbJozSKjsRkoGvp4ScSPQYkUgw5441MOvc/6gwz0GPysP8jnWSUU+7Oa
+2R40gyKEnfps6RCJnv1cPfu5evbT9exnq98qnakoaILv8VokxpP6AooLl1VzPAtEUb9SVhz7v2SX
+vLBLXqvNXxjaBTgWMbr9OciBJwtNIqkt6op8j8YwX07RVnXqP390MgdAHyopKqFqWce3LAdlDt/q
+820u3w01+IYr1Ms8LomgASFqwsYl3bRUSGVU0BjNCwf0lFEBPno051q/VEqU1mQPhc43Nc8UvfbH
+sUPRl9ycEm/azdEyGdvLgOLCbqiu/BtuqXIH22uE89n5LrOSM3ZD5IAPlhpRXHxz4ZQHxq1Dx61D
+x63Dxq0jDFkRNxQH+RUzh3qSgErRKZqXaJJB1sIn7FqEKupzqUR+qz8d2qGOmsowoGiojjVpfhjA
+p4ThbOZ1Nj0E5VfMyGF07Fw0rUzAjhO5njoCH6cd+A2b27Stm7Z1s7ZumMJNGRX4WtIwX07Rw7zp
+nK+Fp2W1iygQXyo1Zxvmm47y0rUbhe1lQHFhN9SUCSMTg0Sn55PaIjW+hgE2QYbRtgbPhsFTgpCy
+SopK8JwoXlttZBCNLFITUM4qnUncZDzqGI/6C+MPg5k01atqbK3DYMVWzcVizwUUDVcK3eAUSWUz
+0D3crYG7LA5M+CWVUUFivlIbBmLaQxsGFA21HntQzyqzkl4zqUTE2TZaFBc257BQswZLxIHZdz+o
+LFDXg3A6miT1PeoXlUX5WmyNYxpKPxqFuMhyn4p46K8FOEbOwwbgbss6zBqNkWjSyuGCVyMM9kCK
+XvFOlhbTHIbD/JPN91nhlCNI+EUq2fyug1RGBY1BJt+43poyX0ragN96EaYT2ik1JYWGuPR8GVA0
+NMf0lMnUt8x+5s8yQKoZFfxe86FvHMDytLBIikZtUVdcKb/UlqMBNYcaP/fnxd3ot3LQH/Ud5n/m
+YDbJW98vfvONtTce83/jMZA3P9DxDoMkZJN0lbf2E764eFORNzEBYj9arZ9Mon+HNjOixkH4OPGb
+9Dete3XcFK4xtTkW05s8R6/IefWud29oN+UW5RVuc9Y0u2EFDReZrC76JUVRkNeTVKrhaQ+GTjVn
+lVXXb0WF+/6BzxKzVkK+OnmOXvFeVkXRNOpnZdZwSDLvKjVHTGEEkWKg6DGorA3/NhkV4P+tWdg6
+yuLole4US8NHJsAbMXItDZ1YdFCKKqOlTVEjBxnAlPmiaZIs1DDlBVqivT/KSv0y0A3SV3/A5jkM
+1ITDATdomOQSc2LT/Bp5QtqW4pMcwjzXcRZZNaayPFDA2SIVhxSdspJCy5fTNS+1Xcv9kri5KknR
+UOO3sBNA31kRBR+I+qPGP3q/6ytXMfzSHzX+0e/pf1f6Rp9nBvdhsBz60LSnHaPCZ78p+bqp1lU2
+avTRAgp5hfYkh9FxX2zlv2j7WgQPWXhjqtvXIoqOm2fnblnnujKsZ1xEjp33iZ601TAM1KR7t+5a
+sCz1CVkueF1UkN8fNbTRe6ne07MOx9Bc7geVrmPVUuMfvd/1/6RfvX6ZavmP4U82fL8Thkw/aTg1
+bb5WosY/er/rq1blM0XSMXSp/aaPu2b1118htu5aSz/3w21Q/TKwOq2RbLORbKvVjuzamn4CeaqN
+Ih41Yw0/sy1K/Y6LyLGVMBVJGkOWrYy0VTvpqBJvWvea5rV+q1+0QfNkZinflOvh+R6zcmEVJeB8
+EiuEllzNvWIUj7UviEbqb8NWbOFzLOqK3wbO87sYqSN1RxQdO/vKgjRfPW/rgvDE80Plh+bEP4/0
+VFY/SUrylqlSvhbbiEcYH0g+yg7SVLavSEcPihgIKJ52nIClSrTrL7LdNC19/uSAbrso6a7QXYt3
+9e5r1RP9l+5avKu3tL8uba6XjWhUxuvs+qzmNPSRdttSKPGmda9ZvlBr+aPC+2fDz8qb5jE6XnVj
+1aW3sn6obdZ0UOJN617z6cYil+SW9doIKCsz7RY/R65puM0Vg+V41E4OvbkvXoUetZ+Nbor6Db5A
+NySvrAyGXLErd63Yp87lP5Hwkxxo+k+bxM0fxjdQz7QmS5PVs78MzLPb7P0z4+D+lNcFhHQqKajm
++m2gOeX6vXLSaTDuezBdKs2toqE+T2zDkwq1NYdbavyj97uuOarhl/6o8Y9+T/97S6/nnmcLv7Qo
+LlzxllAHxmeTm6yCZvtejto0uK8CGi+HYpYvmgFooWb1CtqDXmGZni76qdEd84dXOLOBZXlmaoYr
+OXM3BUQhGpipGVhko2W0PO3C6qXj+otstHq58fxlA/oL4/krLcNyOxWugwwku1TuU4lU4pmf5Dl6
+RSdFS72lK8NrPkork8pnto1JpZ8trDz7qZdi/9ZL5bJoHv9WXPp8EU53X3p8f63j+8sd3192fOeg
+l2JyJ0xALUUth0ptqImaWw6HZqbXFnwrQSS1Rep2raSoeqbwqXAYaGFkJVA4BkiWNuCRSGVTQIoa
+ogEttBpU7ZJ2KeOuZNyFjL+OkZ8WYKhfBpamrjTVpVlz2mxz2lxbNKCFy7PF5SmfAXzbtGcNi/xI
+jUidbU500R3QdSYkSNEpy70PfRXMVOWx1m52iuvCAoqek/Y59Es19yqfyprIj/EAsItKQo8vduJg
+oui4ee5L0aqUdaZZP801tfUar1O613RSdITty4CiYQclNaUyFpGzamVY0WRtubShL2Gy2ruBudgc
++ghrCn3gcDrWkXOENb0DazocUocehHpDD2g52MF31ANPwjol3rSbo+UAtZY/qvPWkfSo39QWWfbf
+q9epkq+lInELenoXjI6di+XUaFsJqG8tffzR4l3tN9XlM/V1sToNNoc7WtocxYXdcOVknaa5TtN8
+p2mr07S66cFnt0cXR694r5XV1FytVTXnvQ7LctA2PFP0mstz0C3PQWsJGqrk/78z4HdDp9YwNNnl
+9LqUXpfR7hIat0SVKDpujvtin3QscklvudtGqLKyGoqfdtXeCB/oUj1FPWe2FMmgGXUlKzRhptGq
+JjjzgmjUlSzlWVQWZxM/hHzzFqA+uGnLNFeDHMbFy3uctntUUT+Zj/YNu7FTUIkCiFRafjoR6UTN
+wNwVcjZYKXVm0qn0ZP3LNLvI7/SjVXyFWL8d3uyWM2tdudGiuLA5dL4LV3aVDDRal9rXbWpVzAMO
+dqbr/iTW10msp7XbJrqH7P9j6jcbxbt6i71uyir3lwyQPBMdi8xzKl0Rc7FePxesHaT3MPpB8p1h
+V/+bqd9t9Ef9kwv9cbeqwfD732J+6Y8a/+j3LH9dliUuupktCcb1fuikm8mKcNNuVjRd04sid9vc
+NDiN8KlX9H3Y8TnWwTnRpgGm9aUutAJGuAxWPpeP11R29OorsMrLeR3XQ/vLILtMYHbO2nVG2teM
+w7R4V+++Kxfouo+HztuZLBN3p4EVza3yizCGam7BBnxRVmJb7hSk6BUf45OM7eWZ4k3rXnPJ0qa7
+sp1mPT8/F1lGJz5xPZXzw01xTIt3td9Ul3boOHMeR7bhQ5S+lMvhSt0P/Tj3W5fd5JkJQDFqi7qh
+keY4wo9Kikbq9mNuFFepL34gWclcXvJIMuO6vTleuuwyXq06WulsuYVRs2hnHw7NnS9JtO/IZx4B
+SJkaGUQji7SM0MJrBB26rj9BTbjgHDoLHbaKOM5WDLSks1lPngxPXfkYlx2dJ8bdUzv4qZ37tI79
+DvlcR55p3VRaFBeueMso0beBuib69vGS6ttW7pkoLlbnb11L+qZ1+xiMNuXHZYZDik5Zadb59/tF
+eV9EcbG5Q8nXTbXdxwZgKtHA0qdi28ZI0Sl3L2TDz+0A1Fsf0gGrp64ofjcsqHw3XY1n6oaWuqWh
+N0y+WzXndez8UrALOjC2lq+NU+SfLBPJVnlq9Z0OvKrIJDmko/Y0DCgaSn2E2XP8Puq7chHyRbp3
+HfgmihDfbanv5ApSTQrDRbeec+xsY+eZdYZZ5xZ3MXvq66Rvmbdjqn7uHPRH/X7U75Aavwv7LSE9
+ZCb6TYWD6TVTSSf/zvOfhoTTnHmpnUW+ANK633kGMxc+rL8LNQk7BC8LKbFRyuAyO7/W9fOoP0E2
+/yfwN0R+Qq5hk3c5fz5yrVFln1C/JWQFD338bKENiGmn8qg/n2GfYW0RIoWyqANNvng4/EnykJXI
+LtBJJQyyI5TM+KZFs6l++DLsR668flK0mSYzqYwKzUD9LSG3mxKMvy63X1cTnlaKjArNwHwUkHWv
+GWJGFF79/sE3gH5cAx0kS4+A6Tz4So/FVGfP/rnmLrwyFbopHLVVuTM8qYw0C7iepEL6Aigu7IZS
+Y3AtntnpdcVmzX7ttQVI8e1nPl6ya65Rt7QplEe96qNeshTJYuNn9P9oHSpFAFfowmLk76P+hvYl
+4dR5W3+vPZSYwjT8JpKwP47HER5HCI2D/DjCR64cnvsUfczwhDZlfxxhe/BD+wc/RT7DXUKezRh1
+YGEpETAipDGoOVzmuUWTrxm+aKssL35L2KgL9lBiC545IknWiXgb5ld7N5V8h1qZd6FXOjSKCs3A
+4hT6bmCmYWBF9oFYlHthA03wBn5xwDeKGZ4mo0Iz6EoKyEsIxiYJkR6pNS0NCI3WZv8iqe7Xq7Yu
+ddCYVJ7y9pBXumoCCEOMmRyKmT8VeISNy5ZiZptMWwxcSIxcnxgTQXSRiEU5MeJTJIcWNEWE5Bu0
+C5FFpqZSDZCaed2+VCKvqhvPKO5cY9opS/HU6Lt/pYlPGnPLYsp5dsmYkWG2DPPKMGuGLSQ2NJJQ
+jJYpq33U7ynH/JLCrADxkUWBOyzFFOZ1ksMUyl3zfjf+hxX8qtfCvjjNjaGv8BVa4jqRbN8U7FYL
+V6nVaX1yyc9QUkmAvht0IRbEAXr8k0IZKpvsduFLSUwf9VIphsjhfGLwwDGCA0SODnzxSSEaWaTs
+EMFaHMKDY6UVnjWqmJGzl73Cv3M7XymMc8a9cnhPQ5olJd7I9OLEKXPweZrsDBwWDkhCTlrgOKX0
+XWAfxpcST4ock2hS+XkBaFkIdmN1yZrDhHwZFm93WSPEZapjpJVKDv4FQSNqnvtSNA9W1F5/DEht
+/L39hWpHkw0twD7QqNqlBJMViBElSf9KRV4EOELinJpk3ODe1Fuyka8KHCHxfBKSY85BEFP9N+wB
+Yqpf9AosyivMQ4MPqq/UJRyhGKRFHHs2/gDBEWabZjkPZ5xTc4ipqBQD1VDGq9XDPoX1X2sf/zVe
+MH3UIR9CvOl9eFV9NyqkmNMexp8ynbGP/9g0F9r+JKPtnoQ2764/cHrTulfTdtdC/qvnW2aJbsk1
+LqexstrDUz4LdVP7Dl1/VeGPGvLdQP2u9/FXvztoVY7gPm32x9THfy39j0nz+a2o4vwpsbJI/Id+
+GRJKpkU4sKaWVcqBoBgd98VyFInyq6MtVHIYHa/kvy551oplxCO5uietWVq+eiJQVNdiRphODt/o
+V/JuLkir8NZu9vubIKtKjuA8KUgoCmdCnzhqGcRVKkj7h8yBVgOTjbd5HWBUn4tW/793f999fe/9
+09v+drZbX1tdBCW0nbcRFUIOXRuQSeYdxurZUpGmGINUShyX9NmWZHP64uE3p13mFjntR0eJQPEr
+sdWdHDqz1B17DAN3Tj1EMohGFqn+fJrhc1eeI2owKItwzE+lKpxi+pAntxZyAegEmQaEXpbftG4q
+LYoLVzwqO/n3ltHvPaNfWhQXrviVkdgSDhWGaGSR6p9WqckVmWRFYmG+nAIfZBs1Lmq3B6ofiqoN
+QswSclJu8R013qW6uzT+Tq3LdULeaYxqwMn3BC9cKMo6JkvVu05GhS8lMcnPpU0SfdRDpRjE/+JL
+VL64znXnC+5cd7nwE2BvTnu4gffQcXcCLFnEIJVRoQNwPB62lw7bScfaR0eScGhWaVhmjN1Qs0lj
+ZQQWJwlPFhLIBfSUol8SIqfZpk1BWno0na8pEhROekbe8Lmn94+TLz/2jxmzkygyI9uPIIIaBEUF
+tUgdJonrS45GAaLmmaLXNCXUlZxpBrNtStgoqOwCL1Iphj1AYMBU1FiRvRYew0oYuOQCSfScHZct
+fJHKqNABUuGyvVrdg0xYyiZLJGVr5IAMzW3i4Gln4YueEqWqkX/1nUEEyUgroKZaZCQ0RkSDoKhg
+lqYuqHZUS+XDh3u1XBsVel5yvQtiP8oSwgUDhwAc5LAWgMncl79LkNcA7bQVSYviwhXv8uxjkTn8
+3nL/vef+S4viwhVvuYuJQ7Y8g3TBZ808tcP2vEKR1RJQXNgcOt+FKEtaPWFBY4IcykJ9KKr31yWS
+M5Le9G8osUnn+Hrx560msd9+ln/rLsTrIaWE73kU8NZg5xeUWLAmV3CZW6rcqlXqIM65Nhx0DF1J
+NudIh3hLID8YaCSDMRTxQOb8FT+eGpQ+Ei/2FB7q+Oq3jPBsp/TKEXKtZREnH7S9StpquSmI4u0Y
+L5I5BqgbjkVpW6wOtRioqakUQyIIqK/aYckmowJ8cirIBi3OJ9QyZLLpUPzrnnZaFBeu+LQtLM/F
+fXiGP8S5qxRD041oF4QsPDORkDj0Mw0DMV224dfavOs7aA0E2feX9/Qv+1Qej2tOHNbHEY75HzjY
+pEJY3pTrdoYsYRbzXsVazsSSfzsF9Pu7iHMPkuOoNCoxfkso9itAsErEJ3U5DR7UPmR9GdQV29ci
+NVZZN2a8DBC7vUJHxltOxyLEE5JqudLZDmrP1PIiGF/8ezKeXYT0hqUgSmcJdiI/qH2V9EmGMr88
+8Kw2k96cELR0iMWNcMcaUdvXIhi5TbDCy5IUVpQcvRPrHt6p8fnykB51oDcdVfodSzYcSUJoiJZp
+1XFw5i19BZVs5oDXHY8TVeH7uiyhSn5nRoby/PERjqslEazONNxLGyadLLuA9HYBM6VNqaboMGSn
+wPtssVbGjXKXAsNGI+w1e0bEK+USgLKUBooL28JuKPsW3IdnOGXS/JpMlFuIKiJkU9kBkOm5G2Ci
+JIrGo/SYesDyjChGZKBJ+nKsPyo1tv6kbVHInvPlNRwWLcRWD4OD4mKrXZOrkxaojxf1FG4K3TRx
+nM9VGcDEXw+c9Mwp0iJnbA774oVapWctKhGJEwqIouO22NLrSaeF5xkug1AcSvwX0TAQU54/UCX3
+I1rI9Ax5ETzoJwUj2SVzRnTq6bfNi/8fA2xizpQNKC480vEfdSXRrWK2+afq5Dl6xeXQ73nsnp3b
+75/Mf8lz9IpP5TOfJ/z9ri1XUH1aFRjNXE9D2Wn6S+CO+1I2h2rW9risMSbly7gtWmmMbFMYV7Kk
+HrZ/r+GOOtbURVvtsia7rL2u1ViMSC9rxg7T5hW01h5a+pBhc6ctBaMT8fwJfKOQPfelKOqgyeR7
+ljd0b6GbEm+ad/z9m9fvf/L6pZsSb5pzzNSdZtiSbR7udSre7LaHWO1rMWVZMnnV1/s/TeBb4NYA
+t+1v63Bj7fc/pf7eS/39U+rv31J/vbMv9deXal1rn8UFTINNRfl19Zzquk49y1i0XYu7YjMQE07J
+paYF26JuaBQN1MTfd1+YL6eIz/w/91MYx8ihQ/XxolZCRgXs7rTi5rk7RbmF1QFY8xgdN8cusbY4
+K3rQHq8r2xF8ZHqm4LAvdh5auxpVprAoG2J3HTVjhnbI72Cby/Gywepo9VN+8TTk/xjg0q/tlbbF
+7k7SH0u+/tio/zH08R/DHxfJ4n9nGAbigVDuiQto0/aNSlxEzXNfivmnQQZdKW2LQvacr6UtdzcQ
+sEoOo2OXwlVAB2zB5fN7z/WXHEbHLoXLVY8fQfPxbQ8Vm4S5dNd5dL/2I4yX9U2ni8MIm8YJWvPM
+58rh89JNYFLj1bR6YES0Wo9F1Dw7F+B1vCwbYY74qCmrpKiEppnIXlzxTaZYm06wNps/bbq4x7SM
+kvYlIRxeRD2BEK8JXvwyiZHEchhluYClGKShYi3uDMBaJoc6VxdVnSgqNAN1Q10imh/XUpynJKNn
+xUlRiN0oZ/HjH6GYULbXtXvMjgdmY9hcKgNzYiGN0zR6F7YRVqsYmkW/qFuaF/V0Y7op0Wm/dFPu
+UT4PPVJYRS9WDvmuWUVf8gSaoua3ssJKQ9M9TbL4LCsaWM9oqIdun27b4Nb+DGmoZPtnJrkBAGJj
+Ci8IUQntyiCm/RyyeqgoZqlqkudA9IGUlhC+IEj1pB1KGRGYZTOICWuBDf32i4MgIUfImm+TtZSG
+Rwmb3G5oWAduugrcsM4yZZfj4ou/nqAgqWSlTlZX2lfHleSXPOXGUmJYla8WKnQhxFxSObzMLSQe
+dEHAg8yD4JGCHuNA+fTmHx2DvDf1m+FymsTUBgH1bCp3eUCMWSNZ7lT0EnmnHoqBmNIzYR5WQsdC
+ipCkKcT3rFvdXik4St3zflN81LiWIkdNjbKoztABfRjABF9t2yojXsUIXDOpjjyySk3AB898RHo3
+CNmQnf930lBJUQkZTNQpMPOlxCZueYyWLW26TMVIi8Q3qZ/qUoQeX60OiI+Us8MORvI6PlcKexR1
+KpphHTl9EVAiLwnhwTYZLjttEDBGEQ0CVhHpU7avp72WRH1xPe9KvkwFoWQdWvBwW9OH21ov6VAp
+Bjm+ey04YwhpHEtusSF3K1kWhWbQlRRQFbQnLl8HtUYGHSTyRUV+wo/+6GnpUrA8k9nwpBBLGwHH
+OhLsASImxG5J78xDKcOz8xrkOXrFe+l2Qqvlrt1c3z7L2m5Kv2mXabaRwnnFuK3IViUzZtL7AU5b
+Xkp1jmK2dViAVsTJfmnxrvabulpC9P2m4TSv+tpf0C+v/fqWFAvdtXhXb3n93muSfdbOcZBnRGjG
+6K9WS80RB9ZIGN8Zlqm/0nFTEKVlrc2aRNFxW2yluR60eo8+h8OvWE6haQvpnix6i4WxG2jtwBJR
+K88XhrwVZfRBNoguw7VUiZHwbGvLwBKByQD2ro6MbyqnSvab77e1G3L2/B8i8Vmzy9RwCpUcv71g
+f8HwChKyMj/eQRNY0xUEhkvgSSqjQleQ6iuqWbai9w+ZE/X+IU0oIF5bjZIvQnGkDCFOhJtoIBhh
+aR8XQG52gNTJpLqJIdnVS5ffHmTYH/r9HUhsPLozrzOolONCUR1r4Q/dtbtmibHyxwx4Y9TsXXou
+S83urVdSxmSago4RwppwjQ2i0aK40NL96kZ/0aVSIneZJ/de39QMJM7WlxRhrm0YiOm7tmhACzmW
+nfn6rQ/pjtK2w8ZmRUTC9GzyVkEf0mEGOoycRacoKsOXEjwgzqZyrY94tS+dHEbHzbP3d4zWZeX3
+Xs7vn3J+yWF03Dx7f8daTpLLMYFoJJ4JUcgxmRk7IuVMBojbSSUM2i/HepKtDyte1NogQjaQ851w
+vgw1AY4qITViC2rT/Cu2v4U9GcCkm9Mkc1HOhvFLSI1qQgFnI1y/GZtnU1CL1mUWM+Z/eNaSwqLi
+cCbSF0UGL8yM7YVBdmAXjU1u/A3sqCm/a8YrVmN7JY3GnpqULwD2hyKZ8hSoZ05CuOcPoua5LwXI
++XM1ibeNjzR9WI8lKTSFroCERcJN8ioDQrdfV1cYzCJbYCsXQ74uLVCbgXqJFHv75BsEod815Nhk
+7VhAuqNxd8rwnDavLTdUqRUdhYXVWrvVSRgucjkhPXxwd3hJf3mhr+h6z215Z7w+E5aLFcUZpxUB
+7QmvEnYyEFN9UjHAJYMoaTNUY6l7NftaRB/39fPhls79Oxq3tzPWexnjJev59gLJ3RDy/2PK/0nY
+xx8LPFAWdvVLQpmdsGRDIlljEVDTUEnNqJsxX0riXwIEQcIsapVQbDwhIYdslgyq7IGa+CXFUWXJ
+azTqvgu3hNVcUMiOB90Ul2A1HXQf6RAuW4BEhnJPbb1q6V6zHLamMNaawsQvkbPfhLxY43EBPlqq
+Q2XaFq36VtxwUOzKgG7pOpb6R6sZQlZkmDB4tJp3bETNOymh3zS5mzDld11+39U8VchZBNQVUa4e
+eEIWvSYWTluRtCgubA6d70LbMOF8ec3cfm9F/96L/qVFcWFz6HwXuqJ/fdEG6nrtaXNoe1dUeGn1
+kEwC9Bh+0nQMefRv4CyB88nAQIXjdeCijkEtqCkmgizNqbhIHT91fqhv6481VI8koVRMT0oDkbOe
+/IzaiUcdzzDCS3nTk8O5yamBX3uVZ/xPfWNIzs9nljHkRM89+f7GWbYXBEWFDpBpgVAfiuiMwk8Q
+ly13rE59lvG05wyZOi2KC7uh990XmcMvLYoLV/zKgG1SIApDQSjkHVqVhucrozf74AeXFDpTk1AU
+DlOWji9A0RBZM4snDHLYvHN9QwSVapdx8Hv+c4bfkvQ7czC9vtmhfLQqEyvFbowkyhIhuRUiCFgl
+B00hxv6qhwGbUCM8J8uBPJ31w3cY5z6/MNW69IHHC4/LXvqw7CU/+aLApihpo5w2L86Aa3qRsGTF
+1bxkG7FycfH3lyHZvutVGUiMsiMv3Y8Xd/2rUH/jGuSqn58Q7CBPaV71HBBslaeOr+NVCxlwzDwF
+qGSDBPJgL0s2fMvMnCVFJUS1mefv4/h4HB/9cXz8PI7tcWwfj2NL858ex7bPfz5hbsfj2Nrj2Prj
+2MbjiI8jhvnfH0ekxxE/5/9UjscR//c4Yp/R43HQ46Aw/21FSfBi4NsPFDaSUHaokhzs0ODH79YD
+MjXHfSxlOa883Av2U/0iOqh5dhG8v52CKDyObLjMlkBYI6os8Sym6DVLBX+5IFUS40tCJHypNSFf
+jI764VgG2Xq+wBLJaT7nZ5og2fCiAsEqe39JOV/4Me+JyREXINl9cSmco+yzLEkzvKSMcg4IVrnO
+TfZ2yxJKsoad2XIilWboBgMkZbScBrzhwpWS/SKnOAptNEIOHMvYQxkq5daysPbQjpcShMT0qnVQ
+c8hmzmagskOrKL/BrCCOEs5MP6SbTxEhu0jJh6EPEG/rhxwBH+kjDZVd4BpkME1bKBKysvFnTapD
+gr0lo5n19qotTPnFAddMvj7Dgl3RBaY8wkuoi5T4XtLnZ5IK1JZKNeB4CTngme4UBYJglmpjeQXA
+JizaA6KRRaJyjLU4NI/aFsGYQjPAhMa0yzgvFIeSpNVmNWJ4ylWfUsie87W0bkiGJy0yo6SRfDJK
+QVeIGM4FmkEHmVeFQISIMiDSZmB1tAMgav+P6P7ytR4WEbKp1HhIlE8t9GGAbJkXcQnUR8gGyF9Y
+s+oD1ac+5A6/oV2H/LX0m4nuWryrd99Vqj09sLTf/xT3+9/ifumuxbt6970Xx3GJA3wb6PZpIKf0
+pS2UvNMWPlTCQFklDiNmRGZSCYPcCmRqZBCN1A2nMME+HIrHsxEZRCOJlIkSIGRDiobsiLrI/TuK
+uMClWPFgtJLE4oNISsuYwkS5nKfY+Og4C7adgX1n1tPEtSDZPvriIHzUatCZxOtL3diYOZAYOUwI
+RwkecRMpBg4LB5KiwIX3Lx0cfHCxMtpg2qIzFjpeNZOBmJDFIVp/1W8DMfFGcjayhCyS42Q3U5Oz
+PktEIFc86A3Il2FRO4ccvEnCKKLBKAL5veUUwjJfSpqZsqT5ll3zjey/04CY6sztGUotxMAP2Yhk
+7yduuwDYxLf96Inh+Ck39+jJ/YtfFRq0iF1mkOb/MxQINj1LTc2ATdgN/9amY4bgmxzmyykz2Rf3
+9i/igA0Jj0VoN5uCOi3SOJaVJJxKDk8ZNQRkKBPW6A3yFEN74gXwxRxBHGzXUeMiieGozxkkmXqw
+5LjMQXi2YCB2GhAUAbJpWV7Kp5wxKOVsg1HO55bYfZfkO3zrUUuRBsn1ENOoRSUbOAFKk0Eja5GS
+yVta4i2DaX4nFdyQ+wc1yXLnS18D+cLlQfuOjReYaaZWAgdZwnWuWkpXjQyikUVKVYv7/I5T1OuX
+DKKRRVoW3ONKyDgnTxJL2UhlVOgATa2iYKfgezxUZgPxlQdy2V4y1RBgly1lCMl/w5PBSuq0ol28
+d2DJPpjPFJ2m8O+EsYCdzN7mZPWhn76fMhPEx8lzHZmoF/xkltC5G7CJtlF3dqZcD86ARpOnhtGe
+pl+mZXMsY5F5D1oUF6541Fg5X0urxbNL8ibPPonefodem0M2f7aE9hVi45M/NiWSDa8ZJNk1DjlO
+TsEllc7rgUptobghJMghY3dJQ7uRUFccBilkz/nyWncK5kSmLkeXhj3wOjKDjMVFfv5PgU28ks5C
+HqgxlFg57CUIEpahkl1kE+VsXwr1V/gW4uoUVHc2YQchBa6R8NkEkTIolWJCPPqV36GkcFPKXRNP
+KfRq9ZIGLZe+Vr0Ynt+1Dd7p5bfKoVxl59qtMmAfDs3MJVWpekuSU6sy7rFTDxsy7Pi8BH4WElKP
+o46lT1Bb1BXRQl0PiY7Doduh0N1h4J9CQR8bYV4PR2AmA3GRF18BITvMl1OWsxK/c6kE4y4/uQiW
+s5MizP3KOXzwKaqMMOjcXsCXvBe9uJtSi0M1y73PiT8saKt9LArZs0wYTO1LEcRXZZXEKNMHlmLg
+8Nxe7HnmASE/2uo4X16TVPtHCwZkJJF6aJ8t1dNRvhbrPl6apmEn7tOyT35xAuRmw00x0ltihPt9
+8l0O+XIq1SMTRITsIqX/1cZH4DH/qdWSJvBgKS94T9EgttqKww42N3OccyiN1bW5Q+92MO2pDIfd
+8QVGXYTUQaU4JZSIlajjWIXZsxiEl9Pp4CuKg009SVggYGQhqw6H3NycMpJKNlwz/N9ZZ4mz+Bbi
+m4o80+KUzhp/UGqQ5+gV7yXVNK2Wu+Zda7spHLXJIidLMVB6YzxSvpTNSNGoLbL0KyfUbpPBh6V0
+P8WunDI5NDPgGCrtSUBVMzle9lochnxTbm5Wgm3fYfMKVdBBvEpmMDhXMacV8pIwQMiRiNe8WDbU
+9IUcX9pmr4znq8D6tWCn6lamAw9JLO5LGQ5hfqOqVfuZUFzYDbU+dfUv5eVUm0Mxn90kRSOzabYn
+HtM0lLOs07pTF7/JYb6cYnvrHKFQPe8KXDmMSbdIiD3jqRPbRk9pdc/RK90p2CDVarlry3Vlh/Ty
+sAM12o9RW8qXU3RjRJWY8m9tSUge3FDK12JJ1Uer/ImWxRLxO8NP2obKYNCVKBo1o24pkrs4cIZr
+qZmMy9OwFodyJnZav6njrvnIxW9ymC+naOtNdUV46zqWpyaQom5UijgBK+bLKZZPimK2A1YoLuyG
+2hJr8cRYnTbNY1epHZK5bDfuSxmG1iiqdK/pTqptt0ru1BaZ96otdsy5k8qo0Ay03U7LY9KlpCbN
+9Szb6vCqWauKjriuxelo8ZQpNUv2f4WDJR8ZKWDQT6EPDLFpC41fajfMi0fqS+GJXktbplCUJFp+
+8wGA9JqnpYt1qJRUz9Cx5N3Sk0glInPYtCovyikYodoSJVOxpksLTZcTmq0mtITre4F8GWqTygpD
+S/mUQSjtVOoRzh7yTdVIHPfJZuw8mMG5UIDEZpdzNpikqBEibQYhO8yXU7qxjSfQ1AsfdTE+Xqrg
+Aoy5jJA9ZxcjMzNV+rgpGgV5kC00tHSEIU93Lha34yUvFxvmyynLJ9Fi9cDT98rJsZbVN1mHAYlH
+f0HoiADUWHSJ/qJmYHEK2l2YUGm+DKK+OC22Go0r0yJxGLVQyIbn7tA87PACI+07dF0Ebek7PVV2
+QPnK5JDN/2LO/C/u2zA0A/NRSItks/+VK6T2xYHkIe8Kk74rPEHaxZ4SAJHyE1J0CTkFNwXu38vt
+exkx9rCdWSf8e4gUxkvws8mVqqKkCTmVr0VqzPUSKjrjA/bFySvLfSzKzqobKJriM+jEZye/Cmjq
+pUqmRZYX6VlKSI2WI06OTBaJVmWsxSE8JIms99ipaU/u9SBVM3m2Wom+/GpxuFze5NC5v+85+QuB
+PQ0IVXUL07DtSmOde+XY2M+xthUcstfUjVRGBY1B7ryYLcaCX3tcmC+n6EY4Felk1JbquPmJn57I
+qU1/CQy4IBp1JdSPF6jtaF9a6n907WjedN0N+Y9u/gBdelaMjp2L1WwtOC/F+dXmWSNwuSeUr8XL
+YZDD6Ni5rEqcvhLnrRKna5WpSGmZflRSXJQtFknmJ+LK09CyYoWd/3emjiELyB4Nw6IMmO3Cc37U
+Om3ncBQXdkOUKVyL5+VUm0NvvoQTssbTgow1J3iekG9qOuCY4qIGeY5e8V5WYdG0ym9qK71L7dL6
+lHZOtRNtlzldx8jVsUY934nPWA4BwrzvtSyCsQ+VFI10o8F9KcNhvkxpSlY3nHA6f0NZukNH8bUE
+lRhSu/9gjKhmfzqy2GywbhLe9O4M5Dl6xXtpzVnTzgztWvz7p7jfv8X9kufoFe/li8sr/+Vyq8Kq
+gdrwGN5E9xNvd70vAzlnao6Xz7VoVa5QXqS+Io91kaycL6+p24/ItAU7gSwtX3fd+oBYrBWgmvd5
+izp9jCvjvJVw3vN3R6MqFkUG0cgitXnOEK0HnyEuiEZtUTc0WhnprAHYjd/kMF9OsS1ZquTMx+gI
+qaikqIQCR8AUE0eurr/I3HG89DZ9G6msnWZKvm6qVkQMulugqauOKcBuPBbl5Syjh1qsBLeREp7t
+Q6XtTVPMBQ1wtg9tgLN9ILbjmuTk4IsgxIZL2ClhIIgI2VRqPCQKOt8Ynhk47s0Bustbe8tb+8Xb
+usXbesXbOsLb9YP3rRu8//SCuXROBvkytASqLH8tTnCZdZ+KYllpndDlpqS4yMoc9ny0aE1JN1R3
+53uGfaMx0gQaAYLt80cBZ9KO66qu11Ud11Xdrqu6u67qfAXVKwds6HqXexJH9iTLLgJZbbKMCOqG
+Y1HaPN+S8iaJr2xyx+tJpL9nKiRueJdOCcZPvdfZR8o4hBSXeSySJZ+l5Gup4EwQEVIyylqjKqFY
+ZzizHwEPTguwOXzmejDwUCOrJAPP39qzqbgROuRhsoFHyQaeNxt48H7og/dSCG2vFAzE1CT8qPyr
+1KJICdReFJRwj9WaePCFJ4AAHeLjbPDpFiX3N/WlLxqvebHBWaaaTXIOaUhIEOh9+vSbfsKE1hdM
+FJvD5Yoa212DIRd+eM5jtCCh3P8ZjTIEO7Uk4WfQ1fClwMFNFFijaKQel0rUpdXtRTuW2Zwm/nOl
+TK69jBFhrxZDuUD5Ol4O1UF/w84pWqKKMM4dSEb2/DgU6ov78LwiUBXdBJlTjVa7ZtRt8+ZYLSll
+ZBvohtqFRtpqGIsoLoYDEqBm+srDGOmzPg2eFB03z90r11Kwf1iBT0a0dsP1WQb9ueEp+0ul+tvj
+o/wOI40TH6BT6orDQJKe8swMy+l0PqlOn+/5TyThjJAnT/HgKT9U+y0t+Y03NRmm/aqDIPrj+Hwc
+n9vj+KRS0lPltMsrqs/H8Twex+txvALFFliOVy0CS/YJT5JQlLrxgKa0jGlb2Mlz9IpLICd71X7J
+c/SKS/Pr01RXTvXlVD6EXvzpdmqLllHpRyUsOUFsIs+uUhwKNfzu3SuUEfpuEJ7yIMZN7Xedjzk1
+LIRX3VWyoYX6UikG/t2ARSEbSz1a6kQGkmYPEBud2EemhPxHzdcfQ7/ppiVa2IdDM9d4Ffn9nLu+
+HGwMuuv5ulnonqCPP+oteiU9KqVw15bneFELhzkriMNVnhSyw2U2SniSxDRl7k19q8+QeT90hFEE
+v3oJ6EINAqoIaTY9PHptEBw7s5j5xanpO2YANn3FueWUs3yw4kX5YHvhN/6KQzHXj9A+Ek9Dpra1
+qw+HcEKsHBhU3O6jctt39hge0/Ear5AJxfLJY8odgkpYlB1Kj17auGtpc7oWu/+t1v7fqu3uOUKv
+93HTnXdxqIkgx3XQomVMm8OQbwoqcmWIOfikQtB2Gi+JanWvhRZx7t8cTEzzn7OWpwvmbIt7cGJb
+DpHywZPBqciHT5QoOvb21pcinQ+K2serVdk6p2GbloXu7n38UW/RSDoop20h9cV9eF4RkvJDBPEi
+3SvleSjL04dOkUTpgDBJCnKRCd4WLWMhh2qGxI+cGjqz9OOlSJRWJacjKCBGNqdGKrHVclPEw3Xx
+peRrqeT8+vC8IuDeq/xgyuK+lLR5DvmuZZeFFclKp5sSb1q7a/d0N007oKi/dFPiTWt3rd/Um3bL
+VLZgIPnQhKORSjYcsmskeEkoFehovK4Nh0XmV/p9HK9MH3aiEAZVebj/lem5Q8jPBRmieafKaSrt
+EKI+k/xs6SvXecqR8bR+pADRRW4QolYW9EEQohb5Vo1ScagOPyolCXf1WmjjS1MlRMGiGzSRVMJl
+txjbUFGktwov3/6PICyW7/Cph9VdeblbtsOyHStbZHBpzeUC7oUhpBZXiia3xAmJEjXIrlKKxCWF
+0j8L1ROF4YkKpW54vBymzSnwWaNBvZ/sqjvRVXeSM14RcJf2q/rV/8XiKV7aPlVartLFsf14hSHz
+HWFH6sArNUJFib8aPaitpDWn466leFM0u9oMqG90U8p/9X43jD9qvsyQNkNeRQIvEuOoEn7U/WMR
+RcfNc18K2lAU2Od+U2rIZCOzUc7qaAsLXsuX0+ULfqLip66X0ndodT9qx1qLGI5E0XHz3J2y+Fpk
+G7WdraGBR92ujefCddRIz6ZL/05fFRCDxQ5+L/+uoeSYaiSHZu69bslnaZchdVTdOMq0jZa2u7ba
+UC1p3Azul/2dsdy1v7E4epZBVXvmYWlvMk1WTFgpzxbedFPiTbs7tn5Tb5rtIlM1uvdwOYT5MxU7
+p3nVoocBTPganuFqVB3hxhrhxhrhgBS90m6KT5K2m3IrRecjppWn03zMSvPmR4GcAjdL+2897ZOH
+d73/MYy/OhxyGq/q8LYd3rI2ZlnVID/mI2gdUbrln169h/61CInwEKHhMq90dD8WdrKxZE9b83ts
+6epQ8UaBU7SIirWLyfPlmyPb+LJ0OOOgKPTEg3+safJ1SOmxtOY+YHgeNV+75Xq0Gs9t3JQVNeQa
+tY7ayO1nUcZN0ewbbXTYCKVaV7W7dH31AxVUelpbp6oNOfKtKqWDolOsV3b5jhXj8SLtw32EsSht
+i/vCQdEpK8uRdnt55Y9BU1/28y1OTX9V+qvHv4a/KVYlrvVrL3c9/jX8yeP3Tx5r66/bz72IqQ/a
+PSOzNQOa/ENm/tGNXEtIU5m/sWk7brRQ5u7WfjFaPV4OLWO9lFbWLodVBVCndSpQ3bbxXfM64Fjh
+HI+H/JRZFkkQ8glxw/6w3zVjqR+YYmXNHVW7wLCZK04HjSw5TgHtthzT/qzFNL8Q0/wqzFJcFCe5
+nnyUT/OVg4TbwDO9qlyMOYmJb6jIzhRFfXFz5XXxy9cvPuNdsvJ24UdXAbJjlIdn5y77/+q2gYK4
+ABBFSS5VQGa0rLHlE/mJWCFp3ImIrBBuPjjVZ6k7NhLapbzOjaZqHO+ax5+Pyf79jqzp1J3Sx01x
+UZpETXLUJPqj9/FXvzsgm/+dZMAOt6mw0yyyVb74vIbvBVNx2y5n2UVIfORQvuRMdI3a66YblB5H
+Cp8zeNKY4qvOUBZ6WPa0V4cdPFSmTUmixLl+1jbIISJzUAkDC7k1LhKGRirZ8PsbIKb6Mf9b3V4q
+p3HWYwscxNpUckwoXJctNOr/Oz1KdKsQoiLsVeVKdfHbDQmfBbsEc+IbLYacfqu5qmTDFwfhB4JU
+RoUO4EMDB8sU+RXOD3GnBkHZoBt9kyZplkdD9IBQj6FZjeViiYZaJNUnv9Q9D1okkhUBPYpFSpJM
+EBESZnXkjziKlJijWuUE1VM0cZLwPCBE/dYm5b1QZP8Xa7dizVVWO5RV5+JSchzvrfoZWoiLJObf
+esqG1Vb0J86dIhtZO221IMWQkBUO2KCXDaDj5TBtTumOL+EiQn4ZVilfi2VsVk1QXzdkNBkVugH6
+t3K+vGY5O93SSnPKp85YRMgu0uJnlvGZCsSMnknoI+Soko0bSRhF7MRbTBvvTeKljym+eU7GxJ+x
+V6LmuS9FUOpDWzv7MOAoLi/Oif6EZygQHIlknHeTGjZUsW1mluIa6ti0MIZ8GUpsk1wadxYk5WCo
+5HGJn5tN9OuuiLzGZ+r0+ZkJIkJ2kVIF/qdaIOTzV4bRcV+MpFSLzEkELwAbnpL2Seohjyek51Pa
+6vlUO8YMPLIwZX+pzJcSupoxEmf+xFh6PhHKN2uVKC7W3FnhxC+Sp+uU8rVYi1uaFJhpQLDKjw6l
+504qhkpcWTilq0ZRCXXa5YHG9CzngGD1kGzlix7pKcFXKirFgE7x7Ly/n/075TxhhGSSotJ0fYXH
+kaTsL+Igcigbx7IzyOZ9kWyfHEA4dL4I4Qifn4vYKFlnnj5kGHOAwCldgKKhJtJTvbwkzoLV7cXL
+hQAx1T1cdRJ7SmZILYnoPCBY/ZSiP/GdNiWNo6jQDDQO1fuE5dnSrhJ5AaPjvhjpp7JcnFHGLMFf
+Q84AXhgeeOfmjEKzljihGXQlBeSRA3yapoLrR/1RiTRw0ITSm1lKPD+YJxIG+b1AkNoulZpR/YbY
+QieHSPCteXyvBBol32Q2FDNC+epUyjxmZvntwSnxwJ9hF0bmciKex19VyYaklmQm3hf7wQFnvh/U
+CuVF4iA570cmiAgp0bIcJNAH6Au+F4vpWOZ/+KyNDNgcRoCAen7Mehb53RiRHIPP8wHYxCenIuek
+KSgCpMoFZyn+jnzCZ+RZNkjEqvvZIeSnkQ3ZHLmn8ZxYXtWc4pBWAbGfFEKv8OYxpsD6XWs0ENNs
+nPJJYaBCQI7kCsmRUJ4ksx95JCqVp0azpzwKKpIcYcBnTUDOhHbqk7tfCTe98Kx8Kth0PL/JsGI4
+3b+hNd5pXxyIm4ylU8gErnwh2y+6SCUn/0oEAVVyl08Fi9S6f8l2f5mOm3EgZFAlFEVCTlaChBup
+7IBMBtHIIlGhEuDVahUYYkAa1F9bp+gOKVx43bZXqkIvGgApWBoIrXnUVIYBRUPkyyye8nFSAEVD
+9Wy4gJCMdQFKSYyjpYMWxYUczzXBibbo6bUgHx5BMHygTuP8SCK3L5UcweepIqep8v0iygYUDVFz
+ZvbktrtktLiqihk1y2ZDLURNJUUlyYqR3SXRiZA7kr274V/Y5BGYm+SgLYfLgKKh5C3MSRAnPwML
+kMxIfssCEI0kskkoioRDwkEqowLih5aOyT9Gh0Mn2kcaErI/qiYD7qG+6BOHBP87+XvuStP4v1Nf
+fBW8AENlNguaUK6j/ndyeVhfkB/yTf87pdiGBw4nXCz4VcPUwvMMLEuUHzOf+AoqxWcQRISEWdaN
+lPIFlhJ5bOFmkqugVp9JxEkqxX7k0Hd53jS12l9f4ndiV4Diwm4oZU29zzr3LdjVat8oFJVdQESS
+F9dD9hp5RZaaeuqjhcEN1ffUSCUbJJBlHPkiUJJfhWQhsfBELXtNTaVEzBbrI2B8AnEUb7n8TmDq
+I2UIzFwnSkzNEKQyKqASAz9IJISqjKrpC8RU50bzJGCEIwyVHMM9YWwhtcLwknC+OhnJs/jJCX7g
+BM9SIyCxviP0tYicVSoLBenMJhcQEwiAr1KmId9IEpkvJRwtxlKTT5ndsGQDbxlO5UNP2QMbJD/n
+wJK7yJCfwJtSs18/gJeG1vYlGnsmrI4IaKok3yVL8tO2aaAcXKLjOmwk/lUNkZnawj6MLyXLOnvI
+l+HNQc/nS5Mi/w1l/BvGv4EnGgOtXhGyyyG2Qw6z0Wj2+AeGwcE1k1415OF+gMRKx5LDW4/nocUg
+B35Z5iWIb1umcaaBl6nAvGESIJtLB2buDm+ev7/rCFklR74xo3/r1P0tU/d51fLDJ8sfOSX+8JD2
+I2X+JHxgbJLhHg4I9pnZ/4bGY8Rv+P2F4AxkdRmLy78a+0stBQOJ4ldnjOrj+HocX8/H8TUex9f3
+9Tjy48hhk341oUWVtKgp8UYKdoFBKqNCU1AXSyWLD0oW/dY8+Los81Qv2y/FTPqoKiURCqNXzVo7
+MOK/eJKlRNFx8+zctZaswC7dXymjdtzDGGjBWIQkZQQDMsqLlh8IVdNaiUgRAocy+AJlhaJ11Q2R
+qWwOsmAtUgz1t3LVniQhW58JPwduGB03z97fMQoWZXfofIbDtDll+fySw+i4efb+jl0dLoG65YDN
+OlVEyAYpGZxInKQDSsMnHAhJenwqEuLT14zzIp76TUEXWTpBR2ZFik6FOnLRsovs0FR0fwpp/iqs
+gJV1r+W5SB2P+qUOKGmkz89FXfFNi/K1eNVD9lGSbHDAJxzvSY/yJF5cZAkNAv21yFFZSMIoQn5y
+S0mSS64F+7kQxIAIjT8wtribcoEIr8c6RZ1qiovUKPKp3wwCX0pScpJ3SybJ5hbZIAwx5cvMevSV
+Lwh0d9zXBDibuGmjaANYC1iBxXKuEm61bOFNprzph1mcpN7DPlQkCjZiBL41C07FAGmx/4aN3GvR
+eDLqii5QhtZV+wIDTFmLydrUQ1aYckDHKmcOKtn+v1MFx/eXhFJqf6k14RjCo3ITeDtlCOp7gBD3
+PUWVMNRI5iQ8TEuOsqF0vY7t7vx+L86cpnTVyOzLHQ1lC8y2viwg14g56BC53tIWToUMu8fouHnu
+TnFshdvX5lRZZf2Sw+i4ee5OcewKMOuJQaePhD3Mu28E4saWGcWgcKqkqITsJnaFHwH48CwXABf0
+vEHa84YMiPIznFlnLLoDMG8Z8s1JAEVDrcOnfAcyh8H9Uvu8dXl+44QWxYUrfiWSPjXSOCMt6oap
+1POPJh1iVAkxlgx8RxQ0FknqWotKVKlWHVtGxaENX+wKbVxtKGsp22apyPWivKeiJ8OlQz3OBerC
+4oxpqBRDdx9UXeqlSqZFen468T2zHM4hBbFyuZHv0oHvCttQSVEJWzNR0sttOwBMKWcDmPjBLoCa
+LpViQCHSgBfa7/okufFgCPOZVeZLSTfzcr9xmMP1TC0bwFSpGcDU6lmiw2U+FokRtyaUxKhNo537
+yuhaDBqbtZdd+BVFJTOq547DnEFi6+enSjEcVFSKodW6G4gJ4Xz2iByaudbsEOZ1OlNGxCnd8Bpy
+Br2GnlGExEt+wBEgpu8mv6ZgaGZtmt8g4YzYHkfmoY4XCjJW/Fk2lV1AUgvkyxCxIhDKmV6A2sK+
+zPkyRG9aCi21Ja8gDUFESJSgnlrRvio6cRVjte76gxaT9XmPxSsF65eyOWkNzoaxXtgSaU1kswfc
+tUmGRXOCDw4+uJQPkjCFYiBOkvlH6hvWClQhh+LD4TZagDx3A46KT4KIkKo3SLihnkk+sJMp1SGv
+a2ZKfdSNeApAJejeKqhZSUf1P4pys8Cjv1RKNYqedUCSDGthSvlarHupYFlJKV+Ll48YpSkKGqLu
+tclDRMZ81qJagoOuNNLmEJMh6qn2cDaHnKD3yrYhP3cJ4KhTDGcLBJlFpo7mmsiZ/6RnDn0RgfEY
+P1Da9EcK/JEG+8G4mdzXQlW5BDOpRDslXbpnEq8yILLq6mxrKbzmh4f3M9LLWDQFRYUGkH6lY1Ta
+d9zqy2nvNWcDji3jBSEq10L7nsCMqPM/chBJZVPZBaRggXwZSiyHsiyba3mS7MfKF1cVF1dV7k6K
+RDQylWusenBwiDfumsiPheUqr/eIZMPgQFDaqWKCU3WCU3WCI8Am/ogPC4oKzUBdkOx8vuQ3Yhmx
+eZNISRP0V2jkmSPekvUbGX9zIOV+o4hv5PqNPL/F0/L7Xrlxo/MZk2/zzZAiZBOZFvQByhcAfU+R
+THkKQGd3NumP8Smxw/OJzn/Kw1gsm0q4IM+n3O0GSFwqEKJyuHMgy1knnnYRkOgPCcOzCiD+g5rK
+rnAJoAIMiJPB8OQ3BjN/+j3Lh9+nwELhJLmo5S/ABwNEIntUC629a2PvWuqOiF0jdjKJEnc8eSoo
++VmGK8ddCqxd6nRIiDwOuB/qfWiWh5wiTnn4Y0rd+ULYgANCfERhli8eiKRotGytK6LIEtUme7Fg
+JxZ0jvJc8ZCa8ktC+KMv6yBx6iBxyiBx2k89GLJZzgNT2Lc5vdZNpUVxYXPofBeiEg0vNCgth7Eo
+bYvNAWcR4Uvp91bX33tdf2lRXNgcOt+Fq65ZC5JITCQmswEbKH1FXsdn2VWK6ytpTFom7VDyNOWU
+y7K6mCzlnEO+HpZPvtg9R930rrQql+FYlDbPujmiI22BSMFoU5BxBUtz81OIWeU0zLxwXXTt9eRT
+5SUPCInsj2N/HPv2OHiYkKN77vF9PI5CJ97SV5LqqdYd/iNct7rxXXNTkuMTKSqVjY5XyOsx7f/H
+jJKq/RoDa/LLh9yuTlffksIic1mJ5RMLwvXMowV+RbFv9UhbTzlt8yWpTRYpU+3mzbOzn8dRjsdR
+H0flH8Sv+EF8lk1lB0Byk9YwUgmPo27hVeVmSsVjmSLZ8MUB5yg3RFjItbthB5sbNQON4wmvkhql
+IpPwA4PKPNuouPVScbas29ce2pcBRUMfLyk4rKVCsDp3dp2J4hb6UGkTTFUzKVNb1BWlLEa28eRN
+p2o1yiPRLKWMJw7aGuVrWkxc3Ks2Gr+T7AeqgX04NDMn4uOLBds54NG8zmrTzsG0kkzNK/WZjvcd
+Dd0oEMcP6iYlBR9hLEI2yJdh0RRpM/kPA7ZCJvhVdt+zzqDVHWLaXmGeicKkc5xf4Xtimv9P+ww9
++FIaBlyV9MXPgVLjV+VrKhtP3iakUMIizoofBEvBoZiHhB9yT2IiQUSV+VLCdidZKxAJQ1ILdvuk
+LGetxd0p3mloBHehVEa2gvQOqnK5xeAZAq8SoiE4aeO9n1pl0UnCKIIdO4rutUAgWr5fCeig5SwT
+PcZ6OsrXYq2yfE2apQz4NXWUh+b7Ig4ihztHfSGCy/7iqWDlx9XrFwlfrCAD6TiYiFeMC181fyTO
+bKbI85+vL6boX4mhSaiXqYv7UtoFVpvOeZxiUfLbK8ILolFbZGnISCq+fsyg6o8YMPz6cn9v5f6S
+QTSySMtWX0xanDavoSS+5QdAJiy4EAo/JqNCB6Ak2sJQKVHiKcds1jchlfKlLAeIYlcWKCOwbBKK
+EeEIzUBM33JSE2AT36pmESH5elCIlNT1u67Y72rx0uMY4IltTtsFoQ9aOAWuutS72EVoPhBVwv2i
+rN9mcjo0CLy2Zgjn/oLABsusE6Au5qsFdyzf1pzGx9nqIhgJQnabfRTXkFRpkL3TIo2UPZ3kpMMy
+5EWaN95ANuzG6yh0qosmh9Gxc9EtnsrvPbvfP9n9ksPo2Lm47L6Wz5c3r5ZN29rEamCRC/4BaUmc
+6ouDIKGU9RVQEP9SX83cmJlvyogUK46KrAcFDs0s/TtTgZX3XcavGCrFhd0QxWb79cLFy6k2h2pG
+uu+6QKIqai0aJi8CYjqHTAxAaiSVUaEZmI+Cln9arc9V53mntKIX5iuUEhbhuFJlXwqn5b1Zv75D
+4erU6eNIfOSCaUIJ7eR5UJa9z8EIbzYNak/CoKgsDq3WYiAnSGGOZvUKW7ty2FMkUfcUbRdddzt1
+xaJGvAKk3IexnPOFUVKJy7vE5VGieoyK0f/6OAcVcii+W2gfVfvbtb0C3RBO68V51lrFlaFqSJJT
+SZtD+Gf5ZZGJ7eoj5Kzbu01HpLi6ZokvIeVLyyftjEIwjhe1RWa8sm6nKhL1DHty2IdDM9fTI/bo
+M+yAXIdBNxohL06bY+mm1xN7/2kNYT/SLYy8dJ5w2beOlNPmuDyXgpRX0Zq/5IPNStmwFofwvXa+
+ZK75Sr1+nGO1Xep1u4KNOVM/mkbu/EQBQOJ37Isdn2oztOi0Ga2ziFOX40qvZxGwc9FGmMrvPbvf
+P9n9ksPo2Lm47GDVmz/Gfb8pzq3abymaBTtjv0J+ooX3q3adQ1yFegqLikN1OFs9wpDZ4VXqnkJ2
+mL0dSVwtmOvQwWap4lozfSYp9JBQfwV7sXjiI8UgNMjxov3U+FrMii8cKvfheUVUl/ZS0o8Cek3d
+rkx6XB0Z82ShvhA51Wh1qGbTJK0eq4ubBncRCMM2F2a0SZaKaJ1UT9LN6YRhoqdcf3Aiuvq4Gt0Z
+7vZj+qZkuiniN2h7aRODEfEi7ZSCzjwcps0p5oMqDRuShhuShvyEZc3XWXoYZ9Nds9T4R4d7o/HS
+TVdFot6pXLOkWeM9RIKIkF2kHIg7vkgM4Dh6NvzK4WKJaEFGMVBxyA47ZQgpbccLYUzL5oySLBGE
+qLNp9horT873WgIEZ35wEGI92yKKi7tgqSrFQKjvQSmJ5GLQTIes4OxHFaeqvxkDhu/ZVWaz4PLA
+GKk4nEHh67Iil2XywkIt26tCiCotIutCfBVfZIdh7+jNk6r3TqrdOql254RJqgjK12JU02mkukjN
+QzT5IEvlDiYdS6KfodXAIPFPIgjxQS480y1JpuMsxSCJpGWLBLV8cDXl+1m1jDkAjBZ42CyDPlvY
+BmMKG2QJX0Kfnyo7ABdaivlyivMZ5Dl6xXthc4b75RGvwRVOFdF4nk7IRYo3fi1QRmR8xbWWS97R
+BEzPOv95+bti+XvKFgBdpCSvMRME28X9kwM2fMq8jiUbOF9kq5nwDKNCyRyQhOyWNUYevgJwmoxQ
+hnEBNvGxW8sWGh/jOBjqwYFkeyBbPmL4Qqo2fmiuVunqtcnVdNXfxlGSBFlc0b8F/jESJw6GbM3Q
+7fiWLn3M/622onI6H8TBXj9SFuSJvgzWB78BXY/nWSDYOl+kvgzE1F8QXMtDluQOrDgdORUIVuvs
+fQeNzArf8RfJsUeQMNbdABEiuDmPQ5IdmooGhKhy6OGJCJaiXxLKrxkpIaoTxUUwzjeoJrHa0ybN
+1JG+a2pcUBx6OXGc8lIPQE1N5ZqyerUvnRxGx82z93eMjRZlN8RIf9iPqgJ/71X5/VOVX3IYHTfP
+3t+xq0q2EjV+kEE0ssiV1FXPVc02DTMVpmEAUz0XaA3WaWIpXG7bSN53URLjS0K4yLVm287jRhzJ
+Om9ME8NnaqlOkK/s1MZ3j1ri4FOGOQEuVba5pXZyxBcH1CA4A6yWNyyXN10vb/iEV21fh0ZJyCVy
+9v4nKFmTqCohbipPtM/hOqU7LW03BecfM5D3RYyYOFyzjqaTjlZPCTtEhlX31O1s3o7X1VIwckaZ
+nTJzDnrPpNltk2Z3Ttq6edJa4gcVAV1oDyLlkQwljuzbi1SygffBcI+/mXaBMxlodEbck1RGBbhg
+ptFkTaDJO3RT4lspE1vWJ0xrG9sp37czpOgVH6NZw4OKyWUxH5kxNXkaQiT2FjBfTtG9NuTlwCq/
+JshCNxZTvbZ+DG6xJrHSpdHHJ5bUQWKUR6YAYpK+gUVZlhqxIVGiRgYwoTAcHvpTcEIuUrxxG2pC
+Tc8XehlrijhSR9ZDdORkDZPT6s4DbSf3KljK2yCLu1d+WJFtqx/yKYLKk8lhkPqNuymLzkaeNeJS
+6btv01cJwHmRpaNo1BZZtDZhk1lDQ006yc7qeKSktnE+kf/5PAmtOc+j03EmYpN07E4p8v6SUyNO
+itKunc4GIercFJ6q4B4KHtipXfYUbrr3pFaucE+iyGHS8YC7fcgegMaBkh3myxTLAXdmFbuxz1ut
+bzKwvPQlRafQUpvRsmGT5ief+77QWYc5y07vSb42D0AsCwlIQijdJMVF+VpcvI8RNpmxFofL400O
+V46uFZxK3mBc2yJnlLzcqzemaUwmA41GDc4dgu3ckBjJuw7gXYbtjmG7j4/wVAlD/VGJFPJGOgCm
+GskgGmlkriWFfFOwj50+oJdoHXdpmlPptYSilY4yQxOIRl0Je27EuqnsCqM25IKU0ikHtVQdhYVp
+Yb4Wd0XdP8zqIJbP9KOSopHFaT2fOWzaUM8W4qnbp4qkeIUO+6v2I2lrvE6szCnmyynaEcfrOkqV
+WUgfSWYJfSQV4pSy7gImiovVwaa9rBiWePbRdCtSmY9zrkjRUMtUPnPYd3xkoHbbX7h/2fkcpsZ8
+RjKQaDkdTdmsFfAUlwBFQ81Gn+KaZDt9D2tfyT14lvUI8svNrLYUkxaY+mjaoKpopWqkVhxqV1dt
+3DWfSvvNXqOfCauBolfaTelec4rL8LI2qG3Q7lD8S9CNLWF4Qkcq5zF0x9aDWtChf2nwrPr7eYul
+iCOkCGhDxqdxrJPTwEqXQDSSjzk4pZvWjMymm3k7Tw1/rhr389U42uxoqHAjec+06r7pW0vHcAgz
+hV6LQzGPFkr/PPVcMUajMGwD9KoSFBd2Q63/6Q4xd2kpih4zpw5jMlliGRWaQVdSsEKaHW3vuoWM
+gYF/IXvKqgeZPp3CT/zNjbldS7hLCb2SGOtVmmoXFTgBDX51nAXMvcu96hFGhZCYOX2Ung80Kxf2
+UZM8RgDiWNr546d16KcDmC6RA0J2BAg+6N8g2zhVr6Vkx8tnkMPo2LlIy7Oivz/ite5VuYm4VC3U
+Smdvef5VH37F545EwtAgPlSqneCJ/qA3loc9XTTWo0V6IcMyKjQD81HQPOWJXpYcJZ1a+/PIgb9A
+BZDU8kKMSDbUnVedR221quSIIxGEqPVsLS1iowQxcAebc+toIFGvOlSygTXtqzpZGiN88wMGYpUm
+wP7Tq0UGs0iT6A8yCEmbjBXHuxIPKQ7tcfqop/0Ks775VIesn46BdkaFZy4nN+OJZeoTbXnq6vT5
+ycEnQXAkr0ufmcrGUqsPksghz7QJRCOLRPZZn9CdxPUpYT9ZSnrcfDlx92VKcdMcikRHaXgB8YxI
+KZ3y1Nslp84PBGBiwX54mVVvTemwxzJfSv1hvUa7jFyRnfLtq3oODiQP2eP6sI8+4XPyfnhTwx6c
+NAv4nv9S62+9e/Std4++7Z7RpOP8/AR2SM6BcoYY9bswNgk/ahiLuqAUQU1GBYF8GaI7fa9VjW+3
+qPFtFaKWzdMeFAaXW4xd1Ew94RYH2DI7Nkg8F/2NjXyFwSeYb9a+pb2+5Z06BuTwDQ++NJ+zaZk/
+/5aZ+ngcx/44WLbHcfTHcYzwmNzCxsFImztfecO11EyeZStNX3550T2/W27Zp7jllQ2tPIpGFsmz
+TWB5Kp77InXtZBCNmpG5rWz0Et8p7BUDh3WG1JO8EdACjdpUolrArsygP9ypFLLnfC3Nufd/jPvu
+cLn04VDMJ29y4urK5ylFciwaRJtDGwO3JBhU8hfoku6em+Gf/1juqS5R0KhJX7Rv4aslrrA89iWS
+U/LWyXaVcIWda1+kFxT0AfnussgOgERJZbOSYHgi6VM9npLiS0IocrmjxMZONV47djLXeZ6BhxBJ
+GEU0iC5ShHxvESAmVGFYHWWHIc+RSWVUaAZdSUEzG9mye0sX/S4SSjbf6Mzf2o+/C5J+Sw/9kSx/
+EsQgFMJ5XpLLJZlcczitX0AeRpXytVj7zNL+gSpmVOCa2vY42txw4hepWFBUaAZdSSFZpMwHBFEy
+6etWSvlarA5y5WGoZvGM4RgGuNjwWjeVoiFfQ02u2X5Z9Kb2my4FPJv7Tmuj8GXbkHOVxwAWR694
+L91aaLXcNe9a202RqF1GeIFoZJGa//4h90InHhAZF+BLaTele80pl0PNv/Ex6zA6tj221O50x5pd
+7+lZFlFcfHORpOd4VbmBa8ovKx8f2eSQtv2gEkPjTdjC/oGxn7Y5KOVFFBejRFG6odw6m0pLd8yX
+U7RbOJVg6EOlFSEnA9rCqZ0BaHU+dVnbFEt8WpeRHrxRNBkVyorTns08FjnnYQ4KWhodQyWOHyC2
+Hr+bqNQVAd2klmbtoivUQDRDKtswEMdktzVVuYBnN6CFeUXbXvmzT9KRNlpkRiwBqFKGZ+c1yHP0
+Sr68tioguld066HVcteWaz1v7Eq4baIKUhkVNEV39enk20MmGgxmQb9NtpvS2kvdKsjPj8vguMlS
+JyAadSXNKJ+Wk6B41LVLq9+T9b4v69+9WbfVP+qm3aOiLlWrUp8lWTOr0p2G/l1lCVBJK5EzPeGg
+9wUm78epPaCWjSwT4VtEd4o2xdSOVSvVlmsbDi2/6LYEyp+4VUD0G1o+01OvLpd+V6LXVj7d7Z7S
+bSeIoX6p1OR40VBQvM7Wa1vkjNLGp2zrtelgHHGkRT3Koh5D0Y6faMdOXMdN1BNptJNoxNrjpG/x
+p00+R2UYHffFWhKPVLV57jflH2j4+RlhmUxTpM8bxYXd0EqabNtlGhznOVFbRpV4026Olmkf9hbS
+Te1LJ4fRsXOx7Aa1/ZahM3RvoZsSb1q7a/d0N83KfVOuh842or8cFi2Tw67sE9jcJCadw8VkMzjB
+Fa8FpzV3U3ZOb/KcrTraEdOmxfsFaG+4lprJsdmteq5Krka+Qq4+t+q42mjnTZu2xRNjvhDFxVYe
+K7UsBUkzuZpAQVSX5woMo2PnYkX09QzATRVfuwM6efY2zHRM6U4bnvO1tEGeo1fubi43a4Tqez3x
+sqrIDig6/RIeDiV32o+hkqIS8mfUzG3aBVzppTBxw0FFOJxIjxWSRqMfWYkQoLhQ6/mTbB8zc7JP
+DsIHxAdFJbh+ho9mB5Ap8aZ1r/1Jh610qnpDbqQyKjQFdbE8dXiXb/KI7Ao4xwFhbhB6vAH1rCYq
+8mzaVsBuPDAXMMWS6JAD1CQ4N0zSymPg+6SxvQwoLlzRmoTP49aEpnWvvsk0R3Gh1ZC1ldYK+YHQ
+RD/q9KMu+Eickpr5VqRIpNUP3gpyNs+wk0ox6JMEjZ7FjYrPovtofQoDrPFlGMgOeLb6Pbg1n2eS
+yeCLwlBJUQm1esmnHCeUnjIZShO/UrdFxKV0p22eQ75r+XI6uWRiP/dQDDiWT/n4TJTX4l29+2JT
+UhnU3om+Wek16+b+e8YnGSyT9gNTumlGyHuid/feuKoSigtXPHaucr68pgee6FLcVyqR2jO0QeWv
+zs45aHfIQTpDxoiUdfjJaYdI+pXLpeXLacrwGNQ0L7BE1BBVkhEqrBdBdg00oSl0gDl3HKRcQ3sg
+xFBqx8+EDCGshoIoLkaeonRBjCd70BFQKF+LtdVZw/G2B/49EWkPHMA7T2fyQh18pqbXxMrxpuTL
+q90pWmdotwx1NNspQpY+2okqYB1rlwdOAdGoK2kJutTF8EqHZ0TI48iTOqmMCvDpll9Xi8Wdu0oY
+fiA0lx/11NHz/sCM6hQdN8/dKYuvRZYv1gEZ4Clfa1Uyz3WhoWz+Ojxhe66eE1cNz9kAZO8WnhZl
+h7D3wAJ3jaicB0SH1BNo3bbzsKZYWjcVLQNe9msRNss95+MUqVFtMaSyiOJiTc6KDnOicXFc94O/
+GyEyPMlhdNwXI9cDv9g7KUGuc7hyX8qbPOfLa8sNO+kITd21kKaHHqMe6KZYHtQMzKRgdUdGlzst
+H/rwMuPy1IYDIqtLJJWokha1ReamGcoz8o3kx0BEYq+t37MQFj99z90Q5pm7XjAs7RY5bkq+nDro
+psSb9sfT56mDnKnY37gssmsiuyBaV0P+Usg/BO21fHmdotf+ZKO5d+oeKXrFx6z0fS3SLk1dhy2T
+m5Kvm2p970BhB0a2Qw9khgFCsXJg3/p0S2UYOJsmaUn7W6tr3RmKOdW1kmOKpoqnXcoe/OWnHSdA
+GbCO88PdPlraLXLcFI3KbqQ/Ts0ASedvz6tkQwsxmXujrba4iBxjq0SRpDb/bvRMOmNQpug1Sy1q
+X0pzpS8dDv87U0+DbopE1aeNXMocIQHGy66DZQ846XYdMruNl/3jqkcKDjsYWyQUsuNBS0k+BjN3
+U+Se/U3vN4PXyCkeMY8QjTur/lKAEjXPfSnApD0JuMxjUUbWdcDY0ocOvKw4igubw754obYzHhRV
+wl43pTvtTTclX17VNjXVZSqZ4NlTJRgzLtmFKC7WGpJbYDLFpdZ6sVLbYvhoI68m1gamYuttyjpY
+Qb+MVw2ghPxH/eNs2eg2Fe25TBaddlcdVkK+qctTG6QMbW1GS71q4PO85eG3r1p1YGrv1Rzt7TYZ
+ihW19H4zeO1NN8WizKzt0t7UFi0/I9tulK6FDmuRFx4cmZQ8LQf5PWjGEx5YGegJQ+oEW2AQ5TIc
+i3RsUSU7L0vbFNSiVdEHlKhnkoS1DZWoiL45Jyg5IIQH7tr1ri2Gk3fviVSqoTwN8mWIWCw+dPkF
+uAnyjv4kXDf2ritHTF3JMj7Xu9c3dXl2chgdN8/e37Ev5/dezu+fcn7JYXTcPHt/x1rOSDE97bJ2
+qVqwN/S7pba/unaWkZ5koKmeeltNOV9es4N1VAhUoMLsOsTwu3p8J7lM6eduhzTjBVQTUp+YM/cT
+q3H91BU7IUu4ThHgvpQ3eXZJTt1MVf7E2YZ6g1bk0NkyMDp2Llbdw8+cnSq+134M/TRaoxH4On1g
+NX3osrktjw87awxcvAy7eBl28TLWxctYFy9DL14GlU4GZkIVgWoe2ieU8+U1bSqva5aoizzKJ0CL
+uqHSDlmCPeW0NC22D5W61Xa/YOhNoEE/Q6V6/Vh9foa1zc9K+oOv+goL1CZTvtFslda9i+IUcdeD
+i0FMA+sEY+AiY+jNNn0+nelzuX2u+M/LQKs5sEhoD7UbaipEW4pfyirZ481BSFklRSUkAeZLFUkX
+snZC4DKPRflaDId1Y0Y5esV7WQ38TZmledfabopEaVXcTOrtZk7MOi6YYkkpGjUlrREVq46gpXLZ
+2SgDRXcRVGMBLIpPSdGoLTI3rUSib9jquQBF2jMxQhQXW3o7sN+pn7aiD0VOVt+yWsd5ykOdpH1J
+Vk1+fxOpnIbEz+SmcKQNkieD8xXb+jmBOw1W4ZP+/PCkBgEV4mKREIuDRBfh5TyC77aybCol+Zf5
+ZVIZFeCSNfOvbNl/6Xv7i5e7ZSl145mbzNYwV0tRfrcLIH4y19KZlhzhKBm1JYQDAi59oCZC5vXi
+z3Ibqlnz70NmcExaUB/ZFbA20hTLPWtaNnCWz6es0wrky1BzMUVy4Ukc3+FIu94LmXSJnMOCEEQz
+F9z8mAQ5SCVcMM/lH6qbYRShVZEZQ8IKm/yK3RRa5gSUgQbb9UErUL4WW663d+9VX4i+vtub9ovF
+CZlq7Xbsi90KqE8quNNiSrucpnUUrbab4vOQ6bHTENn4B1+VssN8OWV5n7JNh4Ro6ENb7RDHJrMg
+ATGdOahkg5wupuAvPSlpnPZRwT6MtW1MIVMvgBr6ArPJtDSVLR0hL9LHXEy9lpIdmw8pZEdxoTg+
+ESvVd8/PJDsHJTsD6UJhwikm6aW3LRsKwISvkBsusznXcxggGuJbvg7U5KyAgT9J/6m4gBMgYIIw
+fXi0SyqvumhyGB03z97fMTaHld97Ob9/yvklh9Fx8+z9HbtyLoEMCSfW+paydOW+Vb4exdZhw7BN
+ujnYEt0IGRG6zulB2sdkaU9+yKHpDzkINAN10Ryx/b3jiNGraYEBQgHdjh/gP441mbjiKTsBST5e
+RCrZlyca6W3D+RvD+Rsj9JTUFqHOonTFbGm0cm+8EMNgFtkYW+tJax2Hsd8i+p9IWhQXrvhVNYyp
+73Uzi/n3lv3vPftfWhQXrnjNngaERGGfvbX9dI+9Ex7jBcWF3VDzxM59czivEVEdVOWXPvHsiyK1
+m9KdhjyX5iPBEN+pFGqeXYTmI7LO/6Aro0xvWpQvYzn26gcHPF724Tltdw2HDiwYk1TTmEwGGq2p
+BqmMCnBBUzDVsihkz/lyWkvUveriqHl2XobSIB8IpTZIhVy1Qtgr9WNtr25rpl1l0LYCh3zX8uX1
+ropA7RsZJUdi3YIMDgIwtatKa24UcYmumC+ndOPljTyIokpHTQkNALQkgG5S97jdWKs2B3D31+p6
+oUMx5JuSr5vanbaytUso1cyttsftrl3dcki7gdVyKhQXNocrnRYyWa279U1TEJXe1jlEkU2p4Xlm
+uXu8FPGqpZ95UWiLxaEFeRKb7lq8q3dfrbTptfzV7wlq+6MimqxcWmXSKo9cWeTKIXfMLi1fXndZ
+vMmxNlqjVS/S5xjq1s4+KJTFks/A/tMkQ74IKZRCNkR1bRvtfQfGTffeKZ9lFNJMBdVBE6Gc9cCk
+sXk2AzMpaIUuaTvOK+I4jHrsxfQM2cD6GLTLOC9Uh+WKFQrFfDkFU4Cpilk0uQ3NMio0A/NRSGjJ
+iaj4uQ2V6B3AbtzJYXTcPHt/x64cdCNgvpxim7fUf/7oLtN1EKtqebH1k4OwDizlDoVURoV8KWlV
+lMm0pqAWbNskrRCz5KX52Gj7aZ9+A1u8ZrRG3k8/8pqCXeH1+NfQ/xjueeMbaHf9T5Lf1W7LRn/1
++NfwN5e/Befrpnp349oWpZBvykqPQxwod/GdJpGf2F+feGSCSeuER7brZ+L79om8di0eDrO3wz+T
+yqjQDMxHQUtPyNZd+YqWyaF1xKX+s3RNpAXjigLkratUsjoNajWTZ41Y3nmVLzKnp42/olyGgxxm
+bxf32vZgABN/eAVAbWEfxvkytOZQhZYqJOHJr8yRUySPE8fO2bV+Z19tfHbXwGf3R/5px6S97cjL
+RWSE049bX5p8ieyzfQvdlK5aKovEWMJ44TzwLFgmYkpGmMEC0fRLi3e131Ts3qXX8lf/k+B9z762
+P6p3F5YvTrDcXQ+Hnumm9KXpdqmihzwbViqKC5vDlZPLVLcXbBEA6gsoLlxWy2CNzcrO6U2e8+W1
+4gvxJzdvoJuLVzBemzbuGru+0kcaBhQXNkNsiHAtnpGpU7vXnfImz/nymm4n65gNMrP1X3lIiCVF
+JQygQHNEXf+1p4YUUdOldacufpPDfKlS2yJzZvhal2mC0hW/6mfiu381hw0Haw5HJ4No1JVQebzH
+gGE207PuVIKwudOB4zrTgTKzRo2Ap96M11rOX1O/2+iPGv/of9y1xmr4/W8xv/9PMb/0R41/9D/u
+f4rxscOzRPDn3wd5jl7xXpoztFru2s31vbLEOJvnQ/wGOKvl9QsiULR/45XgmtMPhJ1vmJHiB0kh
+6lN3S31qy9XnuSAadSXdrImW+LTUGluea5tV6apRXKRVwZsSTGnlImnsdMfDzuyzkRbFhc1hX7wQ
+We80XuEXjTYVXB0ynl1RbqHU9f6lYjcei2RT9tS301of2orB/lXOtxjdnXhdpOrrIgzNwHwUdKvW
+l5cm4y34ulcJi70oBgWxsPiZ315t5rfXYTIqNANNrPlqv9+r7n+hkD3na2mW8E2LrCWOAYHCDy38
+sMIPrDdXe+MVpNFpnJE8awSqcQxX3Drz4ZVYudNV9zPrlBIYHTsXrYIotdwU51ebZ45gX123sRUb
+W6tZqzTrMtIWYtYSjPRqvX5cV4/+2hHZ4FAsz1dtZRFctDBpyPU6Ti31LCo3WqR9pugXDg3ztRRd
+8VhaX2pbuKxakXqiaoO7tP3s3cSypdUoprG7XHzXT6lRxVVY/US29bPxd0KVqHnuS7n5w86CL/aO
+8AwlBod9MQ5oU+JNuzmiFFXHTUnbTfUJf+mmxJt2c3QlDFoUF5r78L5oXWXn9F7Z1OYQLoVUioEy
+hC5wAjUWl65My4HaIvPU+sljUfWgYt/2gXIJInFbgKYluXk4QY+Ugxqe+AKa9TLQS1H8TOIE0ivR
+iRega0aMzWFfbGipLt2wFw2VViLZ8ySspG1RyJ6z5oemfV08adxuyor6IccyCTxS+d86nUGTXJMN
+VUca6Y7RcV+sGyVKLTfF+eHcNBWcMIHZ2213VT0RC8HYrATBkG+Kbobq+a76THTbTLv76iauXyJZ
+SnOKbr1ou9W5ra2vXWvZrQroXlVHjdo1V50L6DOr1R5Yre5p1Xp7z6v+ec+r3t7zqvf3vKp/yrUe
+ujxi36EFmrOue+ClLP1Zr1aPlngmTd1pl7G31+YQC25Ly5fTfSJdc3PqitaLalNceZphXd9NWuqb
+/qj55l7bXZNcEb7Cp+zgM+v8FdiNkT9+mrRVvbywSwu7rFiXFOtyQt6GunOHcpJBNLJIZMV6D2lT
+qQ3cNyqY/QLFdUvnkJOvvSVU11tCdb0lVNebQdW9GVT9+0D19j5Q9e8DodN32k6bibLiKC7shloM
+cy2el1NtDmFGbvkatMgZu6EVMbmT5+iVdlO617xyz2737Nx+yXP0SrsptzResXLg345UwkLkLylw
+otLHUmqvMW0GXYmUpM9UWU/tVVbG+oH1FQaKhlqTY62LKOfLazrAq76U2hwu86LToyXUVM1q03TL
+D1uVZtQhSRStlV6eA7O3u8qiRiM85Xc77ppGDjLY+GPq/aZfppldfkR68os6LVxWnNuBdjSLCq80
+9DASjI6di7aGKLXcFPiptdnG2MAl2A0tuzV8+RdAq0x9Bq9pYeQZ4WnLa8pdlSbT60EBoqhUu0ky
+gGkbKikuypcy6jrWWh8Qx+fS+k0dd81HLn6TQ1fm6u5OJW8wrm1RyI4H3RSXwBDwJJULLAqQikpr
+Q0aLBtRnvrZQbopG5aunxeiegzBEMGj0viLRVwe1+lvVrM3b8R1NRdspqnWnLl67sq+XrKq9gSLU
+Flm09YZun3JmpbZF5gyQYWK8aqlr2qPqdVN04jJetYUtbca8lDe0V6U+gkFZ1BXnQtyliljrVrOB
+mizHuq1MheHyvEo9F0umNYfyPMOT7hrcCkTtx0s+n1LHfdv9pmvb1yOHQsO47w7T5pTl3527VriP
+0BbBOK6DFsWFKz5tDkO+KflaKjpA/a3BIBuVRV1Rc/6taBr5zWgARUPNu61n7pS7KbU5XGYQFrcY
+opFFWgknvkElWIvDbvwmh/lyio1O54d982IpPoo39IwSyiF16i3UM76k5BPj7Sl2vqPxvj0a+HYL
+kvoKMYNYRJUtflOB0IoBOxh7V8iM6olKvu19u4kamZZj+1CZQnaoNeJfk5kgIkWrdYomo4Jmm+yL
+ysJjkRZzeycY6uJmYJkoYOe/kxW2iiqbZc+IcXRp3i9fTtEI5K2dSShkz/nyWl8KRcdt8S1X9a8q
+xSAqfxT9bJ67KVol90F0KG/ynC+vaR/3Ot0sUL5IZVRoBuajoNvEpCVmLNS+K46AivHsmwPU5Ntq
+8J0llBK/sRz2rYth37oU9i2tzK+Y1R+sPv3gmPrBWzsC+VIctMhbtUF++H0YWiw+CFHyb9ggpp8M
+S6f289N6+Yk+fq4efvr+rYr5qhXnklN+DkYHuTPK200s4brebTIW3zS4ZG75U/rgKd3vxMO+pyxS
+nuiIZ9PjH9QVh4EUil80ZuCz59l7CiqLgUax78xkpkYTXto/rsJVvGp7HP1x9DD/8y4hn8ABXUgE
+z19EclMJGlyPo9Px4tt9PCB6LfvYPm5KXxp7nVHCCiGfxjaMjrvx+n1wNuy1HS/Pabtpffcqz0eW
+qkq59kXcKVVZCUTJLk5jjhoXpeD43Jei3i0Ufv/JKZap+tjXV7x2ixxyY9cb+vira4rv2qJDmCFm
+2a/wLeG0pfmf63Z9pCK4U+a198nH6xrA8Wq8gamWtKlED2Dm3DgYI2yyKqDM9tqSXAcImY2PgH7w
+7zR13i7ZmEnX9pKQIKJKyik43jyjWqZ3KJApyC/DGd8i+rgpLgp5WlI5HEDZYqUXAjUzrQMfsgwa
+VSHCVk+5V3NTu+lyFhKlhHz1P1q8q/2mWrmq36PHXbtFujpBDfk/hnx50y/dtXhXb9n/rqp9BPkR
+DVGSDh1TWz9/wmps/PM/izXLeJWwp+2uuerBou5PKub8lQqtdpna2vJ5njzXLlP1P/ErZ7WYbuOn
+0/LldPKufdwUF6VJ9PcNncJnDKf3u/aPV/u4a97Xiqh8FcFc6MRSn1OttY4wXg7TdlO8VyKv+Q2a
+uo8z+yu0PWx/WtBb+/h/jf2/1pXn1W+ZXf22P8TQvfaf8tXWx/9j+k9SzVkjVlN2+jlDvilWj9XC
+vcrM2ynLS78i4rX/xltWZ3tSu5zmtmu8qIWDzpWfs/SbKdEfvY+/+t1Bi3R53+rpS6h72t0BOJqe
+Y6AdPuqwqFaPV8p0U25RmoyNOfNZezyOEeb/awZtT89QFvXHMajVGLZxZc+I6OFsi2C88jOfQ4ai
+Ued/pj2IlvGVdqbrwR+B4RVlwIy6Qk7lcZyP4/yY/3yv5MQ3z+cVM782zsB5MV0sRdOPAxou85g0
+q3J+JLZmUeaZsxhoDOZfizWCb2MoxYUrHpXNOBEqmYNWnflS+qVFcaF5/q5ssyZCZH+plItoYc2l
+84+FKVkSMrKMGdk8g21LJT3kU4nyEgr/vOq5fYXjLBfvCPkaBQuKAMluk4s7lpfIMVhKdVnmS0ku
+QRajuBnOfPGmjkg2xkwQEbJBIloT5JVilhaJHzxhOQ+CMz6TJH3KHZwJqA1IcnxyasZOk+kjBxEV
+YkZS4+NQZL5Aus+FxXrIxxNPaqM+5Gc8z8/PD/5xPEBn4i2UFyJYwMrN+ikfKWbJtf/8TAVCNgJE
+yk9I0SWcOU37k2YWT37U6XyiYZ7aME95yul8zv33TLIIAuhKwyBthhxbAn7DxjBfTkF1vUpmGMkx
+p5pq6p1X0ACc3Vf4SlMQB5HDrwrxEms7A8vO4UiN42Xjvr5mXA6ds8nbq+GRuMXdKUmWf0/e59IX
+pQei/+lnhc6cBt9+PHn35vzBnzEGcAKkzvCS/ZwzDQioF4SoKEU7ec78NTmRYqjnUAnDm5qBmDjc
+8XLvmQ8OAnsdUqtD0hzJJO/pfGg3A5HyUyScpOhDwm/+qAtATFxslxJ7kFp1XmkRGRU0BgXoE9VK
+Gl1lQ7pkgORSdVQRo3R+0/p0HjRaFBc2h33xQpfh7y3D31U1VmlRXNgWLlfJcw8cTPOeNoKIkDDD
+dacMIQ47flyPadmckdMfHEi07OspStq+KN+UDk0kcjn4l9NFdgOi6FkiZjh9y/aSEKessr0+wtOg
+K+UMqt8gipC1GMCdmko1QCaNQb8tuCQFwEseDVeC8WIxgoiUU13UF543zpfXcIjcdGQ/eA4M0GFO
+tcs4L1yZnXKAgOLCFa8byVyL55DvWr68rlnskI0MopE64ZRfYhoQDRsy8QKw4amv6wlegKFSasGn
+joKdprtMR5qyBhr5qD4L7UY68Sk67yk617Hv7iuhrI6fOzUUZ1l3AOTLUGvxb/iASFN+cSAlfqHG
+X9iCL2zxl23Cl4Sca5H68s09FhxXUFVW5G6rSDawK/Y54iwKCSWbWcIx/wMHxGHmcBBEhOwiJb3+
+FsB5pCxh5huak9hRBovjoAFB1BbBRTsC4wUQg0aguENiL9mjPEzwZcDMs4Uyq98+iM+Z7SOnMgtv
+G6+enW17BXweQXhBNGqLuqGR1KLF8ILgmHhyThJ8BF6IniQHgQA7krjATMiNJH/pPfpbHwBEca2k
+uzR9QE3oTYs6EA/5KTs7l/CUJFKLp+T9xOY+4YyquR++cEpfGrLEdxoFmoE6EgC+l+a+SyixsvNk
+bG0YWFtCuENAHRDS0pg6tsS38UXKRfvifJnWd4eWEKB15VG1cRZyOmw50amyC8hm5JqKSopGTcm5
+SToOD+mHB3I/ZELX5PNZIocA0h+4gmtHbUMlxUX5Mm6LuqJmIx8qntBJZVTgj/UZ5ssp2i+dSmbI
+1G+cL6/5pCtGTVaxjt4sZI5rNG28XTiscDphKb6yrbJV2CYc0Ho0o1Atssti/tn6KXe5lbriEEAU
+8jyR6am5nppA8z3TUMkxJz4RqmRGdpPu9KYLQusC1Fgp/U16+LyJb2YB1Eskf0JT5DTwPx8cclHK
+HcnOhv2Fy4z++khfZMCpXltowwCmV0htkRi5ejib6hICzqn9Jf2KZb6UsFc75l1dp139leCUlgte
+qFUkNT9Zysyyv3DVIiAuB6IOOfwmSCGtRpUSMU4WXPQ8kRW7Ret0HyvTOadJtdmIGnZsW0+myzZ2
+cb22MAzE4/qsdSyi6Lh5du5oB1HYPuQzNgKDjOA57N4hs8ZzJWfhI/B3xkV2ARmiR2hnOxlw+hl2
+3/8c3NijhJ0Fdzb5FB8Lzoh/EhBlL8VHSa6NcFYRUodLpWzJaGs1z7gvhYtuKULIDpyUFdDHpHJ9
+e0FIJpxc9sEYRBASRQ1Co9uyNHVBLYdasEnoV/g0/jmkdKnc0E375YAdf+sMfv+PrXNLdx3VtfD7
+7Ei1y9MoCRVsXICT5bT+fGgMCbL2eQj6JcTVN4xtkoQiUHJQaljJxaQZKJExIF2O9ed4rT/Hq/0c
+75+j//XuWy9lb1y83n3r/Pk5rkW2uFCuP8clKe9yPPigflZrV+F035akW/u6Y2r3uveJ3etffUql
+QgKhxz51YH/1pl5J0yXdPa6US6RYIc9u3n6Oa1/1Jagr7/dr6VW+cikPWQgZ1MrPcZVli8Hkkgal
+y1g8XpsPrArlfmG17qsg2E0sO0GbWBi2R8kxTAjzH4R0+qOd2vdO5Ba3v1H9WC6WCL9KxlNOQnDy
+yGiErVPytuxxGTQZ1zqYiHXKjdxYLC8M1a+SD1TCimuyPva4zrykWZvdahsKcvvDHlVQ1/JAbqU/
+A0EXnC81tYe9tkzERmiPSFveKeiO/egs2gt9G2AL/InBJGL43w+XfrL1WT6fn/9+/lt+/lv+iaGL
+3yUtj5//lhCWW/z5bxG17m2pP/8tbSkII0X9+e/357/15781/Pwn4ee/+PNf37F6EJb881/8Lz56
+ou3nv9x/9ee/o/z8V37+az//tevnv3Ppv0X/t/C/s1+sNZRAqYN84gXQAhTqA6AemiIcSf4olOUu
+O5YggKrLtg6szno5mZXocc3BTAe6fFYsypybOHhUdFgH6YHpmvueRSYcZpLse1zSzOmatcnt3GZm
+hNUgrnLUCcUVg351LUv61jwyyaBhjHmf+ZZTmPUvx/b41izyWIoeDl+aNTIeSe5x+VLqrK1fikW9
+PMnLey++vIfyvF3PQ8KgJQ2uhkkGhYFTfJtwmLHezZcWvtVvX+7rQ8/73/qUYHK+jDKrqm63G0IU
+qo9UVDJlfxzy37nct1jEQU2PfKdQNSaEyCdayRF7R8RxgxWPCdVMqFnk/wANRDmx4NPp/87lKQgD
+RKHgQa+0DapESojIVJHF26njqXVIa940hzQmA760aqqEQelyLoOGq5G5XSwzRY4qJ7a8mifDKTGh
+WhvbmtC6PSx2Nuyonvs9U8DeFohIsdIcb1FviUwZzZ3U4SthYJlw8nC8HNjSvUUvKblfnUusX+VV
+cQhOZdDwcxpFNR5QxDB4FNe+ihvbYW9fG8JV2zW7YbS0mdNnzvvzlfdHHIJTGTT8nLwpOBdlXIxK
+j9tlwjBxHczk5YkQMUWSSQlOZZC7WfKChyOOZUJ3/vLWwTWVAXmTmdM1a9avs862lGilRys7WhTz
+uLz0a1t2IwE1hEzbpPxyLGEsYdYsK6rVlCDrc2aPGMlHEbe4L+lLcfcp/2TtaLp6AIl/pfyluduA
+xlO2K3PUyzO21jcaIsVq0uxJHGjKFFQpPhTm9eH1uGLvrAvqiPOTzpBA0srm14gwxV2YMG45aJFN
+yu7nMyiXIY8uIjzKEncHNb3Qfy/bOC/vdhA6+8XufHH8iAD9cFWEMYhDN/3GhrArPUo4yJJl/5VL
+dz5ZKvZUhdpIdOOK7AOFikb/ykohxaGSnm57DmOOoLKsjzxIjevjXEzuBhqjx4aIvousoB0lwpOG
+yJ5YS9kTW6NUm+FTSBckveua4u1GfsSDlJadJdSW3ygduRYWXVxnGcXqUlhEsZ6TMjqOPZESQmTG
+05SflyTpASj90SoEr3mCp6aE4mDeYsB8+gNQVmIP2rd7071PeJoSnqXs9kQKYs+UzzooXYOtVUNj
+Svjw5AZbXYL+HcFAmBtCVIHnD7EzB78+BOTdwfrAFauJGS5XJAwsEw73uQQ7xFwbbvuCEeSkjUiS
+ivbpw7Cl6XbFoXaiFqewrBNVOVkNdtHJ2I+2NP6ikSqDQXGoRgZIHdeHUGiEnvv7U/7dpASnMqga
+WjZPHBiRB2+XurHi+ryhJ+L6jC7pm+Img8x4QXIrkSzWBI4zkhmFRdaYXlJmljBrXmuolrzJMeiQ
+MCkjRcNNY1yfjbVXP9RB6iomg8E+bDp/adwGpWuwtRuNkcaMmuwmh4XV6sg0aLbwfiNKcxfcLSg0
+h7g6MgNWZarG6H4xJ0xWAMzkEhY9OUZcr6OetiJPW9FOVxGnq5gO7BmpIaQX25J4eMdkbVGgSYX2
+DK+fkd29976UyQjPFSpcBOcYjifjbnY3IOMdp924Z3j3G8MxtdJ1n5IYSrq+VOTHkAfAXuHTEPZu
+tsqbUietWRFDZSEY/6hkAmxXuxGJfiOiZPVuPl3hzOkK1+ustMe3xkjLgSrnBkhh4BTfJhxmbl49
+CO34Ow7sBX4I4ug7ePIHqAlnGZxnY+HItEOICk+EyLbw1lgBx0N52pDF8J+JxSPuAOq6AQoy4I5b
+bD/FYDJWa1i1WwYdXcWG/01wQoy2QgelGH3F1hbuFSAaUVZjNzTrhWaF8wTo575x2psGh9HOfhwc
+xj8ZFfiTuQv9yWOeThWcRT4a4B6vy2KyEih72kHI5cM7vvj5dH0rujv2Fmc9kjPv9bLd22UcOFm7
+LcfG2GaxjbG6wXJ/f/O/M+shm3GGyXtYNpW4RGTOUWYdteRybhTqrIG66WSCrfWIg9a0i6z7etbZ
+4S448MjjhhsdmllpbK7cmNdDwxhwRJIQFXGBANCk7S3/LDuFbiJ0X4H21rfhIepP+SnLT1mW209Z
+fpfWw18xIYHQswVVlRHh3vvIiVG9EIMlTZguKPRrFCwkeiGRhSShUFXSEgf03ijLbwwIkTUf5nVC
+mqhOPa5XaV1z3k32+FU0WJcSHGBf81mqTAgz3O5ZigMiHtnclWDchAIq0jABwlb6DbgTjO/lMqmG
+xyJJpQvtmPWxLXvc8+02Ka/OcUkU/Ug0aoMqUXttjaiZnuO6pHUXigr3/U4BlTnXjWqjqD/Wpie6
+6ymNwnWRMmi2shBX6tAMh4tZtLrPx6IVelq8xua9yVkGqbGPqE3qq6MTu4NCz7nXLiwFYaVY8yET
+qllv9tSqnxuUJdyy7lcB2yNYhwe91KvcKVZxsBiTzaSlbWIyGIwopmIvKeV9kEe/LJNcHBC5srIr
+/oliYJi4DraSVtvbDIfPZ9RCVZkwTDylmHJl41brtHV9xAxiiqghbPYt6MTpmrTYqP2Oj9b+0tO3
+R21/qXXW6bsu5TfvM9Nrfcg2ClGtti+lXF+qpcvbdu4+56w2dhY/zBw4qnzPe+SjW+hl2QZVx+Mx
+oYRZKV/KnCSuX8pUqhpk9p2ZXrE3KC+1LmbgNs4xB5xtOtfc8vGt1FmzWqR+IUhWd51TIZW4TLjP
+zJx0Q0+4pC8lXUOtbeaRni6b5b+Jg/e+YnUucR3M9PpkFHSuKQY2/MAXLOQ+vQZ85H1GJi3CpROp
+1YgVnVSlT10XvC1MbXRkZxZVsdjrS/5SeQ2YTF47GqZD3E3yl1q+9VGZkVnew3KXocxoNWhS5Ks7
+uyWm7yrQZLtHbSXvrT/IhMqPPF0Zu0CTJNMB4uq8X7ix/qVP8dM27uq05VydUuvSSq79IU5fm/5t
+mCrjn5vOam1/qV/RU9LNG/7IxTuo5T9xndC3ULOBjK4RAsGimfaEpm3A5lDDmRYKoey5dJaEg1Rl
+99X8pMVH/Ck6LL1hAKQDndtNj+7bDeWrTJcRd9TbLQlFoKQZO92taYBIHK63xpHIjcfsrZlv1zrd
+Fw02fD03UOMXDX/hqJ+mFOzJd831rhW4Iwua7hKkGNRGim6Lw5guAhvYESZUchJwTctOoe77Uihy
+H5rd89koNFqDhro3U6MOX+9n1Tq/BZV+57JR9iz6NtAxuL41paJQVJWoWNSdID6fedfzZEwaoIdi
++kXa9HtWkwJal2IyOHgUgIlRcHrIEhzgwDokDihjSpiSJl6ECgtO6TFtrGnJS3CQCcuE1dkK6wwr
+wpaRnQ35Y8I4X+WyL4ZWfEdx1Dz0ZT9KNWj0/pvfJmFd89IcYAolHw4woSn7DSNgAGJuuWDXM4QZ
+e2bco0Wyqbt13Y7/ZXZyozli6fiy4JiJe23Ym0g0vuVA/u+lMTvtqENH8hiUcjwaq9CGwrjbVPQM
+x6YqzQ6JbdH63vtv/+l+/O+iJ/TnomeYp2gQNCwaaizyfOL087Szz3OcfAz/UT5LzyxJvHf/1Fuf
+UpRAWVVeCNeSKw0oJellqPfQtmgQTOwmq8Kz9EIRmZK2Zlv281/6FX21sofc0zbcW2+/OHi2Xxw9
+KiuBEjUBpMuRsTBkDfXTtXzWLyVdXyr7aJPlVPGMu0m1R0EIxV/BmBWLkmBAy0XBGsciH0it3r/S
+KOoP/2FKBXoffyJVlg2FZfSSDp62QwOcq1QGg0pg2mPZ14vQTCZaSjOJVMzFkvb9TnepTQ/PreRA
+EcSJziXrmWVDoGeRrQY9WWw4ArYqsD6W9ambukf2R34aSqAsJiuBMlJoefsaAwVmuIwvEjeq4T/K
+uVBgjyAlpMl6KOuQfQ9LooAUyogQdbSL4R5sQmIPaFfIOEr0+S2EvUbzpVVXZVAYWAYOV3ZA589X
+np/vPD8yKAwc8SMj9EDIm7cjb95rZE8IqSlEEKrpdhbko7nqnrljv9zvcos7Lk7OFQptpkJEhIy0
+br57N3Pn3u8x66lsv8cjQ7al0dLiU5ARZth2vT/anxqgXjiJ7rws4OvHLuNOIZR3k5VAWR+Uupm5
+Vz8Tc8f5SiXBckJHPa09OjGEPCuuxColOBUj5lA5m7RX7GE1b/TOmxQHc7FU3HraSgw1d1zOdo4w
+d44w92anViVE9TCf6acsR/8tGqw8vR6+MoIzm+c3jno/gZPMoaUeKBR304dUjOcOTi6qjE0mVD89
+8g+O6rrUrXbY0X9w5vXwk8CBod4RmYKlYvN2QfX+47cpdntyHMuBUeXBih+o22E3+of9QQkQiVCQ
+Nc+y9pxy4RSgYTUGFJ14wchq0jQSQb/ZwqHvrBGaAud3/uFRh1woMOt3+L8sgOF0FjFphnxOlK7B
+lvO5f7Q7+iscP2XRHIpgGl1lVQga2ic2EyOa100FWi4K9FzBfFmxm/6i/VvorBuM07TFrgYFO4Ne
+Z+uS7tL0AlKX9FjuOndRcX9d7b61cjZPj+mK61DlZYg3lPWBwjjA8uFV3c5a9VpXDw1+pXA0D1Zv
+7EAVO0c9okvkyb1EJbI/kIF+wdRBjXp6q2351ctnbcvNZpSccTpyVXPBYV55mPcCNaO2tKwPuDvj
+TrHxTrGtD2kmJRihlorqpSnxp1CF879NjuWSMgiOMFDREJ3cHvWJV4Im1vh5sNW+BlvNBlsKxcHj
+CBcFaz0E4jPC1R4aOYdZqZNiOVDL+7f25fpilvTZl+Qgg6rjGCN86bODzBxmpXwpX2lmxeu/29y4
+8+zWZo7rrE1uLc55t2vw56+WfP5uyUdmDrNSvpSvNLMytySNos3F8xx+jFKhb8Gp1JuqluLuUh00
+aJgVaG3ZKWBG5tylbZ9u3BEbj+hmO0vD5m5JfnmD7Cxh1ioVMxaTFuFyx72gs0U0XJsNabaKJa9Z
+8qrZsYCLRvMMPHU3nysuBSqTW3j6c+7jRR0fnNvSYpdcwcSoNsN0GVkmumJJWV64qXnxnqbLYrIS
+KNEGPcpecNar0EuSaeZhrXzZVfvlF20SYyF2hPTczXFHJwDS5cgWvGQ38ZvPsosp5Vyf5LXkWyM/
+snm0Ry6HKbrIUNGXZDVEnhpiH31xt3lxV32xftaKmFM0CUN9UDAdr24AcxEDy86WGgFzOGLYnfsU
+z3tJR8SN0fuXR91btJ5vLeSNjf5+4EGCSk0ciybSAt7swrftRd144RJ3/Z5FpebNM/llsxfXlvdg
+8sUr2bWde8gd9BykM1VX03V3yqJnHpxycELhmeSTC8JfDMFJlRhTMkwLmvDJhVO+JLOq+GiAcljQ
+JwoECvxsS1O/35/yG3/K+lPW5aes/e5+PdpPCT8lLD8l1J8iP0WW/vutufw6SHDUTMnVCP/CVGRZ
+V53BIuDpLhXksa78o2nlFPsRXOVbC99q/VJZvukf+dbCt/qV9mNpHwjtVRUqCVk9mMeDiR+Wil8u
+DZQwKd9elRyLDAoDR/xIF/kva11DMaMD8VCLgMRWvJc8EsfMV+eHdjmb9bU0mTBMPLmMzF/LVMBr
++crKM9IRtIzHzYJ/nKCkvwotL4x3h4Z2kZMY0HI0k16VrkgwYmU7IkPEBHoEiw9FzwNGEiae7O4O
+mxQK1keK2fUN94EwR2Ybmc4aictTB+bDS5MsXj+cYmUJ/561OUhwNMfOOGNOykgK2tCPW/QDz5jx
+sZl0gxW28Y25zpn9n73L8zFHIrE+X4GEAeHLduWARl9MeLvFsjn4dh1adVWC45yYDpy4d/Ss7osJ
+/BdQZ3bZ3Xrrfi9y5/Fwx36XNMC2S8w6xftuUoIRK9PRtoVxNYVQpz1ctSQD3Wo1n0bmwlG52Ihc
+bDQuPhIXG4WLj8CFo2+xkbdw1C0+4jbC6FZs7C3LOC5HjT9f9f+M+n9G/T9T/T9z/T/ma/X/WP0/
+Xv+PlWjn6pTyyg1DDBPXwV/+VgFTzK85jHzaSIoWoL/YW3ZspiP3Dnkqw8ITW0J3aCruoxsatrFZ
+trsiS67R7hQGjnimALv5I4PCwBFvyfAvHE5hYHWcfdlfxuqkZ7QDaQ806GCDDqY9DlmKgx3hrlTX
+JDjOSengXy5/aR5piVO0HNNl0nPLvDSDJAz+crEDdGh1qIZlVAYsYdZGhuO/V//Sv3KiUpZYlzTz
+FCEThoknFytWTdrr7NYi1NCf5X4uJQyCkalxri3bYrUmwom7r59fy5YrveDAGXejMNDzc21KMdAy
+tpO1guUjfmErfPRq+0h5W5Pe1qK6yh4cZCCzUHZPu/JVF9OZrPIjqIF21EM1JxaSdykO5malZj4S
+VoSsslnuwDDx5BK/lP0+lItYmoNnUtpw9R3XuLpiVAd4FjbqURy51Wob1RW68TKoYLmMC6HySDpf
+ClUjnX7CMPYIt1rmZxmRXsrJ9/SLLK1xUK8gYWB1ZDplq50r5hZ3B88l7iNp3KekcWcy2Y42SMJg
+T9kV9T71baNxkZ/12UFmDrMyezF/aJ+/Mv38nelHZg6zMnsx0/fylN1BguMc39P9Lhokbk9SGFgd
+kfR3KRo2oQiUcGSv/cq6as7yXB4qE0IcQb82twLK+6DqeM6YrknhIf4rehrWt+l7yNPHrz7Lkd+4
+Bwqz74wo7WFSglMZ5G6WsrSH2nJMFEiYo7UjR1Qm5ycFPfC3XyB6NArzaO7R4FG0QzKmBQnBqQyq
+jk6W17kHk+I0RWoCPM2GFCd6lcW8ZGkPGRQGlgnhm5ewLrXNLOVb+3K0wqhqXF8wHkKCQXGYbEys
+bCmHDZaYgslx4aCaxFjKIE9nWcUUzKYNO59CESgLJdxsk59PO839nniyRqgkpucN/+9Z6HtZBjBX
+RqNw9lFrS3IwEzO0gfHaf/9027qkNTY7b7vWqGC5vYnDrNRJYcam5f1bo2v8LZ4hOMzK7OUZQrMM
+EwU3UkfLI3mShIzYjL2N9GSLZ1I8y1awPHABWXXMvC5HbPN93Lehzhb5UsKX9uVoBUH9/J3153+y
+/siXEr60L8cp67EBTQlfWp21v9JNFfrainigreApGINnwUZh4Ii3JLVRDAOcmtztajmU8KV9OVp6
+qB/5UsKXNqezXsKOjyvaqu4ShMLUCskUEo9mki7RxgWKcI8v20eJZjabpX1JcaiDMAM1FI8ysBL1
+GZBtLV1hvMgYIK8Y3XTBcrA2EyjRmY/8FcvXrv5l+HKRLyV8aV+OVlGon7+z/vxP1h/5UsKX9uU4
+ZY00R1vWNGMlW5mH/Rn3rExOji8ZlK7B3lOHT4naFwsERJf5KbTpEiYefsiE+VbrzGr9WL0Lq2/V
+yn9TnbhOSrmG4vaXDErX4H0UbfvXYyl3cbA9WZXg5J6s3mMp3MlA7trsmkwFCWV9mqSn8D0lIL3K
++kDh0U84jxJrk31CCZPy7aXZxBBfmDgznMxtQphvWmI8TMB64P8bHS/HNoiuJa9LmjBdkzL5NJk5
+zMrsxSaZlvdvbbjaHJ9pKLaMS7lxmJU6KVZUmS/lsbE1zeYbDauxwZRkVI1LcA2kmSbWhntPtF0k
+Ws2sUnwljpAuR9uFnyj7yXt7hUKwPJTMW6V+l05p+3pnCU5lkCdhhkm/ZVfwmTdnRtT6dTb4Mswu
+EmZlirkmtHLjLiaDQXGoRgaW7KxishKsWqedJHIwESgrJDPJ932e+zY9yaxMMTxuXKmuDbuEgWXC
+yXfgVA/rVGN3+vxVwc9cwc9cwc9cwc9XBT8cRyoPn1HZz6jsZ6rsZ8rCKhsTBVPbfWIn27N5y7jm
+lGRtgyQMtlRQ8v6lTKmJeZ8u6JM6RcuEYeLJxQvN+3QRn9Th+5EJw8STy8iumHTXMkVakrM4uN85
+OZ703H7jbpuMPEV4tV0bkTIoDBzxVta22WWAGCbmbNKs1kmfeM5u5BB8H4U2KuxqnfVcrqFONQlS
+Bn8VZcn96eFQJHypI9l4uqhac5iaG9vc2DgRrx3gUYbfjubtWNY2SMLgLxdmecheZcIw8eQyksY0
+erUro1NNq64ON883JhnxI9fslcijDnlUIdfZN06FFjvWgRJmZY4ZGRSZNtdxNhkUBlZHS4kU+zp1
+QVcmCgPLwOoYR3cN9Sva9kFVrdzAizsoXYPtWq2aZw2WMGtzZqPQW7yfZT61myXJrI6sh76kvy1z
+nnNyCbMye3ml+KCb9FXe9Kib+sjPHsZQoc++xDouk0O1aLsWKEkY7Jnt07VAFUuabVxlLGHWRgbZ
+B15QiswcZmX28gz6snaDJAz+cqmOtU1IswlZba7IlfClfTl6AVTz/pdq3tFOjMDZ1fXZV8KkjFJi
+8Hbw4Qlp+N/3yd2fn1CztDnFMLWMqsW22M4mX0r40r4cR3FQ8/6X6t7lXNvM49CBLmHW4l+xUzmq
+5v0vdUl/G8r1l+WrKjbmHOo4RcCUy6xZ1JnYirasE0kY7HVtfvnY8fcfTb618K1++07ZbPMoYm9x
+P8dR2zXia0m2Ft6Xznr2Jg4KA6ujl/mSfRT4Et6YK9bZ3gaNHMu0+V7+bJGKF3aNNrzi2gZ5RnHq
+S1W84Ogd+8pPZJKfFExuTztWPu1Y88E7nXzwLifj4xfBAg4qLE0JFL6bFhvpFDuaix+PJYx0dp/X
+sbZBZoQ8uVFOmxDOp08E59OGNOdBYT6Huxys1nnQtXBkwKm9LufrlarJXSQ4ubv1ymucdGx6MOva
+ZUXWIsu+OjWHNGyV1MQhOHkkS1DM+4RL+lLSNalIHcehZBxmZfayYuJ8CA3ty/U1Z8nxBb4ohpTg
+5DbL/wxSV5kwTLx/xextUupgzyo2k5aLXUxB1XBKsXm205C4nBwRl6u2Jc1TrN+Wbyf51sK3+u1r
+VaD++Z8CPv9bwEe+tfCtfvuyAA7nm+0QDf+04VQNsTDGYLpHhFygnjzmRtr3xMiYNlUqg0a00WXS
+amoLUU9sldA1PTtlhIXCcuOdp+18LUM9NwqqKs7tl9schDLOQ2wIQwwT18GsLZW8fymzH44K3Anj
+z+MI3KuJ1bkNYp2KnU94v3z6NQTkxpcMStdguzSr5rON1Oj3sprZQ4rTh7ZKl5HW71r93oRYyWaT
+YFAc3McARXXPgOFAsLFA8IFA8Jeqw3h3OtgrzcHfaQ4cLQS80w8Jg+DFpfAbh0RMETzVItEYmcGp
+3niiGPjILKy6b/pyayRE5aWZhOEsfDRrqOYgOwXaGmzk0IkeBQK1w2o3XVJtq+m5OCBl31BB1rT4
+jglNBoWB1ZE1kDXzCDAME9fBX/5eUB5HQJAQV8sKGCaeXCyrME4pQ4GfbBTc7ToyO8FML8C8LUvZ
+YLlhrggQnDzS/Dt6FUyBV+JjP5KEwZY62QNBIrbFdniO2zHy2+yJVZAj5ctBgqPl25mXqKFU10i5
+xjZIwmDPZ8xpUEFS3g8BJDhaOrv9CdKkTEMB02XmMCtzKsvtJSkfgyRMXGauQ/lK613h2uSpeJOi
+9bvxwLEvdoSLIkq442syCViwB1Kc1PcRfyMvJuERU+oyLibgHBc7i0V7sUFpGDWriIiCOQGADJt5
+F58QcK6u5DLhMIOqDV0NJUwKTvmu7fdJm2Mu8JGWiSQM9qRdYcqa00smDBNPLp60lfiLe+ShhC+t
+ztpf6ax7hvrt/frKOJdvbfiubcKp/PWrQOveVwziEJw80tK8Iq/NwZ/e6BIFEvDxmoQdz1BVVoBe
+nUPWAFlmJs2J4wQlrXHe6YmjLp+/aUBwqkaW0/nbTC4jSeMjFWWm2YNJcfJcMBkd8p8eYikzSAlG
+9C1Yu6zDm8Jj3jslHKS6lOBUnDzWMhDkyGOyRDvySvQjr+ArPkF960OXAyHApGt/QKrhRE+fuPvo
+spi0eMrod4aqJHEyG+rgUy0kPM52pX0pcf1S69AcsW+fe1jwB/WuXI5tECtjf2g2cZiVOilW7/lz
+gEn7ckVluEiWBNu4b97BB3zcCukG/ceRIuEShAGCaS+tjPzqZITo5pD1kSkkGFQCksEeKHC/YcvX
+deABZm8DCUZRXViSNmLgifBkv5HCwBFvKU/vL7FvU0V0MCY3DZAcrz8K33IUvuMoN2gNGWjrMREj
+nIYR3WclaYCLiPgjRhkPGGU8XpTp4aLYo0V8L91FoYDVUqu2/S7l6SDBkV7K9Mz8xo7o1svBEnEW
+DBCccH4DVyNLczyWihte5zArdVI8DQZTAHP3wRSYI4ihVNeUdtyOqJRgxBw6WgbGngpwX+7iEJw8
+0nLiixkACY5zvBdlr27Ye2EqmcgfCijSi5f9Ttoj+scFlBh2if9tAVn9EWgDar9AYBDlLOFLq7PG
+rEy1ur+WdPJYIoaJ62Cmp5L3LwV+yIWJ6P9nnuOdtOqqDAoDR7xldCR8f0CSMPjLBSmxzA/BfLNN
+2YK75+1H18ERikBZIeF9s3tagDk1e7R+81vd21IfaCpJwmBzVoXeePEKYL7+0hVYPf3ccZvmVW4+
+o4KT182nQm9SxGUwYK7CHakDjnFSGDjiR6I9LE7nNqE7NwcMxp1xSXY1DY1pi/kXDMs7WX2KNY7f
+cHXyWqkBp3B7L90fP45nj+PB4/TU8YYntzc+tr3xQe3NnrveMIjpwrrVhjM3H83cOJi5xbRRmMfm
+HuihuHPehhQGjnhLs9PRvOxdCEOmkGIyipscLgINnjdOGwAJA6ujubIfrRetD70HUSXWhu8j3Pxl
+hBvfk7vZO3I3ez/u5u/G3fwNAhKeb93GqwQ3XlZVWhf6JfY2LrG3cYk1XNKk8K2g23T5Bb9kwnRN
+yj4n4ZycKlxBfVI2+VLrrB2Pb21kUwZ5CsIfCjF9DG6hJTEcDf3jjfkzteXPV1P+8JVB4MjRvX2B
+81mrkwpHTKqp5KbIY6typu2WTjvJdEKC8w8Fk53WxvMPk+f1rCYlOLltcpviJwf3SIFCDEYMvPm1
+5feH8ZM2vCQ4Wib2KeYtYxClMhhYjHtvFOMJFrQkhs3BK7LN1dhsgA/2awE1z7INq9d7W9qo+OZW
+KQ6WaHjhzgjUBpmjSXsl1jnMyuw1sh6vxE4aXf1JhfGwX4MsrzP5+bEsq0sJRnQsPmVtWJ1fMmG6
+TLF1+2etzmqJ65c6UtqmoWIHYBnDBaCnoC3uJq0Bfu4uHODcyrKJyWCAGJGPOLjJMpD6MGm7XWcJ
+TmVQdXSa8rEvr1Tx/icjAbeh90S8C+fdnaeIJjOHWZm9rArU8j5puXwp9UtDZTO7J38w+FKfUxIF
+yj3tzut22ieVoDLIEk6eMOFMcN7FpdkpY1aZ7CYL2AahXzHh04VtKpv6ufnMz40TP7ez/PK6DpIw
+8eRhyVSxrTY0eu6e1z7ltU95TcOEE5/uq0RsFYpAucenxRRK6pZHm86JZ/MGjzPyOZ2OMUt54/xk
+l8WkxVMie/3hwLrbcXX3w+rOo+quzbgvSSgCJbvpjpUCu4wLJTPgSiJ3e6P/7u/z3znexUordzzx
+6kIMLI8SOEK58zP+u33/CZDgaEnso9D70tpiEgbshSqDgcUwufCkDQhG6O++b+KwvfPzjLvsUpYJ
+m0wYJq6Drah9HKtDmf1e8qWkqZhcZmaiRgH1D8KDQo33RSigRlXjGsMAjYmbUOwmzb43hyVNOOK9
+VUMbkRIc2RHRDrJOkPtCIZQJqZgtB3f37+Upv/U6GWTmMCnbzFOKz19ZfmTmkQM2iZfGEIceQIKj
+Nde+Ru5kt6fgvU04XMqgychP+KB5dU1xxyiOWlm+vX7HHlSWICaDQSWwwpzyvvuU990nuu9jovs+
+XiE2HB4vmTBdk7J75o3idqZB6Ro8uZq0k6KxeTerp43u7piGvxd8oK/SYviJ/r3wcUKHw6S4xdz5
+Hta95DeFx7x3SnNoDwc18ZUVldN+O6l16DJhmHhyYcmq8F0JKpfj57uYz1/FfGTCMPHkMhWTPGOL
+b+IQnDxyJJ1qMdfATnMdcxlUB7LIE6aiq6nLzGFWZi8rnVrev7Xu+lh+EWLRwof+BeMdFJMVNZTw
+pdVZ+ysdipvVb+8XM4aVablKzsPXvukEj/qgoAcHHo/FRh0PWQpFoBwRfNTx4BI5Kpc0yI3iEJyK
+k7t5zo3C3NuIgbOeWB9RgyIUgRJxVs+9r2qoGWYMmFQGg0qg9wlxhbLYiQDKRGFgdYxTQmyl2H/6
+okrU/4dUW08bucwb3jqwVw78fYMOFs9nNKBqNPmxKLyfEJ9a5jP3EOe5uC13THORNLvt9yz27RuU
+iYaHjcgmrQ51IOuzHWmkIHtnmEHCrP2VEllyVhkwZWBzzMQy4UjoOXLqOdpXL9E/eon+zUscn7zE
++YuXiGBJQbtyX5fCd0adw6zUSWF2puX9W6NrLoe9aDRp4Vv99vWMTWfWQdAV3Lb7DRN5gMlm6cc0
+t3N1hZR874cyURg4nD3vNOp1y2WVQWGg7SRDq0MtE07mgVbWfVHxWEpAbg9MQ9n9hr69NI/lvg11
+tsiXEr60L8fvrD9/Z/35n6w/8qWEL+3L0bLO/uWzc5iVOinfaawu8OBaXQAJjpZmrNXlPBKSDtuX
+lCQM9lwO32v0ndtBU31cnRwlDPbMGpdCAfp+Upt1SG3eHYrfLmNXqs17qrbRT+P7lDh/nhLnr1Pi
+18cp8evblLiPNcOckajJ3Q9ocpiV2cuypsasm5QDY1bn/4mcepEWCbM2clbVPV9R3jNLmLUpVVeZ
+qmS+E+IcZmX28gxUG5V2Fc4v21ovvxIoWvLx3YjzSGhUm8OYzDA9iSuef7UPSqjY5Xdo4VutX+qo
+HHWvYP2K20bjxiK40EjxPs75poQv7cvRM6ea979U9a7ZzxC1nmLSDZXA3LSKzaYVSGFgdbQEY0rB
+eXJ6IZvHT5F/cbL4184UCsWhkpDvv34VImGQNpQ6NMeXkNQS7wgxvFUoDpONBSoz4d1tashxFZPB
+oBiYC9OA0jWYDjtFkDLI4izf3XNBs85wF5PBwGLoe76Eww/DMHEd/OXP3nWl+z0j/riLEJw8Elk8
+d91RnnvuIvXf8jukBCO4j38DJk7mqhARd1Qx6YZiUAmWeDq8x3iEl0bb51XyLj7xXSyVZqD0PNEr
+aXwslOZPhcZVioQJkaFY1EscPKHPPCRfL0QJc0LENmFcv5SpDrauC5i34VTok4tJxJ36/3IECQNH
+tHXCH4SYtQFUkvfPn6kn/jC7P3yZU8nbSv6HSjFpsZReuB08eMnWXrD1l2v5Ym2SpewmJRjRp6N7
+NYDd0HR0GQyKQzUysDx5XQAEp2o0+bGXiPBgPXZLu3uCvXHK2TBdk7KPOEhbpxzEba98GTUHZBXn
+RexNs5gkDlZW5MRWilZWZKZx5Lki5NIFpDCwOrKhWNo84d1iLpqcotwoEMlkeJFJZXBgwYJXuQHu
+bmCF3R/NJLO4P9oUCX9O8AJ4+BkPD6dq3Xbnwp2kNFm9E3ehCJQ0WyX2J4U5PD2mT0o8JpQwK3VS
+vpNwkDGrcP7vtL9bAF+kuKCSLM1L8lJGCeycat1Z24iBLxxevku9TARKuNkmSrJnkxqTl0BhWfBP
+fRWYCv/cK7puurvxVdSx/PpYfH1aen1aeH1edj3l9UlhaZ+eQJ+YpdNfejEc5jYIuwEC5LD1H2a8
+t+W+2wN14+p8DWJKPCnbFhsxkCQMHp4cSmz2XG3z52obn6tty1MoqFr8HijEYMTAdecjUqWmcCA8
+mMZek9zwHdiGV2S74DYy5NFh6sQXkTmWpzSCZY3Xarel6H/udyhxuc9YjS2TEt12mbTs+NQEIMFx
+jk9WXXuEsi34ukylBCeN07VktdaycJFoUhjIY5RadWSxEuycvNn3NIB0GTaP5d7cmUXwLeMNb1d3
+YfmmRkHP1EYMkmy/UhwkDBxWS9LZEtkdr7NGRLvfJYWBI57Z4RFJF+JQHCppONMiXys7ukG+lPCl
+faX0DOVrHUc3yJcSvrQ5o49ndG+WQ8QX26QwcJ/t3lnQ2szpGtpwsqKwW/qSR9OCRoovITEXH0dg
+rSOuZqRLGVmFWhvbrtn2as1q1Xxj/UFIjz9MwhHcxqd9m61TtWUN+pBjWl/NDUkmzTbApNahT54S
+Jp5cWAdVPn+X9vkq7fNd2uev0j6T51TaZyrNNnsOkkzSNditj+Jknc3IKdh0nmGYeHLxhGMqj0ou
+MyNJChRiYOnxxt2Wd2lzjw91ipYJw8STi2Xblc93dp+/svvIhGHiycWzq76I81DqpLWZp4gmM4dZ
+mb2+yhm1np9lu/qSbw2HVC7snlIFD5ANR/x4hKxq+ovTNWvDs/EDCcMw8eRibWCTm1XMF6TqWChN
+r+i4kzvI6XvIOXaR0/aRk91sC4lsYyGRzRYS2fLLj6vXkkwyinamfrE6HLd3oGSmqp1SFpWpRftz
+FVfqUOzKdvprMIZh4jr4y5/b/JxfgzHtJV8K+lXH+1vHfdFgrG/1tbgV5g3s6dCOdwR3viK48w3B
+3f5yZ1/8mmBYnZtSQ3ENPn/9Q+rflvpl+k4i4Vv99rX6NFQbaxGpVD9Ni8uyD0MV3FIqiTmBzEj5
+eeVzxn8mljArs5fl6NIaP9ZRw6hg1xYLwvsSBgSnasTc5E4v85Fikq6WxBPkZoM0Ku2Lw6zUSfnK
+wNowNLqeyOyN0At6L8kkHN8s6G3Z6tT8fivyrnzwvUf8n8zOEfm+o3G76Nbdo0Coj274HNUh+zo4
+hmHiOphl5+kTo6HAr2y+LxqHWZm9LDvVPrPfZ/b7mN/JY0chOHGQDaU4VSNL/rI2vryFL2/fa7Tu
+Ndr2mlr24tWXBON7p0CGtUpaUAetEt5hs/fXeGblR2v2eZqdZXGK3U+OzAESHOl12nB8703DxRFo
+tXalTtpLhoJ3XvY3VvfI63oelngo1TSclIluvRxQr87FZPEk9uWQsiY+ZKeA02FfX3VSD66LpVKC
+EZ1sraxcoN6XaX3YWa1DlwnDxGXm2X9iK7Yrn+9yPmPmeDZg8mS2jOw+MmGYuEw8uXvp5uwGOHH1
+bgB2b+cwK3VSLI84vZQxacN1ymJOow6vWPTcfvQf1pnpAoMZUhhYHZFTZ48fJrhFROA/1A7/b9Nj
+/LHpYf9qeiyxUIxbAGhJiJYYly+ApfXsyrbsRmIEr4PpDrGsDvGEh+U1SvQBhyEdmoiDmeJwbWMN
+UdesFKpIVRsF1FZ4STIME08ulheUvH8p8HsJRaCk2ZKicy9v6eURPAeTkEwn7w/8d2UXyFXsHe6D
+f1144CWvLqZNKD7jRzSr5dI4R6xYHCxHLwOVkfXBXVNQnWTVSZyuJ6VrcCVaXqwu3Xe7nTaszsPB
+8rNd2E7+hx9ThxdU9DOqvH8pjGoZ13hDN5c4McoaO5VMuw34/OJ0zRrvJKC/ZMLJz59sHY88PrUb
+ioQvlW10HSkLHssAgpNHMhVm1Q9Ocx6cVT9stvNI+I5Ype0siR8VK9heaVxNMfCM9t1S7SN3S7zP
+OyZUK8y2a/K/31Fk/kxwmc/lLjg2kvCzD5KEicvEk7dloYo30DV6NjEZDGy7dTbwzGyPSXFdTFoj
+47pUgyaDwsARbzmOVTO+1syYV8wA5zIhXSxr9gGXy7NbwsP/MBV/vel3h0fW4Ou15CPzgQdAwsDq
+aMkPm58xDBPXwV/+Vk7JC2SjsA7seDeS4JAuw+JQSVaCHuE4g/ItxiMzFIpAiYkJe5/R32ac3mU0
+lDAp316WdJxYoKCq9o+NJAkTT3bPsvpL+0OhX6OwPLzFvKwVeeA+lCQT0xXK8K7je103JPnS5ri8
+fynM5+CqcEeRyoMLtHhe1K5Ja56bq0v6H8P/JKlf+kv+Uv/yH6feyTTpc729i6qdE8pIW92zevo6
+UoyuqVPH2ETzMX9ncXx/Z3EUvD2jMhhYjJXAB76A4FSN3I+Pq0gy8ZcLE3Jj+WhRqQxyN0+s6GZC
+XZKDmcRkMLAYz+vDuIy//gT4PpPtf0CJZWB15AFsnK5Z8x7O/uehzubJv7AkhYHV0VP+jn02r553
+p1HpdewqeR07S16n3cVeDDzGa4HEMmEdPHA+XPNYPsqVKWYqbFR7ep/QtJd8Ken6UkcfcjEB0jaa
+7AsLOIdZKV/KlNnmVbS1CIDHQ8qXMkfFdVJY1XwzaSXnm+edb0xu7bTjh7OtCubiqV6+i2hUwzIc
+Ks0QF4d9EGJzDCbVcP7ifRDAsk+I15smrU7qxLYNhzYinepjkISJJw+28bQHpaQwsDrOvlZ+mO5+
+VMllQpjvtowIsA1Cp56pislgUAlWaLKTKsijXzLIs5t21bOsDw6WiWHiMvPsP7HVoNiQARQGVkfz
+nce7/mkwyGzehdOV7OQ2sA3gY5zTtgM3gv17gkoJTmVQNZxSoEjDkc5HMaZ5YkCUzSQNRUwGAw6l
+nd3TwIqIFd/kkKqjVS9Oo6yhlVmb8/L68m/KAGYSk8GgOLiPAbItekwWrObZBTdgR3pwXU9foH5a
+l35ehn4sND99efr17en316fz96clY3dQGQzol7lHdKDp3BHurncH/S22HlBdXCCi9XCldeUUgwKf
+vykbIOeKc1YXu/lbqZXnqdov12eSCcPEk4ulw2sNKsVpikQSf4ZvCHPBv3ICYDqFIlAWkxZPyTIw
+zVJl9JXNuFSfcamccamylPXhMBIUjlKIZcKRTgZ6to3CUrcRA++1UYwzCzQWvVpSu77XaT0h8uxh
++U/vi9fxhngV+kpwsBRY0rDa2oEAr0caNRnrHNVpLcHqCwhWSYlCioPFeEr2+PZrf0juPEU0Q3eQ
+4OR+lid2Nb8RqrwL4v2K3wp0wJiSlK7BvMCMe4VO28jBToHV5X+2sJBhmHhysewK52+qFH+i4lyp
+mIMEh3Q5eiXLSww8+5dt9mKvSHfEIy0SjPCiRxvbunnL+a/kgODk3WFKdc3JsngjZOq3m9FqfVhX
+H8shJoOBxTDFYyl4QkKSMPjLBemiG+NBYbUm0u+wIn2VQ3uHvT6ymCW/Kcxw3m5JBoWB1ZG+WOWh
+clXgLrm7gKpjnPgyag6Y7nBOw8WSUXq552IyOViyk6e8TsVsYtKizk3h1ijYBFvBtxNc7zvFkhzY
+LWBLcPcnpM6Wg7vbvq5o+3pXxKk4mGkUAEuK3s8pTr2bondvita/SiwUdbPaeL57ik9kt2O2uEaW
+tCMdjrpYbfrbsDqbA7PmuKH60KATb/aIlrNVovJWjjTi+dFDZ7vCx4pRO8BMkKiJKZ69vR3GFVW7
+mCyBEqn4DK7ueFpa8bpVF1IcKkgo6CE0M4ecMCICpMvRNn1XuM8QaX5NV03VrKSXy2CwN6PiYNlY
+hewkmu3WhlQNmwOryfcDAMHJErSRobdg590UCa7ll4KZlF9LWX7psWLPzOXwrIr9QUDF7Gq1idTq
+U6WV06OVy2xUX7W7jlW7DdkoruRU81lWcQhOvHgap2vWfKN1Pf2tfMU6WS3eCFnU281oLS4Wejo/
++t85YzrcuDpfg5gDv9FVOX8GMhmuoSaZ2O1eGI/aw7/YU2SfEEeyqUfGN8HAl0w4UtA3JZNWsp9E
+Dxs9HfH+iAB/uOpcXcllwtl8kcVkMDAvKzEWX39uKHRqYhKGJLtf40y5JqXNzHZj6XuVMmhvE1bn
+MsiNVtHMfffI+CYH4Kb4tfXzPDdONcnMvu2yz5ODXzLhl31OYk8LqMCtLC7Z1rL4nlrwCAwXk7bc
+biYlGNG1I1yX0kyalx/+HenVxGQwsDHR/GW4fxc+vgqvbTn5vqlhdW5yli8FUSxE9lwjLcXeYHYO
+szJ7Wbk8rLrk3QSwNufLyDreK80/wQFIGFgdZ1dOes8fs3/9y6JffMfxOs2ejgvv+H6tzl+v1YZv
+bFSyNv6VjSK9pjc2VPO6TN80U3nJzOmaNXMzFyuySHFwHwOrDRd/BEiYMF1DKRNW5zmPYW2DaJT9
+3h6yfykSvlTPijpT4vO56Y85DK1y/h8d5LzPPHKxDiTPyacNa3/yUdvon7jfZ2Z0zpsDTefaziIz
+h1mZvTxD0yySdqgnv3MBme0yaZlcSUwGg0qgz/k7Pp4YSjWtOQwTuxvs2dS1xF+ZOcxKnRRLkxrF
+sk+UrsGVyLxSG0kRtQlFoKTZ/PxflQwRf9iDdKJbLwfLoXBFdlIYWB3d9y4mg8G+yoTVuRmZyTPh
+3/+QbCwCTcLgrwTWyjL9IRA14ksuB8vmJaOdL8FV56yrrb3lHGZl9rLU1PL+rX25vuYsc/lS6Hhw
+/gYkE3tBh83oKNqee+3ro8x/h/+XpX6Z5FsL3+q3Lws2/fM/BXz+t4CPfGvhW/329QLaQ6rXjUr4
+0uqsfaf7yJcSvrQ5HctrS0wUEgyKQyUNZ1p2Cku0W6LdE+2eaIflKRQ7Jc3ml/iEihQGVsfZFxvA
+eXJ6jWww0mpYkK0LnGRI1dAzK2HKjPP9CsXB4qwumP5vy4lvrDHSsGGGjTF8gIEmCp6Bq5RqZB4p
+UQwDfGzBlzaWe1F8yaB0DeZ1i9+5NBuLNR8ZNV5L2gPfX6tkVEfEZWvag49MADLQkthjkxbtMw6l
+5oDaRfz3o0q6c2VGkgy8HJtMGCZOs8/Iz9oYp/WehuJ+nutImmUPuT0kYaZNbToB1iK8WTxT7AtF
+oqQ5tkjKjDnFJCIKk7DBhfkWMd38bHzepmdvje/rNntdt/F2rdndGoDd09hSTuAqVALdMXPT8AfP
+LaPWmdM1HcwvY4fEXEOzuYbmcw1KeR9k7pDn+jApwakMcjdxYsYF12GVwaAShk8zyQtn88XXleIw
+TmlY42kdduVcBrmREHeTlrmf+GzQ28qyV9t7jcOszF6eGFrevzV3HeO1WZ2i24SzeSp7jNyaHdjj
+uJ4Oaz+qC55jNf+n6Tb+X1qRXlhdBSBh4Ij2NPtqr2gOBW489gr/FnH+R7+vv/Ob/8uv8Vt3W3a3
+y2lqkGoS5zTI/T3Ws8JMIWn4OVlrbFUlkoTBXy5ImBHazprHHpqn/TJPO2b2fwVwTpdrzHWVsWdk
+5se/kW0liz+0GQoT8r9VSGHgiPdK3WJz8Gjft/Jt2rMy/ziE5C74lxAo97KMi/Ck1qHLhGHiycVL
+tCWTDSXMyhzznWSqgEzHU54WUZ406/rS7+LbzExmgpOOhhImxSsALe/f2pyFV+BrpnDSZ2eyfYRi
+SJ+T3YkMOPpvlVeyapewcxeKQEkzE5xlp7CDiljJTFd2EQePKw5mGtmqxep1HUIRKBHNx3Tnr+7c
+5z7/z/ikVVMlOJntMsmsdk/t6ZqYDAYWw0THIyc+wnGWMGt/OWr6gyu8AGSgOdviLufBwTNATfYO
+Ilbp5cei3Lz2gaj99cDJJfvP5jNtQLi9+m/RYF3tM0fnMCt1UpjYNO2215JisAyAYeI62FJDscR5
+WpQb6mdSz/9hZniKyWBgMVbOdlDQZTtGjPri7ZDXeDvkZW+HKBQH82Z6ULoGmwMk/94RABMK8qv7
+a1pITRn3LC+x4q10L9zLHsUld3bv4T78pwS8QnZicVD3u5gMBph7NUzXpPAWQ9ViYBYrd9fzyksw
+/lVZHKoRoPxS2N+yOKdraE1mDrNSJ8UqQC3v39qX62vOMpcvxUpmAnpaubzVeY3/rHuN/6x7Tf9Z
+p+zxwaObQ1zSzNZkGa23TKOYNMN09oMqYaA7XQ5eJwc6VatEtb24VDEZDKxClduSq1x3GI9SoCUZ
+6FYv1Ktc7XUmspflrzNRYTnsNt/+zWrSRpFjF2hIhUT2Ig+JUZaTDV64EGwXdlaI/PDc14VVKA6V
+NDKAhal5mEYfhRmaVzFpBkrLr3pFqolAWUxWAqUnZUcr4MYajJeSneuktJlHhJFFN6tEbKM0+rd5
+fYZZnaJlwjBxmXn2n9gKbPOqRbM6fD8yYZh4crHsXtzML/ufEsM6uE3oZoJQBMpCyeivo3VSR7SE
+gW69HLyehISssn2V9hrLu72m5d1e+SkUgbKYtHhKZpxTo2AKm38n4YjOmIt/jTc0DG2pvaFfk8Zt
+P7TwrdYv1Qs+x1uNk/bl/JFvLXyr377fGTPu5V2ZXi6DQXEwd8vz5bm97HqrAa5+vND5C++v8cL7
+i9kzgzemLd82Efn2WUdSusgVcqMw7829N3jUBwU9+K3A2//C4i34xFllJVhUQBgcPAKe8f4wSZd4
+93zj/QEvnmXffpZ9483gLtjhb38z+M0Xg9+4N33zxvTNu9K33ZK+8Zb2297Rfvsb2qRKpHxSWIGZ
+K+118HTPke4JiTdn3vi6pIu9y7IcFBheKjGXgiUA3yXaVFRHl8XBvMTAMoj2DVbHxhKzZtFvq/E/
+6Nfz3+UVe6uufUGIFXeuXVKCBbN7KnuST96FIlDSrCXffspt7b/6U27hp9zOn3L//SmPn/JYwrIt
+e3uctStHzSGug5bkLMOhyoRh4slFC6bykQnDxMP/M/lfP+UhiwbdQXpqiZty3O/LCXjkFFrHTRCq
+A74x7vLcutT/F0U7xP951LEaa5m5tqU5aFw9TSD7lgtFXB2WNGG6XInLPrNlAKkFoY7ntrS4Dlom
+ZH7QnhNXx7pN6OYcg+PUB67X9pdaZ50FRSkG5t9j4rLvubct7oIwLXsYpKniLrXlXSasyphLf8Q9
+I1yl5Doh3UYtnRlxpAWLSA9F5qgLTBPnTRzh+smbmKShHg8p3djrlcOioSCsFHFx2AchFntcDjnI
+HkrevxS45ONxNZlQzduvNiZvv9hogCU50ut0iXQ76rgHSs1YY9A7XejY/XH+LuXXZPfQql7aBZde
+Y7ooFBXSRJMyCEYcrZcq7bFRxNVhSROmi0r9KfEHs8GYCo6//bekQIHxBfDqoC8ddLG3kg9goVBP
+vHsQf3/zTsEU2uXxd13u0qXsSxf5lvpFUHE/1yS9zvE317wtyUgT9NT9SMRXfxHfysR1KSF3uS6l
+aZGraBA0LAjVU1uyPjTQqz9kNqgOyOchKcoJogFtWe2dmYgnKxHT7yoa5KXiFiUhQdRKPXBUdoDD
+lvfgwJW04vrAjX9cH43Zt0e+sQ5vQR1YgacGbM5TKPbfZX0i7VNanAgVxDyqygsSAtV8Wt74sDOu
+z7KwIKSqj+XtAJOm167M60OagwRH5qpcnWYPd2ltMdk9uwfHLzEEvDUcMe8eOVKKITBabwNVVkiL
+1xfDYlAvsdxkOVUUhHi7LOJRtAr9tAGv/ffwgS/0DYeVpejOjb9Q6ML2gHCXIycZVIEQ2EDhzs3D
+/1uIoZ/1Y+CDVEJwKk7ViOk65rNOmK5J4e5lHddZbrLXtjxS57uGGuDvwqJUZg3oKW79JxoUDbXq
+NzXfcIzjdcrIdykjPuZQESgrpMWXhenVDm86p14VghAKBb0hmJdWBvsWv6PAVxSx033RoOU9dtCm
+4t87Ip4yqVBvbgqVMGj4aAi5G3Hpd5U7JTYAKV2D2f13Ht0qzVCZr84KEiytzwj6+vIK9WGwOZiT
+ZYdLdSfLbMsWN/LMENTwxotRGDjivRK4mzJSBz1o7zHwFht4EdqwNdoSpVVnWwoOGJIa868UVDMn
+aaSCkP2tkC5Hyy+jj/NZKFTtgZaC3QzFJD0bck9KT41OGvTY3svb73J2f70y6eUaf+8acSGOG88j
+G+PMrLZTg7Y8l59i/+2mt2n8ehO3ZXGXx1LaD08s+30pOW+a5P6L03GHMkCCo6X5xUrmnXSqQWEX
+BbpLoYDfPTaTMFhW3P/3e5IlSJnQzM0kDfoljwKzsIXQHeGozWfm+I6LUAaNWFCzVnAUG/f7O5fN
+QU3aNWh/FYQBokAgCtXrtcgl6Ekm94jcNNAkGfXOrHPm4Zd5+xhzy6fLdBlx33P+R5VuOvpvKTpo
+JagZX0RDqkE00CwPPbXwqzyVFS4cjOCzvHhoVQ+cSg7sy0e+3SjURQc4ugCOyWBQCczzQAwzxXpz
+9hEfJOp2JKEIlJWyQUbmkHiR67TfHSwXFZq0Lm8KqE2Hm0fDHqKyR9S1B6LBrmFBqJFw8TnzyAlz
+3g6rUI+nBlr3+kRV65M1rc+o1apP/qm/kiZ/JjMgVLecTj0z1Vzw/89Q2pDqrH8tECs+3ILsdh35
+tCXpmLhx5NgQaDx2xaafG6pA60lhYHVEbcGbk96TDMZtAfSPDAoDPcfPyNETaeRHA3Rg+7AD26f7
+vPpv0SAh1KxffNzXwS3DxBuQThckVA1FA02j2x7D1ZeUBeJ32Z+DKlGCg5ny0gbReCt5pzXuiwGK
+LAkr/0TMUKoIS2IkT2IkxuPm5GX/gIfZ6qjPTHrISjW2oFm+PA4B2tcvnGBfkc3sEgZ42n8DxNeJ
+g09l93jjknMt4aEiIexRn65sP2WLP2X/Kb29+1Llp+yf5dbDUpcfLi9kywmprD9jTSB95p6X8Csu
+KwB/fGIkYbAlVAXe/H9cIxijUEB96hWZABMOxbwE/cuQvIRy7ruUCRHNUL82JtCkm41AU5PiANN7
+uUzSoDOeBDe1h7ptGqDBG/LamNPGpm+q7RroA4Ss14K8qHdB2iLUkLYwrb2CCELUE6FmBO/aECIr
+a5M3ied6Ak1d/PYfAk3a7/ohCgU2B+/9VV4qdWv1qQCKOyW81Ec9kK0UvePu0CgWykxZTdIRumtH
+lFI0x34Hf2quKDzu0hpiUIu4P3KmQ6XIdH3pIZx/YztrRfqEonI/PTpIcGSuyvBsCONqEudIMF38
+oR0UcQhO7mkl4G8NCMHJ/D7upxYEKEJrdFYTUgzchAqelTcDGZvo6qnXNffwsSDcfvWOOa8PnO/6
+fTvCZCpL0CN+fS6/+Afojjh21ucvJvvtVLA+5caXK/L6dIGda8XDM5VILY3CEjeRMojO3Kk7jZya
+ZwHDDVsVQJPeUBGY7qajsrw+H8t26Mm1n7lYqGWOi10HdqKd3FQ+9FSwPjED1EFQlU1S3gch4a5d
++YRSE05MJBhfkf39fAvbwBORzsXkNet262WFIPuvFG26ZoRPPlVUlRkhFEwq5XCXQqF2PRzCrrUO
+Od00jfmWeKd+P+UD+o+kqVnJXrr85rPsoHN9muxu0rtL9naX3UHtPbj1DOwv7/JdUswqC0LdGexl
+0A573nC8sQV33W/upyDkTtHpgoSKza8yXUbcog+cwmP/JQ20VN4q5qi7EFccyL5MEWnYirsxnZ2K
+/116+BQ9/J7nT8lp0fmZzN2mi95q3fQ4NekLoDndNBCE6po0WBAidcKNI0FTJzpLofhdmFLKb1qC
+zFwnBVVPmH6BfPKloKyfjupmSimyRM5OgswNmC5TkFVkSnPCRakD5JYPkzBkhC8pDojQMAd00dE3
+bHoti2bby+yH5LZoEHcxWRV2hKuYDAbFoRoZoMrbsguG9450iIvJ3cGiVkr39WvEUDxKHIKTR3ot
+Ym0OFvuZc/185foRh+DkkZ5rpnhB0kEPm23ZmzVkXPmgeKXBYVbqpHg5qm0zz25t5iniIzOHWZm9
+5lJo16pqaj0hbjghblKgqcIrwaZ3BHnbJHWBs+J2aIAyD+wmB3eSg+XpDot2VCQ609nVvf8WDVJQ
+oZagpiCJQl31FLAHrd5+z22JHfYoKnque21LaB14LtULAc7F+fZjCzx2USgQhWr2F8grDPhzSQJM
+CPV2HFIN8TdD7vI0qQbVcOxnv6pnXs2zPnJQATMrgKSbBkiKU2/m6DlvHNYBYNJJU0gYItPw0tw3
+G9vFwXfetqWJA6IQau2wYXOVlyTNoTJEnXimz3b+tmmTjHWEs44FM539/4FzZjJLZYnaMzaTiLDN
+MH3hrkozCQNDNrcD6tn4NbwR/bRNDfNlhB5ziAaY0s7Yjw/9G8dC4t8Q54OWgmQMMSueD74Dkg/t
+vAO1OlgnHghHj/rvlFsubVDPCBdK3L+U/3SWA1Ij6/rAPajuPXpPWpdVIHFBrEuKQrkHB7romLYu
+ePCW67LvCyXScP/skCG1oDULxLbpoarnsirLvpukoVGeKn51wFvlV0p7GNF0BpOVUB8OqISsBefX
+KhxtVz7Y75AWSkRsve5GKKO3UoyaAuqra0Uh4/13OTeSlDtIzzBV9vbQ/q+yf/pbRopI1tpCKSaD
+ATxegtvPKu8FO3yVN05EAEbavXKNaznxikauaAl2Hdudq07DZdyYce0olXpY2CJSGStG5copgspN
+rrbeNHZkr2lUYLhD0r2xm1lzG8qNQZsd87XphbC2ko/80ufLXTk3k+rE8a8eer0t2nuNE8CEShKC
++i5Q+KAp47NxFYGSDqiVfSKexzLgeV4AnIqleYmDxeZishog5uVxL49df/C2VG73U5v10EAKbnfa
+45lV1PUR9bLWHrWJbsCGB4eQmlmsVbALG6o538vyOovMjIioG5C1Yo1yObVrc4sc1BpqtAbsuyY7
+hRSD2kjojiY7dy9Mu6oIaBu3f7Oeb576LZFFE9UPt7xdLCbNzudh5IuUDFiFE/+Ulk/sCifnJnSc
+iRHmeReEAQK2B8LlLg6MWDE1dOKdQpW7yWFB+xSZTgqlJbcpO8MwcR3sOQ2orJ029rw/dlmfgyQM
+9jRdqYaVZOKRkYTeiSeo0wbf2Aq983/z2SasYAkmLW/jf6gkk8s1yDyTQTFphsrCHrKEQYz1/tvH
+nKetG6aAbuI+Me0Su+VwHiZp4OWdpEZcVM+KiqNAbquKClfWlzWwDVZ5QcMc0lmbdx4R3o15NUsG
+uzpiK9CDK/F2KBTwRLENY06V6TKyJjcORE8rxmYtO+AmgxQGjviRCDcXoDbIXT8yKAwc8ZaVWvot
+pp5O9YEE70V5I6pz/VwTXaed33raf+Oc/8bjB0g1BFz43pzuVtkA2h/vYFe9d4B+oTEAjRANgoYJ
+oWmsBy5Jb37fpOAxyELHRm/UBS1504OjkDdmsd6WkPP8b5vM15n1Pxwd/fnNdjr683sWQk//R8/6
+fzrqTy9bF59WAZpDBSV6UuUZtJNG6H37B4Oj46cc959yxJ9ybD/lyD+lHyXlpzepLj+lrj+l3mo3
+xJ/SC63H2u09/tV/x09pP6XJT2m3/tt+Sks/5fwp54KXLs/lqY9XzuWQh9r1z1N+yvnbf0u8+kY+
+f5cWITJF7VJnwk/MrKuIE9VmWGVQGFgddTuAPzIoDHTfz/DdlmgeOP86ymyX8qXUSRt58ex88qHA
+yYcCJx8DQCJCz2qQEpw8zv3rAxkkbVHQlQoh1VetGA7jc9UeIoYhlsk4f+Oad5MVoNeD8zfiZdHz
+l/0ekdT6PmqfRCTeOYQ5WaEEXz3Rnb8lrhRq1aBXf338Lg+VgjBAVBVoq3Y9O936dH3qXBRmSHu4
+rE8HmE4TyAwjQMhuCEEDKRS4qVSEjIxia4OdXU6+M3eGfpbR75rPoGfkM4i5IC1TRnx5RdAhLFl9
+0ZKgs5VnwIzcibwvfYPo7EVo8/Fd5GnfRJ7je0gd6pz9fYYTL5idt5sOoyHTZQQ39CLePTv57plK
+RCePRzq16ssc503DHnvXLYg3xFRIMaiNlMyFtcSLZOf9HnUC6byXpVFodA8e3UN3pcivvE//4Neo
+KmrJqCrvc06ukAuppUc4f37K+ZRLL+n65uCZ5FfnYQhVKWi4cZEgvE94YrCKVwr5OqGKrvbjg8Oo
+0+Ywz+13Qaj5bb8L0my/iyZBozasKK6SZuxzCrWR9rsDvM7KP407daLt1Df/eqgmzMWdW8SSsYRK
+auIQnDySZY3e3qbuBr9kQu3fbdMxNGQwKAaVwLw3xrB9G1u8RTpawzfNOheEyJdLBHbY8s4yjCsU
+iLNQMOHpKc856fmV9oQ8NJBUW0ytPnVH3A5ssYNb7OAWO2yLHb7FDttiB3rngONpAgkR3PQ12LOX
+sHPwSKhKm+5V+8IBuhEi9bEhpBrWXDQjfeP33HlB2O2CoFfjXUvX+mFv0gn2c9e5MxVqtMNu3yVR
+wC6FAmqkzrbzHxtOnW3t4SZ6dtz1PSsVmk4DTdCYQ7Mcmr48iL82P/em+bCh1s5L98xj2a8+uj8P
+EYRVRenZHHF5UKhV/Q6drDwPLIkIGQwqAQ0oS0Kot50ddFvqOAMbUK/R9RGimnDZwv8DqoALPSPN
+bKNdwuuj79gKOtujpMU8NdAdT1+hOisCSRSUjQKxcaHYTcKug9Gz1n66RQXaL7oCtyYnXnk6x9tO
+wCaDwsDqyGY0/6x0sDnxFp5sWdOCbrFNX5tdX6vnnIQiUBaTlUDpCXiSxHO2szb+e5ORhMGW5uDs
+/VkbNkcrzBZhr3WvYFt+l/viUJV6KY1nEN2E7aGzn2ezb7s6FZ6UDUfEPQm8dUdqD45CAOlyZNe0
+uGOu8Wy41zh75+jpV2uLSZwT0zJna9Gl9jFnZc6mjTrDT3n9lFf9Ke9F519U7CbNTilVfsr7+il/
+fsr1U67ueC37EstPuQJmSa8gGnRTJ07QXlJ/ypX4ndV1Pq/zp/7U5acuy28PigZxl71DWx+xy5eE
+0n1+lxBTUvpd2sNkJfTddkI1S0GoSnxquCNcIOQHH7ZrqK83KGmC3BAud3EITtWod6ainMWBsSpQ
++7JAEYQBgraWM1pUULO1/5Z/l7eotj6WEoNMWJ33QXDIPZQSctMOWR+yUVTIRgFVw6cGa0ItdJSt
+oqjow01KeKPJKmHQUNu5FpRaFh3uOs3WPBAJUVzRgSJA9/SO8RZXGRQGjvi4pJnTNTRUtMQkdxlU
+HXUINSuWuLZlH1QNozjCMf/mii7Xdx2WdUK45rrsa5vQ23bqhuncmxWW7hTCsqmQnUICAY3pVCEL
+REW6JBS/2J6GEialOt8HmdEci0m0VtHhItBglTpX0fIRaOTjRKhKFIT/qKgbBeLQArx1a8Dt2BV1
+gsa+U4lS8rbUvD6Y5dC+I9u3FtdvXZ17s6X/unZbSqSQQEBbO8HjFTVGNLifSwmDZGKmg6JJ5Sly
+mFlbdhM27abdf0MlsG1vwv3uJg2+fTBUl9st6QyUk/r0d3NNdkMv5L5osHJ3J6VrMEvvWoN511CN
+osGvDnicBFHIxGp/1+rcGae1v+OsqTd7lLSjA+53zSHuy1tlw7kCkC7DEs86M4rIGihqcC4lm+yG
+x1IWit6eh/SsH/EXYfeIv0vsotcx3rXrYtLgN2OLkqTMXIeCZlBxe3Mw00hmJ9qIYy4mXnkAAifL
+lifZmHKhSBcB9iMtuwyCUUM9AeNvV1Xq251OdEnYLqDaDJ9ixE0bdS0glaoeWmNtx1O7UFPgmvHU
+WjwfC8KkV6nnYyn51UG9u0fv6LQsG4UEAtreSb14iU6+A6dpB05fO3CyHTgtyCxo2WkJGQJOGz78
+HkhzpIDKY1+BlotiW3YjAcHho6MYVFdsBUgwjdpYKXuGzHlz0CyE4ZqkPCek+R5LGkRjwtvfxiix
+so5S7e0EKELrIflgneqB94wnZm5evzpV8J0963dmfjq4rUuK65X09Mq/PyJchGYyuUUzxbinC6pC
+QRUnlqQf5JuNXRvr71lIDxWvhYLm19LEIThVI+5ukf+GVpekHY/OSdFEoNR0Kb8ppBjURmJFUn7b
+bplORl4Ilx0txFLvdUkbCt4yFs4YKGTNRneavAkEklgYnwsAnjRTrQtCKP1rw7okrVHzRrff/Mck
+GtnWXoMyIczoioaWNzErmtgi7dEN229e1wW8i8nqYHQ3SQO6Ea/6AFBv9GLzlKz/IValQ1pBOXCo
+D56ZDGHG2xdKuaCumnOfo+aZhphmu5XbVSZ4RgpENO565+hdosXrE5D9S5mimFgoAmWhpCc77GXn
+ltcScnFY9gmrs0x2mSPiFBE94i4mRym4oisUA3P3OpVq+VkPgDBSc6V9KXVohhkb8sUQJbNcbscX
+S+9DfgArkREyWkcE6S04YV2nFv3pj9t+ql6DtkXHB7rGzKJQEFKJJnFzQKrEE1mU57IHENL9MtSq
+cxZYQROKBtyjNkHYN/8WP7glAGjEtmw4JvoUqQr1zAvCnUJ9c10oqIpmll9LMYmICxU7kPxgclwa
+tiMxFrcFKi2eku05bKSyHbjX3Q5cUrYhou7RWz2R5ER3n5KqSbieZYkme1Q37v/09uwIyHuAbJnd
+b6iJfnPRhOtDPpBZRRuLoH2r1XUJA8tAd7gc0PrOW96HR1fy+ZeWrm+dW50Wi/Rq5d2rkfdRzqhn
+G+U1pj5trAI262ZSozTXsCQKbGIllhIwgdLlG2PGPeCWUeVdwsAyYXW2jHh72WF/OpjJ0lrCtNQ2
+SMLEZebJ3QvqCu35j0nLghc0JU+BA38P61JbkgnhyrQs2Qq1gdIebhwKdNJ+Do98N4mIx1nYoIiQ
+mfHKuAe7+O3BahX3WJvu1SHlZrIaWEcAYcZgbA8Yie0hv7hpj+WQMkjCYCtOFWRzRPeOk7GZhKGg
+boXf7XRGBOb1nNxYtkEwnv1j4A76moVCXB8OEgZWR6uwptRycOu527lzR+XZsxyOdqnP+OuC9Nxf
+77eSI6wxv6Rqhnec4FVqjtiK9zPuPJI6ikMaNquDKjzuHxIwPbdHvs04sDqf24Ruvgh2NvBDPfr3
+cVTEITiVQZ5oJGdPxmbfO1ARh+BUBlVHJ89Jq6uHt45B9ycumWhmlRJvCs8Sm0NcB6IVPLCw9zee
+6ttyGQm3T4u48cdM4t4y7x5IFdjF0X92H9KhUvZqHBxW2hXML2D6Yb+KQgHrIxZxoCmriPTkcd2/
+/WfenOU5jlzg2rvqKFxba2AdrB1zqKGenIQlqZFnZO3hsqyym6wK91wroh4a/is6DMJwsCw1N8S2
+fDd4qXwvfQuWdambyUpAnUi8iSkrjiCVucZqTNgWkzQcj+UeB+pBUgIOKJVm0K1cQt7j6mCF3pda
+s4EeO0VHqPhTK5WaDWZyipaHvQOzduWJbVWe3FZFz0VlW1pcHDTtHoVCE+rzy7qUvN8p1JwveB8S
+UKRa63IshY8rhqJRutMWHfGnQekyRr/g9gVTxHp06LCv6rC0PpaLQgIBu1mnqpIRWvX61GBp60Pe
+y04FN4j1v7PbByEFguVWlokYhdcbOqEQs6Nja2XHVn0nXSUPhspTT60su/b47qu7JAbVbcFmV7mk
+QekiV3EITtVoM2gOFvcRh+CESM17xfSgSjWrP3xx06B/jtRkUBhYHdFKWiJvm1pcmstgQBdLEu2E
+TEK0Tf920opGuDANvXaeF0g0wr8IBawlriaXNCjRmT2sEJwsabVyC/tYwWI/4hCcPNKS1tuyektN
+qUPLJabrS+Uu5AaLjWOOjSp7u3IQDyqDqqORJb5G9S58elkXPVp7Bbooy0HBxGU5TKpzPbfFpEac
+hVtdITh5JIs8i295Ij3CcjnQRK99SXEh4iRKQr0vjbsKwrjgBN0ubvtL++HEI9JTr48nHq+dq+BW
+EFBBdCtUISLNPOLPFVvtXG1znXwCd+r836mj4DNoTU4pz7KcbUI197W2tHR+xl0Xffp46jn83HEX
+fe68iz5xuT9tfH6OAfrJseq5Y8ysUoKT2ywnjqdPDtVOG6ed/euV4KAmPhQ57VnHWeupBxnmbM4m
+KAlvcysUXOA4e2NzNpxgsTmVlz1ReY1nKjbNojKKm8zNDLoNfAbmtez7YvJhAN+9Uaiq5aN0SVlz
+RXnoD0yuvOL2G1Uidw6VXjEXCqpnMamGvC8l62XwBc+MbfDKBXuPQnVoJGt75uZ5sQAc+S8Wg3e7
+AJbd6fmdI8NzyvH0LK2OJzNliMc+r6wH3OuF88jrxQJezP5lmb8uhMj13X+/vAF661f8lGoImAd+
+a55vPvd5+2Oft91UvnFP+X7kUsVBY1gOH9G8U75TaOwWUzKphv1Hl4mqy7vhduttbxu8LykUPb7f
+NOM2+c8DMycq1RBvBTsqSY16mP3BqeoP5i/+5OOB20BSdcTVwlkjcKj3hKgKa8KuvLiTXXpk9a3x
++1N/l5+6/tR1+dXgVzcWoYKEFt1ggNpI2AuAd8ClQrt9XX6xelGnks8B6XL0LDTUspDidsspODB/
+4+GhpHGYSFkXRKYFoV3QVnvw1aFQ0PO93B1gCgjFJBJYLVgqcxPPl+6S2KYkOyMKBc0Q7NFkHZq8
+P5MVlPQyRkBUyodJCU7FyFPmg/6XNU8JRmRhGRySTDKa+R1WY8+WmWqHbhpwUmdddAlCSNORGosS
+djgQMp6TKCB3sURHhICmoXYFptogY5UJw8R1MHNW5SMThomH/2fy53utX5p75nPGdE2KbccdU3+r
+zkQtr7jMvH8p5nZqr+47a7ez+N0K3q16UCt3EAWYGkJm0JhB406G90MJ1cHi2Izmu+LerMBmcSOK
+Qu2HILzr03ojCYOZEZRKLMsqE9IMcabFpBgUB/VRteD0VXgSKcumf07Z0b4E6oyCyipoSFnFWlJW
+9ElZ2ROFr1kS4Y/ksvYvq5zgg2YWGTuMMRttKt3pAu2GkHncLMUNsXe9BK1L4XYrtrWm/wqiJsGp
+OFXSZdLy57Yuvqm9rvoPlothiyBpJgEHQhbKg7r4QV3GQV3soC6HW6Cbr3vCjAJerLtWU03tIRQB
+kskU0Jyx5L0pMGtWjftj41HR5W9Z4v6lSPhSLWUZ9pGaDet4Pz0bqxYwXZPi7gMeGY2zI7P5Adns
+gGzjgGzNc9co/NmXQXAqg6qjE/JYuy77UmJ2cFNtg2AUhBgSKl2QUONKgZvfVfZ8L8vxiOukMOKQ
+u54NZUcp2DBiZxvZ+Zh6xSsNqxzYZCppiKvJJQ1Kl7FOXzh6IkIRk8Ggxzz6bwmy34qcQVQTfY1M
+wYhSdL98SFighjOJQ3AakaU6OqHJD3lIWT4LyuQnkp22haJSNjOgB0hsOTX3xSXPMEw8uXhR9DU/
+Vnyzam+WyBLssjZ9Q6bzU0X5RMpsUhPF5VhKb5Yqqzrjy3kAswQhxR4sum4U1mZiJVPqxogoOH50
+RpVQScejyB6XL2391qwjh8USq0yyRUmDaDwocD5UYouSHPSpfPNmfSQueK7YPioz+iRl3e8f25La
+xwGnBuPaXNGqbjHAEhtkRojNteX8EYfgVAYNPyfWfjvXp0mN25dfNH9fjoOWk5mBYJQYaIztI2kQ
+ovtnYpQ0fCjE9E+SQWrMXDqkIxa87/RYSlTPjOGBSh9MQ7uM3IR3uAZbROUeRrS9gSr3RGpIkxOF
+PtQ1srhftpQ4zNcgM2LxiomL7FQxY6Uo5nITvDg1KeaDF9sc9c7YNXPCYgATWwS3PciMzxgGmXFb
+yjbhKKdrwwmPnCdFwpc6CoSeri91ZNRkQjfH2orUv1WPx2t3jmY2iT/wL9/aV+Sonq44BMTMhiMT
+7JbRbnkcsj+Wk+bWntq0suBILdGvKbr04d1YwiA3n7+iY6PHmfQxdQecJM4EsXlTTjvB9C/v2P0n
++uis9RfrXM2KRUkwMAubf9pWPBs2w3nx/Hxe6Ylh6uO9IPyFKO3j8MzHjcpHdrmzUV0dUVrmW/qZ
+ArH9lAqjd8M7asti//GCoLIXzeeTkBXQ8CqTI49pVevm1AYNd4x9Otq/En1pc6Tmyay32JbioF57
+i/eybBOaWZ9sGU3GJjOHWZm9sHkmLV2znvdvTZPCSOXQ4V+sNReTEoyYe8funKTEJg405SBlW8jV
+rTUatYdgWh+a9q8+AuyhBMpisgJQdr7d0vJ2YJwKPVtmTtRkTtSoZDRz8EkjzhjlFFm5vP3i5QUj
+NWIfytyF8g4Nis6/ZM6+ZM69qGS0fuFDoInVwJxM1vE+lmuDLCYZbe66e2W9s7bycDB4jkdOyxZR
+v/J7smPL+qCQYFAcqpEB8wLpzpMLchS+tu+IJLK6dR1GWvrXGFJmrkOxomyOrJNVX4lGprekEJZ4
+R8gCdzrv5r1zAyiky3G3InfPCDr2f5W7A2aTJ0XceSQzOAFVqjZFt2/DRmi2EZr1ePMeb30QtxuJ
+kXnxzXBjxuvoNeNjxQ7wruZaza+O5HWkRtVaFJOVgNa2huXlFbXrzj0USYPSZNW0Z0GIVp7cdqdt
+vLPcxWQwKAbmwg45iwNiGkKm5JFy2pFy2pFyNh9jONNDhZ493gkhMnvj2sUlZRTg2sOy/GJSFxCc
+yqDh54ScysLwzoT8WodUm6F539OIT5NDugiUKGdDaPXbvH6b128b9du8ftuo3/avNAfGbpbL5l6I
+2fW5wqqPa3vIAS0JPodQBMpi0uIpH7Icg2iMTG0FHzxZ8784CcXB4qLbvNOOkcmBXrNCOFwjwYhD
+VOVq0WR3EDdLGTSineKI9+p0Tpcjz0BU7hO6+VjChG5GHu9EwUq9bYO/U5yIhb99e2PX7pL57O6+
+D/d91O+9q6NgZ5OFO0eH4lBJLAWULCFiBeH6MCnBqThVoziMVi9lliDsCLFcAlPuFONxD7TE8ndL
+sFsBvEh08tx32xAi+5GWa0Izu8SknaGZ+XewszJHeUkwvhHqQthOUmauQyEG/PvIxIxgE98WGQew
+J9++geVtVXlT11XcnGjUHoj4znvVP2eEZL9GPzdGPzfGcW6Mfm6M49xIpJnZWCaWhaXyNNxscUOo
+y6M4hYFlYHW0XLaDwlIcI4beJh7Le5CEwZ6iPjASLRG5HszCRKNg4sO2fTywWpfjuc1sqU3quhkD
+0+WKDDLnsfe5MkdZDV52FIDUJWs18w2PXBTwfKvkO0Io2FNzsoepRZd4L4PMDe3ONq9XMnfgnOwe
+uuR8F5OIawsFSmnom3zud3EITmVQdXSKI94OAGVW59xxVT1/EXIfPH+5EypUUnSbZXXaI+9OzAje
+txuFJbrdPNHtxqtBJzsWz9vNJOLu6Gs0/tweS7o54ZmIrRDkFAaOeKugXdpPv7SfOBROOxROPxRO
+HgrndmBFpIlZ9e2gROS+PkxKcPK4KIPKwCm+NmfWYedp/jySmAwGlWDOx5lytad1rl6unHXCNNt9
+A7S4i5QJ3RxloL0p/qVO0TJhmLjMPPtPbI3pyue7nM9f5XxkwjBxmXn2n3gqp/dEjT91PfUlkBOf
+VHUJp/N36QlPNYZlwy3hGXCvfQbeWp96CcZef/JW/rQ7d6yGRhkMmCC5jxr0zHLyxHHyOqgSG+G0
+y6HCRaBkVva+xOnvS5w47ZzpaBTM307InXJxMGdOJxqKKSZ5XjZMlysyKAysE/53Dh9WYtMA88cq
+1Z8HLpf0ItRGYspNi9aD8dzbIxcdi58cMKuUYMRENog+9dnzWXiuUkD+Rc9FZ8FH24BKSLF9KebJ
+Y80wzXYea2cptPIxNAlxL6vGy/J/eX1ekfm9VLT1IXkfpO5NEEJpSUz+nq3N7A7BwCys1YcPXBRQ
+g48+arkwV3fhSfTFJ9FdVsi4mNwdLKrnHX5qiD81PH9q0Hs5+amy9J9egVRUlbEEkzBgKoZAU7lT
+7prPr/4NQpVlzUttDup8w3sHBDNppYxgTDkXB5j0ZWhZ8E91Bhp1X5qWfM/MCXOcstz1iIZUT758
+R1CTOqRlbyZhRUck1Daxron5I7Oki3lVQVk6z6EiXQQ83uGdSxfwQJ4b89xuRbRKOMBUots2lob/
+VzVIl+Nu9rw/BeZ87s0BkQx5OnFE67cTQlNr9fZVKDSGa1EQYMqlmVTDf6cuFSGL1l8n/lT4nRC1
+JEQJBsWhGhmw9WNG0Ngckv4Hw8SIYOaWfCTWaAR4VYgAU3/c7UCTXm8IMOlDY0jr/RqDmIQh7xTW
+ZKKnUPUyTA7srMoF7IFoT+UHo0aWkUrtBSz/ZgA70jZ0c2MGbePxogDHjHCHOCER1dAG/SfHKst7
+SclkBZRgkgYWqEDTRQFVJJiEIZdmUxPUeoLfZcW3d/K7VHzFI7+5lIcsTnE1zMOaae4XNem0/tPz
+0/cFBe+nqagqub6XrBKEIlAWSkajB1epeOfNKNb2pdShKaYziMlgYDHMM52WJ6gavjQp95M174GC
+3wUbp2swOxKa2cUSSnEwr8fi2bI6WZdfB2DhL0dLpLLIymoUWS/IRrHgiyjn2d7qUGTwNYhnz0kz
+NzEZDCyGle+U90Ee/ZJB6RpsHVakuTkXh+pkkWrRQBtln4Ma6SO9SalDcxQjzdMqzD+ycIpVvpTw
+pdVZY+NN3b6U2fMjX0r40r4cv7JM12D3GqknX8SiA1subA8ezyiYL7sW3Yk3KyH9TudLrUOXCcPE
+kwsLgbJNOPm0CYe5XY6f73p8/qrHRyYME08uUz3QhWcZV0ZVEnIpIdYVRAOzLGIJxY5FYiXbDoxH
+HypNZxutz8/CoTNQ8+1B0P03YAcNuEIEXkG6TBeBRQWe5QOvISobAEUF67rgnRYWHoMANQX4cAnt
+ypMuT07hLgj5qk3HDWPDcEd6zLKoRPn3N67S4a7ZRXbZWKbb2eo3tOoqyD1GJOckjNI12LpmX3p4
+rkIRKIvJSqBkf512FiBVw5cMStdgK+9cW/lG5pzyOYApN4TdLv33K9pbWkkJv0OqR1iXKg4w2YiC
+RCPSWQStkTq3jdj6CGLXUgnJDMktHN9I0M+0IGFA2Bsid2TNRJZEm/JE1JO1eNLnqZresItsGqDW
+HGmLPTwQ2RJrviWr+pa87hudVNl7cGig82GQGnUg+4PZ6wUM2aJixT4Hd1QzguVNgUzsOyEl9dD7
+PxWBkmb43TCcuy89uOnf6Rh0vzsPi7st5mmULmfujvevw0M1CUYo7G6HzD3rJwZyL4LwztObYpso
+DKyOzA2c95lxsp7VOuuToofL/WQYIJjzicJOU/eQf6rEIKmLu/BjM+ClsMtZHDCiAVcnOsZcHLAv
+gD1RzJ79ji/sSJrBU6uyC8IAUSgqJMQNJ8PIi26scVexoaC6xdXksHDTxrpl3iATj8fMUr61Oqtx
+nbWvKMtb332feUnfWpo9a/tS6tDMa8ObY4PV58PdCldgXnwxA6kCTuguXoHjB9dVlT3iX/3yX9JS
+a+wR6beXmULufZMwtOuCRXWUQECWyQduaRq6pa8RUxpjIsWXDBoOfjKHhgtzZ8wrKInR5JqLg5lK
+XEmw7AtFEwfG0BDDAMZEc9aHQx2mvWPS6qxeVHzDDqUODV7nGgNBr1HppgE6+IaFLSXdsGHwDUmX
+6Ha8GAOZLiPrlluaIV2O7uBQIkYYJBixiBfBXTWbqJ3x79kvM+kZi0rd5mlByH0lLTZiwTSyCs0I
+f/1b7e16SUlfQoNERG6YnyCFgSPeE5/NJCI13LQ2eIERUs34OBkSBo6wADSpeF+6t312DKb4Z7cG
+3LfH/9NOXF0BHZxHIYWBIx6t2ZayEGoce9GkaYrfxFkqkhplTiBf/tAuIpsBwl0bFTinY8GHooID
+bhO81UPQiKjBsu/nkiZEn8Q+IcZS4lJ0N9/icra8DbNpyO5X3yLsUOQlgxC7xrImmXCYec+0xTWn
+vA+iR951pLjfZzWXb819K1djlS2GpdgE1xaDLDfCFrv878QX5WpqCyf0tigFJ80t3uQM7Jpb3Gfi
+FnKlDo2Yy8YEd3yIYMR2J9F/6lQ8d7Z/W/5luzZpSxqU4joUZLDlvT3wWbZr2HJW0923peW/2x2+
+YXUWRwdLy2c0neNNbLeKe07iAFPWT1rWJX1rjLzZouiyYc9eJ5zttc1cXUGF8L5pB9sPp2NgHAIR
+643JFg8pG78t7lqRsYcchauwKmeTN0x7Lel/DJYs15+/9iGgmeOed5kZEQWL+Cq9IaVFnk5i8Y6G
+j/4hKuNqTjwG61sEHcSKN2Zu3eE9gJeyO1hlmteklSUmKTN7xF6PpWBg3fV8+OZ55UFvmQhp3yJP
+3yVVwSa7hLMGGxfFku2ITQp72DhdQ8PYoS/fiJPkgbPv4Y8ADFFakz8/Vfb+w47LgYINE3QFRpPY
+CRU1LmiwRBVSKBCF084ecHlTiQgNZV0ooN6XZFKqli91xYv0xNbp/kBlou6Tuz8IBGr1Iz1yGXHK
+A81PLdqr+65fLcRfZWlaGDLKZaGokLEtDjSpQMDe8wks0pJmxgTWrIa/9PqtszOHYftL/cu//aXG
+9S/Dt/9H/lLDX/pf7n9VJ11DgyfyYzYSITguI6Vr8O4xEPF32rLULnISB08Wf5kbPmQAnOgjq+u0
+0t6sVVdlUBhYJpx8B075v2RQugaPetq0F3Bqog/6lT9fFf18V/Qjg8LAMuHkO9Aqit264h0wACub
+S9xyG/G78Ly6V9vHca+615P2s+9No7bn2PnOaT/r7G09F9+aHT9fqVmTE9cZwGTznjwL/j6r8sTQ
+7FyhEJyqEVvfZG88iymyHGMU1aLnFi1/W+Nc9N+s9jZoSTNPvfGXqX7b5C81/KWXv/W/0/+lWwPd
+sP2l/uXf/lK/o21rDcP1pX7+t5Wf/6eVH/lLDX/p5W/97/R/6X+1ct4i9NwlOSQJgz3pjqvu3oo1
+Unfq3H9nT3z0n27WAwOXY5nm7FVJMog7JTTzaOIQnKbYKVETJ9QRmPcJh0fdJpzMbcJhfnk1chmk
+8Y+lhOiwdtIzwhEXjc8XQlUOSa3aPe2Bne/AePLQkvWieOBgOBqb73OIR5PtV8ogJLQxR6c9LmnC
+RHujQAJUs8V1kS0uE7vdEsZ18pgcuA051Dta5Btbjlj0x9XZqz2+FESdy1389mBWPTrubUKadSue
+iS9BkCQM5q4ApRrifGyYLldkUBg40nl2eEFM/uu/0/6BwzFdpjSQJIrFpBCQjGdKQHAqg4afEypj
+aOZmEnuCoddENVbQ7spJEgZ75na/rjgdwKYnGUr74jArUxZtytsP0UmbXTOqF3cKZhr3QXCP7SwO
+MOW4mKTh6oaC4KZ5luWeYnaoSodQUH1QxNWBXajKRlDvXw3iQrGbRKSGmjtmFLsI4hCcilM1Qq8V
+2UM87MpiWj7/0tI16WSWIxZnZ8+C98hV1ga4S4ugqSjLCc5wvWmA6V6V6qs3KeUuCPGuCIARmvkd
+Pa+MvbVEv9B/a9VVGRQGjnh2U7SLN6gNctfPVzmf73I+MigMHPGjHPRnxEWuxFXPDmwOE9wQ3pgV
+uifaYwMSjNhMPKH4v2zI9BcbUrjLbqj9lnd2CygMrI6sbuePDAoD3fczfNWW7yWfh9KYKylfTyKK
+zY+UvI/HR65wx8lH9gG+Kx5X5LUknghLbnnvO8hqCo7W3K5DHKoR3dBlh+wmm0n26sEBTjniHf+G
+XKXgfFCWPUM2MRkI3EvG2Ymv06pUXS9EeJVeyov7+8t2dzT4hSSYsOmCaTlt0+UFCRUzDyrHWZdq
+kpltt3jhvwABeOYBFEf3w4NNRX5f6op7cz94RWlNBjEPq0PiCeU17jbBtFomuESVV8RJHWAmvNxF
+gjEj3GR92MtAs0onu9UypLkLPoKo+AOR/r521BWKbJD0bVCXilbZq2n+9lnFQlqQ3dD6b9HAXq9r
+ek3H2K3h7wYhNfLRg6iBT2K2eHfU8nR3afykjADTf+dSZJAatQ72YA1LYnVBjT5SKKBia6iEgYkT
+Uyd7OcbQvIpJM1Bahslz1FDPF+08utLX7u2iduW11JaPnDrqjvYSGyy+ZH/ExJi8aL+9cDC/+maS
+fdBI0uTLpT1mtggzYo76NUdFGdQeE5rZUlxeZkHInu+UTDL/gv9MUsIZjYRMCy3C/MSS2aH84lX2
+ZdfZl43IOnidOQv3koKuKTTrLsWVguWF3eXdf3h0xbdN8VIE/m4Cnxv2kC4KFaQCxb97QbpP/um/
+edT+52vM3rUa8RDCOV2zpm44AO0JgKvqqPX7o4enQzI6t0GaVBvwR8qKi+Ef1PsP8r3lqCnxRsof
+vo/yB3M2f+ylFPwLivzB2eYPzzJ/Ip0xdvjDGbg//hDhz3Rd/DMeIfyZniD8sQvkn2MpDVPnf47c
+KNRFlcbua7iIqIQBNeeR/Af3dH94T/dn3IH94TH+p+Uga9zyzPClX2ZJ+DyLEJzaIPdj1pi3+nPa
+eIwUHFmVacbqj09Y/fmar4ImgyyTRN8mJhHjTxb/vCIv1n+666WLrUl1zFH5JqW35NJ/vq+3n3rL
+5YP/niHlQfWn3rf0U+/tpz5+6sPWy334erlK6SJgv3lgvdyHnvMf/HfZh/0lcIckJoNBMagGul0U
+PZ3GaZIgCKkkFhyQPLCWwSoZWMfgVQzMNOBE/VhCflPoXwMZSZi4Drbk+e0eLDO/OZAdbOmiu3jF
+8ntUKb9HrlgHw9Ec0vA1iYZBU76hlJvJhhD1vDXhXyF2Znm3ViksSs1Y6eFhCz08fJ0HJav2/c5c
+dEGHx4Lu1Paj4c9FQ0GIywTpAoX83gchkdCiLyMQGFMoqJo4RAcFg5f9W5sdo0Weh0lEs6lPa+mT
+u87TG2z78fN8LOsjTohodUcjk0BhpskyTQlhbiYr4W1SglNxstT5nS4jq1ZK3CdS3lTAV/3UsKHJ
+G/48A1BlUHDcnNqgKVGrhh8ZZOkRyc28/fIg3355bNuCHZ1yiusgnOUeOk594BOnLm7L+oXmpEsd
+ECab9ccm6YvSNXj4QFiFrMwNfb55xJGzSQlOZVA1nFLAVvL6dIAJ4ali/0f7eNeDZMdftHbIyGfH
+Mb1rl+z3xxIdJDiyUGWkfyLUfwDqQGdUYGdpO06xHPV1OJlRuygaD02iuuUU1XYIwgBh3X1M3X18
+dffQ/qHKA0OpNsPJn7nQq9BM8ZYFEWz/Efe6IEUsusEKdv2yND0hlYBQ0+NkUfyMpCsfH4PYMFPK
+l1InjaVDYwSTPrh61+A6FE9HhVF0N0+Kt9U3IvxngTSB9sUbBHN+ImRtnm6uFE9syIL39h5LwQ5R
+0AvYvsX64ZDd5LBIGVQNrRzMPSiYmztha5cjiknGeOKIMUc5sBOUw3aLcph4cJmxSZmjLCs2qzbt
+LC24MZ2tgPwYKxw/5hWOoRwl55spWoKuKPFYzh2hKi9BGCB2iEJRISF4+XmxwJdt/deJS4JeBt46
+CfPQ9XEeXB7ngVVvHst7QwhlR7hAiJjUWG3G1XHth8Je9RKFS1f3lH+ChnpVkkWDm4YFoQRK07Ux
+9kXkAx9CPgQHmWA9a8hgYA6WQsmMkC+hCJQ0M8nvgtA0Jv8V2U3SkLRhv/m6ay8jP37Z/xA9+EUe
+iBOGCwTORwq6g1qmsl8qDoQh3x0Qf+B22IjGh+A/4QczAvs2IF2O3MOH8g80/I9HJzu3G1ZjWVg8
+D2oS499Leg6CEfXixpXiOhtuGxfLlnbptWMr4NeYQTP/5rVtvPaJ6IhBbnzYZyRlZnWJT4Q6hU5g
+xIOCKkRaKB6Uan5KolA1LXeN1rexH5JC3k3iQJQUSkRV0w0hRhcAZHKzdvF/zB+S9OwrOG5SWlaT
+OOMC2S3G1RSCeaIvsPZDl7dYxKg+BgnTPfq/AJxMFOnqZdEJeTXcSwj+jBtS3DLZSjW0jJrb2GU4
+SBMPUvzJskq42cGqG2/vwxhsmH3ZI49GovrvFw/nrF144AKNY8VBJqx1Zhkay1WG1TPrUwyofb9w
+G/KvSB9SUK2yNAe4lF9pJiug5IxdgIUUgRYRBuYQbzeTcEs8DspGDwwwpRwLBfyYUYliknbdkOWN
+I7vgX9weUi7tNvXhyokENb2WEvYl6BhdXnrdk7cG6FLebuos1kP05uHxUx/xn9h6kri0enb5+5uT
+tMeE3RXjTx7QXbiObDvBr2gI1pNLvDFEkhv2S5UVEM0yUuA+rAPPQ9HuX+ONy+obpWvw/hWz214T
+cc8bbzrSjbHhBhVQQTRA/Tcun8eJ3TU+81N7Ro98vhaqkmcawwpmNRVoymaCnjXVpgFuQiJvQlQO
+C7PaNnZVhwsQzYt9bXcvURdTUWEGjd81+MVhAIA9xLtJGOCA7DHiixzuRUtzT2IyGFgMS93vJw7J
+yMtstKts3H2b7uZs4y8+2+4yDqgEOu/0tAbuiK9HwssRj7i3TIHbW1J1bIPMCKlZHxpwrRKjSizo
+oOO3ZExOxOP3jCl8cx0Ka3ngZB+PlJfgAD+cKLps4sAo2U02B0TlNzcPCMaDNziRdzSRNzPR7mOi
+3cLEA6rd28XjXfRTTiMJgy2JKpWI9TMcYb6s1y7rtLJ8VAhC2J4IUUZhTxbO80S7e4m4e4mFnVfg
+xkOk2AGiAEembwjp17joiyOdLLmfUYrF1CTykpnDrNCrLTENovG9cP8i0qz1V3vrExsOmm/jiA5Q
+QRkbsnGJ+0f0ajbbh5B5YzubnUmbn0mbtbGxAli47hFfGiwaItHLzjx8AKPA1K9xixR5dxNfPBe9
+upYkYgYyLwmh5pWXZDdHmavfdKgqGkJVdL/L65N7b+bel/F5KyQdmcv6tBrl9YndI69Pu3fj7Ite
+OXPgFLFCbSSkDT5LnIPOvmQtX35xugdUUP5jUmDBv+IRJDiyisrwNEvCpgcgihs1y8bZTpIbLS0M
+doom0diKezU7GLI0DFkAatI/+FGBUw3J4lT+G3uIQrIGaFq+ocs465WzbjM9cWa9kc252fbjnbrf
+pPv9OYAmTPcCaFJxaLA8OGNoiLhb5O284TCziMMOdxIc7DAiwWgLfTsOs2WWMIABSBhYJqzOczJY
+cXbPPJX3CSWKQFlMVgKlZQW14a4uH/0QljJhNdb89Tybi2DxLaMKpAWTxLlIwrQRiU6JexiIRhMx
+MA5PUpXE8uVYNdveyBo1hPp4lYDs2m/Bic+Q5hUDNJIZH8t+l5nDrMxeVnxb8/YXm1s5K6u1ngOq
+U/N0VozsJodlOO0jb9kNCqUVdcPDABK9Hkssg9y4h0FW4CMnp7IPYqIoJs1g1cNdZS4N74MZSZhY
+Zq5D8UwSr2YdL0g7dxaLqH0kIOFLSdeXOqVp+RhE4xtzQyQaNQc/KyloTG3LM7/i+piUBk2b2XjC
+65KbTZFtanbOaxlXQZ6AeEXNZzLxDyp1cqAHcCe7UIIrCbP9JMsAJsufu8hpp5DTepu7IhJh9JFf
+kihsbNrZ4zCJQWLqxIFFR+b8snkIUBlkaSZPM3FCOnOD8M5fH2Dl9y/3VYUKih+TnwvEC76CBEfL
+6deGAm+sGEIITh5pKfggEYBYuqNRb9tAb9887zG76yxh1oYjcyyoPv6MiICoOz8gcYSZYw176pvf
+HGn4s1978qsShn9t9dpHfvNy8fYFADvj3MP26gdxkEh+RFllEI021gDBiL8RIsDEsPmVyHiKsLq1
+uCHL3qKjN69ghrIsx657J7q8SAgDikMlIb+C6dkimoO8BCsDPvQVsB4GCubwDujSTukisFOLvHGO
+A1j0mGbF62Qq+Ny7RBQcBYNNheJQSaxrlCcst0YB9SkUUFOiYJY8whRQeZ8i6GSV4yCz4L93Hljo
++2ELfQOKQyVZPrrg8QPvz6oolDQ/KTg6U7xPZG6Wztu8+yC7M9xeQhEok8lhsfR+rgFOZstrp6RO
+j3zYDWPJZ6AQA3M6sfvgJNlFw+BEF8R+YPlrXArBFwlH6FgK+8EFsLvkxBqJaSHuCDHs6MB6nHfE
+o5vPHW8zNEwxN6zW8NC/oOuhpj7tNub0WJzgTh4uKiUY0dkOIYVeYS5ODGkXhaFU09xcHDzOgGXo
+HLteNO2K6ZdLXCt1hdAeMhKXsJMr+Tywbujj1IPx5AOZkxOXZ+UzIb0Mno2ncIA66F3J2eSCxKlO
+JaIzU/ICaSEr0eXveZ/QoyUYWQ2aFN57nXYb4cM+Lr0LueL21FnCrE0JVt52nnypFGAWOPbNdmlt
+L+x8nMa6OG2sEg8qLj3yLp4jLnywAll/avypcdk0kCo/Nf72n6R4VgV9Wgy5O2hU5PoXpMuoOaRh
+YwpkqvfZke90QKr9Sghx59Cpm9f+W/lGfFx1riOueLU6rjGhYoDFqYJ6Qb1D4vr8XS6TFSDBJAzU
+ZadwnWWBmKfsTFQgalMZhQKxllKvKhGvsalANF5mADADe5Uh2ltsHVCkR9iUdFyfGSGVs5mEARdq
+Akwa9qA3LoTH0u8NfnBDFoPovxhCqpPofxpA0oAThBGNZ9kdYFqZcLWESKTX3C7ZmiBPbCEATFxU
+18iMuzgEJ49EbwXBRHkMeIG/S4HkAMcICYssSQFqXUJIMiHNOkol0HQkmyzoWpPD4ZAwePZgylby
+UyYME08unvIdDxkUBlZH821lWZ+DJAz+ckFKPOMluCk5mKkEBzNdhIgVFxzNXLXGLJNHeMBHOZE9
+nFA79jH39rDlsz067Hqa0AIlyP57lh4vd0GYIDT6fsP3JLFva7XoqQCrKkQpR9zrMyqWhULdalso
+VNVTDKZpIyZoo2A0oQM2Tt1w2kZFVWkubKeO6uKdL3XGOwJNeselK94f6qJ7Oe91x23ufIerXCAt
+qZVzf3Ay1yhdg0dynmVAtRni0DE0Zg6YUbGDrwsRB69HFSmD6GkLJ8d7TAg1ItZNb3TifVsQqnnL
+EUZG7hrowLLLRCHBoDhUEquzT1++zZq7VRkUBo74KaPPV0af74w+MigMHPEjo1HxUfOp6lPd0fP7
+gj80BYkR/RtX7iMzBT6lNUK0naVJMLIurIjVQhoF1HiLuIgrrrzID6VO2uzGXWef/ox/1jyZhEHD
+WAYNo5FlfFlvTRI+uVAsJs0ulNHk4pCM9mVQdbSUsBy5NgcJjlaVzvBEaH9B5DjMTFKw45+6nR7L
+ns/nT43Phwb6yBGyKmj4jBsFBmvPXPTNrJhwwCTchkUsl68Cqob4NBAyGBQH9zFAPZPsjcKcMRWp
+lC4CElXt3vTIJ754cwwT18EsQv+xVkUThwrCx2GdMEYBmGna2aBKGOhOl4MXaEnyLg7VyKC6qUal
+pwZ6WCa8960S3YH3vrvk+RTX1PS0f2ZzhBn/NEaASdMnDZh7YjPs1J38bI3LM84bKWughvyHW19B
+TZqweUdxczQW0Sxr2xzYmI3vu0T9Eiumk/cAAHV56WZ7IZuXFPxjpiKKeEnBu0iOqJB+vFmcajO0
+ttlTQyUbDnbG2TG9uL92ucX2mHkkVNX9mkRPa01Txt/7qdKyuWCy0tFywWMKxQuy8rh4Yd9/Td8v
+D01mJdb2l9pz71XYet6b7FqLTSDiYkLdYlqKSX4cZMplmEhCQR9+Jhq31Ku62bIxfHHE3xsZr40o
+aWJ8yxw33VE3VAx9uOU9LivyyTvrw6+ko38kTfJIZp93rSnuU7ZjaXHNSqjQYRU6vEKHP0VW1gwP
+NPRIskU851CFO1PHQml6bQRscJLF/jFp/nH1noZyEafzz6xW1yUMLBNOHo6e6WVttUWzHDlO2A6K
+yqIry0QA44mtfKZlLYPObcJKLpRNHIKTOfH0AMz7hMPj5RnkMojx61LbIAmDPed14cFwprbs4r1u
+6jUUXBeGkr7juPF7Nfclati0G/ZfHdriYtgvfC7TZWSJV7zFAKiNZLUCI1HS529xD8w9PLRMzZtr
+6ROqEU4DOw1vhFQ001s/shTORIEq+jcrjsjkrgEmaDvwVpgEl+XAoIi07DPDBdG6ffa7WLboCPzH
+YMSj0rjrvQ0flUKihndWITEXPxh3vOWlspnM+yCPLCITuplgJbI4WKu1t4MExziZ4cl9HjBuG770
+4ekb/O7/zGD8+Svp56+kafiq8cGVBeMeeeEApMvReirWhqsICS5PDWwbY/5pf3KrPPG+DwGmuKD1
+nADYdVCy88Zx99vGfbppxJxtF4UCVubAT3EjmslWcHZUgX4n/4Cw01lNJrdYaYzjTnxWrJLYCTeH
+gCVNmC5Xumv+feiOnE+KfgY/+u+RdwoJBNSuU0/K2Ro8t1IBK71UOfcVJ1PSPuFwiGHCYdbW9bz1
+sEMnFdkRapy2m51WMPNaUo67STXkdc0m1VCWhwo9TLTS+tNuqrhlRI/i9f5YK65AXVaTDcDrVK29
+W7lfdoEVsB0r2QajYG5G34ttarli1ZBYr+OB80wnbV5P3PQIbPwDFEIFqVjzRqGqaBA0VENvPq8c
+SId6NxZvdxfNpksavoWGDAYWQ+fTjmfSkmZO19BY7dMPeB0Nv7Shehns+6B+VB8xjviDDz7in4N3
+YoBK0pHQH3/tKf7Bgg2QlrTx7uGPLdVgBCMM+uAy/jFjFJPt4QAT/Lgwgy1nj5HbR1zTOK0TBnAc
+vH0+iP/gJN9lMVkJlJrg35/67yIJD8eeP/W59F8ThJyxcJQvpXwpddI0a9cYw7RMZf4QSCCBM71G
+YWB1pK80hFJMqkvcEaqSsHIJIV3EvDvAjZlgETnIJQ1Kl7FOAQA1Jd/tf4q+mqIC/0DvaLESDIqD
+xxlEj8TBDEyXEY5q8p2kf8puZNGa5K3B75KCg8a/UZk3SuPbFArM9c26vU23gt89jP3XPzZ7RuyO
+z/ibfynUQePwokCXyCWGY9lNwk1DeGldMAbHk5qnfn7RQxShzwmfkXn9m4sUh0piJL+1ISAzvEQD
+mS4jtkvv721haJXNJKMt9ZT8O72KTYMNueAG7mm3a097k/+prwA8+QZAl2z8dnCrd0Ih/U0AFsGX
+Ap54J6ALeKii/bqvenv8jHt/5tsGwQUnFAJNaNWOd4kIiEosFsOOJ8cdTxt4dIhmsWrvu9eW45In
+XurHF2xPq1TD3MEzaj8c7Adc7SGHhdlwDPC0QUAHzRidceCYjmXjlleQMLBMWAcPZLalIWTa5mb1
+1EA99BSJq94zmheccM2DlGBkLjxRKVwA1lkhDZv1JZ5YQ6rhJQgDRKFgHASLe714fL1ePKper4uC
+Lvj8+pn1jId3uJ9Z+fi3F3QuGnTzmcJ5v6PeZ3oilEBZTMIVY9sO0BPCdTkcGKFifyJEdrul1qHx
+sxd4/fI/OZ4X8riYwxXQIyqDQXFwHwNkfWka0azxzvOTXXL9qw/sICU4lUHVcEqBIQ1YIS2/EE+B
+LE+TEoyYQcJbus+LB97FCTYCTbuYVMOhav88U9ogCYOZOxRNU5eYTMKA/zwl0FTwtb6jmvsTSVjx
++BGSUbgCAqRMyHjtZ5uBN6qGWk/tr7Tg7+7Twn+xT8tv7eH6RIhIvBMAOSxMsOJVgMSNlmyTKdQG
+cN90QeJ4S9h6XWC6MS1BQ/XGS0LJlktJy/0hy+GgCe8aRq3eUxAGCI2xklPeKBCd+Eqzknpq/MZo
+XE8gEWvucMZDX0gJTsNW3I3pOupNoGO6TIEVblg4BoDe2635+x0pYG0s1zanAvNsyLHhMXTC4hQJ
+fXeEpT4UHoue19OCq4LKYrICmDk6+6h4H8tIjcisPkJuAOSFywAk/YSSeap7E4QBokCoY2MO7WF9
+3B7enc2yteajyc1yalJ2h3Q50ssSacTJR8xGEiae7aUOhR9CfKlTNAuAonP2pqjTSwNBGCBs4YvB
+dSjMzxVG0b1YHhgigyyFZVMuSKhxhaBXrA+T6TKyg/Q1Pq1PyyvvTKtxbw0UNd2Fqlyo2sXSL5Zz
+WdWvHsryEQr9x3uj6pjShGamxIGiUBspXQRWXZaPWoIGwWUxWQGoHv6GSUWgtJVtJuWvuJH04acL
+wd84dWnRqsoTIbLAi7Iq0QC+Jpv4xyNdWjvkCXEgZEIepyJHNIt1hhzsDDm8M/g5TofkJpsAMx7O
+eJvfiEYKvDljJGHiMvPk7mVTmaIMdXTUifW5MFh2hB/a11hos6Y3b3qzohoTILuXUARK7wQcFMJ3
+XZLE+4NCgkFxqKThbJZmUg28SIhfGkC1GeLsbBgmroNZiiofmTBMPPw/k3+6jGyjHlq2vmCkApmc
+zdpyNrTlrQFi34zTgzyugjBAFArGQSABLhV8rzDxVUHIStCusNcEE9/0S/6qX+I1JmoGOjKI6NPI
+QxdPwpO/oWYEI7NFChaGvYDbyl49SvaWEaBaHDMApcu4Pkhusvpy08d77uGmgSBU+8bKbFadzQ7O
+uGHE2UFz5VCEt7bJbm0VmJZjkrh5Hgg1PaL2OxaSNapAKSZpcHfq+PTHCEZk82QT9qe1YbfttzP7
+p1bg0ADfoRAqiCcWEozPPTcHmPBPrgSa0CccqPjtKygOo1VN2XrHhjUKqCJPiLjBTXqD28NHRqb2
+aNxRZrulhYakOvaGVIPWgiOZ6COZOEYy0UYyCpoYzedQhne4yW5m/aXLFNmLdvn3L40Tb8RS1nFH
+XnS/yr8aYLzfZTE5LEiuWA0ugEWpfT17p2TNVrdM1sg7yrsvu0gZxKRQKhESF9oui0lGWCJV84FQ
+FeVDEAYIRDDNYWZeFRVqI6WLwN3CPszpgCi16jGeOZrNHLxmrs0BQJY2ns31ocnVqCfY3B466UNI
+l6MVjbMt9nd+LtclMzzXB4UEg+JQjQyiR1rVzvUxstLSz/uDglne3QNXsfxanhRxcdgHmROk7BRi
+0uxxcdgHWSxPysTanK1nunIR1PDWYM3L+hiE0vgNGwEmNO+NUt4sgVeyjI+cuCSkrweZ8vvIT3GA
+qYc9kxNjw5P/dA7QbE/9i/N0arSWe2ra867BXW/fCbBjrz+515+219vHMAr14ZAuR9ZUv8hIJy7G
+J6/GJ6+/p12AT4pNAxzwKiU4uc18f+02VREQ8t0kDCmX4ADTxkw3ZrnFAegmu1ydurhKOrcDIRMe
+FnsgQw1h0ivPqdmVAyESlUMEkokLEhc2kY8MFOig57MTDUMf8xg/eYyfdoyffoyf1R4HJPv2poPm
+pPF6AJ9cOQKAhJxvJFgeuoyEblXcJuEblnTBBK/tp2565d44DbNxggWyArQuG67rm94tbXzTVmVt
+ALu1HFyHwjxcYZRWvAPrs6R05D8A6vhQfVsKNjYAZZa+jbelQGloAL7+hhwWll/wxbdCoWReeIKn
+YL6J2VvlSjvwXbMjzRScM3O0aGZzHZg02Ra0GvvExlkMSEYLJauigNrUx3mYVCefinbsZr3J2bhG
+oErqcUBtJE2N1QK7uFToBPwm+iK5Cn2Wtoluf//7442fDW78QLnLOABF+OuUjv8Y3wGM05IT0jRm
+25gbRiYdLJX2pTT9ImmT9njrzcAWw112k5WgjYn93NdlWv5QaEdHbUlEQ/CHnxve5+viooAaGRvF
+dG1jZFtUJiTRsKwPCgkGlcAk5YmQDk83w2/XL+vwR6YbZls3PKfAO5k9FKHki6/gKI4OF4Ar/nV0
+L5ar4wUVeXeIC7llhzeJS/hvcWwC/TZh4yzwFhuOSf1sbuNneZt9kLfhU7wtK3NRa4B2bdYFrDe9
+LGyZL+Vs+clw6AGCk2aOSMb7cCPdOFgWSgWjTPBd4sEW0XC6NKQZmbHiT6s3XlXucrey2eBnRgij
+OvHcmW2xAhD97WSak+xVy09JmhaSGGp0Xh8UEgyKg7mIQfRIq3K2nbKT1ivD3h4U1t25WRntIbvD
+ZLOMmtWheR3aQwZYAVEcLHJkgQtFp8RqPaxTMcDdcrkv9VfHDIM1Qg8cr4bdH4FYglfttO1wjg1x
+TlvCOF2u9XT6WzRQ1ISYVdm0lq2ftDG8UxEouVeemMvbbOS3+chPKV2E3d2RN4aF26mMoZ5KpLzf
+k5gMBsWhGhkwTxCMKPlu5d7t5H2WG0I1a2kNiPO2jVM2H6coWT68/dgwStmuoufwqz2k4vX2/afu
+eqjtHJnsNhDZcdjtC94jgAwGFkPX24lQzWrBuHi35z27TgTv+gith4iMlj4mjX0KQizUA2oyiE6M
+w8sKRhaJVUo7FT2odjw9UqHP+3cucr/bIvf7Yi3up4h9OTQIZbnnfULN5NC8bU25nU9bdj5tgYTj
+Ec1ipfB+VQEbaLeHMgSPrg+HyTYl8jn8rllV4AkXzPkQ1FQEYYAoEIi6IWTcjXXSvW7nwGXXh7A9
+ZMOKd195envKk0LrUhJC5puYMMUBzMF2hJK8Gwr2iQJNR3wqJBgUh2pkwOw+Hxb1+VhRnw+3gi7D
+suuc9C7LE6FQk2KyAqJZmI/wnxEU2HqxHQlg0YxSDfkjL5z3VFJnaj/zESsI4iMUgbKYtHhKZpYS
+wm7VUcPOWdyds7iQw4J0kTcmu83o7pi/3WMQhFrBGPgu9I7J3D1iRZJdX0La+Sd3KovJCohmiZ6C
+BfGME+2Mo1AMmDy5Mw1aHXzNCql2nKwhJRgxLW/W93hoIAgDRKGAA4rl0cq5S5XNJBx42Ec/7G1e
+cse85K7zknvUKOzU0XbqaHtw9D04ItuXJAoJBsXBXJjElhghlUHmOHty/4pYgWTPvxrYOiG7TRt2
+QNOyLRKiVB8O6XLkPpF1EZFdJxr3HO5lgfmuAa4QnC+ERCwvGToS3fW2dMf7unvOT4RSTEowYioO
+M3e8Q7jrfGMP6Xgw6WFe3GIKaBYnH3dMUnahrciIbObf3B+L8ypYy3HSzbkh4iMUgRKxPEiznpsz
+24LTQS6sIM7R2ZpXnpJMSnAqg6rhlGJynXxn59kbxoaQKRuTNUvTLAFaqt5E66LmXdSsi9roooa+
+ORtClHMii7cGeGZMkODIkpUraX860KR3uArFdmblC4QxBoCReGPFqEw4OQz0auB5ihKmKR3pjNeB
+jWTmMvPk7pnnxeuH1bX2vuYXz0Adm0l4BdkhsTCTESNLPhxgYj436+ubdypvXo1oxLs5RjBye79t
+c799I7+tHXgitnM1r50reHWZf2OSCcPEZeY6KRNbCQcGsgDEH5wld5QwKZ5QNU/xdhje78n3DU+G
+eAnMiEYrpz6yOAyTZda5DKqOpKYf1RrReMbmABOmCHZdg2zv+Z2/GujjIZXFZAWgArqM037qlfq8
+3XQ1V4IwBjnw6n3a1fu0SzVXW4IMBsWAvmk4X5AaoTZeBXhTtdtN1W7z5zvvrna7u9rtVmr3W6kd
+90+73T/tOqW+X3pw5Z+al6cGEiCKCk0KWRU0PDTQroAUmJEW1wqVtQHSBYmilTTfAxo/zu10Bpg0
+gWZTFn3EmDEqzxiVZw64IZF90ZQvzagbfvtPN689zYNUb93cmY8GMh8MqNQ62/MBgiUxyXZgX8m/
+JQpmbIEX4L9T2iCN7cHaf/oeQV6j/02tchKHasS4ROFfrs1adVUGhYFlwsl3INq62j9AGw2HNiiu
+g92hyaBhtLr7N3Oz5m4fGRQGlgkn34Gj0t5JiMR3GQTG2QZauX3WmBH+Rn7c1pXxIfesMYssa97z
+FtdvbcQnuVvSfsydo7MmvX4bTLV/w5wUzzbfre7+T5mDRwTdN2klroNpPpb2GBTXwXWg+eYUm9Xn
+qQHWe+J0acYrwf4+8HgZWKlCXmISBnNQLZeiG4B/dE/4hxRXhx7ZiwiLBqrq4KCLnSJdBB6YfL8N
+a3PnoP98mkNmiN7sUMUhOHkk88iY7iZY7EccgpNHelItv5u7O/4IN98WDRJEj7xF5aYB3l8hVFJK
+DjRhnJNvjW+X5FuTnUKCQXGoJFTthodPGfeCXVSU/hA+vcq3FoUCntrLNz6BIjCKz6LyrfG/bowQ
+jXedO+idCgFxPVNOtPs8e4fECBanE+9ZV6DOj/xTc+y/+y4UKpMGWnsOeXLUKsQCB3xXnP/N2J1V
+SnAqg9wN2Sh2Wy8CS6SqCJQVEs5p2e0/rnNajrOoZBgXykqpO2fSjHAxttlegFtKJbGQYJZIn+jJ
+Iu9MifSPvIApeSxWiwVrrDZN8DUaoRo1Bz1E0dX4O/OcZNsppBjU6tRI/PbUuTpfg1jnrvgVb9I8
+TZVBYeCInzJqU1nNy/p8Zf/5zv4jg8LAET+y9z7wRu85BoclOSIWIfaRuMZm0qvjirtIMGKxHXMZ
+5J75nDFdk2LV66rDGWRCmFFY8I/hTUFm3FU5ldUhUowVMr7U6rqEgW69HKxtYSrXS2VTgrciULDB
+D/0UKKeY8n+nJVeuMnOYldnLSlftIzOHWZnSfOY0qB8NR+VRpNQGxXUwckLYllhkkBlLnDBdjt4L
+bSm02pb07Zg1QOUzK5ZZmWzF5y3vJlE36hqLoMVVHNR0+ug1nRi8qkRcEwqq3CNO3xdeHPCm12L7
+8ws6KvuSfWW7O16EZpIxhYIqBPP7PGSPrV695G3RIEUKU5fdoZIgsZFUcjC2LS1X2WvWzsYLRiqS
+6dwg228RCkbZCiOKJTuoSX3lN+OfXYGXg/7nYt4kLLDpe795E1xxVZoL3gLJm3AV77xJ4QRFxtu0
+KpYztQklTAp6zjRL8XsRpDlUp5GDrfDVFT6Kz5vw4akREmJRcAJMb7YQ75J2eCzNwUyyG6CTYzOh
+Hsh14//GGmnUvmy/J29xXGlfCvzijS+F5W3PXEaN2AZh0+bP8lN13971Er/jRnRf2kJBNe6Lg5rC
+Q+KmUDTmrgHm3AgVpFO6BJjWon/anff7TeoARD6WCHkiX1j5ikYnDPoAjGxF6owCxb6pcxxmbNNd
+DyScNHY/UnYM3Pd/NXKXRqFGLBmV9ePdvOd7WTaHanQ8BsV1MNLowYzV/1TszQE5+EVLEYm4SQFp
+2Hhk7gjy7deeMeS9LdpG9b16YbohsBF0m2eMdTLHN3nXyf6cVWnAh1AEymKyEijRowCtH14nULFc
+DlImHPGWOjKeQ2mdXc9H/z10Wx2PKBB1M1kJzWRcBy1p5nRNWpMvJXxrf7nWWUNth5r3v1T35sUP
+7DnClNcky+5I45aLDBpGNCqXeJvJmgRNvTGTxedU/ja1vTiN96bzUZY9m9RkGrxxlOGfIVVUlX3/
+78AVpgjVqE4kxtGpF1hCDBTJdO672t4i+HqIALteGXgZ8otQkb3KTsgArRPKQ2vL/XFuJtU+jqpi
+R1Upkigq5N6yAvqu8N2+DqiDj1pKyW8KCQaFoO+RG6Vr8JSY2j9UsW06wUETadF6Ji78lyk+rcl4
+WKNC/XG320WgZIbW5GZ61/TXs8XG0jr1srTx6sE3YfPZf/9o8ObSgfkc04OcHYbs6XQu3qbhfQY+
+Y/K9C0311EAtSYPbkOkyYmedKc2QLkd3wH8IEmDSUOcYzj0seGXEkZG6WDjBTLgek8yIhzuObv4D
+WB/6H4KOjF/xtW1HYXIpJumD3asD88KKWEb0Yicq0GR9sofRKXswuXss//rFUcKkeL6qMYUJPPZw
+pJkrdjuq+dBAFymFhBVlHbpY7AsjhJNnpdPOSifPSueBNNoA9SirUHAEYVjJgfKWy91cGcc8S7Ad
+EoRYJkQ9eLo4iyXhfo9R8Wlj4rMc5wQsR0MEC0KbSjJEJCwol2l5q3Y2KWWJ+4QarWfhsz0wddZh
+w0IenW32rCMXfugsS22DpMyMt2wn/Zq1fVYmvwnfPASGZpFWFS9wLmwU5IVwpsrQPXdeyaFs2RtT
+rEuEedlNwdkeWJ2p07G8HeiPv9bJ+Eqyi9GITukaPByeJLGef0ttg6TMPFpK/Zq1fVYmvwmnGsnU
+rf3zm+LQTS8pEu/7oHQNnhx4vjdNnaI0ih7VuwnXLFyw8Aw7v0XP/rwQvXtJ2o89Bx08Xr8YrKjU
+mLg/NeL8/NTMdbWOn3os/be0TxerIOSQ37GCA8QND8Ycq7NWR5lfgQ6mkz5tVhDCgQWlHemI3cGI
+RpZqJZrQaUUjM+pzWSMa8WW1kYSJy8yTuzfpjW+pFbM34529IZcKfX7WJXPEmbMDTk4HZ24hacjn
+gHQ5egr9b49jCQwDBF64NEJvBLnpHYGRGc/kQBMF/m71WIKthg0kJBbMKof4p4v7Q1qLnR7a9qjh
+U12T+W4a/OZmUrPEt0wqi0lGRKFkejVrzrjgdomx37FgDVDIYGAx7Hql2gz3uxF6Dfc9x7JHPaoJ
+yCNKMkkD0zwRskzuNbvtJApIgF0Fi06pLCYZYUlU1ciyIqw2L3ssRRAGCFTYhtaHHYZFSvw1A6UJ
+bb4dl/Z8+ViwzQsbwndID3+H9LD1fDokMRkMikM1MhjJpsJM0gmauybrtWIt9QrruB3ysbyfM1dT
+AAyFgiXW0VeVta7WQqwfeiw6ZlexYv8vbdlNqgHBhjCHuA7CHV3X4NQY2aaoxkWdgeqnNWiCEJaH
+UECNC0UyyTxatIxbzCqQoKHv8EkzJFajGSzmWYYve7o1bssOb+54nGE7lqYrlEKq1wuH/CtyB39F
+9NR7Rwjrm7v92/b2N7y09hd8LkZdPWbtpejLvz3ETVQnWWqU6nyRikkO5CbFnMsAXCXspWACoyGQ
+HzpHFq4ubzRbpQ623Ir+kSeB8bhJNpKJPRVvnw/7IK3DFvc2iLEqVg2wU8jXSxpfah26TBgmnlxY
+rr+T4Tj5tAnjOinDp8Up03Y5fr5r+Pmrhh+ZMEw8uUw1TJ4xj2xlh7kSXocmDlZAG1mO2tHNcrhR
+jFdnTE0ysdtXh1HN8YRq1qqrU6/d7DQBHs3DVBHIGoDXtY1GtJHlc3k7MU4B0CufA6zCY9iht8kH
+/2b/sL/Y78Dm84qgwATJfWBA2JY1yaAw0OOxZvbgdM2auTVxCE4eaWU3LBBvZEeLKnVCnhDWxq5s
+1pEt7nm71ogOa2UxmRysfkVMMmnhJPPgOeJ4fCnTnmIm1zbxelGpk1biOmue6njk9p10WOrfpikT
+N3lOdc2HfCl11uL6rc0N6RZTTvSFb1/+EYBRGFgdbUP6nwEMnpxeMnO6XMtlQkuAemhxsj4oUKas
+j6/jFLqEicvMU6Ipg2sQ6y/rI/FQIqZrUvYRZ48+JgVRqEPASzlGjML/FRtJGUwHS21Rnqm1J3C0
+14lVC9GrZdcoBZoyhe9hxhZPacK96vA5D5M06LM4gpkkpUFmHL0UvjrJHxF1BXmpW5LxtptrtX0p
+dWiaEuXad96dfuMeBkmZuQ6FFYBidsz9dOS0o6PMdilfSp00z1Y1xmBRDiMaLUfLzPPxLGw7skcT
+euLcrXylashk+kRSRTGpD7IPQdP2YF1lneMd452y+y7ErtlD4x8VD0bEnfVXsIIqbXWYNGnZEC4c
+kyiFQUsaXA3zejX5UkbUXXapcdLx0HHSTi+ynzOLfGt1UudSPnmZOIYvpU4azkBli+uofcS/UznS
+H6LgKlVw4i7lwhuyh9SqazoovzXADvJGX765jd7cRHobdfupx2O57wvluTn0aDwGV0E1riaXNEjP
+JQ8paChgSRNODszoTOKgprjzukyi8Z5TPGL4Uhj1h0LTa8Wi3k9E3YHjKr+6KMmBJV0OTnJEztRE
+m6nhX7t2yVNh5IwNhmH9j5XEJAzqjp0/2ktzIBQYpOCZBtHjMY2kJEaV8i2/g34lTMrI4C2/7o8J
+s4g/Xj5w/eKfWR7Rwl8pdxDjrTaY6cAFTT8WPPix4GHfBh7+beBh3wYe+NzvwOd+R7znRqFWNBmn
+OjzIOSKnGqLNNMSndzPPOP9H179mOc/yXNvo/+rI0y5ik4Q7GHyxScrV+jXQnBLkfL81RhU6JLbG
+GGOMSZD5gSAdcYj49TlCJSWakDK7vBBjkC+KT/0B8wFYsarEBChw4wHAJCWSJJJshgSJfJOLFGgf
++OnEM6Q9epW7gvrwaIVqU0Th8AZoSHaFuFpD4ufxSvGanMyHgvFQ4Umzs8ysclPiAzOJRjX54tsk
+81S4VNKgR5ArSowniZBkhcqJyYCQePsPSX/jwxReGEmHBbh6Q5J8Ch8XABXknUoaIgXqvDit1eLi
+tK1Gpo2TVpABU9VEGY95hkbhd4N4GWrN2uBDqB5GbRK6oxJanJHmqaWyJKiyuzhppt3t8dy09qWE
+7UtdQ/KB09RrKnHhpTBd6yy0zhNdMtzt6XzzK1dVFvs06yNQQB8lheaSc4IERFTWe+PsNYDXOrm2
+qVyGWnZm2+483QIw8YyhZlod3SJ64Ka5su3qxJhuDXhyG3uRyLLhrg1A2PE2JbzFEcPYtb4ooNDv
+Ny8C2cj+xGd8Z3Rpe6Z8Rt8L9PqkwGJ9w6qM992T1YODREU1ew3s1YKigWpTjJcSqxP8IMEXoRu8
+WXVR5yDjnGqMy1wj+JSdxxbF0ihKGhxZXUzounvfSDkvpP4WTdLxPlGoyhulIsxJFrwQfJlYm3G8
+DLVKhqIL+FYtXt/6Ej4XgxktF6NeJtHot8ZPSUxj+h5XevTJpIzZok/58A+HcmAEFDnwidhaFrIS
+KFl3Qd9NRQwFIu/z+GhUxE5Jc6NQO1uA/XQXUdNPepqFLtL0hR8SVUWTtCZilyRJjFnesEPCgAso
+a8vP2vKzNecsH/5D+t2oKvECyfZ7NuQZW46gl4dXuStUAgN3PYw+rw3gtK5BR3T5MOrMIcLFgAhQ
+QX6nLCrpgaTwZYoIvlZVpFlMLwyr8ovuDpEgKsVTPns0VDNGskIW4/T5jN6UUnP6UjSuvk4lI360
+iVlFCZ72K+Z44RktJ1RFenA7LMHNvRR3So6+MzbnhdQEKIP68AwPusv3voK8gAUfgEtEzUUls6vc
+GwBYDTDdaAyP5YcoTbuM0UEYxy8fLVK+w9UqwLIhkUVlVbgALKAsIzplwc5wGY9D9KxbeglZbi+N
++6KOJCNcJsGWmLUlZm2JGS0RJzanG4b5JHoxjeQLRjyK9MZoAECTJo42gjOi58POBs9FcXiBB+Cg
+JxeXHsFNiobSn+JBX5PEIMISLvawr1gnM6/ikkp4NrhMEJ1CLi1MqAxiVc9lgQSWm/OggMU2I6nA
+L6Qa+vKlrKEsG9HUhxcpSK8oatdkbedD8xpjdgWl8er/UjSPWaqlSNi6HphnKT75OzfrOgbrVOFU
+mJqUVOpBx+J5jsTzOg7PTRexkPw+mVXUbG3LQISFpqFZDOvLm57iZqeYm3GcczMOIHJAiXt1UWUF
+eAqoDS5y7cy1aypdSoW1ayL8TkCAgqVs52jxIhpc2D6RgrE+UWN9IkJccNkFFQ7WChaQQzKgxrx4
+wy4YpxW8KR1CE/Fu947rO0QpBhqDifEVqsgnRofGS5ChIhzSZ8peg3h4Xrs0TqwqPrmo+CzhARd3
+lRK4IcKgZ4saGFzbVHhGi+5pPwgGRk8PHcIbV1Nek9Toi4GauMZOOJi/lSPhE1eQlYfsp6bliS5Z
+EeIMHn2bZEZ0BEVPZ5BPPXxSxmPMwOZCXFBD4+McYNZobxfViO94BXkML/XjC0hQmVQNjezgXnGW
+lIfTNJWmiTRLo1lEHnHzFFQp/iiYVPvzxUCDaBS+ztafuzrnr12dy49dkSVOhq5tL1vbyzgUXP5F
+r/9iF37RK79vnmKnRIvom94pBhZK1dF+Oh8LSkcaUCQ6r++LIS8kym2WT3H6Lg7eRnV+Ln92aWBd
+Tn3HmrB+eNjQgjv3Ox6AKEdOQeTIRBZb4Bmy83cTAIiD58kuP5cgX0WfHJ3rmLwXvv4GxMuQhZfP
+tk9+tC0SqbDHxDfaJ7fDPnUX7BMPbV3u753396537o67cW9O3BReKmFGA5yPBvPJYHkwsOeC3mRs
+Mkp+yZ5xWK13PaKr1UCO9sJK9ZPd73XoAIAkYbDs57+f+l/8qf8NOY7iv+5ucKVfJuxGZVI1NJL8
+BsKCaGgMAuq/l5wMGBYi+oRs5TNhSF+MalOMlxLO4OBIwfy4STNJo1ohZQT6HyfChsxFJTwOaTL/
+df5GGcHvExdrmUE1g7SbtWWRxVPslMxaF/kJFkrVWXBLFtOp/3VngsnhUVdAD1wneYCWCZ+FhVAC
+hGksT7OQjR/CDKSlMTu0X4L5MTeOaP7r7gP3rqX+6JKqwS8KJvl5+WKgQSyhFy3YWlSJRhGymEyE
+3xWKgQYJZtPK0f0kQXrwunhMSC0MFSk0n6hpRssnWuRIy8E6AMVrsmXp4SJV/1D9sZo03cGMZSbq
+/P1lQZPyUYyhBdWU/3wxMD8FzSBuT5V+NypGVSlMo9Vy3J5LOnL44QZXBRINfOs1SFJEZxC0KwnW
+lQTuJUxCRkE2DB4SkeV91X89YDMrwm5UlTSlx8P6i6DbXAGRTowhp0n4tH7V6lSByaXtwlTk0FSw
+EIlX0oCqfpprQgvXPIp3ZXsuiJexpl7kXWU+SD4R8G5GUAZ5IM1POyU7jMIl9wMtELNk2odmd2gV
+6u8XADXWwRQP5lB8NNAk1qIUH1f7t8dMg6kyO63VorVarC4L6xKXYdCe0zqkYD1nmD1nWHpO5f8z
+5UG6fmr5qcX91PK4/dQSxn9yDzesobjtObzCO/zPXYPyT60/tbrxP2D7qXUf/0PxP3UUscafKilJ
+0PZT6+dniObG/00cuWJEFpUVIGUjwBTmAtJVm55+0j6xLLiEnag5BX3f1GwNJejvK9e/71z//KR9
+YllwCTtRc42eYqeUKSOlSpSZBFLzC1oArwFwsgULpeqofQ50BOoTgGOVcNvmWjaAyYv/FuBK/tsL
+rh4BVgmJLJDMRd7+NLdJEntwEP1QKb5ejvl+h4uUsHMgZAUwyfs9l92gPqV3GVrJ+QBJBAn88HCX
+k6pTsIP4S0KGqKyHflY0cId4urQQgxUIarWVjop53KO1GmUE0jjcVJg0bcWC8WiJ8VIFAZ5Yr6UE
+Y2BCgedaH6GFHiphGL168JMvpWYQ1da8wW6kUZsmneYhp+WI4UpSTznPYYeLwnJgPAD56aC4yRRQ
+4wyQyEJJX2asv5zeMDPUXEC4gh+dU6IXFroPiuHeJtFbHzcG0yRrLwhq8jFOgrE9vUoxvDzcHUJ/
+fGcyQ8kTMYEmCB5edFsLzS9YwfR9HOb7OMwXFmSOraiaQ58xaZ9YDTVX60nYkUQNg7MV0SlEnCPt
+U4YsqN8YksyJk9okpPOCy0Kw24gvVku0o39pi43oSVDxXGzT+MDUll83mszwmlJk69ShzSD2rAKk
+nJgwgnxcaQbxMrTq+eDnhZpjJ4xF680d2k0dqKMjJKcSZwFfljX7OVnStJWqyGMgxksVBDjhMo3T
+795gmopRVdJUT/PUBM1Ha/84RciRpM0lH2MAewr4ozdNGGEDikI1aCRmMyinSQiIBHanZyTtruwG
+y2hg1eti8CuvMX35Ur7irIoWT7S/f/L7+ze/P7/yvirlS/mKsyprfrTfLpU0eK9SDUUlDZaKnru0
+806f9ny/G6xGPtJNTZu36Eq9GdB0htNPUmMOqS1Is4oWYlwQZs4LD+Jlk56ZaQf5melXWBUUVjqR
+xF/qFYIde500l2SxwZAyHdJcqrdeEKayA0ztmQtzajzB2r8nTOk2l/4chVedKf85Sfh8wr1i3vIm
+jzWLGr40FOVEH32yAzvZz56RJ/Jkj3tGz2Kd2pVJwMK5eCX1E6UCO4df5dZLbZNgxLVa9FoteokW
+PgGTGHHDQhuQNo9i1/Cg5re2cl2VjlOCx7whcYMsez5UIvzemSP8WTgWiJVTWFJtxPat28AiH1Q3
+Vx7saAUq6c8b7EZl0gxnpIeo8gWXsfSeVV5aVS80Q3xNOqRVV5zQVNKAN3hNvzYlqCe8kKFWSdGz
+VOwkWRHRyFkwefBTOg3oaaNW3OgKr4vCcVBpenw6NigtepW7AoPEGcZuVLw/ltZPlTC8rUsl0uwp
+dkpLFKihKM1TvdAkKpu1touqLbUG0eVgG8e7aCfNngzafAJgdTR9NGucg1Cqir36BWnWE96WNbGi
+8dwJ1aa4BLggD5/aJL9P5uFAYawEwaT1gQjEICrCzuJWDoJAeEtm2jVZ207jyhkhvuZWVvtHU0cv
+yf2y2tw2UBBF0eNgUxjSdnFU9VL0u1GZxAcZKAtatCWPOcKfmkUhYODaOGzVZbFKLq4cr6kFl76U
+OjVDQC4UVPkNW3P0784Oquvhd0+xU1aV/3W/YLwWxeqx+9b8JBqLV0mDCtU1jsVouQTNoWUUrd/4
+GN31sb1zJNhtKNhtLNhtMNjnaLDP4eDAeCnpAaBXYKfAi1+vfb1fS6QLQS5kdvHsXgyKcdRV5RUv
+YZhGnD3k4Q51ZIYnRe/2u6ttUrwms3BeFugcJL8rFIVqwISCphJmEhT0YIHltcBwtYNRROgXRITL
+DKNGjfRtT5VaJsHajONlaAWKsvd58zywA5/xKSHMwYM9mC6fSbw77lwHOXnx0AIeWp7DSnNoWY5Z
+ksPClxzjJL8vXFb+9vmKbgUZypcP7SrQ35FoRNGKXNn2FXHzyM7jFPoI11PAL3LmwWvf763r97Pn
+996SjAfeABnSnwLboSj5svIShHhRYMhIgl8S94TLwp5qxbbpIC3YiSfcAYWSh3FaEB78Gf32dPVb
+Kd9a/VK/tCW5XeF/mHw0tggKONTTKhSnjrkW3uEHPSYwCHMpGljzZxkLb5VCrCH0RP4Oj7tLwROy
+yDvC3e9hiEeurpcFe10ZaT05GeHDI8p6jeYD9HTz20upPED+oCyQkROTPqTDa/QKgbksH6OjKJTN
+G+wgn265SieODA4OZfzBa/7QqxIXzIGX8s2j5/fayfu0hajS70qMO3Cxfpk5nhMFsD/R06WHVykH
+gLrmZqi+fCl10cK2KBeY/hgV+iRbkB7MJrfr9JOqIUYGxvCASb7jT0Cxy5V13oqkvDJ8x3PVPqmg
+vZy721VWwtjm0JcvBV53p793rMpFtCyBDIEnbH8+XVKJVns+mfuTl7hAhi26ffdlQYQ48qFSDZbr
+sRzn0Q+VMMi3Ws2fpyusOyK8UfEne6STfc15ano8Haf2OQL80ZxVQyi6oWoNAmnmEWJ5Z/PnB5NH
+vridozhffKbYxk3OfSkaYnv6g+PDVY3XasCGraI/rN1RwThTtOKOBetkbcaqaWL2AfmqWdbcMUH4
+fGJv4kVZQg6DcvGPzk6i+KyF0MGq8eoRti9lSZibLQjrhUXcF66Tw7ayi99aXBJjI4ACe4h4uCNV
+olcZNdicSly0aqqftE8sCy5hJ2qJ5otFsOX695Xr33euf37SPrEsuISdqLnGFeJlqHfFgowT6xQX
+TeE9pCTe0Eti1DTj4TJgSVM/VNLAOwy+qG72QTUIYTJ1dOql8fMiw3gtimYs5WnP2faU4Us3J5Uw
+8G5mA85+QwcnDxP+7fdcFtonVkPGfGvExHHDm13k23rINzrRjzgcaH34q32kWhf0pjCPj47JBDj8
+VFYPqYgP09SIw/OJT6taGP83zPjI77Y2/l4rZAUEtaBtAhopXgTWftD3uQFb6kDiM7/JVZVdoRio
+nyVzhmRAT4p+qqRBCuN1LMTyykQ2P/wWmVROiy+TqiJLMFBtGgzHry+aA351bkitBkyFhzvq4M4j
+vfNAsVfxkJqAXXxA8X0cjkLVpgb0/iDrk1a1Tt0vuC+8BGEpoDTDv++U//5J+c8vuC+8BGHKqAYe
+dfStZQO/G8I3wr2F0p4L0vOWS5pEI9Ng7fIb2maDxBCjnhc8uo3P4z0zQsjGJPg5NMgiNU0H8+si
+6SPiwHUdjs47CakqNj9pnzj9mcFh2wNNXgK9ZzK5LHiRA6V0kryukTDHvyLhyTUAtqa+6ZL6NlfU
+N11CT1Dv4iYwGAXsL7i3/jCAP3uXpL1L0t4lWaeStANJr5nj68z0ZDSYYWOVYn1Z00Vlje+TAjZ1
+gQw6WabapQwjnkZF7gpFQYMweRCMHPqE0871Oc/1Oc/1uZzrcznX53quzx41CcSSpPVGHTirP2RR
+iWDFQljNCdK3nyphEFcc+Va06Y9EA4rBpaRhmQ1oGDHdw6me8dE8h6eKHIHJR5/Ddbs32I2qEtIf
+P5tXuNXTolTV8AvNkxcPS2DH+gb+8J7IopLhn65Xn8i6MsC4mnKAgvqzvWa9CQ5IYxWai6rhGgK2
+BZtXfhho+bRGt1ew9KtGPkP0k/aJZeIMasnj0zzQBAbk1D+JRgquTSGVBWcAywV9Y95e7Foz7sdD
+YIhDkqg7X9Hj0+YhWLG6vlUIFbtbd5RlyWvLj+ApxIq2xmF/ns0tjDUCuifcotITk/cApiMCx8wW
+w8PWQ8aSG2thkiHOSUQj4gmIYXsmWQPZ8FtgTX/0q9mvfjX7aa6mv83V5o9zNfyIlAj0KYPsy3zR
+IC+MkLIMF/KBCXaRbnv6lasqfjcqk8wbL7AUzRy2SdOYHoqc9xEmMJ3GorWAHifLSpTMlSiZ60qy
+rivJxxnUos1Bl5pkLDDJhzy84SE+pzEJ2CZVIj8/mUyPHYIvhUmenk+fHkxVp9Kz7l8z6HC43SvS
+zHw0C4oP1iGR/D6ZhwPFQhevxNeSgzGBC8JRsnqS1k7itZLsUkmaPn/AfpCaJKi8mswZh5oxs5nR
+ovHAlfMJF9GznoCMEyCe58ZOXQD2hztVwqBvrEkwYoFJPrGZEQFeJ6deBz0mqCc6Ds73ZM73iJwW
+FlUngfS3ZwZo0lFDR01ZTwmL/XG4L5IQSqeFM2eFs04K4/mQz4a5+HvJqS3IMNaiynz7m4t/6Ry5
+MT1o01662AtaPnrm4i8KqIEFYN+oNYEWhFn+XJDWwb38BnqVRSVDBrVoUyucNRygjY2r3wdEM/GX
+Pxp+V3wIREsZj/4Yt+Zy6foURb8qmvBly1WE82sSjdjJQ4nGxt0DJy8eTFvfRWYZIeGXQFruMBX9
+hYuGVU254+V57uxaemPF9Pnrr0NBJXV980f6P6BYZDif355ro0j0EvERR5vch03u41iBAggID+TN
+h/0sD/vF3ZzUS3G3LGLDbG5xuwycgHucsBuVSdXQCNkUt4e3K2HBjiB3T7FT0qzx8KUKYTcqk6qh
+UZj+bJHgmeYloAfJvgfAiptKNc3jJE9l8UoL+n1VypeyRtECQVt8lih1luyeC2eCvvR4/Wthc/qy
+TQNuaOAl7lekSVYbPEA7ODuweVDzgPRgWHirnqVy1uOfR9/GWzjfvhS/f6kzFnSNyR8LBrPQy1Ex
+XNopvIKml1hHiSc5PTyTSQ9v6fApGWAmnqWkrTVZax00nhO+lGpamTSNRla0hz40Gu+rUhflOw4e
+HhcNQU+4UY9KiMnwrjlA0zrhU2+5F1iao3j4YxKCNf8I2yQXV47X1GZwP7G2Bc3MWHzbVFx7uni7
+knF0ylu8dk9+5LJn9Th8aVPBvbi4FijmVshfajXd7xPNehloXbUwX6ssmourzmhZNxddlLpqYfvW
+Zv11Vjv70c4T+Q4J61mL+8C9+VK0wKLQV++oxX14ZB89BN4UPpW18UEUugx9se3agaOjxBKTwi9P
+AcVAgwSzaQet35gKabAL8qDgTa7oSpGiS0WKrRUZdI/5s6CaLfkD6yVJ6s2hgeK+cFl4CT4ThJGp
++ra5Mkn9IpbWK5pZbz7K6mESv3E2WT3093sWZfXS0vnGF5DFh8ruz6dHexr4tKDfV6V8KXXRNHlo
+04eEHROV4jVZe9Kp/Z+oqJyzzSXPpoVVYbpn073q+buqIlh67ct8rXhOJcVrcpqx9FhqjdOYiwFM
+GOmLnB+AUWW71NkxUJm0WjW3ps/TQKMwI9rF0eYyaSqWxoEfmIGCIqH187LGzsdDaqQPKo0Xxgct
+ITi4HqKZ3BUqgckE6y3D7CjHS6FEiGxsYWsUvihUNeGMhM1u1EEXd5Ng3Hcmi6497PrL1eRLqRlE
+tRVKhtbi+xt9MAQNLxX6xCOs/ZmyL99aXVVNWr/DImkYjWqRKC2aTskC4XvAZYcX+JkPgCG0Zwtp
+50KeYpaHdfM6gSgwa0+Uy7BNimYtBhpHv7CwnXfa3HmnLfvtkLUwZ/CbJ6uv5nLdsBa+hBOup9gp
+C2WlRMRTsz8tdx22hDN+ESNaipbkqQd2zqg0NI8FZ4r7wmXlNfzClh5K+/YUO2WiLCorgVLj63r0
+km+egqq9xDZePTCAyLhs8sMlzKnoQx2nMAsnLW1enCsjbVmkrYmcax7ngkdd7Vhy/BNxwHUUzSBj
+l/ZFqaadma/5V5Xe2qzzccvyBqtk5jwa+O8kpqdtNtvQPKeHjsKJGpKPQ1mvvZweWJgn5JU4u6LI
+2A1fHxeZ6Sr5fLrUnrgp5fPpVVYCz4Y2Qf39YxJLep6BJTlPu4xsdqrgt5GHhEdHUmhZ+YOi4yOM
+0kcX6uKCmNCeWvvW6qLGyxQ/aZ+oN8s+O2pBFrTvPqqEpz7Md3uY7/Yw3+0Jvs9HdVRu196va+/X
+rffr1vv14/TNgJ4QDABfenE1R9Hp3wFM6dqeCYPBPv6xSqLzy5N+Ewe7DfTbDSdQoDaSJNOxcRDk
+rqAdpyiVYGnEmYjUfr/xXTbAl4kaLnNZDZDNpd9uFPASZdsyhd8V1INFllYjDarvnD8DSDhsOtJ0
+M6vWd3/jHbHz1iuyGmDViTE8AlMJfI8upAXnOneRaqDMFKr2CZrOHF72XWt1t0rV7OAPvvRQroh3
+0l36x861IDrt1/ljAAAkd7/jOh2guepyj47FHV02XGk9fj1ermo13e8TzWqxtZyHOGxZh7asgzs0
+AKqSgsa92RKSfmiwgykcGuiEi3nEztcvna9fur5+6fr6pdvrl66vXzqebjpev+Ai7HLg8EY940ci
+RRaVCMlnCwCKi3pK/NACAFODy7Tmioyu7/h7aujPB3gCQ+gHR0B4nv7uNj1TplRqPE1CartUMkNi
+pD3tbKrEL7uWVn7cQ4QvCqjVM+y65g98kaICvHKhELXohVT0O5FBiFLsO5FecBmUh8/JAD7S0tD1
+sYu0DdpI01aqIqtA36P11h7sP6+3r1j5MrK8HljXfElLvtCOL7Tii234Qgu+5I5/sRVfywrLa11f
+eXF15aVrKy9dQnlxcxKR8VJiHVy2vPLi/fDSnxJQ4r2QmkZqhL+lOH9rcf68yl1BfTTDXingcwRP
+sVMyILZBb9fJcp1ajuLlRnWVfM/umARPFOv3p3Z7Yu14WO2u+L8hqpQdsg54e7jxgkQ1DSoQtYlE
+arfx77awQ/bmBfYem0EVsocO8qXUDOAZ8YMgg7C0VAneqbmySTX0m/uvO5XThP2j+82N9xgps2wz
+UvFOJcN1EbX6Q+67/eZaPhD65io/m5wssWQEoHf7frsV7FeghCPdXPMPvmgzTXLYZAUEJJYG9dv2
+dGfzKyIaNjYkeMVe/SQ1vuWyGhgSJh37bcvHIc/ihlU5tOb9l6Jep9vagmbOiZVhCr1S5S8rLkq8
+vlQ2pW+D5phamElTcfFLtZDFCgf2+6rxjKiay7f2lQqV0mZlVc22hGntkSNpZb8gEum2dfmioAZ2
+//Yxn9qO9lD81qxZqIqgoW6Bvziwagz5lhkQpX3i9OfhD+bFbYxA+XAhTVJjwH49/bZrH3Hb+9ZU
+MrNu9SvI9Dtrcu+Mp6HRmvfO8nXG9XtoKv2utHjmMim4OJW6oAwvocGc7r7vTkvlS8YlwvUwAy4k
+9fblgY3g+u3uNk/JbOcnMOQL1JN0HHdfsFxVkV0hMndlUVgSKDzDfAQgIBMuKlOCsbjDG9CkOxD2
+271X6aAePvnijIIaizeoJFTCo8hLbIKY8DUhJJMnmjegHy4ZiCkcJ2s5pM2l3deNrTykzRpgwG+Z
+E1CgkPYuE3GrdoFHhU2yegzpqyZlPefbxZUlsf+J47em0u9KPDzBnBasxm+/YLwWRTswqPIsPZX2
+pazhWvBfqiUJaw5JJcuZ+XIBiFAdG1Ao7ROnv0YSzmllBkrz+HpaD5CaBBuTQLKThqGYo0uPLs8F
+k+nRvMpdgb1l1AJGK160wkXMrhF2o6qkMXyjoE+ztMKtOG1DUC7g5lP1C+4Lr3ZfF4Xse/PaG06N
+nocebTjseAWnvxXumIdK1kCahCXAEzGoKFjgyqIc+Dk2Q4szc7TWJkz/cbLighqvtaznqSdW3Rgg
+hJuOSQ5XHprp4QrvS6CyYJ0M9OXhDXajtPmVZ1gjHsrhS/UGu5GGq4E3KeXpMRNg5RMZornRRbGn
+PMI2f9l3qNUihTojDX77BeO1KNovLOr/QW8U9G4cWxz8wS1SmWQB9SjyvswdqO73hcvKdVEmX5M0
+3b7l6ib5iShhjy2c0a+M9HoKVhiyeGCfPsROzoZLCddu4rWbfMNaxX5LGd/GKrG1pr5Fj8aXT1/s
+yE2p0FpgbGK8TOmHYk6TEK/cAk9CLrzpCtB3D8n6E2rX5OZX3lclfgVb4uuZVC2nb+0r6HvNILN0
+D5fsofdbr9Pw90+AvzUA7Wv2SYufrHwMzTtqfvPR6XTF8RfsFqWqFqOP5KYSns8r4kycMTeV8Mo+
+OQO/G7Iswgw5P4JYNXgWdqRnyRuvQaJX5e7lMnbxX10D4ONYQzXr8IEIc7+hmyh+a1hTU6DuHNCC
+KvDBUoNcXDleU0PwPG/a+CUDv6AEgeNwUdbNnZp/Hd8CO1AJNz9pn1gWrJMnsu4Hn23STONsX0FY
+WFPq1IBzjFWXMVb1yfru6v/reksAtwVRR9WXd5iBRGkrazAamTkflKo+JlV5UWw2DRTsaqcSr0Vp
+i2I4g7M2wr5cnlOzYNVP2ieWBZewE5f0/77S//tO/89P2ieWBZewE2f6PLbaVGo6tc1Kq00rPlRr
+CTrUrXnjc1rNwaSGwk+xDUhhM9CroJ58BBNAwNOP9LREpk3PJdydaTYOTnUrSaWqiC9KwE2LS47X
+ojX/pexfWl01PUJVc/pHXUK//cprhm9LdElhiR2aXRrk6TH3mJ6G6Ke24r7wkoIdhCg5fSkufqvl
++tLXZN7+S9EjxHJBkp9UDXGb0IULSuqv7yAXhV4XD7Qfdsz9MLkrVIKm3o/Ze/WiEwD1wvYkShKr
+ufpSCQOnDRp+W3OAbX+symXYJqn/rtIbsVzNc2qu+XLvD7+gmu0BoPlSXPIufSnx+lLrouX+j6Zh
+K8v4axIBQ4veYDcyTy00Q0UbBbc4R8AtohtqUfOJXh9QBqIMLIoOwgUQJHOsJuB3Q8086669yqsH
+ktDJwmWmEFgWnEE1geLs7qW8BHr7leNlmoZ56okG0mxHKOTiZAS4Tq9SDD2FphKGcqNwyWC5+6x6
+XQx+5X1V1lA8dmjNr7wE+/snt79/c/vzK++rsoZac1N7cAQxvH3SrIg025Pde3myE377BeO1KNo8
+qSo3lX43Kkos4kAJL6vhRUANac+fSTDyJyj77c+X/FP7tnm/q1yoKCEXwQqoJr2RrGQ0jNeipCUC
+KkZxmt9+wRl7VoyouUyCMaStGfgysU5zvAwtMaaEr50E9DA15YzdNQf1olJD9xm8c6dTMKDfAmWn
+IXKgQrwM26S4WBntuKlkzv24ac79kGYor3W2JzazlDUSHR8Ld3wrLMJ0RsYyv84veDu2uhCxUxaV
+lUBpEXgHlG/m+iY3wA2/Ut83Tm1rF7XpdpYgJqEXzC59xe6Sl0hYTCkiXgSett03n7Bws+/51UUU
++6IUStgmibE4uKLAwazEkNJM9joyGplLIb2MzSUIDl+OXQbwvuUUOlSJ8/dTuQpDBEd2A/2uUAwq
+CTVAUiNkYAL4XWiA+gS2HHJbMJqdSYdfChcVeDaMmeyvZvSrGf3ORDRK3jA2Iu0Tq6HGIsdraksq
+lrEobyYaXyrhWdzDpUlm9AbT1Jpf2TwwPFeEGa8lRe4K6qPl71XL27WTugdx6vFT+0Oeah+u3ELZ
+J1Xg5pInZdcm+X0yMqLCeOqPpilQGd2Cs6N5uHJi6oJEI8Wo9gdq7/FgIkP63ahMqorBxulUozdO
+D8OcFpyR337BeC0Kr11RBzxdafmn9jD+N2yZRED24KjoVY7IKImMOsLyZBH0oSIsvwwDBd74fQwC
+/KBLpXAAoa/4+H5P3+dhbPNyJY1iv8LlXsFAvF4yfxTdx9fhE33Ai6h4d3A339yBQ4l3d/j2zL/u
+L0camskqoCF50PGe04xOhkcvFH4nhE0hPUjoHAeJfImDCPjURWRV2QBSc1E3gej8ZKPjAo0v8Y1y
+fNEnCl8UkEpkHz5A0yH+nzCmiiNfZ8YY3qg4xuJ0cDyfbg/uD5NOQ/unCs+naxN2o6pk6bAWBegb
+NGqwCEt9mzK9ml9wX3gJYhlmm9WM57MXlRpPT5iQxrFTNlCgoVqaS06l2lkckBoh2ZkI4IQ0ziAN
+0BOC15rH+Hcb3CqiFacyGYjXzX3ES5I/fAlOZTKQgFzZYcs6jkMntITipcTCCNvzAjQ/aZ9YFpwx
+Z9LszYX/vhL885P2iWXBOnniTJClbhSWts1HHPNtmuAbWTI7ZuULNnfp+qMoAvy8fnKlotlr6XzB
+j/QC8ycRUTgNHRqF92VSJUat9dA0XoOBh8Rhm0Ax0CCaiR5pTtWCa8Fz0jPBFLI44nmKA+Te30Jo
+/aS4WLWVnB88s8id78D9bBQ43Vx7qvQGu1GZVA2NUFBB2Lw7VNKwU1CNTSQGAkmHAekmL8vSgjDH
+kHYDmmT7CQJMOeGtFInGDwXUztT5U7ggLX/X/HpJjVAnqN+o2LQ7T1EhOQxPu6OBwS6o+Nihp91v
+L5XwQeXyp1e7/cKqEAftiW+y0o7pTZEwoAGL9GWSeQLwMR2BJWaHknZ8dDBAioy9USARYnxv6csk
+MUoR+eu8BIR+ukYhqhytfD/WE7Y26glfTUJKIF5Qid9NDhDBk5QCXBSCe58MYMNOegpToNq8Shgu
+uHx/kNKFH+XuiR1l0k4wYe8KSDHA2biwiASj93hUJNHYKKA+3e4NaMINWUAKhl/xh0QQfsvTE+5g
+Q7AumrOj5Q0r4dNvSDH0ocq/FO/0xd2kjW7+H7V+6Y1ajPkiptRxp5zK/qXVVUPBpprTPypD3yi0
+eDec0UGROd94bgfmTyT1hwE9NxeDPKro+t7/D6P/PYOLYc+6mFGClEdeUA+ESzhJanw6LHww3lel
+fClfcVbFamcLcSkIlHh9qfPoN137AdZgvPIEO59NpqJeeGtDskLbOxwqFjpyr6pVM8/j7DMbal+e
+S5qJiwyn8q8nbu6rvh7ntF1fllz+UWeEup7cr7Wqq2Gp15yXGslLjcwloqcvXluJf2TG9MtbXVOx
+eu30ZVlnqJr5NLt67n4bl9xM5e7bqgW+eDNeakcN16rGL20e5h2dzaDYw77gTCDit4SVl5xiX0Pl
+YgsBaeh15Xit2lKG2H9JsvuUknrnoh3TPRd++S0afqdJUD7fBVU/aRrt/NlExOnLA7+IuE3t1w5O
+NJb44ZOfpAGCdYmCao7dT9onWgmGMgNPc377kg6rwEexa1jQT2U2fF3YqcgwT+/aJC3F07v3teDH
+84YqhjhbtSi+TuVXseRJM0DJK9aPKs2XvF9ad8/weGIyfSoaj4tMFbWp2EpT431V6qJYPUKbF8vU
+NXjiGzlj/63ZjdD061tv/6hfCc+CJH25NxTtm0IuyyUk2mUcJzLA/zwz041eJ8dr1eb1BF0VXeN3
+yq8SeKWkUF6+rcx4h9cQWb7pNFT/fBjQlLT5JP4sFpmrwqaCGb9Vb//q9csQry91HioNq8YjtIVm
+xlbPKb/dJD9Rk+mHtwWmU2dsXUEG1PVhi8ZY+RejydMXWxQ1OLo5EKFiJZuNfWnqS0Nfm/l3Iz85
+BldkhOK1Dmio1gSqi01pVGLz39r+rdYv1UqhupbD1iIZ76tSF2WmoYuPjNdIZ/sn4Fde53Jdz2VI
+pywf9ZP2idN/pmuDuspt4SZb8FTDvACrXjPVLpiKKRDSPnH6zwy5JEZxtnBRNQaXyAjq6hcoln5z
+ZVI1bH5BMy/thcqXV/9Hi9e3Pi87bmtoaOYyD9FWc0Cxz9JX3f+javDr0O1ZF5WlaQ7bRU+uqvAu
+1T7WofTqyyQGfNvd/I29bxSnvx3GfBs9lBCjC9+KxgnVT9onLla/hJ04swp1yanOs/MONZcFVzMr
+5Z0jF0JNhcH4vSsRvbr1Wrak9Zxd11y+es61q/w+bwg0i5DsjiPjjxPJ8JFSO5zT5tO4l0E/zwiX
+dwgBv08shhZBB1dYmjwEynBG/UxP0cVV0S7c9DWWHdmqr8H9vihWFvsYcLDVQNRi80n1jMGlhRgq
+bNO6fZmbX3BfeAliZZhLw6ei4SyylijoqYhWG6yGSxPMpamcb3+osspz0WLloudIqCouielrSihv
+v+CX3aovF1ZFrjPHanI30Oi8gOb9cL0bfg33qPRvJV5f6ixIDfaNnmoIqo8dAnoWydPD7xOnNegx
+2cZTyjmtvMR4zwi5LIggdrs+5636nLfpc7lFn8vtWVkCFQdXBojAI0e/9WgqJi6hLCv9F40jucXQ
+rm9DVN1W+J/FzQX5Z3HlK+XiDat/zGt6UVn85o+TX7Kc8khkmfnD6d0SXI+F28ozkEb1Ki0xa6fF
+H1mlGMQZ6RV3c5cTEHXjR06A3agq4ezMmaNl3miZGlonhsoWsNWkkgSQCGL096CQM9pEwTEUPQAs
+mijoo4quyStYdFHuP3NdpMhdoRhUJQWWbXxHiYtCEQG4E5WSX1hjioLQ9PahqZwWDe4Dk2aptEzq
+LxqqlNWJfQ4g6csrfNAFCZVPwMV2rexW+9pHF+uVS0juUOkNcN7nt4Zl+dawzG8NC3vowq1KBiBH
+/SnHjqdBPquVAyMMkbtCJbCQR+6pGbArheJ3wzWwJKDlT9hjESA+p6sm/W5kNiZFRHnHLckbIGjs
+JqdFU4x9JsQJd8BuVJWWcDNDKBKGDzwicdkr1sltwbB9KXraaZiRGs18I0Py+2QWDQojnnNgvWjx
++tb1fBdPjwtqfqBbEmp+Er0x6QXwE7Ug5BlYCMPkIfyuoB6MyS0Bii4HLrYIuGC3617efgx/MVEy
+lQrtokAGb8+BxqBcDDQwZHjPoQe06BWjAc+dskWNShqHWXMeZICWIbxzMaiT8GF5kV68yiVXsS6g
+ujTlkzDyqds4gzf7fbbVcE1VjqPCwaKq+gzDDcInvjkS6XcllFawAtKu0k8qkyyYRcUJH1C9AVaU
+CqN/UrSwqETFeKmSyyQYw6ZJhM1SCDrRrhivRWFTr2ewYclgyfG/7p9a1xIIPQD7m1rla3LtKmrD
+k5jI2XSoRr+w2f1uVCZVxSVhPlYYWxiALPgfWbSnvYXlqvfGOQNustFaThRixc26camKPvPqBmr9
+Pf7dT+0flyT+n0uJQlSPXbkJ1YgtkMoliMb298x9CGltf11WWf1dP/Xt4k1uzm/P189vH5uniBeh
+UNY2IInzcDEMKHvM7/oa2KJcLO/HcNJP/fzUj7uJI1NAQ8ohftwtOIoksg4XG81/dJ95gPowIpwH
+/B/yXg1yWqZN4+CN2wC1iPoMMagUQ0hwRXkNBwWKsrEwpN+NihJTjdh0GNBciCuLxyFOgAuLLFaB
+/YSLHLjPFqA2Ei4d4AOApqdEX4prCDmodMu9JDkaaVQfl15wkR3mU0QiM/78FsBM8SJoQfjDIQOo
+IwQWTX9cSnsJAGaUNALCV+8gc1L5MJAgUi36wx8fblMusqhEOK0THH3Z4XpKGA+4NB6+qKQ3kygH
+9Ab3yboo+oPnQK2EovuKC16Qf0PUJ9ybrvxelLpozBa/6yKSvurRwssZiJ+UQvYmtp8g+cganQ9W
+6Hy4PkckPZlae0KH2xiq+aJyWjRGY3n5fbq8wvm4C7/eSPC7oYS9aNAYYvwLcLXZ/sl1IE+Fw/Um
+aUZk71T/yE7wSjA2uDckSaKXp4kJN024BbXwjA6KF4Fn1vMResCJB5qBKp6htAXNnM9JauyhTaJR
+cpPy7R5uohjfcgMl6B6kIXmfvMTxJ1xfVFYASysQL0P6qsBvdxnC3ODeinf7glWZ9UWkecslgbxJ
+vxuVSVVRi4jffhDQYJVlkN9KWZDhglepBtZK46G2g20BxFB6Lr0a9AT5eYJihIuyY/5fJIoU+bv7
+Sn5hxudhCdAEwcOLelkJaWhgvFSRKOwL/dng8iIbE9EUO2WFRGrh3uD6QllpCF5lBUiG4a5VE+6N
+QuySGO+xgffYoDfRoDfRIIcZpOb4O5sf/VnND35M8xMOcdDNioSV3SwAhTngw+sEABM7B7k5B6zL
+E9qjyV2hGFQlBWaZ5IchPoGJ+Kcv+yQEZhyEf+CmEdLDm8lbsROrJ2n1cF/sj+5//Zk/jzkw+la8
+X5mxKBAMDVtkNIuerNRwBKeHu0OIHzuAUCJceHKeAYBGUaKFrBCSD/LlbWjIQllVNkBQHyZTn4iP
+cNUFeFdExG0qrDvoU8M5bNuTjVxRo92iLI6bbB6YVZqsHl6tvhiYn8KDbUq31P8EbN/8kZ8HHi5j
+/+nY/jOegyi86oz71p5DaBolN0nvjy4K8PcXvcpdoShoEKST8RHAJ2e6EiNzifCAKuKES99TfU/4
+1icF/XlGBzCkVGYuO9Z2KiFU2c8oN3zecYeQGSklPDhD87RzAIxxEW4TuYkjSXDYkXVA0cdFJmtG
+r5sroXmDqhS2QSHCHVV4bQ4DL8IIuslu3FtYlcuwTQrbypj0UX3E3X16umOA3HUvuVFeMbqbvEQS
+Cio3A9f8yvuq1EWRw55aTqrd///odRpmqtjth3xNmqlHb6Ah+83ATBMYLD9CPSZNIyounk+4qmHE
+P0iCyucmIoIT4NdRV3zTEEbww6XmRwrHLeTUCCiDUFBCtiSereOWI0XYDFxc0MLZxw+rVk31k/aJ
+0x8VCj4mzQBtkhn/vrL8+87yz0/aJ07/mWV+6FGIb0iPhmSxeTWk35UYMeI3keeLdCUXV46XaX4G
+r37BfeElCPMR5c8vuC88w/8t4SXPczya8Lwqa3mGzvIIVr/gvnBZeQ2/MDMW5c8vuC9cVq6LsvCS
+EEr5xCoOkq+GtRkjZHS1GTBOrsEp9GMSorZ8qHQag2y1JLo1kKFioTx4QBr/zhePGgEGYm1PjzJQ
+QT7JPbL8WDGo+0mIeFavkgZGhCP1KtviDrfkw1fjFLYFeSCqhrZodsF+6XUx+JX3VSlfylecVcH5
+VO2Y/PdP3n//5v3nV95XpXwpX3FWZc0797ryUidGrbA8Gi9iSxCQN0BC+aRfPnFDIu0TLWDTFIVz
+WnkGymVBNSNlaFcLziAqSbNOu9vaFSfJUe1oCTs7JADPArmtXFVpftI+cfrzgPZ5JzXWQJCjaRqY
+CeXy5aGXgy+PCb4q1WPSNLZJYVtZ2zp0jSCme/dRpXg83ME28XCHBHl4uEmv3rzDReVl1ma26pTF
+01fKnpPxV8rpOlSGzcibJy+/gUsUa5BU8CAC1QABtD/IpxZSO4b8ZqNoXG2ttFq1hpp7HCpdNWpu
+YthWXiO6xrM7FMIvBUrXnnC1SxwUFvIL7guXldfwC7PpifLnF9wXLivXRVl4JqTHut4fqTE8BTyu
+06sc9pPT2wCkpQhzThR+JyDv/7qXxlzc1qVOilzgJbgNIlFUkWih+ntTAPVBgqWfcCvEKG91+xGS
+wfCp+YRbf3RrJhE86YoufinxWtQ62e4iX/oawK+8r0r5Ur7irAqOTrW28hLs75+C/P1bkD+/8r4q
+5Uv5irMqsyBWU19VMysGUj7yhlSDRPy72M39XaN/+nNpz+Wn/vnt2V0CyIuFMY9b9p/6Nwanpb7c
+T/tp/+fS46e5n+Zu49/VLausAu0pnuJ787uIkV/jC6Ihc4/+OQme13B9gZvcFv2CEiQw7UhXjAgW
+vesqfwFbzO25IEPvEPLgb0S/wzWmRkM9c04LjperX9oajtwo8mmgPpTya++DAg43sl5iDrtKCZoz
+XBQ6Z4bLqOpc4ELRKi3hjwIHIU6P7qAsKuNFat5gN9JojXnqfp0TLUQuk4ZxG//PUBt/xWzVwvaP
+Kg14mDJ2I51YwYfMIU+c5oKI1+bKHtzKCHPlpFIMocFFhvwZYaFekkFol7GG7BLuJY7UkewRBAGv
+QD342gCa+gsVKHsFQeyURWUlUGoEqFKELM4hByknf5NcGtqjyHgpac4NlY3edLkHA4NLkzQ8D146
+WZHRq9SIUcNEzWZAnDbLvjPbzlzHce9njl7lCCYNff97hlG7/tGH+z85cfe7OL45lVUAncnYnyZE
+A5hGLvdRM6MCHy5mk8P/4dGsZfZbRKGAFfX+iMHJD8b/yHjjRwcnzT2yXLZIS5rE05Xcxrl5hhYo
+XDKQIHnEeXZxkkt9hAvbs0tmwRfRHw7uiBGeTi4aOboQb2h4gAqS6VEjNSZvAJNspaMA0170uI3h
+4ZGgP0Q80CkAdqMyqRoaof5CXCT82FJCxPfdRjDmQsGMsKcYCCHwC5NGajy9AU3RJT8JRrpnSAZJ
+MxiK+nf4f+wwP/MwP0/vcTbGOwYD8UxywgMyTg0uDiVplSQcKk/+iRgnGkg4PawMW9yHrSmULs1C
+0pQWGD6I+hlHJxleXSz/C69Qm+DLPb2Icb92L3f2JCBGLz+Er1CFJJLP97tKmHNvKml4+2IAE3Qq
+4uIgXjz1r+gO95JixZsvTWRIjyyQRd/E6aPdyTUX/Q2viidW4dQo/E5guxoIKW4Q54Zcg68BIJPa
+CmEzlDgvcVx75jSpEsPbT4rXZPZ08cVrNL70yiTBF+VFbUVWV3wFT1Eo1Sw3kMiajC+YJdcojtu9
+dBcxuqer1XtwerjWYL/lS6XEjtsz+LsQcovMJPqLomA4hK2FIIvKSqBMCZJqfYrUuoj5Q4Gke3QI
+APV6ZpXM6HpyWCOINC5ajr5ThE2htkmVKBKnTh50RIqx05XCjzZ2cMR4uFcQQQ2N55Dh0eGKo9he
+BvQPSeVuYF6AP38ir5uPsZ9CGW5BR3Hccv8HJaoYvL5HA6OAclSHl4v6QCs8tAEecq4Oj1LLSy3E
+KfClG3ZYpQYPOfqA9ORWc6CvPFD3x+HSBSmJI/whrfY4UAlH/xMh4fJvuEtfcsgBnw7urwiU/kQ5
+T1+8SrVPH5T59LwLHKdaVM+JgpFzmj4IKS4qQ/CTizwJjIjJ4fYnsgpIHSTUSLq5QvFSKaE2lDXt
+/qAQ855zCQYwjepIcmVJudIYgGDslh4uXajz9PAxq6yAtF2EpjK4uGA0f8ZgGyLBOBesTu0iR2/A
+tgPFiAF9UUkfNBr5PgFip4R/1Bhxhgw8ehxlZoxsZsSk+8TJeUj+MphPocrTXpLz8HIPD1l2lRL1
+dcPuFw2r3URQQ9lfvjVExWBZJHxYlFdIQSU8MuYOmyx8ay4lJ3fbhCaXkk83J3eslJBZwhcHIJUX
+JOojPV2vDBwSxUNlJYiEw/SqpCI3K30AW7Rqqp+0TywLLmEnsgzK0XL7+8rt7zu3Pz9pn1gWXMJO
+XHL7W3PrhwLD4juHgcVRwKcwaOm4/nraJcAl7p+2HYE0aYQd7TCHelBUyEYRNsKwn+OfY/VTcpax
+5qm9Mfqz03MAcnp5PwpIE+BX4DJKUR11wd7tZNjaSvD7RLNeBhrvk8thIOHoE/LmVMJe4IqiRT71
+UHgsLKeW5/SNAirTlh/MpqyEplIMcHBFihyGociJLG7HDbe4+IDhcNVFPGCV0RkHQKJhPIqtWMkh
+ZVI3/67eGDiLhOED/eNZCjTbcsOYSqQEHDfsIbeckerWMajCT69CSpspe3gUt/sFK1hatf4c6yDR
+JSBy9WDxlF6tPHyjQIiH55PDIAkX7ncKhAj4UWIQQhziwvjyspBoEN8nN1ciMj7cRuF2dxDRdwox
+icPJHIDMjJTkHp3nIWHEJ5JtSNjvRlWJScnlV+R8of2UDEuDK8opjusxpEnwQfF5ey/SxEpxj5wM
+aIpeBpuluHfAE3xhnZdgslBWlQ2AssqVVvQyK+WSPQKbQzuuLlJAlcZapdeSp5uCQUZhL17Y9PX8
+D6kBStgIGrIrSBHYFHAllcZiNpa/BTOgvTVrb9p0Gj3MDndsmiwgvrIjIKX4/0n5cOeo7LWq9la8
+i+DGXJ8vDFTqSxyMnCpaUn1hV59BjPs6HPsFxQoOFXv+rIqHKi4e3OrBzh0wTVLTiF59pEAhquco
+pFas4iJNo0ST8UFFhdfmMU4AxMuQ9Vibj18Ur8kzTKGgChFo5smrPHnVTl7lyRMJwwgwAnPI3uTj
+giELXFG8OAWuWJ7S07XgpCOVc99whI0Bmy+7P1zEbX5VGbhYcBSqadpDoudsLXiKQllVNgCjRk+x
+UxaVlaCS8wCKNGs6OVMwoZx9MaikGTjUNkm9IaViZTjRulRWH/+YoOqHKx33fwxEOqcdhiwqKwC5
+AeR8dna+HeP0LoOHfo6S9zIeAgR6pZBDhCZZNZ8okCXXVYMQDGVgp9BxpXe90rusGeGs71TiNVU/
+w+U+eYR4+5IonMpKGFJupZ+dHalAbaR4EViSzy592QdPQ5/EOEmjyBPmZ4Qcj4m/ThzcU371Lcyv
+w1PJrw5If20s+iv18yuJ/uJ0/CJOEGdzN5Ww7r4cwS9c28rTQzLELeNXI+Ac/AZ9IUGCJ/3YAH55
+9f4GuJh0EykG9Gi/h5zo30xX1iYYubhyvEzz1bC2Bc0sQU93+WJQSSjnCHBh3hVbYrbbT7u5nzae
+FWv4aaO6bvX8adtP254uvMZXYNdP2/JP286ftv+0ff9p/qd5N/5v7kFRh9xkthEShidcnNtBvqiM
+lxKDahQpKwEmvNDzbusnhXTVSpUo8uHK7tMgbA2sIJ4vcTx6JSX4iCuJcrKaALu4hzh+F8FSHnh1
+SEAwus0XA5jktxKbd2duFGKXYAUztATY95JPA5iQe+HxF96pQLj4vCsPZ9IbVb8bsvC4lkXWBqDH
+/3x5+bIgkom+ToiXoeUswzxIGOBKSMGK5wDvKt0dIlIgELKuzJYHIV94UMLA0uoDrHd8bUtgJdX5
+ypaKkBxwQ/4tHF4lPMX9Q7H+pMS3cAtNZQVczRsMk7T37Rk8BWzJy4opIhbLiYKLXYlzN1O9phIX
+tjAyGDI0c5YrXPi/7icxQKa4uRuuyqHob6hSy8cZ/a9qBZtuTO2a3BYM25cySy0GTeCOBi9YcEkO
+lle/vqyaxrab2qrF1dfXRanHqrQvZQmn8Tm8nKxHcdjxnDIpCHyG6FeuixLcqljsZ775leuiLFHy
+bUbJMs1u3PRM57P45Hv51oKL3waNWzf81uKitVkl9ZzVX0flW0COy1Xhll7/6BpgadEwSc02Nba8
+tGBRJFQ/qn/+4Prbd5zBfTwy+11WtlFGs7CP2XEp7YP9+B8JePQE/hAHpkNtEjKJI5OJkLtCMUDA
+QAv7RJ9grmqv0+MSQfeWT4PTl5UlhRPFPb0cKh3chT1GFiJQMB17e440vJc7oG9PT7FT0lND5+ai
+gS8LVmNcIcbTY43AGPcoTk4Uw/boW3i4eLqf5p9FmmZ4pFsJu1RviE+ZrPWhtL8R5uXOPEQLzaus
+AqPljG5mZBK3DHd4Rb+52gx8WXD646DBtOZD5dETVpV+6zKW/NdSp2mNgNZpSvlSvuKsipVKd05e
+lbpoS0A1p7svvGJXdf9H/yf4TKiX6hdEONmodcDdbTUcIar2CxniYYAYd7lmgdjFfeADlofHlR29
+/NiuwuH3yVqioVSl8zlpBmavb7wE99VPJWwrs0NRfWZ3PkNtX8qS4IKILct1QjVuzOVw27NXYkqE
+8jLwemJVqYumxTkwDUfG4pqBfg9b4Dk5ZkpLMp53Y6KfZpR7uSdCofn0RZs8eV8ULQu1nBYtoxCZ
+lZe13k7XngZ+NwzbgnY2RPUzpuYoXP3K+6osMep3nLbyEuzPr7yvyhpqTWzaWdhnTn7SPrEsWCdP
+DNvE9Jhc28ozvGXY8qSlHYrO4NFdBjTl0gzmiePefYZlwRnRypeLFqj4ap3MWUKyqGANlTdf59ko
++ThnQCgIWfyWse3XlwbPiqmcQVgUqLRPnP5hW9BalahMnr/IMLD532aANLCQg0DTdXqDafqUoEcz
+VXi/5eUs7pKL9u3ZvjV6Bv/xZcFp1vK/9TJ42zXwtgvgPVv/G5uGTnTWDah+LZr5dBcXZAXywRPg
+y4LVWDPGTeGXZftlnr/0vme5tUfJBOMFHSpwqYhOs0KiABF2zPARYOoF4zmWNPlGUSnZwgcx2Xz4
+gsdHwQlVCa0on08Gq6jIymqqzUm5+6P/NG/LNvxxw5OTyBFyZFQ4GjXmOHRkwwc2wVH8Ixc3xCmO
+h7tDFAqn8vCprezitxavVa+q4LoVNFvzk2a05rWoomEEb6weVj7U7sHh6nFilCvSDD33L47Xqll2
+AS4TjK6orITmDXYj87SIDKU+vsl5PGQ0yS0iFViSXPQyIa92K18uuHgG4XIk7RPLgnXyRC1lth3r
+qP35SfvEsmCdPHFJ8G9JEAHg4ozYLUCRnsytMaum+ViFtpmsPuGRWAye6c5W2tnG+mvoI0duyIUq
+TrZeySfO8CVM2vrkNk+BkBsvI1K8JvO0DM0S3jTZtKlJFskM2Sj8TsDhDSoaBFFGV5ZkTiZJ2N1T
+WKq7Z7K7T02LqBy/fLSYuy8UmlqZltpI95xX1JhPzxd8qxavb33JiYc2SJeBrJoFq37SPrEsuISd
+uKT/95X+33f6f37SPrEsuISdONOPOaQFNYgd+3LQkBo1pNACTCyelquEqBIGcf2tOE/gCfXsawEw
+QaciDeWeoxyaZKLtLHl5LMV6HxEVslbv0oLTLJknFDlduPekvD1baBFRaMKQM+kQMvG45B6UcAdK
+J2rtZI3B8XB3CFRh1d4+8bTzNFe2yYrHvqSnnKMLkS5OYmpBSydUJlXFJZ2oOWuTqbx8K46l8mCk
+IA1f+BB2I4TgqluleE3W4+P1g25uiHvwUU3PnF8LIsITLhN7wsjyt8zpMzaMzqLzVpfsNpeWW1zC
+zTR19gG8n6bOCza38Oxuk7ONeR9O+5xhh6tdneAFiJTM5L8eolM5DMVhBlokDZiBBsB0j/k0gOnp
+S/sTeoVnEDjw/FlkqaIvrm4YmxXX8sOnSWFbGWWlWonzWVS12r6UJaBEv2HMVzYfIVLzNr419ZpK
+wilaNAk5LvXWY9i/FHhhnFR8dRDPV34LyvHjV2mbLy8UQYpzuAeuepIkhGmNgvcC5Qh6pxOMBvEy
+tIDNT9onTn80FnJOKy+B3n7lmREe2RU1gqYYKNezs6jxWg2+Lpr6dBorKifo4egRMAlRpOzzFOrZ
+w2Hkf6fxV0tt/4/hn0iSUv4Eyf6sYXvm8sVymRWpjOLgbl7lrlAJrKLittwag99zOSb5snKdikUN
+SSV9qSfN99QQpwXhgw6Jxr+/bAATEsADVrFmXHxt7KeM47VqiB3gsomWud4XCmIEevI4gx4jTx5+
+FF7B8sEONUoaUv0urRugxnmwyIHHw16nYJHkAOt/jdnHqBq/tCXWkgKC4HooeRkrLVo11U/aJ5YF
+l7AT9QgHH5NmgDYpbCvbQYhuEf6+Svn3Xco/P2mfWBZcwk6cpYQNGV/6Dh3zw4WjhmKjhsIK4HCh
+4Hs2kVrTA0ekGsVxw83CVRwxVB/5eKe4L1wno5yq5PSlMFxxKr0C8gvQAxbwoMi1zceRyu+DB3Ds
+R+IhNHuoro3FbVq+xp4VoKYSNj/5VwjH1ryngL8vFFQhwha9gZrwWa4iyxGYQNDj0isPVCZVQ6VL
+JasXC82GPDJvosZ1Kn4yg7NZ6DsWkTRA5HGEmD+dCrzgjoTa+He4TzZ8fQXJK6I53GXa9sSBCdRG
+QpjtqaVpeLfY8MZgCL8TUMzG9wftKTOnDS2pSSNpxW3PmAveuImGuSxFBt2uDe9ulZP/UhhMfhbR
+0hItj3yj1/CyTpXg4oLxMoWJPf1e3IJx5XhNDTdhKjmtrElde8mbS+PhPGUmdHg+CgmjhSrSfEb/
+CG7hTVk+TjesC7NgZ94N6F3kqRur90W0YJZ2RR6EbbO2aOrXnlGP782PAH0rGRXefHrKQkTfMSfU
+9XVz5zl/+xHhHfIQn5ev8gPOTZ70fp080/1KD/eLR7dfKSlmO2VW+bfd8CgBgF2a2y/q75d194uP
+pSFpkMb4y+8U/W/DqcIk9W/T9+6/+FIaEvcUYluwKks7+MWPbSmYzavcFRgPV8Yj3H/a86c95dN1
+dw3axdn9aBhPF8TB7ghPF8QW3REoEUgO8OlicBB7lt/9Xxj+MVD0A5DPpzypTGZApHt4FbL3WXu6
+JI6HK57pkXMUkONgMFl1/NSPfAjxMkTP8dSPfgZEM+kqJuUZWMUDPy+wKvBqwy3iPPIR0u6kVOUc
+rni2/9shYoR8y9G07Unhd4ViIK1AuXnjqqTAUvXDVYbrh2vyAymTw/aluLiqtX1rdVGlTj7ioJQf
+5veRUBdKLK+VnyOPIb0TZ88FkNqz4IXO02ONMuQjf3NVhVC8yhkSXKeC4oiiVh6fIh+wF/1atLgq
+M5jQDfE2B9dDSCV7OOJ/b3BFCRFFDXRhrAcF1UaBSmGd+3hIThIW2+EoaDn13fHTHx7uDgEbK+MI
+tWUMLp7+qD7eFd6wJXE2T3HH15HKsrl6e/osji36Il9KLF/eCrbHePq854g2qKjm4hTUtzD2nmtw
+RkxnPP25tOA089yuT6/UvAWCbSy9Ynrn0/IbmM1cXNWyF3+oVIMeoZCLK8dralpUVRhdCzQfQhbF
+vLzBblQmzXBGPMl8ziCo79+a1d9XVn/eYDcqk2Y4I8sKR1szFl8YujgVXyfXtvL0kGSKO31n1Sq7
++K3Fr5B1UWr7UtQreCMLsXgzubfbpa2hFRb/fztljATzeRPGvjptwUq+syMr3tH7hkzGFWTARO4l
+H6CQII8FmGS+U9KnsqiVibSscibc8RICqAH6qUl8Aq7rgtwPVvNhFXywzysHz8cRcvpC9a4tlwXV
+jKu9HJlie/rDTueRt9zl5d2iMGber+QsV9VmwdSiwT331VTNb62YKvMz+6Kw4ZYjY2WSYZ18PlcO
+26ppIWI/QvJ1087yy9Ro4Th6cp3KLCOX7AjrL+VB40rSyXVRLP65BFrDfNULNfO8otNIaqt+Et+k
+Q2uuLRi2L2U5MRUfEz0x4/f0ha0ty+ifID7V9RIUep2EIqBhm8IAAeJkryqgJsjqYk7qrcrq9Qqq
+SJmbgytBdDDk50DI2yDIzwGQ5+DHf8QRk+gXOo2LfcbFLuNCjxEcrmyRYthecH1S6XejMqkq4pQo
+mllBo9RGMGnpNoYdg7BJ/os5wqVODPUJkIoL22uGGuTLyqsHOVNQrSibv4ubKj/JfAbMRj2D7+Ki
+5vwbJfRvfkoPvAg0sHb8m2UV0GI+nnCxE5ISvUQct+hV3nSmc6qXKniIULQwBCn2cT5FynlFfihb
+erjD3fJt5WrK/8JjZXqwHEn1vqTQ1xT6cVjYLhtvPOV3BYZ4wXU8xsTGkl48WfroA6AJAic8sW0m
+tsyk7TJpDQ9oAE0r8UyghabK+atnkFFkKDvSLmhkpWRUbUE6pbLdFs53kcw3MLpNRZEfJLUgVPM+
+GeA9LpVq1J4L0hy8QXtOopFpX5qgmOv/gdERhNqiV7nLF8NgCYuJqmce/xIMe589ZViQDwfGY0LG
+V12QYVNCkLNKb5sPSqmGLHtyipRQklVxGyIX90uBLIv7Q3PNxbsuEk/nuSS4e3h5UqYJ6ResiQeg
+kCXpacklxUmWxFmyjGxySfRrmuglglruj6fBrfj9S6mm+TKxNuO7K/5LmTH0IRFavAyt4Ceisk7l
+vpSlGWSpH5YMX4ZCon9QjNeiaKpDjf9wvFZtDQmgi7kXANsclOnfngsOM151DYFbY5FpMRF7Pkh+
+J6BGCnY9eBZXnxQMUVHPAA3iFTR21R6ruHrpSulnwW1niBvOStHbj0Ax0NDBbGxWgsjEu52NqfBG
+NeQFybDNJwPNR7+ZnRwv05hMg9xvWSQy8azx4v3JcR8wIT5qwfu65eIXVHM+/CQYU97DRCSDGvda
+415r3FuNe61xbzXu61MLJwTvMUQpASUN90bBShWqZoyXElvf4DhRJYKhCQVtOAELPQmVxLIFW+Yp
+DN+3p9gpadY4b4uScTIzXhQJ8AiysyPITo8gu3kEyv9HRY0IeIN7Y7K3my8GlcTi4C5bsocL5bgF
+yrxdLZ8+BaeGeGG8SgXdtSjVT9onWuAl3oyFyweDl5LZlDmCKZllZGc/IJgJIfA5K2E3KpNmOCNN
+BL2cSDzyCp5fWJXlE1VDmD9wnfp93PTC/nhKNPI2WeTHFinppYXSAGLu4hxw8cA3gGHlVfqzdFxY
+XS+ejpbDPaII6qNRcXstnUXvLPjIsG/XzunsvouDrqzv7KFk87lnF3w8MGDtjwdGRQA21C6nqcs5
+6pwS78cNLhI92BT7cUsuxEkVKAvzF6RZxYbOQJFm7FyrBOMJl7meUjedS3UHSCjOLoi8YTxnbAFu
+ObaVF49fw/5Y0EJs0Z0rLx6571+KeXmzz7LhhAreI+5lU/GLX1iVS5HPScYWhrVBnBHYXwrnvuIM
+Y2vEoE54srkv2vRsuRxfyuJV+vZSDdlAxRmVJAs6N/yiuMgsQ4leSkZk5FXq7i4DmjAuw+HwQNqB
+juyDFvThNkGAYlBJqK8PG5n8wCYEI+HTFAENWqS9jMyu2NNDJoovuYNeuHteR9gxXr0OlfL658Kr
+qCEQsOgkFQlGeWkJ6aISvVrRGU1T0lRWD7X/sgicVP/7aeGnBTf+k0uHT0L/CyKLg1t/WriNf1/g
+yjx3uPkG1yWVYpbkbsF5iAghXjnfepFkb10GzqOMMsUQcGYCzkvgWQmYH7DpAQGpeiHdj0cnDURe
+IjVQ9BQ7ZVFZCZQWQTo+QrwMcTEEaRphe42eqGUk3vL2MhDvLFfQw/1gMgunbriS4C6rH0WI545S
+iaQhqAUjh0EXxUcelA0lghdnFxdvPgnwxat/Akz4BpAAE1xuH6ykxkulGALyYimDpxFFDSgqhzcB
+txkRooonlnUEP/5xb4WsAvianuDLgtNfaJSC3zUGbw3Yn0GFhJIECgKX5C+R4iNO+/M/TbYnC/e7
+S5dICc0tyrBBWXhIQg9f0ELwnTW22BiuTyr9blQmVUWkKag2DVaZ1j1gWmGw1Objyb1xBpV8+kk0
+UnzcbiCm4qtJhC1hiP91l9xPk8nG8BLTKzR39p8W4vi/Dd8oTSuicci1hCsJLZbtFdcQr6Aokbn+
+YcDDZAUUSYDpMCFNKcrqCh2UhxjlRoDheIhVrpsIB/HZRGOTaOwb5CYejvHvZF42HK6MAzvQhQ3h
+dwKiDIru5Q3TvmBVxoWkqGbILB3acSueIqqsBMj+alIWCeZvrgIkP//C7+ZOrMYsKDfvIsTLMJk9
+MAXtPQ7tAQ7rAUhEJCLDZkjYT8cA3L9cCUbkwQLSxeJrJRp9efuy4DTrIdWnK37SPpFhY24GNJ3S
+Mg5fdXteZfhz4ZzSPnH6a/byA5ADfsX9y6issMNF4UcP2QB8JQ+8AJFSK5Vps9eTmc5wzLfRZFgl
+81zgerQHvuYlxMtQM8gy7AhH7hLxdCmorG2ShBUH4XqRwxpZyvWfXMl5SG6NQdiNqhKOKOFehqF1
+sHGyEoJLOsB7lu4EcaUvwAOg/mKtgIR8PKU8D7VGT7FTIhRbt+3UG3SnXgJDXT9NFUbQ4NruMb8s
+ArtmAuU5wVADejVaHLTjQcz9NS0tS/YYfSSOPhIHG0kHG+mFUuKGkbScVtAU6G8l5r0jJSxZCDKn
+ExI/RCRIYqdcMHKDSdVHCvjppoJC07YaL4LGxx6SIdUjtKeB+Eo+zUVZ+MdfDghJGpYjPSjT8iss
+/xiQVC6+NWmNDQb55BlSDPjhmSCHjfHVOf5Pv7mUPXCHKBSyUFaoQjYKqr8UGg9NeAAKzq1jwylv
+gkXslEVlJVAygmjYbkWknNWz4qyeVc8qN5uFhEECYHg5xE7JdFtGRk3q8Wz5pBhWbOkqAuoRajeA
+SS784hICiq+gZFQ8Lu0hpcQFH/YNyRIXj+BRVbsTlfVOhL6j+IrRf+HcIiFehhoex1dyhOtdMhj+
+FSWu0k/UZwlD1O4pRpA2/t0ziEhwg6PcVHIcPrh5Qj8AkgoG6I0D9CZjEi5aC1i7G9rTw90hCkWF
+hMABYQl6aGFzm5QlcGm50j6xGmpccrymltPKS5Q3Ei0uPaQKwlvzeVs2b8vlPTN5z1TfM1Fp8JiK
+G2LM2PqyMkNBMC2s+oQUjyPI2KEd2MYuyAXeCktWtGBFy1WsWMVKVRAWAcTBNaObfAbb5FMIIXCj
+bTwMPQ4trxVY7uANK9ghUddysfxhuPr3Jw5S/Pu7ftor/7T406KLeftpcfyPe1f7abH+tOOnpZ+W
+ztdPS+2n5Z+W3S5O8CqrSLot5zgJxguuFFOgHgNqg4uE0HlAVkBQC/MQYCQmVu3ZeTLDzt1sV01T
+ohhFu7ltyyox6JhcVQE8JKAvRy5e4kqXkG8hiStR8+PhkoHfJ5YFqzEOhVzbyjOQUOmvISTj60Am
+lzwr5s0l3CbztrnmVIrhJY4UhBMKeL7PWz6fXropQzH/1z3XCOSthkQhXv34uBF+JCZzV3kXZDsY
+z/4UO2VRqf6UKIjsMJZHHL+5k2KEkPiy21X2eNkCKX6I6zE1Cyn24h4Uog5nPA/n+917CtjE7eIM
+HLEeThzJ9yEV9+CHDQT07hk/WpX5K1X5IeM7ETslQvEApcU8cvRVgg2/UdEjMyk8HkQznkQzH0WH
+bBTqzSY/CMslgBdBDc0bwKTRoOHWRYiXIQ9OHgPy/0aZX9h2P7/kLL+8OLu4Ca4tqITiDXajMqka
+GqFogoeB+v55g93IPC0qLOLSlg8XSihyDBk1/eoP2S43v64MNzRvMCJHJ+df2pmc0Oj3TCH+8pEv
+Fi1Did5IbWnzkzRWagYWsHmD3Ugj8ERH2xLKUEK8gk8qfZUNJXOM4txybs9JEhzNK/KHxAjwYT4R
+msyNQYqhyuA/x+a3IbpPEv9yrYWflscJO9zTfV4GfjdE0uAqRNfLpwj5cC1TSGGOmzhOXn5lvIXJ
+fAmTjxvePMvLPacS0fJFIZNZQvCg23Lyk8S4uUYhqnjD546bl0guflJFsnmhEzyi9In4DR4RYj0k
+zFzGmOcixnzkIi/mCBL+1OTgSD220CiGceSSpLtNtn4eeAGkQdlGfzjU5F05VEoEqczEUS4hXoZJ
+7QUuFHEf4ji4iQI+jpcYnoL1JShegeb06J5ip9Tc5cfNRTJCEEfykFqTj9p5qPy+PWMBbMaTZeaD
+ZU6YtOTOBzlhN0lICY764IHxkHSZKK6mVEOkiH5r3NR50S/VsOeFMCQ/k82pynwD5K5QCSyatPYk
+iV3jUIea90suuTzOvDS8HGXrfsgKyL8qPSySR+bFO7qBphL+B6aalcyoEWCQB05IMRQHd5emnYv7
+eJ/cc7Ckj44qo+oyR1nobXJ7uu3pJ9HrhnfAhmrf5KOcQUwTnxoCaiNJA83tyXaT2/N0tfkF1Szv
+XZRo5G0Oc0s5s0vNLXqKnRLhtTZRha0Gr5IGSeWTRxf1I8+p+XR/cCXIiV94hBTDXnSvtsni4T1c
+KC9JT47/vHOj63w+5HfkBIM4/OZX6BK5wXVRpV40p31cM7dsGyR+r5SbSjGwes+lIzgxsjwPjNBF
+wu5hDynlzyT4ZQROWZ4y8zn3D55cpxK2lV381uK16H6JBrt9gDI5Th9fDWtb0MwIKr/OXhVh0z2D
+8ymnWOZg8snTc/IATp7VU8+oTKhkTqhkzp9knT+R6Yx8VoxVRcIgr7sgYcgMIa99IOExCocbPXaN
+zqM8Ra7O4uQ2X27SlchXnsPVX26cXKH4nZI6ili2p7Y96cuKfDZmIGHvTk4/G2LBV9yQfldicsR4
+qZLLJIsuIGkmt2eVSJ5tEa+Ns741JiBdvD/Ohb1rOf1u0u9GVYllOzmiKyeSnnu/kC8ScjlR9JPl
+/q+jonE6htgpD/moRZAhmaHE18FhKRiylsL6K/rbckD6QYSNw6VSUNZi5StavqJT9Vl+OQeDBpQV
+OwpBujgpXsoIluGKImVqHi4zF0hEhAoxOgOacp+ALFrGUwGgTnrKPPWq0ZOpZuaWU4AH3P6Ad9es
++sxqbgY0lOJV7grFwMIo8ES1XpiwugjxdnjsLJ8sz73SA9fNwU2oGrnV1WeQG4QUAxv8Z6zXF0Er
+BDKttZ8UYm5oRSLVkFWK4cKcG2GY2g93mR6u5Nkc+56GH6jLZeWqii+T1NhcCS59KXg6+9K/Q5sW
+vOFF+Fty/1tz/7Pc/yx3K/0sfmRCCCHDFDl8dDrNH3DDRlnVUJvB9JSG1DzKj8IypzwcMTDl5tPT
+HaBCoT5lWgrjsBGRUOqmvvDrm0sUVeQTLp8l+/bESjwlBqKf3ymLSvWnDOrDUVTnhg0CvDS69viA
+6f2gb7RweNWqROPpdoXTlwXVP4fUFqRZ94idrB58aFGkmRdalylqrIgcLqrg8fRJ5bT4Mqkq8lgH
+qk2DoYoez+BVMoTFicxbq04+Xcn9FWXmr0dMA+d+ehXiLxmgpbDX0T5HO5qOr8oh6VGbyrApmZfk
+Vw6np4QI/8QNVQxp9pdKliX5S/NP/mKo8TsUbUGYxa1olRUX5ZA0MxWJhosFu6rnrg0ddyF5/S4j
+RXzKLEJMeMz58Cnnc8PgWaQYJNGPj3BV4+QWCQFZGABNFmF6Lr4QBS5DFg1o1y5WA+YP1iRCeiN6
+eQpRmQTP0UdPkFzQn4SwiWHVi7+IowRj8BRQsYSDQJOcW3QQn1Q3dyJK9Qxa7zm+QM9wGjA8xvcD
+POH0GYPYj30dNvCTLegnMzC2H4eU9LCKB1IMcFDGX+eP4BS2AWqAFjY85wAqSG8kv/r8/xs2Hegv
+Wm1fSp0a48Ay98kbywbhig/Glr8cR/5i9N/8yvSIrh5uIlaNy9TTdTMhJmkjvM4ufRS/7En8Sjdp
+CVeW2/yVmwjxws/p/Hf8tPLTirv5TX5QU8lPXPzbNAttdG2yEop67hDo5ZUigxUKqXwhlQxAFdrT
+O5VeIRKyzqSYxjj5cAZNBlvFbQEuUpGqg4TvCy5+IVSJXjce0MtkUckQmtzLDvm1HPJL7mcFC/ZE
+9NAm0SgzowSYEKatldyskhtmhIUaTTISJzAJeck5IBeV9IGQJKR0u4dLBUselVYrDxZKBSZ3+Ek0
+FgqqKuQlkqMilz7IK2k/IQp7B2UG0jLo+duDHjzIxZXlRvil1m+9/aOG7R/DV/h4TQ0effMqdwXc
+L8HFwMIr8EjuLj5wxd3vYVPJegeWBauxxiebh7bEwYRcJLWH34NLk6qiT8mvPD2U5MAfAe5G4aJB
+ZIAtHzMatOnDiLgsQ4R7i+7Pl5XrVHiMeIklsqiclhlIo5qFul5YIcHVaykkppK86r4ayK1tsnoU
+lTRoZklPQkgxu30SjWzNIbEph0SPevIZYFFWL83grF7lrlAJGqbBzUUlO0FivFShVcT/ULL/+U3n
+X1SR4HIJHZsrKiXAwRGI/hKMyKhyWliygYt1NTMRtZxwGfb0RWUlNPR6/IplQPQqd4ViUJUULFrG
+YMpwX7hO/grPLuNAc0B3crAHP7SdSXUmdA2JV3ySJy1IGB64JSX8pkFxKQWTCJEk2TQ2BDbwZWJt
+xrxPgP/8yvuqlC+lrtqq8JhFm79I/4+hrhb/pexfWvnWvuN9ad/5tvDluxzi/N36fwxr+D//pexf
+WvnWvuN9aV9lQhHgX3mnFvC7oUao886s7OK3Fq9Vn8nmsuA0K8WTLTdV16JLvG+lesuFt19lRtlc
+31zF3TfVzYe3LytrMBniK/mVtT2bVle1fSku/qPyRvxtqf+Pqf2/ln8DxevLsKpW7xv2hheMffcL
+7gsvQWbUbMHzDJ2tUgWr8RLvwNYti7J/aXXVlnhp71tzs3ZzaiGtdVjCzS+4L1xWrouy8MyqhLMt
+aE1TNRe/9bqo+fDf2uqp3CN3K4TGSeih2FiIyBheLyA/LyC/XEDemrzXonvLzv92Fxek+a4Z3Zc1
+FaZHP5W44Izs/YKL2c4QlemVy4LTvGbwlUPx+8Jl5SWQVcJ9NWNlryjh0cvsBBe9LoY1sN9XZQ01
+8wpY6wn+NZhRf2fE3yXa7yxHLsekpcaH1r6CubXVLYZ4/WOqX/p7SdHvC5eVlziznPjUUrBXP2mf
+OP1ntF5nuXudBXrw50Em+33VLAWoM5afNI3aifNjJEO/r8rqM9MXLadv7Svo2y9aLl8KA3LEK3DY
+tUtlhjn9PtmKoEPkQbXlos8HqT77wVn+RWPiYV5XYbmqgv5kiyqX4YxonX+YxQt3z1YrhVhQhyim
+7d9q/VLtsKD/+W9t/1a/4v59x1U/Lbd1dGH2c2FeF2G9JsL39SDqEj2n7xQsKzvZQX9CwJRkbUvU
+ha2jDDryH8gALFB07atPFV2vcJ0uAu0Tq6GVNy5dQfzqB+J6xcflco/OTmh0s2JjaFzytmr7t/od
+dpaCek7/6hohbzPpvC0Ji7KGmonmbU2Smgbt22zxUKydQ70Wpa0czSc51gOfKQcUGxEe/rgVNzUN
+Eh5YLbgoddGa/1L2L+0roB0p1Zz+US10rcFah2g5fSlrwPca7j3H/ma4VLVjmOVvSzMULa68BrPK
+V23xnAetD+TKS6B59PmBX0xYamA11S+b37/VL9/rS5k5zNHU0dcj7G3NlFo1dQazXPs8oD5PZ59H
+k74uwvTPnVh1Rsybd3a7yodK9TWv023hPpWytnao16K0lTVn/pq2YZ0c3MKaiQ3ClxH4aY/NwDp5
+otXEGdZGrZoFLH7SPnH6z4SiXW+CXz0nLdGv6pKt6XUx+H1RvvPRYDlq53DmOTgBW5jSJn2XKpe1
+UPPnR7/UJR2/L1xWXgLNkmKtBbCuGdWF9okz8JLGertUzQJ+rBS9fSFDyM5ZbOXrIwTYRimm1i+9
+XauqfjZu+h44/TNy+ho6fY+dlsFTv9U2Tq71x9NgB/1l0nhlXpFvp0c1nuy/OV5T8wt+2atybVYj
+VFav5r+ULy+LZ/P4aU7jp3rFNz45WxR4/Q1X6vPccy4Gkvrp/7xKNQSnEBT6McmCdSV5Mafk4mQE
+OLVd6tk6OQl56iSknTkBNdVmABPdpy4QLa64+MBJrE+4m0sGFYRMK6cJKxZeAJBF1fUWhv+n/ADI
+uex4GdZlcbzIpgbOF/Vl13Fqc6oPql9wX7hOZq5QDsO/75T+/IL7wjMlHTi/+XLgbRP/7zmp/9Zp
+/Tdmm9862TygGGgQjaUv8AVnjBllibNEWmPlR/cLqpmymNTmM689kAUwXy2GXnGDLO/SOKKT68nv
+E6viZaCxAvxyxKn+RLiI/eHRfvRYP3qkHxTuI+vEC2azL324uBKuQpnb9k5+qsBPzv2L47VqbKfQ
+6RM9JfQdLjLzbqc3PvECVIKFOUKMk+gNQTcnlTwdwlpW/DLwAMyQAHajMmmGMwoLskS18+gaXMu0
+MdWmkZpG4cXl7XLyrvHeQaKRQtJnsjdWzo11c9OqGfBygyQcQnl66nt57/HBi0ACwC5rzyHFgF0J
+IMXwEgdvJ4csKuH7Cl6lGpC1vC4el5NJvxuZjYEH4hesiz9cw4wsqQIvFPu4RZO7QlGohGAm9qCC
+6TEJTfG4Ufq0s6UQv+zapo8cs0oaCgVUWcM1KV6TNQ35tVoR+su3qlyGbVJUq98VigFus2Q/WRO1
+1HnoCWnsTGtnNaVdy7FrdSVsViRgJd8tGerV86nJePGwwEgHyTZZATkAl+vpsMlD8WfY9fZhLKnV
+LVNw0+3iK/ZfIfh9YllwCTGRpWJ09WnRqxRDC6/8HjCmUlDJ75CkHG+c8o+Ek0OTXjLw+g/u1lNy
+Qpvneo0gXV5wu9yEAzqu4B6eYqekGWXEmDVgAe6QZjbLNCEmtgYhqInLexTjNZXmV95XZYndNBdq
+OX1rM6gMwsKoZRxne0bf/IJVOadJNHK1QHC/PPKbCQwyBqH08njIdx5D4DoQ8ko6CCXTvhzAbS3+
+rScXDdTkVdLQ+NUM+SJ5lbuChNk83PnzpqvGIKdJjVQc++KwPYOrQTE5A6+UNZawBo34oTJMCE2d
+P1ymlmMNMhfDmVbbl1KndhEPb6C+8rtzVpC5aotK7ovCZErW0WnYAo7xBRf1yeUwIjUx3n4EkMwr
+aCh2YYOi+rELszU0uiV+4UZ4kLsCw0YLTENtvkyiEXnEkHgMOeZiUJV6meT3yQyAEvcqn5CUMH6I
+zhtUpbBNmsb0MKxtQQnBF7UiYQjH/EnoglFmmA0UFK/JEgvHzWP2dNHw735rzkC87vewq4SBOg7q
+zqq+484tshIoeVD3vLEAoj48f5K3hAd7zcfDF5XTcufZVfZVNSb8eDDLh/a2j3htftTTl4IwOdlP
+fqyai6suVYZfJYGshPNpAJO4+iuABQOz8EJe0TW+p1SE+cZajzfJJ4bk0a2PquqMQBf1YHeHGNnj
+CWATbCrVqD0XVHM/DGiCyDdMBJBofAQjKaNEPhwWxwDEn0O4oD9ZOEivLBCNZltMz3xqynZG1rNx
+oOlyhdKQRWUl4CLR5UcA+Gk3ceSdqWX+qHgJqIGjlxYGoDdOvAmgG+V9IoXd7QaY5DBmCD4cDCrZ
+PoYXHQm+fFOJgFqklI+wkvhmcdB2OWQOOfqmEga4v2zjpziucFInnHCf/PEBQxcXRZI4Q3PyyBHG
+PKQ3gGekASeAq7DCydKcWpoz+l+VFvRXAwe+hFHcF16C8BRSQSvX5V6Bv2tJYCypXflBGxE8uEzv
+HAMkVd5eT9QVa+hkaTBHFHSOaMzusW3pbBGAeSJMu7anAUwfTDADxFT84VWKgcsmgq2aCHPRBDCn
+BS1OLpPMCHjCdRQflfDNOy/+anfwyjt2bWjRVQe38zc+laNfWWIjaMOkY2j6UjLwGm+sucZs8XgQ
+Go+ldb6tIu0Tpz+ro801EuBcFpTg/TifKl2cxHGmKu1LqVOLl3FqC06735VYqoFM4R2KAY9KeYYA
+4eLSX0ct4e1ViP8b46U3O4Qh53Teqtap+wX3hZcgLLIo2lG99We7iX/f2fz9k82fX3BfeAmyZBOZ
+sJx86VrzrQQnz6t5e7qwGVRS0vukKhrGe5USVJLYdz/Kmh8x71fzC0qY8HIUUKPcljJWyQ4hqtxe
+uf+JyEvlrdcFvSkEHGgev4uq0iswiIgDP29EMBMaoiLMJ1xPsUMyIyxVlbOT0/70cr/E2ckoQmYU
+bBQASW+mwZLlk686SGJEtidKyO/pB+RbdLWtHLZVYzCptpNevG/PnQWAaAMnT/eJC1MkDLme+LW/
+yasHEz8xbs3WMw9iJqc+FOTzFIv4yzAIO92U3Oi+LpUsB7AtaCHR0SnCjP5Kl3zbam9b6J37ze14
+QlCs4OhV7gr8BGhR6qJZguh2AeN1xJfi92+1/KN+R13TfGbWCrWZLp4GjeO1alrVXWfh8AmmCHjg
+Jb3IXaEYWBgFTQVNsbM5dzbnrs25s5F2bda9MkTl44IQwtTqXZ/0K4gDbJhLwAeIJcvChYylNPkj
+jm6ZU/CtYcHHhkW/Niz2ZWGxDwrL/KJQEKmpLppU4oV8pDfpNmnYOWPYcQvq+q6423viznfE+GKF
+36HITw0MF4G3l/eURSWDMRE+IHd9QO76gNztAXkQvn8g0RuiRy5/AbZJ0kawWLfvqPuOShxip6yQ
+zGoP4kpMCYlwPuKkACSKNrqOI6NRrtp+v7tOiF6lBJBMZJjbmSOu+c6up/8vRJkE7ig9GkbHVuul
+H7cut4l+4CvLIj/IUfiDHCL5/Cx4EZpKC4WLkqTh9cQe2KS16O96FPyuR+mJw2bAbmSeDJ5suKzI
+EE+f0yQY8X5G5K5QDCyMgmbxgss4L7lb9vTiozmJsV4WSfQiFYyzx/ndzrnarlO4HWey6ouXXnnA
+tXnVfTXAXcyYHvJrX4R4GWrL4Y6RALNoxmEJpkXS4T5+eGaITy7cj2jRalvVmY7ojC9C7ke9PVlQ
+gXgZaswlEdy7Rqc0gvE14aXluvise6Fnu9ijXWcNaUgp2MWqvPTALjma8eeKOP4t29GDGlGeiSER
+VG6RkDAM9/bTqm/VUwxTOOpTHpNryPGV33LDraHVID/AXnN6vJ7uHKmfP632lz9yypNGGj25I6gc
+ho+MIVr8aSPzdtWf1n9adwcL2t2RYeGmsN0V/xiiudMXDyoj5G38C7go7kjrdpOTKrI2gWu4Xpxd
+XLx96zcE9EUm9Qj04Ne3xMUakqE8xirXJQymfFXp5Fy9gZrUD0JcaVIiRJUz3W/Sn/WbmLRoHcVm
+8bbx/xJHDhO3DhGmIzHeTPRegjtC3+RNbd/QdfY9F7jDb+QjmXj8jg3kMNybOJIDfqJZBLyQ/L1B
+G/GG/sAuAJDDC3OGnVN+0sv3MP7RF0IO40ve9XRMboxa4EfnihtQjeGEO6LGKI7T5Zk9RrrwHYWL
+VaLVsEtLOsb/zY2bhxOMnmJXKR/9d77qhMTpU6zKCio/3u8L0owK4E8xQMKnBMIo52E/kExsg6Qx
+zr3+u+7137nXv/6QKX7EtMsr0q679ne8Ku3YnF+EqOL02ChU7bmvGK9FQYc3VIYZkdP4d+KgGqUv
+6rajYU9bL2Ukk3a5EfW0F0chISWQdwgsdZfwo7GQ8VJi/roNYp/bIPZ1G8Se5Jzh61G8ShoutotX
+grGfFKI+3OEkyIMzLUJejlC+qBXxksJLi0xho+I8ZZuwG1UQRHpQQKUbnMpkIF7JRwq/KxQDDcJU
+QWqcMWaUJc4SaY1FKbWKryS7fCTZpTynj3mIMBw535hv7Cd/4VFAwp+nfkrTz1G5bUSUTbc6Ntjq
+4z6ALWq73hLKzSUKvxMk6C3sFHoZgC9QQs2TGDSpieEz3FvOcv8otzGTUR5+5X1VypdSV21VUJHl
+lu8uGTDA/3wzoOks+ZxEIwUDM6iO5A2nuU2SK6BIwaW7KXvHj2QridHD4qnexcHh3nFjHRIVc+cR
+3RFU0n/45N8COBOP5Uw87Ew82CUVuZvytlRe4nhMZA66KCT2K2wUVKWbKK/Dp2BRxGu1HJh0IMBb
+ltJAikECasc0QAZhSgjiSu5pXxDmG5/DDNUs95uSsFskAX6oTPQ+hd1P4QVf9IovKZwUUFkZSWsj
+yX7XkDSw0Fbk/PbFAKZTXmoRYJrHBb3iZ5OU1JiTnwRjsxprnE7uJTtxpYMtp0+NV9eJE32GJs+V
+Sggm7n8de8gpibHgsiieF2vh1VECjr6hAPIL0O8VKzn57bWg3xdFw4h8j+qtm4s5yyigbi5R0Kfk
+4iZVQRmeSDvEI1OvL3Ekn/ryD+8BhQJhcLKrlKBW1yV0jZ5CVXizA65V9neHrAqjbG38u1cQkV5P
+d/g0WW69zeNnrQiVVMJESUnGBC1jwNi48S2hkqQ0mLEXIU9wSmKEI+XBeWsNCuM2uZ01nLzWxRmW
+t4udQir/83TFN2lSv+Pf75nCVwJk/Wnvn/Z2P+2d/n7au/60jxv/2KUeclcoBlVJQQr4cSENF4xt
+j4cMvijURtIwFeIaQlgCtIHeveD6nbKopDdSwU/EfbwXZ4e7u0gduQvURsJIZmD0u0aMfu8HkJ6X
+iARXo+Cm/fFMlmnSZfaNno1F5UTHAOh/nmKnLCrVn5Lx4r09VYpPfHsKvP8WPPDae7IGFZlamNCe
+k2i8KDTB8oLrqbEoBbVdTrhDCZsXF5tWfsK4Kcq6uE9gQwrakII2pKDNx34E8TN/BRGYHqRRsiAK
+nniGZJSHNipd+DAA4SXx6MTF1q0EeAQYIlwkGzVTeYHxkeYsHfEHe2t8sHmKCATAIaR7xFcbhjA/
+PMVOSTNzSSpfcINXiXCv6FXuCupjUTm4/PBHpj74pcgPf2zqoz8l9QkJ5uqbSjGUCBehC89O4VUe
+ip6cYuemaOYlMojkC09MtYgsKisgqEUT4nTMh9+5fjCv8sF+fh+cIJmMEeF3hUpggk03dAQiSfx4
+zycgxcZSNZZKt6okTVvR4EXTaYsNKTbzkqHUJ/xK3n9eavLvj1WXx798yi9CTLy2MkfzhEpK/zem
+y1QZmWXxwrsIyGEYl97vT/v9GfV03bz/GS/f83BzThT1p137PcSfdknmmDm7Xl6cYYg+5SiyDHec
+gus4XaIIKjeF2iZVYj8MhilJ9ITuV+TIIYniN4eFqVfyR5apwCt57PIsP/91nRLEACUGV6Fd3Lvb
+vAE86HKDCEOafVNpBmkIhtOseX7wil+pLFgnT1zjNZ/IGWk8ZcZryJxUSlT5EeOLLw2vuTH5Za8P
+r3P5QFMUvysg7p2heIJPFahInqozi2Nbjk+uUwnbyi5+a/FadL9Eg90WXF5fW45fc8vxa245bmhm
+BB1qcQltDuDigvFSxVvQ6iftE6c/KkT4z0/aJ1rYvxk2V9fLynVV+j+aJZHNh0VtFGKW7JGzB4s5
+ixPhepcM4CuXT0Hl5Co3pEsmHf9cESckp7IKiNv+5Ddf/8LDJf/Tf7or47/9dPf56TeZEui38F/H
+gyYx9xXjtSgyBqI67EPpqfWfvrnLxfDTt83HmH/6Fse/++lbHlTdT5euoO+7L3DrT9/vP30/+ijR
+fv50f3fDCT99hBnKPY9/bVnE2iZV4jVk/ekPl3ZHkVQO+/On4/ckRYwkHnFeWFD8rlABF8VoFIMi
+DKiG8f6hP3/68/7Tw08Pj2cvP/1/PT1OyfXx018//eWqh1t/+iu4509/FReSpwwuTRpBevTRq6w/
+Pf70OMLEzRe4rnmD3agqSWGJOS04Q7yZgJzqKK+je4xuH84gefnX4+FKGXqC48UdhRirnEW8HMTw
+khs+xEiujX9fQh7GFg6UGhAvxX5MqsAsouaoUuxSkFbczdWwATefWgn3/nDxXwOPfLX5f/WdhhyN
+5OVkaqqm6ssbXwT+Y3n7/9fCYm4lNLTWoclqSaG7q21S2AMqf+ghtrIU+RkeTxCGrRMZgVPxC6tH
+2EquWz79P7pW2ZF3X5Jyai75L4Xp2CfUohV3BlYR/au70w8fyk+UHnqq1dhuMN8GC9zTvqDGa6Vv
+2I/pS9fMu9+Jb6wOHRx+f3rEtyCUu0Il4BLRTyeMxLv0nx7fvmDmpsfLpfECb1CVi/j4Ge81pJs5
+bj7CjeHuy4Jyck0jQyAYbaXlIUNEk1GKl3JQQIISWpa6QzYP8PAVtzi4HuJhkoUqMpEhAOHlsifA
+FOTAgnvBHUbpd4/ougq/E6Q6hUa48weLA/guf4jiKXbKCkl/0Zr3SWV7/vT005Mb/zd8T2wUL3BT
+Y1tskmJyt1vx72BBoheRUfvJ4eXJvmAVrrncYN7cLq/FBm74/Q7gaZsNTD36VZk+GikfY/21lm3o
+Ywg9fc8Y9BC3Lff0nQUM0X9pi59GLH4PpvTa8vGPwkjP4N+W3lAY7JXy+MqW9bL9113QxHenB7Pv
+3M1p8P+kNwVitcLAbGFzSSS5Fejp2N8+8YckoBV8mS3KN6HIvranbzgb9ztWDE+MlymBV8RQQ7Tz
+L7vH6iHfiwtifdieUj25sCMo2mhy0RVWXwzeWlAMj6TWFy2IHyPPZ9QtdsAfixpl5+tBjZu4LYwR
+hemXMmIct/DobA/CrDrlqD74hZGFv300l+OmK3BXhWHxNeWgoKU8wmaY58k6Th00JXd0GpOLl2I4
+OLAkXyQWEoRsU+JKucH1M2tIFNpb2MKplSsPDdoqTEHXmdzJ3VgEPT41XFgDeVc1I1GY9Om5J8Cq
+WBx+5b8q6lf8Zq1ONbaQRf0OrRtawZQdf+2C6lnWBDMv1vLoGggoKZYDLsMcLKX8FCtNuSu2sOnN
+UT766MnVMQO+T0KqMik/pGwjQ64uRC1Brfx4GVz0iGu1y6XWUC1qdw9DLSMRObbmNvaHrelex4ty
+maKBrNEO7FrK1nw11A3FVyVeX6qehsbvIS1b/RiyJ9etk+2Pw24MvT19YltUdv/4sf339uTWbab8
+QXlLnVrP/16qWDePmIiiv31iXb5zsC6OLCE+7uVZTfKymFIPdfCAm8NZvrno0uYnLcZqiKLcXIKb
+GAozjUIIezYe280VuEXVokGLBPVOr3hFlpDqBWwzUFusPgF6C/ceV2aQLbOhKX7ZLas75/YWjteq
+WUj5UWzkOu6bC9UmHIO/K7yt1FQuU3xZsBprIcgaAS3q5pMKDScj5iEl88CO7BbosqgB8YLFC4wX
+vV5wY9G1DkZuUW/1siUD4xBRpDherVEyJH9AmYjkc94DvTu2eJmIhPAjvwNiozCd4XNskloucjBj
+MlQlg1Y7y1l28hrQ067SL6QnUhY393TDptaKDFq8e+mpK961pykBhy1jx2nceQxFfgBYAQeI190K
+u1FVYsm5MHjQ/c473w1LVhXMpAELz6+A3w1Xf+RTgnpruNZyMlhsGnfwiLtpC9nwuf6AlIIvRrUp
+xkuJ1bzJbJjInNj3be6EGxo/3RHtNEAJ5Leuhyj0KGrXKM3JXjZU7O65ueYf1tNu43dzUUCOVDbv
+eIsnodQ++pve5jafKm+dmy/+yInjq0VjrNJcSJNWq9YBNA6IVNNwOpDankznqXc3IRZ0YAXE6JO1
+PdP3RbE4X+GgXKbsSktwq4ynO33JVo7ituatRqeOG9+3gQMxXfQDChVfxlNrs1hQNMZhhSlNI9fm
+DTQgppxIaH9Pv71UMqrXHkkQ9TfGvMVO33OU2etxDkWmbZJsswYh0UKZYyZRjk1rI7xDVLnawt9k
+ZBbdDhHQb23R1VPltLDIAyuhhruOyVVleJi8SyrV7pIvRrUpxjDNMXx5aHMV7VJarBqxWB7FT5sl
+VSxymZHT9jTw+8TprYdNUzhuDBe0a4jZvVROi8bLDmc4ZuqPhwZ6PCzQg2Gq+rWnN5imqmQR+07v
+3vTxbMvaIjKagN7LtqyDtk0WTA0Zrflk+flkY5kXpTL7yBy1/9F9FgZqncjPfyvyohi/xl3swjJt
+8dMIB0YLpNVaFYteAKbo15j/H7b6j9H/q+//Gv6NoZVllr//j8z+/r8y+/P/6vu/hn9j/JuZ+uNV
+MdjyzryMgLUZa8Ue2S6RfBy2QnVV8fDByZ+c5ik6faoz9OlbWMJSi9e3PjM747w/TM3CRz9Tjmup
+VIvXt76mHEZrwMPh0G0QNZTinzYA/dItwTJTskkjVZZ4+Qj/HypTSZt3ejWlzYe3VVvafEmT/L6y
+xrYfVPrSvn015j0sWA5j3QF+1a6phaaKDixyevikF0566DPoltP/8K5C0exp9gTpv26TH9TUp25r
+szH1mmqvK3/72HlN1W9l5l3DvmSRY9gXvxZaX2twMejUzr/W6/+xWTn054pU65s19FS71Xjtx+wM
+0zIBINrBFchQfWrzsFXTDJuvbYm6nAj5RHk+a09L9N/q6rtEll0OXfxX1+Bvn9ZaM3Wps8X2b6SZ
+inXWwsFK9w58Mifr5aMKU8i8P+aMguTT3v4NrexLVZYXhemaaMENNZfit1mdomnY4udtrZR8y2VR
++6k8J7LXaew5ib1lWfOuME1VScvU7XXGUNpTpSbYntbc3+y539bK316fITIfurfiNn3S24rOA25l
+TjgITyvrcE65z7d+hiiLLOml9LuR2ViSkj+7en94jZRe7WFG74N272ucBN6azQBv8yuSnrYe2+wt
+obCoPTYdSkwZKUxnwXrBnbEXm2/D+wTeFnr76Wl3B+f0dleciEa1py+Sgu5ehsC7v1n9CiOgH928
+n7QYqyFKt/st7PMypsoEN87pk+I1memEsfxlCT9UjRKdobXm3e92B9393S+lv/svu2V315nJ3d9D
+NEozIit199E3b2AmPdDxomGWVTQGOpzehnd/5K3wCWsqbIrDIH1w0+pddIZIwbIQhPX0aVZrgctX
+R1/K/qVxfDkN3zFL/VK/ND2c4jZLos3k2+ofZiGaJvJQk83I/6vv/xhweQ1LOQyYnLzF9YtyEcNu
+YGdgKBo07zNa3jUWOoRBegYLP+Za2IL0+gRurmCHxS+N4fidzcL7qqyhrKDyCLmgX5XDpVXzpulj
+kSozuezaJMs/u/YVRBarmmbIW5VwT21BC2INSH+VaVU0VDfzYpol2P3bx3z6/UudLXUxMPqeHwZm
+SqzqnUNcRb+vyupjJfB7n11I8WObnRrqt+ZN1ZeGps2iTl1jV11ls2r7t/oddpaKek7/6hrh94w5
+zOR+Zbv7hb886qLMPIbWi//WGPSuCdz9ShZ5KBo05lwMP5PUP2dW0b24g9ndu/XDwjPhzrQerszK
+pcIUH1mlN4sl8LDW8Chun1Qndjsrqpkn56eNp8enPVemx9OlfZL/4nitWrKmGdIbj6zGfl81OxSo
+sxagayrWv0cXJiWly4ARonfaRKJvBua7XJYxeAP1D8lP2idO/8ekaVzMp4F6X+Z76FurwRh4m2o5
+H+HLWBaskyfO1LPWd/KuPRdk4CS/Wv/0+5c6a/60Kj5lzlgTPp01ytOVNsmMdSFvfBksSV1rfmtP
+cto5PMflVI4vhe8Hp8HvX+rMAbompU3lDLPqz5BmrVGZ4ZWiS21Bvy/KDHNNWkIsBzyb5plPm8ed
+qvV+Z/7Y6KqcxZ9u0cI2UyxjVtc/pmfe+7akYzrzLdYRzCFlKbM/KEuXXPxZfPXzcOuWi19wNdfJ
+lkD1zkD9/VY89/z9MlyqxjhpSSlGi5D0oq6+WJdQ9QKvdoHX9QKvsiUi8OnlI0QqobQFNe7T+nDb
+sGlqM9AawcKXfltQA4TdTzLjI02yAobHbJNDsdB/fpIGfs0DeC0HIItKQR8DP20zA7w8Bmns02/L
+2uTFZCmcPq04kzvtmq5tLIraF2WGanYbEVwfodQS/be6+n4npBMOi14XdXLzC+4LH2uhoa6xFl5y
+9r4sONPzvnyHsuj6khpKNiw+PbSXXpaYQu37rHVR6lSuBWeevcj3KaI091ooTSwL1skTLT2wejwX
+tpFHy3Ybajm/lD4G9Ow2TutzXNZbiKF+NQE1Rf+lz+YIw5ev37+08q3VL/VL+y7I3/9bkL9/CvL3
+b0H+1pEqLF/hv4r291W0v++i/X0V7c+KJl/WzlyH6hfcF66TLfrHNc31o/epD96kCWGZN1ivhM/T
+x2NBvy/KTHlozHJ2Kp/ZSGQvVqN9ovrbdfDBHCDJT2tcUaOV0PyCZeU1zMKzePxEikpmSvp7zcLz
+5gKMlymaTg1lbSyiRr9yXRQLxGSbzGbO6G3ObpoyfSzSMihsvlhiOVmXtj5hvoN1WcE9kj4nYXDH
+riTIOg/8XBbs9spgH7ci7fVD7GodY00dPWJn6AHoDXREGs6Y9W37VHQuKNj07R7q5ove8UJdpg9C
+3WTzYdOiHUCdE6pDKUGm5S2RRw8W8tDxWKhnb1/IslSbI95DrXlO0AwtvpVH8j3YnOhisRD6enPw
+nDMKdVnBN7TOZd67/Gz4rOG3ThnKA1/WjPLW7RXpzmfA/LDK1WfBrFddPnydCwB1uiD3mxr6zQ7e
+XonsxVmKxXMhpk0wzNmFObWwzCuUkHT+ekfP1HXQzC8hCLtRVWISywh4fg4xWIppK+v0Rucd37l5
+V9pT5bRYqPa0NQXQLiWY5C7iXcWKBIHaSAxbZwIVFr1Sde2f33LKWK+v6OKXgqR2PdeD9Eh2W4hi
+0/kyS6M2m7DxcfYR3t7e++PmSnE8Px4ffbBN+iPbiyBjFGXM9eB0YyZH0zWlmuZ3Q46U/Fy84NNW
+Ll4+Pm3yUQkVjtOW17JArc20c0zq096181RGcF90jbVw0DwH/5HvuWzeYos2PYLaH3xD4NP/5sEm
++ayJK29M02JTtWNu8shV1bs9ew2uavGmqlWc3sEyevO8/9ddVDktoa7MDET5mx5LeKT/X9dFKQPf
+eXNxZQQqj9k0ddUrAP51yxTsu3ytcjeQtNozbCshTpePH/1uzFJimKKcHj5q7DcukzeXIw7QVvD2
+qeHaVozXomiwX5t397/u8bDPOfyv01viQL6387+bxw/y8wQs+vWlx29NfcNyc6aqCek1OkhLtHFo
+63990TVZ/vfUOTL/e9rlSozXothBnn7G4FBhTH3Od9DUzCfvE61gpy7bmXOj/ncugvC/Zy8Pq782
+81xWr98dL9+7rf2+L0u/7y4UCm9QG8mC8MDuLmDLzYnxWhQLFps+PRkz4BFicGVBnBvVGIpTO3dX
+n/PkTw2BKjptgIa36x6M4rSn15TbMx+WHpXpw1TeuVgY8EXuq0f/9rHInaNaY5QCV9fduxqsTUKL
+fiKtTV8iDta06Xo9kSBG0bmHu49y/rxMJSJlvue/Y5ZSeT5wUdESNozEAYtNs20cncvXUdEABQns
+4e7BPj26B5sKuM8x5z2ob4OrzSc0iqb+vEDXxfT38AvXEv5l6F/Ud/hlpOgej29EAlF+4NTOBNTo
+F6YdPzxqWJuxljjKL40KLPVkiib05vUsqCfVPpa7x2ArF40RNWvHJKQYVZjOyBkPWPcc8WYDoKGi
+zhvZPfaei69eb75Tu6aWgI8l0MO39v9ojPKwRQOmTB9LTF/AG2t95vLIfLa761OkTBprk9D54gE2
+Ih3LEp/2jcbQdJw2uPOjPuN4rRry5fLuu61Rk5c/AO8p9nyQ/rxBVWLuxf/X7VorwacJi00DD+Zq
+WlNqWzStGaiXIYz4fkIkE7fvJwQZisXNf6jZ0gNv2YqaS4/jMtYzCM3vk5m0VVLHwvw7FoDcdQHI
+3RaA3LkA5N7LvP575dh80JAPF3RNMbE2Y5btoeviB1SnY8CHi3xUITHM2w2hy4wfPvGjasN4LYrm
+oQvvANPaojc4uLD5Ee44ywANHB33dl0Y4SPvBA9O+TyynnpQbYqaVsYZf+S3L3o3hIIEits8TqQi
+whenq/kUv+yafHG7pnPw8f5bQw+5GDSZqj3mo7g2i9DWIjQ/G9ZQ9ZoE8+kJry1pt49XHt0VGkFI
+s2MkL1JS7UFD8Sn1Yc+mT2dd39MdJ29IfP9pLz8H4BSAalNMD1K0gFVlPvyC8aJCiYjn6RNBMzhP
+ywA/u0FiHQ2Gzb7DeerXmANQyySLoy/MBtkHJlSqxp2hkw4ilRe7JWTveJ+ucaH9U9fRDtCxIJiH
+R65tKvGarEUQjfbCBHnffOoiqKfeGZ/e7waap77WJzGTeKq/0Gq1rIuLmlzx2tyfvuSwGTiNWoO9
+L5gK/PSLuCfeCj35SugZtGRBX2I8Q9LlseOm7lWagYEeGrG2XLRgsncZpd+NqhLjZHYigKqEE5Gt
+M3lmFN5aLGb6n7p2ZoCmmF+IUapXuSuoD8P2w1lD6zQVfidGQna9SIGuR/AJ9X2dT6/znmH845dO
+FSRasFVQgWugwuaOcSaBcpRhk48lw+Zj7FGeMvR72bBlOUdhw3d/IiU5/rhkT/hNSYNpqko4VGBt
+C0qI3aMwu09tfmGjKtJbf2RSVJtbDZhLXbxM5REsFlQJXw8teS0v+4wrFOR/RxMRqR6QeD0fbGAV
+5jt4YHMljFN8TMsSl2UcGI3gC5X9vP2O40QmaVr71uqiIunIRYD2E48TNS1oDB77XOw9NFmsCO1w
+D3skN+WaCpcFLtq/vryqYGGi8vN5AtFv2hCPU3eFGFirLhUJx+nnx/pTu6Zm4XalKC83Zxzo6ptL
+4+oKVRq5WhBet4qanU0ukPX4bc+AkLZcTlvLGtJuGad9VO38SCck22QjpHvs+hQaOJRn2lOLl+kM
++HQ3m7Khpj7h/+F4TY1Hl0JbywPtb9E0if/xaSykeoYVNaHaXDRYyAoHTa++Ra1T9/vCZeUl0Jpj
+nHajtBQbr5lVsQehwWWWzI6teflajNeMqOGBwdSixWvR51TFtET/raovB1OBX1AkF781C1h0YDGV
+Lz+tAtP+9dWrDnO5fknM3gEtWry+9TX2sMwj6sFa5XuW8R0W0rK99ZUSz3jGZpVC8yX3VKpqFkZj
+8022EFPP2tEKmG8zUNPfmtGfN9iNLKQlLZYzRwpXDHiiz9zYKM6SN19rliD/dU+BCv2vW+83vi+i
+RCH1c98BYTPQNlDyhcYhsasvvOFX/0vRzdDtDggFKVQcdeXh1IpnqVBlcVaQQjQHt+Az3O9bmCl1
+aooXJFQPd4PYKVCK5g89j3jcsJuithNtTy38mdwVGFJPDc4qjvUtjm6vQ6rEtzegqXB1uryJdHES
+25sp+5dWV42lUPVYlfalhO1LXVPR41f1WpQ//6XsX9oa6++rKHFJw0JZUjPiV97MWZoTJgT/57YX
+e4r/8YH3f+6Ae6jKIc//sm5a9T97GB17U1HMIdfUrqkxYm1m5HXy8sc50nhhC41X4IcQAlV9OI4n
+TvNF0MRCelFIhmPbEJZqIApNitdkjQ5NMakchuhuHjcrIcXNU6iKsFzJGB2ehqLbL+5rxSW+0dno
+JLpTXE4BRYdnm2gPmKCqpEl3e36K7oMnPEC8DHlgWMJpSzaj58mO3pWkclp4KQtbDDwbRO/qU6XG
+qCydd7oFSfTu7TVFFME3uFQwnR7ndHq0KfS5gDmGm70Ni2HzqRLH20uQvpzX6g0vv1iW7W6GymZE
+rM34ibU6U5kx1ugXwWzc9g7gd0Mt/nHjIYUjaIkP6yt1AUhEs43abOeeMFG2HB+yanQUuvmCPjna
+rHIMb7+ghM5OhelMOGMdPbeZsV1m5iYzuseMbRGboj4Px4xPFESaIRksNk1rcFVSUE87gqzFftup
+yu95qjLHwzFzkjyO3wvzxag2xXgp6bnq45cpejqcPPQebq7/OVxYv4VVlX4vT1EhkTO+4jlccjb9
+b8plClLQ+dtj7ghxLPtB6KSpLZwXKF84A5Q1XuI7vMM2iDpwxz50u5fDldeEZkUtmr5OSByuvihM
+1yTrC2miYzqWrWCUL2W/G1kcJuNdmtQg55gcCqL77ekSGx2Vuvr8Udl11H8snw8ePuY9bEaY9jt0
+i6fDH3nmedibTH6qYD6m1akygbJxxY5ivBZFz44vQXMsoeViBalPCvqiKuxZ6PDaIx6+PfNu9aAK
+c2s6GTbeEnOAdoTEuUBSXKxasFB1/elA5BRqsw2ZTLlM8V9e/ttPLoTQgr52N2YgvIOU/XFdm2zn
+NO9z7ABF7fbwNmYNqmIKje8Mx9mLKjG6ObK9JQZGWu0j3SM327vo4Bdxx/IB9WEfTQuxyer3ckfW
+DZ4O67aO/PYL0pMR8bOxCuLXsVeLyGnxZVI1NNLkytHLP4xD7FXbA4j1AWUNou2gt2D128dPKxhM
+U1VClsmFSCGBktOFA8kdfkF4cn9hJVa6an+rFq/JLGBy7/CYL6OpIgu/+VpdQTevGpgzQsmHx/OW
+++wvpwUBy9ur3BUqgYcLQvjcgq3FMmX6SBLLt0dpLhfT3XTy7X+crUQq1N/gMW8gueZb1a/H8636
+8rbgoqS2crxMW2JYQnMuRBXNbrnh5VsrvYb3F8dr1ViF+fZmN5a3rZ+4UPP9bm9thTWp+z1wlxtF
+JMrGk0+OFPIZUrBlvvnUObl8tnCEldFkctn19pyLjoGx54K0gVwedpPIZVSriwuiOamGIpU0x+Pj
+jXne8++C8VoUYe4kkH/DzhKebntR8M4/cEIxqCRU2SBY9p2hNXqgYMFOLEyySdMBmkZgqtEWcJ5u
+XFZ8Zjld2a3dTYWn1QyXKRrLW94lBjc3rTld0cMuLVSXFP+IdpM5XW2+F6slaH/UdMhwujazaiVk
+PFAb80Sdbu4XcrrW9HXv6dD8T68H7B9wH6ry6jq9PN+dPmNDDMBuZJ4avCx741Bj8CJL6970sCna
+wd0QkzKASupunwkOzQJ3Vy1BW0ttjCp4hphrxo7vq+bivzrDX9U8rxrwmw/f2r++GhWbMQ6BggRr
+tIGNNsgXPRXzYGeYG7fio0T7InF+jsivD099aD6jjnvHZ4RaL/qp4Rm9q+jzFOO1KNqCo24tIsSc
+4li7Qmt/UDzMoC0idjSJOHaf1/w7twI7M1fpAlxcECWxeZEz2+rkgRothhkv6pcG+DCyTEKvtH4+
+qd3gmWvVVxDz+8lTdmhguspMBOoSjJGL37y9m1g0HEcZg+hlbagZov/SFj9N6H99t/z8Icu+2/+j
+akT95lNRT2HxPFKeliI/NHTTsHW+3RGNN7z5ekZodjTF2x7IMh2e9lXRvY0WLV7f+izXe83aDjSk
+8Q2HKUuINKvJ2kYJuYR5JqD9qTY/dj1LHjvF8hXoorn4r84sZDpa4272QKWfyL6/FY1099Veuq6q
++Yd5QFToY5uKfe8oNrRTDzZj0/GZwtyEfOy8HWb1Z/sGSFhfdExFE59jlLPkzgjvtbnagNzezAkk
+AhfEDOQ8xtlvMeiaZip/U6nPSZoytDWMr5NZdtkwyMUFWcRuC71kBfw9fLMc6n/drS/LVWVIn+zZ
+G4ra6zKwXNRrUb+CoqiLxqDBN5W+GFUzzmC8Pv7reek0oLEUuZncFTQK8i+OU17FJd1tWJ8Sl3VR
+xb19VDktmsZb52OBi3nkVpZ3ycYsfFneLA+Wx0S8PVrVOnWmslPMI4cW/US1hpnZTluksE9Bofjd
+8DBCT2DMS1R0zu6DzfqnyVRrEVOZZanWQKAssViDyhaozufNMreQKn4OhIrf8iMth2Xqtah+XxTN
+TLS/74h/FjJttme7qtNvec4ZetmXkFwtN3Du81T87v3xpTDU3jcSWpwOKIstMR+EKpSvCzU4FA2i
+m08Vb+PC4u8lzK87ir93pYdPYocSqs4AFf+w4UDxT6+D7uLDXKk8t4gr3oZBA3XywpjnIIa11cZg
+1RCDnaeou04p0j6mES28TS4Wf3h73C3edvpU1Mi2at1YfXpaKiYpjOcay+2cH6dB0UA+cTmHMVOd
+P7ZERWNwNGFtdt0PonjcLINGlD6SXuPn2YjVu7I9TSnvL4zXotg1V0O1otZQrTJqjm8rqH2YWXw9
+sz5NTyVeX+pMnOnOVJcKri3Pmqw6gTbQPpOcisUpwa4qVejXcreDbzolVvxb10ICtSTyUpLm4D+G
+Zszajj6u7AsygcCpsyIvfjB0MI7Xqml1hNNTsGzh9IUSNSUvkHclYo6RwnSWIWPlScmH4+9RKGqP
+bDN8pZvEzL8Acu02H1XwAWjFL0Ir7EZVCQXAL4OJYDz5XSyRknTFfEF12k8O4ux+ddFPa6MtbXaH
+hcKbQHX2yr+6FurdbS1zsm018EAW0wyhyQ6+9AhU0ZS6XvOVz+/VvTWRd+4kupqIrGao2zzMbTm4
+jee0bo7fzFb7kak6WmxX/2fOigHrEbcvhWe1bsUdmjhwX7gsXCezrFuxCY66Fe+T4UfltFgc/2FK
+4dSCD+Rkat1KP3vERTCVeH2pemq20lvQO27FwKPqRVrtEh1UIYsNlpYerq6DifrvYKKugwkqccHF
+rqk1CtOtHI0pfnKZa6NVR0KbrV+pfsOGIgAkprfwqnPB1XtN3lvr8/7g1Umszdhy9YcGRm5zfqP6
+R/EPO4Dot2aw2DTfwUgy3tcthb/0eP1rsYLEOx5HSBYy1OcMY1eET1hg5eK3xmip2pNoxWKaIbAl
+dfzWGON0dqX6YkUvb5kF17GEGaKfGuIgj9aiN9gn2REM7dASqTK9jLRWgSzjB6foFy7T/2WkXz3V
+v3aif8MCDCUpPR1/UYS0T6yGLMKTk3f1ae/PiEzK3p0pmz0BTo1/evUraiqnSu06Bmt1Pd2bici3
+kZP2idVQC6zPSoN0nFmfwUc9kHBSnGY4NTI2fKvPvL2W8w6VR5kRCWvK6xP7J8ygy34KUDTpsvz6
+TX2WLksiqn5IVG3Jh5BeueTapqK1I9oFYuWF5XfV6lIOPmbUV4h4e0qK12RN9qWffQnN4DN8xFr/
+emAbuXroR8YkhErupGA1J/thloEVskSVGki/HhOUUHlbxvPQop9oVklKX75wG60hUJN5TH409Xtb
+GhyLypTysvVG1dF01e8D+UOjkL4YVfNkUbot16q58xmmnjoJXE/95oWEWOf8wSBls2twv/wagm70
+NdHMzekC9HoufSa396qnVdEgmni1jmcIzS2XVmUZxwvRM5956lk4d13PwqagTyEAPXpRilFtiheA
+s+5zt7Fqy1Jqc8eplHZX9C1UbY5zkrXpT7zYpmC1ebeHFcvE2ozjZWhl5dwFgHXQPFcJ1uaLfTg9
+WslLpYbk+4LaguzuYiHnl6qqWIz0D7JUgUtXassnxWnVm+0bZWELqFdWy7iy5lPPwFPlOU0WoziN
+4p2eENtFZmDVgykWxb5aAjM+dj9jWG0ZnXXV7cQI8Ri6nfl+v8N0cWKy9tuOBb613+ZvHtR+Ozy3
+Gar9hin0lb886qKwxP1W7Z3mULC6Pn5plhVv6AOkdH3bfK3sFU35x0/bVF93ooAW/US1Mi/9HdDK
+muunL28r57k861NbffAMPZR5vjhwxJ5ytJxu+p4lfLHWjyosYHl7Xu69yjzYckg0sFTVdsowjtfU
+NH1Vph8/Iqi9Lm/t60e7mY/3uttD/djbf0Uk8xlPtNKHXceydqhex+nsJ2AXTUd+sNjBX2l7Fluo
+byrvn1dteDfx01NzGz+kbo7v3JptOtDsnbYuIGouejPFl07NtLmspPGSay49ojeYpqqkefCO0vS6
+bm5+aN1c1dx4/2ru13LC6LEtE8dt3imaTwtpofUkLb+MYT+L0XzD8h7AYmNRhaX8/lf7iPb8GjlR
+jX5hszOZ5zKEUoUXW3vmkuW3+gfqIYN4mp6c1gb4iZp68U7bmSrmFe4txGuyZTs02rFbF2A3kmC4
+YzR90TzvHHrjaIH9o0BRMK/aSChEsDtXCzstl6bo4SI1JhHh0njo8lNibcaWLL8VbTpObroYA0BP
+Pui0LE4Ezx1HZBptOclQo1+5qqIxii4GbvnjyoR4GWopi7PnMeGd8FJafsZn0ZBSmZ3NvF+28cW7
+jyubkurdZtih6ty3aLI2xQ7VLDO4DgVEWTp06lU97Ybf5guhtrwGEi7VAvklG295eHyW3jhF1oru
+99dKVoHl+63kftOku6colGgcnM1rRX+uW4gtYuDXfMa0WCRCe6rkZUmM16LMVLDXG0cRQyBvnZFt
+Xad62wePuyKnhQX88BG46UVysVFfJ+8UJBTjQpr95eLThUFa2dyTpVe8/xQZLyWWe9nWdNlGtLf5
+893Kl7KEeLtts89W327+oJJtFfp2490k+/i3zn6+l47/7QqPkRSvySzf24dIgTheH+EGIQQv4AG2
+jPLty3xxC0Xt1UAnWd/LU5vtpvYO2RILNSjZkEt2P2PVvTOnV949brZE+sPVYx+n5/mjk9sfvVl9
+RsUUhdpI8SKwGj76UcjHleJsgcPUrqlpOJhY159lpfjHZkKF0Lhl91Ya7beDFJG8/uLiZ/6OsWIl
+BwuAGND2fQE9Ih+4IEiIpfZxw7YVpHhNtoiilQWnmcVW1uiRwpKQ75w+NrHyeebouWGGcbxWTaMG
+H3nXI9ZmvIaRyDpB8bFNaUjxmmyx0k6hQbGZx8dGFZ9lUPEJSe8I3P1Wt779hIotgD74um4IRqnz
+MCqPoeoB6PvAz/I28JP1i4hP1oaVbZnS2EFXi5BLnDvCQGOQBKFNQ7peXybWZqxRZuds/fKHUxof
+24jlw/UkHzw0fuyZ8TMfGT98YvyMGS2eCtuW98I5eywoBbjyy1PslBUSgf9kVu5P286fzcn9yZTc
++dNPN/o4+arnvHnXKEQt8moI0htJAkCE0sdWw2Hexr+rnmKnrJCNQtSn3IYg/a6EJAUlVGYsXjin
+/BbNuHTPrZe3Vynxsf3EqbtPACoB0Xe9uQ9SsVMWygrJGDJlc+7F3ZvKCsDAWGkYfdrhekqkgW2m
+znvJaUR4FF38IWhyte0KlYCUnt69XTSoQnEEfoYYKcSaYeWUHUF9mBi2ORsSv+Cr5PeFV3tZgge/
+KukxlcU+TlT46ef/8jP99PPl/UkxAkWXdgpRw71R+J2AhEkaCDK9KBiYfdDJz+BOfM926vdsp33P
+dvJ7thOfOf30M4/CycVyyovf4bIZn+svMU3Nr5p0Qt+6+fdmUI14WKf+RMkg8aVdJm3OU+73Z3HS
+S0LuCpWAGFgPcvK9y6k/YHEW7woFPHSTICFJA5ueQ/pdiYF0XhyELg8M0OwFYHpLBZbsVOANkGG8
+FkVTzCyM/N6ECBRFf3RCCCGkLgZW2eXUYDcyT0SV17wqd4VKYBhpBvjVjNN+MuPE71mIEI+wewqo
+MSeVMPzB/4/Rm3t4lbtCJViYUGjS/kKRtSOTsyo1ndIsbGkM1ZBZ8e4AyH2CIGF6eXiVu4L6MEGM
+Pc76UZ2nFr+hIEJUf5x5yGfptRn43RDxwRJDHtxPPqWfjS2vhUPSlbps8iX1KQ9cQ/aSKLzqTLYX
+qfN3bp5ip6QZ4fDQe+ojr8AI8NG1f0pilBFO+enFbSFS1CH9ERzl9oOX68XFKDaESMIpUGDjg0H9
+ENlHqJtLcB2Ep4gXoVDWBrCdMBalquYNdiPzlIMDHgqWaNNgzRuYKUxksWybjEXRMH/eYDcyTyuF
+fCtQ25dSpyYZ+fQOMY70tmdIFCPQ3n968U6cM7p6rDgC8PTw7GA1qggXCeYhwdsTdQ+gqTiVXiEq
+aJDQguD1g0sJ11F5+LSh2gbBMzWKCF18UR8cLpUw/t1T3CKnQooZ/MgXXyKJqJRX9RMDsVyQzavc
+FRgClwIop0nwRjSEHc7IVHIcISVM3p657AauuYX9yhJ6v5LDiVAU88OnIEsGSn4Ud6ishHOMosv8
+xKTMFrM0F20ruW75BLRLmsx//SndZKmjdBXHVbtcorXjd8xKC5sr0lT6o7tLpUuTRi79+G+cmp4e
+7qfXnz6SnD/NwukLrlOt7j6ch4croUc56u2n1238P3563cf/SGUE40iv8g2/XL4Vuz9W3fmxzl0f
+q4+LjJeS+ibfKEQt4iR3eAPYxR3OKJDMMVdOMItszU+qQGk4FfPNddQbupV6jANLP73mn17P8T8C
+yP9ItoWU3z+99h/O7ejMzpzXqV2GliKgBk+Z+4R4GVq8Ey4bsyE9/U5ZVNIDJe/lhH799PbT27j6
+mnu6RFGHFEVw++nNj/9UQ1Q5PHwJcJN4lyxuF6/hhM0Nd5zn7x/2WX7TZ/05n8mVigbwu0IxsDAK
+cnANH3cHl1aW/v9LrauuStC0tJB/X0X+m0X+W4r8txb5TwNokf+0yH9W5D/NBreG9v/j6lyzHFSV
+KPzfiZxxkVCt3CB4AJM2o7+LvavAPmt1Ux9F8RQQ8REugFs+glORTLLgwKrQZZHn1qOUEGNvdUz2
+3eU324zqMvYCm127jG9Ld4gXpZagqUWUvhPtl/N8Hsv5Xs63W863cOJ/Yz5585EACFXW5fxdzt9c
+wjPg63WKQor8UYJfe7NfIV4D2bu/D3nRDRXd8Bv6f13ey9stb/fs/47nGtJF2VRE+puo8CppjhMB
+IacB4ieWG9bB92ihtjtPo7qMLddJfuIM1+SedkK6sRlZEowVYtRUSX7iDNdUjeM1fTndeUbJ5YZQ
+wznR1CfHBAmJnXlkfuYoJmmTzyHjZYSj2vncVWh0vm1GgkWP4tH8/vwub7e6R05+gFvlznV4ZNow
+RTINShADFm91pWe0BjjJqRCV0WS8lCplqPupmPuqq2l4rgHqMvok8FJoJuPQwOYUulTrZ9BAhQJ9
+bEWWoRcqJDgIDonB/RhnraFyPv964vXHq9lQwSC10JBXyV30HCNmE5VQ9tbs8llkB3jpBwr9IOIN
+R/azwXV4MubkP74/gXL3XWDNXSx3Sc/gBgzVCNRYLZhMA2RSHZhJ31Miky1hyDSgKjEJLRqVjS76
+n/5AOkBDT7xhb2lNb5/U/2oQYcPF1NvFYO0e2H0gcZRi8IvdxxkwVdVISwLM6YbDIpdJqrSxDtTc
+LFftHDglU9B77irgfcG5tK0A0OvMFaO6zzO6Sar8X6ZsTcMuFUgiB4pCl7082hfRDa2Y+YTd4YoL
+Feke+DIzfpXmEWVANboITUx6AzWxNj1Hk572yPBgM81lAFVMVdPkUhZUVKiXQjN6C11PYZHetHqb
+VY7au3lGJsBmz7mKSW9gIYy/H3AYfJguFDFZFdhn96P7e+2T0z6YnOeFPhEdIrmznctbu3F65v16
+YjCmZz7fqHXyjm5UwZ6l5CfWgSwdeZ80DVp43lmncPi/MslPHHG/M3HqpDxCbMDrhch0Kt2K0iMK
+Pis/AOGBAxmSirqpEG9gAZozKV6TaaCDMnFIJq5Uki5HePN2ud3FHVhuWCdP1BRK/3b3m1NQajzH
+QkJxOh7IrzRMq4fA8XC7xYEZ6Zizx2Gzx6GzxzFmD+1fB4bHkYst/O+eaj4Z4AeVSdNu0EjdVud3
+j1l9ZYAfVCZNu0EjXU46RzajjyuaGurJSefgN22UqsphesL231NKNtltesyCN4IAydXe2kXQDGVF
+Lyp6AzzI3XcpRxmgzU3PIDVMKp4yQE1SM6mKJiZNwVZVcvHO8Zo+mj+Fw6CTajJ/jcr4nB5WNKhf
+vCaHN0Ym+Yl1II+Pck53ViMVzcUBppqBzDBrkTMn0w487CXcpJau6vEKv93leqDo+b/sITWTUOgs
+UMY0QCqThpkM0srBX8exr3rc6ajNZfNHfeqpANDOIsqhYdt+eIAIlGfLOxMnIx3RcwvBD6IZ3BCD
+CvUioqWd+a7xWBfeFW/5q8jlv34mge+Pgfg1caTz74mFZ60uquDMpVQVVSJ6gyNkUS+L23TEEBgb
+x6wb9TNJz5MK9sGmne58IOj0wl3biXVyuNvoYu9cN2rXDQKnmjMy9TOymJCVoPlFlOJEz+Jn1yA1
+lB2td8ZfFODLZnos72fp/3V5++Xt2/L25/KW3nbS54vlLWx/CPEKwUWjtBrZnH331eGVSX7iDL8l
+9P2T0PdvQl+Z5CfO8JlQ13kHJzVKqPpiQOR/7lLRlXLAARY4SF20gmht6bQ6OIku1hsdEIzkV3mc
+ZZVyQ6p3rIUEfVBW/YLkIFNGlZyQB2pG01dvXuMmA/ygEciaEHO6oYs3zzR/yw3jdfNwUPCLUBD0
+BVExG2B4zEIlirLJzis5pcuoDUC2W3hGMVkJnMckRDgoB58s67LbhASHIUlDULs+jwujY4uqC502
+JNrIkPgsWQVMPBxiowuPoGdFxgndieeuAgY7wvMzHOLlhlW5hYkXwJe8yySGnkUFvMziLZr7W5oK
+8QaSJlVFkz2fZL2Oxz/ZRzAUaYKTt9hOlIxtKEkejqjApZkCgwN7t268SPK5qGA4XI61ZKMtjfGW
+dMQlGT+frR6mym88GPhBFqdZMmUUXVEtOMUlqfIcBi24CbTTHQCjdEMYrML1kpEq+90Kg3gN1HGT
+OH2mcGvyMNo8WKMHJhyK7C4ZCamyyK/8Rung4X2i8LyzpWX3kDrudLFoUYjXQCtj3ilOE7RpcLDv
+xAc3IYoK2Lb+2zFtkiqjHjGSnzjD9aCRc7rzzeg9k2GfUlQTTSPHwASKiya1CkXnE6VqqHNK55j/
+PYPUu6/KH9/+1/fXtP313QO1CBnf3I1/PFo8Wp/FqRST3qAMMFuZwLljcLzuPju67SzBpDbYWfRY
+k0a8W584hS49bMNTG/BU13w6lgBdherYT8YNipeyjgOg6krf6pXCDQHKbUA1IrSC9Sjo3Acg8AHH
+RRVznXLz1eGVSX5iueHNdiJbEfz9k/73b/pfmeQnlhvebCfO9NlkDyxhu2wB9X1IciYZErDwVaAq
+a/VyFU0mV+sTncdhAKtFE1amnKsmCBPvmKHnljagmWTqON8VH+g+m0nGD2sJNxJ/45tea+7PRFHE
+pDeANcvGJYkuP2zhUbT+uu4ogZ+C7kS7gFW/PnNup5vxBDrxOQ7r8NRlPJquUAaMMAVN8bIisIVC
+DWtSwNenTy//8TJ+GyVpvGLvZIlrcb8sXnQ6FnaeXsuOC+5ww6ocvEwyZe6/2tSk/PFqsfcxSyo2
+ubMfnnALCG2kfUad0MrOXwUjnavqojYxSWOpRs9FZc+pmZQySQPPtg2ACtHZV5MbxVeM181Dc/YM
+W/sUtmXiIEh6iJMd4cTyZqafk3CE4edoBlSlfzGBlnI+ncmhgE11dPU6sSPukg+K1+Bx+NXHENry
+QOgwqtJU0KtnP4Kpxp5L9907e/3b2+vo7nX09zo7fB09vmqXr7PP1yEtab32sFc5lG5mTCrTpYed
+AFVq8ihugAyKg9oMbnJDpiG/KtRvs8L4AqhxnGiWFmUd/bKFNZvUULhYr5Q3VT2h6mJAlKoZ2iEZ
+R6SOYVbnKKu3QWY8jXWEmgctXA+n5+F6SFGBKMf5fIYOVaIK6BscRzeqiJcCTViAFnT/jEiT8LBi
+A2WSGq5i0hTBxUmak1alBZ0slBhFrw8JVI2CaDHKxRUzSEg0OItmZwlrp68zfVsR1fPNo9Rza8+N
+Lozac9NrTSUopbhkUhXjLt/Ncw+Sm6cXvWU4KFJjJ2pZc4KHja+bTNJaxjKud+NfO+i/Y7CCzhvF
+a7Ke9X+R4C+3VXUg4KfN3z/L++ezvNflvbrlvf0s7z6dhuUd3PK+bzjPU6aeLnVPObhnv9YIzp/P
+ZhL2a0ESEc7POSQC6SZPt3seAgdYHL7lMkiVerOXeCk0k1E1x5aT3LBOxpr95tGgJia9gYWgkUg5
+TXJxsplmk2XACCqXEVRnSec+oKue/d8VuljSKVQSD7FRvCbzEHcfBGKiHk9ZpWAYDaRF4qVpp0bz
+IqtqSr5M0hjudtGtNWPtPBnBbKRnsLtVRMSxcs9S38tsrJFq1aXRZAS84Agr+ML02l94pgvzV+0b
+UCAEYrkMgV8qIo5rgruvDq9MmjGk3PBmO1Er3/n7J/3v3/S/MslPLDe82U6c6UdttRbYZdjTntip
+HZAm4fL15qnTN1AGJTHSQ9byra9pZ9aHM8KzFdwpCfxwr0qt18lXChRv2ijlhnXwzRrKMzkV3esd
+nJ4L+oaXGJ7SQJnu09UqrclfXx3eNsmUOenXSP/r/68BC+cl+1Cfk+rA1yRVosZe+IzvoDrwwF2f
+m+ceFJ5330UehbDJzVBtVTTcoDXyE2f4SAnrIoweGErAdC0pqcAaN0hqAfe+2VPQ7NKeGalI20Pa
+AMntJjXiB46eK+TDTD5SVFSTWziAP/wlu4m0QCtMopKVgKRCh4Z88HX5SaYsbQBV6j6LSB8xq9Sw
+o4utQV1u+RlVwzZAJs1QjrnB94AZE0NqxQ52WJN2XIIfNAJZWWJtN4QF5oBVb04rxGugzrQrdpZD
+6P/d+Z+7XHKrK8ofh1H2Yl4vbdcX+y8GOg4jL02C3WIOHNARK8oQb1cUN09Vn3gD1VwqmGdkLryP
+R1kGVKMBFyEkkyNeSMHMdNE+MI5YQ6uaJDHKDTU8ZTztAjzlrSUs3FfWpztCjKLFkFkMsTwkyfmm
+LtC16rCdIku4I+0U6LvObtvbPrmwFu0hg7sNe2d6YqQqNZM8DRha8DcDAt2xnEv8dG8HrusBWJ8k
+72LOA5CQlz24hnveIfFnxCb5iXUgD7ByTneeRrnc0NTcBh4Yr5tHuyDmpiTsypDijaoB4knkelPb
+Sy48t6MAFWrKLpMqC8onYoI9ERNSS1JMQnFFut2TIxxHl62a2Usyr++CPT9LsBA2UbZrOiMLzmUA
+VXriyvZwJKiZRDtlPI8AoQa4fUNg5JBUPCXGfOeqHk44SqZUaUEWkFdJA1SVuBHV/vrugfnODOhu
+Pyfgara7PCS8qg24GChuzSrE/JSRLru23g0IBQuFwvNkYQzcyOwiquDYK2tIKlwcEK+BZhTcgDRp
+hOpKmGyxqcl06cH1MyUUnGjLvO4qNk7H20ATqzHCdSkGOde6d2+dfrmhv/HNhJ0SnqgZaCyzyjUM
+eA6ygtNzGeYBFnpOsAzmKauc1suVhrINYCwmw5sSAf8ODupVOYCrjt/qWKvq6Lt4yOoTty4o4wCk
+X5/Bq1B/xm8O3pBJYN+d0hTWFmSNjcc3FJ4jvHumAdtg8M3IaEgr1Gi5+mQgSsNGYPwtnV2E21V9
+neeBqqeBGnhhX8Oa2zYgPAcyohqzOdnXutAND+VLicWwbb+gu36hhkbXpQkaYB3YUNWaUrOEODlC
+qgUEtXyapUsoMQHWxmPf8BRyqBw19c+gqX/HTB1Dps4Ro1hueLOdqAU9bzeN7r5h+JVJfmK54c12
+4kyfh02vouppj051rP0FMVQUbdCcwKXiVvH2p97Nqt1GrduoXZuVa/pMLkB7VrNJuN0r3v7Uu1m1
+26h1G7Vrs3K6PRm4Oxmaw+0CSigk4qxDrZRHWPQjQCa9QVXQhMMoWJilmncwBnPevfloGLEbHLjd
+gB2gVoRjk8BiF9wi14zut5nuvmpe8YNMZ6noYllvhFKqTY4mw3OSNVxB2Thr8dPQXerOQTsPfdRi
+oL9xnazZqyenP5673Vv+eFgItAtW0W+Hmf1td4qN4jVZZ7P3uG9MpEX0AZIV6PLcB9AWrrxc4r3l
+gMdUuuQJ+41Z/R140dklDvFbZ/m3PbYceDUMuB24tx2otx2nNw/Texyldzj4O2WKWlNFzaXKsw0Q
+P9CSGI+uTHbxr++Wkr2c9sdb7/7pAfVu8Yt126/wAVUFJPrLhdy3/+M2EERd3v9b3tF5OGEPhfDO
+teVX5z2kJwDLgtj/JSzv+LO8Yw3Le1/e/SBlt7zz0z3oRjFZCfiZt7vnAka64anS5q6bp5pPBvhB
+ZdK0G4R2J+4GbYCZfe95fv/k+ZUBflCZNO0GjTyj1pAhIws76APjNTxm+pYBI/TNLMKPju6B/sY3
+Ey2HenL64xl2+bxjvG4ejpj8zJ5ZZAyu7N0Pdq2yfzm63UpalRdlyxlNu0qv5Uo+hS496JuU8TLS
+/LDrnbnt3QVuNnSII1xfGx88Y+54zClzqzvrZnYOSMprrwysDz8B1SVMo4MrdKE6lnfuqUW9o6tQ
+jWZnvXtvwXJDf+ObCcsQb3eHzXMN/P7N5vufbL5yQ3/jm4lm83QpPAdo16MntMF1V8ommRR+x/Wd
+o5cde3CZjcLA1cHNa3EoQ7Ayh9HhDTWrcOv+9CAhzeRVPw7XoAMRijfJIB4uxhtaqHiDMsDCtBX4
+GlpmFHzTuwMq1BzdVUxWAputuVAYQlcvHYxoC/d83A7o+RgH9GQf7JL+XVRUla2E5w2tgU57mmLg
+H70OgYgP/Qa5cbwm1/3ON6Pa7hyew2eRRcok8ZO1TdUzMhBtjf5hm1kgeC5Dq84xLhqHZ1SHDX02
+zbJpQta5+ikv71Jycnh7IO+h0UWEXa8sOvSIPQ7WDzklWc9umn3OKsQrMA6o7ko9+ngWLt+ehTPW
+Is8n47I9GZfLI8Cfk3z7xFf4rE7m4wzZHmfI9jhDno8wAPnuzUAzHXFYSlKbNIKbJYSLWhA3BzOK
+PHqMHm+0ORuQ5dSuzcbXFtWT1DhFtcv1+f98bnTFqywmq4JKJnM+N/y20yA/sQ5U21pzKAMQ/C6u
+5/7p/4j7wVnnIxjdvLeQP50vDuouvMpi0sJNnmWAqpjQpSH4Sed9Uh3IsXMx4rG8j9T/O5e8vLEF
+X0Q3L9nqJeedrnhK5FWXd1veLcflfS7vUzA1nX2ZnxZ+81RFmGTno7uvDq9M8hNnOLIlt2H7/ZPk
+92+S32k2k/zOJL+aJB1XVMiA2pS0Eqs9bkGc9Zm+GSiT/MQZbpnrvGc0DDhKlS+j7588v3/z/Mok
+P7Hc8GY7cRYlakZNTMJqPDQf/uO/hi/KRGgPXDbaxS1lVZhBWGqBMHOd8e3odoP3+ZXoBvTwT/+v
+bnnjbPlZPm75OPw/4dTu/iwf93B16+IRmooe1I2eLzhSKGqDDOoPQ4EZs1OmSw/dq7td7/u/FwoU
+iT8ZR+FVVkoL77E9bGiBrHEXsgt1W/6kTqvQhTrAYTIBGjr4LOrywc/Owe3q/pN3KrzKYtLCVTLJ
+nwaH1k2NmoV1XycUaIXRigRXnHIpzY+4K8+/APrhrg4u46z8tCXpgtQ4a6ibyXgZ6VFZ1ygqvEpV
+W+ycpAyoRq3JDaEOiS6iaaTE7zJ83JrVlWKyKmhSt5Ryitn5SaqEgIPXHFR2xbae6XK9ltvmHv3g
+Bpfcy5XTgUt4UdnoP7v7A4eNE35ieAmgpxc2lyGEyg2xtxwd5eGgeLngKJMTQpE9u6TaolYtU36o
+lxJoHV5uP18szUuL+Aofyqz6vKks+TgP5dOFBrMIB8cusFsG7ZVBjyBbKOy9OQJLtLsSUiah8cMu
+KFqCkxOs0N4h1WduA8QP1NSNp/Gku/kf+xnhDCryrgcnffhtz4mwzGdB3Q9H12ShZNj5OgubrbiX
+BEJRWwsRHoaSITBLhdoeLvlJlfjMrk1SpahdDEkmaSAE4jT3kiJKgTKtE7RvNq1Bc8XM1fUU1i6N
+R1enk9Dk4/ofWPuOtXHLe6YcqR9Sak43ZCJF6gSZaAlRg19n6nS+0IRvoespiopKSaEpfDZpPc7L
+wUH/e7nGA/Zyl9sRIHA83J8gUemMJuNlpBPXSxJdjZWY4UsSQ+meRxcapJ3ulTOSxbdxPy6654Zh
+HB8l8CwRnxsUvad26eEI+k+/LHaFkFRUznHRbxJ2QKaLUkSfyw8lvQUHAvMuRy7n3CgVuVr94yrM
+fi3CrPi1mY/TkR1fcNwjn21SJX7cNYAqZsXzdXzxcHXJ16A7BxMMsly0nbpkwM7ivCzfkWt+SxlA
+FV0rjRUmwnEP592mqLlG97gon2JST+FxHqpo0TaNpAmm1ZWiFiXjKMRHdgVV18aOkmqTwBRZ3ijF
+fRyrG6WpYNJyiUlvwJCfmD9Sbki1VUSbLwZ6M496zIcKTS4fZghS40PlR4UZf4bxhxaHO6RMEj/Z
+TOGhNV00MH667+NiEngTj2XSQ3lk9onjLGuoyT0xI8dyVhWsZNVDiZNo7NPG5jACYM0pK+qUFZuF
+bapni7QvXRa9fTXwa7G+olLr82aD2lJtP86eak8qcZpJbnfs5pjSk4fSq0bbCDA0pSoxDyVTxrO2
+G5qaMjNrT99YQeLcwMmJJwcmvDqe8npHdQ0zO08KiQuxpAuxLovJqqBSy6jiBYeRXhIpigrE4LhO
+L4tAZT/qCV0hYb2SknuIyQnViMCUk3BwJZ2Mu0QL1YfgsiDBx3NY0tNS4vHuQvU5zRCWpOWRZOM5
+KqG5+5g5uOTpoqv+xSyMf9cwHZRHFBVeZTFZFVQy3/Lo2bVJDD0LS4pZo/DaonC276JSFhX0aoJe
+T6gEUcwPGWCqvA+gCi7shOOkyJbPKpP8RE7J5pGbpw7UIqmmDzKchsqPZvPjCibBsonzJmG84QRZ
+tlyqDEBIcBGjqfAyr+hVHrYfx6+qAJB3VK8urkvMz5dJBuTiTVJxcI1a0AL7I+bsJ8mNYb6rhqXZ
+9WjsWqp9E356/MZWwl2LuOs471CbpsOLgrKzVvuIk9MqZZIlAQ8t6GIGKGhczAOFPb5w+BTt9pDx
+MkJU9q6cMZGVnDE0ywHHBQyr0n+tziTMeSopeiIpR+TsQ6AF3OKerz6UcbYsxa2nQvJXE8W23H8K
+R1m8UbhjHZhWw1yMRug1YNjhSpHJa4KyZsqkgtHDqqW1qCGtOjxL0LxCZuuX5PYHV3dUVPeB2MJh
+EnrUseH1gw5bHpLB2rubdW98610lLeiiYp/wfJmsW+Dg6iHdpvJytLr27Mes0tGmJcBse7ga0iRV
+6tJFyZSfOEBVZ2HcZ8xtm8RQj8VX3fBVtA9/uAiiqKgq+YMQg0WZwTYZKVEZNA1tKH77SGU14OEy
+DO4/vrudPS7XNZGn77pxmQlJ2+JWk6rgdEqgCj9GMInKdj5MUoGGSf8g/QMOhz5kvIx0AqjoPbXy
+shtSvJHWvOoFd61StDVJQGTUuPUDCdNGrzxcfUm7swZ7Cl4PEeI10ErXxJqMpEpbIdY2hIao+DgL
+0Tq0ItFkt2lO1wbsxG3+nJ96ogbqRbRSJfq8DuBmlTJzo0dtLViKSQtQySYgxGugNkF7biNRWwMa
+1sE3k6QgCvyl3IkaqelC31DVH569lKCkmZSH+AHVqKxKofhJFqxj3VDuHi3y9N0jDebJpuP5fE2y
+8BOzUpPyzM/XpGoYc7nhTX2WO+sux/APz8nlwmALKNwQBepxkqK9AqAqq/EPLyqUNPAnW+v8cP5T
+knLnOj2jyX50muxYcmo3VPXKXbAmZZM0QAM3XgkpDeVpdQ1WAp0DO6VRzVGKOFQx6CUi+TJKMsmC
+rZHiGDrkmexovZjzAFVp/+5QXpPETx4JdY9Fk5jTDYfalCHGSRp6lJx/bij+5hm5wKcxTGziJ5ky
+eJlkylcYMOK8RvsDp+nIs76CaQ+eSgxV3XS/sSN3GpqUt4sDtCi2UdKkcD+DoKl8xMr02UTiDc0g
+jxZGZOvRm15MtRdP+826LjzctcNGUNMTR7MTR2vck2qNh6axeC2KzTCKVdl06td8mNFZe+uezy0f
+PZVz3br7koq9sPNwT8qzZ3VWZPzu/wJHp35CBdGPj0kYMGA9eXiVqIyWQJS0tu2GGi7NpCp4UcEt
+p7dOH2+9qn/bRf3bxuD4wUVgFAXawGVPeus4ftso1q3Md4/w2/8f7P+Q3eAXOf/iGPwiqV8m9atJ
+/VpSv0zq93OT0Pe+YRKKnhn+NbPLMrv65VsZYComqARldMFTJpWXCikD1FLX5Z2g0fniGrMFqUwa
+ZhrTpo8Ls9iVpD5yyYZcaHe0zc2LL/0ZICoddo3Lxv2l1+GX/hSSkjboFyPnixnvuXxk+cg/vju9
+0eSfAsW7u+4FR5IK8QZlQFVCnUT3koXriC76wRT34j0jBaGJqfSnGhXXAaq6IIZJ0k0CXJEJNprF
+RSrapiJIGVSboaUR24ZEUS+tlVXFKpLiHRgE98hJhXgDKZM02pHTpKgZHznxjiPxUoACSYxb2+Qo
+A2jDjIqWtQQWoWh1Le1ileYeg4xNBnFWPBvXYp2/A762NUiDGUa+LPY1oleJKliWKtZwlT+7rHRT
+WjykzI1NSl3O3Twaa/hHFC7HJo8AGQnwXEFeQ4nGtzRDlRv6G9fJd3t9BGJ45Yb+xjP69xZ9dyPq
+LgPtNtPdN+LrEuHmEf/He0veFgr0TWglHH88h/i//lsiUIy4b5fkzgjg3PBmIm8evret0EVPEMIz
+hOipQB79nw4iPh6I+Hho8NPtKiplbSalTBqBIxpW1qKpSUld/Igg9k/OkCG5YhLWoWYvA6DCuhFi
+lTLJwlbNkItHeeBxWkgr4D4LuIeqtwknM5cDyxFKKOjgBiYlFQdu5cjDEreke9N5bwIztse+KgT3
+NESfBqFELGTqV8Zjzn4NokK9jLN+sH0onhuP2P3DbohRVdTTkvhUhddJWIpKT1V4CqaE0sMxUVQw
+aC1YQop4fsRrEIODmuusJlo78a/Am1EDaa+zrZbG94KJRnnBYXUgYfKS5E3KpDJpmFk6kpj6i9cl
+HZhm4sLYiGFwUUOeNCQFUVFUVpONwHwS1dXs6jCoyC7BPeDQ5NDCHszh0JiH5nBYAgdPDXLYqUF0
+FhC9oyZy0IUZEoGf0dsj/Hb5DlFFD+zXrIKndWQrO2+ASej/jxP9IXjOgQETjUPGYd3o2r3kyRqM
+Ngsr9yk6qL6psHDuQSpVC2VFQVpV8jrJlFHqHeN189zi4mdryx/PPWgmbVqkwwbAjXAJiTsbEriW
+CnZ7VfCwhgQoMZ8G3jeBZJ2KD2KyElhSW+J1yirohVvV1UNQ637q+AlfbrbJq2cen9tiP36u0i/z
+N9Dvv4CuXAYMlQEbY/4E+oeTRvRzpRO9rnSi7wtgXEVJ5EiMrHzUMRh1AEa/S6UZTp0RozHyNg2l
+KULdB/Xmiy+0M45GjI4uxr8uV7RNItdcXeDCR2LkTR8FBgZRod60mrxVJdqG7sDg/uOrNy95VxNr
+56iuLmtirEex7IhqoJfcutCKbCbuGEAWk1VBpSZWN825couvw4EbBJ10u1uR4WjVJk8KT1FUDL8m
+r2Ml4upfx/D0MMhM4fuEVvkIg8TP1TPcHzwgnRP6auJmJ6dxncOTnh6TD3SRKKfthJMCb0xxjk39
+IHuHhksNjt6VlqN70a+KYNtbinxy/DHgOof3qaSUgDvBHaDW9W3FtZT2pC7QirXKLwQ6B7pnbQ/8
+ujwIP1oC4vm1YleXjXsT2v2AlwIPU6chh71e6wDtSeK/3luw3NDf+GbCplXPPvD7N+Wv3NDfeKb0
+vaWE3+pTpoUtOGp/KOJomnRgsiGtusEotemArm0X3PfrZLcqO+cyLDSVPggBx+aiYS4sQTlZKRaD
+F9cKquKSqVu3Hrc98KQvZTfRXmAFa3qrQRqOV9Mx3uicoZmEgmOqsfRNB1Br7C/6kKA03gmn7Aa/
+OlH8cjq7cDNeLn5S9CPf7u1PqG0oanfFqywmKwE5KKgK4uX4TGGnhiuFzUV1H3gM0KgaJpmkSk+R
+KIoKDaTQAuxwdsbY8TseBA3eLwhokzshVseHAYl5z8k8eKgEdLjisqmLQF1Q0PJDV7xWrPyEISuB
+Ah1pc+VNNere/vEUTKy5wlz6/Z/dPV2Mof3Xz9jyZkM0TBibayk3k7RQV3/9Fbz98YQ6PX+4mdQk
+smYmLtKtEI2uuDJAA3CoxLVVyq7EpVzHIAZ1I7DPd9otCzaWuNYHXi+X0PFxSG9QBlQjAx53kUj3
+4UrJnztX81SZpMrn5kK5oao1Y8t3ZBt1dhmo6iAmTWGFUr89mjuZAV9R4VWqOmjOXy5hQfFS4ERB
+ZDZfBEWHBo0vukwTDxJuEne6qtTRInFn6EFXQw8LPRCKVNNTVMy+kgbQboagr0vBniKkKexJS7DJ
+Rvm4KH9ykUlqFBJl/lGpfg2+Z90y5XmY0Qj6hLZNYkcsRQ9bKSgASsO5ehOWdXZREpWNzaWT7ybo
+dT2J8NzoYge1g5YgYK4NP9i/6ZIxQ38RohWRO1d6IGCk7yd04LAKmIcDhgtvqHdBnSYc8w6BThTA
++yHFpHgjtd955biFXV28cDco6Mge3mt64o21h3af2SM0PTkpEhCCZg2JJUksWtJCsKFDWkWFV6ml
+5dqN0ixVmkVQCx1H/NkUlSxxSkHTTVrYlDTgMmP4DzhPnFAVYH5EV7cBTOI4nEbimOqymKwDkvuj
+T+4e3EyqQgtz6KV5pygmrfcYq0HO5YNrzpuHQeq6j0ktZ3Ufy6g6zJ2YILF12l2alRjWsE6qikl1
+vFYw0lAVn5D8JFVeKrh/OJChLID21mJ9lQ1VX3QZWLlugZyaanApDKkheuCrLnLwcUsINVQ7PVZ1
+jBzQ1JVhZukc497Z8AwbQqMbxaQ3KAOqkYEmj7jNaZ3bo+DStBMybM+8PwBC9+E0ijzE7ZOqInfe
+B6r66Y4BqlIrXYsT6zZJ1O6HNW+izw8M1PDNxWj0lqRotRC9wgbykUriaRjZfZtwhdRB5Z4zG8VS
+TVZonWOa2CzTxKarJjY9kEypEvfAFVSlSamvajjfsjLSwM+oqz5PTqzbJPE3Lje+WY8C6jMzHbXO
+7KVtHhVLJLi0OpLGHpULRYIGaUmsKbnzC2Aj42JyCy2pAROoZzKJHdWBI7hhN3cLbfbUZm3Rgmls
+d2QLTQdDs8HQbDC0MRiaDYY2B0OLo1aWEnd4tmCX8Vv4wnkFJPz9PlxaJ1WiaKConxnk/o/1TMZN
+0i1zUZN5kseiPvszhTagx7d1SQ7woWo5yg8uFo00aC0Oy5wcZTyAe/PE649Xe22OokvqHGXqTLgo
+k/zEcsOb7UStdeTP7wyK1+SZm10rZP1l2kG6LsgqdjjWJPtYtWW0bj7ospz5EDFZFYpJVWgh8dbH
+lnPdVGgKOnQ6qCVy0JNy1pNvtrNutpOspsfjy7k+j6fKB6oNB04uYneBJqsFhSZdzD7S1dQxI2Sm
+XzNdbZz+LtZ2aqFPK+OJMvZWvdArL77jtPWBF5ZPeD4d3aSi65/9fLHwwdvAi30IKQa1KcVLgUe5
+4xESQa2L6gufwuiosdtL70XcPPXmQxXgoxaX9UE3GbrsdQ7PV+xrZVL+QHZ7L3D45Ezw6JPBC3Y1
+hKpE13xSTFaFqsAc+XZC8Gqnn0qeyMB68AwbtJn8isuQLtWL6nisW4NHiT1fQYIsJjUYn2sfpEot
+EK5bg28bXfXwYY+gz/xwog+6TR50nzzYRnmwnfLArfHAF/CCNoe1Bu9DBb3/FFhZqQ/HVpQqkSLx
+iingiPzwe0MATOKE2pTSCO0H5ycsn9BLsqI7arvx0Ty7sAl6exWyQkZR4VUWkxauMliIlsBew+6A
+pLdGF/ZRmgp42RwrGG+YQ2jZxvvgQLXZVXRvr9mLThSH/vkK+PUz0PGX0g1p+u/JF7kDnrYLr6af
+kvyE+MDzC09RbpBnUdGTik+XsGkZ4jP/duHhQOOfrpmsBjipGJYbTgtWN2KURS8P3u4bSEONK0UP
+tKGoR6P8hCIDGGRv/w1Udf5EgGUf2dEiZ6LoR0Z0ud2Kq19e/PLaN2D9EiI3PkP8KTJkBpxRBdMd
+D0GHyG0SypDQhuxY1q341GkYvVs7GV4KRY+OL5YBb44F3mWCqCZphiJER3fXDhCjY5mjPswSoh1V
+rWCUXUVtTjVpYsmooVVx1vFeyRjcbnIo9Ekz9eQ0kM910nNjLSdryW2vEK01or3NSNQihLTOIqj4
+uM8Aqvrdsgcj5I+KB97VC/Y2J2CT8lDUQwSqzVCLkj/FKWgGH6Z+WCckMZDuidVy0LbbwTuNx6Ha
+bb0a4p6R6V61xIew62gzVr3RB0IGMNdxaINN0+Lgavo2TIgnk+oF6Te8cJ8z7AccJLDz3j4kKr/r
+uXXnCxlhxyYBhFdZKc2OXmRRMXZ6Htju666nqBSX1h933Lqr4RsW2oQqTrGorCbbVOmMk/TFg5Ce
+Jl8SeSMa+wQh6Y3hDgUHM/mHW01WQmTfTL5fBL8mSblznR5FTqME8QNnaAM9seVd7lzVw8HM+40Q
+2suTl10KC64xpdw0avNLcakJH+FXoMmYL9Ntvkx+y8J6Bg3UDpA8+0vyYZREm1afBArJ/6/fQSk3
+pDq6OkH8xKm1pKKlvVsD7DZNEc1QH3UPyefiNsJHhZrbcO000s2fwyW5oamHtIyJVB/hkAGq4tNz
+IfmiGRdLRF1b7RmqetP1laGqo3sMUBUulRVMVUyqouFxbiNVnuVngPiJ5YbT1Fqps2p5Zzokf9I9
+VDCYdykD7nQHNiZzkdUONUmUi3U1Rap3G0eyj1Ek9j5QSPoBnQ7Mh57qDpOq4OmQANWPtbCepbtE
+Ekh3feCiUgERVuvK6+jLXKdxK9h2gscJSDeCA14CZwCffg9pxX5Glzz2K17kp6SluodLA0yl1wGG
+qsY9235iCIdJhOgItQEarLZaNvQdXoslbWhrZWviF2fAlw6wV1SzaHac1dPLhh27YbKpM8nDrZlU
+VNBC2gtzKi8CkpXJ9sdJNA2HMIzDKNn4TdZp0ugyaQzppGMNmeeMV1FCytAVXI6wUfXsmbjbQBkv
+I5vJatZpqwNbk3fvQ9LpWZ82IZhmtQFuXNWjhWz6FLCRnzgs6832K5P8xGH7nbam45uwIbWtZPQO
+K3bR3tGK9Y9WxszdOKLgfNGrML0dogeWiwBeaeslNqc9XQUU5/93lt7kOO+waYqgwxb5KbghbEQl
+74gqUKVjhyATcXyK8A0s/VFvSKFkOrRv7jB5iJ9YbjgsrfSdoWQbWQuN9tHjp9vqoRRcn5Zycm2N
+xusB9QknpxrSJOyL3zyw5EVv9XlXAS0MxT3LBOqfBU9OGIm/cbnzzZyFVg/1jLael0mqI/1sV0jq
+0yZh1/TpUo/WwbKvv+ScZACCuVjj1jKEail40VDt/dCgW2ahbjHUNoDGMNDwAw7T1tVotdUoXvwN
+FatLKvrwcAMQ2jTrNrJu83qlR8Lbs93VNyIHVrLP+Hm4G2uAeJV6hgJeCqZoTTWsD3ZcdKejbb64
+z4CbzsU6WMpAS6JzmmR1gc9ImLDQ9RRFxfBbiuNGknGdyKkEnqbJlShOyQzrkc2Oqo0n37Zt+QZS
+blgHWzmUZwApJBW0wq2S0OxlciMLtjfLgbyO18duw3jmNtweuA3tLsUPGjpNGX5kzeur1kQfsgXi
+bfegGx/NThVtnAhsH6S1dXzrKDT9guwgWgRNRHt64yPGXVpZmyU6jpyWD8y3KQvb5y1wiN2IL0z0
+2xXhy0XcV1/jAmh/BpoOCsxeX258vpYPHkjfl0+/iN398kl5+aRj+aTP8snLJzu/fPID5xII81ZK
+1JFQmxJrR1wJVxey5tQlrqiyt93y7Fd1eyyfcW2du0oQBYQJAAILG6XaDC8FzRtJ/Syf3PPnLmHW
+zUC8mJFf/V/g9MJwxs0vWxbmPutm/UoZ+j77MZ7nzPHnYc+EKF8goQvrHxr+4Mo+x58V95Fy/Nn4
+3KYR0wgaiKmZMl5GWqf4kz+hfUHF7SYxoIm0UhdtHWNec9GsY95dUHgopDVrIB5b0ekzR1zn54jb
+CxDjWUr4cD43qoojuCtwRuout7CNNAjnSwU/qEyadoM2AzbWzs/nGvhBZVIdOGhEfmHzoJN2YlJt
+hi8x0gOw86NOuGWe94drKhD24LO6eedQ2XWI7A+46GS7pJ8cX5N6UA/A48I5eRwd7gVAPIpTEm9g
+YRwQSvGarIUdX9wyGja8MzDQjPET0xNNTZlzZFHwDVyDeA20XJEhtvBzesFh4yb9am1OrPULUaFo
+rJzevgRYam1z64pG4UyZ88JN3Zw95rycvQ2/7B/slYRK4j1qI1XqCyQdORcBmMjTFXZ6wzpYi2Cs
+AXjsR8FU5/M1SZVWkOcohw227J/nkNWgSbnhVI9SkBkgbAtJKh5ZrSRZiCYn/J4YKF4K2t7Zc088
+Z6/XQzl7m6Wz3/ByTs7cT4KsJplkGAlZKbWzEaQMrEofZoIt+S7Dk3nF3Eyq6SgGZ5bsdy3gIVxJ
+D2SEI0QZQBV2d7o8MTtnLQWXvQqqsirWUbc6K1etCNXKUHeeTbKvzQ50ta8MTtborGs7ix0UYh1s
+rafvaOSs+zQKNOTup4KqcJdVQcoNZ7ilre/Dk/YBVKGEbBUmoymg2XiGyz8M+bEgTRjnn6we9JfI
+Roq4qM48hea4zrdsuw8aYKBrrR15gsvRkhnp6Lyd9faCQlMa8dnL56kuR+q5LZCzfe7QCKG7YO7N
+qW/YCdLsF8CoDwxwOsrWdXiNNJ4iyLnq8G0sdNO8vlrkrxWUD+12aeXtT+rmPqVgNilP3RM3uin1
+HgCHbPFupeeBt0EUYM7poei4LTZeC/eBu9TM9U1qSiqi1AnxGjhi8NpPgarDMb8DH0ZQ0KBi8dTd
+cTllpEo7IobBJQcvkkM1UcXXeDsMHGWAFe/luPgsL+edwobfBJtYJ9edXHSRZkgT+4jUQFXz9SIj
+US3ebFAwVX4NoMoK5bWBXjxUHLw2cMuLb6wpqIpzC0FVvBVsRKU+F2dkynMoT1PqsX/ZzqeiDBxm
+7M1AW+mZR62t27zufeXFb48rUGWx55ILzO/KTTZjGwwvfl5bQUM1b1xaUjLgkHxo37C36DsGecok
+NeXHcY1UWUJikvwamgID1d1E2iRT5mPAIX6yNXXddJi/sGPSZWgyQIMslcPKBtJA/WHIGzOgjfEA
+UqUm1Szb81BBLz8wAZDXAA0L2mXGIcd1UYn+yUaJXmdkUm2Gdvx5o75L9b6DfCapkk/v8JzEr1Bm
+foUyl52PxynAftcsd8tw17bl4dl1vUigCmVABfhlDEoWrWhyJejQ08u8UoIukIt9AMNII6qruY9P
+XygyjYsfSDSCko1a2Xcrr8sBmlLl6a7YiOhS/KAyaZjx2sAwXjePHYrutQzsGXTDcsNhfLemstHV
+mI1Lp6KfXOgAf1vGZK7fWyHUAWqh56Lx/RXiOkDnnLbFETxPSeQZUV966NjDexYNfbbh++P5RNc9
+o8ejmR14DUYYyqoCQTghnLyNC1koWcjT7p3a6QJp4JHl/MY3RzFd44aI3vrgtN3Ld+E5CYiu+qbg
++uj7fvHJ32P5HPvy4T6y7SGPbWLdIi7upzs4m0JAl1bcdi/6DeRiH0Eu9hXkMj6DXOw7yGV8CNkI
+ygOOnRkHMuxgouw8xbbqi+3TK1AFt1ZRAS9L3bjFrBCvgTywvG/fXfEqCyUz4T37wnc5IcSkNzAD
+i8F2QjN3F2soBQ1g3KfmpdcXHTQJtr7gzmUROs9NhXiDqqCxSKgixnfBOOguo/A8V0SHU4coJr1B
+GVCNDEY0KBpL1KxEACk3rJNrGxyvgXYMmlXmFztlRR/CK/YUXrHH8Mp4Dq/Yg3hlPIlX5qN4ZTyL
+V/RhPH0Vzt6CK2HF9WFRI97JLXYrt9g9XN3zLnoztNjd0GJ3PwGafdJPchg1Q03pxYJQb79hUeZv
+WOi3GrvQj3cOZLKIqCdh7rp3d/qLChprnE1UeJWq1lK1m2QIXVxl4JmUkp945aHgWqe7HldwRtUw
+zHBNLPNGedEvMUOi+jmtHJeEeA3UPpHTugl/KWhyvO6+aTkyNoMZBIGWwqd2u4sulk/2hxNRezQ8
+DFMulu9CShfTqZ/l05ZPy8vnXD6nDym55XNuLi2fM/7U0Ec4Zm9uAA6UcvNUcP6Ebswv2p0lBn5B
+/ywsyYlz4Mkz3VnaF0f5LF+JKrq+0hEK9D5I8yONWq/l814+n+XzCf2/O5/lcy2fy8UXetUOxnG9
+8NXC67mJPrrSkcW8nvpE9NXf7elSelteL376nGeaCwu4K3nqEjwXthiu3P+5iKJErQbWzgis4XOt
+y+/y65LzJwQ8DSuH5Zdf/ft95F84UpffZ+j/cN7d+V1+n9nL8vt8Bzhh+ZXlV3b3rMuvJPcKlKVt
+xU1KN4RBQGBPT1J+hNzC84Ywyc9y1TYpPCfTYHV7PgdeBOzRDWKofklZkYYoUj42l6QqhiiTTJkf
+Mmkqg5v4NGSaeC7gV+ArvRjHFp6TL+CxXU0mVeAvXfEqVduP7a8U+uvyu+Xqlt+t7XH5Dctv+Ke3
+b1CndpevUfz2wxPg4HmLpad0Lr+hD+Iuu+a9/PbDEJffWH+X391VON26p78fy2/uW6UhxrD85rL8
+HstvkR84dfmty2+Tsi+/Z+z/5Uyp96Lzu+Vz+X0vv72noKO8UZTf5bcXGmVj4VAGFKKXokdBHEay
+WD0U8RhRYzIq4yIiY2pUjXtF2elWiCRd5Ai3HzWZVAeiw0xmQJXlWq5/XIzL5ZbL4WtnXQRR4VVW
+yItuPxiXez67szW64lWWSlCjfgal6GNeSYx4KTeY+hccl3z/2+Bh6i9N89WT3HKmC0/7Si/y9pEe
+oafwCnuv0utFFym8NAUk8Dob3drNo2vdMPZU4oq08YTgeYDyWXrZYv50l6bncrm9/7uVkXe3QTzo
+nj1dPMVIId5gaEpVYrn2XTX4gczzVXox9q7pS7hekuRD6qnDPK05QbRvL3Z6wXERD25f/OkQCFER
+6q6ENLU10ou+htTyg4njEmO5XM8V6+tLV9OXO7r9v2dYLlfs+0GYvbvjVjFZCWU3SYXQkEe+Syw2
+J9KKnaF4+vp8+ZoEJQ1izr2IhV8M6XDGlwyAJQwT802sQ0kIyTW6d+/9pTi6bNNSXMRNJ+BOkdb8
+wEPX8OFGEzB/VPQka/8OuUko9pBU9GLVrmsOh7aheD32B4X7sGyfCAdhCQ5DkxQVDFJrfRKuE/QH
+HEY5GOXQKN25luuxXH65ZLnE9f+1G0kfauK22l34C/YLDajHopWQ1gGqyqa6IBJd8ZQWwYqryLiJ
+Ai6dRpexKx5mULAgXqgrrgT1I3l4JIYSek3XFU7Xvfj5brxRcEmf/PhRrUs/jdVl/qhQPZ4gVKhN
+6SckpU0H+s1T1TdTDHVTeqXwI8pai4htVJUtJwu+IBFywGGBDk32QEirKMku6gYVVWXrqfWwFKr0
+wMTDkvSooPk5F4veZyYUAuLmd+hN1Utx4EmdS5B7r3aRNyIXjDjpg8ULG777K5ZIXcK3hbczCUVF
+afiQzMX27TOBd9ekqqjd07Cru30LcOBlRd7trJukV14u6UezN++6+uJqr0d4LFd4bmdaruD7v8fh
+ocREadhuWDt3R6KnKyaLSQ1GXRRU1QUaqB+t/mR7eLiSfFiucPR/6Q4OcBf0MZne0KFu53u5wveV
+i4q6XPvTLde+dSeU5dr393Ll5cqP/v9ARfLjkenWLsIjNJOquJoMgKo73nVHIl3xKovJSkABOwyT
+YTONplVXrD3ldTtLL8ca4DzhIjRrQJdQqF+9fXbPOLflF0r3YqpoWT5Kc+VXcodr7nP0lsm9D+RX
+3tzefdEl1C2+4CCl2Ce4HNN6LldO/R9HFFNa5tPOFx4euDJ1pZeivOAQkQ5unVBUSryU1CnQjOUr
+LwbzJtog9Fzz0MLin49e5ip44ubKtfJHl6/cO2huzelRVOqRe13OfzzcPs3l8x9kdvYdxiufKMmZ
+Vj7DAJSiEqmeCV9yAfAsbVQNWboTc00+E6t/0ql0Jf4YvFkuOihDPRHYNrr9St8gXgM5SXYPxfno
+kT/9P8JB43+0m+HceaABjxqiSy0sV1muXqC2XA0/z3HunQrk2RvydOm7YfGDpj6frkH9fDq6tYsX
+HJyOTv0t5A7Xcp2S/Lmfy3X2/n2uGU5fYAwI7obpznV4OvVMuGY9dc16vpDFK/dYve+c0ZWeWhQ4
+DzICBB80MOiqXsXe9c8dldh3ln/ftfz73hNPq3ucWAidKaF4R/8/gqiolD+4LlAy3WWSxe0T1llC
+dxqc7m/u5ZYLfeCN97Qu/I7/cn16E382t3yXr3u4NYacZPm659Y7+aZ0QQrla/k6H4TuP8vXrUUe
+y9eFh2v17FAEbm/or9sf8g2QwalIJisgJ4RL2vJPB9rl0gUmg69DmVIg96pTNEh00a9LJ4qWeiHT
+t0/wy9cd/d+1ntSRS/5ulwCbPLs8ENAnfoiiokIG9WtGPCN02XM4YNOri3IW17aztj/Mit58PcrH
++foKgC2UDt9vXr6P5SsuwnHJm5QyqQJzU1EuBdWfQ8bLiA0jjnszafnKQ7xIlzgVqayEq8kAqIqj
+C88Jp+PoGr3VxMtLPbm3jvhuIo9yrmtPTrbeCPqz/V/BNPuVn/7Kb4xh+QqKFVDO0FZ8p3uQKUNt
+k27KXtFXzyWGnnWM/VJF5T/1p+Pe69CzwAT7lRTapqInhNXYV1KGgx4q/TAJnz5RaYrwXL7Sd3pY
+42O7ioqqEg10HP3cPKCryu5ar0PJcJBpyUi4YEe2S/pQPlSYT7+rjJeRHlWuzCnVnOpu2Du8NBy5
+pk1v56lBPfBc0UAo9vkb6oJ5+H/BvfK5fDfvUn4v303Sim2Yb183rnn5bp3PVw8Mj/6fl2/w+X36
+0IdqkPVHoh9QQbGXN6zrWVwbUEFXd79uVbGKN0LjALtlfG7d7bOUya7eH+7xkQEu3RDhOz94+g3p
+CQfpp+fLJHNJz26MCiQB/vShFydBCVMWkb0KgkFB/TwmeoPii4Vod3s9UwpORVeiJhkfRlVZFZpJ
+Krp79H/cB+0y98pw9gqcvSCGn3nrhBZ0Qgs2oekSt8terAM2v8s3lGdOKvo5mtRDeyS8iEABVV2+
+MTdMyTG3i25dvmn5Zh/cU4WLCnX5Zlm+Obru9KeHMQXkmH/a8u2P1cINoqLbJ8RO+GBMl3juwQAG
+AsfDhQI1y9iI+ebc//WzI4O6GTZ0WTbDeJlHhkVtk0wJsx0O89iZZd5zwT7twEorRNQdXxAGo0I1
+YsQj6q7QN2cE1SMXGQAVJnV8ofrukT8+5FD6mMmlJ1Yyt3yUeIaazKLevfXuh6f0xqx91siaFO4F
+f8vyrcu3hnXPyV/L93xIkrhKOh9BfRWfMe2z/vnsd0En1E4v/Tk3IobruX7xI7/fc2heuSzfM55w
+OLWdsc9fJ9Irodf2EwRf2DWondLas/7kcr16j/tdvpeXZ16+15p3h5a/1txERaWEej8L+wPoWr4X
+m+4qWEdcbevjpC8/MV9+v8v/BwBZ/4SoBqESAA==
+`
@@ -3,6 +3,9 @@ package src
 import "fmt"
 
 var (
+	// Bold writes bold text
+	Bold = Colour("\033[1m%s\033[0m")
+
 	// Blue writes blue text
 	Blue = Colour("\033[1;36m%s\033[0m")
 
@@ -11,6 +14,9 @@ var (
 
 	// Red writes red text
 	Red = Colour("\033[1;31m%s\033[0m")
+
+	// Yellow writes yellow text
+	Yellow = Colour("\033[1;33m%s\033[0m")
 )
 
 // Colour returns a function that defines a printable colour string
@@ -0,0 +1,64 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	errUnclosedQuote = "the command has a quote that isn't closed: %s"
+)
+
+// SplitCommandLine splits a command line, like an editor setting of
+// "code --wait", into the command and its arguments. Arguments are split on
+// whitespace, as a shell would: single quotes keep everything in them as it
+// is, and double quotes keep the whitespace in them. Outside single quotes a
+// backslash escapes a quote, a space, or another backslash. Before anything
+// else it's kept, so Windows paths like C:\Tools\edit.exe work unquoted
+func SplitCommandLine(cmdLine string) ([]string, error) {
+	args := []string{}
+
+	var current strings.Builder
+	inArg := false
+	quote := rune(0)
+	escaped := false
+
+	runes := []rune(cmdLine)
+
+	for i, r := range runes {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'' && i < len(runes)-1 && strings.ContainsRune(`"'\ `+"\t", runes[i+1]):
+			escaped = true
+			inArg = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf(errUnclosedQuote, cmdLine)
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,94 @@
+package src
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/template"
+
+	"github.com/olebedev/config"
+)
+
+// The actions that til can make automatic commits for and run hooks around
+const (
+	ActionBuild = "build"
+	ActionNew   = "new"
+)
+
+// defaultCommitTemplates are the commit messages used for each action when
+// no git.commitTemplate is configured
+var defaultCommitTemplates = map[string]string{
+	ActionBuild: "til: rebuild index",
+	ActionNew:   `til: add "{{.Title}}"`,
+}
+
+// commitTemplateFuncs are the extra functions that commit templates can use
+var commitTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// CommitInfo describes the change that an automatic commit is being made for.
+// It is what a commit template is given to work with
+type CommitInfo struct {
+	Action   string
+	FilePath string
+	Tags     []string
+	Title    string
+}
+
+// CommitMessage returns the message for an automatic commit, written with the
+// git.commitTemplate from the config, or with the default for the action if
+// there isn't one.
+// Example:
+//
+//	git:
+//		commitTemplate: "docs(til): {{if eq .Action \"new\"}}{{.Title}}{{else}}rebuild{{end}}"
+func CommitMessage(cfg *config.Config, info CommitInfo) (string, error) {
+	tmpl, err := commitTemplate(cfg, info.Action)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	err = tmpl.Execute(&buf, info)
+	if err != nil {
+		return "", fmt.Errorf("git.commitTemplate: %w", err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// ValidateCommitTemplate checks that the configured git.commitTemplate, if
+// there is one, can be used to write a commit message for every action
+func ValidateCommitTemplate(cfg *config.Config) error {
+	for _, action := range []string{ActionBuild, ActionNew} {
+		tmpl, err := commitTemplate(cfg, action)
+		if err != nil {
+			return err
+		}
+
+		sample := CommitInfo{Action: action, FilePath: "docs/sample.md", Tags: []string{"sample"}, Title: "Sample"}
+
+		err = tmpl.Execute(ioutil.Discard, sample)
+		if err != nil {
+			return fmt.Errorf("git.commitTemplate: %w", err)
+		}
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func commitTemplate(cfg *config.Config, action string) (*template.Template, error) {
+	text := cfg.UString("git.commitTemplate", defaultCommitTemplates[action])
+
+	tmpl, err := template.New("commit").Funcs(commitTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("git.commitTemplate: %w", err)
+	}
+
+	return tmpl, nil
+}
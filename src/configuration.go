@@ -20,8 +20,9 @@ targetDirectory:
 	a: "~/Documents/tilblog"
 `
 
-	tilConfigDir  = "~/.config/til/"
-	tilConfigFile = "config.yml"
+	tilConfigDir      = "~/.config/til/"
+	tilConfigFile     = "config.yml"
+	tilDictionaryFile = "dictionary.txt"
 )
 
 const (
@@ -48,6 +49,8 @@ func (c *Config) Load() {
 	makeConfigFile()
 
 	GlobalConfig = readConfigFile()
+
+	validateConfig(GlobalConfig)
 }
 
 // getConfigDir returns the string path to the directory that should
@@ -94,7 +97,19 @@ func GetConfigFilePath() (string, error) {
 		return "", errors.New(errConfigPathEmpty)
 	}
 
-	return fmt.Sprintf("%s/%s", cDir, tilConfigFile), nil
+	return filepath.Join(cDir, tilConfigFile), nil
+}
+
+// GetDictionaryFilePath returns the string path to the user dictionary, the
+// words that til spellcheck knows on top of its own. It's next to the
+// configuration file
+func GetDictionaryFilePath() (string, error) {
+	configPath, err := GetConfigFilePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(filepath.Dir(configPath), tilDictionaryFile), nil
 }
 
 func makeConfigDir() {
@@ -164,6 +179,51 @@ func makeConfigFile() {
 	}
 }
 
+// validateConfig checks the configuration values that can be checked up
+// front, so that mistakes are reported when the config is loaded rather
+// than part-way through doing something
+func validateConfig(cfg *config.Config) {
+	if _, err := TagAliases(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if err := ValidateCommitTemplate(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if err := ValidateNotifyTemplate(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, _, err := DateDisplay(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, err := FilenameDateFormat(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, err := PageBodyTemplate(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, err := PageLayout(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, _, err := Goal(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, err := WeekStart(cfg); err != nil {
+		Defeat(err)
+	}
+
+	if _, err := FooterStyle(cfg); err != nil {
+		Defeat(err)
+	}
+}
+
 // readConfigFile reads the contents of the config file and jams them
 // into the global config variable
 func readConfigFile() *config.Config {
@@ -0,0 +1,164 @@
+package src
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+)
+
+// DefaultDateFormat is how dates are written on the index, the tag pages, and
+// in the console when the config doesn't say otherwise
+const DefaultDateFormat = "Jan 02, 2006"
+
+const (
+	errDateFormat         = "the dateFormat '%s' in the config has no date in it. It's a Go layout, which is written the way Jan 2, 2006 would be written (ie: \"02 Jan 2006\", \"2 January 2006\", or \"2006-01-02\")"
+	errDateLocale         = "unknown dateLocale '%s' in the config. Known locales are: en, %s"
+	errFilenameDateChars  = "the filenameDateFormat '%s' in the config has a %q in it, which can't go in a file name"
+	errFilenameDateFormat = "the filenameDateFormat '%s' in the config isn't a date that can be read back from a file name. It's a Go layout, written the way Jan 2, 2006 at 15:04:05 would be (ie: \"2006-01-02\" or \"2006-01-02T15-04-05\")"
+)
+
+// filenameUnsafeChars are the characters that a file name can't have in it,
+// on one system or another
+const filenameUnsafeChars = `/\:*?"<>|`
+
+// monthNames are the names of the months in the locales that dates can be
+// written in, besides English: the full names for January in a layout, then
+// the short ones for Jan
+var monthNames = map[string][2][12]string{
+	"de": {
+		{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	},
+	"es": {
+		{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	},
+	"fr": {
+		{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	},
+	"it": {
+		{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	},
+	"nl": {
+		{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	},
+	"pt": {
+		{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+	},
+}
+
+// DateDisplay returns the layout and locale that dates are written with, from
+// the dateFormat and dateLocale config.
+// Example:
+//
+//	dateFormat: "2 January 2006"
+//	dateLocale: fr
+//
+// writes May 14, 2024 as "14 mai 2024". A layout without a date in it, or a
+// locale that til doesn't have the months of, is an error
+func DateDisplay(cfg *config.Config) (string, string, error) {
+	layout := cfg.UString("dateFormat", DefaultDateFormat)
+	locale := strings.ToLower(strings.TrimSpace(cfg.UString("dateLocale", "")))
+
+	if _, ok := monthNames[locale]; !ok && locale != "" && locale != "en" {
+		return "", "", fmt.Errorf(errDateLocale, locale, strings.Join(dateLocales(), ", "))
+	}
+
+	// A layout that isn't one, like YYYY-MM-DD, writes every date the same
+	first := time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2023, 11, 3, 0, 0, 0, 0, time.UTC)
+
+	if FormatDate(first, layout, "") == FormatDate(second, layout, "") {
+		return "", "", fmt.Errorf(errDateFormat, layout)
+	}
+
+	return layout, locale, nil
+}
+
+// FilenameDateFormat returns the layout of the date at the start of a new
+// page's file name, from the filenameDateFormat config. Without it, it's
+// empty, and pages.FileName uses its own. A layout with a character that
+// can't go in a file name, like / or :, is an error, and so is one whose
+// dates can't be read back, or that writes every date the same
+func FilenameDateFormat(cfg *config.Config) (string, error) {
+	layout := cfg.UString("filenameDateFormat", "")
+	if layout == "" {
+		return "", nil
+	}
+
+	if i := strings.IndexAny(layout, filenameUnsafeChars); i >= 0 {
+		return "", fmt.Errorf(errFilenameDateChars, layout, layout[i])
+	}
+
+	first := time.Date(2024, 5, 14, 9, 30, 15, 0, time.UTC)
+	second := time.Date(2023, 11, 3, 17, 5, 45, 0, time.UTC)
+
+	written := first.Format(layout)
+
+	read, err := time.Parse(layout, written)
+	if err != nil || read.Format(layout) != written || written == second.Format(layout) {
+		return "", fmt.Errorf(errFilenameDateFormat, layout)
+	}
+
+	return layout, nil
+}
+
+// FormatDate writes the date with the Go layout, with the months named as
+// they are in the locale. An empty locale, or en, is English
+func FormatDate(date time.Time, layout, locale string) string {
+	names, ok := monthNames[locale]
+	if !ok {
+		return date.Format(layout)
+	}
+
+	var builder strings.Builder
+	start := 0
+
+	// The layout is split around its month names, so that nothing else in
+	// the date is mistaken for one
+	for i := 0; i < len(layout); {
+		token, name := "", ""
+
+		switch {
+		case strings.HasPrefix(layout[i:], "January"):
+			token, name = "January", names[0][date.Month()-1]
+		case strings.HasPrefix(layout[i:], "Jan"):
+			token, name = "Jan", names[1][date.Month()-1]
+		default:
+			i++
+			continue
+		}
+
+		builder.WriteString(date.Format(layout[start:i]))
+		builder.WriteString(name)
+
+		i += len(token)
+		start = i
+	}
+
+	builder.WriteString(date.Format(layout[start:]))
+
+	return builder.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// dateLocales returns the locales that dates can be written in, besides
+// English, in alphabetical order
+func dateLocales() []string {
+	locales := []string{}
+	for locale := range monthNames {
+		locales = append(locales, locale)
+	}
+
+	sort.Strings(locales)
+
+	return locales
+}
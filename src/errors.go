@@ -0,0 +1,83 @@
+package src
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// The exit codes til stops with, so that scripts can tell what went wrong
+const (
+	ExitUsage   = 1 // The command, its flags, or its arguments were wrong
+	ExitIO      = 2 // A file or directory couldn't be read or written
+	ExitParse   = 3 // A page or other file couldn't be parsed
+	ExitFailure = 4 // Anything else, like a git command or a hook failing
+
+	ExitCanceled = 130 // til was interrupted, as shells report for Ctrl-C
+)
+
+// UsageError is a mistake in how til was called, like a missing title or a
+// tag that isn't allowed
+type UsageError struct {
+	Err error
+}
+
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// ParseError is a file that was read but couldn't be parsed, like a page
+// with malformed front-matter
+type ParseError struct {
+	FilePath string
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Err.Error())
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// StrictError is a -strict build that noticed problems, like untagged pages
+// or broken links, that a build otherwise only warns about. It exits with
+// ExitUsage, like a tag that isn't allowed, since it's the pages that need
+// fixing rather than til
+type StrictError struct {
+	Warnings int
+}
+
+func (e *StrictError) Error() string {
+	return fmt.Sprintf("the build had %d warnings, which -strict doesn't allow", e.Warnings)
+}
+
+// ExitCode returns the code that til should exit with for the error
+func ExitCode(err error) int {
+	var usageErr *UsageError
+	var strictErr *StrictError
+	var parseErr *ParseError
+	var pathErr *os.PathError
+	var linkErr *os.LinkError
+
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, context.Canceled):
+		return ExitCanceled
+	case errors.As(err, &usageErr), errors.As(err, &strictErr):
+		return ExitUsage
+	case errors.As(err, &parseErr):
+		return ExitParse
+	case errors.As(err, &pathErr), errors.As(err, &linkErr):
+		return ExitIO
+	}
+
+	return ExitFailure
+}
@@ -0,0 +1,152 @@
+package src
+
+// JSONVersion is the version of the JSON that the --json flag writes out. It
+// goes up whenever a field is removed or changes meaning, so that scripts can
+// tell when they need updating. Adding a field doesn't change it
+const JSONVersion = 1
+
+// JSONPage is a page as it appears in the JSON output
+type JSONPage struct {
+	Archived bool     `json:"archived,omitempty"`
+	Author   string   `json:"author,omitempty"`
+	Date     string   `json:"date"`
+	Path     string   `json:"path"`
+	Tags     []string `json:"tags"`
+	Title    string   `json:"title"`
+}
+
+// JSONNewPage is what creating a page writes out
+type JSONNewPage struct {
+	Version int `json:"version"`
+
+	JSONPage
+
+	// Permalink is only set when a baseURL is configured
+	Permalink string `json:"permalink,omitempty"`
+}
+
+// JSONPageDetail is a page with everything in it, as til serve's API returns
+// it: its Markdown as it's written, without the front-matter, and that
+// Markdown rendered as HTML
+type JSONPageDetail struct {
+	Version int `json:"version"`
+
+	JSONPage
+
+	// Permalink is only set when a baseURL is configured
+	Permalink string `json:"permalink,omitempty"`
+
+	Markdown string `json:"markdown"`
+	HTML     string `json:"html"`
+}
+
+// JSONError is what til serve's API returns when it can't answer a request
+type JSONError struct {
+	Version int    `json:"version"`
+	Error   string `json:"error"`
+}
+
+// JSONPageList is what til list writes out
+type JSONPageList struct {
+	Version int        `json:"version"`
+	Pages   []JSONPage `json:"pages"`
+}
+
+// JSONTagStats are the stats of a tag, as written out by til tags --stats
+type JSONTagStats struct {
+	Count int `json:"count"`
+
+	// The dates are RFC3339, and empty when none of the tag's pages has a
+	// date that can be parsed
+	FirstUsed string `json:"firstUsed"`
+	LastUsed  string `json:"lastUsed"`
+}
+
+// JSONTag is a tag as it appears in the JSON output
+type JSONTag struct {
+	Name  string        `json:"name"`
+	Stats *JSONTagStats `json:"stats,omitempty"`
+}
+
+// JSONTagList is what til tags writes out
+type JSONTagList struct {
+	Version int       `json:"version"`
+	Tags    []JSONTag `json:"tags"`
+}
+
+// JSONStats is what til stats writes out. BusiestDay is a date like
+// 2024-05-14, and empty when there are no pages with a date
+type JSONStats struct {
+	Version       int    `json:"version"`
+	Pages         int    `json:"pages"`
+	CurrentStreak int    `json:"currentStreak"`
+	LongestStreak int    `json:"longestStreak"`
+	BusiestDay    string `json:"busiestDay"`
+	BusiestCount  int    `json:"busiestDayPages"`
+
+	// Goal is only set when a writing goal is configured
+	Goal *JSONGoal `json:"goal,omitempty"`
+
+	// Words is how much has been written in the pages
+	Words *JSONWords `json:"words,omitempty"`
+}
+
+// JSONWords is how much has been written, in til stats. Words are the words
+// of prose, and CodeLines the lines in fenced code blocks. Average is the
+// words per page
+type JSONWords struct {
+	Words     int              `json:"words"`
+	Average   int              `json:"average"`
+	CodeLines int              `json:"codeLines"`
+	Longest   []JSONEntryWords `json:"longest"`
+	Years     []JSONYearWords  `json:"years"`
+}
+
+// JSONEntryWords is one of the longest pages, in til stats
+type JSONEntryWords struct {
+	Title string `json:"title"`
+	Path  string `json:"path"`
+	Words int    `json:"words"`
+}
+
+// JSONYearWords is the words of the pages created in a year, in til stats
+type JSONYearWords struct {
+	Year    int `json:"year"`
+	Pages   int `json:"pages"`
+	Words   int `json:"words"`
+	Average int `json:"average"`
+}
+
+// JSONGoal is how the writing goal is going, in til stats. Met is the number
+// of the last Periods periods, not counting the current one, that met it
+type JSONGoal struct {
+	Cadence    string `json:"cadence"`
+	Count      int    `json:"count"`
+	Current    int    `json:"current"`
+	Met        int    `json:"met"`
+	Periods    int    `json:"periods"`
+	LongestRun int    `json:"longestRun"`
+}
+
+// JSONValidation is what til validate writes out. Errors are the problems
+// that make validation fail, and are also included in Warnings
+type JSONValidation struct {
+	Version  int      `json:"version"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// JSONBuildWarning is a problem that a build noticed, but carried on past.
+// Kind is what sort of problem it is, like untagged or broken-link
+type JSONBuildWarning struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// JSONBuild is what a build writes out: the problems it noticed, and how
+// many of each kind there were, with every kind counted even when it's 0
+type JSONBuild struct {
+	Version  int                `json:"version"`
+	Counts   map[string]int     `json:"counts"`
+	Warnings []JSONBuildWarning `json:"warnings"`
+}
@@ -10,9 +10,11 @@ import (
 // (More globals! This is getting crazy)
 var LL *log.Logger
 
-// Defeat writes out an error message
+// Defeat writes out an error message on stderr and then exits with the code
+// for the kind of error it is (see ExitCode)
 func Defeat(err error) {
-	LL.Fatal(fmt.Sprintf("%s %s", Red("✘"), err.Error()))
+	fmt.Fprintf(os.Stderr, "%s %s\n", Red("✘"), err.Error())
+	os.Exit(ExitCode(err))
 }
 
 // Info writes out an informative message
@@ -25,6 +27,12 @@ func Progress(msg string) {
 	LL.Print(fmt.Sprintf("\t%s %s\n", Blue("->"), msg))
 }
 
+// Warn writes out a warning message, for problems that don't stop til from
+// carrying on
+func Warn(msg string) {
+	LL.Print(fmt.Sprintf("%s %s", Yellow("!"), msg))
+}
+
 // Victory writes out a victorious final message and then expires dramatically
 func Victory(msg string) {
 	LL.Print(fmt.Sprintf("%s %s", Green("✓"), msg))
@@ -0,0 +1,122 @@
+package src
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	// DefaultNotifyTemplate is the payload that's sent to the webhook when no
+	// notify.template is configured. Slack shows the text, and other webhooks
+	// can use the rest
+	DefaultNotifyTemplate = `{"text": {{json .Text}}, "title": {{json .Title}}, "tags": {{json .Tags}}, "permalink": {{json .Permalink}}, "excerpt": {{json .Excerpt}}}`
+
+	errNotifyJSON = "notify.template didn't make JSON: %s"
+)
+
+// notifyTemplateFuncs are the extra functions that notify templates can use
+var notifyTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+	"json": notifyJSON,
+}
+
+// NotifyInfo describes the page that a notification is being sent for. It is
+// what a notify template is given to work with
+type NotifyInfo struct {
+	Title     string
+	Tags      []string
+	Permalink string
+	Excerpt   string
+}
+
+// Text is a line that announces the page, for chat webhooks: the title,
+// linked to the permalink in Slack's way when there is one, and the tags
+func (info NotifyInfo) Text() string {
+	text := "New TIL: " + info.Title
+	if info.Permalink != "" {
+		text = fmt.Sprintf("New TIL: <%s|%s>", info.Permalink, info.Title)
+	}
+
+	if len(info.Tags) > 0 {
+		text += " (" + strings.Join(info.Tags, ", ") + ")"
+	}
+
+	return text
+}
+
+// NotifyPayload returns the JSON that's sent to the webhook for the page,
+// written with the notify.template from the config, or the
+// DefaultNotifyTemplate if there isn't one.
+// Example:
+//
+//	notify:
+//		webhookURL: https://hooks.slack.com/services/...
+//		template: '{"content": {{json .Title}}}'
+//
+// A template that doesn't make JSON is an error
+func NotifyPayload(cfg *config.Config, info NotifyInfo) ([]byte, error) {
+	tmpl, err := notifyTemplate(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload bytes.Buffer
+
+	err = tmpl.Execute(&payload, info)
+	if err != nil {
+		return nil, fmt.Errorf("notify.template: %w", err)
+	}
+
+	if !json.Valid(payload.Bytes()) {
+		return nil, fmt.Errorf(errNotifyJSON, payload.String())
+	}
+
+	return payload.Bytes(), nil
+}
+
+// ValidateNotifyTemplate checks that the configured notify.template, if there
+// is one, makes JSON
+func ValidateNotifyTemplate(cfg *config.Config) error {
+	sample := NotifyInfo{
+		Title:     `A "sample" page`,
+		Tags:      []string{"sample"},
+		Permalink: "https://example.com/sample",
+		Excerpt:   `It says "sample".`,
+	}
+
+	_, err := NotifyPayload(cfg, sample)
+
+	return err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func notifyTemplate(cfg *config.Config) (*template.Template, error) {
+	text := cfg.UString("notify.template", DefaultNotifyTemplate)
+
+	tmpl, err := template.New("notify").Funcs(notifyTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("notify.template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// notifyJSON writes the value as JSON, so that titles and excerpts with
+// quotes in them can go into a template's strings. The < and > of Slack's
+// links are left as they are
+func notifyJSON(value interface{}) (string, error) {
+	var data bytes.Buffer
+
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+
+	return strings.TrimSuffix(data.String(), "\n"), err
+}
@@ -0,0 +1,80 @@
+package src
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/olebedev/config"
+)
+
+// DefaultPageBody is what a new page starts out as under its front-matter
+// when there's no newPageBody in the config: its title as a heading, and a
+// blank line
+const DefaultPageBody = "# {{.Title}}\n\n"
+
+// cursorMarker is what {{.Cursor}} writes into a page body, to be found and
+// taken back out again. No page has a NUL in it
+const cursorMarker = "\x00cursor\x00"
+
+// PageBodyInfo is what a new page's body template is given to work with
+type PageBodyInfo struct {
+	Date  string
+	Tags  string
+	Title string
+}
+
+// Cursor marks where the editor's cursor goes when the page is opened, and
+// where any text the page is created with, like the clipboard's, is written
+func (info PageBodyInfo) Cursor() string {
+	return cursorMarker
+}
+
+// PageBodyTemplate returns the template that new pages start out as, from
+// newPageBody in the config, or DefaultPageBody if there isn't one. A
+// template that can't be parsed, or that uses anything but the title, date,
+// tags, and cursor, is an error.
+// Example:
+//
+//	newPageBody: "# {{.Title}}\n\n_{{.Date}}, {{.Tags}}_\n\n{{.Cursor}}\n"
+func PageBodyTemplate(cfg *config.Config) (*template.Template, error) {
+	text := cfg.UString("newPageBody", DefaultPageBody)
+
+	tmpl, err := template.New("newPageBody").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("newPageBody: %w", err)
+	}
+
+	_, _, err = RenderPageBody(tmpl, PageBodyInfo{Date: "May 14, 2024", Tags: "sample", Title: "Sample"}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// RenderPageBody writes the page body template with the info, with body
+// written where the {{.Cursor}} is, or at the end if there isn't one. It
+// returns the line the cursor is on, counting from 1, or 0 without a
+// {{.Cursor}}
+func RenderPageBody(tmpl *template.Template, info PageBodyInfo, body string) (string, int, error) {
+	var buf bytes.Buffer
+
+	err := tmpl.Execute(&buf, info)
+	if err != nil {
+		return "", 0, fmt.Errorf("newPageBody: %w", err)
+	}
+
+	rendered := buf.String()
+
+	at := strings.Index(rendered, cursorMarker)
+	if at < 0 {
+		return rendered + body, 0, nil
+	}
+
+	line := strings.Count(rendered[:at], "\n") + 1
+	rendered = rendered[:at] + body + strings.ReplaceAll(rendered[at+len(cursorMarker):], cursorMarker, "")
+
+	return rendered, line, nil
+}
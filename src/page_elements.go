@@ -2,12 +2,63 @@ package src
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/olebedev/config"
+)
+
+// The footers that generated pages can have, set by the footerStyle config
+const (
+	// FooterStyleTimestamp says when the pages were generated
+	FooterStyleTimestamp = "timestamp"
+
+	// FooterStyleEntries says how many entries there are and when the last
+	// one was added, which only changes when the pages do
+	FooterStyleEntries = "entries"
+
+	errFooterStyle = "footerStyle needs to be timestamp or entries, not '%s'"
 )
 
+// tilLink links to til, at the end of every footer
+const tilLink = "<a href='https://github.com/senorprogrammer/til'>til</a>"
+
 func Footer() string {
 	return fmt.Sprintf(
-		"<sup><sub>generated %s by <a href='https://github.com/senorprogrammer/til'>til</a></sub></sup>\n",
+		"<sup><sub>generated %s by %s</sub></sup>\n",
 		time.Now().Format("2 Jan 2006 15:04:05"),
+		tilLink,
 	)
 }
+
+// EntriesFooter is the footer of the entries style, like "12 entries · last
+// added May 14, 2024". lastAdded is the date of the newest entry, already
+// formatted. It's left out when it's empty, as it is for undated entries
+func EntriesFooter(count int, lastAdded string) string {
+	if count == 0 {
+		return fmt.Sprintf("<sup><sub>no entries yet · generated by %s</sub></sup>\n", tilLink)
+	}
+
+	parts := []string{fmt.Sprintf("%d entries", count)}
+	if count == 1 {
+		parts[0] = "1 entry"
+	}
+
+	if lastAdded != "" {
+		parts = append(parts, "last added "+lastAdded)
+	}
+
+	return fmt.Sprintf("<sup><sub>%s · generated by %s</sub></sup>\n", strings.Join(parts, " · "), tilLink)
+}
+
+// FooterStyle returns the footerStyle config, FooterStyleTimestamp or
+// FooterStyleEntries. Without it, footers have the timestamp
+func FooterStyle(cfg *config.Config) (string, error) {
+	style := strings.ToLower(strings.TrimSpace(cfg.UString("footerStyle", FooterStyleTimestamp)))
+
+	if style != FooterStyleTimestamp && style != FooterStyleEntries {
+		return FooterStyleTimestamp, fmt.Errorf(errFooterStyle, style)
+	}
+
+	return style, nil
+}
@@ -0,0 +1,34 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// The layouts that the content pages can be kept in, in the docs directory
+const (
+	// LayoutFlat keeps every page directly in the docs directory
+	LayoutFlat = "flat"
+
+	// LayoutYearly keeps each page in a directory for the year it was
+	// created in (e.g.: docs/2024/). The generated pages stay at the top
+	LayoutYearly = "yearly"
+)
+
+const (
+	errLayout = "unknown layout '%s' in the config. It can be flat or yearly"
+)
+
+// PageLayout returns the layout that the content pages are kept in, from the
+// layout config. Without it, the layout is LayoutFlat
+func PageLayout(cfg *config.Config) (string, error) {
+	layout := strings.ToLower(strings.TrimSpace(cfg.UString("layout", LayoutFlat)))
+
+	if layout != LayoutFlat && layout != LayoutYearly {
+		return "", fmt.Errorf(errLayout, layout)
+	}
+
+	return layout, nil
+}
@@ -0,0 +1,61 @@
+package src
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	errSavedSearchEmpty = "saved search '%s' needs a query"
+)
+
+// SavedSearch is a query that a build writes a page of the matching pages
+// for
+type SavedSearch struct {
+	Name  string
+	Query string
+}
+
+// SavedSearches returns the saved searches defined in the config, in
+// alphabetical order of their names.
+// Example:
+//
+//	savedSearches:
+//		reading-list: "tag:reading AND NOT tag:done"
+//
+// A saved search without a query is an error
+func SavedSearches(cfg *config.Config) ([]SavedSearch, error) {
+	searches := []SavedSearch{}
+
+	uSearches, err := cfg.Map("savedSearches")
+	if err != nil {
+		// No saved searches defined, which is fine
+		return searches, nil
+	}
+
+	for name, query := range uSearches {
+		search := SavedSearch{Name: strings.TrimSpace(name)}
+		if query != nil {
+			search.Query = strings.TrimSpace(fmt.Sprintf("%v", query))
+		}
+
+		searches = append(searches, search)
+	}
+
+	// Sorted so that the pages are always built in the same order, and the
+	// same broken config always reports the same error
+	sort.Slice(searches, func(i, j int) bool {
+		return searches[i].Name < searches[j].Name
+	})
+
+	for _, search := range searches {
+		if search.Query == "" {
+			return nil, fmt.Errorf(errSavedSearchEmpty, search.Name)
+		}
+	}
+
+	return searches, nil
+}
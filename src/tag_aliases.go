@@ -0,0 +1,62 @@
+package src
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	errAliasChain = "tag alias '%s' points to '%s', which is itself an alias. Aliases must point directly to a tag"
+	errAliasEmpty = "tag alias '%s' must point to a tag"
+	errAliasSelf  = "tag alias '%s' points to itself"
+)
+
+// TagAliases returns the tag aliases defined in the config, as a map of
+// lower-case alias to the tag it stands for.
+// Example:
+//
+//	aliases:
+//		js: javascript
+//		golang: go
+//
+// Aliases must point directly to a real tag. An alias that points to another
+// alias (a chain, or a cycle) is an error
+func TagAliases(cfg *config.Config) (map[string]string, error) {
+	aliases := map[string]string{}
+
+	uAliases, err := cfg.Map("aliases")
+	if err != nil {
+		// No aliases defined, which is fine
+		return aliases, nil
+	}
+
+	for alias, tag := range uAliases {
+		aliases[strings.ToLower(strings.TrimSpace(alias))] = strings.TrimSpace(fmt.Sprintf("%v", tag))
+	}
+
+	// Sorted so that the same broken config always reports the same error
+	names := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+
+	sort.Strings(names)
+
+	for _, alias := range names {
+		tag := aliases[alias]
+
+		switch {
+		case tag == "":
+			return nil, fmt.Errorf(errAliasEmpty, alias)
+		case strings.ToLower(tag) == alias:
+			return nil, fmt.Errorf(errAliasSelf, alias)
+		case aliases[strings.ToLower(tag)] != "":
+			return nil, fmt.Errorf(errAliasChain, alias, tag)
+		}
+	}
+
+	return aliases, nil
+}
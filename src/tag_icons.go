@@ -0,0 +1,43 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// TagIcons returns the icons for tags defined in the config, as a map of
+// lower-case tag name to the emoji or other icon that goes in front of its
+// pages on the index.
+// Example:
+//
+//	icons:
+//		go: "🐹"
+//		security: "🔒"
+//
+// Tags without an icon are left out
+func TagIcons(cfg *config.Config) map[string]string {
+	icons := map[string]string{}
+
+	uIcons, err := cfg.Map("icons")
+	if err != nil {
+		// No icons defined, which is fine
+		return icons
+	}
+
+	for tag, value := range uIcons {
+		if value == nil {
+			continue
+		}
+
+		icon := strings.TrimSpace(fmt.Sprintf("%v", value))
+		if icon == "" {
+			continue
+		}
+
+		icons[strings.ToLower(strings.TrimSpace(tag))] = icon
+	}
+
+	return icons
+}
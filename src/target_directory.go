@@ -38,7 +38,7 @@ func BuildTargetDirectory() {
 func GetTargetDir(cfg *config.Config, targetDirFlag string, withDocsDir bool) (string, error) {
 	docsBit := ""
 	if withDocsDir {
-		docsBit = "/docs"
+		docsBit = "docs"
 	}
 
 	// Target directories are defined in the config file as a map of
@@ -85,7 +85,7 @@ func GetTargetDir(cfg *config.Config, targetDirFlag string, withDocsDir bool) (s
 	// take the config value as a fully-qualified path and just append the
 	// name of the write dir to it
 	if tDir[0] != '~' {
-		return tDir + docsBit, nil
+		return filepath.Join(tDir, docsBit), nil
 	}
 
 	// We are pathing relative to the home directory, so figure out the
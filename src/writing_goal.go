@@ -0,0 +1,85 @@
+package src
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+)
+
+// The cadences that a writing goal can be set for
+const (
+	CadenceDaily   = "daily"
+	CadenceWeekly  = "weekly"
+	CadenceMonthly = "monthly"
+)
+
+const (
+	errGoalCadence = "unknown goal.cadence '%s' in the config. It can be daily, weekly, or monthly"
+	errGoalCount   = "goal.count in the config needs to be at least 1"
+	errWeekStart   = "unknown weekStart '%s' in the config. It's a day of the week, like monday"
+)
+
+// WritingGoal is the number of pages to write in each day, week, or month
+type WritingGoal struct {
+	Cadence string
+	Count   int
+
+	// BuildSummary is whether a build says how the goal's going, too
+	BuildSummary bool
+}
+
+// Goal returns the writing goal in the config, and whether there is one.
+// Example:
+//
+//	goal:
+//		cadence: weekly
+//		count: 2
+//
+// The cadence is weekly without one, but the count has to be given
+func Goal(cfg *config.Config) (WritingGoal, bool, error) {
+	if _, err := cfg.Get("goal"); err != nil {
+		// No goal, which is fine
+		return WritingGoal{}, false, nil
+	}
+
+	goal := WritingGoal{
+		Cadence:      strings.ToLower(strings.TrimSpace(cfg.UString("goal.cadence", CadenceWeekly))),
+		Count:        cfg.UInt("goal.count", 0),
+		BuildSummary: cfg.UBool("goal.buildSummary", false),
+	}
+
+	switch goal.Cadence {
+	case CadenceDaily, CadenceWeekly, CadenceMonthly:
+	default:
+		return WritingGoal{}, false, fmt.Errorf(errGoalCadence, goal.Cadence)
+	}
+
+	if goal.Count < 1 {
+		return WritingGoal{}, false, errors.New(errGoalCount)
+	}
+
+	return goal, true, nil
+}
+
+// WeekStart returns the day that weeks start on, from the weekStart config,
+// as a day's name like monday, or the first three letters of one. Without
+// it, weeks start on Sunday, like the activity calendar's
+func WeekStart(cfg *config.Config) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(cfg.UString("weekStart", "")))
+	if name == "" {
+		return time.Sunday, nil
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+
+		if name == full || name == full[:3] {
+			return day, nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf(errWeekStart, name)
+}
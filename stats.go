@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// runStats writes out how much has been written: the number of pages, the
+// calendar of the last year, the streaks, and the busiest day, like the
+// activity page that a build can write, and how the writing goal is going
+// if there is one, then how many words there are, and a chart of the pages
+// written each month. --months is how many months it charts.
+// Example:
+//
+//	> til stats --months 24
+func runStats(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("stats", flag.ContinueOnError)
+	months := flags.Int("months", defaultChartMonths, fmt.Sprintf("the number of months to chart, up to %d", maxChartMonths))
+	parseFlags(flags, args)
+
+	if *months < 1 || *months > maxChartMonths {
+		src.Defeat(&src.UsageError{Err: fmt.Errorf(errChartMonths, maxChartMonths)})
+	}
+
+	loc, err := configuredLocation()
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	today := pages.Now().In(loc)
+	act := pageActivity(pageSet, today, loc)
+	words := measureWords(pageSet, loc)
+
+	progress, hasGoal, err := configuredGoalProgress(pageSet, today)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	if jsonFlag {
+		stats := statsJSON(act)
+		if hasGoal {
+			stats.Goal = progress.json()
+		}
+
+		stats.Words = words.json()
+
+		writeJSON(stats)
+		return
+	}
+
+	fmt.Printf("%s\n\n", pluralPages(act.total))
+	fmt.Print(activityCalendar(act.counts, today))
+	fmt.Println()
+
+	for _, line := range activitySummary(act) {
+		fmt.Println(line)
+	}
+
+	if hasGoal {
+		fmt.Println()
+
+		for _, line := range progress.summary() {
+			fmt.Println(line)
+		}
+	}
+
+	fmt.Println()
+
+	for _, line := range words.summary() {
+		fmt.Println(line)
+	}
+
+	_, width := terminalSize()
+
+	fmt.Printf("\nEntries per month\n\n")
+	fmt.Print(monthChart(monthCounts(pageSet, today, loc, *months), width))
+}
+
+// statsJSON returns the activity, for the --json flag
+func statsJSON(act activity) src.JSONStats {
+	stats := src.JSONStats{
+		Version:       src.JSONVersion,
+		Pages:         act.total,
+		CurrentStreak: act.currentStreak,
+		LongestStreak: act.longestStreak,
+		BusiestCount:  act.busiestCount,
+	}
+
+	if act.busiestCount > 0 {
+		stats.BusiestDay = act.busiestDay.Format(activityDayFormat)
+	}
+
+	return stats
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errTagCommand   = "til tag needs a sub-command: suggest"
+	errTagNoSuggest = "there are no tags to suggest for %s"
+
+	defaultSuggestTop = 3
+
+	statusTagsApplied = "added %s to %s"
+)
+
+// tagCommands maps the name of a tag sub-command (til tag <command>) to the
+// function that runs it
+var tagCommands = map[string]func(ctx context.Context, args []string){
+	"suggest": runTagSuggest,
+}
+
+// runTag runs one of the tag sub-commands
+func runTag(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		src.Defeat(errors.New(errTagCommand))
+	}
+
+	cmd, ok := tagCommands[args[0]]
+	if !ok {
+		src.Defeat(errors.New(errTagCommand))
+	}
+
+	cmd(ctx, args[1:])
+}
+
+// runTagSuggest writes out the existing tags that might suit a page, based on
+// its content, best first. With --apply it adds the top suggestions to the
+// page's front-matter.
+// Example:
+//
+//	> til tag suggest goroutines --apply --top 2
+func runTagSuggest(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("tag suggest", flag.ContinueOnError)
+	apply := flags.Bool("apply", false, "adds the top suggestions to the page's front-matter")
+	top := flags.Int("top", defaultSuggestTop, "the number of suggestions to add with --apply")
+	query := strings.Join(parseInterspersed(flags, args), " ")
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	page, err := pickPage(pageSet, query)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	suggestions := pages.SuggestTags(page, newTagMap(pageSet))
+
+	if !*apply {
+		for _, suggestion := range suggestions {
+			fmt.Printf("%4d  %s\n", suggestion.Score, suggestion.Name)
+		}
+
+		return
+	}
+
+	names := topSuggestions(suggestions, *top)
+	if len(names) == 0 {
+		src.Defeat(fmt.Errorf(errTagNoSuggest, page.FilePath))
+	}
+
+	unlock, err := lockTargetDocs()
+	if err != nil {
+		src.Defeat(err)
+	}
+	defer unlock()
+
+	err = applyTags(page, names)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(fmt.Sprintf(statusTagsApplied, strings.Join(names, ", "), page.FilePath))
+}
+
+// topSuggestions returns the names of the first n suggestions
+func topSuggestions(suggestions []pages.TagSuggestion, n int) []string {
+	names := []string{}
+
+	for _, suggestion := range suggestions {
+		if len(names) >= n {
+			break
+		}
+
+		names = append(names, suggestion.Name)
+	}
+
+	return names
+}
+
+// applyTags adds the tags to the page's front-matter, after any it already has
+func applyTags(page *pages.Page, names []string) error {
+	tags := []string{}
+	for _, tag := range page.Tags() {
+		if tag.IsValid() {
+			tags = append(tags, tag.Name)
+		}
+	}
+
+	data, err := fileSystem.ReadFile(page.FilePath)
+	if err != nil {
+		return err
+	}
+
+	data, err = pages.SetFrontMatterTags(data, append(tags, names...))
+	if err != nil {
+		return err
+	}
+
+	return rewritePage(page.FilePath, data)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// runTags writes the tags out to the terminal, in alphabetical order.
+// Example:
+//
+//	> til tags --stats
+func runTags(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("tags", flag.ContinueOnError)
+	withStats := flags.Bool("stats", false, "shows the page count and first and last use of each tag")
+	parseFlags(flags, args)
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	tagMap := newTagMap(pageSet)
+
+	if jsonFlag {
+		writeJSON(tagsJSON(tagMap, *withStats))
+		return
+	}
+
+	if !*withStats {
+		for _, tagName := range tagMap.SortedTagNames() {
+			fmt.Println(tagName)
+		}
+
+		return
+	}
+
+	fmt.Print(tagStatsTable(tagMap))
+}
+
+// tagStatsTable returns the stats for every tag as a table with aligned columns
+func tagStatsTable(tagMap *pages.TagMap) string {
+	buf := &bytes.Buffer{}
+	writer := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "TAG\tPAGES\tFIRST USED\tLAST USED")
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		stats := tagMap.Stats(tagName)
+
+		fmt.Fprintf(
+			writer,
+			"%s\t%d\t%s\t%s\n",
+			tagName,
+			stats.Count,
+			formatStatsDate(stats.FirstUsed),
+			formatStatsDate(stats.LastUsed),
+		)
+	}
+
+	writer.Flush()
+
+	return buf.String()
+}
+
+// tagsJSON returns the tags, and their stats if asked for, for the --json flag
+func tagsJSON(tagMap *pages.TagMap, withStats bool) src.JSONTagList {
+	list := src.JSONTagList{Version: src.JSONVersion, Tags: []src.JSONTag{}}
+
+	for _, tagName := range tagMap.SortedTagNames() {
+		tag := src.JSONTag{Name: tagName}
+
+		if withStats {
+			stats := tagMap.Stats(tagName)
+
+			tag.Stats = &src.JSONTagStats{
+				Count:     stats.Count,
+				FirstUsed: jsonStatsDate(stats.FirstUsed),
+				LastUsed:  jsonStatsDate(stats.LastUsed),
+			}
+		}
+
+		list.Tags = append(list.Tags, tag)
+	}
+
+	return list
+}
+
+func jsonStatsDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format(time.RFC3339)
+}
+
+func formatStatsDate(date time.Time) string {
+	if date.IsZero() {
+		return "-"
+	}
+
+	return pages.FormatDate(date)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const (
+	errNotTerminal = "this needs a terminal to run in"
+
+	// The size used when the terminal can't tell us its own
+	defaultTerminalHeight = 24
+	defaultTerminalWidth  = 80
+
+	clearScreen = "\033[H\033[2J"
+	hideCursor  = "\033[?25l"
+	showCursor  = "\033[?25h"
+)
+
+// keyKind is the kind of key that was pressed. Anything that types a
+// character is a keyRune
+type keyKind int
+
+const (
+	keyRune keyKind = iota
+	keyBackspace
+	keyCtrlC
+	keyDown
+	keyEnter
+	keyEsc
+	keyUp
+)
+
+// key is a single key press read from the terminal
+type key struct {
+	kind keyKind
+	r    rune
+}
+
+// isTerminal returns true if the file is an interactive terminal, rather than
+// a pipe or a redirected file
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// rawTerminal puts the terminal into raw mode, so that key presses are read
+// as they're typed and aren't echoed, and returns the function that puts it
+// back the way it was. It uses stty, so it only works where stty does
+func rawTerminal() (func(), error) {
+	if !isTerminal(os.Stdin) {
+		return nil, errors.New(errNotTerminal)
+	}
+
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = stty("raw", "-echo")
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Print(hideCursor)
+
+	restore := func() {
+		fmt.Print(clearScreen + showCursor)
+		stty(strings.TrimSpace(saved))
+	}
+
+	return restore, nil
+}
+
+// terminalSize returns the height and width of the terminal, in characters
+func terminalSize() (int, int) {
+	out, err := stty("size")
+	if err != nil {
+		return defaultTerminalHeight, defaultTerminalWidth
+	}
+
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return defaultTerminalHeight, defaultTerminalWidth
+	}
+
+	height, err := strconv.Atoi(fields[0])
+	if err != nil || height <= 0 {
+		height = defaultTerminalHeight
+	}
+
+	width, err := strconv.Atoi(fields[1])
+	if err != nil || width <= 0 {
+		width = defaultTerminalWidth
+	}
+
+	return height, width
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+
+	out, err := cmd.Output()
+
+	return string(out), err
+}
+
+// readKey reads the next key press. The arrow keys arrive as escape sequences
+// (ESC [ A for up), which are turned into a single key. Keys it doesn't know
+// are skipped
+func readKey(reader *bufio.Reader) (key, error) {
+	for {
+		r, _, err := reader.ReadRune()
+		if err != nil {
+			return key{}, err
+		}
+
+		switch r {
+		case 3:
+			return key{kind: keyCtrlC}, nil
+		case '\r', '\n':
+			return key{kind: keyEnter}, nil
+		case 8, 127:
+			return key{kind: keyBackspace}, nil
+		case 27:
+			return readEscape(reader)
+		}
+
+		if r >= ' ' {
+			return key{kind: keyRune, r: r}, nil
+		}
+	}
+}
+
+// readEscape reads the rest of an escape sequence. An ESC on its own, with
+// nothing following it straight away, is the Esc key
+func readEscape(reader *bufio.Reader) (key, error) {
+	if reader.Buffered() == 0 {
+		return key{kind: keyEsc}, nil
+	}
+
+	next, _, err := reader.ReadRune()
+	if err != nil || (next != '[' && next != 'O') {
+		return key{kind: keyEsc}, nil
+	}
+
+	for {
+		r, _, err := reader.ReadRune()
+		if err != nil {
+			return key{}, err
+		}
+
+		switch r {
+		case 'A':
+			return key{kind: keyUp}, nil
+		case 'B':
+			return key{kind: keyDown}, nil
+		}
+
+		// Sequences end with a letter or a tilde. Other keys are skipped
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '~' {
+			return readKey(reader)
+		}
+	}
+}
+
+// writeScreen replaces what's on the terminal with the lines. In raw mode a
+// newline doesn't go back to the start of the line, so each one needs a
+// carriage return too
+func writeScreen(out io.Writer, lines []string) {
+	fmt.Fprint(out, clearScreen+strings.Join(lines, "\r\n"))
+}
@@ -1,353 +1,7439 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
+	"unicode/utf8"
 
 	"github.com/olebedev/config"
 	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
 	"github.com/senorprogrammer/til/src"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
 )
 
-func Test_determineCommitMessage(t *testing.T) {
+// updateGolden rewrites the golden files in testdata with what the tests
+// produce, for when the output is meant to change:
+//
+//	> go test -run Test_pageHTML -update
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func Test_generatedPageNames(t *testing.T) {
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(""))
+	pageSet := []*pages.Page{{TagsStr: "go, ada"}, {TagsStr: "go"}}
+
+	actual := generatedPageNames(pageSet)
+
+	assert.Equal(t, []string{"activity", "all", "archive", "changelog", "feed", "graph", "index", "sitemap", "tags", "ada", "go"}, actual)
+}
+
+func Test_newPageReservedNames(t *testing.T) {
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(""))
+	pageSet := []*pages.Page{{Title: "Channels", TagsStr: "ada"}}
+
+	// The new page's own tags get pages on the next build, so it can't be named after them
+	actual := newPageReservedNames(pageSet, "Go", []string{"Go", "go/concurrency"})
+
+	assert.Equal(t, []string{"activity", "all", "archive", "changelog", "feed", "graph", "index", "sitemap", "tags", "ada", "go"}, actual)
+	assert.Len(t, pageSet, 1)
+}
+
+func Test_parseTags(t *testing.T) {
+	assert.Equal(t, []string{}, parseTags(""))
+	assert.Equal(t, []string{"go", "cli"}, parseTags(" go, ,cli, "))
+}
+
+func Test_validateNewTags(t *testing.T) {
 	tests := []struct {
-		name       string
-		cfgMessage string
-		args       []string
-		expected   string
+		name        string
+		tags        []string
+		expectedErr string
 	}{
 		{
-			name:       "passed in via -s",
-			cfgMessage: "from the config",
-			args:       []string{"test", "-t", "b", "-s", "this", "is", "test"},
-			expected:   "this is test",
+			name: "with no tags",
+			tags: []string{},
 		},
 		{
-			name:       "from config file",
-			cfgMessage: "from the config",
-			args:       []string{"test", "-t", "b", "-s"},
-			expected:   "from the config",
+			name: "with ordinary tags",
+			tags: []string{"go", "cli"},
 		},
 		{
-			name:       "from default const",
-			cfgMessage: "",
-			args:       []string{"test", "-t", "b", "-s"},
-			expected:   "build, save, push",
+			name: "with a reserved name as a child tag",
+			tags: []string{"go/index"},
+		},
+		{
+			name:        "with a reserved tag",
+			tags:        []string{"go", "index"},
+			expectedErr: "'index' can't be used as a tag because til generates a page with that name",
+		},
+		{
+			name:        "with a reserved tag in another case",
+			tags:        []string{"Feed"},
+			expectedErr: "'Feed' can't be used as a tag because til generates a page with that name",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			os.Args = tt.args
-			flag.Parse()
-
-			msg := fmt.Sprintf("commitMessage: %s", tt.cfgMessage)
-			cfg, _ := config.ParseYamlBytes([]byte(msg))
+			err := validateNewTags(tt.tags)
 
-			actual := determineCommitMessage(cfg, os.Args)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
 
-			assert.Equal(t, tt.expected, actual)
+			assert.EqualError(t, err, tt.expectedErr)
 		})
 	}
 }
 
-/* -------------------- Configuration -------------------- */
+func Test_validateNewTags_AllowedTags(t *testing.T) {
+	_, cleanup := setUpTargetDir(t)
+	defer cleanup()
 
-func Test_getConfigPath(t *testing.T) {
-	actual, err := src.GetConfigFilePath()
+	src.GlobalConfig.Set("allowedTags", []interface{}{"go/concurrency", "javascript", "testing"})
+	src.GlobalConfig.Set("aliases", map[string]interface{}{"js": "javascript"})
 
-	assert.Contains(t, actual, "config.yml")
-	assert.NoError(t, err)
+	assert.NoError(t, validateNewTags([]string{"Testing", "js", "go", "go/concurrency"}))
+	assert.EqualError(t, validateNewTags([]string{"go", "tesing"}), "tag 'tesing' isn't in the allowed tags. Did you mean 'testing'?")
+
+	// An empty list allows nothing, and has nothing to suggest
+	src.GlobalConfig.Set("allowedTags", []interface{}{})
+	assert.EqualError(t, validateNewTags([]string{"go"}), "tag 'go' isn't in the allowed tags")
 }
 
-/* -------------------- More Helper Functions -------------------- */
+func Test_validateAllowedTags(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
 
-func Test_Colour(t *testing.T) {
-	x := src.Colour("yo%soy")
-	actual := x("cat")
+	pageSet := []*pages.Page{
+		{FilePath: "docs/a.md", TagsStr: "go, rust"},
+		{FilePath: "docs/b.md", TagsStr: "golang"},
+	}
 
-	assert.Equal(t, "yocatoy", actual)
-}
+	// With no allowed tags configured, anything goes
+	disallowed, err := validateAllowedTags(pageSet)
+	assert.NoError(t, err)
+	assert.Empty(t, disallowed)
 
-/* -------------------- Page -------------------- */
+	src.GlobalConfig.Set("allowedTagsFile", "allowed-tags.txt")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "allowed-tags.txt"), []byte("# Languages\ngo\n\njava\n"), 0644))
 
-func Test_Page_CreatedAt(t *testing.T) {
-	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+	expected := []string{
+		"docs/a.md: tag 'rust' isn't in the allowed tags",
+		"docs/b.md: tag 'golang' isn't in the allowed tags",
+	}
 
-	actual := page.CreatedAt()
+	disallowed, err = validateAllowedTags(pageSet)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, disallowed)
 
-	assert.Equal(t, 2020, actual.Year())
-	assert.Equal(t, 5, int(actual.Month()))
-	assert.Equal(t, 7, actual.Day())
-}
+	// They're errors, so they aren't warnings as well
+	warnings, err := validatePages(pageSet)
+	assert.NoError(t, err)
 
-func Test_Page_CreatedMonth(t *testing.T) {
-	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+	for _, warning := range warnings {
+		assert.NotContains(t, expected, warning)
+	}
 
-	actual := page.CreatedMonth()
+	// An allowed tags file that can't be read is an error, for the caller to
+	// report
+	assert.NoError(t, os.Remove(filepath.Join(docsDir, "allowed-tags.txt")))
 
-	assert.Equal(t, 5, int(actual))
+	_, err = validateAllowedTags(pageSet)
+	assert.True(t, os.IsNotExist(err))
+	assert.True(t, os.IsNotExist(checkAllowedTags([]string{"go"})))
 }
 
-func Test_IsContentPage(t *testing.T) {
+func Test_closestTag(t *testing.T) {
+	allowed := []string{"javascript", "Go", "rust", "ruby", "css"}
+
 	tests := []struct {
 		name     string
-		title    string
-		expected bool
+		input    string
+		expected string
 	}{
-		{
-			name:     "when is not content page",
-			title:    "",
-			expected: false,
-		},
-		{
-			name:     "when is content page",
-			title:    "test",
-			expected: true,
-		},
+		{name: "with a typo", input: "javscript", expected: "javascript"},
+		{name: "with a different case", input: "GO", expected: "Go"},
+		{name: "with a missing letter", input: "rub", expected: "ruby"},
+		{name: "with a tie broken alphabetically", input: "rusy", expected: "ruby"},
+		{name: "with nothing close", input: "x", expected: ""},
+		{name: "with a distant tag", input: "golang", expected: ""},
+		{name: "with a third of the letters changed", input: "jsvascropt", expected: "javascript"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			page := &pages.Page{Title: tt.title}
+			assert.Equal(t, tt.expected, closestTag(tt.input, allowed))
+		})
+	}
 
-			actual := page.IsContentPage()
+	assert.Equal(t, "", closestTag("go", []string{}))
+}
 
-			assert.Equal(t, tt.expected, actual)
-		})
+func Test_editDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("go", "go"))
+	assert.Equal(t, 1, editDistance("tesing", "testing"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Equal(t, 2, editDistance("", "go"))
+	assert.Equal(t, 1, editDistance("café", "cafe"))
+}
+
+func Test_listPages(t *testing.T) {
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("aliases:\n  js: javascript\n"))
+
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md", Title: "Closures", TagsStr: "js"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md", Title: "Boxes", TagsStr: "css"},
+		{Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/a.md", Title: "Arrays", TagsStr: "javascript"},
+		{FilePath: "docs/index.md"},
 	}
+
+	all := listPages(pageSet, "")
+	assert.Equal(t, 3, len(all))
+	assert.Equal(t, "May 09, 2020  Closures  (docs/c.md)", all[0])
+
+	byAlias := listPages(pageSet, "js")
+	byTag := listPages(pageSet, "javascript")
+
+	assert.Equal(t, 2, len(byTag))
+	assert.Equal(t, byTag, byAlias)
 }
 
-func Test_Page_Link(t *testing.T) {
-	page := &pages.Page{
-		Date:     "2020-05-07T13:13:08-07:00",
-		FilePath: "docs/zombies.md",
-		Title:    "Zombies",
+func Test_pagesByAuthor(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md", Title: "Closures", Author: "Zoë Ann"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md", Title: "Boxes"},
+		{Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/a.md", Title: "Arrays", Author: "zoe ann"},
+		{FilePath: "docs/index.md"},
 	}
 
-	actual := page.Link()
+	assert.Equal(t, pageSet, pagesByAuthor(pageSet, ""))
+	assert.Equal(t, []string{"May 09, 2020  Closures  (docs/c.md)", "May 07, 2020  Arrays  (docs/a.md)"}, listPages(pagesByAuthor(pageSet, "ZOE ANN"), ""))
+	assert.Equal(t, []string{"May 08, 2020  Boxes  (docs/b.md)"}, listPages(pagesByAuthor(pageSet, "unattributed"), ""))
 
-	assert.Equal(t, "<code>May 07, 2020</code> [Zombies](zombies.md)", actual)
+	assert.Equal(t, "Zoë Ann", listPagesJSON(pageSet, "").Pages[0].Author)
 }
 
-func Test_Page_PrettDate(t *testing.T) {
-	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+func Test_listPagesJSON(t *testing.T) {
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(""))
 
-	actual := page.PrettyDate()
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md", Title: "Closures", TagsStr: "js, go"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md", Title: "Boxes"},
+		{FilePath: "docs/index.md"},
+	}
 
-	assert.Equal(t, "May 07, 2020", actual)
+	expected := `{
+  "version": 1,
+  "pages": [
+    {
+      "date": "2020-05-09T13:13:08-07:00",
+      "path": "docs/c.md",
+      "tags": [
+        "js",
+        "go"
+      ],
+      "title": "Closures"
+    },
+    {
+      "date": "2020-05-08T13:13:08-07:00",
+      "path": "docs/b.md",
+      "tags": [],
+      "title": "Boxes"
+    }
+  ]
 }
+`
 
-/* -------------------- Tag -------------------- */
+	assert.Equal(t, expected, encodeJSON(t, listPagesJSON(pageSet, "")))
+	assert.Equal(t, "{\n  \"version\": 1,\n  \"pages\": []\n}\n", encodeJSON(t, listPagesJSON(pageSet, "rust")))
+}
 
-func Test_Tag_NewTag(t *testing.T) {
-	actual := pages.NewTag("ada", &pages.Page{Title: "test"})
+func Test_newPageJSON(t *testing.T) {
+	page := &pages.Page{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/2020-05-09-closures.md", Title: "Closures", TagsStr: "js"}
 
-	assert.IsType(t, &pages.Tag{}, actual)
-	assert.Equal(t, "ada", actual.Name)
-	assert.Equal(t, "test", actual.Pages[0].Title)
+	expected := `{
+  "version": 1,
+  "date": "2020-05-09T13:13:08-07:00",
+  "path": "docs/2020-05-09-closures.md",
+  "tags": [
+    "js"
+  ],
+  "title": "Closures"
 }
+`
 
-func Test_Tag_AddPage(t *testing.T) {
-	tag := pages.NewTag("ada", &pages.Page{Title: "test"})
-	tag.AddPage(&pages.Page{Title: "zombies"})
+	assert.Equal(t, expected, encodeJSON(t, newPageJSON(page, "")))
+	assert.Contains(t, encodeJSON(t, newPageJSON(page, "https://til.example.com")), `"permalink": "https://til.example.com/2020-05-09-closures.html"`)
+}
 
-	assert.Equal(t, 2, len(tag.Pages))
-	assert.Equal(t, "zombies", tag.Pages[1].Title)
+func Test_validateTagDescriptions(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	descs := "go:\n  description: Notes on Go\nrust:\n  description: Notes on Rust\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "_tags.yml"), []byte(descs), 0644))
+
+	pageSet := []*pages.Page{{Title: "Channels", TagsStr: "Go"}}
+
+	actual, err := validateTagDescriptions(pageSet)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(actual))
+	assert.Contains(t, actual[0], "tag 'rust' has a description")
 }
 
-func Test_Tag_IsValid(t *testing.T) {
-	tests := []struct {
-		name     string
-		title    string
-		expected bool
-	}{
-		{
-			name:     "when invalid",
-			title:    "",
-			expected: false,
-		},
-		{
-			name:     "when valid",
-			title:    "test",
-			expected: true,
-		},
-	}
+func Test_pageFilePaths_ExcludesTagDescriptions(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tag := pages.NewTag(tt.title, &pages.Page{})
+	src.GlobalConfig.Set("tagDescriptionsFile", "_tags.md")
 
-			actual := tag.IsValid()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "_tags.md"), []byte("go:\n  title: Go\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "zombies.md"), []byte("# Zombies\n"), 0644))
 
-			assert.Equal(t, tt.expected, actual)
-		})
-	}
+	actual := pageFilePaths()
+
+	assert.Equal(t, []string{filepath.Join(docsDir, "zombies.md")}, actual)
 }
 
-/* -------------------- TagMap -------------------- */
+func Test_buildTagPages_Hierarchy(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
 
-func Test_NewTagMap(t *testing.T) {
-	tests := []struct {
-		name        string
-		pages       []*pages.Page
-		expectedLen int
-	}{
-		{
-			name:        "with no pages",
-			pages:       []*pages.Page{},
-			expectedLen: 0,
-		},
-		{
-			name: "with pages",
-			pages: []*pages.Page{
-				{TagsStr: "go, ada"},
-			},
-			expectedLen: 2,
-		},
+	pageSet := []*pages.Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go/concurrency"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			actual := pages.NewTagMap(tt.pages).Tags
+	err := buildTagPages(context.Background(), pageSet, newTagMap(pageSet), docsDir, newBuildWarnings())
+	assert.NoError(t, err)
 
-			assert.Equal(t, tt.expectedLen, len(actual))
-		})
+	for _, filePath := range []string{"go.md", "tags/go/concurrency.md"} {
+		_, err := memFS.Stat(filepath.Join(docsDir, filepath.FromSlash(filePath)))
+		assert.NoError(t, err)
 	}
 }
 
-func Test_TagMap_Add(t *testing.T) {
-	tests := []struct {
-		name        string
-		tag         *pages.Tag
-		expectedLen int
-	}{
-		{
-			name:        "with an invalid tag",
-			tag:         &pages.Tag{},
-			expectedLen: 0,
-		},
-		{
-			name:        "with a new tag",
-			tag:         &pages.Tag{Name: "go"},
-			expectedLen: 1,
-		},
+func Test_buildTagPages_ReservedTags(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{Title: "Feeds", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/feeds.md", TagsStr: "sitemap, go"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tMap := pages.NewTagMap([]*pages.Page{})
-			tMap.Add(tt.tag)
+	tagMap := newTagMap(pageSet)
+	assert.NoError(t, buildTagPages(context.Background(), pageSet, tagMap, docsDir, newBuildWarnings()))
 
-			actual := tMap.Tags
+	_, err := memFS.Stat(filepath.Join(docsDir, "sitemap.md"))
+	assert.True(t, os.IsNotExist(err))
 
-			assert.Equal(t, tt.expectedLen, len(actual))
-		})
-	}
+	_, err = memFS.Stat(filepath.Join(docsDir, "go.md"))
+	assert.NoError(t, err)
+
+	// The tag is still there, it just doesn't get a page
+	assert.Equal(t, 2, tagMap.Len())
 }
 
-func Test_TagMap_BuildFromPages(t *testing.T) {
-	tests := []struct {
-		name        string
-		pages       []*pages.Page
-		expectedLen int
-	}{
-		{
-			name:        "with no pages",
-			pages:       []*pages.Page{},
-			expectedLen: 0,
-		},
-		{
-			name: "with pages",
-			pages: []*pages.Page{
-				{TagsStr: "go"},
-				{TagsStr: "ada"},
-			},
-			expectedLen: 2,
-		},
+func Test_buildTagPages_EmptyTags(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{Title: "Commas", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/commas.md", TagsStr: " , "},
+		{Title: "Trailing", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/trailing.md", TagsStr: "go, ,"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tMap := pages.NewTagMap([]*pages.Page{})
-			tMap.BuildFromPages(tt.pages)
+	assert.Equal(t, 0, len(pageSet[0].Tags()))
+	assert.Equal(t, 1, len(pageSet[1].Tags()))
+	assert.Equal(t, "go", pageSet[1].Tags()[0].Name)
 
-			actual := tMap.Tags
+	tagMap := newTagMap(pageSet)
+	assert.NoError(t, buildTagPages(context.Background(), pageSet, tagMap, docsDir, newBuildWarnings()))
+	assert.NoError(t, buildIndexPage(context.Background(), pageSet, tagMap, docsDir, newBuildWarnings()))
 
-			assert.Equal(t, tt.expectedLen, len(actual))
-		})
-	}
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "go.md"), filepath.Join(docsDir, "index.md"), filepath.Join(docsDir, "tags.md")}, filePaths)
+
+	// The only tag link in the index is go's, with nothing around it
+	data, _ := memFS.ReadFile(filepath.Join(docsDir, "index.md"))
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n[go](./go)\n"), string(data))
+
+	// A page with nothing but commas for tags is untagged
+	assert.Equal(t, []*pages.Page{pageSet[0]}, untaggedPages(pageSet))
 }
 
-func Test_TagMap_Get(t *testing.T) {
-	tests := []struct {
-		name        string
-		input       string
-		expectedLen int
-	}{
-		{
-			name:        "with missing tag",
-			input:       "ada",
-			expectedLen: 0,
-		},
-		{
-			name:        "with valid tag",
-			input:       "go",
-			expectedLen: 1,
-		},
+func Test_buildTagPages_Slugs(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{Title: "Nets", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/nets.md", TagsStr: "Machine Learning, c++"},
+		{Title: "Trees", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/trees.md", TagsStr: "machine-learning"},
 	}
 
-	for _, tt := range tests {
-		pageSet := []*pages.Page{{TagsStr: "go"}}
-		tMap := pages.NewTagMap(pageSet)
+	err := buildTagPages(context.Background(), pageSet, newTagMap(pageSet), docsDir, newBuildWarnings())
+	assert.NoError(t, err)
 
-		actual := tMap.Get(tt.input)
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "cplusplus.md"), filepath.Join(docsDir, "machine-learning.md"), filepath.Join(docsDir, "tags.md")}, filePaths)
 
+	// Only the first of the colliding tags gets the page
+	data, _ := memFS.ReadFile(filepath.Join(docsDir, "machine-learning.md"))
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## Machine Learning\n"))
+}
+
+func Test_buildTagPages_MinTagCount(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go, cli"},
+		{Title: "Mutexes", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "go"},
+	}
+
+	// With the default of 1, every tag gets a page
+	err := buildTagPages(context.Background(), pageSet, newTagMap(pageSet), docsDir, newBuildWarnings())
+	assert.NoError(t, err)
+
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "cli.md"), filepath.Join(docsDir, "go.md"), filepath.Join(docsDir, "tags.md")}, filePaths)
+
+	// Raising the threshold prunes the pages of tags that fall below it, but
+	// leaves content pages alone
+	src.GlobalConfig.Set("minTagCount", 2)
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "zombies.md"), []byte("---\ntitle: Zombies\n---\n"), 0644))
+
+	withZombies := append(pageSet, &pages.Page{Title: "Zombies", FilePath: "docs/z.md", TagsStr: "zombies"})
+	tagMap := newTagMap(withZombies)
+	assert.NoError(t, buildTagPages(context.Background(), withZombies, tagMap, docsDir, newBuildWarnings()))
+
+	filePaths, _ = memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "go.md"), filepath.Join(docsDir, "tags.md"), filepath.Join(docsDir, "zombies.md")}, filePaths)
+
+	// The tags are still there, they just don't get a page
+	assert.Equal(t, 3, tagMap.Len())
+	assert.Equal(t, 1, len(listPages(pageSet, "cli")))
+}
+
+func Test_buildIndexPage_MinTagCount(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	src.GlobalConfig.Set("minTagCount", 2)
+
+	pageSet := []*pages.Page{
+		{Title: "Channels", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/channels.md", TagsStr: "go, cli"},
+		{Title: "Mutexes", Date: "2020-05-06T13:13:08-07:00", FilePath: "docs/mutexes.md", TagsStr: "go"},
+	}
+
+	assert.NoError(t, buildIndexPage(context.Background(), pageSet, pages.NewTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	data, _ := memFS.ReadFile(filepath.Join(docsDir, "index.md"))
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n[go](./go)\n"))
+}
+
+func Test_configuredGenerators(t *testing.T) {
+	names := func(gens []til.Generator) []string {
+		result := []string{}
+		for _, gen := range gens {
+			result = append(result, gen.Name())
+		}
+		return result
+	}
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(""))
+
+	gens, err := configuredGenerators(newBuildWarnings())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tags", "index"}, names(gens))
+
+	// The older flags still add their pages
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("graphPage: true\nchangelogPage: true\n"))
+
+	gens, err = configuredGenerators(newBuildWarnings())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tags", "index", "graph", "changelog"}, names(gens))
+
+	// So do saved searches
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("savedSearches:\n  reading-list: tag:reading\n"))
+
+	gens, err = configuredGenerators(newBuildWarnings())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tags", "index", "searches"}, names(gens))
+
+	// A generators list is used as it is, in order
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("graphPage: true\ngenerators: [index, graph]\n"))
+
+	gens, err = configuredGenerators(newBuildWarnings())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"index", "graph"}, names(gens))
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("generators: [index, rss]\n"))
+
+	_, err = configuredGenerators(newBuildWarnings())
+	assert.EqualError(t, err, "unknown generator 'rss' in the generators config. Known generators are: activity, changelog, graph, index, searches, tags")
+}
+
+func Test_buildSavedSearchPages(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	cfg := fmt.Sprintf("targetDirectories:\n  a: %s\nsavedSearches:\n  reading-list: \"tag:reading AND NOT tag:done\"\n", filepath.Dir(docsDir))
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(cfg))
+
+	files := map[string]string{
+		"a.md": "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Dune\ntags: reading\n---\n",
+		"b.md": "---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Emma\ntags: reading, done\n---\n",
+		"c.md": "---\ndate: 2020-05-09T13:13:08-07:00\ntitle: Closures\ntags: go\n---\n",
+	}
+
+	for name, content := range files {
+		assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, name), []byte(content), 0644))
+	}
+
+	pageSet, err := loadPages(context.Background())
+	assert.NoError(t, err)
+
+	assert.NoError(t, buildSavedSearchPages(context.Background(), pageSet, newTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	data, err := memFS.ReadFile(filepath.Join(docsDir, "reading-list.md"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## reading-list\n\n_The pages that match `tag:reading AND NOT tag:done`_\n\n* <code>May 07, 2020</code> [Dune](a.md)\n"))
+	assert.NotContains(t, string(data), "Emma")
+
+	// The generated page isn't a page itself, so building again reads the same
+	// pages and writes the same page
+	reloaded, err := loadPages(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, reloaded, len(pageSet))
+
+	assert.Contains(t, generatedPageNames(pageSet), "reading-list")
+}
+
+func Test_checkSavedSearches(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "dune.md"), []byte("---\ntitle: Dune\n---\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "old-list.md"), []byte("## old-list\n"), 0644))
+
+	tagMap := pages.NewTagMap([]*pages.Page{{Title: "Dune", FilePath: filepath.Join(docsDir, "dune.md"), TagsStr: "reading, go/concurrency"}})
+
+	tests := []struct {
+		name        string
+		searches    []src.SavedSearch
+		expectedErr string
+	}{
+		{
+			name:     "with no collisions",
+			searches: []src.SavedSearch{{Name: "reading-list"}, {Name: "old-list"}, {Name: "concurrency"}},
+		},
+		{
+			name:        "with a reserved page",
+			searches:    []src.SavedSearch{{Name: "Index"}},
+			expectedErr: "saved search 'Index' would overwrite the index page that til generates. Please rename the saved search",
+		},
+		{
+			name:        "with a tag",
+			searches:    []src.SavedSearch{{Name: "reading"}},
+			expectedErr: "saved search 'reading' would overwrite the tag page for 'reading'. Please rename the saved search",
+		},
+		{
+			name:        "with a page",
+			searches:    []src.SavedSearch{{Name: "dune"}},
+			expectedErr: fmt.Sprintf("saved search 'dune' would overwrite the page %s. Please rename the saved search", filepath.Join(docsDir, "dune.md")),
+		},
+		{
+			name:        "with another saved search",
+			searches:    []src.SavedSearch{{Name: "To Read"}, {Name: "to-read"}},
+			expectedErr: fmt.Sprintf("saved searches 'To Read' and 'to-read' would both write %s. Please rename one of them", filepath.Join(docsDir, "to-read.md")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSavedSearches(tt.searches, tagMap, docsDir)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_loadPages_Errors(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "zombies.md")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("---\ntitle: [Zombies\n---\n"), 0644))
+
+	goodPath := filepath.Join(docsDir, "arrays.md")
+	assert.NoError(t, ioutil.WriteFile(goodPath, []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n"), 0644))
+
+	// A page that can't be parsed is skipped, and the rest are still loaded
+	pageSet, skipped, err := loadPagesSkipping(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pageSet))
+	assert.Equal(t, goodPath, pageSet[0].FilePath)
+	assert.Equal(t, 1, len(skipped))
+	assert.Equal(t, filePath, skipped[0].FilePath)
+	assert.Equal(t, []string{skipped[0].Err.Error()}, validateSkipped(skipped))
+
+	// With -strict, it stops the build
+	strictFlag = true
+	defer func() { strictFlag = false }()
+
+	_, err = loadPages(context.Background())
+
+	var parseErr *src.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, filePath, parseErr.FilePath)
+	assert.Equal(t, src.ExitParse, src.ExitCode(err))
+
+	assert.NoError(t, os.Remove(goodPath))
+	strictFlag = false
+
+	// An unreadable tag descriptions file stops the build with an IO error
+	assert.NoError(t, os.Remove(filePath))
+	assert.NoError(t, os.Mkdir(filepath.Join(docsDir, "_tags.yml"), 0755))
+
+	err = buildTagPages(context.Background(), []*pages.Page{}, pages.NewTagMap([]*pages.Page{}), docsDir, newBuildWarnings())
+
+	assert.Error(t, err)
+	assert.Equal(t, src.ExitIO, src.ExitCode(err))
+}
+
+func Test_ExitCode(t *testing.T) {
+	cause := errors.New("boom")
+	_, pathErr := os.Open(filepath.Join(os.TempDir(), "til-does-not-exist"))
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "with no error", err: nil, expected: 0},
+		{name: "with a usage error", err: &src.UsageError{Err: cause}, expected: src.ExitUsage},
+		{name: "with a parse error", err: &src.ParseError{FilePath: "docs/a.md", Err: cause}, expected: src.ExitParse},
+		{name: "with a strict build's warnings", err: &src.StrictError{Warnings: 2}, expected: src.ExitUsage},
+		{name: "with a path error", err: pathErr, expected: src.ExitIO},
+		{name: "with a wrapped path error", err: fmt.Errorf("loading: %w", pathErr), expected: src.ExitIO},
+		{name: "with a cancellation", err: fmt.Errorf("building: %w", context.Canceled), expected: src.ExitCanceled},
+		{name: "with any other error", err: cause, expected: src.ExitFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, src.ExitCode(tt.err))
+		})
+	}
+
+	// The wrapped error can still be found
+	assert.True(t, errors.Is(&src.UsageError{Err: cause}, cause))
+	assert.Equal(t, "docs/a.md: boom", (&src.ParseError{FilePath: "docs/a.md", Err: cause}).Error())
+}
+
+func Test_validateTagPagePaths(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "Nets", TagsStr: "Machine Learning"},
+		{Title: "Trees", TagsStr: "machine-learning"},
+	}
+
+	actual, err := validateTagPagePaths(pageSet)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"tags Machine Learning, machine-learning all have the tag page machine-learning.md"}, actual)
+}
+
+func Test_validateReservedTags(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "Feeds", FilePath: "docs/feeds.md", TagsStr: "Index, go"},
+		{Title: "Zombies", FilePath: "docs/zombies.md", TagsStr: "go"},
+	}
+
+	actual, err := validateReservedTags(pageSet)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"docs/feeds.md: tag 'Index' is reserved, so it won't get a tag page"}, actual)
+}
+
+func Test_tagStatsTable(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", TagsStr: "go, javascript"},
+		{Date: "2020-05-07T13:13:08-07:00", TagsStr: "go"},
+		{Date: "not a date", TagsStr: "lua"},
+	}
+
+	actual := tagStatsTable(pages.NewTagMap(pageSet))
+
+	expected := "" +
+		"TAG         PAGES  FIRST USED    LAST USED\n" +
+		"go          2      May 07, 2020  May 09, 2020\n" +
+		"javascript  1      May 09, 2020  May 09, 2020\n" +
+		"lua         1      -             -\n"
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_tagsJSON(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", TagsStr: "go"},
+		{Date: "not a date", TagsStr: "lua"},
+	}
+
+	tagMap := pages.NewTagMap(pageSet)
+
+	assert.Equal(t, "{\n  \"version\": 1,\n  \"tags\": [\n    {\n      \"name\": \"go\"\n    },\n    {\n      \"name\": \"lua\"\n    }\n  ]\n}\n", encodeJSON(t, tagsJSON(tagMap, false)))
+
+	expected := `{
+  "version": 1,
+  "tags": [
+    {
+      "name": "go",
+      "stats": {
+        "count": 1,
+        "firstUsed": "2020-05-09T13:13:08-07:00",
+        "lastUsed": "2020-05-09T13:13:08-07:00"
+      }
+    },
+    {
+      "name": "lua",
+      "stats": {
+        "count": 1,
+        "firstUsed": "",
+        "lastUsed": ""
+      }
+    }
+  ]
+}
+`
+
+	assert.Equal(t, expected, encodeJSON(t, tagsJSON(tagMap, true)))
+}
+
+func Test_JSONValidation(t *testing.T) {
+	validation := src.JSONValidation{
+		Version:  src.JSONVersion,
+		Errors:   []string{},
+		Warnings: []string{"tag 'go' has a description in docs/_tags.yml but no pages"},
+	}
+
+	expected := `{
+  "version": 1,
+  "errors": [],
+  "warnings": [
+    "tag 'go' has a description in docs/_tags.yml but no pages"
+  ]
+}
+`
+
+	assert.Equal(t, expected, encodeJSON(t, validation))
+}
+
+func Test_untaggedPages(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "Tagged", TagsStr: "go"},
+		{Title: "Untagged"},
+		{Title: "Blank Tags", TagsStr: " , "},
+		{TagsStr: ""},
+	}
+
+	actual := untaggedPages(pageSet)
+
+	assert.Equal(t, 2, len(actual))
+	assert.Equal(t, "Untagged", actual[0].Title)
+	assert.Equal(t, "Blank Tags", actual[1].Title)
+}
+
+func Test_selectUntaggedPage(t *testing.T) {
+	untagged := []*pages.Page{{Title: "First"}, {Title: "Second"}}
+
+	tests := []struct {
+		name          string
+		pages         []*pages.Page
+		num           string
+		expectedTitle string
+		expectedErr   string
+	}{
+		{name: "with no number", pages: untagged, num: "", expectedTitle: "First"},
+		{name: "with a number", pages: untagged, num: "2", expectedTitle: "Second"},
+		{name: "with a number out of range", pages: untagged, num: "3", expectedErr: "there is no untagged page number 3"},
+		{name: "with something that isn't a number", pages: untagged, num: "x", expectedErr: "there is no untagged page number x"},
+		{name: "with no untagged pages", pages: []*pages.Page{}, num: "", expectedErr: "there are no untagged pages to open"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := selectUntaggedPage(tt.pages, tt.num)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTitle, actual.Title)
+		})
+	}
+}
+
+func Test_defaultEditorFor(t *testing.T) {
+	assert.Equal(t, "notepad", defaultEditorFor("windows"))
+	assert.Equal(t, "open", defaultEditorFor("darwin"))
+}
+
+func Test_determineCommitMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfgMessage string
+		args       []string
+		expected   string
+	}{
+		{
+			name:       "passed in via -s",
+			cfgMessage: "from the config",
+			args:       []string{"test", "-t", "b", "-s", "this", "is", "test"},
+			expected:   "this is test",
+		},
+		{
+			name:       "from config file",
+			cfgMessage: "from the config",
+			args:       []string{"test", "-t", "b", "-s"},
+			expected:   "from the config",
+		},
+		{
+			name:       "from default const",
+			cfgMessage: "",
+			args:       []string{"test", "-t", "b", "-s"},
+			expected:   "build, save, push",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Args = tt.args
+			flag.Parse()
+
+			msg := fmt.Sprintf("commitMessage: %s", tt.cfgMessage)
+			cfg, _ := config.ParseYamlBytes([]byte(msg))
+
+			actual := determineCommitMessage(cfg, os.Args)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_parseTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		keepCase bool
+		expected string
+	}{
+		{
+			name:     "with no arguments",
+			args:     []string{},
+			keepCase: false,
+			expected: "",
+		},
+		{
+			name:     "with title-casing",
+			args:     []string{"til", "about", "json", "and", "http"},
+			keepCase: false,
+			expected: "TIL About JSON and HTTP",
+		},
+		{
+			name:     "with keep-case",
+			args:     []string{"til", "about", "json", "and", "http"},
+			keepCase: true,
+			expected: "til about json and http",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := parseTitle(tt.args, tt.keepCase)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+/* -------------------- Configuration -------------------- */
+
+func Test_TagAliases(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         string
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:     "with no aliases",
+			cfg:      "editor: vim",
+			expected: map[string]string{},
+		},
+		{
+			name:     "with aliases",
+			cfg:      "aliases:\n  JS: javascript\n  golang: go\n",
+			expected: map[string]string{"js": "javascript", "golang": "go"},
+		},
+		{
+			name:        "with a chain",
+			cfg:         "aliases:\n  js: ecmascript\n  ecmascript: javascript\n",
+			expectedErr: "tag alias 'js' points to 'ecmascript', which is itself an alias. Aliases must point directly to a tag",
+		},
+		{
+			name:        "with a cycle",
+			cfg:         "aliases:\n  js: javascript\n  javascript: js\n",
+			expectedErr: "tag alias 'javascript' points to 'js', which is itself an alias. Aliases must point directly to a tag",
+		},
+		{
+			name:        "with an alias to itself",
+			cfg:         "aliases:\n  js: JS\n",
+			expectedErr: "tag alias 'js' points to itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			actual, err := src.TagAliases(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_SavedSearches(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         string
+		expected    []src.SavedSearch
+		expectedErr string
+	}{
+		{
+			name:     "with no saved searches",
+			cfg:      "editor: vim",
+			expected: []src.SavedSearch{},
+		},
+		{
+			name: "with saved searches",
+			cfg:  "savedSearches:\n  reading-list: \"tag:reading AND NOT tag:done\"\n  go: tag:go\n",
+			expected: []src.SavedSearch{
+				{Name: "go", Query: "tag:go"},
+				{Name: "reading-list", Query: "tag:reading AND NOT tag:done"},
+			},
+		},
+		{
+			name:        "without a query",
+			cfg:         "savedSearches:\n  reading-list:\n",
+			expectedErr: "saved search 'reading-list' needs a query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			actual, err := src.SavedSearches(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_getConfigPath(t *testing.T) {
+	actual, err := src.GetConfigFilePath()
+
+	assert.Contains(t, actual, "config.yml")
+	assert.NoError(t, err)
+}
+
+func Test_GetTargetDir(t *testing.T) {
+	tests := []struct {
+		name        string
+		withDocsDir bool
+		expected    string
+	}{
+		{
+			name:        "without the docs dir",
+			withDocsDir: false,
+			expected:    filepath.Join("/tmp", "til"),
+		},
+		{
+			name:        "with the docs dir",
+			withDocsDir: true,
+			expected:    filepath.Join("/tmp", "til", "docs"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte("targetDirectories:\n  a: /tmp/til/"))
+
+			actual, err := src.GetTargetDir(cfg, "", tt.withDocsDir)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+/* -------------------- More Helper Functions -------------------- */
+
+func Test_Colour(t *testing.T) {
+	x := src.Colour("yo%soy")
+	actual := x("cat")
+
+	assert.Equal(t, "yocatoy", actual)
+}
+
+/* -------------------- Page -------------------- */
+
+func Test_Page_CreatedAt(t *testing.T) {
+	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+
+	actual := page.CreatedAt()
+
+	assert.Equal(t, 2020, actual.Year())
+	assert.Equal(t, 5, int(actual.Month()))
+	assert.Equal(t, 7, actual.Day())
+}
+
+func Test_Page_CreatedMonth(t *testing.T) {
+	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+
+	actual := page.CreatedMonth()
+
+	assert.Equal(t, 5, int(actual))
+}
+
+func Test_IsContentPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected bool
+	}{
+		{
+			name:     "when is not content page",
+			title:    "",
+			expected: false,
+		},
+		{
+			name:     "when is content page",
+			title:    "test",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &pages.Page{Title: tt.title}
+
+			actual := page.IsContentPage()
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_Page_Link(t *testing.T) {
+	page := &pages.Page{
+		Date:     "2020-05-07T13:13:08-07:00",
+		FilePath: "docs/zombies.md",
+		Title:    "Zombies",
+	}
+
+	actual := page.Link()
+
+	assert.Equal(t, "<code>May 07, 2020</code> [Zombies](zombies.md)", actual)
+}
+
+func Test_Page_Link_Format(t *testing.T) {
+	page := &pages.Page{
+		Date:     "2020-05-07T13:13:08-07:00",
+		FilePath: "docs/zombies.org",
+		Title:    "Zombies",
+	}
+
+	actual := page.Link()
+
+	assert.Equal(t, "<code>May 07, 2020</code> [Zombies](zombies.org) <sub>Org</sub>", actual)
+}
+
+func Test_FormatOf(t *testing.T) {
+	tests := []struct {
+		filePath string
+		expected string
+		rendered bool
+	}{
+		{filePath: "docs/zombies.md", expected: "Markdown", rendered: true},
+		{filePath: "docs/zombies.markdown", expected: "Markdown", rendered: true},
+		{filePath: "docs/zombies.adoc", expected: "AsciiDoc"},
+		{filePath: "docs/zombies.asciidoc", expected: "AsciiDoc"},
+		{filePath: "docs/Zombies.ORG", expected: "Org"},
+		{filePath: "docs/zombies.txt", expected: "Markdown", rendered: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			format := pages.FormatOf(tt.filePath)
+
+			assert.Equal(t, tt.expected, format.Name)
+			assert.Equal(t, tt.rendered, format.Rendered)
+		})
+	}
+}
+
+func Test_Page_Link_Slashes(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+	}{
+		{
+			name:     "with forward slashes",
+			filePath: "docs/2020-05-07T13-13-08-zombies.md",
+		},
+		{
+			name:     "with backslashes",
+			filePath: `C:\Users\chris\til\docs\2020-05-07T13-13-08-zombies.md`,
+		},
+		{
+			name:     "with the OS separator",
+			filePath: filepath.Join("docs", "2020-05-07T13-13-08-zombies.md"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &pages.Page{
+				Date:     "2020-05-07T13:13:08-07:00",
+				FilePath: tt.filePath,
+				Title:    "Zombies",
+			}
+
+			actual := page.Link()
+
+			assert.Equal(t, "<code>May 07, 2020</code> [Zombies](2020-05-07T13-13-08-zombies.md)", actual)
+		})
+	}
+}
+
+func Test_NewPage_Collisions(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	now := time.Date(2020, 5, 7, 13, 13, 8, 0, time.UTC)
+	pages.Now = func() time.Time { return now }
+	defer func() { pages.Now = time.Now }()
+
+	first := newTestPage(t, "Zombies", tDir, pages.PageOptions{Body: "The first one\n"})
+	second := newTestPage(t, "Zombies", tDir, pages.PageOptions{})
+	third := newTestPage(t, "Zombies", tDir, pages.PageOptions{})
+
+	assert.Equal(t, filepath.Join(tDir, "2020-05-07T13-13-08-zombies.md"), first.FilePath)
+	assert.Equal(t, filepath.Join(tDir, "2020-05-07T13-13-08-zombies-2.md"), second.FilePath)
+	assert.Equal(t, filepath.Join(tDir, "2020-05-07T13-13-08-zombies-3.md"), third.FilePath)
+
+	filePaths, _ := filepath.Glob(filepath.Join(tDir, "*.md"))
+	assert.Equal(t, 3, len(filePaths))
+
+	// The pages made later in the same second left the first one alone
+	data, _ := ioutil.ReadFile(first.FilePath)
+	assert.True(t, strings.HasSuffix(string(data), "The first one\n"))
+}
+
+func Test_ReadPage_LineEndings(t *testing.T) {
+	tests := []struct {
+		file     string
+		title    string
+		tags     []string
+		contains string
+	}{
+		{file: "crlf.md", title: "Line endings", tags: []string{"go", "windows"}, contains: "# Line endings\n\nWritten in Notepad.\n"},
+		{file: "bom.md", title: "Byte order marks", tags: []string{"go"}, contains: "# Byte order marks\n"},
+		{file: "bom-crlf.md", title: "Both", tags: []string{"go", "windows"}, contains: "# Both\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			filePath := filepath.Join("testdata", "pages", tt.file)
+			before, _ := ioutil.ReadFile(filePath)
+
+			page, err := pages.ReadPage(filePath)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.title, page.Title)
+			assert.False(t, page.CreatedAt().IsZero())
+			assert.Contains(t, page.Content, tt.contains)
+			assert.NotContains(t, page.Content, "\r")
+
+			names := []string{}
+			for _, tag := range page.Tags() {
+				names = append(names, tag.Name)
+			}
+
+			assert.Equal(t, tt.tags, names)
+			assert.Contains(t, page.Link(), fmt.Sprintf("[%s](%s)", tt.title, tt.file))
+
+			// The file is read as LF, but left as it was
+			after, _ := ioutil.ReadFile(filePath)
+			assert.Equal(t, before, after)
+		})
+	}
+
+	// The fixtures really are what they say they are
+	data, _ := ioutil.ReadFile(filepath.Join("testdata", "pages", "bom-crlf.md"))
+	assert.True(t, bytes.HasPrefix(data, []byte("\ufeff---\r\n")))
+}
+
+func Test_NewPage_LineEndings(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	page := newTestPage(t, "Zombies", "docs", pages.PageOptions{Body: "Pasted from\r\nWindows\r\n", FS: memFS})
+
+	data, _ := memFS.ReadFile(page.FilePath)
+	assert.NotContains(t, string(data), "\r")
+	assert.True(t, strings.HasSuffix(string(data), "# Zombies\n\nPasted from\nWindows\n"))
+}
+
+func Test_WriteNewFile(t *testing.T) {
+	memFS := pages.NewMemFS()
+	filePath := filepath.Join("docs", "2020-05-07T13-13-08-zombies.md")
+
+	assert.NoError(t, pages.WriteNewFile(memFS, filePath, []byte("first\n"), 0644))
+
+	// Something got to the path between it being picked and the page being
+	// written, like another script creating the same page in the same second
+	err := pages.WriteNewFile(memFS, filePath, []byte("second\n"), 0644)
+
+	assert.EqualError(t, err, fmt.Sprintf("won't write over %s: file already exists", filePath))
+	assert.True(t, errors.Is(err, os.ErrExist))
+
+	data, _ := memFS.ReadFile(filePath)
+	assert.Equal(t, "first\n", string(data))
+}
+
+func Test_WriteNewFile_Concurrent(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	filePath := filepath.Join(tDir, "2020-05-07T13-13-08-zombies.md")
+
+	for _, fsys := range []pages.FS{pages.OSFS{}, pages.NewMemFS()} {
+		errs := make(chan error, 20)
+
+		// Only one of the writers that race to the same path gets to write it
+		for i := 0; i < cap(errs); i++ {
+			go func(i int) {
+				errs <- pages.WriteNewFile(fsys, filePath, []byte(fmt.Sprintf("writer %d\n", i)), 0644)
+			}(i)
+		}
+
+		written := 0
+		for i := 0; i < cap(errs); i++ {
+			if err := <-errs; err == nil {
+				written++
+			} else {
+				assert.True(t, errors.Is(err, os.ErrExist), err.Error())
+			}
+		}
+
+		assert.Equal(t, 1, written)
+
+		data, err := fsys.ReadFile(filePath)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "writer "))
+	}
+}
+
+func Test_ReadFileLimit(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	filePath := filepath.Join(tDir, "huge.md")
+	assert.NoError(t, ioutil.WriteFile(filePath, bytes.Repeat([]byte("a"), 64*1024), 0644))
+
+	// The real disk is read through a bounded reader
+	_, err := pages.ReadFileLimit(pages.OSFS{}, filePath, 1024)
+	assert.EqualError(t, err, "it's more than 1024 bytes, which is too big to be a page")
+
+	data, err := pages.ReadFileLimit(pages.OSFS{}, filePath, 64*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, 64*1024, len(data))
+
+	// Filesystems that can't be read a bit at a time go by the file's size
+	memFS := pages.NewMemFS()
+	assert.NoError(t, memFS.WriteFile("huge.md", bytes.Repeat([]byte("a"), 2048), 0644))
+
+	_, err = pages.ReadFileLimit(memFS, "huge.md", 1024)
+	assert.EqualError(t, err, "it's more than 1024 bytes, which is too big to be a page")
+
+	data, err = pages.ReadFileLimit(memFS, "huge.md", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2048, len(data))
+}
+
+func Test_FreeFilePath(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	filePath, err := pages.FreeFilePath(tDir, "zombies", []string{"zombies"})
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(tDir, "zombies-2.md"), filePath)
+
+	// A docs directory that's a file can't be looked in, rather than every name being taken
+	notDir := filepath.Join(tDir, "docs")
+	assert.NoError(t, ioutil.WriteFile(notDir, []byte("not a directory\n"), 0644))
+
+	_, err = pages.FreeFilePath(notDir, "zombies", nil)
+	assert.Error(t, err)
+	assert.False(t, os.IsNotExist(err))
+
+	// It gives up once every name it tries is taken
+	reserved := []string{"zombies"}
+	for i := 2; i <= 1000; i++ {
+		reserved = append(reserved, fmt.Sprintf("zombies-%d", i))
+	}
+
+	_, err = pages.FreeFilePath(tDir, "zombies", reserved)
+	assert.EqualError(t, err, fmt.Sprintf("couldn't find a free file name for zombies in %s after 1000 tries", tDir))
+
+	filePath, err = pages.FreeFilePath(tDir, "zombies", reserved[:999])
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(tDir, "zombies-1000.md"), filePath)
+}
+
+func Test_NewPage_Error(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	// A page that can't be written is an error for the caller, rather than til exiting
+	notDir := filepath.Join(tDir, "docs")
+	assert.NoError(t, ioutil.WriteFile(notDir, []byte("not a directory\n"), 0644))
+
+	page, err := pages.NewPage("Zombies", notDir, pages.PageOptions{})
+
+	assert.Nil(t, page)
+	assert.Error(t, err)
+}
+
+func Test_NewPage_ReservedNames(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	now := time.Date(2020, 5, 7, 13, 13, 8, 0, time.UTC)
+	pages.Now = func() time.Time { return now }
+	defer func() { pages.Now = time.Now }()
+
+	page := newTestPage(t, "Zombies", tDir, pages.PageOptions{
+		ReservedNames: []string{"index", "2020-05-07T13-13-08-zombies"},
+	})
+
+	assert.Equal(t, filepath.Join(tDir, "2020-05-07T13-13-08-zombies-2.md"), page.FilePath)
+}
+
+func Test_NewPage_OmitDate(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	opts := pages.PageOptions{OmitDate: true, ReservedNames: []string{"index", "go"}}
+
+	first := newTestPage(t, "Go Contexts", tDir, opts)
+	second := newTestPage(t, "Go Contexts", tDir, opts)
+	tagged := newTestPage(t, "Go", tDir, opts)
+
+	assert.Equal(t, filepath.Join(tDir, "go-contexts.md"), first.FilePath)
+	assert.Equal(t, filepath.Join(tDir, "go-contexts-2.md"), second.FilePath)
+	assert.Equal(t, filepath.Join(tDir, "go-2.md"), tagged.FilePath)
+}
+
+func Test_NewPage_Tags(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	page := newTestPage(t, "Zombies", tDir, pages.PageOptions{Tags: []string{"go", "cli"}})
+	saved := readTestPage(t, page.FilePath)
+
+	assert.Equal(t, pages.TagsString("go, cli"), saved.TagsStr)
+}
+
+func Test_NewPage_LongTitle(t *testing.T) {
+	tDir, _ := ioutil.TempDir("", "til")
+	defer os.RemoveAll(tDir)
+
+	title := strings.TrimSpace(strings.Repeat("Zombies Eat Brains ", 16))
+	assert.Equal(t, 303, len(title))
+
+	page := newTestPage(t, title, tDir, pages.PageOptions{SlugMaxLength: 80})
+
+	name := strings.TrimSuffix(filepath.Base(page.FilePath), ".md")
+	slug := name[len("2006-01-02T15-04-05-"):]
+
+	assert.LessOrEqual(t, len(slug), 80)
+	assert.False(t, strings.HasSuffix(slug, "-"))
+	assert.True(t, strings.HasSuffix(slug, "zombies") || strings.HasSuffix(slug, "eat") || strings.HasSuffix(slug, "brains"))
+
+	saved := readTestPage(t, page.FilePath)
+	assert.Equal(t, title, saved.Title)
+}
+
+func Test_Page_PrettDate(t *testing.T) {
+	page := &pages.Page{Date: "2020-05-07T13:13:08-07:00"}
+
+	actual := page.PrettyDate()
+
+	assert.Equal(t, "May 07, 2020", actual)
+}
+
+func Test_TagsString_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected pages.TagsString
+	}{
+		{
+			name:     "with no tags",
+			input:    "tags:",
+			expected: "",
+		},
+		{
+			name:     "with string tags",
+			input:    "tags: go, cli",
+			expected: "go, cli",
+		},
+		{
+			name:     "with list tags",
+			input:    "tags: [go, cli]",
+			expected: "go, cli",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &pages.Page{}
+			err := yaml.Unmarshal([]byte(tt.input), page)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, page.TagsStr)
+		})
+	}
+}
+
+/* -------------------- Slug -------------------- */
+
+func Test_Slug(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "with plain ASCII",
+			title:    "Til About Go",
+			expected: "til-about-go",
+		},
+		{
+			name:     "with Polish diacritics",
+			title:    "Błędy w Go",
+			expected: "bledy-w-go",
+		},
+		{
+			name:     "with French diacritics",
+			title:    "naïve café",
+			expected: "naive-cafe",
+		},
+		{
+			name:     "with German characters",
+			title:    "Straße über Größe",
+			expected: "strasse-uber-grosse",
+		},
+		{
+			name:     "with repeated whitespace",
+			title:    "go   contexts",
+			expected: "go-contexts",
+		},
+		{
+			name:     "with emoji",
+			title:    "🎉 party time 🎉",
+			expected: "party-time",
+		},
+		{
+			name:     "with emoji between words",
+			title:    "go 🚀 fast",
+			expected: "go-fast",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := pages.Slug(tt.title)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_Slug_UnsafeCharacters(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{title: "What's new in Go 1.22?", expected: "whats-new-in-go-1-22"},
+		{title: "A/B testing", expected: "a-b-testing"},
+		{title: `C:\Windows\System32`, expected: "c-windows-system32"},
+		{title: "Why * matters", expected: "why-matters"},
+		{title: `He said "hello"`, expected: "he-said-hello"},
+		{title: "<script>alert(1)</script>", expected: "script-alert-1-script"},
+		{title: "pipes | and, commas", expected: "pipes-and-commas"},
+		{title: "  --leading and trailing--  ", expected: "leading-and-trailing"},
+		{title: "dots...everywhere.", expected: "dots-everywhere"},
+		{title: "tabs\tand\nnewlines", expected: "tabs-and-newlines"},
+		{title: "Go — the “good” parts", expected: "go-the-good-parts"},
+		{title: "100% of ~/.config", expected: "100-of-config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			actual := pages.Slug(tt.title)
+
+			assert.Equal(t, tt.expected, actual)
+			assert.Regexp(t, "^[a-z0-9]+(-[a-z0-9]+)*$", actual)
+		})
+	}
+}
+
+func Test_TagSlug(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "go", expected: "go"},
+		{input: "Machine Learning", expected: "machine-learning"},
+		{input: "c++", expected: "cplusplus"},
+		{input: "f#", expected: "fsharp"},
+		{input: "what's/this?", expected: "whats/this"},
+		{input: "Go/Concurrency Patterns", expected: "go/concurrency-patterns"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pages.TagSlug(tt.input))
+		})
+	}
+}
+
+func Test_TruncateSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		slug     string
+		maxLen   int
+		expected string
+	}{
+		{
+			name:     "with no limit",
+			slug:     "zombies-eat-brains",
+			maxLen:   0,
+			expected: "zombies-eat-brains",
+		},
+		{
+			name:     "when shorter than the limit",
+			slug:     "zombies-eat-brains",
+			maxLen:   80,
+			expected: "zombies-eat-brains",
+		},
+		{
+			name:     "when cut in the middle of a word",
+			slug:     "zombies-eat-brains",
+			maxLen:   14,
+			expected: "zombies-eat",
+		},
+		{
+			name:     "when cut right before a hyphen",
+			slug:     "zombies-eat-brains",
+			maxLen:   11,
+			expected: "zombies-eat",
+		},
+		{
+			name:     "with a single long word",
+			slug:     "zombieseatbrains",
+			maxLen:   7,
+			expected: "zombies",
+		},
+		{
+			name:     "with a multi-byte character at the cut",
+			slug:     "zombiés",
+			maxLen:   6,
+			expected: "zombi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := pages.TruncateSlug(tt.slug, tt.maxLen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_Slug_Unmappable(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+	}{
+		{
+			name:  "with CJK",
+			title: "日本語のメモ",
+		},
+		{
+			name:  "with only emoji",
+			title: "🎉🚀",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := pages.Slug(tt.title)
+
+			assert.Regexp(t, "^[0-9a-f]{8}$", actual)
+			assert.Equal(t, actual, pages.Slug(tt.title))
+		})
+	}
+
+	assert.NotEqual(t, pages.Slug("日本語"), pages.Slug("中文"))
+}
+
+/* -------------------- Title -------------------- */
+
+func Test_TitleCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: ""},
+		{input: "zombies", expected: "Zombies"},
+		{input: "til about json and http", expected: "TIL About JSON and HTTP"},
+		{input: "the art of the shell", expected: "The Art of the Shell"},
+		{input: "a tour of go", expected: "A Tour of Go"},
+		{input: "an intro to vim", expected: "An Intro to Vim"},
+		{input: "writing in go", expected: "Writing in Go"},
+		{input: "JSON is everywhere", expected: "JSON Is Everywhere"},
+		{input: "using gRPC with go", expected: "Using gRPC with Go"},
+		{input: "macOS keyboard shortcuts", expected: "macOS Keyboard Shortcuts"},
+		{input: "the iPhone has a notch", expected: "The iPhone Has a Notch"},
+		{input: "installing on macos", expected: "Installing on macOS"},
+		{input: "github actions for grpc", expected: "GitHub Actions for gRPC"},
+		{input: "Already Title Cased", expected: "Already Title Cased"},
+		{input: "of mice and men", expected: "Of Mice and Men"},
+		{input: "go: the good parts", expected: "Go: The Good Parts"},
+		{input: "why (json) matters", expected: "Why (JSON) Matters"},
+		{input: "built-in functions", expected: "Built-in Functions"},
+		{input: "1 weird trick", expected: "1 Weird Trick"},
+		{input: "élan vital", expected: "Élan Vital"},
+		{input: "what's new in go", expected: "What's New in Go"},
+		{input: "  leading spaces", expected: "  Leading Spaces"},
+		{input: "...and then", expected: "...And Then"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			actual := pages.TitleCase(tt.input)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_TagDescriptions_For(t *testing.T) {
+	descs, err := pages.ParseTagDescriptions([]byte("Go:\n  title: Go\n  description: Notes on Go\n"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Notes on Go", descs.For("Go").Description)
+	assert.Equal(t, "Notes on Go", descs.For("go").Description)
+	assert.Equal(t, pages.TagDescription{}, descs.For("rust"))
+}
+
+/* -------------------- Tag -------------------- */
+
+func Test_Tag_NewTag(t *testing.T) {
+	actual := pages.NewTag("ada", &pages.Page{Title: "test"})
+
+	assert.IsType(t, &pages.Tag{}, actual)
+	assert.Equal(t, "ada", actual.Name)
+	assert.Equal(t, "test", actual.Pages[0].Title)
+}
+
+func Test_Tag_AddPage(t *testing.T) {
+	tag := pages.NewTag("ada", &pages.Page{Title: "test"})
+	tag.AddPage(&pages.Page{Title: "zombies"})
+
+	assert.Equal(t, 2, len(tag.Pages))
+	assert.Equal(t, "zombies", tag.Pages[1].Title)
+}
+
+func Test_Tag_Hierarchy(t *testing.T) {
+	tests := []struct {
+		name              string
+		input             string
+		expectedName      string
+		expectedAncestors []string
+		expectedPagePath  string
+		expectedPrefix    string
+		expectedLink      string
+		expectedCrumb     string
+	}{
+		{
+			name:              "with a top-level tag",
+			input:             "go",
+			expectedName:      "go",
+			expectedAncestors: []string{},
+			expectedPagePath:  "go.md",
+			expectedPrefix:    "",
+			expectedLink:      "[go](./go)",
+			expectedCrumb:     "go",
+		},
+		{
+			name:              "with a child tag",
+			input:             "go/concurrency",
+			expectedName:      "go/concurrency",
+			expectedAncestors: []string{"go"},
+			expectedPagePath:  "tags/go/concurrency.md",
+			expectedPrefix:    "../../",
+			expectedLink:      "[go/concurrency](./tags/go/concurrency)",
+			expectedCrumb:     "[go](../../go) / concurrency",
+		},
+		{
+			name:              "with a grandchild tag",
+			input:             "go/concurrency/channels",
+			expectedName:      "go/concurrency/channels",
+			expectedAncestors: []string{"go", "go/concurrency"},
+			expectedPagePath:  "tags/go/concurrency/channels.md",
+			expectedPrefix:    "../../../",
+			expectedLink:      "[go/concurrency/channels](./tags/go/concurrency/channels)",
+			expectedCrumb:     "[go](../../../go) / [concurrency](../../../tags/go/concurrency) / channels",
+		},
+		{
+			name:              "with messy slashes",
+			input:             " /go // concurrency/ ",
+			expectedName:      "go/concurrency",
+			expectedAncestors: []string{"go"},
+			expectedPagePath:  "tags/go/concurrency.md",
+			expectedPrefix:    "../../",
+			expectedLink:      "[go/concurrency](./tags/go/concurrency)",
+			expectedCrumb:     "[go](../../go) / concurrency",
+		},
+		{
+			name:              "with path traversal",
+			input:             "../../etc/passwd",
+			expectedName:      "etc/passwd",
+			expectedAncestors: []string{"etc"},
+			expectedPagePath:  "tags/etc/passwd.md",
+			expectedPrefix:    "../../",
+			expectedLink:      "[etc/passwd](./tags/etc/passwd)",
+			expectedCrumb:     "[etc](../../etc) / passwd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := pages.NewTag(tt.input, &pages.Page{})
+
+			assert.Equal(t, tt.expectedName, tag.Name)
+			assert.Equal(t, tt.expectedAncestors, tag.Ancestors())
+			assert.Equal(t, tt.expectedPagePath, tag.PagePath())
+			assert.Equal(t, tt.expectedPrefix, tag.RootPrefix())
+			assert.Equal(t, tt.expectedLink, tag.Link())
+			assert.Equal(t, tt.expectedCrumb, tag.Breadcrumb())
+		})
+	}
+}
+
+func Test_Tag_Link_Slug(t *testing.T) {
+	tests := []struct {
+		name             string
+		expectedLink     string
+		expectedPagePath string
+	}{
+		{name: "Machine Learning", expectedLink: "[Machine Learning](./machine-learning)", expectedPagePath: "machine-learning.md"},
+		{name: "c++", expectedLink: "[c++](./cplusplus)", expectedPagePath: "cplusplus.md"},
+		{name: "C#", expectedLink: "[C#](./csharp)", expectedPagePath: "csharp.md"},
+		{name: "node.js", expectedLink: "[node.js](./node-js)", expectedPagePath: "node-js.md"},
+		{name: "AI/Machine Learning", expectedLink: "[AI/Machine Learning](./tags/ai/machine-learning)", expectedPagePath: "tags/ai/machine-learning.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := pages.NewTag(tt.name, &pages.Page{})
+
+			assert.Equal(t, tt.expectedLink, tag.Link())
+			assert.Equal(t, tt.expectedPagePath, tag.PagePath())
+		})
+	}
+}
+
+func Test_Tag_Stats(t *testing.T) {
+	tests := []struct {
+		name              string
+		pages             []*pages.Page
+		expectedCount     int
+		expectedFirstUsed string
+		expectedLastUsed  string
+		expectedSummary   string
+	}{
+		{
+			name:            "with no pages",
+			pages:           []*pages.Page{},
+			expectedCount:   0,
+			expectedSummary: "0 entries",
+		},
+		{
+			name: "with one page",
+			pages: []*pages.Page{
+				{Date: "2020-05-07T13:13:08-07:00"},
+			},
+			expectedCount:     1,
+			expectedFirstUsed: "2020-05-07T13:13:08-07:00",
+			expectedLastUsed:  "2020-05-07T13:13:08-07:00",
+			expectedSummary:   "1 entry, last updated May 2020",
+		},
+		{
+			name: "with pages out of order",
+			pages: []*pages.Page{
+				{Date: "2020-05-07T13:13:08-07:00"},
+				{Date: "2024-05-14T09:00:00-07:00"},
+				{Date: "2019-01-02T09:00:00-07:00"},
+			},
+			expectedCount:     3,
+			expectedFirstUsed: "2019-01-02T09:00:00-07:00",
+			expectedLastUsed:  "2024-05-14T09:00:00-07:00",
+			expectedSummary:   "3 entries, last updated May 2024",
+		},
+		{
+			name: "with unparseable dates",
+			pages: []*pages.Page{
+				{Date: "last tuesday"},
+				{Date: "2020-05-07T13:13:08-07:00"},
+				{Date: ""},
+			},
+			expectedCount:     3,
+			expectedFirstUsed: "2020-05-07T13:13:08-07:00",
+			expectedLastUsed:  "2020-05-07T13:13:08-07:00",
+			expectedSummary:   "3 entries, last updated May 2020",
+		},
+		{
+			name: "with only unparseable dates",
+			pages: []*pages.Page{
+				{Date: "last tuesday"},
+			},
+			expectedCount:   1,
+			expectedSummary: "1 entry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := &pages.Tag{Name: "go", Pages: tt.pages}
+
+			actual := tag.Stats()
+
+			assert.Equal(t, tt.expectedCount, actual.Count)
+			assert.Equal(t, tt.expectedSummary, actual.Summary())
+
+			if tt.expectedFirstUsed == "" {
+				assert.True(t, actual.FirstUsed.IsZero())
+				assert.True(t, actual.LastUsed.IsZero())
+				return
+			}
+
+			assert.Equal(t, tt.expectedFirstUsed, actual.FirstUsed.Format(time.RFC3339))
+			assert.Equal(t, tt.expectedLastUsed, actual.LastUsed.Format(time.RFC3339))
+		})
+	}
+}
+
+func Test_Tag_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected bool
+	}{
+		{
+			name:     "when invalid",
+			title:    "",
+			expected: false,
+		},
+		{
+			name:     "when valid",
+			title:    "test",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := pages.NewTag(tt.title, &pages.Page{})
+
+			actual := tag.IsValid()
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+/* -------------------- TagMap -------------------- */
+
+func Test_NewTagMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		pages       []*pages.Page
+		expectedLen int
+	}{
+		{
+			name:        "with no pages",
+			pages:       []*pages.Page{},
+			expectedLen: 0,
+		},
+		{
+			name: "with pages",
+			pages: []*pages.Page{
+				{TagsStr: "go, ada"},
+			},
+			expectedLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := pages.NewTagMap(tt.pages).Tags
+
+			assert.Equal(t, tt.expectedLen, len(actual))
+		})
+	}
+}
+
+func Test_TagMap_Add(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         *pages.Tag
+		expectedLen int
+	}{
+		{
+			name:        "with an invalid tag",
+			tag:         &pages.Tag{},
+			expectedLen: 0,
+		},
+		{
+			name:        "with a new tag",
+			tag:         &pages.Tag{Name: "go"},
+			expectedLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tMap := pages.NewTagMap([]*pages.Page{})
+			tMap.Add(tt.tag)
+
+			actual := tMap.Tags
+
+			assert.Equal(t, tt.expectedLen, len(actual))
+		})
+	}
+}
+
+func Test_TagMap_BuildFromPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		pages       []*pages.Page
+		expectedLen int
+	}{
+		{
+			name:        "with no pages",
+			pages:       []*pages.Page{},
+			expectedLen: 0,
+		},
+		{
+			name: "with pages",
+			pages: []*pages.Page{
+				{TagsStr: "go"},
+				{TagsStr: "ada"},
+			},
+			expectedLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tMap := pages.NewTagMap([]*pages.Page{})
+			tMap.BuildFromPages(tt.pages)
+
+			actual := tMap.Tags
+
+			assert.Equal(t, tt.expectedLen, len(actual))
+		})
+	}
+}
+
+func Test_TagMap_Get(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedLen int
+	}{
+		{
+			name:        "with missing tag",
+			input:       "ada",
+			expectedLen: 0,
+		},
+		{
+			name:        "with valid tag",
+			input:       "go",
+			expectedLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		pageSet := []*pages.Page{{TagsStr: "go"}}
+		tMap := pages.NewTagMap(pageSet)
+
+		actual := tMap.Get(tt.input)
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedLen, len(actual))
+		})
+	}
+}
+
+func Test_TagMap_Len(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        *pages.Page
+		expectedLen int
+	}{
+		{
+			name:        "with missing tag",
+			page:        &pages.Page{},
+			expectedLen: 0,
+		},
+		{
+			name:        "with valid tag",
+			page:        &pages.Page{TagsStr: "go"},
+			expectedLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		pageSet := []*pages.Page{tt.page}
+		tMap := pages.NewTagMap(pageSet)
+
+		actual := tMap.Len()
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedLen, actual)
+		})
+	}
+}
+
+func Test_TagMap_CoOccurrences(t *testing.T) {
+	pageSet := []*pages.Page{
+		{TagsStr: "go, testing, cli"},
+		{TagsStr: "go, testing"},
+		{TagsStr: "Go, cli"},
+		{TagsStr: "go/concurrency"},
+		{TagsStr: "rust"},
+	}
+
+	actual := pages.NewTagMap(pageSet).CoOccurrences()
+
+	// Ties break alphabetically, and go/concurrency doesn't list go, its parent
+	assert.Equal(t, []pages.TagCount{{Name: "cli", Count: 2}, {Name: "testing", Count: 2}, {Name: "go/concurrency", Count: 1}}, actual["go"])
+	assert.Equal(t, []pages.TagCount{{Name: "go", Count: 2}, {Name: "testing", Count: 1}}, actual["cli"])
+	assert.Empty(t, actual["go/concurrency"])
+	assert.Empty(t, actual["rust"])
+}
+
+func Test_TagMap_Rebuild(t *testing.T) {
+	tMap := pages.NewTagMapWithOptions(
+		[]*pages.Page{{TagsStr: "Go, ada"}},
+		pages.TagMapOptions{Aliases: map[string]string{"golang": "go"}},
+	)
+
+	tMap.Rebuild([]*pages.Page{{TagsStr: "golang"}, {TagsStr: "rust"}})
+
+	assert.Equal(t, []string{"go", "rust"}, tMap.SortedTagNames())
+	assert.Equal(t, 1, len(tMap.PagesFor("go")))
+}
+
+func Test_TagMap_Remove(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedNames []string
+	}{
+		{
+			name:          "with missing tag",
+			input:         "zig",
+			expectedNames: []string{"ada", "go", "go/concurrency", "js"},
+		},
+		{
+			name:          "with valid tag",
+			input:         "ada",
+			expectedNames: []string{"go", "go/concurrency", "js"},
+		},
+		{
+			name:          "with a different case",
+			input:         "ADA",
+			expectedNames: []string{"go", "go/concurrency", "js"},
+		},
+		{
+			name:          "with an alias",
+			input:         "javascript",
+			expectedNames: []string{"ada", "go", "go/concurrency"},
+		},
+		{
+			name:          "with a parent tag",
+			input:         "go",
+			expectedNames: []string{"ada", "js"},
+		},
+		{
+			name:          "with a child tag",
+			input:         "go/concurrency",
+			expectedNames: []string{"ada", "go", "js"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageSet := []*pages.Page{{TagsStr: "go/concurrency, ada"}, {TagsStr: "js"}}
+			tMap := pages.NewTagMapWithOptions(pageSet, pages.TagMapOptions{Aliases: map[string]string{"javascript": "js"}})
+
+			tMap.Remove(tt.input)
+
+			assert.Equal(t, tt.expectedNames, tMap.SortedTagNames())
+			assert.Empty(t, tMap.Get(tt.input))
+		})
+	}
+}
+
+func Test_TagMap_Remove_Canonical(t *testing.T) {
+	tMap := pages.NewTagMap([]*pages.Page{{TagsStr: "Go"}})
+
+	tMap.Remove("go")
+	tMap.Add(pages.NewTag("go", &pages.Page{}))
+
+	// Once removed, a tag takes its name from the next form that is added
+	assert.Equal(t, []string{"go"}, tMap.SortedTagNames())
+}
+
+func Test_TagMap_RemovePage(t *testing.T) {
+	first := &pages.Page{TagsStr: "go/concurrency, ada"}
+	second := &pages.Page{TagsStr: "go"}
+
+	tMap := pages.NewTagMap([]*pages.Page{first, second})
+
+	tMap.RemovePage(first)
+
+	assert.Equal(t, []string{"go"}, tMap.SortedTagNames())
+	assert.Equal(t, []*pages.Page{second}, tMap.PagesFor("go"))
+
+	tMap.RemovePage(second)
+
+	assert.Equal(t, 0, tMap.Len())
+
+	// A page that isn't in the map changes nothing
+	tMap.Add(&pages.Tag{Name: "rust"})
+	tMap.RemovePage(first)
+
+	assert.Equal(t, 1, tMap.Len())
+}
+
+func Test_TagMap_SortedTagNames(t *testing.T) {
+	pageSet := []*pages.Page{{TagsStr: "go, ada, lua"}}
+	tMap := pages.NewTagMap(pageSet)
+
+	expected := []string{"ada", "go", "lua"}
+	actual := tMap.SortedTagNames()
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_TagMap_CaseInsensitive(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         pages.TagMapOptions
+		expectedName string
+	}{
+		{
+			name:         "with first-seen names",
+			opts:         pages.TagMapOptions{},
+			expectedName: "Go",
+		},
+		{
+			name:         "with lower-case names",
+			opts:         pages.TagMapOptions{LowercaseNames: true},
+			expectedName: "go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageSet := []*pages.Page{
+				{Title: "one", TagsStr: "Go, Ada"},
+				{Title: "two", TagsStr: "go"},
+				{Title: "three", TagsStr: "GO, lua"},
+			}
+			tMap := pages.NewTagMapWithOptions(pageSet, tt.opts)
+
+			assert.Equal(t, 3, tMap.Len())
+			assert.Contains(t, tMap.SortedTagNames(), tt.expectedName)
+			assert.Equal(t, tt.expectedName, tMap.CanonicalName("gO"))
+
+			for _, name := range []string{"Go", "go", "GO"} {
+				assert.Equal(t, 3, len(tMap.PagesFor(name)))
+
+				tags := tMap.Get(name)
+				assert.Equal(t, 3, len(tags))
+				assert.Equal(t, tt.expectedName, tags[0].Name)
+			}
+		})
+	}
+}
+
+func Test_TagMap_Aliases(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "one", TagsStr: "js"},
+		{Title: "two", TagsStr: "JavaScript, css"},
+		{Title: "three", TagsStr: "JS"},
+	}
+	opts := pages.TagMapOptions{Aliases: map[string]string{"js": "javascript"}}
+
+	tMap := pages.NewTagMapWithOptions(pageSet, opts)
+
+	assert.Equal(t, []string{"css", "javascript"}, tMap.SortedTagNames())
+	assert.Equal(t, 3, len(tMap.PagesFor("javascript")))
+	assert.Equal(t, 3, len(tMap.PagesFor("js")))
+	assert.Equal(t, "javascript", tMap.CanonicalName("JS"))
+
+	// The page itself still has the tag as it was written
+	assert.Equal(t, pages.TagsString("js"), pageSet[0].TagsStr)
+}
+
+func Test_TagMap_Hierarchy(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "one", Date: "2020-05-09T13:13:08-07:00", TagsStr: "go/concurrency"},
+		{Title: "two", Date: "2020-05-08T13:13:08-07:00", TagsStr: "go/testing, go"},
+		{Title: "three", Date: "2020-05-07T13:13:08-07:00", TagsStr: "go/concurrency/channels"},
+		{Title: "four", Date: "2020-05-06T13:13:08-07:00", TagsStr: "lua"},
+	}
+
+	tMap := pages.NewTagMap(pageSet)
+
+	expected := []string{"go", "go/concurrency", "go/concurrency/channels", "go/testing", "lua"}
+	assert.Equal(t, expected, tMap.SortedTagNames())
+
+	parent := tMap.PagesFor("go")
+	assert.Equal(t, 3, len(parent))
+	assert.Equal(t, "one", parent[0].Title)
+	assert.Equal(t, "two", parent[1].Title)
+	assert.Equal(t, "three", parent[2].Title)
+
+	assert.Equal(t, 2, len(tMap.PagesFor("go/concurrency")))
+	assert.Equal(t, 1, len(tMap.PagesFor("go/testing")))
+
+	// The raw tags are left alone
+	assert.Equal(t, "go/concurrency/channels", pageSet[2].Tags()[0].Name)
+}
+
+func Test_TagMap_PagePathCollisions(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "one", TagsStr: "Machine Learning, go"},
+		{Title: "two", TagsStr: "machine-learning"},
+	}
+
+	tMap := pages.NewTagMap(pageSet)
+
+	expected := map[string][]string{
+		"machine-learning.md": {"Machine Learning", "machine-learning"},
+	}
+
+	assert.Equal(t, expected, tMap.PagePathCollisions())
+}
+
+func Test_TagMap_Stats(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", TagsStr: "go/concurrency"},
+		{Date: "2020-05-07T13:13:08-07:00", TagsStr: "Go, go/concurrency"},
+	}
+
+	tMap := pages.NewTagMap(pageSet)
+
+	actual := tMap.Stats("go")
+
+	assert.Equal(t, 2, actual.Count)
+	assert.Equal(t, 7, actual.FirstUsed.Day())
+	assert.Equal(t, 9, actual.LastUsed.Day())
+}
+
+func Test_TagMap_SortedTagNames_MixedCase(t *testing.T) {
+	pageSet := []*pages.Page{{TagsStr: "Lua, go, Ada"}}
+	tMap := pages.NewTagMap(pageSet)
+
+	expected := []string{"Ada", "go", "Lua"}
+	actual := tMap.SortedTagNames()
+
+	assert.Equal(t, expected, actual)
+}
+
+/* -------------------- Migration -------------------- */
+
+func Test_MemFS(t *testing.T) {
+	memFS := pages.NewMemFS()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "a.md"), []byte("a"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join("docs", "tags", "go.md"), []byte("go"), 0644))
+
+	globbed, err := memFS.Glob(filepath.Join("docs", "*.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("docs", "a.md")}, globbed)
+
+	info, err := memFS.Stat(filepath.Join("docs", "tags"))
+	assert.NoError(t, err)
+	assert.True(t, info.IsDir())
+
+	info, err = memFS.Stat(filepath.Join("docs", "a.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), info.Size())
+
+	assert.NoError(t, memFS.Rename(filepath.Join("docs", "a.md"), filepath.Join("docs", "b.md")))
+
+	_, err = memFS.ReadFile(filepath.Join("docs", "a.md"))
+	assert.True(t, os.IsNotExist(err))
+
+	data, err := memFS.ReadFile(filepath.Join("docs", "b.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", string(data))
+
+	assert.NoError(t, memFS.Remove(filepath.Join("docs", "b.md")))
+	assert.True(t, os.IsNotExist(memFS.Remove(filepath.Join("docs", "b.md"))))
+}
+
+func Test_OSFS_WriteFile(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	osFS := pages.OSFS{}
+	filePath := filepath.Join(docsDir, "index.md")
+
+	// An existing file keeps its permissions
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("old"), 0600))
+	assert.NoError(t, osFS.WriteFile(filePath, []byte("new"), 0644))
+
+	data, _ := ioutil.ReadFile(filePath)
+	assert.Equal(t, "new", string(data))
+
+	if runtime.GOOS != "windows" {
+		info, _ := os.Stat(filePath)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// When the rename fails, the temp file is cleaned up
+	dirPath := filepath.Join(docsDir, "go.md")
+	assert.NoError(t, os.MkdirAll(filepath.Join(dirPath, "inside"), 0755))
+
+	assert.Error(t, osFS.WriteFile(dirPath, []byte("go"), 0644))
+
+	entries, _ := ioutil.ReadDir(docsDir)
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	assert.Equal(t, []string{"go.md", "index.md"}, names)
+}
+
+func Test_OSFS_WriteFile_NeverHalfWritten(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	osFS := pages.OSFS{}
+	filePath := filepath.Join(docsDir, "index.md")
+
+	contents := []string{strings.Repeat("a", 1<<20), strings.Repeat("b", 1<<20)}
+	assert.NoError(t, osFS.WriteFile(filePath, []byte(contents[0]), 0644))
+
+	done := make(chan bool)
+	observed := make(chan string, 1)
+
+	// Keep reading the file while it's being rewritten, noting anything that
+	// isn't one of the complete contents
+	go func() {
+		defer close(observed)
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			data, err := ioutil.ReadFile(filePath)
+			if err != nil || (string(data) != contents[0] && string(data) != contents[1]) {
+				observed <- fmt.Sprintf("read %d bytes (%v)", len(data), err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 50; i++ {
+		assert.NoError(t, osFS.WriteFile(filePath, []byte(contents[i%2]), 0644))
+	}
+
+	close(done)
+
+	for problem := range observed {
+		t.Errorf("observed a half-written file: %s", problem)
+	}
+}
+
+func Test_MigrateFrontMatter(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		expected        string
+		expectedChanges []string
+	}{
+		{
+			name:            "with no front-matter",
+			input:           "## go\n\n* a link\n",
+			expected:        "## go\n\n* a link\n",
+			expectedChanges: []string{},
+		},
+		{
+			name:            "with canonical front-matter",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go, cli]\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go, cli]\n---\n\n# Zombies\n",
+			expectedChanges: []string{},
+		},
+		{
+			name:            "with string tags",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go, cli\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go, cli]\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags converted to a list"},
+		},
+		{
+			name:            "with empty optional fields",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: \" , \"\nsource:\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\n# Zombies\n",
+			expectedChanges: []string{"removed empty field 'tags'", "removed empty field 'source'"},
+		},
+		{
+			name:            "with keys out of order",
+			input:           "---\nsource: http://example.com\ntags: [go]\ntitle: Zombies\ndate: 2020-05-07T13:13:08-07:00\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go]\nsource: http://example.com\n---\n\n# Zombies\n",
+			expectedChanges: []string{"keys reordered"},
+		},
+		{
+			name:            "with a date in a non-RFC3339 format",
+			input:           "---\ndate: \"Thu, 07 May 2020 13:13:08 -0700\"\ntitle: Zombies\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\n# Zombies\n",
+			expectedChanges: []string{"date normalized from 'Thu, 07 May 2020 13:13:08 -0700' to '2020-05-07T13:13:08-07:00'"},
+		},
+		{
+			name:            "with a body that looks like front-matter",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n\n# Zombies\n\n---\ntags: go\n---\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go]\n---\n\n# Zombies\n\n---\ntags: go\n---\n",
+			expectedChanges: []string{"tags converted to a list"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, changes, err := pages.MigrateFrontMatter([]byte(tt.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+			assert.Equal(t, tt.expectedChanges, changes)
+
+			// A second run must be a no-op
+			again, changes, err := pages.MigrateFrontMatter(actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, string(actual), string(again))
+			assert.Empty(t, changes)
+		})
+	}
+}
+
+func Test_MigrateFrontMatter_MissingSeparator(t *testing.T) {
+	input := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n"
+
+	actual, _, err := pages.MigrateFrontMatter([]byte(input))
+
+	assert.Error(t, err)
+	assert.Equal(t, input, string(actual))
+}
+
+func Test_MigrateFrontMatter_CRLF(t *testing.T) {
+	input := "\ufeff---\r\ntitle: Zombies\r\ndate: 2020-05-07T13:13:08-07:00\r\ntags: go, cli\r\n---\r\n\r\n# Zombies\r\n"
+
+	actual, changes, err := pages.MigrateFrontMatter([]byte(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "\ufeff---\r\ndate: 2020-05-07T13:13:08-07:00\r\ntitle: Zombies\r\ntags: [go, cli]\r\n---\r\n\r\n# Zombies\r\n", string(actual))
+	assert.Equal(t, []string{"keys reordered", "tags converted to a list"}, changes)
+
+	again, changes, err := pages.MigrateFrontMatter(actual)
+
+	assert.NoError(t, err)
+	assert.Equal(t, string(actual), string(again))
+	assert.Empty(t, changes)
+}
+
+func Test_ConvertTagsToList(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		expected        string
+		expectedChanges []string
+	}{
+		{
+			name:            "with no front-matter",
+			input:           "## go\n\n* a link\n",
+			expected:        "## go\n\n* a link\n",
+			expectedChanges: []string{},
+		},
+		{
+			name:            "with string tags",
+			input:           "---\ntitle: Zombies\nauthor: ann\ntags: go, cli\ndate: 2020-05-07\n---\n\n# Zombies\n",
+			expected:        "---\ntitle: Zombies\nauthor: ann\ntags: [go, cli]\ndate: 2020-05-07\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags 'go, cli' converted to [go, cli]"},
+		},
+		{
+			name:            "with a single tag",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go]\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags 'go' converted to [go]"},
+		},
+		{
+			name:            "with spaces in the tags",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: machine learning,  go , the undead\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [machine learning, go, the undead]\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags 'machine learning,  go , the undead' converted to [machine learning, go, the undead]"},
+		},
+		{
+			name:            "with empty tags",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags:\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: []\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags '' converted to []"},
+		},
+		{
+			name:            "with tags of only commas",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: \" , \"\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: []\n---\n\n# Zombies\n",
+			expectedChanges: []string{"tags ' , ' converted to []"},
+		},
+		{
+			name:            "with list tags",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags:\n  - go\n  - cli\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags:\n  - go\n  - cli\n---\n\n# Zombies\n",
+			expectedChanges: []string{},
+		},
+		{
+			name:            "with no tags",
+			input:           "---\ndate: 2020-05-07\ntitle: Zombies\n---\n\n# Zombies\n",
+			expected:        "---\ndate: 2020-05-07\ntitle: Zombies\n---\n\n# Zombies\n",
+			expectedChanges: []string{},
+		},
+		{
+			name:            "with a body that looks like front-matter",
+			input:           "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n\n# Zombies\n\n---\ntags: go\n---\n",
+			expected:        "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go]\n---\n\n# Zombies\n\n---\ntags: go\n---\n",
+			expectedChanges: []string{"tags 'go' converted to [go]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, changes, err := pages.ConvertTagsToList([]byte(tt.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+			assert.Equal(t, tt.expectedChanges, changes)
+
+			// A second run must be a no-op
+			again, changes, err := pages.ConvertTagsToList(actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, string(actual), string(again))
+			assert.Empty(t, changes)
+		})
+	}
+}
+
+func Test_SetFrontMatterTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		tags     []string
+		expected string
+	}{
+		{
+			name:     "with no tags field",
+			input:    "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: 'Go: contexts'\n---\n\n# Go\n",
+			tags:     []string{"go"},
+			expected: "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: 'Go: contexts'\ntags: [go]\n---\n\n# Go\n",
+		},
+		{
+			name:     "with string tags",
+			input:    "---\ndate: 2020-05-07T13:13:08-07:00\ntags: go\ntitle: Zombies\n---\n\n# Zombies\n",
+			tags:     []string{"go", "cli"},
+			expected: "---\ndate: 2020-05-07T13:13:08-07:00\ntags: [go, cli]\ntitle: Zombies\n---\n\n# Zombies\n",
+		},
+		{
+			name:     "with block list tags",
+			input:    "---\ndate: 2020-05-07T13:13:08-07:00\ntags:\n- go\n  - cli\ntitle: Zombies\n---\n\n---\ntags: go\n---\n",
+			tags:     []string{"rust"},
+			expected: "---\ndate: 2020-05-07T13:13:08-07:00\ntags: [rust]\ntitle: Zombies\n---\n\n---\ntags: go\n---\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := pages.SetFrontMatterTags([]byte(tt.input), tt.tags)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+		})
+	}
+
+	_, err := pages.SetFrontMatterTags([]byte("## go\n"), []string{"go"})
+	assert.Error(t, err)
+}
+
+func Test_SetMissingFrontMatterField(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		key      string
+		expected string
+		changed  bool
+	}{
+		{
+			name:     "with the field already set",
+			input:    "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\n# Zombies\n",
+			key:      "date",
+			expected: "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\n# Zombies\n",
+		},
+		{
+			name:     "with a missing known field",
+			input:    "---\ntitle: 'Go: contexts'\ntags: [go]\n---\n\n# Go\n",
+			key:      "date",
+			expected: "---\ndate: 2021-01-02T03:04:05Z\ntitle: 'Go: contexts'\ntags: [go]\n---\n\n# Go\n",
+			changed:  true,
+		},
+		{
+			name:     "with an empty field out of place",
+			input:    "---\ntitle: Zombies\ndate:\n---\n\n# Zombies\n",
+			key:      "date",
+			expected: "---\ntitle: Zombies\ndate: 2021-01-02T03:04:05Z\n---\n\n# Zombies\n",
+			changed:  true,
+		},
+		{
+			name:     "with a missing unknown field",
+			input:    "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\n# Zombies\n",
+			key:      "modified",
+			expected: "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\nmodified: 2021-01-02T03:04:05Z\n---\n\n# Zombies\n",
+			changed:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, changed, err := pages.SetMissingFrontMatterField([]byte(tt.input), tt.key, "2021-01-02T03:04:05Z")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+			assert.Equal(t, tt.changed, changed)
+		})
+	}
+}
+
+func Test_SuggestTags(t *testing.T) {
+	page := &pages.Page{
+		FilePath: "docs/new.md",
+		Title:    "Goroutines",
+		Content:  "Go makes goroutines cheap. In Go, a goroutine is started with go. Unlike Rust, golang has a GC.",
+	}
+
+	pageSet := []*pages.Page{
+		page,
+		{FilePath: "docs/a.md", TagsStr: "go, concurrency"},
+		{FilePath: "docs/b.md", TagsStr: "go, concurrency"},
+		{FilePath: "docs/c.md", TagsStr: "go, testing"},
+		{FilePath: "docs/d.md", TagsStr: "rust"},
+		{FilePath: "docs/e.md", TagsStr: "css"},
+	}
+
+	tagMap := pages.NewTagMapWithOptions(pageSet, pages.TagMapOptions{Aliases: map[string]string{"golang": "go"}})
+
+	// go: mentioned 3 times by name and once by alias (4 * 2)
+	// concurrency: on two other go pages, testing: on one
+	// rust: mentioned once (1 * 2)
+	expected := []pages.TagSuggestion{
+		{Name: "go", Score: 8},
+		{Name: "concurrency", Score: 2},
+		{Name: "rust", Score: 2},
+		{Name: "testing", Score: 1},
+	}
+
+	assert.Equal(t, expected, pages.SuggestTags(page, tagMap))
+
+	// Tags the page already has are never suggested
+	page.TagsStr = "go"
+	tagMap = pages.NewTagMapWithOptions(pageSet, pages.TagMapOptions{Aliases: map[string]string{"golang": "go"}})
+
+	expected = []pages.TagSuggestion{
+		{Name: "concurrency", Score: 2},
+		{Name: "rust", Score: 2},
+		{Name: "testing", Score: 1},
+	}
+
+	assert.Equal(t, expected, pages.SuggestTags(page, tagMap))
+}
+
+func Test_applyTags(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "2020-05-07-zombies.md")
+	body := "\n# Zombies\n\nBraaains\n"
+	content := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n" + body
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+
+	page := readTestPage(t, filePath)
+	assert.NoError(t, applyTags(page, []string{"horror", "cli"}))
+
+	actual, err := ioutil.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: [go, horror, cli]\n---\n"+body, string(actual))
+}
+
+func Test_markArchived(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "2020-05-07-vagrant.md")
+	original := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Vagrant Boxes\ntags: vagrant\n---\n\n# Vagrant Boxes\n"
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte(original), 0644))
+
+	page := readTestPage(t, filePath)
+	assert.False(t, page.Archived)
+
+	assert.NoError(t, markArchived(page, true))
+	assert.True(t, page.Archived)
+
+	data, _ := ioutil.ReadFile(filePath)
+	assert.Equal(t, "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Vagrant Boxes\ntags: vagrant\narchived: true\n---\n\n# Vagrant Boxes\n", string(data))
+
+	archived := readTestPage(t, filePath)
+	assert.True(t, archived.Archived)
+
+	// til list leaves it out, unless it's asked for the archived pages
+	pageSet := []*pages.Page{{Title: "Channels", Date: "2020-05-08T13:13:08-07:00", FilePath: "channels.md"}, archived}
+	assert.Equal(t, []*pages.Page{pageSet[0]}, archivedOrNot(pageSet, false))
+	assert.Equal(t, []*pages.Page{archived}, archivedOrNot(pageSet, true))
+	assert.True(t, listPagesJSON(pageSet[1:], "").Pages[0].Archived)
+
+	// Unarchiving takes the field out again
+	assert.NoError(t, markArchived(archived, false))
+
+	data, _ = ioutil.ReadFile(filePath)
+	assert.Equal(t, original, string(data))
+}
+
+func Test_markArchived_CRLF(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "2020-05-07-vagrant.md")
+	original := "\ufeff---\r\ndate: 2020-05-07T13:13:08-07:00\r\ntitle: Vagrant Boxes\r\n---\r\n\r\n# Vagrant Boxes\r\n"
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte(original), 0644))
+
+	page := readTestPage(t, filePath)
+
+	assert.NoError(t, markArchived(page, true))
+
+	data, _ := ioutil.ReadFile(filePath)
+	assert.Equal(t, "\ufeff---\r\ndate: 2020-05-07T13:13:08-07:00\r\ntitle: Vagrant Boxes\r\narchived: true\r\n---\r\n\r\n# Vagrant Boxes\r\n", string(data))
+	assert.True(t, readTestPage(t, filePath).Archived)
+
+	assert.NoError(t, markArchived(page, false))
+
+	data, _ = ioutil.ReadFile(filePath)
+	assert.Equal(t, original, string(data))
+}
+
+func Test_RemoveFrontMatterField(t *testing.T) {
+	data := []byte("---\ndate: 2020-05-07T13:13:08-07:00\narchived:\n  - true\ntitle: Zombies\n---\n\nBraaains\n")
+
+	removed, err := pages.RemoveFrontMatterField(data, "archived")
+	assert.NoError(t, err)
+	assert.Equal(t, "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n---\n\nBraaains\n", string(removed))
+
+	unchanged, err := pages.RemoveFrontMatterField(removed, "archived")
+	assert.NoError(t, err)
+	assert.Equal(t, string(removed), string(unchanged))
+
+	_, err = pages.RemoveFrontMatterField([]byte("Braaains\n"), "archived")
+	assert.Error(t, err)
+}
+
+func Test_rewritePage_History(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	now := time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC)
+	pages.Now = func() time.Time { return now }
+	defer func() { pages.Now = time.Now }()
+
+	filePath := filepath.Join(docsDir, "2020-05-07-zombies.md")
+	original := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n\n# Zombies\n\nBraaains\n"
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte(original), 0644))
+
+	// Tagging the page keeps what it was
+	assert.NoError(t, applyTags(readTestPage(t, filePath), []string{"horror"}))
+
+	tagged, _ := ioutil.ReadFile(filePath)
+	assert.Contains(t, string(tagged), "tags: [go, horror]")
+
+	versions, err := pageVersions(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []pageVersion{{version: now.Unix(), filePath: filepath.Join(docsDir, ".til", "history", "2020-05-07-zombies.md."+strconv.FormatInt(now.Unix(), 10))}}, versions)
+
+	kept, _ := ioutil.ReadFile(versions[0].filePath)
+	assert.Equal(t, original, string(kept))
+
+	// Nothing's kept for a rewrite that changes nothing
+	assert.NoError(t, rewritePage(filePath, tagged))
+
+	versions, _ = pageVersions(filePath)
+	assert.Len(t, versions, 1)
+
+	// Restoring brings the original back, and keeps the tagged version, in
+	// the next free second
+	assert.NoError(t, restoreVersion(filePath, now.Unix()))
+
+	restored, _ := ioutil.ReadFile(filePath)
+	assert.Equal(t, original, string(restored))
+
+	versions, _ = pageVersions(filePath)
+	assert.Equal(t, []int64{now.Unix() + 1, now.Unix()}, []int64{versions[0].version, versions[1].version})
+
+	kept, _ = ioutil.ReadFile(versions[0].filePath)
+	assert.Equal(t, string(tagged), string(kept))
+
+	// Which can be restored in turn
+	assert.NoError(t, restoreVersion(filePath, now.Unix()+1))
+
+	restored, _ = ioutil.ReadFile(filePath)
+	assert.Equal(t, string(tagged), string(restored))
+
+	err = restoreVersion(filePath, 12345)
+	assert.EqualError(t, err, fmt.Sprintf("there's no version 12345 of %s. til history lists the ones there are", filePath))
+
+	// The history is never loaded as pages, whatever the layout
+	src.GlobalConfig.Set("layout", "yearly")
+
+	pageSet, err := loadPages(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, pageSet, 1)
+	assert.Equal(t, filePath, pageSet[0].FilePath)
+}
+
+func Test_keepVersion(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	src.GlobalConfig.Set("historyVersions", 2)
+
+	filePath := filepath.Join(docsDir, "zombies.md")
+	start := time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, keepVersion(filePath, []byte(fmt.Sprintf("version %d", i)), start.Add(time.Duration(i)*time.Hour)))
+	}
+
+	// Only the newest two are left
+	versions, err := pageVersions(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{start.Add(3 * time.Hour).Unix(), start.Add(2 * time.Hour).Unix()}, []int64{versions[0].version, versions[1].version})
+
+	kept, _ := ioutil.ReadFile(versions[1].filePath)
+	assert.Equal(t, "version 2", string(kept))
+
+	// Another page's versions are its own
+	other, _ := pageVersions(filepath.Join(docsDir, "zombies.md.bak"))
+	assert.Empty(t, other)
+
+	// So are those of a page with the same name in a year directory
+	yearPath := filepath.Join(docsDir, "2023", "zombies.md")
+	assert.NoError(t, keepVersion(yearPath, []byte("2023's version"), start))
+
+	other, _ = pageVersions(yearPath)
+	assert.Equal(t, []pageVersion{{version: start.Unix(), filePath: filepath.Join(docsDir, ".til", "history", "2023", "zombies.md."+strconv.FormatInt(start.Unix(), 10))}}, other)
+
+	versions, _ = pageVersions(filePath)
+	assert.Len(t, versions, 2)
+
+	// With historyVersions at 0, nothing is kept
+	src.GlobalConfig.Set("historyVersions", 0)
+
+	assert.NoError(t, keepVersion(filePath, []byte("version 4"), start.Add(4*time.Hour)))
+
+	versions, _ = pageVersions(filePath)
+	assert.Len(t, versions, 2)
+}
+
+func Test_tagGraphDOT(t *testing.T) {
+	pageSet := []*pages.Page{
+		{TagsStr: "go, machine-learning"},
+		{TagsStr: "go, machine-learning"},
+		{TagsStr: "go, say \"hi\""},
+	}
+
+	actual := tagGraphDOT(pages.NewTagMap(pageSet), 1)
+
+	expected := `graph tags {
+  "go" [label="go (3)", fontsize=16];
+  "machine-learning" [label="machine-learning (2)", fontsize=14];
+  "say \"hi\"" [label="say \"hi\" (1)", fontsize=12];
+  "go" -- "machine-learning" [weight=2, penwidth=2, label="2"];
+  "go" -- "say \"hi\"" [weight=1, penwidth=1, label="1"];
+}
+`
+	assert.Equal(t, expected, actual)
+
+	// Tags that share fewer pages than the minimum are not joined
+	actual = tagGraphDOT(pages.NewTagMap(pageSet), 2)
+
+	assert.Contains(t, actual, `"go" -- "machine-learning"`)
+	assert.NotContains(t, actual, `"go" -- "say`)
+}
+
+func Test_tagGraphMermaid(t *testing.T) {
+	pageSet := []*pages.Page{
+		{TagsStr: "go, machine-learning"},
+		{TagsStr: "go, say \"hi\""},
+	}
+
+	actual := tagGraphMermaid(pages.NewTagMap(pageSet), 1)
+
+	expected := `graph LR
+  t0["go (2)"]
+  style t0 font-size:14px
+  t1["machine-learning (1)"]
+  style t1 font-size:12px
+  t2["say #quot;hi#quot; (1)"]
+  style t2 font-size:12px
+  t0 ---|1| t1
+  linkStyle 0 stroke-width:1px
+  t0 ---|1| t2
+  linkStyle 1 stroke-width:1px
+`
+	assert.Equal(t, expected, actual)
+}
+
+func Test_buildGraphPage(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	assert.NoError(t, buildGraphPage(context.Background(), []*pages.Page{}, pages.NewTagMap([]*pages.Page{{TagsStr: "go, cli"}}), docsDir, newBuildWarnings()))
+
+	data, err := memFS.ReadFile(filepath.Join(docsDir, "graph.md"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## Tags\n\n```mermaid\ngraph LR\n"))
+	assert.Contains(t, string(data), "  t0 ---|1| t1\n")
+}
+
+func Test_autoCommit(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		failing  string
+		expected []string
+	}{
+		{
+			name:     "when turned off",
+			enabled:  false,
+			expected: []string{},
+		},
+		{
+			name:     "when not in a git repo",
+			enabled:  true,
+			failing:  "rev-parse",
+			expected: []string{"git rev-parse --is-inside-work-tree"},
+		},
+		{
+			name:    "when there is nothing to commit",
+			enabled: true,
+			expected: []string{
+				"git rev-parse --is-inside-work-tree",
+				"git add --all -- docs/zombies.md",
+				"git diff --cached --quiet -- docs/zombies.md",
+			},
+		},
+		{
+			name:    "when there are changes",
+			enabled: true,
+			failing: "diff",
+			expected: []string{
+				"git rev-parse --is-inside-work-tree",
+				"git add --all -- docs/zombies.md",
+				"git diff --cached --quiet -- docs/zombies.md",
+				"git -c user.name=TIL Autobot -c user.email=test@example.com commit --message til: add \"Zombies\" -- docs/zombies.md",
+			},
+		},
+	}
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, cleanup := setUpTargetDir(t)
+			defer cleanup()
+
+			src.GlobalConfig.Set("git.autoCommit", tt.enabled)
+			src.GlobalConfig.Set("committerName", "TIL Autobot")
+			src.GlobalConfig.Set("committerEmail", "test@example.com")
+
+			tDir, _ := src.GetTargetDir(src.GlobalConfig, "", false)
+			commands := []string{}
+
+			runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+				assert.Equal(t, tDir, dir)
+				commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+
+				if tt.failing != "" && args[0] == tt.failing {
+					return []byte{}, errors.New("exit status 1")
+				}
+
+				return []byte{}, nil
+			}
+
+			autoCommit(src.CommitInfo{Action: src.ActionNew, Title: "Zombies"}, "docs/zombies.md")
+
+			assert.Equal(t, tt.expected, commands)
+		})
+	}
+}
+
+func Test_autoCommit_Push(t *testing.T) {
+	tests := []struct {
+		name       string
+		autoPush   bool
+		pushFlag   bool
+		noPushFlag bool
+		remote     string
+		pushFails  bool
+		expected   []string
+	}{
+		{
+			name:     "when turned off",
+			expected: []string{},
+		},
+		{
+			name:     "with git.autoPush",
+			autoPush: true,
+			remote:   "upstream",
+			expected: []string{"git rev-parse --abbrev-ref HEAD", "git config branch.main.remote", "git push upstream main"},
+		},
+		{
+			name:     "with -push and no tracking remote",
+			pushFlag: true,
+			expected: []string{"git rev-parse --abbrev-ref HEAD", "git config branch.main.remote", "git push origin main"},
+		},
+		{
+			name:       "with -no-push",
+			autoPush:   true,
+			pushFlag:   true,
+			noPushFlag: true,
+			expected:   []string{},
+		},
+		{
+			name:      "when the push fails",
+			autoPush:  true,
+			pushFails: true,
+			expected:  []string{"git rev-parse --abbrev-ref HEAD", "git config branch.main.remote", "git push origin main"},
+		},
+	}
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+	defer func() { pushFlag, noPushFlag = false, false }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, cleanup := setUpTargetDir(t)
+			defer cleanup()
+
+			src.GlobalConfig.Set("git.autoCommit", true)
+			src.GlobalConfig.Set("git.autoPush", tt.autoPush)
+			pushFlag, noPushFlag = tt.pushFlag, tt.noPushFlag
+
+			commands := []string{}
+
+			runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+				switch args[0] {
+				case "diff":
+					// There is something to commit
+					return []byte{}, errors.New("exit status 1")
+				case "add", "commit":
+					return []byte{}, nil
+				}
+
+				commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+
+				switch {
+				case args[0] == "rev-parse" && len(args) > 1 && args[1] == "--abbrev-ref":
+					return []byte("main\n"), nil
+				case args[0] == "config" && tt.remote == "":
+					return []byte{}, errors.New("exit status 1")
+				case args[0] == "config":
+					return []byte(tt.remote + "\n"), nil
+				case args[0] == "push" && tt.pushFails:
+					return []byte("! [rejected] main -> main (non-fast-forward)"), errors.New("exit status 1")
+				}
+
+				return []byte{}, nil
+			}
+
+			// Failing to push must not stop til, so this returns either way
+			autoCommit(src.CommitInfo{Action: src.ActionBuild}, "docs")
+
+			assert.Equal(t, append([]string{"git rev-parse --is-inside-work-tree"}, tt.expected...), commands)
+		})
+	}
+}
+
+func Test_CommitMessage(t *testing.T) {
+	info := src.CommitInfo{Action: src.ActionNew, FilePath: "docs/zombies.md", Tags: []string{"go", "cli"}, Title: "Zombies"}
+
+	tests := []struct {
+		name     string
+		template string
+		info     src.CommitInfo
+		expected string
+	}{
+		{
+			name:     "with the default for a new page",
+			info:     info,
+			expected: `til: add "Zombies"`,
+		},
+		{
+			name:     "with the default for a build",
+			info:     src.CommitInfo{Action: src.ActionBuild},
+			expected: "til: rebuild index",
+		},
+		{
+			name:     "with a template",
+			template: "docs(til): {{.Title}} [{{join .Tags \", \"}}] {{.FilePath}}",
+			info:     info,
+			expected: "docs(til): Zombies [go, cli] docs/zombies.md",
+		},
+		{
+			name:     "with a template that switches on the action",
+			template: "{{if eq .Action \"build\"}}chore: rebuild{{else}}feat: {{.Title}}{{end}}",
+			info:     src.CommitInfo{Action: src.ActionBuild},
+			expected: "chore: rebuild",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml("git:\n  autoCommit: true\n")
+			if tt.template != "" {
+				cfg.Set("git.commitTemplate", tt.template)
+			}
+
+			assert.NoError(t, src.ValidateCommitTemplate(cfg))
+
+			actual, err := src.CommitMessage(cfg, tt.info)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_ValidateCommitTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{name: "with a syntax error", template: "til: {{.Title"},
+		{name: "with an unknown field", template: "til: {{.Ticket}}"},
+		{name: "with an unknown function", template: "til: {{upper .Title}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml("git:\n  autoCommit: true\n")
+			cfg.Set("git.commitTemplate", tt.template)
+
+			err := src.ValidateCommitTemplate(cfg)
+
+			assert.Error(t, err)
+			assert.True(t, strings.HasPrefix(err.Error(), "git.commitTemplate: "))
+		})
+	}
+}
+
+func Test_datesFromGit(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	filePath := filepath.Join(docsDir, "zombies.md")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("---\ntitle: Zombies\n---\n"), 0644))
+
+	modTime := time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)
+	assert.NoError(t, os.Chtimes(filePath, modTime, modTime))
+
+	log := ""
+	commands := []string{}
+
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+		return []byte(log), nil
+	}
+
+	// A committed file takes its dates from its first and last commits
+	log = "2021-06-01T10:00:00-07:00\n2020-05-07T13:13:08-07:00\n2020-05-01T09:00:00-07:00\n"
+
+	dates, err := datesFromGit(docsDir, filePath)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("git log --follow --format=%%aI -- %s", filePath)}, commands)
+	assert.Equal(t, "2020-05-01T09:00:00-07:00", dates.created.Format(time.RFC3339))
+	assert.Equal(t, "2021-06-01T10:00:00-07:00", dates.modified.Format(time.RFC3339))
+
+	// A file that was never committed falls back to its modification time
+	log = ""
+
+	dates, err = datesFromGit(docsDir, filePath)
+
+	assert.NoError(t, err)
+	assert.True(t, modTime.Equal(dates.created))
+	assert.True(t, modTime.Equal(dates.modified))
+
+	data, changes, err := backfillDates([]byte("---\ntitle: Zombies\n---\n"), dates)
+
+	date := modTime.Local().Format(time.RFC3339)
+
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("---\ndate: %s\ntitle: Zombies\nmodified: %s\n---\n", date, date), string(data))
+	assert.Equal(t, []string{
+		fmt.Sprintf("date set to '%s' from the file's modification time", date),
+		fmt.Sprintf("modified set to '%s' from the file's modification time", date),
+	}, changes)
+}
+
+func Test_gitDatesTargetDir(t *testing.T) {
+	_, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		return []byte("fatal: not a git repository"), errors.New("exit status 128")
+	}
+
+	assert.Equal(t, "", gitDatesTargetDir())
+
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		return []byte("true\n"), nil
+	}
+
+	tDir, _ := src.GetTargetDir(src.GlobalConfig, "", false)
+	assert.Equal(t, tDir, gitDatesTargetDir())
+}
+
+func Test_backfillFileNameDate(t *testing.T) {
+	loc := time.FixedZone("PDT", -7*60*60)
+
+	tests := []struct {
+		name     string
+		filePath string
+		data     string
+		expected string
+		changes  []string
+		dated    bool
+	}{
+		{
+			name:     "with a date in the file name",
+			filePath: "docs/2020-05-06T13-13-08-closures.md",
+			data:     "---\ntitle: Closures\n---\nBody\n",
+			expected: "---\ndate: 2020-05-06T13:13:08-07:00\ntitle: Closures\n---\nBody\n",
+			changes:  []string{"date set to '2020-05-06T13:13:08-07:00' from the file name"},
+			dated:    true,
+		},
+		{
+			name:     "with an empty date",
+			filePath: "docs/2020/2020-05-06T13-13-08.md",
+			data:     "---\ndate:\ntitle: Closures\n---\n",
+			expected: "---\ndate: 2020-05-06T13:13:08-07:00\ntitle: Closures\n---\n",
+			changes:  []string{"date set to '2020-05-06T13:13:08-07:00' from the file name"},
+			dated:    true,
+		},
+		{
+			name:     "with a date already",
+			filePath: "docs/2020-05-06T13-13-08-closures.md",
+			data:     "---\ndate: 2019-01-01T00:00:00Z\ntitle: Closures\n---\n",
+			expected: "---\ndate: 2019-01-01T00:00:00Z\ntitle: Closures\n---\n",
+			changes:  []string{},
+			dated:    true,
+		},
+		{
+			name:     "without a date in the file name",
+			filePath: "docs/closures.md",
+			data:     "---\ntitle: Closures\n---\n",
+			expected: "---\ntitle: Closures\n---\n",
+			changes:  []string{},
+			dated:    false,
+		},
+		{
+			name:     "with a date that runs into the title",
+			filePath: "docs/2020-05-06T13-13-08closures.md",
+			data:     "---\ntitle: Closures\n---\n",
+			expected: "---\ntitle: Closures\n---\n",
+			changes:  []string{},
+			dated:    false,
+		},
+		{
+			name:     "with an impossible date",
+			filePath: "docs/2020-13-06T13-13-08-closures.md",
+			data:     "---\ntitle: Closures\n---\n",
+			expected: "---\ntitle: Closures\n---\n",
+			changes:  []string{},
+			dated:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, changes, dated, err := backfillFileNameDate(tt.filePath, []byte(tt.data), []string{}, loc)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+			assert.Equal(t, tt.changes, changes)
+			assert.Equal(t, tt.dated, dated)
+
+			// Running it again changes nothing
+			again, changes, _, err := backfillFileNameDate(tt.filePath, data, []string{}, loc)
+
+			assert.NoError(t, err)
+			assert.Equal(t, string(data), string(again))
+			assert.Empty(t, changes)
+		})
+	}
+}
+
+func Test_runMigrate_DatesFromFileNames(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	files := map[string]string{
+		"2020-05-06T13-13-08-closures.md": "---\ntitle: Closures\ntags: [go]\n---\n",
+		"2020-05-07T13-13-08-arrays.md":   "---\ndate: 2021-01-01T00:00:00Z\ntitle: Arrays\ntags: [go]\n---\n",
+		"zombies.md":                      "---\ntitle: Zombies\ntags: [go]\n---\n",
+	}
+
+	for name, content := range files {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, name), []byte(content), 0644))
+	}
+
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(docsDir, name))
+		assert.NoError(t, err)
+
+		return string(data)
+	}
+
+	// A dry run writes nothing
+	runMigrate(context.Background(), []string{"--dates-from-filenames", "--dry-run"})
+
+	for name, content := range files {
+		assert.Equal(t, content, read(name))
+	}
+
+	runMigrate(context.Background(), []string{"--dates-from-filenames"})
+
+	date, _ := pages.FileNameDate("2020-05-06T13-13-08-closures.md", time.Local)
+
+	assert.Equal(t, fmt.Sprintf("---\ndate: %s\ntitle: Closures\ntags: [go]\n---\n", date.Format(time.RFC3339)), read("2020-05-06T13-13-08-closures.md"))
+	assert.Equal(t, files["2020-05-07T13-13-08-arrays.md"], read("2020-05-07T13-13-08-arrays.md"))
+	assert.Equal(t, files["zombies.md"], read("zombies.md"))
+
+	// And a second run changes nothing
+	migrated := read("2020-05-06T13-13-08-closures.md")
+	runMigrate(context.Background(), []string{"--dates-from-filenames"})
+
+	assert.Equal(t, migrated, read("2020-05-06T13-13-08-closures.md"))
+}
+
+// fakeHTTPClient answers every request with the same response, and keeps
+// the requests it was sent
+type fakeHTTPClient struct {
+	status   int
+	body     string
+	requests []*http.Request
+	bodies   []string
+}
+
+func (client *fakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body := []byte{}
+	if req.Body != nil {
+		body, _ = ioutil.ReadAll(req.Body)
+	}
+
+	client.requests = append(client.requests, req)
+	client.bodies = append(client.bodies, string(body))
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", client.status, http.StatusText(client.status)),
+		StatusCode: client.status,
+		Body:       ioutil.NopCloser(strings.NewReader(client.body)),
+	}, nil
+}
+
+func Test_publishGist(t *testing.T) {
+	tests := []struct {
+		name           string
+		frontMatter    string
+		status         int
+		body           string
+		expectedMethod string
+		expectedURL    string
+		expectedGist   string
+		expectedErr    string
+	}{
+		{
+			name:           "with a new gist",
+			status:         http.StatusCreated,
+			body:           `{"id": "abc123", "html_url": "https://gist.github.com/abc123"}`,
+			expectedMethod: http.MethodPost,
+			expectedURL:    "https://api.github.com/gists",
+			expectedGist:   "https://gist.github.com/abc123",
+		},
+		{
+			name:           "with an existing gist",
+			frontMatter:    "gist: https://gist.github.com/abc123\n",
+			status:         http.StatusOK,
+			body:           `{"id": "abc123", "html_url": "https://gist.github.com/abc123"}`,
+			expectedMethod: http.MethodPatch,
+			expectedURL:    "https://api.github.com/gists/abc123",
+			expectedGist:   "https://gist.github.com/abc123",
+		},
+		{
+			name:           "with a gist that was deleted",
+			frontMatter:    "gist: https://gist.github.com/abc123\n",
+			status:         http.StatusNotFound,
+			body:           `{"message": "Not Found"}`,
+			expectedMethod: http.MethodPatch,
+			expectedURL:    "https://api.github.com/gists/abc123",
+			expectedGist:   "https://gist.github.com/abc123",
+			expectedErr:    "the gist abc123 no longer exists. Remove the gist field from the page's front-matter to publish it as a new gist",
+		},
+		{
+			name:           "with a bad token",
+			status:         http.StatusUnauthorized,
+			body:           `{"message": "Bad credentials"}`,
+			expectedMethod: http.MethodPost,
+			expectedURL:    "https://api.github.com/gists",
+			expectedErr:    "GitHub didn't accept the token (401 Unauthorized). Check that it is valid and has the gist scope",
+		},
+		{
+			name:           "with some other API error",
+			status:         http.StatusUnprocessableEntity,
+			body:           `{"message": "Validation Failed"}`,
+			expectedMethod: http.MethodPost,
+			expectedURL:    "https://api.github.com/gists",
+			expectedErr:    "GitHub couldn't create the gist (422 Unprocessable Entity): Validation Failed",
+		},
+	}
+
+	defer func(client httpDoer) { httpClient = client }(httpClient)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docsDir, cleanup := setUpTargetDir(t)
+			defer cleanup()
+
+			src.GlobalConfig.Set("githubToken", "s3cret")
+
+			filePath := filepath.Join(docsDir, "2020-05-07-zombies.md")
+			content := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\n" + tt.frontMatter + "---\n\nBraaains\n"
+			assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+
+			client := &fakeHTTPClient{status: tt.status, body: tt.body}
+			httpClient = client
+
+			gistURL, err := publishGist(readTestPage(t, filePath))
+
+			assert.Equal(t, 1, len(client.requests))
+			assert.Equal(t, tt.expectedMethod, client.requests[0].Method)
+			assert.Equal(t, tt.expectedURL, client.requests[0].URL.String())
+			assert.Equal(t, "Bearer s3cret", client.requests[0].Header.Get("Authorization"))
+			assert.Equal(t, `{"description":"Zombies","files":{"2020-05-07-zombies.md":{"content":"# Zombies\n\nBraaains\n"}}}`, client.bodies[0])
+
+			data, _ := ioutil.ReadFile(filePath)
+			recorded, _ := pages.FrontMatterField(data, "gist")
+			assert.Equal(t, tt.expectedGist, recorded)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedGist, gistURL)
+		})
+	}
+}
+
+func Test_publishGist_NoToken(t *testing.T) {
+	_, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(token string) { os.Setenv("GITHUB_TOKEN", token) }(os.Getenv("GITHUB_TOKEN"))
+	os.Setenv("GITHUB_TOKEN", "")
+
+	_, err := publishGist(&pages.Page{FilePath: "docs/zombies.md"})
+
+	assert.EqualError(t, err, "publishing a gist needs a GitHub token. Add githubToken to the config, or set GITHUB_TOKEN")
+
+	os.Setenv("GITHUB_TOKEN", "from-env")
+	assert.Equal(t, "from-env", githubToken())
+}
+
+func Test_NotifyPayload(t *testing.T) {
+	info := src.NotifyInfo{
+		Title:     `The "zombie" apocalypse`,
+		Tags:      []string{"go", "zombies"},
+		Permalink: "https://example.com/til/zombies",
+		Excerpt:   "Braaains",
+	}
+
+	tests := []struct {
+		name        string
+		cfg         string
+		info        src.NotifyInfo
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "with the default template",
+			cfg:      "editor: vim",
+			info:     info,
+			expected: `{"text": "New TIL: <https://example.com/til/zombies|The \"zombie\" apocalypse> (go, zombies)", "title": "The \"zombie\" apocalypse", "tags": ["go","zombies"], "permalink": "https://example.com/til/zombies", "excerpt": "Braaains"}`,
+		},
+		{
+			name:     "without a permalink or tags",
+			cfg:      "editor: vim",
+			info:     src.NotifyInfo{Title: "Zombies", Tags: []string{}},
+			expected: `{"text": "New TIL: Zombies", "title": "Zombies", "tags": [], "permalink": "", "excerpt": ""}`,
+		},
+		{
+			name:     "with a template of its own",
+			cfg:      "notify:\n  template: '{\"content\": {{json .Title}}, \"tags\": {{json (join .Tags \" \")}}}'\n",
+			info:     info,
+			expected: `{"content": "The \"zombie\" apocalypse", "tags": "go zombies"}`,
+		},
+		{
+			name:        "with a template that doesn't make JSON",
+			cfg:         "notify:\n  template: '{\"content\": \"{{.Title}}\"}'\n",
+			info:        info,
+			expectedErr: `notify.template didn't make JSON: {"content": "The "zombie" apocalypse"}`,
+		},
+		{
+			name:        "with a template that doesn't parse",
+			cfg:         "notify:\n  template: '{{.Title'\n",
+			info:        info,
+			expectedErr: "notify.template: template: notify:1: unclosed action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			payload, err := src.NotifyPayload(cfg, tt.info)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Error(t, src.ValidateNotifyTemplate(cfg))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(payload))
+			assert.NoError(t, src.ValidateNotifyTemplate(cfg))
+		})
+	}
+}
+
+func Test_pageExcerpt(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		maxLength int
+		expected  string
+	}{
+		{
+			name:      "with the first paragraph under the heading",
+			content:   "# Zombies\n\nThey want\nyour **brains**.\n\nAnd nothing else.\n",
+			maxLength: 200,
+			expected:  "They want your brains.",
+		},
+		{
+			name:      "with code and links",
+			content:   "# Zombies\n\n```go\nfunc brains() {}\n```\n\nSee [the docs](https://example.com) for `brains()`.\n",
+			maxLength: 200,
+			expected:  "See the docs for brains().",
+		},
+		{
+			name:      "with a paragraph that's too long",
+			content:   "# Zombies\n\nThe zombies shuffle slowly, towards the mall.\n",
+			maxLength: 30,
+			expected:  "The zombies shuffle slowly…",
+		},
+		{
+			name:      "with nothing but the heading",
+			content:   "# Zombies\n",
+			maxLength: 200,
+			expected:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pageExcerpt(&pages.Page{Content: tt.content}, tt.maxLength))
+		})
+	}
+}
+
+func Test_notifyNewPage(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(client httpDoer) { httpClient = client }(httpClient)
+
+	filePath := filepath.Join(docsDir, "2020-05-07-zombies.md")
+	content := "---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Zombies\ntags: go\n---\n\n# Zombies\n\nBraaains\n"
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+
+	// Without a webhook, nothing is sent
+	client := &fakeHTTPClient{status: http.StatusOK}
+	httpClient = client
+
+	notifyNewPage(context.Background(), &pages.Page{FilePath: filePath, Title: "Zombies"})
+	assert.Empty(t, client.requests)
+
+	src.GlobalConfig.Set("notify.webhookURL", "https://hooks.example.com/til")
+	src.GlobalConfig.Set("baseURL", "https://example.com/til")
+
+	// The page is read again, for what was written in the editor
+	notifyNewPage(context.Background(), &pages.Page{FilePath: filePath, Title: "Zombies"})
+
+	assert.Len(t, client.requests, 1)
+	assert.Equal(t, http.MethodPost, client.requests[0].Method)
+	assert.Equal(t, "https://hooks.example.com/til", client.requests[0].URL.String())
+	assert.Equal(t, "application/json", client.requests[0].Header.Get("Content-Type"))
+	assert.Contains(t, client.bodies[0], `"tags": ["go"]`)
+	assert.Contains(t, client.bodies[0], `"excerpt": "Braaains"`)
+	assert.Contains(t, client.bodies[0], `"permalink": "https://example.com/til/2020-05-07-zombies.html"`)
+
+	// A webhook that doesn't answer well is only a warning
+	client.status = http.StatusInternalServerError
+
+	notifyNewPage(context.Background(), &pages.Page{FilePath: filePath, Title: "Zombies"})
+	assert.Len(t, client.requests, 2)
+
+	err := notifyPage(context.Background(), "https://hooks.example.com/til", &pages.Page{FilePath: filePath, Title: "Zombies"})
+	assert.EqualError(t, err, "the webhook answered 500 Internal Server Error")
+}
+
+func Test_apiHandler(t *testing.T) {
+	pageSet := []*pages.Page{
+		{FilePath: "docs/2024-05-14T09-00-00-channels.md", Title: "Channels", Date: "2024-05-14T09:00:00Z", TagsStr: "go, concurrency", Content: "# Channels\n\nThey're **typed** pipes.\n"},
+		{FilePath: "docs/2024-03-02T09-00-00-mutexes.md", Title: "Mutexes", Date: "2024-03-02T09:00:00Z", TagsStr: "go", Author: "Zoë Ann", Content: "# Mutexes\n\nLock them.\n"},
+		{FilePath: "docs/2023-11-20T09-00-00-borrowing.md", Title: "Borrowing", Date: "2023-11-20T09:00:00Z", TagsStr: "rust", Author: "zoe ann", Content: "# Borrowing\n\nShare or mutate.\n"},
+		{FilePath: "docs/2022-02-10T09-00-00-vagrant.md", Title: "Vagrant Boxes", Date: "2022-02-10T09:00:00Z", TagsStr: "vagrant", Archived: true, Content: "# Vagrant Boxes\n"},
+		{FilePath: "docs/index.md"},
+	}
+
+	load := func(ctx context.Context) ([]*pages.Page, error) { return pageSet, nil }
+	handler := newAPIHandler(load, "https://example.com/til", time.UTC)
+
+	serve := func(method, target string) (int, string) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+
+		return recorder.Code, recorder.Body.String()
+	}
+
+	titles := func(body string) []string {
+		list := src.JSONPageList{}
+		assert.NoError(t, json.Unmarshal([]byte(body), &list))
+
+		names := []string{}
+		for _, page := range list.Pages {
+			names = append(names, page.Title)
+		}
+
+		return names
+	}
+
+	t.Run("listing the pages", func(t *testing.T) {
+		tests := []struct {
+			target   string
+			expected []string
+		}{
+			{target: "/api/pages", expected: []string{"Channels", "Mutexes", "Borrowing"}},
+			{target: "/api/pages?tag=go", expected: []string{"Channels", "Mutexes"}},
+			{target: "/api/pages?tag=haskell", expected: []string{}},
+			{target: "/api/pages?since=2024-03-02", expected: []string{"Channels", "Mutexes"}},
+			{target: "/api/pages?limit=1", expected: []string{"Channels"}},
+			{target: "/api/pages?tag=go&since=2024-04-01&limit=5", expected: []string{"Channels"}},
+			{target: "/api/pages?author=zoe-ann", expected: []string{"Mutexes", "Borrowing"}},
+			{target: "/api/pages?author=Zo%C3%AB+Ann&tag=go", expected: []string{"Mutexes"}},
+			{target: "/api/pages?author=unattributed", expected: []string{"Channels"}},
+			{target: "/api/pages?author=bo", expected: []string{}},
+			{target: "/api/pages?archived=true", expected: []string{"Vagrant Boxes"}},
+			{target: "/api/pages?archived=false&tag=vagrant", expected: []string{}},
+		}
+
+		for _, tt := range tests {
+			status, body := serve(http.MethodGet, tt.target)
+
+			assert.Equal(t, http.StatusOK, status, tt.target)
+			assert.Equal(t, tt.expected, titles(body), tt.target)
+		}
+	})
+
+	t.Run("getting a page", func(t *testing.T) {
+		for _, target := range []string{"/api/pages/2024-03-02T09-00-00-mutexes", "/api/pages/mutexes"} {
+			status, body := serve(http.MethodGet, target)
+			assert.Equal(t, http.StatusOK, status, target)
+
+			detail := src.JSONPageDetail{}
+			assert.NoError(t, json.Unmarshal([]byte(body), &detail))
+
+			assert.Equal(t, src.JSONVersion, detail.Version)
+			assert.Equal(t, "Mutexes", detail.Title)
+			assert.Equal(t, []string{"go"}, detail.Tags)
+			assert.Equal(t, "https://example.com/til/2024-03-02T09-00-00-mutexes.html", detail.Permalink)
+			assert.Equal(t, "# Mutexes\n\nLock them.\n", detail.Markdown)
+			assert.Equal(t, "<p>Lock them.</p>\n", detail.HTML)
+		}
+
+		// An archived page isn't listed, but can still be got
+		status, _ := serve(http.MethodGet, "/api/pages/vagrant-boxes")
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("listing the tags", func(t *testing.T) {
+		status, body := serve(http.MethodGet, "/api/tags")
+		assert.Equal(t, http.StatusOK, status)
+
+		list := src.JSONTagList{}
+		assert.NoError(t, json.Unmarshal([]byte(body), &list))
+
+		counts := map[string]int{}
+		for _, tag := range list.Tags {
+			counts[tag.Name] = tag.Stats.Count
+		}
+
+		assert.Equal(t, map[string]int{"concurrency": 1, "go": 2, "rust": 1, "vagrant": 1}, counts)
+	})
+
+	t.Run("with requests it can't answer", func(t *testing.T) {
+		tests := []struct {
+			method         string
+			target         string
+			expectedStatus int
+			expectedErr    string
+		}{
+			{method: http.MethodGet, target: "/api/pages/zombies", expectedStatus: http.StatusNotFound, expectedErr: "no page has the id or slug 'zombies'"},
+			{method: http.MethodGet, target: "/api/pages/index", expectedStatus: http.StatusNotFound, expectedErr: "no page has the id or slug 'index'"},
+			{method: http.MethodGet, target: "/api/zombies", expectedStatus: http.StatusNotFound, expectedErr: "there's nothing at /api/zombies"},
+			{method: http.MethodGet, target: "/api/pages?limit=0", expectedStatus: http.StatusBadRequest, expectedErr: "limit needs to be a number above 0, not '0'"},
+			{method: http.MethodGet, target: "/api/pages?limit=lots", expectedStatus: http.StatusBadRequest, expectedErr: "limit needs to be a number above 0, not 'lots'"},
+			{method: http.MethodGet, target: "/api/pages?since=last-week", expectedStatus: http.StatusBadRequest, expectedErr: "since needs to be a date, like 2024-05-14, not 'last-week'"},
+			{method: http.MethodGet, target: "/api/pages?archived=maybe", expectedStatus: http.StatusBadRequest, expectedErr: "archived needs to be true or false, not 'maybe'"},
+			{method: http.MethodPost, target: "/api/pages", expectedStatus: http.StatusMethodNotAllowed, expectedErr: "the API only answers GET requests"},
+		}
+
+		for _, tt := range tests {
+			status, body := serve(tt.method, tt.target)
+			assert.Equal(t, tt.expectedStatus, status, tt.target)
+
+			apiErr := src.JSONError{}
+			assert.NoError(t, json.Unmarshal([]byte(body), &apiErr))
+			assert.Equal(t, tt.expectedErr, apiErr.Error, tt.target)
+		}
+	})
+
+	t.Run("with pages that can't be loaded", func(t *testing.T) {
+		failing := newAPIHandler(func(ctx context.Context) ([]*pages.Page, error) {
+			return nil, errors.New("the docs directory is gone")
+		}, "", time.UTC)
+
+		recorder := httptest.NewRecorder()
+		failing.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/pages", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "the docs directory is gone")
+	})
+}
+
+func Test_hideDotFiles(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(docsDir, ".til", "history"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, ".til", "history", "zombies.md.1588882388"), []byte("# Zombies\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, ".til.lock"), []byte("1234\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "zombies.md"), []byte("# Zombies\n"), 0644))
+
+	handler := hideDotFiles(http.FileServer(http.Dir(docsDir)))
+
+	tests := []struct {
+		target   string
+		expected int
+	}{
+		{target: "/zombies.md", expected: http.StatusOK},
+		{target: "/.til/history/zombies.md.1588882388", expected: http.StatusNotFound},
+		{target: "/.til/", expected: http.StatusNotFound},
+		{target: "/.til.lock", expected: http.StatusNotFound},
+		{target: "/%2Etil/history/", expected: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, tt.expected, recorder.Code)
+		})
+	}
+}
+
+func Test_runHook(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(runner hookRunner) { runHookCommand = runner }(runHookCommand)
+
+	type hookCall struct {
+		dir     string
+		env     []string
+		timeout time.Duration
+		command string
+	}
+
+	calls := []hookCall{}
+	fail := false
+
+	runHookCommand = func(dir string, env []string, timeout time.Duration, command string) ([]byte, error) {
+		calls = append(calls, hookCall{dir, env, timeout, command})
+
+		if fail {
+			return []byte("Already up to date?\n"), errors.New("exit status 1")
+		}
+
+		return []byte{}, nil
+	}
+
+	// With no hook configured nothing is run
+	assert.NoError(t, runHook("postBuild", src.ActionBuild, ""))
+	assert.Empty(t, calls)
+
+	src.GlobalConfig.Set("hooks.preNew", "git pull --ff-only")
+	src.GlobalConfig.Set("hooks.postNew", "./scripts/publish.sh")
+	src.GlobalConfig.Set("hooks.timeout", 5)
+
+	assert.NoError(t, runHook("postNew", src.ActionNew, filepath.Join(docsDir, "zombies.md")))
+
+	expected := hookCall{
+		dir:     filepath.Dir(docsDir),
+		env:     []string{"TIL_ACTION=new", "TIL_DIR=" + docsDir, "TIL_FILE=" + filepath.Join(docsDir, "zombies.md")},
+		timeout: 5 * time.Second,
+		command: "./scripts/publish.sh",
+	}
+	assert.Equal(t, []hookCall{expected}, calls)
+
+	// The hooks around an action are found by its name
+	runPostHook(src.ActionNew, "")
+	runPreHook(src.ActionNew, "")
+	assert.Equal(t, []string{"./scripts/publish.sh", "git pull --ff-only"}, []string{calls[1].command, calls[2].command})
+
+	fail = true
+
+	assert.EqualError(t, runHook("preNew", src.ActionNew, ""), "the preNew hook failed: exit status 1")
+}
+
+func Test_runHookCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the hook commands below need a POSIX shell")
+	}
+
+	dir, err := ioutil.TempDir("", "til")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	out, err := runHookCommand(dir, []string{"TIL_ACTION=build"}, time.Second, "echo $TIL_ACTION")
+	assert.NoError(t, err)
+	assert.Equal(t, "build\n", string(out))
+
+	_, err = runHookCommand(dir, []string{}, time.Second, "exit 3")
+	assert.EqualError(t, err, "exit status 3")
+
+	_, err = runHookCommand(dir, []string{}, 50*time.Millisecond, "sleep 5")
+	assert.EqualError(t, err, "timed out after 50ms")
+}
+
+func Test_Permalink(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		relPath  string
+		expected string
+	}{
+		{
+			name:     "with a page",
+			baseURL:  "https://me.github.io/til",
+			relPath:  "2020-05-07-zombies.md",
+			expected: "https://me.github.io/til/2020-05-07-zombies.html",
+		},
+		{
+			name:     "with a trailing slash on the base URL",
+			baseURL:  "https://me.github.io/til/",
+			relPath:  "2020-05-07-zombies.md",
+			expected: "https://me.github.io/til/2020-05-07-zombies.html",
+		},
+		{
+			name:     "with a child tag page",
+			baseURL:  "https://til.example.com",
+			relPath:  "tags/go/concurrency.md",
+			expected: "https://til.example.com/tags/go/concurrency.html",
+		},
+		{
+			name:     "with a Windows path",
+			baseURL:  "https://til.example.com",
+			relPath:  `tags\go\concurrency.md`,
+			expected: "https://til.example.com/tags/go/concurrency.html",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pages.Permalink(tt.baseURL, tt.relPath))
+		})
+	}
+
+	page := &pages.Page{FilePath: "/home/me/til/docs/2020-05-07-zombies.md"}
+	assert.Equal(t, "https://me.github.io/til/2020-05-07-zombies.html", page.Permalink("https://me.github.io/til"))
+}
+
+func Test_clipboardCommand(t *testing.T) {
+	defer func(finder func(string) (string, error)) { lookPath = finder }(lookPath)
+	defer func(display string) { os.Setenv("WAYLAND_DISPLAY", display) }(os.Getenv("WAYLAND_DISPLAY"))
+
+	installed := map[string]bool{}
+	lookPath = func(file string) (string, error) {
+		if installed[file] {
+			return "/usr/bin/" + file, nil
+		}
+
+		return "", errors.New("not found")
+	}
+
+	os.Setenv("WAYLAND_DISPLAY", "")
+	assert.Nil(t, clipboardCommand())
+
+	installed["xsel"] = true
+	assert.Equal(t, []string{"xsel", "--clipboard", "--input"}, clipboardCommand())
+
+	installed["xclip"] = true
+	assert.Equal(t, []string{"xclip", "-selection", "clipboard"}, clipboardCommand())
+
+	// wl-copy is only used under Wayland
+	installed["wl-copy"] = true
+	assert.Equal(t, []string{"xclip", "-selection", "clipboard"}, clipboardCommand())
+
+	os.Setenv("WAYLAND_DISPLAY", "wayland-0")
+	assert.Equal(t, []string{"wl-copy"}, clipboardCommand())
+
+	installed = map[string]bool{}
+	assert.EqualError(t, copyToClipboard("https://me.github.io/til"), "no clipboard tool was found (pbcopy, wl-copy, xclip, or xsel)")
+}
+
+// fakeClipboard has the same thing on it every time it's read
+type fakeClipboard struct {
+	data []byte
+	err  error
+}
+
+func (fake fakeClipboard) Read() ([]byte, error) {
+	return fake.data, fake.err
+}
+
+func Test_clipboardPasteCommand(t *testing.T) {
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		t.Skip("the system's own clipboard tool comes first")
+	}
+
+	defer func(finder func(string) (string, error)) { lookPath = finder }(lookPath)
+	defer func(display string) { os.Setenv("WAYLAND_DISPLAY", display) }(os.Getenv("WAYLAND_DISPLAY"))
+
+	installed := map[string]bool{}
+	lookPath = func(file string) (string, error) {
+		if installed[file] {
+			return "/usr/bin/" + file, nil
+		}
+
+		return "", errors.New("not found")
+	}
+
+	os.Setenv("WAYLAND_DISPLAY", "")
+	assert.Nil(t, clipboardPasteCommand())
+
+	_, err := systemClipboard{}.Read()
+	assert.EqualError(t, err, "no clipboard tool was found (pbpaste, wl-paste, xclip, or xsel)")
+
+	installed["xsel"] = true
+	assert.Equal(t, []string{"xsel", "--clipboard", "--output"}, clipboardPasteCommand())
+
+	installed["xclip"] = true
+	assert.Equal(t, []string{"xclip", "-selection", "clipboard", "-out"}, clipboardPasteCommand())
+
+	// wl-paste is only used under Wayland
+	installed["wl-paste"] = true
+	assert.Equal(t, []string{"xclip", "-selection", "clipboard", "-out"}, clipboardPasteCommand())
+
+	os.Setenv("WAYLAND_DISPLAY", "wayland-0")
+	assert.Equal(t, []string{"wl-paste", "--no-newline"}, clipboardPasteCommand())
+}
+
+func Test_clipboardText(t *testing.T) {
+	tests := []struct {
+		name        string
+		clipboard   fakeClipboard
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:      "with text",
+			clipboard: fakeClipboard{data: []byte("Brains are\r\ndelicious.\r\n\r\n")},
+			expected:  "Brains are\ndelicious.\n",
+		},
+		{
+			name:        "with nothing",
+			clipboard:   fakeClipboard{data: []byte(" \n\t")},
+			expectedErr: errClipboardEmpty,
+		},
+		{
+			name:        "with a picture",
+			clipboard:   fakeClipboard{data: []byte("\x89PNG\r\n\x1a\n\x00\x00")},
+			expectedErr: errClipboardBinary,
+		},
+		{
+			name:        "without a clipboard tool",
+			clipboard:   fakeClipboard{err: errors.New(errNoClipboardRead)},
+			expectedErr: errNoClipboardRead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := clipboardText(tt.clipboard)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_joinBody(t *testing.T) {
+	assert.Equal(t, "Brains.\n", joinBody("", "Brains.\n"))
+	assert.Equal(t, "[Zombies](https://example.com)\n\nBrains.\n", joinBody("[Zombies](https://example.com)\n", "Brains.\n"))
+}
+
+func Test_changelogContent(t *testing.T) {
+	log := strings.Join([]string{
+		"@@commit c3 2020-05-08T09:00:00Z",
+		"",
+		"M\tdocs/2020-05-07-zombies.md",
+		"M\tdocs/index.md",
+		"D\tdocs/old-tag.md",
+		"D\tdocs/2020-01-01-vampires.md",
+		"@@commit c2 2020-05-07T18:00:00Z",
+		"",
+		"M\tdocs/2020-05-07-zombies.md",
+		"R087\tdocs/2020-05-01-ghoul.md\tdocs/2020-05-01-ghouls.md",
+		"A\tdocs/tags/go/concurrency.md",
+		"@@commit c1 2020-05-07T13:00:00Z",
+		"",
+		"A\tdocs/2020-05-07-zombies.md",
+		"",
+	}, "\n")
+
+	commits := parseChangelogLog(log)
+
+	assert.Equal(t, 3, len(commits))
+	assert.Equal(t, "c3", commits[0].hash)
+	assert.Equal(t, fileChange{kind: changeRenamed, path: "docs/2020-05-01-ghouls.md", oldPath: "docs/2020-05-01-ghoul.md"}, commits[1].changes[1])
+
+	pageSet := []*pages.Page{
+		{Title: "Zombies", Date: "2020-05-07T13:00:00Z", FilePath: "/til/docs/2020-05-07-zombies.md"},
+		{Title: "Ghouls", Date: "2020-05-01T13:00:00Z", FilePath: "/til/docs/2020-05-01-ghouls.md"},
+		{FilePath: "/til/docs/index.md"},
+	}
+
+	// Only vampires had front-matter; old-tag was a generated tag page
+	isContent := func(commit changelogCommit, filePath string) bool {
+		return filePath == "docs/2020-01-01-vampires.md"
+	}
+
+	expected := `### May 08, 2020
+
+* Updated [Zombies](2020-05-07-zombies.md)
+* Removed 2020-01-01-vampires.md
+
+### May 07, 2020
+
+* Added [Zombies](2020-05-07-zombies.md)
+* Renamed [Ghouls](2020-05-01-ghouls.md) (was 2020-05-01-ghoul.md)
+`
+	assert.Equal(t, expected, changelogContent(commits, pageSet, isContent))
+	assert.Equal(t, "_Nothing has changed recently._\n", changelogContent([]changelogCommit{}, pageSet, isContent))
+}
+
+func Test_buildChangelogPage(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	commands := []string{}
+	isRepo := true
+
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+
+		switch {
+		case args[0] == "rev-parse" && !isRepo:
+			return []byte("fatal: not a git repository"), errors.New("exit status 128")
+		case args[0] == "log":
+			return []byte("@@commit c1 2020-05-07T13:00:00Z\n\nA\tdocs/2020-05-07-zombies.md\n"), nil
+		}
+
+		return []byte{}, nil
+	}
+
+	src.GlobalConfig.Set("changelogCommits", 5)
+
+	pageSet := []*pages.Page{{Title: "Zombies", Date: "2020-05-07T13:00:00Z", FilePath: filepath.Join(docsDir, "2020-05-07-zombies.md")}}
+
+	assert.NoError(t, buildChangelogPage(context.Background(), pageSet, pages.NewTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	data, _ := memFS.ReadFile(filepath.Join(docsDir, "changelog.md"))
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## What's New\n\n### May 07, 2020\n\n* Added [Zombies](2020-05-07-zombies.md)\n"), string(data))
+	assert.Contains(t, commands, "git log --name-status --relative --format=@@commit %H %aI --max-count=5 -- docs")
+
+	// Counting by days instead
+	src.GlobalConfig.Set("changelogDays", 14)
+	assert.NoError(t, buildChangelogPage(context.Background(), pageSet, pages.NewTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	assert.Contains(t, commands, "git log --name-status --relative --format=@@commit %H %aI --since=14.days -- docs")
+
+	// Outside a git repo there's no history to show
+	isRepo = false
+	assert.NoError(t, buildChangelogPage(context.Background(), pageSet, pages.NewTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	data, _ = memFS.ReadFile(filepath.Join(docsDir, "changelog.md"))
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## What's New\n\n_The changelog is made from the git history, and the target directory isn't a git repo yet._\n"))
+}
+
+func Test_browser(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md", Title: "Closures", TagsStr: "javascript", Content: "# Closures\n\nThey close over `vars`.\n"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md", Title: "Boxes", TagsStr: "css"},
+		{Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/a.md", Title: "Arrays", TagsStr: "javascript, go"},
+		{FilePath: "docs/index.md"},
+	}
+
+	press := func(brw *browser, keys ...key) {
+		for _, k := range keys {
+			brw.update(k)
+		}
+	}
+
+	typed := func(text string) []key {
+		keys := []key{}
+		for _, r := range text {
+			keys = append(keys, key{kind: keyRune, r: r})
+		}
+		return keys
+	}
+
+	brw := newBrowser(pageSet)
+	assert.Equal(t, 3, len(brw.matches()))
+	assert.Equal(t, "Closures", brw.selected().Title)
+
+	// The cursor stays within the list
+	press(brw, key{kind: keyUp}, key{kind: keyDown}, key{kind: keyDown}, key{kind: keyDown})
+	assert.Equal(t, "Arrays", brw.selected().Title)
+
+	// Filtering narrows the list as it's typed, on titles and tags
+	press(brw, typed("/bo")...)
+	assert.Equal(t, browseFilter, brw.mode)
+	assert.Equal(t, 1, len(brw.matches()))
+	assert.Equal(t, "Boxes", brw.selected().Title)
+
+	press(brw, key{kind: keyBackspace}, key{kind: keyBackspace})
+	press(brw, typed("go")...)
+	assert.Equal(t, "Arrays", brw.selected().Title)
+
+	// Enter keeps the filter, and the keys go back to moving around
+	press(brw, key{kind: keyEnter}, key{kind: keyRune, r: 'q'})
+	assert.Equal(t, browseQuit, brw.action)
+	assert.Equal(t, "go", brw.filter)
+
+	// The tag filter only looks at tags
+	brw = newBrowser(pageSet)
+	press(brw, typed("tjava")...)
+	press(brw, key{kind: keyEnter})
+	assert.Equal(t, 2, len(brw.matches()))
+
+	press(brw, typed("tbox")...)
+	assert.Equal(t, 0, len(brw.matches()))
+	assert.Nil(t, brw.selected())
+
+	// Esc clears it
+	press(brw, key{kind: keyEsc}, key{kind: keyEnter})
+	assert.Equal(t, 3, len(brw.matches()))
+	assert.Equal(t, browseOpen, brw.action)
+	assert.Equal(t, "Closures", brw.selected().Title)
+
+	// A new page needs a title
+	brw = newBrowser(pageSet)
+	press(brw, key{kind: keyRune, r: 'n'}, key{kind: keyEnter})
+	assert.Equal(t, browseNone, brw.action)
+
+	press(brw, typed("Nested q")...)
+	press(brw, key{kind: keyEnter})
+	assert.Equal(t, browseNew, brw.action)
+	assert.Equal(t, "Nested q", brw.input)
+
+	brw = newBrowser(pageSet)
+	press(brw, key{kind: keyCtrlC})
+	assert.Equal(t, browseQuit, brw.action)
+}
+
+func Test_browserView(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/c.md", Title: "Closures", TagsStr: "javascript", Content: "# Closures\n\nThey close over `vars`.\n"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/b.md", Title: "Boxes"},
+	}
+
+	lines := newBrowser(pageSet).view(12, 40)
+
+	assert.Equal(t, []string{
+		"til browse: 2 of 2 pages",
+		"↑/↓ move  enter open  / filter  t tag  n",
+		"",
+		fmt.Sprintf(reverseVideo, "  May 09, 2020  Closures  [javascript]"),
+		"  May 08, 2020  Boxes",
+		"",
+		"",
+		strings.Repeat("─", 40),
+		src.Bold("Closures"),
+		"",
+		"They close over " + src.Yellow("vars") + ".",
+	}, lines)
+}
+
+func Test_renderMarkdown(t *testing.T) {
+	content := "## Heading\n\n* a list item that wraps\n\n```go\nfmt.Println()\n```\n"
+
+	assert.Equal(t, []string{
+		src.Bold("Heading"),
+		"",
+		"• a list",
+		"  item that",
+		"  wraps",
+		"",
+		src.Blue("    fmt.Prin"),
+	}, renderMarkdown(content, 12))
+}
+
+func Test_readKey(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader("a\033[A\033[B\r\x7f\x03\033[3~b\033"))
+
+	expected := []key{
+		{kind: keyRune, r: 'a'},
+		{kind: keyUp},
+		{kind: keyDown},
+		{kind: keyEnter},
+		{kind: keyBackspace},
+		{kind: keyCtrlC},
+		{kind: keyRune, r: 'b'},
+		{kind: keyEsc},
+	}
+
+	for _, exp := range expected {
+		actual, err := readKey(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, exp, actual)
+	}
+
+	_, err := readKey(reader)
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_findPage(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/2020-05-09-closures.md", Title: "Closures"},
+		{Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/2020-05-08-go-closures.md", Title: "Go Closures"},
+		{Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/2020-05-07-boxes.md", Title: "Boxes"},
+	}
+
+	page, err := findPage(pageSet, "boxes")
+	assert.NoError(t, err)
+	assert.Equal(t, "Boxes", page.Title)
+
+	page, err = findPage(pageSet, "2020-05-09-closures")
+	assert.NoError(t, err)
+	assert.Equal(t, "Closures", page.Title)
+
+	page, err = findPage(pageSet, "2020-05-08-go-closures.md")
+	assert.NoError(t, err)
+	assert.Equal(t, "Go Closures", page.Title)
+
+	_, err = findPage(pageSet, "closures")
+	assert.EqualError(t, err, "'closures' matches more than one page: 2020-05-09-closures.md, 2020-05-08-go-closures.md")
+
+	_, err = findPage(pageSet, "zombies")
+	assert.EqualError(t, err, "no page matches 'zombies'")
+
+	_, err = findPage(pageSet, " ")
+	assert.Error(t, err)
+}
+
+func Test_fuzzyScore(t *testing.T) {
+	_, ok := fuzzyScore("cls", "Closures")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("slc", "Closures")
+	assert.False(t, ok)
+
+	score, ok := fuzzyScore("", "Closures")
+	assert.True(t, ok)
+	assert.Equal(t, 0, score)
+
+	better := func(pattern, winner, loser string) {
+		winnerScore, _ := fuzzyScore(pattern, winner)
+		loserScore, _ := fuzzyScore(pattern, loser)
+		assert.True(t, winnerScore > loserScore, "%s: '%s' (%d) should beat '%s' (%d)", pattern, winner, winnerScore, loser, loserScore)
+	}
+
+	// Word starts win
+	better("gc", "Go Closures", "Magic")
+	better("gc", "go-closures", "goclosures")
+	better("ft", "fmtTricks", "aftermath")
+
+	// Runs of matches win
+	better("clo", "Closures", "Calico")
+
+	// The best way of lining the pattern up is found, even if it isn't the
+	// first one
+	better("cl", "acl Closures", "acl")
+}
+
+func Test_fuzzyFilter(t *testing.T) {
+	lines := []string{
+		"2020-05-09 Magic Numbers [go]",
+		"2020-05-08 Go Channels [go, concurrency]",
+		"2020-05-07 Boxes [css]",
+	}
+
+	assert.Equal(t, []int{1, 0}, fuzzyFilter("gc", lines))
+	assert.Equal(t, []int{0, 1, 2}, fuzzyFilter("", lines))
+	assert.Equal(t, []int{2}, fuzzyFilter("css", lines))
+	assert.Equal(t, []int{}, fuzzyFilter("zombies", lines))
+}
+
+func Test_finderLine(t *testing.T) {
+	assert.Equal(t, "2020-05-08 Go Channels [go, concurrency]", finderLine(&pages.Page{Date: "2020-05-08T13:13:08-07:00", Title: "Go Channels", TagsStr: "go, concurrency"}))
+	assert.Equal(t, "Boxes", finderLine(&pages.Page{Date: "not a date", Title: "Boxes"}))
+}
+
+func Test_finder(t *testing.T) {
+	lines := []string{"2020-05-09 Closures", "2020-05-08 Go Closures", "2020-05-07 Boxes"}
+
+	fnd := newFinder(lines, "clo")
+	assert.Equal(t, []int{0, 1}, fnd.matches)
+
+	fnd.update(key{kind: keyDown})
+	fnd.update(key{kind: keyDown})
+	assert.Equal(t, 1, fnd.cursor)
+
+	// Typing narrows the matches and goes back to the best one
+	fnd.update(key{kind: keyRune, r: 'x'})
+	assert.Equal(t, []int{}, fnd.matches)
+
+	fnd.update(key{kind: keyEnter})
+	assert.False(t, fnd.done)
+
+	fnd.update(key{kind: keyBackspace})
+	fnd.update(key{kind: keyDown})
+	fnd.update(key{kind: keyEnter})
+	assert.True(t, fnd.done)
+	assert.Equal(t, 1, fnd.picked)
+
+	fnd = newFinder(lines, "")
+	fnd.update(key{kind: keyEsc})
+	assert.True(t, fnd.done)
+	assert.Equal(t, -1, fnd.picked)
+
+	assert.Equal(t, []string{"> ", "  3/3", fmt.Sprintf(reverseVideo, "  2020-05-09 Closures"), "  2020-05-08 Go Closures"}, fnd.view(4, 40))
+}
+
+func Test_SplitCommandLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmdLine  string
+		expected []string
+	}{
+		{name: "with a plain command", cmdLine: "vim", expected: []string{"vim"}},
+		{name: "with arguments", cmdLine: "  code  --wait\t-n ", expected: []string{"code", "--wait", "-n"}},
+		{name: "with an empty command", cmdLine: " ", expected: []string{}},
+		{name: "with double quotes", cmdLine: `"/Applications/Sublime Text.app/subl" -w`, expected: []string{"/Applications/Sublime Text.app/subl", "-w"}},
+		{name: "with single quotes", cmdLine: `emacsclient -a '' -c`, expected: []string{"emacsclient", "-a", "", "-c"}},
+		{name: "with quotes inside an argument", cmdLine: `vim -c'set tw=72' --cmd="set nu"`, expected: []string{"vim", "-cset tw=72", "--cmd=set nu"}},
+		{name: "with escaped spaces and quotes", cmdLine: `my\ editor \"quoted\" 'it\'`, expected: []string{"my editor", `"quoted"`, `it\`}},
+		{name: "with a Windows path", cmdLine: `C:\Tools\edit.exe /wait`, expected: []string{`C:\Tools\edit.exe`, "/wait"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := src.SplitCommandLine(tt.cmdLine)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	_, err := src.SplitCommandLine(`code "--wait`)
+	assert.EqualError(t, err, `the command has a quote that isn't closed: code "--wait`)
+}
+
+func Test_EditorCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		editor   string
+		lineFlag string
+		line     int
+		expected []string
+	}{
+		{name: "without a line flag", editor: "vim", line: 7, expected: []string{"vim", "docs/a.md"}},
+		{name: "with vim", editor: "vim", lineFlag: "+{line}", line: 7, expected: []string{"vim", "+7", "docs/a.md"}},
+		{name: "with emacsclient", editor: "emacsclient -t", lineFlag: "+{line}", line: 7, expected: []string{"emacsclient", "-t", "+7", "docs/a.md"}},
+		{name: "with VS Code", editor: "code --wait", lineFlag: "--goto {file}:{line}", line: 7, expected: []string{"code", "--wait", "--goto", "docs/a.md:7"}},
+		{name: "without a line", editor: "vim", lineFlag: "+{line}", line: 0, expected: []string{"vim", "docs/a.md"}},
+		{name: "without an editor", editor: "", lineFlag: "+{line}", line: 7, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := pages.EditorCommand(tt.editor, tt.lineFlag, tt.line, "docs/a.md")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	_, err := pages.EditorCommand("vim", "'+{line}", 7, "docs/a.md")
+	assert.Error(t, err)
+}
+
+func Test_Page_StubBodyLine(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	page := newTestPage(t, "Zombies", docsDir, pages.PageOptions{FS: memFS, Tags: []string{"undead"}})
+
+	data, _ := memFS.ReadFile(page.FilePath)
+	lines := strings.Split(string(data), "\n")
+
+	// The line after the heading, counting from 1
+	assert.Equal(t, "# Zombies", lines[page.StubBodyLine()-2])
+	assert.Equal(t, "", lines[page.StubBodyLine()-1])
+	assert.Equal(t, len(lines)-1, page.StubBodyLine())
+}
+
+func Test_Page_Open(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test editor is a shell command")
+	}
+
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	filePath := filepath.Join(docsDir, "zombies.md")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("# Zombies\n"), 0644))
+
+	// The page is given to the editor after its own arguments
+	src.GlobalConfig.Set("editor", `sh -c 'echo "Brains." >> "$0"'`)
+
+	page := &pages.Page{FilePath: filePath}
+	assert.NoError(t, page.Open("false"))
+
+	data, _ := ioutil.ReadFile(filePath)
+	assert.Equal(t, "# Zombies\nBrains.\n", string(data))
+
+	src.GlobalConfig.Set("editor", `sh -c 'unclosed`)
+	assert.Error(t, page.Open("false"))
+}
+
+func Test_planImport(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	sourceDir, err := ioutil.TempDir("", "notes")
+	assert.NoError(t, err)
+	defer os.RemoveAll(sourceDir)
+
+	notes := map[string]string{
+		"zombies.md":             "# Zombies of the Night\n\nBrains.\n",
+		"go/go_closures.md":      "Closures capture variables.\n",
+		"go/deep/channels.md":    "---\ndate: 2020-05-07\ntags: [concurrency]\n---\n\n# Channels\n",
+		"already.md":             "---\ntitle: Already Here\ndate: 2020-05-07T10:00:00-07:00\ntags: astro, lava\n---\n\n# Already Here\n",
+		".obsidian/workspace.md": "# Hidden\n",
+		"picture.png":            "not a note",
+	}
+
+	for name, content := range notes {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+	}
+
+	mtime := time.Date(2019, 3, 4, 5, 6, 7, 0, time.Local)
+	for _, name := range []string{"zombies.md", "go/go_closures.md"} {
+		assert.NoError(t, os.Chtimes(filepath.Join(sourceDir, name), mtime, mtime))
+	}
+
+	// A page with the same name is already there
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "already.md"), []byte("# Taken\n"), 0644))
+
+	opts := importOptions{PageOptions: pages.PageOptions{FS: memFS, SlugMaxLength: 80}}
+
+	plan, err := planImport(context.Background(), sourceDir, docsDir, opts)
+	assert.NoError(t, err)
+
+	written := map[string]string{}
+	for _, note := range plan.notes {
+		rel, _ := filepath.Rel(sourceDir, note.source)
+		written[filepath.ToSlash(rel)] = filepath.Base(note.page.FilePath) + "\n" + string(note.content())
+	}
+
+	expected := map[string]string{
+		"already.md":          "already-2.md\n---\ndate: 2020-05-07T10:00:00-07:00\ntitle: Already Here\ntags: [astro, lava]\n---\n\n# Already Here\n",
+		"go/deep/channels.md": fmt.Sprintf("%s-channels.md\n---\ndate: %s\ntitle: Channels\ntags: [concurrency, go/deep]\n---\n\n# Channels\n", "2020-05-07T00-00-00", time.Date(2020, 5, 7, 0, 0, 0, 0, time.Local).Format(time.RFC3339)),
+		"go/go_closures.md":   fmt.Sprintf("2019-03-04T05-06-07-go-closures.md\n---\ndate: %s\ntitle: go closures\ntags: [go]\n---\n\n# go closures\n\nClosures capture variables.\n", mtime.Format(time.RFC3339)),
+		"zombies.md":          fmt.Sprintf("2019-03-04T05-06-07-zombies-of-the-night.md\n---\ndate: %s\ntitle: Zombies of the Night\n---\n\n# Zombies of the Night\n\nBrains.\n", mtime.Format(time.RFC3339)),
+	}
+
+	assert.Equal(t, expected, written)
+
+	// Nothing is written until the plan is carried out
+	_, err = memFS.ReadFile(filepath.Join(docsDir, "already-2.md"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Tags given to the import take the place of the sub-directories
+	opts.Tags = []string{"imported"}
+
+	plan, err = planImport(context.Background(), sourceDir, docsDir, opts)
+	assert.NoError(t, err)
+
+	for _, note := range plan.notes {
+		if strings.HasSuffix(note.source, "go_closures.md") {
+			assert.Equal(t, pages.TagsString("imported"), note.page.TagsStr)
+		}
+	}
+
+	// Two notes with the same title get different files
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(sourceDir, "zombies-2.md"), []byte("# Zombies of the Night\n"), 0644))
+	assert.NoError(t, os.Chtimes(filepath.Join(sourceDir, "zombies-2.md"), mtime, mtime))
+
+	plan, err = planImport(context.Background(), sourceDir, docsDir, opts)
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, note := range plan.notes {
+		if strings.Contains(note.page.FilePath, "zombies") {
+			names = append(names, filepath.Base(note.page.FilePath))
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"2019-03-04T05-06-07-zombies-of-the-night.md", "2019-03-04T05-06-07-zombies-of-the-night-2.md"}, names)
+
+	_, err = planImport(context.Background(), docsDir, docsDir, opts)
+	assert.Error(t, err)
+}
+
+func Test_planImport_Obsidian(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	vaultDir := filepath.Join("testdata", "obsidian")
+
+	// The pages' dates are in the tests' local time
+	date := func(year, month, day int) string {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local).Format(time.RFC3339)
+	}
+
+	plan, err := planImport(context.Background(), vaultDir, docsDir, importOptions{
+		Obsidian:    true,
+		PageOptions: pages.PageOptions{FS: memFS, OmitDate: true},
+	})
+	assert.NoError(t, err)
+
+	written := map[string]string{}
+	lossy := map[string][]string{}
+
+	for _, note := range plan.notes {
+		written[filepath.Base(note.page.FilePath)] = string(note.content())
+		lossy[filepath.Base(note.page.FilePath)] = note.lossy
+	}
+
+	expected := map[string]string{
+		"go-closures.md": "---\ndate: " + date(2021, 2, 3) + "\ntitle: Go Closures\ntags: [go, functional]\n---\n\n" +
+			"# Go Closures\n\n" +
+			"See [channels](channels-and-goroutines.md), [Projects/Lava Lamp > Wiring](lava-lamp.md#wiring), and Missing Note.\n\n" +
+			"`[[not a link]]`\n\n" +
+			"diagram.png\n",
+		"channels-and-goroutines.md": "---\ndate: " + date(2021, 6, 7) + "\ntitle: Channels and Goroutines\ntags: [Projects/Go]\n---\n\n" +
+			"# Channels\n\n" +
+			"Embedded: [Go Closures](go-closures.md)\n\n" +
+			"| note | link |\n| --- | --- |\n| closures | [closures](go-closures.md) |\n",
+		"lava-lamp.md": "---\ndate: " + date(2021, 4, 5) + "\ntitle: Lava Lamp\ntags: [hardware, electronics, Projects]\n---\n\n" +
+			"# Lava Lamp\n\n" +
+			"Wiring goes here.\n\n## Wiring\n\n" +
+			"See [Wiring](#wiring) and [go closures](go-closures.md).\n\n" +
+			"```\n[[in code]]\n```\n",
+	}
+
+	assert.Equal(t, expected, written)
+
+	assert.Equal(t, map[string][]string{
+		"go-closures.md": {
+			"front-matter aliases was left out",
+			"the link to Missing Note isn't imported, so it's plain text",
+			"the embedded diagram.png was left out",
+		},
+		"channels-and-goroutines.md": {"the embedded Go Closures is a link instead"},
+		"lava-lamp.md":               {"the link to block ^abc123 in go closures now goes to the page"},
+	}, lossy)
+
+	// The templates folder comes from the vault's settings
+	assert.Equal(t, []string{
+		filepath.Join(vaultDir, ".obsidian") + " (Obsidian's settings)",
+		filepath.Join(vaultDir, "Meta", "Templates") + " (Obsidian's templates)",
+		filepath.Join(vaultDir, "diagram.png") + " (not a Markdown note)",
+	}, plan.skipped)
+}
+
+func Test_planImport_Notion(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	exportDir := filepath.Join("testdata", "notion")
+	goNotes := filepath.Join(exportDir, "Go Notes 0a1b2c3d4e5f60718293a4b5c6d7e8f9")
+
+	// The pages' dates are in the tests' local time
+	date := func(year, month, day, hour, min int) string {
+		return time.Date(year, time.Month(month), day, hour, min, 0, 0, time.Local).Format(time.RFC3339)
+	}
+
+	plan, err := planImport(context.Background(), exportDir, docsDir, importOptions{
+		Notion:      true,
+		PageOptions: pages.PageOptions{FS: memFS, OmitDate: true},
+	})
+	assert.NoError(t, err)
+
+	written := map[string]string{}
+	lossy := map[string][]string{}
+	assets := map[string][]importedAsset{}
+
+	for _, note := range plan.notes {
+		written[filepath.Base(note.page.FilePath)] = string(note.content())
+		lossy[filepath.Base(note.page.FilePath)] = note.lossy
+		assets[filepath.Base(note.page.FilePath)] = note.assets
+	}
+
+	expected := map[string]string{
+		"go-notes.md": "---\ndate: " + date(2024, 5, 14, 9, 0) + "\ntitle: Go Notes\ntags: [go, notes]\n---\n\n" +
+			"# Go Notes\n\n" +
+			"Start with [Channels](channels.md). The reading list has more.\n\n" +
+			"See [the spec](https://go.dev/ref/spec) and Elsewhere.\n",
+		"channels.md": "---\ndate: " + date(2024, 3, 2, 0, 0) + "\ntitle: Channels\ntags: [go, concurrency, Go Notes]\n---\n\n" +
+			"# Channels\n\n" +
+			"They're typed pipes. Back to [Go Notes](go-notes.md).\n\n" +
+			"![Untitled](assets/channels/Untitled.png)\n\n" +
+			"Gone\n\n" +
+			"```go\n// [not a link](Channels%201b2c3d4e5f60718293a4b5c6d7e8f90a.md)\nch := make(chan int)\n```\n",
+		"the-go-memory-model.md": "---\ndate: " + date(2024, 4, 1, 10, 30) + "\ntitle: The Go Memory Model\ntags: [go, memory, Go Notes/Reading List]\nsource: https://go.dev/ref/mem\n---\n\n" +
+			"# The Go Memory Model\n\n" +
+			"Note: read it twice.\n",
+	}
+
+	assert.Equal(t, expected, written)
+
+	assert.Equal(t, map[string][]string{
+		"go-notes.md": {
+			"property Status was left out",
+			"the link to the database Go Notes/Reading List.csv is plain text, as its pages are imported without it",
+			"the link to Elsewhere.md isn't imported, so it's plain text",
+		},
+		"channels.md": {
+			"the link to a block in ../Go Notes.md now goes to the page",
+			"the picture Channels/gone.png isn't in the export, so it was left out",
+		},
+		"the-go-memory-model.md": {"property Rating was left out"},
+	}, lossy)
+
+	assert.Equal(t, []importedAsset{{
+		source:   filepath.Join(goNotes, "Channels 1b2c3d4e5f60718293a4b5c6d7e8f90a", "Untitled.png"),
+		filePath: filepath.Join(docsDir, "assets", "channels", "Untitled.png"),
+	}}, assets["channels.md"])
+
+	// The databases are read into the pages, and files no page links to
+	// aren't imported
+	assert.Equal(t, []string{
+		filepath.Join(goNotes, "Channels 1b2c3d4e5f60718293a4b5c6d7e8f90a", "Untitled 1.png") + " (not linked from any page)",
+	}, plan.skipped)
+
+	// Copying a page's files puts them in the assets directory
+	assert.NoError(t, copyAssets(assets["channels.md"]))
+
+	data, err := memFS.ReadFile(filepath.Join(docsDir, "assets", "channels", "Untitled.png"))
+	assert.NoError(t, err)
+	assert.Equal(t, "png", string(data))
+}
+
+func Test_splitNotionProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		props    []notionProperty
+		body     string
+	}{
+		{
+			name:     "a property block",
+			markdown: "# Title\n\nCreated: May 14, 2024\nTags: go\n\nBody\n",
+			props:    []notionProperty{{name: "Created", value: "May 14, 2024"}, {name: "Tags", value: "go"}},
+			body:     "# Title\n\nBody\n",
+		},
+		{
+			name:     "only properties",
+			markdown: "# Title\n\nTags: go",
+			props:    []notionProperty{{name: "Tags", value: "go"}},
+			body:     "# Title\n",
+		},
+		{
+			name:     "a paragraph that looks like one",
+			markdown: "# Title\n\nNote: not a property\n",
+			props:    []notionProperty{},
+			body:     "# Title\n\nNote: not a property\n",
+		},
+		{
+			name:     "properties running into a paragraph",
+			markdown: "# Title\n\nTags: go\nand more\n",
+			props:    []notionProperty{},
+			body:     "# Title\n\nTags: go\nand more\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			props, body := splitNotionProperties(tt.markdown)
+
+			assert.Equal(t, tt.props, props)
+			assert.Equal(t, tt.body, body)
+		})
+	}
+}
+
+func Test_parseNotionDate(t *testing.T) {
+	for value, expected := range map[string]time.Time{
+		"May 14, 2024 9:05 PM":        time.Date(2024, 5, 14, 21, 5, 0, 0, time.Local),
+		"May 14, 2024":                time.Date(2024, 5, 14, 0, 0, 0, 0, time.Local),
+		"2024/05/14 09:05":            time.Date(2024, 5, 14, 9, 5, 0, 0, time.Local),
+		"May 14, 2024 → May 20, 2024": time.Date(2024, 5, 14, 0, 0, 0, 0, time.Local),
+		"2024-05-14T09:05:00Z":        time.Date(2024, 5, 14, 9, 5, 0, 0, time.UTC),
+	} {
+		date, ok := parseNotionDate(value)
+
+		assert.True(t, ok, value)
+		assert.True(t, expected.Equal(date), value)
+	}
+
+	_, ok := parseNotionDate("someday")
+	assert.False(t, ok)
+
+	assert.Equal(t, "Go Notes/Reading List.csv", notionPath("Go Notes 0a1b2c3d4e5f60718293a4b5c6d7e8f9/Reading List 2c3d4e5f60718293a4b5c6d7e8f90a1b.csv"))
+}
+
+func Test_parseBookmarks(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "bookmarks.html"))
+	assert.NoError(t, err)
+
+	bookmarks, err := parseBookmarks(string(data))
+	assert.NoError(t, err)
+
+	type parsed struct {
+		date        int64
+		description string
+		tag         string
+		title       string
+		url         string
+	}
+
+	actual := []parsed{}
+	for _, bkm := range bookmarks {
+		actual = append(actual, parsed{bkm.addDate.Unix(), bkm.description, bookmarkFolderTag(bkm.folders), bkm.title, bkm.url})
+	}
+
+	expected := []parsed{
+		{1588881600, "", "", "The Go Blog", "https://go.dev/blog/"},
+		{1589000000, "How goroutines get run.", "TIL", "Go's scheduler & you", "https://example.com/scheduler"},
+		{1589500000, "", "TIL/Lava - Lamps", "", "https://example.com/lava"},
+		{1589600000, "", "TIL", "Zombies", "https://example.com/zombies"},
+		{1589700000, "", "", "A bookmarklet", "javascript:alert('hi')"},
+		{1589800000, "", "", "The scheduler, again", "https://example.com/scheduler"},
+	}
+
+	assert.Equal(t, expected, actual)
+
+	_, err = parseBookmarks("<html><a href=\"https://example.com\">Nope</a></html>")
+	assert.EqualError(t, err, errBookmarksFormat)
+
+	// Dates in milliseconds or microseconds are brought back to seconds
+	assert.Equal(t, int64(1589000000), bookmarkDate("1589000000000").Unix())
+	assert.Equal(t, int64(1589000000), bookmarkDate("1589000000000000").Unix())
+	assert.True(t, bookmarkDate("").IsZero())
+}
+
+func Test_planBookmarks(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	filePath := filepath.Join("testdata", "bookmarks.html")
+	opts := importOptions{PageOptions: pages.PageOptions{FS: memFS, OmitDate: true}}
+
+	// The Go blog already has a page
+	existing := []*pages.Page{{FilePath: filepath.Join(docsDir, "go-blog.md"), Source: "https://go.dev/blog/"}}
+
+	plan, err := planBookmarks(context.Background(), filePath, docsDir, opts, existing)
+	assert.NoError(t, err)
+
+	written := map[string]string{}
+	for _, note := range plan.notes {
+		written[filepath.Base(note.page.FilePath)] = string(note.content())
+	}
+
+	date := func(secs int64) string { return time.Unix(secs, 0).Format(time.RFC3339) }
+
+	expected := map[string]string{
+		"gos-scheduler-you.md": "---\ndate: " + date(1589000000) + "\ntitle: Go's scheduler & you\ntags: [TIL]\nsource: https://example.com/scheduler\n---\n\n" +
+			"# Go's scheduler & you\n\n[https://example.com/scheduler](https://example.com/scheduler)\n\nHow goroutines get run.\n",
+		"https-example-com-lava.md": "---\ndate: " + date(1589500000) + "\ntitle: https://example.com/lava\ntags: [TIL/Lava - Lamps]\nsource: https://example.com/lava\n---\n\n" +
+			"# https://example.com/lava\n\n[https://example.com/lava](https://example.com/lava)\n",
+		"zombies.md": "---\ndate: " + date(1589600000) + "\ntitle: Zombies\ntags: [TIL]\nsource: https://example.com/zombies\n---\n\n" +
+			"# Zombies\n\n[https://example.com/zombies](https://example.com/zombies)\n",
+	}
+
+	assert.Equal(t, expected, written)
+
+	// The Go blog, and the scheduler's second bookmark
+	assert.Equal(t, 2, plan.duplicates)
+	assert.Equal(t, []string{"javascript:alert('hi') (not a web page)"}, plan.skipped)
+
+	// A folder becomes a single page of links
+	opts.Folder = "til"
+
+	plan, err = planBookmarks(context.Background(), filePath, docsDir, opts, existing)
+	assert.NoError(t, err)
+
+	assert.Len(t, plan.notes, 1)
+	assert.Equal(t, "til.md", filepath.Base(plan.notes[0].page.FilePath))
+	assert.Equal(t, "---\ndate: "+date(1588000100)+"\ntitle: TIL\ntags: [TIL]\n---\n\n"+
+		"# TIL\n\n"+
+		"* [Go's scheduler & you](https://example.com/scheduler) - How goroutines get run.\n"+
+		"* [https://example.com/lava](https://example.com/lava)\n"+
+		"* [Zombies](https://example.com/zombies)\n", string(plan.notes[0].content()))
+
+	opts.Folder = "Nope"
+
+	_, err = planBookmarks(context.Background(), filePath, docsDir, opts, existing)
+	assert.Error(t, err)
+}
+
+func Test_htmlTitle(t *testing.T) {
+	tests := []struct {
+		name        string
+		html        string
+		contentType string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "with a title",
+			html:     "<html><head><title>\n  Go&#39;s scheduler &amp;\n  you </title></head></html>",
+			expected: "Go's scheduler & you",
+		},
+		{
+			name:     "with only an og:title",
+			html:     `<head><meta content="Zombies &quot;101&quot;" property='og:title'><title></title></head>`,
+			expected: `Zombies "101"`,
+		},
+		{
+			name:        "in latin1, from the Content-Type",
+			html:        "<title>Caf\xe9 \x93lava\x94</title>",
+			contentType: "text/html; charset=ISO-8859-1",
+			expected:    "Café “lava”",
+		},
+		{
+			name:     "in windows-1252, from a meta tag",
+			html:     "<meta charset=\"windows-1252\"><title>Don\x92t panic</title>",
+			expected: "Don’t panic",
+		},
+		{
+			name:     "in UTF-8 that isn't valid",
+			html:     "<title>Caf\xe9</title>",
+			expected: "Café",
+		},
+		{
+			name:        "without a title",
+			html:        "<html><body>Nope</body></html>",
+			expectedErr: errFetchNoTitle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := htmlTitle([]byte(tt.html), tt.contentType)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_fetchTitle(t *testing.T) {
+	defer func(client httpDoer) { httpClient = client }(httpClient)
+
+	client := &fakeHTTPClient{status: http.StatusOK, body: "<title>Zombies</title>"}
+	httpClient = client
+
+	title, err := fetchTitle(context.Background(), "https://example.com/zombies")
+	assert.NoError(t, err)
+	assert.Equal(t, "Zombies", title)
+	assert.Equal(t, "https://example.com/zombies", client.requests[0].URL.String())
+
+	// Only so much of the page is read for the title
+	client.body = strings.Repeat(" ", fetchMaxBytes) + "<title>Too far</title>"
+
+	_, err = fetchTitle(context.Background(), "https://example.com/zombies")
+	assert.EqualError(t, err, errFetchNoTitle)
+
+	client.status = http.StatusNotFound
+
+	_, err = fetchTitle(context.Background(), "https://example.com/zombies")
+	assert.EqualError(t, err, "https://example.com/zombies answered 404 Not Found")
+}
+
+func Test_fromURLPage(t *testing.T) {
+	defer func(fetcher func(context.Context, string) (string, error)) { pageTitleFetcher = fetcher }(pageTitleFetcher)
+
+	pageTitleFetcher = func(ctx context.Context, rawURL string) (string, error) {
+		return "Go's Scheduler: A Tour", nil
+	}
+
+	title, opts, err := fromURLPage(context.Background(), "https://example.com/scheduler", "", pages.PageOptions{Tags: []string{"reading"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Go's Scheduler: A Tour", title)
+	assert.Equal(t, pages.PageOptions{
+		Body:   "[Go's Scheduler: A Tour](https://example.com/scheduler)\n",
+		Source: "https://example.com/scheduler",
+		Tags:   []string{"reading"},
+	}, opts)
+
+	// A title given for the page is used instead
+	title, _, err = fromURLPage(context.Background(), "https://example.com/scheduler", "Scheduling", pages.PageOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Scheduling", title)
+
+	// When the fetch fails, the page is titled with the URL
+	pageTitleFetcher = func(ctx context.Context, rawURL string) (string, error) {
+		return "", errors.New("no network")
+	}
+
+	title, _, err = fromURLPage(context.Background(), "https://example.com/scheduler", "", pages.PageOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/scheduler", title)
+
+	_, _, err = fromURLPage(context.Background(), "example.com/scheduler", "", pages.PageOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, src.ExitUsage, src.ExitCode(err))
+}
+
+func Test_NewPage_Source(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	page := newTestPage(t, "Go's Scheduler: A Tour", docsDir, pages.PageOptions{
+		Body:   "[Go's Scheduler: A Tour](https://example.com/scheduler)\n",
+		FS:     memFS,
+		Source: "https://example.com/scheduler",
+		Tags:   []string{"reading"},
+	})
+
+	data, _ := memFS.ReadFile(page.FilePath)
+
+	// The title needs quoting, because of its colon
+	expected := fmt.Sprintf("---\ndate: %s\ntitle: 'Go''s Scheduler: A Tour'\ntags: reading\nsource: https://example.com/scheduler\n---\n\n"+
+		"# Go's Scheduler: A Tour\n\n[Go's Scheduler: A Tour](https://example.com/scheduler)\n", page.Date)
+
+	assert.Equal(t, expected, string(data))
+
+	read, err := pages.ReadPageFS(memFS, page.FilePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "Go's Scheduler: A Tour", read.Title)
+	assert.Equal(t, "https://example.com/scheduler", read.Source)
+}
+
+func Test_pageHTML(t *testing.T) {
+	page, err := pages.ReadPage(filepath.Join("testdata", "export", "20210426T09-30-00-go-closures.md"))
+	assert.NoError(t, err)
+
+	t.Run("with the image inlined", func(t *testing.T) {
+		actual, warnings := pageHTML(page, filepath.Join("testdata", "export"), defaultHTMLImageMaxBytes)
+
+		assert.Empty(t, warnings)
+		assertGolden(t, filepath.Join("testdata", "export", "go-closures.html"), actual)
+	})
+
+	t.Run("with the image too big to inline", func(t *testing.T) {
+		actual, warnings := pageHTML(page, filepath.Join("testdata", "export"), 16)
+
+		assert.Equal(t, []string{"the image images/counter.png is 1 KB, more than the 0 KB that's inlined, so it's left as a link"}, warnings)
+		assert.Contains(t, actual, `<img src="images/counter.png" alt="The counter in a debugger" title="Stepping through">`)
+	})
+
+	t.Run("with a missing image", func(t *testing.T) {
+		missing := &pages.Page{Content: "![gone](/images/gone.png)\n", FilePath: filepath.Join("testdata", "export", "missing.md"), Title: "Missing"}
+
+		actual, warnings := pageHTML(missing, filepath.Join("testdata", "export"), defaultHTMLImageMaxBytes)
+
+		assert.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "couldn't inline the image /images/gone.png")
+		assert.Contains(t, actual, `<img src="/images/gone.png" alt="gone">`)
+		assert.NotContains(t, actual, "<time")
+	})
+}
+
+func Test_exportBody(t *testing.T) {
+	page := &pages.Page{Content: "#+TITLE: Zombies\n\n* Slow\n", FilePath: "docs/zombies.org", Title: "Zombies"}
+
+	assert.Equal(t, "This page is written in Org, so here it is as it is, from `zombies.org`:\n\n"+
+		"```org\n#+TITLE: Zombies\n\n* Slow\n```\n", exportBody(page))
+
+	page = &pages.Page{Content: "\n# Zombies\n\nThey're slow.\n", FilePath: "docs/zombies.md", Title: "Zombies"}
+
+	assert.Equal(t, "They're slow.\n", exportBody(page))
+}
+
+func Test_htmlFileName(t *testing.T) {
+	assert.Equal(t, "go-closures.html", htmlFileName(&pages.Page{Title: "Go Closures"}))
+}
+
+func Test_RenderHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{
+			name:     "with a heading",
+			markdown: "## Why it works",
+			expected: "<h2 id=\"why-it-works\">Why it works</h2>\n",
+		},
+		{
+			name:     "with emphasis and code",
+			markdown: "Use **bold**, *em*, ~~old~~, and `a < b`",
+			expected: "<p>Use <strong>bold</strong>, <em>em</em>, <del>old</del>, and <code>a &lt; b</code></p>\n",
+		},
+		{
+			name:     "with a nested list",
+			markdown: "1. one\n2. two\n   * nested",
+			expected: "<ol>\n<li>one</li>\n<li>two\n<ul>\n<li>nested</li>\n</ul></li>\n</ol>\n",
+		},
+		{
+			name:     "with a block quote",
+			markdown: "> quoted\n> text",
+			expected: "<blockquote>\n<p>quoted\ntext</p>\n</blockquote>\n",
+		},
+		{
+			name:     "with code in a language it doesn't know",
+			markdown: "```brainfuck\n<+>\n```",
+			expected: "<pre><code class=\"language-brainfuck\">&lt;+&gt;</code></pre>\n",
+		},
+		{
+			name:     "with HTML in the text",
+			markdown: "<script>alert(1)</script>",
+			expected: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pages.RenderHTML(tt.markdown, pages.HTMLOptions{}))
+		})
+	}
+}
+
+func Test_HighlightCode(t *testing.T) {
+	assert.Equal(t,
+		`<span class="k">return</span> <span class="s">&#34;a&#34;</span> <span class="c">// done</span>`,
+		pages.HighlightCode(`return "a" // done`, "go"),
+	)
+	assert.Equal(t,
+		`x = <span class="n">42</span> <span class="c"># answer</span>`,
+		pages.HighlightCode(`x = 42 # answer`, "python"),
+	)
+	assert.Equal(t, "return &lt;x&gt;", pages.HighlightCode("return <x>", "cobol"))
+}
+
+func Test_pandocArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"--from", "markdown", "--standalone", "--to", "docx", "--output", "/out/go-closures.docx", "/tmp/in.md"},
+		pandocArgs("docx", "/tmp/in.md", "/out/go-closures.docx"),
+	)
+
+	// pandoc works out that it's a PDF from the extension
+	assert.Equal(t,
+		[]string{"--from", "markdown", "--standalone", "--output", "/out/til.pdf", "/tmp/in.md"},
+		pandocArgs("pdf", "/tmp/in.md", "/out/til.pdf"),
+	)
+}
+
+func Test_pandocFileName(t *testing.T) {
+	assert.Equal(t, "go-closures.epub", pandocFileName("Go Closures", "epub"))
+	assert.Equal(t, "go-closures.tex", pandocFileName("Go Closures", "latex"))
+}
+
+func Test_pandocBook(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Content: "# Zombies\n\nThey're slow.\n", Date: "2021-05-02T10:00:00Z", FilePath: "b.md", TagsStr: "horror, go", Title: "Zombies"},
+		{Content: "# Go Closures\n\nThey capture variables.\n", Date: "2021-04-26T09:30:00Z", FilePath: "a.md", TagsStr: "go", Title: "Go Closures"},
+	}
+
+	actual, err := pandocBook(pageSet)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "---\ntitle: TIL\ndate: \"2021-05-02\"\nkeywords:\n- go\n- horror\n---\n\n"+
+		"# Go Closures\n\n*Apr 26, 2021*\n\nThey capture variables.\n\n"+
+		"# Zombies\n\n*May 02, 2021*\n\nThey're slow.\n\n", actual)
+
+	_, err = pandocBook([]*pages.Page{})
+	assert.Error(t, err)
+}
+
+func Test_exportPandoc(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	assert.NoError(t, memFS.WriteFile(
+		filepath.Join(docsDir, "20210426T09-30-00-go-closures.md"),
+		[]byte("---\ndate: 2021-04-26T09:30:00Z\ntitle: Go Closures\ntags: go\n---\n\n# Go Closures\n\nThey capture variables.\n"),
+		0644,
+	))
+
+	defer func(finder func(string) (string, error)) { lookPath = finder }(lookPath)
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	outPath := filepath.Join(filepath.Dir(docsDir), "closures.docx")
+
+	t.Run("with pandoc installed", func(t *testing.T) {
+		lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+
+		var dir, input string
+		var args []string
+
+		runCommand = func(cmdDir string, name string, cmdArgs ...string) ([]byte, error) {
+			data, err := ioutil.ReadFile(cmdArgs[len(cmdArgs)-1])
+			assert.NoError(t, err)
+
+			dir, input, args = cmdDir, string(data), cmdArgs
+			return nil, nil
+		}
+
+		err := exportPandoc(context.Background(), "closures", false, "docx", outPath)
+		assert.NoError(t, err)
+
+		assert.Equal(t, docsDir, dir)
+		assert.Equal(t, []string{"--from", "markdown", "--standalone", "--to", "docx", "--output", outPath}, args[:len(args)-1])
+		assert.Equal(t, "---\ntitle: Go Closures\ndate: \"2021-04-26\"\nkeywords:\n- go\n---\n\nThey capture variables.\n", input)
+	})
+
+	t.Run("when pandoc fails", func(t *testing.T) {
+		lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+		runCommand = func(cmdDir string, name string, cmdArgs ...string) ([]byte, error) {
+			return []byte("Unknown output format nope\n"), errors.New("exit status 22")
+		}
+
+		err := exportPandoc(context.Background(), "closures", false, "nope", outPath)
+		assert.EqualError(t, err, "pandoc couldn't export to nope: Unknown output format nope")
+	})
+
+	t.Run("without pandoc", func(t *testing.T) {
+		lookPath = func(file string) (string, error) { return "", exec.ErrNotFound }
+
+		err := exportPandoc(context.Background(), "closures", false, "docx", outPath)
+		assert.EqualError(t, err, errPandocMissing)
+	})
+
+	t.Run("without a format", func(t *testing.T) {
+		err := exportPandoc(context.Background(), "closures", false, "", outPath)
+		assert.EqualError(t, err, errPandocFormat)
+	})
+}
+
+func Test_searchPages(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "a.md"), []byte("---\ndate: 2020-05-07T13:13:08-07:00\ntitle: Arrays\n---\n\nFixed size.\n"), 0644))
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "b.md"), []byte("---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Slices\ntags: go\n---\n\nGrow with append.\n"), 0644))
+
+	updateSearchIndex(context.Background())
+
+	_, err := memFS.Stat(filepath.Join(docsDir, til.SearchIndexDir, til.SearchIndexFile))
+	assert.NoError(t, err)
+
+	q, results, err := searchPages(context.Background(), "append")
+	assert.NoError(t, err)
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Slices", results[0].Page.Title)
+
+	mark := func(word string) string { return "**" + word + "**" }
+	assert.Equal(t, []string{"May 08, 2020  Slices  (" + filepath.Join(docsDir, "b.md") + ")", "    Grow with **append.**"}, searchLines(q, results, mark))
+
+	_, results, err = searchPages(context.Background(), "tag:go OR fixed")
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	_, _, err = searchPages(context.Background(), "tag:go AND")
+
+	var usageErr *src.UsageError
+	assert.True(t, errors.As(err, &usageErr))
+}
+
+func Test_pageResults(t *testing.T) {
+	results := []til.SearchResult{}
+	for _, title := range []string{"A", "B", "C", "D", "E"} {
+		results = append(results, til.SearchResult{Page: &pages.Page{Title: title}})
+	}
+
+	titles := func(results []til.SearchResult) []string {
+		titles := []string{}
+		for _, result := range results {
+			titles = append(titles, result.Page.Title)
+		}
+
+		return titles
+	}
+
+	tests := []struct {
+		name     string
+		offset   int
+		limit    int
+		expected []string
+	}{
+		{name: "with neither", expected: []string{"A", "B", "C", "D", "E"}},
+		{name: "with a limit", limit: 2, expected: []string{"A", "B"}},
+		{name: "with an offset", offset: 3, expected: []string{"D", "E"}},
+		{name: "with both", offset: 1, limit: 2, expected: []string{"B", "C"}},
+		{name: "with a limit past the end", offset: 4, limit: 2, expected: []string{"E"}},
+		{name: "with an offset past the end", offset: 5, limit: 2, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, titles(pageResults(results, tt.offset, tt.limit)))
+		})
+	}
+}
+
+func Test_parseQuery(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2024-03-01T10:00:00Z", FilePath: "docs/a.md", TagsStr: "go, til-meta", Title: "About"},
+		{Date: "2024-02-01T10:00:00Z", FilePath: "docs/b.md", TagsStr: "go", Title: "Slices"},
+		{Date: "2023-02-01T10:00:00Z", FilePath: "docs/c.md", TagsStr: "go", Title: "Arrays"},
+	}
+
+	q, err := parseQuery("tag:go AND NOT tag:til-meta AND after:2024-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Feb 01, 2024  Slices  (docs/b.md)"}, listPages(til.FilterPages(pageSet, q), ""))
+
+	_, err = parseQuery("tag:go AND (")
+
+	var usageErr *src.UsageError
+	assert.True(t, errors.As(err, &usageErr))
+	assert.Contains(t, err.Error(), "at column 12")
+}
+
+func Test_onThisDay(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2024-05-14T09:00:00Z", FilePath: "docs/f.md", Title: "This year"},
+		{Date: "2023-05-14T23:30:00-07:00", FilePath: "docs/e.md", Title: "Late"},
+		{Date: "2023-05-14T09:00:00Z", FilePath: "docs/d.md", Title: "Sets"},
+		{Date: "2021-05-14T09:00:00Z", FilePath: "docs/c.md", Title: "Maps"},
+		{Date: "2021-05-13T09:00:00Z", FilePath: "docs/b.md", Title: "The day before"},
+		{Date: "2020-02-29T09:00:00Z", FilePath: "docs/a.md", Title: "Leap day"},
+		{Date: "2019-02-28T09:00:00Z", FilePath: "docs/z.md", Title: "Feb 28"},
+		{Date: "2019-03-01T09:00:00Z", FilePath: "docs/y.md", Title: "Mar 1"},
+		{Date: "2016-03-01T09:00:00Z", FilePath: "docs/x.md", Title: "Mar 1 in a leap year"},
+		{FilePath: "docs/index.md"},
+	}
+
+	titles := func(found []*pages.Page) []string {
+		titles := []string{}
+		for _, page := range found {
+			titles = append(titles, page.Title)
+		}
+
+		return titles
+	}
+
+	tests := []struct {
+		name     string
+		day      monthDay
+		year     int
+		leap     bool
+		expected []string
+	}{
+		{
+			name:     "with earlier years",
+			day:      monthDay{month: time.May, day: 14},
+			year:     2024,
+			expected: []string{"Sets", "Maps"},
+		},
+		{
+			name:     "with nothing on the day",
+			day:      monthDay{month: time.June, day: 1},
+			year:     2024,
+			expected: []string{},
+		},
+		{
+			name:     "with Feb 29",
+			day:      monthDay{month: time.February, day: 29},
+			year:     2024,
+			expected: []string{"Leap day"},
+		},
+		{
+			name:     "with Feb 29 and leap",
+			day:      monthDay{month: time.February, day: 29},
+			year:     2024,
+			leap:     true,
+			expected: []string{"Leap day", "Feb 28", "Mar 1"},
+		},
+		{
+			name:     "with Feb 28 and leap in a year without Feb 29",
+			day:      monthDay{month: time.February, day: 28},
+			year:     2023,
+			leap:     true,
+			expected: []string{"Leap day", "Feb 28"},
+		},
+		{
+			name:     "with Feb 28 and leap in a leap year",
+			day:      monthDay{month: time.February, day: 28},
+			year:     2024,
+			leap:     true,
+			expected: []string{"Feb 28"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, titles(onThisDay(pageSet, tt.day, tt.year, time.UTC, tt.leap)))
+		})
+	}
+
+	// The late page is on the 15th in UTC, but on the 14th where it was written
+	loc := time.FixedZone("PDT", -7*60*60)
+	assert.Equal(t, []string{"Late", "Sets", "Maps"}, titles(onThisDay(pageSet, monthDay{month: time.May, day: 14}, 2024, loc, false)))
+}
+
+func Test_onThisDayLines(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2023-05-14T09:00:00Z", FilePath: "docs/d.md", Title: "Sets"},
+		{Date: "2023-05-14T08:00:00Z", FilePath: "docs/c.md", Title: "Maps"},
+		{Date: "2021-05-14T09:00:00Z", FilePath: "docs/b.md", Title: "Slices"},
+	}
+
+	expected := []string{
+		"2023",
+		"May 14, 2023  Sets  (docs/d.md)",
+		"May 14, 2023  Maps  (docs/c.md)",
+		"",
+		"2021",
+		"May 14, 2021  Slices  (docs/b.md)",
+	}
+
+	assert.Equal(t, expected, onThisDayLines(pageSet, time.UTC))
+}
+
+func Test_parseMonthDay(t *testing.T) {
+	day, err := parseMonthDay("02-29")
+	assert.NoError(t, err)
+	assert.Equal(t, monthDay{month: time.February, day: 29}, day)
+
+	for _, raw := range []string{"14-05", "02-30", "5-14", "May 14"} {
+		_, err = parseMonthDay(raw)
+		assert.EqualError(t, err, fmt.Sprintf("--date needs a month and day, like 05-14, not '%s'", raw))
+	}
+}
+
+func Test_configuredLocation(t *testing.T) {
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte(""))
+
+	loc, err := configuredLocation()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Local, loc)
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("timezone: UTC\n"))
+
+	loc, err = configuredLocation()
+	assert.NoError(t, err)
+	assert.Equal(t, "UTC", loc.String())
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("timezone: Mars/Olympus_Mons\n"))
+
+	_, err = configuredLocation()
+	assert.Error(t, err)
+}
+
+func Test_activityCalendar(t *testing.T) {
+	// Friday Mar 15, 2024. A year earlier, the day after is Thursday Mar 16,
+	// 2023, so the first week starts on Sunday Mar 12
+	end := time.Date(2024, 3, 15, 18, 30, 0, 0, time.UTC)
+	counts := map[string]int{"2023-03-16": 1, "2024-03-15": 5, "2024-03-14": 2, "2023-03-15": 9}
+
+	cal := activityCalendar(counts, end)
+
+	assert.Len(t, cal.weeks, 53)
+
+	first := cal.weeks[0]
+	assert.Equal(t, "2023-03-12", first[time.Sunday].date.Format(activityDayFormat))
+	assert.False(t, first[time.Wednesday].inRange)
+	assert.Equal(t, 0, first[time.Wednesday].count, "the day a year before end is left out")
+	assert.True(t, first[time.Thursday].inRange)
+	assert.Equal(t, 1, first[time.Thursday].count)
+
+	last := cal.weeks[52]
+	assert.Equal(t, "2024-03-10", last[time.Sunday].date.Format(activityDayFormat))
+	assert.Equal(t, 2, last[time.Thursday].count)
+	assert.Equal(t, 5, last[time.Friday].count)
+	assert.False(t, last[time.Saturday].inRange)
+
+	// Every month from March to March, over the first week with its days
+	labels := []string{}
+	for _, month := range cal.months {
+		labels = append(labels, fmt.Sprintf("%s@%d", month.label, month.week))
+	}
+
+	assert.Equal(t, []string{
+		"Mar@0", "Apr@2", "May@7", "Jun@11", "Jul@15", "Aug@20", "Sep@24",
+		"Oct@29", "Nov@33", "Dec@37", "Jan@42", "Feb@46", "Mar@50",
+	}, labels)
+}
+
+func Test_calendar_String(t *testing.T) {
+	// Sunday Jan 7, 2024 ends a calendar whose first week is cut short by the
+	// start, Jan 8, 2023, and whose last week is only a day long
+	cal := activityCalendar(map[string]int{"2023-01-08": 1, "2023-01-09": 2, "2023-01-10": 3, "2023-01-11": 4, "2023-01-12": 7}, time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC))
+
+	lines := strings.Split(strings.TrimRight(cal.String(), "\n"), "\n")
+	assert.Len(t, lines, 8)
+
+	// Feb starts in the fourth week, too close to Jan to be labelled
+	assert.Equal(t, "    Jan    Mar Apr  May Jun Jul  Aug Sep  Oct Nov Dec  Jan", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "    ░·"), lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], "Mon ▒·"), lines[2])
+	assert.True(t, strings.HasPrefix(lines[3], "    ▓·"), lines[3])
+	assert.True(t, strings.HasPrefix(lines[4], "Wed █·"), lines[4])
+	assert.True(t, strings.HasPrefix(lines[5], "    █·"), lines[5])
+	assert.Equal(t, 4+53, utf8.RuneCountInString(lines[1]))
+	assert.Equal(t, 4+52, utf8.RuneCountInString(lines[2]))
+}
+
+func Test_pageActivity(t *testing.T) {
+	today := time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+
+	pageSet := []*pages.Page{
+		{Date: "2024-03-14T10:00:00Z", Title: "A"},
+		{Date: "2024-03-13T10:00:00Z", Title: "B"},
+		{Date: "2024-03-13T08:00:00Z", Title: "C"},
+		{Date: "2024-03-12T10:00:00Z", Title: "D"},
+		{Date: "2024-02-03T10:00:00Z", Title: "E"},
+		{Date: "2024-02-02T10:00:00Z", Title: "F"},
+		{Date: "2024-02-01T10:00:00Z", Title: "G"},
+		{Date: "2024-01-31T10:00:00Z", Title: "H"},
+		{Date: "2024-01-30T10:00:00Z", Title: "I"},
+		{Date: "2024-01-05T10:00:00Z", Title: "J"},
+		{Date: "2024-01-05T09:00:00Z", Title: "K"},
+		{FilePath: "docs/index.md"},
+	}
+
+	act := pageActivity(pageSet, today, time.UTC)
+
+	assert.Equal(t, 11, act.total)
+	assert.Equal(t, 3, act.currentStreak, "nothing yet today, so the streak runs up to yesterday")
+	assert.Equal(t, 5, act.longestStreak)
+	assert.Equal(t, "2024-01-30", act.longestStreakStart.Format(activityDayFormat))
+	assert.Equal(t, "2024-02-03", act.longestStreakEnd.Format(activityDayFormat))
+	assert.Equal(t, "2024-01-05", act.busiestDay.Format(activityDayFormat), "a tie goes to the earliest day")
+	assert.Equal(t, 2, act.busiestCount)
+
+	assert.Equal(t, []string{
+		"Current streak: 3 days",
+		"Longest streak: 5 days, Jan 30, 2024 to Feb 03, 2024",
+		"Busiest day: Jan 05, 2024, with 2 pages",
+	}, activitySummary(act))
+
+	// A day without pages breaks the streak
+	act = pageActivity(pageSet, today.AddDate(0, 0, 2), time.UTC)
+	assert.Equal(t, 0, act.currentStreak)
+
+	// The days are the days in the timezone, where it's already Mar 15
+	act = pageActivity([]*pages.Page{{Date: "2024-03-14T20:00:00Z", Title: "A"}}, today, time.FixedZone("JST", 9*60*60))
+	assert.Equal(t, map[string]int{"2024-03-15": 1}, act.counts)
+	assert.Equal(t, 1, act.currentStreak)
+
+	assert.Equal(t, []string{"Current streak: 0 days", "Longest streak: 0 days"}, activitySummary(pageActivity(nil, today, time.UTC)))
+}
+
+func Test_buildActivityPage(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	defer func(now func() time.Time) { pages.Now = now }(pages.Now)
+	pages.Now = func() time.Time { return time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC) }
+
+	src.GlobalConfig.Set("timezone", "UTC")
+
+	pageSet := []*pages.Page{{Date: "2024-03-15T08:00:00Z", Title: "A"}}
+
+	assert.NoError(t, buildActivityPage(context.Background(), pageSet, pages.NewTagMap(pageSet), docsDir, newBuildWarnings()))
+
+	data, err := memFS.ReadFile(filepath.Join(docsDir, "activity.md"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), pages.GeneratedMarker+"\n## Activity\n\n```text\n    Mar"), string(data))
+	assert.Contains(t, string(data), "```\n\n* Current streak: 1 day\n* Longest streak: 1 day, Mar 15, 2024 to Mar 15, 2024\n* Busiest day: Mar 15, 2024, with 1 page\n")
+
+	assert.Equal(t, src.JSONStats{
+		Version:       src.JSONVersion,
+		Pages:         1,
+		CurrentStreak: 1,
+		LongestStreak: 1,
+		BusiestDay:    "2024-03-15",
+		BusiestCount:  1,
+	}, statsJSON(pageActivity(pageSet, pages.Now(), time.UTC)))
+}
+
+func Test_CountWords(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		words     int
+		codeLines int
+	}{
+		{name: "empty", content: "", words: 0, codeLines: 0},
+		{name: "prose", content: "# Go closures\n\nA closure is a function value.\n", words: 8, codeLines: 0},
+		{name: "markup isn't words", content: "* one\n- two\n1. three\n> four -- five\n---\n", words: 5, codeLines: 0},
+		{name: "links are their text", content: "See [the Go docs](https://go.dev/doc/) and ![a chart](chart.png).\n", words: 7, codeLines: 0},
+		{
+			name:      "code blocks are lines",
+			content:   "Count up:\n\n```go\nfunc counter() func() int {\n\n\tn := 0\n\treturn func() int { n++; return n }\n}\n```\n\nThat's it.\n",
+			words:     4,
+			codeLines: 4,
+		},
+		{
+			name:      "tilde fences",
+			content:   "Before\n~~~\n``` isn't the end\nstill code\n~~~\nafter\n",
+			words:     2,
+			codeLines: 2,
+		},
+		{name: "unclosed fence", content: "Before\n```\nall code from here\nto the end\n", words: 1, codeLines: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, codeLines := pages.CountWords(tt.content)
+
+			assert.Equal(t, tt.words, words)
+			assert.Equal(t, tt.codeLines, codeLines)
+
+			page := &pages.Page{Content: tt.content}
+			assert.Equal(t, tt.words, page.WordCount())
+			assert.Equal(t, tt.codeLines, page.CodeLines())
+		})
+	}
+}
+
+func Test_measureWords(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Date: "2024-03-14T10:00:00Z", Title: "A", FilePath: "docs/a.md", Content: "one two three"},
+		{Date: "2024-01-02T10:00:00Z", Title: "B", FilePath: "docs/b.md", Content: "one two three four five six\n```\ncode\ncode\n```\n"},
+		{Date: "2023-12-31T20:00:00Z", Title: "C", FilePath: "docs/c.md", Content: "one"},
+		{Date: "2023-06-01T10:00:00Z", Title: "D", FilePath: "docs/d.md", Content: "one two three"},
+		{Date: "2022-06-01T10:00:00Z", Title: "E", FilePath: "docs/e.md", Content: "one two"},
+		{Date: "2021-06-01T10:00:00Z", Title: "F", FilePath: "docs/f.md", Content: ""},
+		{Title: "G", FilePath: "docs/g.md", Content: "one two three four"},
+		{FilePath: "docs/index.md", Content: "lots and lots of words that aren't counted"},
+	}
+
+	stats := measureWords(pageSet, time.UTC)
+
+	assert.Equal(t, 7, stats.entries)
+	assert.Equal(t, 19, stats.words)
+	assert.Equal(t, 2, stats.codeLines)
+
+	longest := []string{}
+	for _, ew := range stats.longest {
+		longest = append(longest, ew.page.Title)
+	}
+
+	assert.Equal(t, []string{"B", "G", "A", "D", "E"}, longest, "ties go to the newest")
+
+	assert.Equal(t, []yearWords{
+		{year: 2021, entries: 1, words: 0},
+		{year: 2022, entries: 1, words: 2},
+		{year: 2023, entries: 2, words: 4},
+		{year: 2024, entries: 2, words: 9},
+	}, stats.years)
+
+	// The years are the ones in the timezone, where C is from 2024
+	stats = measureWords(pageSet, time.FixedZone("JST", 9*60*60))
+	assert.Equal(t, yearWords{year: 2024, entries: 3, words: 10}, stats.years[len(stats.years)-1])
+
+	assert.Equal(t, []string{
+		"Words: 19 of prose, 3 per entry on average, and 2 lines of code",
+		"",
+		"Longest entries:",
+		"       6  B (docs/b.md)",
+		"       4  G (docs/g.md)",
+		"       3  A (docs/a.md)",
+		"       3  D (docs/d.md)",
+		"       2  E (docs/e.md)",
+		"",
+		"Words per entry by year:",
+		"  2021       0  (1 page)",
+		"  2022       2  (1 page)",
+		"  2023       2  (2 pages)",
+		"  2024       5  (2 pages)",
+	}, measureWords(pageSet, time.UTC).summary())
+
+	assert.Equal(t, &src.JSONWords{
+		Words:     0,
+		Average:   0,
+		CodeLines: 0,
+		Longest:   []src.JSONEntryWords{},
+		Years:     []src.JSONYearWords{},
+	}, measureWords(nil, time.UTC).json())
+
+	assert.Equal(t, []string{"Words: 0 of prose, 0 per entry on average, and 0 lines of code"}, measureWords(nil, time.UTC).summary())
+}
+
+func Test_findDupes(t *testing.T) {
+	closures := "# Go closures\n\nA closure is a function value that references variables from outside its body. " +
+		"The function may access and assign to the referenced variables, so the function is bound to the variables.\n\n" +
+		"```go\nfunc counter() func() int {\n\tn := 0\n\treturn func() int { n++; return n }\n}\n```\n"
+
+	pageSet := []*pages.Page{
+		{FilePath: "docs/a.md", Title: "Go closures", Content: closures},
+		{FilePath: "docs/b.md", Title: "Closures in Go", Content: strings.Replace(closures, "so the function", "which means the function", 1)},
+		{FilePath: "docs/c.md", Title: "Understanding closures", Content: "# Understanding closures\n\nClosures let a function keep hold of variables that were declared outside of it, even after those have gone out of scope.\n"},
+		{FilePath: "docs/d.md", Title: "go CLOSURES", Content: "# Go closures\n\nSomething else entirely about lunch.\n"},
+		{FilePath: "docs/e.md", Title: "Lava lamps", Content: "# Lava lamps\n\nThe wax in a lava lamp is a function of the heat, which references the bulb outside its body.\n"},
+		{FilePath: "docs/f.md", Title: "Counters", Content: "# Counters\n\nNothing in common.\n\n```go\nfunc counter() func() int {\n\tn := 0\n\treturn func() int { n++; return n }\n}\n```\n"},
+		{FilePath: "docs/index.md"},
+	}
+
+	pairs := findDupes(pageSet, 0.5)
+
+	actual := []string{}
+	for _, pair := range pairs {
+		actual = append(actual, fmt.Sprintf("%s %s %v", pair.page.FilePath, pair.other.FilePath, pair.sameTitle))
+	}
+
+	// The same title comes first, then the copy with a few words changed. The
+	// paraphrase says the same thing in other words, and the lava lamps and
+	// counters only share some words and a code block
+	assert.Equal(t, []string{
+		"docs/a.md docs/d.md true",
+		"docs/a.md docs/b.md false",
+	}, actual)
+
+	assert.InDelta(t, 0.85, pairs[1].similarity, 0.1)
+	assert.Less(t, pairs[0].similarity, 0.1)
+
+	// A lower threshold lets the looser matches through, as long as their
+	// titles share a word
+	found := map[string]bool{}
+	for _, pair := range findDupes(pageSet, 0.0001) {
+		found[pair.page.FilePath+" "+pair.other.FilePath] = true
+	}
+
+	assert.False(t, found["docs/a.md docs/e.md"], "no title words in common")
+	assert.False(t, found["docs/a.md docs/f.md"], "no title words in common")
+}
+
+func Test_duplicateTitles(t *testing.T) {
+	_, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{FilePath: "docs/c.md", Title: "Go - Contexts", Date: "2020-05-08T13:13:08-07:00"},
+		{FilePath: "docs/a.md", Title: "Go: contexts", Date: "2020-05-07T13:13:08-07:00"},
+		{FilePath: "docs/b.md", Title: "Channels", Date: "2020-05-06T13:13:08-07:00"},
+		{FilePath: "docs/d.md", Title: "  go contexts!  ", Date: "2020-05-05T13:13:08-07:00"},
+		{FilePath: "docs/e.md", Title: "Go contexts, again", Date: "2020-05-04T13:13:08-07:00"},
+		{FilePath: "docs/index.md"},
+		{FilePath: "docs/go.md"},
+	}
+
+	groups := duplicateTitles(pageSet)
+
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, []*pages.Page{pageSet[1], pageSet[0], pageSet[3]}, groups[0])
+
+	assert.Equal(t, []string{
+		"title 'Go: contexts' is used by 3 pages: docs/a.md (May 07, 2020), docs/c.md (May 08, 2020), docs/d.md (May 05, 2020)",
+	}, duplicateTitleWarnings(pageSet))
+	warnings, err := validatePages(pageSet)
+	assert.NoError(t, err)
+	assert.Contains(t, warnings, duplicateTitleWarnings(pageSet)[0])
+
+	// The same titles are what til dupes --titles-only lists
+	pairs := sameTitlePairs(findDupes(pageSet, 0.5))
+
+	actual := []string{}
+	for _, pair := range pairs {
+		actual = append(actual, pair.page.FilePath+" "+pair.other.FilePath)
+	}
+
+	assert.ElementsMatch(t, []string{"docs/c.md docs/a.md", "docs/c.md docs/d.md", "docs/a.md docs/d.md"}, actual)
+}
+
+func Test_normalizeTitle(t *testing.T) {
+	assert.Equal(t, "go contexts", normalizeTitle("Go: contexts"))
+	assert.Equal(t, "go contexts", normalizeTitle(" Go -  Contexts "))
+	assert.Equal(t, "c 17 für anfänger", normalizeTitle("C++17 für Anfänger"))
+	assert.Equal(t, "", normalizeTitle("?!"))
+}
+
+func Test_pageShingles(t *testing.T) {
+	page := &pages.Page{Content: "One two three four.\n\n```\nskipped code here\n```\n~~~\nmore code\n~~~\n"}
+	assert.Equal(t, map[string]bool{"one two three": true, "two three four": true}, pageShingles(page))
+
+	assert.Equal(t, map[string]bool{"hi there": true}, pageShingles(&pages.Page{Content: "Hi there"}))
+	assert.Empty(t, pageShingles(&pages.Page{Content: "```\nonly code\n```\n"}))
+}
+
+func Test_dupesTable(t *testing.T) {
+	pairs := []dupePair{
+		{page: &pages.Page{FilePath: "docs/a.md", Title: "Go closures"}, other: &pages.Page{FilePath: "docs/d.md"}, similarity: 0.04, sameTitle: true},
+		{page: &pages.Page{FilePath: "docs/a.md"}, other: &pages.Page{FilePath: "docs/b.md"}, similarity: 0.875},
+	}
+
+	expected := "SIMILARITY  PAGE       OTHER PAGE  WHY\n" +
+		"4%          docs/a.md  docs/d.md   same title: Go closures\n" +
+		"88%         docs/a.md  docs/b.md   similar content\n"
+
+	assert.Equal(t, expected, dupesTable(pairs))
+}
+
+func Test_findDupes_Candidates(t *testing.T) {
+	pageSet := append(dupesBenchmarkPages(3000),
+		&pages.Page{FilePath: "docs/loops.md", Title: "Closures capture loop variables"},
+		&pages.Page{FilePath: "docs/loops-again.md", Title: "Loop variables and closures"},
+	)
+
+	// Only pages that share a title word that's in fewer than 50 titles are
+	// compared, rather than each of the 4.5 million pairs
+	assert.Equal(t, [][2]int{{3000, 3001}}, dupeCandidates(pageSet))
+}
+
+// BenchmarkFindDupes compares a few thousand pages whose titles share words,
+// the way a big collection's do
+func BenchmarkFindDupes(b *testing.B) {
+	pageSet := dupesBenchmarkPages(3000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		findDupes(pageSet, defaultDupesThreshold)
+	}
+}
+
+func Test_loadPages_Generated(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	var err error
+	src.GlobalConfig, err = config.ParseYamlBytes([]byte(fmt.Sprintf(
+		"targetDirectories:\n  a: %s\ngraphPage: true\nactivityPage: true\nsavedSearches:\n  reading: \"tag:go\"\n",
+		filepath.Dir(docsDir),
+	)))
+	assert.NoError(t, err)
+
+	files := map[string]string{
+		"a.md": "---\ndate: 2020-05-06T13:13:08-07:00\ntags: go\ntitle: Mutexes\n---\n",
+		"b.md": "---\ndate: 2020-05-07T13:13:08-07:00\ntags: go, ml\ntitle: Channels\n---\n",
+		"c.md": "---\ndate: 2020-05-08T13:13:08-07:00\ntitle: Zombies\n---\n",
+	}
+
+	for name, content := range files {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, name), []byte(content), 0644))
+	}
+
+	pageSet, err := loadPages(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pageSet))
+
+	assert.NoError(t, buildContent(context.Background(), newBuildWarnings()))
+
+	// The build wrote the index, graph, activity, saved search, and top-level
+	// tag pages alongside the pages, and none of them are loaded as pages
+	for _, name := range []string{"activity.md", "go.md", "graph.md", "index.md", "ml.md", "reading.md"} {
+		assert.FileExists(t, filepath.Join(docsDir, name))
+	}
+
+	pageSet, err = loadPages(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pageSet))
+
+	for _, page := range pageSet {
+		assert.True(t, page.IsContentPage(), page.FilePath)
+	}
+
+	// An index from before there was a marker is left out by its name
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(docsDir, "index.md"), []byte("[go](./go)\n"), 0644))
+
+	pageSet, err = loadPages(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pageSet))
+}
+
+func Test_checkEditor(t *testing.T) {
+	binDir, err := ioutil.TempDir("", "til")
+	assert.NoError(t, err)
+	defer os.RemoveAll(binDir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(binDir, "edit"), []byte("#!/bin/sh\n"), 0755))
+
+	wd, _ := os.Getwd()
+	assert.NoError(t, os.Chdir(binDir))
+	defer os.Chdir(wd)
+
+	assert.NoError(t, checkEditor("go"))
+	assert.NoError(t, checkEditor("./edit --wait"))
+
+	err = checkEditor("til-no-such-editor --wait")
+	assert.EqualError(t, err, "the editor 'til-no-such-editor' isn't installed, or isn't on the PATH. Set editor in the config to one that is (ie: editor: \"code --wait\"), or pass -no-edit to write the page without opening it")
+
+	// A path is looked for where it points, not on the PATH
+	err = checkEditor("./bin/edit")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the editor './bin/edit'")
+}
+
+func Test_createNewPage_MissingEditor(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	var err error
+	src.GlobalConfig, err = config.ParseYamlBytes([]byte(fmt.Sprintf("targetDirectories:\n  a: %s\neditor: til-no-such-editor\n", filepath.Dir(docsDir))))
+	assert.NoError(t, err)
+
+	err = createNewPage(context.Background(), "Zombies", pages.PageOptions{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'til-no-such-editor'")
+
+	// Nothing was written, not even the lock
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*"))
+	assert.Equal(t, []string{}, filePaths)
+
+	_, err = os.Stat(filepath.Join(docsDir, lockFileName))
+	assert.True(t, os.IsNotExist(err))
+
+	// With -no-edit the editor doesn't matter
+	noEditFlag = true
+	defer func() { noEditFlag = false }()
+
+	assert.NoError(t, createNewPage(context.Background(), "Zombies", pages.PageOptions{}))
+
+	filePaths, _ = memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, 1, len(filePaths))
+}
+
+func Test_isUnedited(t *testing.T) {
+	stub := []byte("---\ntitle: Zombies\n---\n\n# Zombies\n\n")
+
+	assert.True(t, isUnedited(stub, stub))
+	assert.True(t, isUnedited(stub, []byte("---\ntitle: Zombies  \n---\r\n\n# Zombies\n")))
+	assert.False(t, isUnedited(stub, []byte("---\ntitle: Zombies\n---\n\n# Zombies\n\nThey're back\n")))
+}
+
+func Test_keepEmptyPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		answer      string
+		interactive bool
+		keep        bool
+		discard     bool
+		expected    bool
+	}{
+		{name: "with yes", answer: "y\n", interactive: true, expected: true},
+		{name: "with a shouted yes", answer: " YES\n", interactive: true, expected: true},
+		{name: "with no", answer: "n\n", interactive: true, expected: false},
+		{name: "with just enter", answer: "\n", interactive: true, expected: false},
+		{name: "with no one to ask", answer: "", interactive: false, expected: true},
+		{name: "with -keep-empty", answer: "n\n", interactive: true, keep: true, expected: true},
+		{name: "with -discard-empty", answer: "y\n", interactive: true, discard: true, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepEmptyFlag, discardEmptyFlag = tt.keep, tt.discard
+			defer func() { keepEmptyFlag, discardEmptyFlag = false, false }()
+
+			out := &bytes.Buffer{}
+
+			assert.Equal(t, tt.expected, keepEmptyPage(strings.NewReader(tt.answer), out, tt.interactive))
+
+			if tt.interactive && !tt.keep && !tt.discard {
+				assert.Equal(t, "Keep empty page? [y/N] ", out.String())
+			} else {
+				assert.Equal(t, "", out.String())
+			}
+		})
+	}
+}
+
+func Test_createNewPage_DiscardEmpty(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	// An editor that closes straight away without changing anything
+	var err error
+	src.GlobalConfig, err = config.ParseYamlBytes([]byte(fmt.Sprintf("targetDirectories:\n  a: %s\neditor: \"true\"\n", filepath.Dir(docsDir))))
+	assert.NoError(t, err)
+
+	discardEmptyFlag = true
+	defer func() { discardEmptyFlag = false }()
+
+	assert.NoError(t, createNewPage(context.Background(), "Zombies", pages.PageOptions{}))
+
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{}, filePaths)
+
+	// Both at once make no sense
+	keepEmptyFlag = true
+	defer func() { keepEmptyFlag = false }()
+
+	err = createNewPage(context.Background(), "Zombies", pages.PageOptions{})
+	assert.Equal(t, src.ExitUsage, src.ExitCode(err))
+}
+
+func Test_entryAnchorURL(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "Go: Contexts", Date: "2020-05-09T13:13:08-07:00", FilePath: "docs/b.md"},
+		{Title: "Go Contexts", Date: "2020-05-08T13:13:08-07:00", FilePath: "docs/a.md"},
+		{Title: "Mutexes", Date: "2020-05-07T13:13:08-07:00", FilePath: "docs/mutexes.md"},
+	}
+
+	assert.Equal(t, "https://me.github.io/til/index.html#go-contexts-2", entryAnchorURL(pageSet, pageSet[0], "https://me.github.io/til/", 0))
+	assert.Equal(t, "https://me.github.io/til/index.html#go-contexts", entryAnchorURL(pageSet, pageSet[1], "https://me.github.io/til", 0))
+
+	// Pages that don't fit on the index are linked to on the all page
+	assert.Equal(t, "https://me.github.io/til/index.html#go-contexts", entryAnchorURL(pageSet, pageSet[1], "https://me.github.io/til", 2))
+	assert.Equal(t, "https://me.github.io/til/all.html#mutexes", entryAnchorURL(pageSet, pageSet[2], "https://me.github.io/til", 2))
+}
+
+func Test_DateDisplay(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            string
+		expectedLayout string
+		expectedLocale string
+		expectedErr    string
+	}{
+		{
+			name:           "with nothing configured",
+			cfg:            "editor: vim",
+			expectedLayout: "Jan 02, 2006",
+		},
+		{
+			name:           "with a layout and a locale",
+			cfg:            "dateFormat: \"2 January 2006\"\ndateLocale: FR\n",
+			expectedLayout: "2 January 2006",
+			expectedLocale: "fr",
+		},
+		{
+			name:        "with a layout that isn't a Go layout",
+			cfg:         "dateFormat: YYYY-MM-DD\n",
+			expectedErr: "the dateFormat 'YYYY-MM-DD' in the config has no date in it. It's a Go layout, which is written the way Jan 2, 2006 would be written (ie: \"02 Jan 2006\", \"2 January 2006\", or \"2006-01-02\")",
+		},
+		{
+			name:        "with an unknown locale",
+			cfg:         "dateLocale: tlh\n",
+			expectedErr: "unknown dateLocale 'tlh' in the config. Known locales are: en, de, es, fr, it, nl, pt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			layout, locale, err := src.DateDisplay(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLayout, layout)
+			assert.Equal(t, tt.expectedLocale, locale)
+		})
+	}
+}
+
+func Test_FilenameDateFormat(t *testing.T) {
+	notReadable := "the filenameDateFormat '%s' in the config isn't a date that can be read back from a file name. It's a Go layout, written the way Jan 2, 2006 at 15:04:05 would be (ie: \"2006-01-02\" or \"2006-01-02T15-04-05\")"
+
+	tests := []struct {
+		name           string
+		cfg            string
+		expectedLayout string
+		expectedErr    string
+	}{
+		{
+			name: "with nothing configured",
+			cfg:  "editor: vim",
+		},
+		{
+			name:           "with a date",
+			cfg:            "filenameDateFormat: \"2006-01-02\"\n",
+			expectedLayout: "2006-01-02",
+		},
+		{
+			name:           "with a date and time",
+			cfg:            "filenameDateFormat: \"20060102-150405\"\n",
+			expectedLayout: "20060102-150405",
+		},
+		{
+			name:        "with a directory in it",
+			cfg:         "filenameDateFormat: \"2006/01/02\"\n",
+			expectedErr: "the filenameDateFormat '2006/01/02' in the config has a '/' in it, which can't go in a file name",
+		},
+		{
+			name:        "with a colon in it",
+			cfg:         "filenameDateFormat: \"2006-01-02T15:04:05\"\n",
+			expectedErr: "the filenameDateFormat '2006-01-02T15:04:05' in the config has a ':' in it, which can't go in a file name",
+		},
+		{
+			name:        "with a layout that isn't a Go layout",
+			cfg:         "filenameDateFormat: YYYY-MM-DD\n",
+			expectedErr: fmt.Sprintf(notReadable, "YYYY-MM-DD"),
+		},
+		{
+			name:        "with a layout that can't be read back",
+			cfg:         "filenameDateFormat: \"Monday-15h\"\n",
+			expectedErr: fmt.Sprintf(notReadable, "Monday-15h"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			layout, err := src.FilenameDateFormat(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLayout, layout)
+		})
+	}
+}
+
+func Test_FormatDate(t *testing.T) {
+	date := time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "May 14, 2024", src.FormatDate(date, src.DefaultDateFormat, ""))
+	assert.Equal(t, "14 mai 2024", src.FormatDate(date, "2 January 2006", "fr"))
+	assert.Equal(t, "14. Mai 2024", src.FormatDate(date, "2. Jan 2006", "de"))
+	assert.Equal(t, "2024-05-14", src.FormatDate(date, "2006-01-02", "fr"))
+	assert.Equal(t, "14 mei 2024, 09:00", src.FormatDate(date, "2 Jan 2006, 15:04", "nl"))
+	assert.Equal(t, "14 May 2024", src.FormatDate(date, "2 January 2006", "en"))
+
+	// Every date on the pages and in the console is written the same way
+	pages.DateFormat, pages.DateLocale = "2 January 2006", "fr"
+	defer func() { pages.DateFormat, pages.DateLocale = src.DefaultDateFormat, "" }()
+
+	page := &pages.Page{Title: "Zombies", Date: "2024-05-14T13:13:08-07:00", FilePath: "docs/zombies.md"}
+
+	assert.Equal(t, "14 mai 2024", page.PrettyDate())
+	assert.Equal(t, "<code>14 mai 2024</code> [Zombies](zombies.md)", page.Link())
+	assert.Equal(t, []string{"14 mai 2024  Zombies  (docs/zombies.md)"}, listPages([]*pages.Page{page}, ""))
+}
+
+func Test_relativeDate(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+	now := time.Date(2024, 5, 14, 9, 0, 0, 0, loc)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected string
+	}{
+		{name: "earlier today", date: time.Date(2024, 5, 14, 0, 30, 0, 0, loc), expected: "today"},
+		{name: "today in the timezone, but yesterday in UTC", date: time.Date(2024, 5, 13, 23, 30, 0, 0, time.UTC), expected: "today"},
+		{name: "late last night", date: time.Date(2024, 5, 13, 23, 59, 0, 0, loc), expected: "yesterday"},
+		{name: "days ago", date: time.Date(2024, 5, 11, 9, 0, 0, 0, loc), expected: "3 days ago"},
+		{name: "a week ago", date: time.Date(2024, 5, 7, 9, 0, 0, 0, loc), expected: "1 week ago"},
+		{name: "weeks ago", date: time.Date(2024, 4, 25, 9, 0, 0, 0, loc), expected: "2 weeks ago"},
+		{name: "months ago", date: time.Date(2024, 3, 10, 9, 0, 0, 0, loc), expected: "2 months ago"},
+		{name: "just under a year ago", date: time.Date(2023, 5, 16, 9, 0, 0, 0, loc), expected: "12 months ago"},
+		{name: "more than a year ago", date: time.Date(2023, 5, 14, 9, 0, 0, 0, loc), expected: "May 14, 2023"},
+		{name: "tomorrow", date: time.Date(2024, 5, 15, 0, 5, 0, 0, loc), expected: "tomorrow"},
+		{name: "in days", date: time.Date(2024, 5, 16, 9, 0, 0, 0, loc), expected: "in 2 days"},
+		{name: "in months", date: time.Date(2024, 8, 20, 9, 0, 0, 0, loc), expected: "in 3 months"},
+		{name: "more than a year away", date: time.Date(2025, 6, 1, 9, 0, 0, 0, loc), expected: "Jun 01, 2025"},
+		{name: "undated", date: time.Time{}, expected: "Jan 01, 0001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, relativeDate(tt.date, now, loc))
+		})
+	}
+}
+
+func Test_listPages_Relative(t *testing.T) {
+	_, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	pages.Now = func() time.Time { return time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC) }
+	defer func() { pages.Now = time.Now }()
+
+	pageSet := []*pages.Page{{Title: "Zombies", Date: "2024-05-11T09:00:00Z", FilePath: "docs/zombies.md"}}
+
+	relative := true
+	relativeDates = &relative
+	defer func() { relativeDates = nil }()
+
+	assert.Equal(t, []string{"3 days ago  Zombies  (docs/zombies.md)"}, listPages(pageSet, ""))
+
+	relative = false
+
+	assert.Equal(t, []string{"May 11, 2024  Zombies  (docs/zombies.md)"}, listPages(pageSet, ""))
+}
+
+func Test_IndexContent_SortOrders(t *testing.T) {
+	pageSet := []*pages.Page{
+		{Title: "zombies are slow", Date: "2020-06-02T09:00:00Z", FilePath: "docs/zombies.md", TagsStr: "horror"},
+		{Title: "Channels", Date: "2020-05-08T09:00:00Z", FilePath: "docs/channels.md", TagsStr: "go, concurrency"},
+		{Title: "Lava lamps", Date: "2020-05-07T09:00:00Z", FilePath: "docs/lava-lamps.md"},
+		{Title: "mutexes", Date: "2020-04-20T09:00:00Z", FilePath: "docs/mutexes.md", TagsStr: "concurrency, go"},
+		{Title: "Gofmt", Date: "2020-04-01T09:00:00Z", FilePath: "docs/gofmt.md", TagsStr: "Go"},
+	}
+
+	tests := []struct {
+		name   string
+		golden string
+		opts   til.Options
+	}{
+		{name: "newest first", golden: "newest.md", opts: til.Options{}},
+		{name: "alphabetical", golden: "alphabetical.md", opts: til.Options{IndexSort: til.IndexSortAlphabetical}},
+		{name: "by tag", golden: "by-tag.md", opts: til.Options{IndexSort: til.IndexSortByTag}},
+		{name: "by first tag", golden: "by-first-tag.md", opts: til.Options{IndexSort: til.IndexSortByTag, IndexSortFirstTag: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Footer = "footer\n"
+
+			actual := til.IndexContent(pageSet, til.NewTagMap(pageSet, tt.opts), tt.opts)
+
+			assertGolden(t, filepath.Join("testdata", "index", tt.golden), actual)
+		})
+	}
+}
+
+func Test_RenderPageBody(t *testing.T) {
+	info := src.PageBodyInfo{Date: "May 14, 2024", Tags: "go, cli", Title: "Zombies"}
+
+	tests := []struct {
+		name         string
+		text         string
+		body         string
+		expected     string
+		expectedLine int
+	}{
+		{name: "default", text: src.DefaultPageBody, expected: "# Zombies\n\n", expectedLine: 0},
+		{name: "default with a body", text: src.DefaultPageBody, body: "Braaains\n", expected: "# Zombies\n\nBraaains\n", expectedLine: 0},
+		{name: "placeholders", text: "# {{.Title}}\n\n_{{.Date}}: {{.Tags}}_\n", expected: "# Zombies\n\n_May 14, 2024: go, cli_\n", expectedLine: 0},
+		{name: "cursor", text: "# {{.Title}}\n\n{{.Cursor}}\n\n## See also\n", expected: "# Zombies\n\n\n\n## See also\n", expectedLine: 3},
+		{name: "cursor with a body", text: "# {{.Title}}\n\n{{.Cursor}}\n---\n", body: "Braaains", expected: "# Zombies\n\nBraaains\n---\n", expectedLine: 3},
+		{name: "cursor on the first line", text: "{{.Cursor}} {{.Title}}", expected: " Zombies", expectedLine: 1},
+		{name: "only the first cursor", text: "{{.Cursor}}\n{{.Cursor}}\n", expected: "\n\n", expectedLine: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("newPageBody").Parse(tt.text)
+			assert.NoError(t, err)
+
+			actual, line, err := src.RenderPageBody(tmpl, info, tt.body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedLine, line)
+		})
+	}
+}
+
+func Test_PageBodyTemplate(t *testing.T) {
+	cfg, _ := config.ParseYamlBytes([]byte(""))
+	tmpl, err := src.PageBodyTemplate(cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, src.DefaultPageBody, tmpl.Root.String())
+
+	// Templates that don't parse, or that use things a page doesn't have,
+	// are caught when the config is loaded
+	for _, text := range []string{"# {{.Title", "# {{.Author}}"} {
+		cfg, _ := config.ParseYamlBytes([]byte(fmt.Sprintf("newPageBody: %q", text)))
+
+		_, err = src.PageBodyTemplate(cfg)
+		assert.Error(t, err, text)
+		assert.Contains(t, err.Error(), "newPageBody", text)
+	}
+}
+
+func Test_NewPage_BodyTemplate(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	cfg, _ := config.ParseYamlBytes([]byte("newPageBody: \"# {{.Title}}\\n\\n{{.Cursor}}\\n\\n## Links\\n\""))
+	tmpl, err := src.PageBodyTemplate(cfg)
+	assert.NoError(t, err)
+
+	page := newTestPage(t, "Zombies", docsDir, pages.PageOptions{BodyTemplate: tmpl, Body: "Braaains", FS: memFS})
+
+	data, _ := memFS.ReadFile(page.FilePath)
+	lines := strings.Split(string(data), "\n")
+
+	// The body goes where the cursor was, and the editor opens on it
+	assert.NotContains(t, string(data), "\x00")
+	assert.Equal(t, "Braaains", lines[page.StubBodyLine()-1])
+	assert.Equal(t, "## Links", lines[page.StubBodyLine()+1])
+}
+
+func Test_validateLang(t *testing.T) {
+	for _, lang := range []string{"go", "c++", "objective-c", "F#", "{.python}"} {
+		assert.NoError(t, validateLang(lang), lang)
+	}
+
+	for _, lang := range []string{"go lang", "go\t", "\ngo", "go`", "```go"} {
+		assert.Error(t, validateLang(lang), lang)
+	}
+
+	assert.Equal(t, []string{"cli", "go"}, withLangTag([]string{"cli"}, "go"))
+	assert.Equal(t, []string{"Go", "cli"}, withLangTag([]string{"Go", "cli"}, "go"))
+}
+
+func Test_withCodeBlock(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		code         string
+		expected     string
+		expectedLine int
+	}{
+		{name: "empty", expected: "```go\n\n```\n", expectedLine: 2},
+		{name: "with code", code: "x := []int{}\n", expected: "```go\nx := []int{}\n```\n", expectedLine: 2},
+		{name: "after the body", body: "From the clipboard\n", expected: "From the clipboard\n\n```go\n\n```\n", expectedLine: 4},
+		{name: "code with fences", code: "```sh\nls\n```\n", expected: "````go\n```sh\nls\n```\n````\n", expectedLine: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, line := withCodeBlock(tt.body, "go", tt.code)
+
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedLine, line)
+		})
+	}
+
+	assert.True(t, isBlankBody("```go\n\n```\n", "go"))
+	assert.False(t, isBlankBody("```go\nx := 1\n```\n", "go"))
+}
+
+func Test_NewPage_CodeBlock(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	code, err := stdinText(strings.NewReader("for i := range s {\r\n}\r\n\n"))
+	assert.NoError(t, err)
+
+	body, line := withCodeBlock("", "go", code)
+	page := newTestPage(t, "Slices gotcha", docsDir, pages.PageOptions{Body: body, BodyLine: line, FS: memFS, Tags: withLangTag(nil, "go")})
+
+	data, _ := memFS.ReadFile(page.FilePath)
+	lines := strings.Split(string(data), "\n")
+
+	// The editor opens on the first line of the code
+	assert.Equal(t, "```go", lines[page.StubBodyLine()-2])
+	assert.Equal(t, "for i := range s {", lines[page.StubBodyLine()-1])
+	assert.Equal(t, pages.TagsString("go"), page.TagsStr)
+
+	_, err = stdinText(strings.NewReader(" \n\t\n"))
+	assert.Error(t, err)
+}
+
+func Test_nextReview(t *testing.T) {
+	created := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		reviewed   time.Time
+		now        time.Time
+		expected   time.Time
+		expectedOK bool
+		due        bool
+	}{
+		{name: "new", now: created.AddDate(0, 0, 6), expected: created.AddDate(0, 0, 7), expectedOK: true, due: false},
+		{name: "a week old", now: created.AddDate(0, 0, 7), expected: created.AddDate(0, 0, 7), expectedOK: true, due: true},
+		{name: "just short of a week", now: created.AddDate(0, 0, 7).Add(-time.Second), expected: created.AddDate(0, 0, 7), expectedOK: true, due: false},
+		{name: "old, never reviewed", now: created.AddDate(2, 0, 0), expected: created.AddDate(0, 0, 7), expectedOK: true, due: true},
+		{name: "reviewed after a week", reviewed: created.AddDate(0, 0, 8), now: created.AddDate(0, 0, 29), expected: created.AddDate(0, 0, 30), expectedOK: true, due: false},
+		{name: "reviewed right on time", reviewed: created.AddDate(0, 0, 30), now: created.AddDate(0, 0, 90), expected: created.AddDate(0, 0, 90), expectedOK: true, due: true},
+		{name: "reviewed late", reviewed: created.AddDate(0, 0, 100), now: created.AddDate(0, 0, 200), expected: created.AddDate(0, 0, 365), expectedOK: true, due: false},
+		{name: "reviewed after a year", reviewed: created.AddDate(0, 0, 365), now: created.AddDate(5, 0, 0), expectedOK: false, due: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := nextReview(created, tt.reviewed)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.due, isReviewDue(created, tt.reviewed, tt.now))
+		})
+	}
+
+	// A page without a date is never due
+	_, ok := nextReview(time.Time{}, time.Time{})
+	assert.False(t, ok)
+}
+
+func Test_duePages(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	pageSet := []*pages.Page{
+		{Title: "Yesterday", Date: "2024-05-31T09:00:00Z", FilePath: filepath.Join(docsDir, "yesterday.md")},
+		{Title: "Last month", Date: "2024-04-20T09:00:00Z", FilePath: filepath.Join(docsDir, "last-month.md")},
+		{Title: "Reviewed", Date: "2024-04-01T09:00:00Z", FilePath: filepath.Join(docsDir, "reviewed.md"), Reviewed: "2024-05-20T09:00:00Z"},
+		{Title: "Last year", Date: "2023-05-01T09:00:00Z", FilePath: filepath.Join(docsDir, "last-year.md")},
+	}
+
+	assert.Equal(t, []*pages.Page{pageSet[3], pageSet[1]}, duePages(pageSet, now))
+
+	// Marking a page reviewed only changes its reviewed front-matter
+	assert.NoError(t, memFS.WriteFile(pageSet[1].FilePath, []byte("---\ndate: 2024-04-20T09:00:00Z\ntitle: Last month\ntags: go\n---\n\n# Last month\n"), 0644))
+	assert.NoError(t, markReviewed(pageSet[1], now))
+
+	data, _ := memFS.ReadFile(pageSet[1].FilePath)
+	assert.Equal(t, "---\ndate: 2024-04-20T09:00:00Z\ntitle: Last month\ntags: go\nreviewed: 2024-06-01T09:00:00Z\n---\n\n# Last month\n", string(data))
+
+	page, err := pages.ReadPageFS(memFS, pageSet[1].FilePath)
+	assert.NoError(t, err)
+	assert.Equal(t, now, page.ReviewedAt())
+	assert.Equal(t, []*pages.Page{pageSet[3]}, duePages(pageSet, now))
+}
+
+func Test_markReviewed_CRLF(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	page := &pages.Page{Title: "Last month", Date: "2024-04-20T09:00:00Z", FilePath: filepath.Join(docsDir, "last-month.md")}
+
+	assert.NoError(t, memFS.WriteFile(page.FilePath, []byte("---\r\ndate: 2024-04-20T09:00:00Z\r\ntitle: Last month\r\n---\r\n\r\n# Last month\r\n"), 0644))
+	assert.NoError(t, markReviewed(page, now))
+
+	data, _ := memFS.ReadFile(page.FilePath)
+	assert.Equal(t, "---\r\ndate: 2024-04-20T09:00:00Z\r\ntitle: Last month\r\nreviewed: 2024-06-01T09:00:00Z\r\n---\r\n\r\n# Last month\r\n", string(data))
+}
+
+func Test_Page_LinkPath(t *testing.T) {
+	tests := []struct {
+		filePath string
+		expected string
+	}{
+		{filePath: "docs/zombies.md", expected: "zombies.md"},
+		{filePath: "docs/2024/zombies.md", expected: "2024/zombies.md"},
+		{filePath: `docs\2024\zombies.md`, expected: "2024/zombies.md"},
+		{filePath: "docs/drafts/zombies.md", expected: "zombies.md"},
+		{filePath: "zombies.md", expected: "zombies.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			assert.Equal(t, tt.expected, (&pages.Page{FilePath: tt.filePath}).LinkPath())
+		})
+	}
+
+	page := &pages.Page{Title: "Zombies", Date: "2024-05-14T09:00:00Z", FilePath: "docs/2024/zombies.md"}
+	assert.Equal(t, "<code>May 14, 2024</code> [Zombies](../../2024/zombies.md)", page.LinkFrom("../../"))
+	assert.Equal(t, "https://me.github.io/til/2024/zombies.html", page.Permalink("https://me.github.io/til"))
+
+	name, ok := changelogFileName("docs/2024/zombies.md")
+	assert.True(t, ok)
+	assert.Equal(t, "2024/zombies.md", name)
+
+	_, ok = changelogFileName("docs/tags/zombies.md")
+	assert.False(t, ok)
+}
+
+func Test_migrateLayout(t *testing.T) {
+	docsDir, memFS, cleanup := setUpMemFS(t)
+	defer cleanup()
+
+	pageSet := []*pages.Page{
+		{Title: "Zombies", Date: "2024-05-14T09:00:00Z", FilePath: filepath.Join(docsDir, "zombies.md")},
+		{Title: "Mummies", Date: "2023-10-31T23:30:00-07:00", FilePath: filepath.Join(docsDir, "mummies.md")},
+		{Title: "Ghosts", FilePath: filepath.Join(docsDir, "ghosts.md")},
+		{Title: "Vampires", Date: "2022-01-01T09:00:00Z", FilePath: filepath.Join(docsDir, "2022", "vampires.md")},
+		{FilePath: filepath.Join(docsDir, "index.md"), Content: pages.GeneratedMarker},
+	}
+
+	for _, page := range pageSet {
+		assert.NoError(t, memFS.WriteFile(page.FilePath, []byte(page.Title), 0644))
+	}
+
+	// A dry run doesn't move anything
+	assert.NoError(t, migrateLayout(pageSet, docsDir, src.LayoutYearly, true))
+	_, err := memFS.Stat(filepath.Join(docsDir, "zombies.md"))
+	assert.NoError(t, err)
+
+	// A page that would land on another file stops anything being moved
+	assert.NoError(t, memFS.WriteFile(filepath.Join(docsDir, "2024", "zombies.md"), []byte("Other zombies"), 0644))
+	assert.Error(t, migrateLayout(pageSet, docsDir, src.LayoutYearly, false))
+	_, err = memFS.Stat(filepath.Join(docsDir, "mummies.md"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, memFS.Remove(filepath.Join(docsDir, "2024", "zombies.md")))
+	assert.NoError(t, migrateLayout(pageSet, docsDir, src.LayoutYearly, false))
+
+	// Each page goes in the year of its own date, and the index and the page
+	// without a date stay where they are
+	filePaths, _ := memFS.Glob(filepath.Join(docsDir, "*", "*.md"))
+	assert.Equal(t, []string{
+		filepath.Join(docsDir, "2022", "vampires.md"),
+		filepath.Join(docsDir, "2023", "mummies.md"),
+		filepath.Join(docsDir, "2024", "zombies.md"),
+	}, filePaths)
+
+	filePaths, _ = memFS.Glob(filepath.Join(docsDir, "*.md"))
+	assert.Equal(t, []string{filepath.Join(docsDir, "ghosts.md"), filepath.Join(docsDir, "index.md")}, filePaths)
+
+	data, _ := memFS.ReadFile(filepath.Join(docsDir, "2024", "zombies.md"))
+	assert.Equal(t, "Zombies", string(data))
+
+	assert.Error(t, migrateLayout(pageSet, docsDir, "monthly", true))
+	assert.Equal(t, filepath.Join(docsDir, "2024"), newPageDir(docsDir, time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC), true))
+	assert.Equal(t, docsDir, newPageDir(docsDir, time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC), false))
+}
+
+func Test_periodStart(t *testing.T) {
+	// Sunday Mar 10, 2024 is when the clocks went forward in New York
+	newYork := time.FixedZone("EST", -5*60*60)
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		cadence   string
+		weekStart time.Weekday
+		expected  string
+	}{
+		{name: "a day", date: time.Date(2024, 3, 14, 23, 59, 0, 0, time.UTC), cadence: src.CadenceDaily, expected: "2024-03-14"},
+		{name: "a week starting on Sunday", date: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC), cadence: src.CadenceWeekly, weekStart: time.Sunday, expected: "2024-03-10"},
+		{name: "a week starting on Monday", date: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC), cadence: src.CadenceWeekly, weekStart: time.Monday, expected: "2024-03-11"},
+		{name: "the first day of a week", date: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), cadence: src.CadenceWeekly, weekStart: time.Monday, expected: "2024-03-11"},
+		{name: "the last day of a week", date: time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC), cadence: src.CadenceWeekly, weekStart: time.Monday, expected: "2024-03-04"},
+		{name: "a week across the new year", date: time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC), cadence: src.CadenceWeekly, weekStart: time.Saturday, expected: "2024-12-28"},
+		{name: "a month", date: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), cadence: src.CadenceMonthly, expected: "2024-02-01"},
+		{name: "in the date's own timezone", date: time.Date(2024, 3, 31, 22, 0, 0, 0, newYork), cadence: src.CadenceMonthly, expected: "2024-03-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := periodStart(tt.date, tt.cadence, tt.weekStart)
+
+			assert.Equal(t, tt.expected, start.Format(activityDayFormat))
+			assert.Equal(t, tt.date.Location(), start.Location())
+			assert.Equal(t, 0, start.Hour())
+		})
+	}
+}
+
+func Test_periodCounts(t *testing.T) {
+	// A Thursday
+	now := time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)
+
+	pageSet := []*pages.Page{
+		{Title: "This week", Date: "2024-03-11T09:00:00Z"},
+		{Title: "Last Sunday, in UTC", Date: "2024-03-10T20:00:00-05:00"},
+		{Title: "Last week", Date: "2024-03-04T09:00:00Z"},
+		{Title: "Three weeks ago", Date: "2024-02-20T09:00:00Z"},
+		{Title: "No date"},
+		{FilePath: "docs/index.md"},
+	}
+
+	counts := periodCounts(pageSet, now, time.UTC, src.CadenceWeekly, time.Monday)
+
+	starts := []string{}
+	numbers := []int{}
+	for _, pc := range counts {
+		starts = append(starts, pc.start.Format(activityDayFormat))
+		numbers = append(numbers, pc.count)
+	}
+
+	// The empty week is there, and the page written on Sunday evening in
+	// New York was written on the Monday in UTC
+	assert.Equal(t, []string{"2024-02-19", "2024-02-26", "2024-03-04", "2024-03-11"}, starts)
+	assert.Equal(t, []int{1, 0, 1, 2}, numbers)
+
+	counts = periodCounts(nil, now, time.UTC, src.CadenceMonthly, time.Sunday)
+
+	assert.Equal(t, []periodCount{{start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}}, counts, "without pages, there's only the current period")
+}
+
+func Test_measureGoal(t *testing.T) {
+	goal := src.WritingGoal{Cadence: src.CadenceWeekly, Count: 2}
+
+	counted := func(numbers ...int) []periodCount {
+		counts := []periodCount{}
+		for i, n := range numbers {
+			counts = append(counts, periodCount{start: time.Date(2024, 1, 1+7*i, 0, 0, 0, 0, time.UTC), count: n})
+		}
+
+		return counts
+	}
+
+	tests := []struct {
+		name     string
+		counts   []periodCount
+		expected goalProgress
+	}{
+		{
+			name:     "with only the current period",
+			counts:   counted(1),
+			expected: goalProgress{goal: goal, current: 1},
+		},
+		{
+			name:     "with an unfinished current period",
+			counts:   counted(2, 3, 0, 2, 2, 1),
+			expected: goalProgress{goal: goal, current: 1, met: 4, periods: 5, longestRun: 2},
+		},
+		{
+			name:     "with a current period that's met the goal",
+			counts:   counted(0, 2, 2, 2),
+			expected: goalProgress{goal: goal, current: 2, met: 2, periods: 3, longestRun: 3},
+		},
+		{
+			name:     "with more periods than the hit rate covers",
+			counts:   counted(2, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0),
+			expected: goalProgress{goal: goal, current: 0, met: 2, periods: 12, longestRun: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, measureGoal(goal, tt.counts))
+		})
+	}
+}
+
+func Test_goalProgress_summary(t *testing.T) {
+	progress := goalProgress{
+		goal:       src.WritingGoal{Cadence: src.CadenceWeekly, Count: 2},
+		current:    1,
+		met:        7,
+		periods:    12,
+		longestRun: 1,
+	}
+
+	assert.Equal(t, []string{
+		"Goal: 1 of 2 this week",
+		"Met the goal 7 of the last 12 weeks (58%)",
+		"Longest run: 1 week in a row",
+	}, progress.summary())
+
+	progress = goalProgress{goal: src.WritingGoal{Cadence: src.CadenceDaily, Count: 1}}
+
+	assert.Equal(t, []string{
+		"Goal: 0 of 1 today",
+		"Longest run: 0 days in a row",
+	}, progress.summary(), "without a period before the current one, there's no hit rate")
+}
+
+func Test_entriesFooter(t *testing.T) {
+	const generated = " · generated by <a href='https://github.com/senorprogrammer/til'>til</a></sub></sup>\n"
+
+	tests := []struct {
+		name     string
+		pageSet  []*pages.Page
+		expected string
+	}{
+		{
+			name:     "with no pages",
+			pageSet:  []*pages.Page{},
+			expected: "<sup><sub>no entries yet" + generated,
+		},
+		{
+			name:     "with one page",
+			pageSet:  []*pages.Page{{Title: "Zombies", Date: "2024-05-14T13:13:08-07:00"}},
+			expected: "<sup><sub>1 entry · last added May 14, 2024" + generated,
+		},
+		{
+			name: "with the generated pages, which aren't entries",
+			pageSet: []*pages.Page{
+				{Date: "2024-06-01T13:13:08-07:00", FilePath: "index.md"},
+				{Title: "Ghouls", Date: "2020-05-07T13:13:08-07:00"},
+				{Title: "Zombies", Date: "2024-05-14T13:13:08-07:00"},
+				{Title: "Vampires", Date: "2023-01-02T13:13:08-07:00"},
+			},
+			expected: "<sup><sub>3 entries · last added May 14, 2024" + generated,
+		},
+		{
+			name:     "with only undated pages",
+			pageSet:  []*pages.Page{{Title: "Zombies"}, {Title: "Ghouls"}},
+			expected: "<sup><sub>2 entries" + generated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, entriesFooter(tt.pageSet))
+		})
+	}
+}
+
+func Test_siteFooter(t *testing.T) {
+	defer func(cfg *config.Config) { src.GlobalConfig = cfg }(src.GlobalConfig)
+
+	pageSet := []*pages.Page{{Title: "Zombies", Date: "2024-05-14T13:13:08-07:00"}}
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("footerStyle: entries\n"))
+	assert.Equal(t, entriesFooter(pageSet), siteFooter(pageSet))
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("{}\n"))
+	assert.True(t, strings.HasPrefix(siteFooter(pageSet), "<sup><sub>generated "))
+
+	_, err := src.FooterStyle(src.GlobalConfig)
+	assert.NoError(t, err)
+
+	src.GlobalConfig, _ = config.ParseYamlBytes([]byte("footerStyle: build\n"))
+	_, err = src.FooterStyle(src.GlobalConfig)
+	assert.EqualError(t, err, "footerStyle needs to be timestamp or entries, not 'build'")
+}
+
+func Test_Goal(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         string
+		expected    src.WritingGoal
+		expectedOk  bool
+		expectedErr string
+	}{
+		{
+			name: "with no goal",
+			cfg:  "editor: vim",
+		},
+		{
+			name:       "with a goal",
+			cfg:        "goal:\n  cadence: Monthly\n  count: 4\n  buildSummary: true\n",
+			expected:   src.WritingGoal{Cadence: src.CadenceMonthly, Count: 4, BuildSummary: true},
+			expectedOk: true,
+		},
+		{
+			name:       "without a cadence",
+			cfg:        "goal:\n  count: 2\n",
+			expected:   src.WritingGoal{Cadence: src.CadenceWeekly, Count: 2},
+			expectedOk: true,
+		},
+		{
+			name:        "with an unknown cadence",
+			cfg:         "goal:\n  cadence: hourly\n  count: 2\n",
+			expectedErr: "unknown goal.cadence 'hourly' in the config. It can be daily, weekly, or monthly",
+		},
+		{
+			name:        "without a count",
+			cfg:         "goal:\n  cadence: weekly\n",
+			expectedErr: "goal.count in the config needs to be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.expectedLen, len(actual))
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			goal, ok, err := src.Goal(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expected, goal)
 		})
 	}
 }
 
-func Test_TagMap_Len(t *testing.T) {
+func Test_WeekStart(t *testing.T) {
 	tests := []struct {
 		name        string
-		page        *pages.Page
-		expectedLen int
+		cfg         string
+		expected    time.Weekday
+		expectedErr string
+	}{
+		{name: "with nothing configured", cfg: "editor: vim", expected: time.Sunday},
+		{name: "with a day's name", cfg: "weekStart: Monday", expected: time.Monday},
+		{name: "with a short name", cfg: "weekStart: sat", expected: time.Saturday},
+		{name: "with an unknown day", cfg: "weekStart: funday", expectedErr: "unknown weekStart 'funday' in the config. It's a day of the week, like monday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYamlBytes([]byte(tt.cfg))
+
+			day, err := src.WeekStart(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, day)
+		})
+	}
+}
+
+func Test_monthCounts(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	pageSet := []*pages.Page{
+		{Title: "Today", Date: "2024-03-10T09:00:00Z"},
+		{Title: "Start of the month", Date: "2024-03-01T00:00:00Z"},
+		{Title: "New year's eve", Date: "2023-12-31T23:30:00Z"},
+		{Title: "Also new year's eve", Date: "2023-12-31T20:00:00-05:00"},
+		{Title: "Before the chart", Date: "2023-10-31T09:00:00Z"},
+		{Title: "No date"},
+	}
+
+	counts := monthCounts(pageSet, now, time.UTC, 5)
+
+	months := []string{}
+	numbers := []int{}
+	for _, mc := range counts {
+		months = append(months, mc.month.Format("2006-01"))
+		numbers = append(numbers, mc.count)
+	}
+
+	// January and February are there, even though they're empty, and March
+	// is counted so far. The page written at 8pm in New York was written in
+	// January in UTC
+	assert.Equal(t, []string{"2023-11", "2023-12", "2024-01", "2024-02", "2024-03"}, months)
+	assert.Equal(t, []int{0, 1, 1, 0, 2}, numbers)
+}
+
+func Test_bar(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		max      int
+		width    int
+		expected string
+	}{
+		{name: "the most", count: 10, max: 10, width: 4, expected: "████"},
+		{name: "half", count: 5, max: 10, width: 4, expected: "██"},
+		{name: "eighths", count: 3, max: 10, width: 4, expected: "█▎"},
+		{name: "too small to see", count: 1, max: 1000, width: 4, expected: "▏"},
+		{name: "none", count: 0, max: 10, width: 4, expected: ""},
+		{name: "nothing written", count: 0, max: 0, width: 4, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, bar(tt.count, tt.max, tt.width))
+		})
+	}
+}
+
+func Test_monthChart(t *testing.T) {
+	counts := []monthCount{
+		{month: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), count: 12},
+		{month: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), count: 0},
+		{month: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), count: 3},
+	}
+
+	expected := "Jan 2024  ████████  12\n" +
+		"Feb 2024             0\n" +
+		"Mar 2024  ██         3 so far\n"
+
+	assert.Equal(t, expected, monthChart(counts, 29))
+}
+
+func Test_spellWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected []spelledWord
 	}{
 		{
-			name:        "with missing tag",
-			page:        &pages.Page{},
-			expectedLen: 0,
+			name:     "with front-matter",
+			source:   "---\ntitle: Mispeled\n---\nA mispeled word\n",
+			expected: []spelledWord{{"mispeled", 4, 3}, {"word", 4, 12}},
 		},
 		{
-			name:        "with valid tag",
-			page:        &pages.Page{TagsStr: "go"},
-			expectedLen: 1,
+			name:     "with inline code of more than one backtick",
+			source:   "Run ``go `vet` mispeled`` first",
+			expected: []spelledWord{{"Run", 1, 1}, {"first", 1, 27}},
+		},
+		{
+			name:     "with an unclosed fence",
+			source:   "Before\n~~~\nafter",
+			expected: []spelledWord{{"Before", 1, 1}},
+		},
+		{
+			name:     "with a longer closing fence",
+			source:   "```go\nx := 1\n`````\nafter",
+			expected: []spelledWord{{"after", 4, 1}},
+		},
+		{
+			name:     "with dashes and quotes",
+			source:   "well-known “ideas”—mostly",
+			expected: []spelledWord{{"well", 1, 1}, {"known", 1, 6}, {"ideas", 1, 13}, {"mostly", 1, 20}},
 		},
 	}
 
 	for _, tt := range tests {
-		pageSet := []*pages.Page{tt.page}
-		tMap := pages.NewTagMap(pageSet)
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, spellWords(tt.source))
+		})
+	}
+}
 
-		actual := tMap.Len()
+func Test_unknownWords(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "spellcheck", "technical.md"))
+	assert.NoError(t, err)
 
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.expectedLen, actual)
+	expected := []spelledWord{
+		{"shuold", 25, 32},
+		{"aftr", 25, 69},
+	}
+
+	known, err := knownWords([]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, unknownWords(string(data), known))
+}
+
+func Test_englishWords(t *testing.T) {
+	words, err := englishWords()
+	assert.NoError(t, err)
+
+	// spellcheck_words.go is up to date with the word list it's generated from
+	expected, err := ioutil.ReadFile(filepath.Join("words", "english.txt"))
+	assert.NoError(t, err)
+	assert.True(t, words == string(expected), "spellcheck_words.go is out of date; run go generate")
+}
+
+func Test_isKnownWord(t *testing.T) {
+	known, err := knownWords([]string{"Kubernetes"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		word     string
+		expected bool
+	}{
+		{"Kubernetes", true},
+		{"kubernetes", true},
+		{"goroutines", true},
+		{"Rob's", true},
+		{"boxes", true},
+		{"couldn't", true},
+		{"mispeled", false},
+		{"aftr", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isKnownWord(known, tt.word))
 		})
 	}
 }
 
-func Test_TagMap_SortedTagNames(t *testing.T) {
-	pageSet := []*pages.Page{{TagsStr: "go, ada, lua"}}
-	tMap := pages.NewTagMap(pageSet)
+func Test_addToDictionary(t *testing.T) {
+	dictPath := filepath.Join(t.TempDir(), "til", "dictionary.txt")
 
-	expected := []string{"ada", "go", "lua"}
-	actual := tMap.SortedTagNames()
+	added, err := addToDictionary(dictPath, "Kubernetes")
+	assert.NoError(t, err)
+	assert.True(t, added)
 
-	assert.Equal(t, expected, actual)
+	added, err = addToDictionary(dictPath, "kubernetes")
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	_, err = addToDictionary(dictPath, "v1.2")
+	assert.Error(t, err)
+
+	words, err := readDictionary(dictPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Kubernetes"}, words)
+}
+
+func Test_buildContent_Warnings(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	files := map[string]string{
+		"arrays.md":      "---\ndate: 2020-05-04T13:13:08-07:00\ntags: go\ntitle: Arrays\n---\n# Arrays\n\nSee [the index](index.md), [go](go.md#top), [channels](./channels.md), [mutexes](mutexes.md), and [the spec](https://go.dev/ref/spec). `[not a link](nope.md)`\n\n```\n[also not](nope.md)\n```\n",
+		"channels.md":    "---\ndate: 2020-05-05T13:13:08-07:00\ntags: go\ntitle: Channels\n---\n# Channels\n\n",
+		"mutexes.md":     "---\ndate: 2020-05-06T13:13:08-07:00\ntitle: Mutexes\n---\n# Mutexes\n\nLock them.\n",
+		"sitemaps.md":    "---\ndate: 2020-05-07T13:13:08-07:00\ntags: sitemap\ntitle: Sitemaps\n---\n# Sitemaps\n\n![diagram](images/sitemap.png)\n\n[spec]: missing.md\n",
+		"zombies.md":     "---\ntitle: [Zombies\n---\n",
+		"rust.md":        pages.MarkGenerated("## rust\n"),
+		"tags/go/old.md": pages.MarkGenerated("## go/old\n"),
+	}
+
+	for name, content := range files {
+		filePath := filepath.Join(docsDir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+	}
+
+	warnings := newBuildWarnings()
+	assert.NoError(t, buildContent(context.Background(), warnings))
+
+	expected := map[warningKind]int{
+		warningBrokenLink:     2,
+		warningDuplicateTitle: 0,
+		warningEmptyPage:      1,
+		warningParseSkipped:   1,
+		warningReservedTag:    1,
+		warningStaleTagPage:   2,
+		warningTagCollision:   0,
+		warningUntagged:       1,
+	}
+
+	for kind, count := range expected {
+		assert.Equal(t, count, warnings.count(kind), string(kind))
+	}
+
+	assert.Equal(t, 8, warnings.len())
+	assert.Equal(t, []string{
+		filepath.Join(docsDir, "sitemaps.md") + " links to images/sitemap.png, which doesn't exist",
+		filepath.Join(docsDir, "sitemaps.md") + " links to missing.md, which doesn't exist",
+	}, warnings.messages(warningBrokenLink))
+	assert.Equal(t, "8 warnings: 1 page that couldn't be parsed, 1 empty page, 1 untagged page, 1 reserved tag, 2 broken links, 2 stale tag pages", warnings.summary())
+
+	build := warnings.json()
+	assert.Equal(t, 2, build.Counts["stale-tag-page"])
+	assert.Equal(t, 0, build.Counts["tag-collision"])
+	assert.Equal(t, 8, len(build.Warnings))
+	assert.Equal(t, "parse-skipped", build.Warnings[0].Kind)
+
+	// With -strict, the pages that can't be parsed stop it first, and once
+	// they're gone, the rest of the warnings fail it
+	strictFlag = true
+	defer func() { strictFlag = false }()
+
+	assert.NoError(t, os.Remove(filepath.Join(docsDir, "zombies.md")))
+
+	err := buildContent(context.Background(), newBuildWarnings())
+
+	var strictErr *src.StrictError
+	assert.True(t, errors.As(err, &strictErr))
+	assert.Equal(t, 7, strictErr.Warnings)
+	assert.Equal(t, src.ExitUsage, src.ExitCode(err))
+}
+
+func Test_buildWarnings_summary(t *testing.T) {
+	warnings := newBuildWarnings()
+	assert.Equal(t, "", warnings.summary())
+
+	warnings.add(warningUntagged, "docs/a.md has no tags")
+	warnings.add(warningBrokenLink, "docs/a.md links to b.md")
+	warnings.add(warningUntagged, "docs/c.md has no tags")
+
+	assert.Equal(t, "3 warnings: 2 untagged pages, 1 broken link", warnings.summary())
+	assert.Equal(t, []string{"docs/a.md has no tags", "docs/c.md has no tags"}, warnings.messages(warningUntagged))
+}
+
+func Test_internalLinks(t *testing.T) {
+	content := strings.Join([]string{
+		"# Links",
+		"[a](a.md) [b](<b c.md> \"B\") [d](d%20e.md?x=1#y) [top](#top) [site](/about) [mail](mailto:a@example.com)",
+		"![image](images/f.png) [g](https://example.com/g.md) `[h](h.md)`",
+		"[ref]: ../i.md",
+		"```",
+		"[j](j.md)",
+		"```",
+	}, "\n")
+
+	assert.Equal(t, []string{"a.md", "b c.md", "d e.md", "images/f.png", "../i.md"}, internalLinks(content))
+}
+
+func Test_isEmptyPage(t *testing.T) {
+	assert.True(t, isEmptyPage(&pages.Page{Content: "# Title\n\n"}))
+	assert.True(t, isEmptyPage(&pages.Page{Content: ""}))
+	assert.False(t, isEmptyPage(&pages.Page{Content: "# Title\n\nSomething\n"}))
+}
+
+func Test_parseInterspersed(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	apply := flags.Bool("apply", false, "")
+	top := flags.Int("top", 0, "")
+
+	positional := parseInterspersed(flags, []string{"go", "--top", "2", "closures", "--apply"})
+
+	assert.Equal(t, []string{"go", "closures"}, positional)
+	assert.True(t, *apply)
+	assert.Equal(t, 2, *top)
+}
+
+func Test_SuggestTags_Hierarchy(t *testing.T) {
+	page := &pages.Page{FilePath: "docs/new.md", Content: "Some notes on c++ concurrency, not c."}
+
+	pageSet := []*pages.Page{
+		page,
+		{FilePath: "docs/a.md", TagsStr: "go/concurrency"},
+		{FilePath: "docs/b.md", TagsStr: "c++"},
+		{FilePath: "docs/c.md", TagsStr: "c"},
+	}
+
+	expected := []pages.TagSuggestion{
+		{Name: "c", Score: 2},
+		{Name: "c++", Score: 2},
+		{Name: "go/concurrency", Score: 2},
+	}
+
+	// go isn't suggested just for being the parent of go/concurrency
+
+	assert.Equal(t, expected, pages.SuggestTags(page, pages.NewTagMap(pageSet)))
+}
+
+/* -------------------- Test Helpers -------------------- */
+
+// dupesBenchmarkPages returns n pages with titles and content made from the
+// same handful of Go words, for til dupes to compare
+func dupesBenchmarkPages(n int) []*pages.Page {
+	words := strings.Fields("goroutine channel mutex slice map closure interface struct pointer defer panic recover select context timer ticker buffer reader writer error")
+
+	pageSet := []*pages.Page{}
+	for i := 0; i < n; i++ {
+		body := []string{}
+		for j := 0; j < 200; j++ {
+			body = append(body, words[(i*7+j*j)%len(words)])
+		}
+
+		pageSet = append(pageSet, &pages.Page{
+			FilePath: fmt.Sprintf("docs/%d.md", i),
+			Title:    fmt.Sprintf("Go %s %s %d", words[i%len(words)], words[(i/len(words))%len(words)], i),
+			Content:  strings.Join(body, " "),
+		})
+	}
+
+	return pageSet
+}
+
+// setUpTargetDir creates a temporary target directory with a docs folder in
+// it, and points the global config at it. It returns the path to the docs
+// folder and a function that removes everything again
+func setUpTargetDir(t *testing.T) (string, func()) {
+	tDir, err := ioutil.TempDir("", "til")
+	assert.NoError(t, err)
+
+	docsDir := filepath.Join(tDir, "docs")
+	assert.NoError(t, os.Mkdir(docsDir, 0755))
+
+	src.GlobalConfig, err = config.ParseYamlBytes([]byte(fmt.Sprintf("targetDirectories:\n  a: %s\n", tDir)))
+	assert.NoError(t, err)
+
+	return docsDir, func() { os.RemoveAll(tDir) }
+}
+
+// setUpMemFS is setUpTargetDir, with an in-memory filesystem in place of the
+// real disk for the pages. It returns the MemFS too, and its function puts
+// the real disk back as well as removing everything
+func setUpMemFS(t *testing.T) (string, *pages.MemFS, func()) {
+	docsDir, cleanup := setUpTargetDir(t)
+
+	memFS := pages.NewMemFS()
+	fileSystem = memFS
+
+	return docsDir, memFS, func() {
+		fileSystem = pages.OSFS{}
+		cleanup()
+	}
+}
+
+// newTestPage creates a page the way pages.NewPage does, failing the test if
+// it can't be
+func newTestPage(t *testing.T, title string, targetDir string, opts pages.PageOptions) *pages.Page {
+	page, err := pages.NewPage(title, targetDir, opts)
+	assert.NoError(t, err)
+
+	return page
+}
+
+// readTestPage reads the page at filePath, failing the test if it can't be
+func readTestPage(t *testing.T, filePath string) *pages.Page {
+	page, err := pages.ReadPage(filePath)
+	assert.NoError(t, err)
+
+	return page
+}
+
+func Test_authorsSummary(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	defer func(runner commandRunner) { runCommand = runner }(runCommand)
+
+	logs := map[string]string{
+		filepath.Join(docsDir, "b.md"): "Bob\nAnn\nBob\n",
+		filepath.Join(docsDir, "c.md"): "Ann\nBob\n",
+		filepath.Join(docsDir, "d.md"): "",
+	}
+
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		if args[0] == "rev-parse" {
+			return []byte("true\n"), nil
+		}
+
+		return []byte(logs[args[len(args)-1]]), nil
+	}
+
+	pageSet := []*pages.Page{
+		{Author: "Ann", Title: "A", FilePath: filepath.Join(docsDir, "a.md")},
+		{Title: "B", FilePath: filepath.Join(docsDir, "b.md")},
+		{Title: "C", FilePath: filepath.Join(docsDir, "c.md")},
+		{Title: "D", FilePath: filepath.Join(docsDir, "d.md")},
+		{Author: "Cy", Title: "E", FilePath: filepath.Join(docsDir, "e.md")},
+		{Author: "Ann", Title: "F", FilePath: filepath.Join(docsDir, "f.md")},
+	}
+
+	assert.Equal(t, "6 TILs by 3 people: Ann (2), Bob (1), Cy (1), unknown (2)", authorsSummary(pageSet))
+
+	// Outside a git repo only the front-matter is used
+	runCommand = func(dir string, name string, args ...string) ([]byte, error) {
+		return nil, errors.New("not a git repository")
+	}
+
+	assert.Equal(t, "1 TIL by 1 person: Ann (1)", authorsSummary(pageSet[:1]))
+	assert.Equal(t, "1 TIL by 0 people: unknown (1)", authorsSummary(pageSet[1:2]))
+	assert.Equal(t, "0 TILs by 0 people", authorsSummary([]*pages.Page{}))
+}
+
+func Test_pagedWriter(t *testing.T) {
+	defer func(finder func(string) (string, error)) { lookPath = finder }(lookPath)
+	defer func(run func([]string, []byte, io.Writer) error) { runPager = run }(runPager)
+	defer func(pager string) { os.Setenv("PAGER", pager) }(os.Getenv("PAGER"))
+
+	installed := true
+	lookPath = func(file string) (string, error) {
+		if installed {
+			return "/usr/bin/" + file, nil
+		}
+
+		return "", errors.New("not found")
+	}
+
+	var paged [][]string
+	var pagerErr error
+	runPager = func(args []string, content []byte, out io.Writer) error {
+		if pagerErr != nil {
+			return pagerErr
+		}
+
+		paged = append(paged, args)
+		out.Write(append([]byte("paged: "), content...))
+
+		return nil
+	}
+
+	write := func(height int, lines int) string {
+		buf := &bytes.Buffer{}
+		out := newPagedWriter(buf, height)
+
+		for i := 0; i < lines; i++ {
+			fmt.Fprintln(out, "line")
+		}
+
+		assert.NoError(t, out.Close())
+
+		return buf.String()
+	}
+
+	os.Setenv("PAGER", "")
+
+	// Output that fits on the terminal, or isn't going to one, is written straight out
+	assert.Equal(t, strings.Repeat("line\n", 3), write(24, 3))
+	assert.Equal(t, strings.Repeat("line\n", 50), write(0, 50))
+	assert.Empty(t, paged)
+
+	// Longer output goes through less -R, or $PAGER when it's set
+	assert.Equal(t, "paged: "+strings.Repeat("line\n", 50), write(24, 50))
+	assert.Equal(t, [][]string{{"/usr/bin/less", "-R"}}, paged)
+
+	os.Setenv("PAGER", "more -s")
+	write(24, 24)
+	assert.Equal(t, []string{"/usr/bin/more", "-s"}, paged[1])
+
+	// Without the pager, or when it won't start, it's written straight out after all
+	installed = false
+	assert.Equal(t, strings.Repeat("line\n", 50), write(24, 50))
+	assert.Len(t, paged, 2)
+
+	installed = true
+	pagerErr = errors.New("exec format error")
+	assert.Equal(t, strings.Repeat("line\n", 50), write(24, 50))
+	assert.Len(t, paged, 2)
+}
+
+func Test_lockDocs(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	lock, err := lockDocs(docsDir, false)
+	assert.NoError(t, err)
+
+	// A second til fails fast while the first holds the lock
+	errs := make(chan error)
+	go func() {
+		_, err := lockDocs(docsDir, false)
+		errs <- err
+	}()
+
+	err = <-errs
+	assert.EqualError(t, err, fmt.Sprintf("another til process is running (pid %d)", os.Getpid()))
+
+	// Or, with wait, gets it once the first releases it
+	acquired := make(chan *docsLock)
+	go func() {
+		waited, err := lockDocs(docsDir, true)
+		assert.NoError(t, err)
+		acquired <- waited
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("got the lock while it was still held")
+	case <-time.After(3 * lockPollInterval):
+	}
+
+	lock.release()
+
+	waited := <-acquired
+	assert.NotNil(t, waited)
+
+	waited.release()
+
+	_, err = os.Stat(filepath.Join(docsDir, lockFileName))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_lockDocs_Stale(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	// A lock file left behind by a process that isn't running any more
+	cmd := exec.Command("go", "version")
+	assert.NoError(t, cmd.Run())
+
+	lockPath := filepath.Join(docsDir, lockFileName)
+	assert.NoError(t, ioutil.WriteFile(lockPath, []byte(fmt.Sprintf("%d\n", cmd.Process.Pid)), 0644))
+
+	lock, err := lockDocs(docsDir, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, cmd.Process.Pid, lock.stalePID)
+
+	data, _ := ioutil.ReadFile(lockPath)
+	assert.Equal(t, fmt.Sprintf("%d\n", os.Getpid()), string(data))
+
+	lock.release()
+}
+
+func Test_lockTargetDocs_DocsDir(t *testing.T) {
+	docsDir, cleanup := setUpTargetDir(t)
+	defer cleanup()
+
+	// A fresh checkout without a docs directory
+	assert.NoError(t, os.Remove(docsDir))
+
+	_, err := lockTargetDocs()
+	assert.EqualError(t, err, fmt.Sprintf("the docs directory %s doesn't exist yet. Create it, or run til again with -create-dir to have til create it", docsDir))
+	assert.Equal(t, src.ExitIO, src.ExitCode(err))
+
+	// With -create-dir, it's created
+	createDirFlag = true
+	defer func() { createDirFlag = false }()
+
+	unlock, err := lockTargetDocs()
+	assert.NoError(t, err)
+	assert.DirExists(t, docsDir)
+
+	unlock()
+
+	// Something that isn't a directory is in the way, which -create-dir can't fix
+	assert.NoError(t, os.RemoveAll(docsDir))
+	assert.NoError(t, ioutil.WriteFile(docsDir, []byte("zombies\n"), 0644))
+
+	_, err = lockTargetDocs()
+	assert.EqualError(t, err, fmt.Sprintf("%s is a file, but it needs to be the directory the pages go in. Move it out of the way, or point targetDirectories somewhere else", docsDir))
+
+	// A docs directory that til can't write to. The tests may run as root,
+	// who can write anywhere, so the error is made up
+	denied := &os.PathError{Op: "open", Path: filepath.Join(docsDir, lockFileName), Err: os.ErrPermission}
+
+	err = newDocsDirError(docsDir, denied)
+	assert.EqualError(t, err, fmt.Sprintf("til doesn't have permission to write to the docs directory %s", docsDir))
+	assert.True(t, errors.Is(err, os.ErrPermission))
+	assert.Equal(t, src.ExitIO, src.ExitCode(err))
+
+	// Anything else is passed on as it is
+	locked := fmt.Errorf(errLocked, os.Getpid())
+	assert.Equal(t, locked, newDocsDirError(docsDir, locked))
+}
+
+// encodeJSON returns the JSON that the --json flag writes out for the value
+func encodeJSON(t *testing.T, val interface{}) string {
+	buf := &bytes.Buffer{}
+
+	jsonOutput = buf
+	defer func() { jsonOutput = os.Stdout }()
+
+	writeJSON(val)
+
+	return buf.String()
+}
+
+// assertGolden checks the output against the golden file at filePath, or
+// rewrites the file with it when the tests are run with -update
+func assertGolden(t *testing.T, filePath, actual string) {
+	t.Helper()
+
+	if *updateGolden {
+		err := ioutil.WriteFile(filePath, []byte(actual), 0644)
+		assert.NoError(t, err)
+	}
+
+	expected, err := ioutil.ReadFile(filePath)
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expected), actual)
 }
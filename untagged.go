@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errUntaggedIndex = "there is no untagged page number %s"
+	errUntaggedNone  = "there are no untagged pages to open"
+)
+
+// runUntagged writes the content pages that have no tags out to the
+// terminal, newest first. With --open it opens the first of them (or the nth,
+// if a number is given) in the editor.
+// Example:
+//
+//	> til untagged --open 2
+func runUntagged(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("untagged", flag.ContinueOnError)
+	open := flags.Bool("open", false, "opens the first (or nth) untagged page in the editor")
+	parseFlags(flags, args)
+
+	pageSet, err := loadPages(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	untagged := untaggedPages(pageSet)
+
+	if !*open {
+		for i, line := range listPages(untagged, "") {
+			fmt.Printf("%3d  %s\n", i+1, line)
+		}
+
+		return
+	}
+
+	page, err := selectUntaggedPage(untagged, flags.Arg(0))
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	err = page.Open(defaultEditorFor(runtime.GOOS))
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	src.Info(page.FilePath)
+}
+
+// untaggedPages returns the content pages that have no tags
+func untaggedPages(pageSet []*pages.Page) []*pages.Page {
+	untagged := []*pages.Page{}
+
+	for _, page := range pageSet {
+		if page.IsContentPage() && !hasTags(page) {
+			untagged = append(untagged, page)
+		}
+	}
+
+	return untagged
+}
+
+// selectUntaggedPage returns the untagged page at the 1-based position given
+// by num, as numbered in the output of til untagged. An empty num selects
+// the first page
+func selectUntaggedPage(untagged []*pages.Page, num string) (*pages.Page, error) {
+	if len(untagged) == 0 {
+		return nil, errors.New(errUntaggedNone)
+	}
+
+	if num == "" {
+		return untagged[0], nil
+	}
+
+	idx, err := strconv.Atoi(num)
+	if err != nil || idx < 1 || idx > len(untagged) {
+		return nil, fmt.Errorf(errUntaggedIndex, num)
+	}
+
+	return untagged[idx-1], nil
+}
+
+func hasTags(page *pages.Page) bool {
+	for _, tag := range page.Tags() {
+		if tag.IsValid() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/pkg/til"
+	"github.com/senorprogrammer/til/src"
+)
+
+const (
+	errPagesNotParsed = "found %d pages that couldn't be parsed"
+
+	statusValidate = "validating pages"
+)
+
+// validator checks the pages for one kind of problem, and returns a warning
+// for each problem it finds. It's an error if it can't check them at all
+type validator func(pageSet []*pages.Page) ([]string, error)
+
+// validators are all the checks that til validate warns about. Tags that
+// aren't in the allowed tags are errors, so they're checked on their own
+var validators = []validator{
+	validateDuplicateTitles,
+	validateReservedTags,
+	validateTagDescriptions,
+	validateTagPagePaths,
+}
+
+// runValidate checks the pages for problems and reports them. Most problems
+// are only warnings, but pages that can't be parsed and tags that aren't in
+// the allowed tags make it fail.
+// Example:
+//
+//	> til validate
+func runValidate(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	parseFlags(flags, args)
+
+	src.Info(statusValidate)
+
+	pageSet, skipped, err := loadPagesSkipping(ctx)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	unparsed := validateSkipped(skipped)
+	for _, problem := range unparsed {
+		src.Progress(problem)
+	}
+
+	disallowed, err := validateAllowedTags(pageSet)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	for _, problem := range disallowed {
+		src.Progress(problem)
+	}
+
+	warnings, err := validatePages(pageSet)
+	if err != nil {
+		src.Defeat(err)
+	}
+
+	for _, warning := range warnings {
+		src.Progress(warning)
+	}
+
+	src.Info(fmt.Sprintf("%d warnings", len(warnings)))
+
+	if jsonFlag {
+		errs := append(append([]string{}, unparsed...), disallowed...)
+		writeJSON(src.JSONValidation{Version: src.JSONVersion, Errors: errs, Warnings: warnings})
+	}
+
+	if len(unparsed) > 0 {
+		src.Defeat(fmt.Errorf(errPagesNotParsed, len(unparsed)))
+	}
+
+	if len(disallowed) > 0 {
+		src.Defeat(fmt.Errorf(errTagsNotAllowed, len(disallowed)))
+	}
+}
+
+// checkStrict returns an error if any page has a tag that isn't in the
+// allowed tags, which stops the build, as asked for by the -strict flag
+func checkStrict(pageSet []*pages.Page) error {
+	disallowed, err := validateAllowedTags(pageSet)
+	if err != nil || len(disallowed) == 0 {
+		return err
+	}
+
+	for _, warning := range disallowed {
+		src.Warn(warning)
+	}
+
+	return fmt.Errorf(errTagsNotAllowed, len(disallowed))
+}
+
+// validatePages runs every validator against the pages
+func validatePages(pageSet []*pages.Page) ([]string, error) {
+	warnings := []string{}
+
+	for _, validate := range validators {
+		found, err := validate(pageSet)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings = append(warnings, found...)
+	}
+
+	return warnings, nil
+}
+
+// validateSkipped returns a problem for each page that couldn't be parsed,
+// with why
+func validateSkipped(skipped []til.SkippedFile) []string {
+	problems := []string{}
+
+	for _, file := range skipped {
+		problems = append(problems, file.Err.Error())
+	}
+
+	return problems
+}
+
+// validateDuplicateTitles warns about pages that have the same title, which
+// makes them hard to tell apart
+func validateDuplicateTitles(pageSet []*pages.Page) ([]string, error) {
+	return duplicateTitleWarnings(pageSet), nil
+}
+
+// validateReservedTags warns about pages with tags whose tag pages would
+// conflict with the pages that til generates
+func validateReservedTags(pageSet []*pages.Page) ([]string, error) {
+	warnings := []string{}
+
+	for _, page := range pageSet {
+		for _, tag := range page.Tags() {
+			if isReservedTagName(tag.Name) {
+				warnings = append(
+					warnings,
+					fmt.Sprintf("%s: tag '%s' is reserved, so it won't get a tag page", page.FilePath, tag.Name),
+				)
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateTagDescriptions warns about tags that have a description but that
+// no page uses any more
+func validateTagDescriptions(pageSet []*pages.Page) ([]string, error) {
+	warnings := []string{}
+	tagMap := newTagMap(pageSet)
+	descs, err := loadTagDescriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range descs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if len(tagMap.PagesFor(name)) == 0 {
+			warnings = append(
+				warnings,
+				fmt.Sprintf("tag '%s' has a description in %s but no pages", name, tagDescriptionsFilePath()),
+			)
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateTagPagePaths warns about different tags that would be written to
+// the same tag page
+func validateTagPagePaths(pageSet []*pages.Page) ([]string, error) {
+	warnings := []string{}
+	collisions := newTagMap(pageSet).PagePathCollisions()
+
+	pagePaths := []string{}
+	for pagePath := range collisions {
+		pagePaths = append(pagePaths, pagePath)
+	}
+
+	sort.Strings(pagePaths)
+
+	for _, pagePath := range pagePaths {
+		warnings = append(
+			warnings,
+			fmt.Sprintf("tags %s all have the tag page %s", strings.Join(collisions[pagePath], ", "), pagePath),
+		)
+	}
+
+	return warnings, nil
+}
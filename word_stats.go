@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/senorprogrammer/til/pages"
+	"github.com/senorprogrammer/til/src"
+)
+
+// longestEntries is the number of the longest entries that til stats lists
+const longestEntries = 5
+
+// entryWords is the number of words of prose in a page
+type entryWords struct {
+	page  *pages.Page
+	words int
+}
+
+// yearWords is the number of words written in the entries of a year
+type yearWords struct {
+	year    int
+	entries int
+	words   int
+}
+
+// wordStats is how much writing there is in the content pages. Only prose
+// is counted as words; the code in fenced code blocks is counted in lines
+type wordStats struct {
+	entries   int
+	words     int
+	codeLines int
+
+	// longest are the entries with the most words, most first, and newest
+	// first when they have as many
+	longest []entryWords
+
+	// years are the words of the entries created in each year, oldest year
+	// first. Undated entries aren't in any year
+	years []yearWords
+}
+
+// measureWords counts the words in the content pages. The years are the
+// ones the pages were created in, in loc
+func measureWords(pageSet []*pages.Page, loc *time.Location) wordStats {
+	stats := wordStats{}
+	byYear := map[int]*yearWords{}
+	counted := []entryWords{}
+
+	for _, page := range listedPages(pageSet, "") {
+		words := page.WordCount()
+
+		stats.entries++
+		stats.words += words
+		stats.codeLines += page.CodeLines()
+		counted = append(counted, entryWords{page: page, words: words})
+
+		created := page.CreatedAt()
+		if created.IsZero() {
+			continue
+		}
+
+		year := created.In(loc).Year()
+		if byYear[year] == nil {
+			byYear[year] = &yearWords{year: year}
+		}
+
+		byYear[year].entries++
+		byYear[year].words += words
+	}
+
+	sort.SliceStable(counted, func(i, j int) bool {
+		if counted[i].words != counted[j].words {
+			return counted[i].words > counted[j].words
+		}
+
+		return counted[i].page.CreatedAt().After(counted[j].page.CreatedAt())
+	})
+
+	if len(counted) > longestEntries {
+		counted = counted[:longestEntries]
+	}
+
+	stats.longest = counted
+
+	for _, yw := range byYear {
+		stats.years = append(stats.years, *yw)
+	}
+
+	sort.Slice(stats.years, func(i, j int) bool { return stats.years[i].year < stats.years[j].year })
+
+	return stats
+}
+
+// summary returns the lines that til stats writes out about the words: the
+// total and the average, the longest entries, and the average of each year
+func (stats wordStats) summary() []string {
+	lines := []string{fmt.Sprintf(
+		"Words: %d of prose, %d per entry on average, and %d lines of code",
+		stats.words, averageWords(stats.words, stats.entries), stats.codeLines,
+	)}
+
+	if len(stats.longest) > 0 {
+		lines = append(lines, "", "Longest entries:")
+
+		for _, ew := range stats.longest {
+			lines = append(lines, fmt.Sprintf("  %6d  %s (%s)", ew.words, ew.page.Title, ew.page.FilePath))
+		}
+	}
+
+	if len(stats.years) > 0 {
+		lines = append(lines, "", "Words per entry by year:")
+
+		for _, yw := range stats.years {
+			lines = append(lines, fmt.Sprintf("  %d  %6d  (%s)", yw.year, averageWords(yw.words, yw.entries), pluralPages(yw.entries)))
+		}
+	}
+
+	return lines
+}
+
+// json returns the words, for the --json flag
+func (stats wordStats) json() *src.JSONWords {
+	words := &src.JSONWords{
+		Words:     stats.words,
+		Average:   averageWords(stats.words, stats.entries),
+		CodeLines: stats.codeLines,
+		Longest:   []src.JSONEntryWords{},
+		Years:     []src.JSONYearWords{},
+	}
+
+	for _, ew := range stats.longest {
+		words.Longest = append(words.Longest, src.JSONEntryWords{Title: ew.page.Title, Path: ew.page.FilePath, Words: ew.words})
+	}
+
+	for _, yw := range stats.years {
+		words.Years = append(words.Years, src.JSONYearWords{Year: yw.year, Pages: yw.entries, Words: yw.words, Average: averageWords(yw.words, yw.entries)})
+	}
+
+	return words
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// averageWords returns the words per entry, to the nearest word, or 0 when
+// there aren't any entries
+func averageWords(words, entries int) int {
+	if entries == 0 {
+		return 0
+	}
+
+	return (words + entries/2) / entries
+}